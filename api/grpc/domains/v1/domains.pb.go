@@ -113,6 +113,438 @@ func (x *DeleteUserReq) GetId() string {
 	return ""
 }
 
+type UpdateUsageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Delta         int64                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUsageReq) Reset() {
+	*x = UpdateUsageReq{}
+	mi := &file_domains_v1_domains_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsageReq) ProtoMessage() {}
+
+func (x *UpdateUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsageReq.ProtoReflect.Descriptor instead.
+func (*UpdateUsageReq) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateUsageReq) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *UpdateUsageReq) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *UpdateUsageReq) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type UpdateUsageRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUsageRes) Reset() {
+	*x = UpdateUsageRes{}
+	mi := &file_domains_v1_domains_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUsageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsageRes) ProtoMessage() {}
+
+func (x *UpdateUsageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsageRes.ProtoReflect.Descriptor instead.
+func (*UpdateUsageRes) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateUsageRes) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type SetUsageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUsageReq) Reset() {
+	*x = SetUsageReq{}
+	mi := &file_domains_v1_domains_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsageReq) ProtoMessage() {}
+
+func (x *SetUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsageReq.ProtoReflect.Descriptor instead.
+func (*SetUsageReq) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetUsageReq) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *SetUsageReq) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *SetUsageReq) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SetUsageRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUsageRes) Reset() {
+	*x = SetUsageRes{}
+	mi := &file_domains_v1_domains_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUsageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUsageRes) ProtoMessage() {}
+
+func (x *SetUsageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUsageRes.ProtoReflect.Descriptor instead.
+func (*SetUsageRes) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetUsageRes) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type Quotas struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clients       uint64                 `protobuf:"varint,1,opt,name=clients,proto3" json:"clients,omitempty"`
+	Channels      uint64                 `protobuf:"varint,2,opt,name=channels,proto3" json:"channels,omitempty"`
+	Groups        uint64                 `protobuf:"varint,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	Invitations   uint64                 `protobuf:"varint,4,opt,name=invitations,proto3" json:"invitations,omitempty"`
+	MessageRate   uint64                 `protobuf:"varint,5,opt,name=message_rate,json=messageRate,proto3" json:"message_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quotas) Reset() {
+	*x = Quotas{}
+	mi := &file_domains_v1_domains_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quotas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quotas) ProtoMessage() {}
+
+func (x *Quotas) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quotas.ProtoReflect.Descriptor instead.
+func (*Quotas) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Quotas) GetClients() uint64 {
+	if x != nil {
+		return x.Clients
+	}
+	return 0
+}
+
+func (x *Quotas) GetChannels() uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *Quotas) GetGroups() uint64 {
+	if x != nil {
+		return x.Groups
+	}
+	return 0
+}
+
+func (x *Quotas) GetInvitations() uint64 {
+	if x != nil {
+		return x.Invitations
+	}
+	return 0
+}
+
+func (x *Quotas) GetMessageRate() uint64 {
+	if x != nil {
+		return x.MessageRate
+	}
+	return 0
+}
+
+type RetrieveQuotasRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotas        *Quotas                `protobuf:"bytes,1,opt,name=quotas,proto3" json:"quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveQuotasRes) Reset() {
+	*x = RetrieveQuotasRes{}
+	mi := &file_domains_v1_domains_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveQuotasRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveQuotasRes) ProtoMessage() {}
+
+func (x *RetrieveQuotasRes) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveQuotasRes.ProtoReflect.Descriptor instead.
+func (*RetrieveQuotasRes) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetrieveQuotasRes) GetQuotas() *Quotas {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+type ClaimInvitationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInvitationsReq) Reset() {
+	*x = ClaimInvitationsReq{}
+	mi := &file_domains_v1_domains_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInvitationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInvitationsReq) ProtoMessage() {}
+
+func (x *ClaimInvitationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInvitationsReq.ProtoReflect.Descriptor instead.
+func (*ClaimInvitationsReq) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClaimInvitationsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimInvitationsReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ClaimInvitationsReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ClaimInvitationsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainIds     []string               `protobuf:"bytes,1,rep,name=domain_ids,json=domainIds,proto3" json:"domain_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInvitationsRes) Reset() {
+	*x = ClaimInvitationsRes{}
+	mi := &file_domains_v1_domains_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInvitationsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInvitationsRes) ProtoMessage() {}
+
+func (x *ClaimInvitationsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_domains_v1_domains_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInvitationsRes.ProtoReflect.Descriptor instead.
+func (*ClaimInvitationsRes) Descriptor() ([]byte, []int) {
+	return file_domains_v1_domains_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClaimInvitationsRes) GetDomainIds() []string {
+	if x != nil {
+		return x.DomainIds
+	}
+	return nil
+}
+
 var File_domains_v1_domains_proto protoreflect.FileDescriptor
 
 const file_domains_v1_domains_proto_rawDesc = "" +
@@ -122,11 +554,42 @@ const file_domains_v1_domains_proto_rawDesc = "" +
 	"\rDeleteUserRes\x12\x18\n" +
 	"\adeleted\x18\x01 \x01(\bR\adeleted\"\x1f\n" +
 	"\rDeleteUserReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\x87\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x0eUpdateUsageReq\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\"*\n" +
+	"\x0eUpdateUsageRes\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"\\\n" +
+	"\vSetUsageReq\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\"'\n" +
+	"\vSetUsageRes\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"\x9b\x01\n" +
+	"\x06Quotas\x12\x18\n" +
+	"\aclients\x18\x01 \x01(\x04R\aclients\x12\x1a\n" +
+	"\bchannels\x18\x02 \x01(\x04R\bchannels\x12\x16\n" +
+	"\x06groups\x18\x03 \x01(\x04R\x06groups\x12 \n" +
+	"\vinvitations\x18\x04 \x01(\x04R\vinvitations\x12!\n" +
+	"\fmessage_rate\x18\x05 \x01(\x04R\vmessageRate\"?\n" +
+	"\x11RetrieveQuotasRes\x12*\n" +
+	"\x06quotas\x18\x01 \x01(\v2\x12.domains.v1.QuotasR\x06quotas\"Z\n" +
+	"\x13ClaimInvitationsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"4\n" +
+	"\x13ClaimInvitationsRes\x12\x1d\n" +
+	"\n" +
+	"domain_ids\x18\x01 \x03(\tR\tdomainIds2\xb9\x04\n" +
 	"\x0eDomainsService\x12O\n" +
 	"\x15DeleteUserFromDomains\x12\x19.domains.v1.DeleteUserReq\x1a\x19.domains.v1.DeleteUserRes\"\x00\x12N\n" +
 	"\x0eRetrieveStatus\x12\x1c.common.v1.RetrieveEntityReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00\x12T\n" +
-	"\x11RetrieveIDByRoute\x12\x1f.common.v1.RetrieveIDByRouteReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00B5Z3github.com/absmach/supermq/internal/grpc/domains/v1b\x06proto3"
+	"\x11RetrieveIDByRoute\x12\x1f.common.v1.RetrieveIDByRouteReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00\x12G\n" +
+	"\vUpdateUsage\x12\x1a.domains.v1.UpdateUsageReq\x1a\x1a.domains.v1.UpdateUsageRes\"\x00\x12>\n" +
+	"\bSetUsage\x12\x17.domains.v1.SetUsageReq\x1a\x17.domains.v1.SetUsageRes\"\x00\x12O\n" +
+	"\x0eRetrieveQuotas\x12\x1c.common.v1.RetrieveEntityReq\x1a\x1d.domains.v1.RetrieveQuotasRes\"\x00\x12V\n" +
+	"\x10ClaimInvitations\x12\x1f.domains.v1.ClaimInvitationsReq\x1a\x1f.domains.v1.ClaimInvitationsRes\"\x00B5Z3github.com/absmach/supermq/internal/grpc/domains/v1b\x06proto3"
 
 var (
 	file_domains_v1_domains_proto_rawDescOnce sync.Once
@@ -140,26 +603,43 @@ func file_domains_v1_domains_proto_rawDescGZIP() []byte {
 	return file_domains_v1_domains_proto_rawDescData
 }
 
-var file_domains_v1_domains_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_domains_v1_domains_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_domains_v1_domains_proto_goTypes = []any{
 	(*DeleteUserRes)(nil),           // 0: domains.v1.DeleteUserRes
 	(*DeleteUserReq)(nil),           // 1: domains.v1.DeleteUserReq
-	(*v1.RetrieveEntityReq)(nil),    // 2: common.v1.RetrieveEntityReq
-	(*v1.RetrieveIDByRouteReq)(nil), // 3: common.v1.RetrieveIDByRouteReq
-	(*v1.RetrieveEntityRes)(nil),    // 4: common.v1.RetrieveEntityRes
+	(*UpdateUsageReq)(nil),          // 2: domains.v1.UpdateUsageReq
+	(*UpdateUsageRes)(nil),          // 3: domains.v1.UpdateUsageRes
+	(*SetUsageReq)(nil),             // 4: domains.v1.SetUsageReq
+	(*SetUsageRes)(nil),             // 5: domains.v1.SetUsageRes
+	(*Quotas)(nil),                  // 6: domains.v1.Quotas
+	(*RetrieveQuotasRes)(nil),       // 7: domains.v1.RetrieveQuotasRes
+	(*ClaimInvitationsReq)(nil),     // 8: domains.v1.ClaimInvitationsReq
+	(*ClaimInvitationsRes)(nil),     // 9: domains.v1.ClaimInvitationsRes
+	(*v1.RetrieveEntityReq)(nil),    // 10: common.v1.RetrieveEntityReq
+	(*v1.RetrieveIDByRouteReq)(nil), // 11: common.v1.RetrieveIDByRouteReq
+	(*v1.RetrieveEntityRes)(nil),    // 12: common.v1.RetrieveEntityRes
 }
 var file_domains_v1_domains_proto_depIdxs = []int32{
-	1, // 0: domains.v1.DomainsService.DeleteUserFromDomains:input_type -> domains.v1.DeleteUserReq
-	2, // 1: domains.v1.DomainsService.RetrieveStatus:input_type -> common.v1.RetrieveEntityReq
-	3, // 2: domains.v1.DomainsService.RetrieveIDByRoute:input_type -> common.v1.RetrieveIDByRouteReq
-	0, // 3: domains.v1.DomainsService.DeleteUserFromDomains:output_type -> domains.v1.DeleteUserRes
-	4, // 4: domains.v1.DomainsService.RetrieveStatus:output_type -> common.v1.RetrieveEntityRes
-	4, // 5: domains.v1.DomainsService.RetrieveIDByRoute:output_type -> common.v1.RetrieveEntityRes
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6,  // 0: domains.v1.RetrieveQuotasRes.quotas:type_name -> domains.v1.Quotas
+	1,  // 1: domains.v1.DomainsService.DeleteUserFromDomains:input_type -> domains.v1.DeleteUserReq
+	10, // 2: domains.v1.DomainsService.RetrieveStatus:input_type -> common.v1.RetrieveEntityReq
+	11, // 3: domains.v1.DomainsService.RetrieveIDByRoute:input_type -> common.v1.RetrieveIDByRouteReq
+	2,  // 4: domains.v1.DomainsService.UpdateUsage:input_type -> domains.v1.UpdateUsageReq
+	4,  // 5: domains.v1.DomainsService.SetUsage:input_type -> domains.v1.SetUsageReq
+	10, // 6: domains.v1.DomainsService.RetrieveQuotas:input_type -> common.v1.RetrieveEntityReq
+	8,  // 7: domains.v1.DomainsService.ClaimInvitations:input_type -> domains.v1.ClaimInvitationsReq
+	0,  // 8: domains.v1.DomainsService.DeleteUserFromDomains:output_type -> domains.v1.DeleteUserRes
+	12, // 9: domains.v1.DomainsService.RetrieveStatus:output_type -> common.v1.RetrieveEntityRes
+	12, // 10: domains.v1.DomainsService.RetrieveIDByRoute:output_type -> common.v1.RetrieveEntityRes
+	3,  // 11: domains.v1.DomainsService.UpdateUsage:output_type -> domains.v1.UpdateUsageRes
+	5,  // 12: domains.v1.DomainsService.SetUsage:output_type -> domains.v1.SetUsageRes
+	7,  // 13: domains.v1.DomainsService.RetrieveQuotas:output_type -> domains.v1.RetrieveQuotasRes
+	9,  // 14: domains.v1.DomainsService.ClaimInvitations:output_type -> domains.v1.ClaimInvitationsRes
+	8,  // [8:15] is the sub-list for method output_type
+	1,  // [1:8] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_domains_v1_domains_proto_init() }
@@ -173,7 +653,7 @@ func file_domains_v1_domains_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domains_v1_domains_proto_rawDesc), len(file_domains_v1_domains_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
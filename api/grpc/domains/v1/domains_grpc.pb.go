@@ -26,6 +26,10 @@ const (
 	DomainsService_DeleteUserFromDomains_FullMethodName = "/domains.v1.DomainsService/DeleteUserFromDomains"
 	DomainsService_RetrieveStatus_FullMethodName        = "/domains.v1.DomainsService/RetrieveStatus"
 	DomainsService_RetrieveIDByRoute_FullMethodName     = "/domains.v1.DomainsService/RetrieveIDByRoute"
+	DomainsService_UpdateUsage_FullMethodName           = "/domains.v1.DomainsService/UpdateUsage"
+	DomainsService_SetUsage_FullMethodName              = "/domains.v1.DomainsService/SetUsage"
+	DomainsService_RetrieveQuotas_FullMethodName        = "/domains.v1.DomainsService/RetrieveQuotas"
+	DomainsService_ClaimInvitations_FullMethodName      = "/domains.v1.DomainsService/ClaimInvitations"
 )
 
 // DomainsServiceClient is the client API for DomainsService service.
@@ -38,6 +42,10 @@ type DomainsServiceClient interface {
 	DeleteUserFromDomains(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserRes, error)
 	RetrieveStatus(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error)
 	RetrieveIDByRoute(ctx context.Context, in *v1.RetrieveIDByRouteReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error)
+	UpdateUsage(ctx context.Context, in *UpdateUsageReq, opts ...grpc.CallOption) (*UpdateUsageRes, error)
+	SetUsage(ctx context.Context, in *SetUsageReq, opts ...grpc.CallOption) (*SetUsageRes, error)
+	RetrieveQuotas(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*RetrieveQuotasRes, error)
+	ClaimInvitations(ctx context.Context, in *ClaimInvitationsReq, opts ...grpc.CallOption) (*ClaimInvitationsRes, error)
 }
 
 type domainsServiceClient struct {
@@ -78,6 +86,46 @@ func (c *domainsServiceClient) RetrieveIDByRoute(ctx context.Context, in *v1.Ret
 	return out, nil
 }
 
+func (c *domainsServiceClient) UpdateUsage(ctx context.Context, in *UpdateUsageReq, opts ...grpc.CallOption) (*UpdateUsageRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUsageRes)
+	err := c.cc.Invoke(ctx, DomainsService_UpdateUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainsServiceClient) SetUsage(ctx context.Context, in *SetUsageReq, opts ...grpc.CallOption) (*SetUsageRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUsageRes)
+	err := c.cc.Invoke(ctx, DomainsService_SetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainsServiceClient) RetrieveQuotas(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*RetrieveQuotasRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetrieveQuotasRes)
+	err := c.cc.Invoke(ctx, DomainsService_RetrieveQuotas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainsServiceClient) ClaimInvitations(ctx context.Context, in *ClaimInvitationsReq, opts ...grpc.CallOption) (*ClaimInvitationsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimInvitationsRes)
+	err := c.cc.Invoke(ctx, DomainsService_ClaimInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DomainsServiceServer is the server API for DomainsService service.
 // All implementations must embed UnimplementedDomainsServiceServer
 // for forward compatibility.
@@ -88,6 +136,10 @@ type DomainsServiceServer interface {
 	DeleteUserFromDomains(context.Context, *DeleteUserReq) (*DeleteUserRes, error)
 	RetrieveStatus(context.Context, *v1.RetrieveEntityReq) (*v1.RetrieveEntityRes, error)
 	RetrieveIDByRoute(context.Context, *v1.RetrieveIDByRouteReq) (*v1.RetrieveEntityRes, error)
+	UpdateUsage(context.Context, *UpdateUsageReq) (*UpdateUsageRes, error)
+	SetUsage(context.Context, *SetUsageReq) (*SetUsageRes, error)
+	RetrieveQuotas(context.Context, *v1.RetrieveEntityReq) (*RetrieveQuotasRes, error)
+	ClaimInvitations(context.Context, *ClaimInvitationsReq) (*ClaimInvitationsRes, error)
 	mustEmbedUnimplementedDomainsServiceServer()
 }
 
@@ -107,6 +159,18 @@ func (UnimplementedDomainsServiceServer) RetrieveStatus(context.Context, *v1.Ret
 func (UnimplementedDomainsServiceServer) RetrieveIDByRoute(context.Context, *v1.RetrieveIDByRouteReq) (*v1.RetrieveEntityRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveIDByRoute not implemented")
 }
+func (UnimplementedDomainsServiceServer) UpdateUsage(context.Context, *UpdateUsageReq) (*UpdateUsageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsage not implemented")
+}
+func (UnimplementedDomainsServiceServer) SetUsage(context.Context, *SetUsageReq) (*SetUsageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsage not implemented")
+}
+func (UnimplementedDomainsServiceServer) RetrieveQuotas(context.Context, *v1.RetrieveEntityReq) (*RetrieveQuotasRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveQuotas not implemented")
+}
+func (UnimplementedDomainsServiceServer) ClaimInvitations(context.Context, *ClaimInvitationsReq) (*ClaimInvitationsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInvitations not implemented")
+}
 func (UnimplementedDomainsServiceServer) mustEmbedUnimplementedDomainsServiceServer() {}
 func (UnimplementedDomainsServiceServer) testEmbeddedByValue()                        {}
 
@@ -182,6 +246,78 @@ func _DomainsService_RetrieveIDByRoute_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DomainsService_UpdateUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainsServiceServer).UpdateUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainsService_UpdateUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainsServiceServer).UpdateUsage(ctx, req.(*UpdateUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainsService_SetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainsServiceServer).SetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainsService_SetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainsServiceServer).SetUsage(ctx, req.(*SetUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainsService_RetrieveQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RetrieveEntityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainsServiceServer).RetrieveQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainsService_RetrieveQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainsServiceServer).RetrieveQuotas(ctx, req.(*v1.RetrieveEntityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainsService_ClaimInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimInvitationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainsServiceServer).ClaimInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainsService_ClaimInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainsServiceServer).ClaimInvitations(ctx, req.(*ClaimInvitationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DomainsService_ServiceDesc is the grpc.ServiceDesc for DomainsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +337,22 @@ var DomainsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveIDByRoute",
 			Handler:    _DomainsService_RetrieveIDByRoute_Handler,
 		},
+		{
+			MethodName: "UpdateUsage",
+			Handler:    _DomainsService_UpdateUsage_Handler,
+		},
+		{
+			MethodName: "SetUsage",
+			Handler:    _DomainsService_SetUsage_Handler,
+		},
+		{
+			MethodName: "RetrieveQuotas",
+			Handler:    _DomainsService_RetrieveQuotas_Handler,
+		},
+		{
+			MethodName: "ClaimInvitations",
+			Handler:    _DomainsService_ClaimInvitations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "domains/v1/domains.proto",
@@ -154,6 +154,94 @@ func (x *RetrieveUsersRes) GetUsers() []*User {
 	return nil
 }
 
+type ProvisionUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserReq) Reset() {
+	*x = ProvisionUserReq{}
+	mi := &file_users_v1_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserReq) ProtoMessage() {}
+
+func (x *ProvisionUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserReq.ProtoReflect.Descriptor instead.
+func (*ProvisionUserReq) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProvisionUserReq) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ProvisionUserRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionUserRes) Reset() {
+	*x = ProvisionUserRes{}
+	mi := &file_users_v1_users_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionUserRes) ProtoMessage() {}
+
+func (x *ProvisionUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionUserRes.ProtoReflect.Descriptor instead.
+func (*ProvisionUserRes) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProvisionUserRes) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 type User struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -179,7 +267,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_users_v1_users_proto_msgTypes[2]
+	mi := &file_users_v1_users_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +279,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[2]
+	mi := &file_users_v1_users_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +292,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{2}
+	return file_users_v1_users_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *User) GetId() string {
@@ -339,7 +427,11 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x04R\x06offset\x12$\n" +
-	"\x05users\x18\x04 \x03(\v2\x0e.users.v1.UserR\x05users\"\xff\x04\n" +
+	"\x05users\x18\x04 \x03(\v2\x0e.users.v1.UserR\x05users\"6\n" +
+	"\x10ProvisionUserReq\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\"6\n" +
+	"\x10ProvisionUserRes\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\"\xff\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -363,9 +455,10 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"\vverified_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"verifiedAt\x12#\n" +
 	"\rauth_provider\x18\x10 \x01(\tR\fauthProvider\x12 \n" +
-	"\vpermissions\x18\x11 \x03(\tR\vpermissions2Y\n" +
+	"\vpermissions\x18\x11 \x03(\tR\vpermissions2\xa4\x01\n" +
 	"\fUsersService\x12I\n" +
-	"\rRetrieveUsers\x12\x1a.users.v1.RetrieveUsersReq\x1a\x1a.users.v1.RetrieveUsersRes\"\x00B.Z,github.com/absmach/supermq/api/grpc/users/v1b\x06proto3"
+	"\rRetrieveUsers\x12\x1a.users.v1.RetrieveUsersReq\x1a\x1a.users.v1.RetrieveUsersRes\"\x00\x12I\n" +
+	"\rProvisionUser\x12\x1a.users.v1.ProvisionUserReq\x1a\x1a.users.v1.ProvisionUserRes\"\x00B.Z,github.com/absmach/supermq/api/grpc/users/v1b\x06proto3"
 
 var (
 	file_users_v1_users_proto_rawDescOnce sync.Once
@@ -379,28 +472,34 @@ func file_users_v1_users_proto_rawDescGZIP() []byte {
 	return file_users_v1_users_proto_rawDescData
 }
 
-var file_users_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_users_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_users_v1_users_proto_goTypes = []any{
 	(*RetrieveUsersReq)(nil),      // 0: users.v1.RetrieveUsersReq
 	(*RetrieveUsersRes)(nil),      // 1: users.v1.RetrieveUsersRes
-	(*User)(nil),                  // 2: users.v1.User
-	(*structpb.Struct)(nil),       // 3: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*ProvisionUserReq)(nil),      // 2: users.v1.ProvisionUserReq
+	(*ProvisionUserRes)(nil),      // 3: users.v1.ProvisionUserRes
+	(*User)(nil),                  // 4: users.v1.User
+	(*structpb.Struct)(nil),       // 5: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_users_v1_users_proto_depIdxs = []int32{
-	2, // 0: users.v1.RetrieveUsersRes.users:type_name -> users.v1.User
-	3, // 1: users.v1.User.metadata:type_name -> google.protobuf.Struct
-	3, // 2: users.v1.User.private_metadata:type_name -> google.protobuf.Struct
-	4, // 3: users.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	4, // 4: users.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	4, // 5: users.v1.User.verified_at:type_name -> google.protobuf.Timestamp
-	0, // 6: users.v1.UsersService.RetrieveUsers:input_type -> users.v1.RetrieveUsersReq
-	1, // 7: users.v1.UsersService.RetrieveUsers:output_type -> users.v1.RetrieveUsersRes
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4,  // 0: users.v1.RetrieveUsersRes.users:type_name -> users.v1.User
+	4,  // 1: users.v1.ProvisionUserReq.user:type_name -> users.v1.User
+	4,  // 2: users.v1.ProvisionUserRes.user:type_name -> users.v1.User
+	5,  // 3: users.v1.User.metadata:type_name -> google.protobuf.Struct
+	5,  // 4: users.v1.User.private_metadata:type_name -> google.protobuf.Struct
+	6,  // 5: users.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: users.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: users.v1.User.verified_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: users.v1.UsersService.RetrieveUsers:input_type -> users.v1.RetrieveUsersReq
+	2,  // 9: users.v1.UsersService.ProvisionUser:input_type -> users.v1.ProvisionUserReq
+	1,  // 10: users.v1.UsersService.RetrieveUsers:output_type -> users.v1.RetrieveUsersRes
+	3,  // 11: users.v1.UsersService.ProvisionUser:output_type -> users.v1.ProvisionUserRes
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_users_v1_users_proto_init() }
@@ -414,7 +513,7 @@ func file_users_v1_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_v1_users_proto_rawDesc), len(file_users_v1_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
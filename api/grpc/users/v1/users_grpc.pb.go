@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UsersService_RetrieveUsers_FullMethodName = "/users.v1.UsersService/RetrieveUsers"
+	UsersService_ProvisionUser_FullMethodName = "/users.v1.UsersService/ProvisionUser"
 )
 
 // UsersServiceClient is the client API for UsersService service.
@@ -33,6 +34,10 @@ const (
 type UsersServiceClient interface {
 	// RetrieveUsers fetches users for the provided IDs.
 	RetrieveUsers(ctx context.Context, in *RetrieveUsersReq, opts ...grpc.CallOption) (*RetrieveUsersRes, error)
+	// ProvisionUser creates the user authenticated by the external identity
+	// provider on the first use of its token and returns the existing one on
+	// the subsequent uses.
+	ProvisionUser(ctx context.Context, in *ProvisionUserReq, opts ...grpc.CallOption) (*ProvisionUserRes, error)
 }
 
 type usersServiceClient struct {
@@ -53,6 +58,16 @@ func (c *usersServiceClient) RetrieveUsers(ctx context.Context, in *RetrieveUser
 	return out, nil
 }
 
+func (c *usersServiceClient) ProvisionUser(ctx context.Context, in *ProvisionUserReq, opts ...grpc.CallOption) (*ProvisionUserRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvisionUserRes)
+	err := c.cc.Invoke(ctx, UsersService_ProvisionUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility.
@@ -61,6 +76,10 @@ func (c *usersServiceClient) RetrieveUsers(ctx context.Context, in *RetrieveUser
 type UsersServiceServer interface {
 	// RetrieveUsers fetches users for the provided IDs.
 	RetrieveUsers(context.Context, *RetrieveUsersReq) (*RetrieveUsersRes, error)
+	// ProvisionUser creates the user authenticated by the external identity
+	// provider on the first use of its token and returns the existing one on
+	// the subsequent uses.
+	ProvisionUser(context.Context, *ProvisionUserReq) (*ProvisionUserRes, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -74,6 +93,9 @@ type UnimplementedUsersServiceServer struct{}
 func (UnimplementedUsersServiceServer) RetrieveUsers(context.Context, *RetrieveUsersReq) (*RetrieveUsersRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveUsers not implemented")
 }
+func (UnimplementedUsersServiceServer) ProvisionUser(context.Context, *ProvisionUserReq) (*ProvisionUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionUser not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 func (UnimplementedUsersServiceServer) testEmbeddedByValue()                      {}
 
@@ -113,6 +135,24 @@ func _UsersService_RetrieveUsers_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_ProvisionUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ProvisionUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_ProvisionUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ProvisionUser(ctx, req.(*ProvisionUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -124,6 +164,10 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveUsers",
 			Handler:    _UsersService_RetrieveUsers_Handler,
 		},
+		{
+			MethodName: "ProvisionUser",
+			Handler:    _UsersService_ProvisionUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users/v1/users.proto",
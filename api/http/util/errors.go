@@ -275,6 +275,39 @@ var (
 	// ErrEmailNotVerified indicates invalid email not verified.
 	ErrEmailNotVerified = errors.NewRequestError("email not verified")
 
+	// ErrMissingRuleActions indicates missing rule actions.
+	ErrMissingRuleActions = errors.NewRequestError("missing rule actions")
+
+	// ErrInvalidRuleAction indicates invalid rule action.
+	ErrInvalidRuleAction = errors.NewRequestError("invalid rule action")
+
+	// ErrInvalidRuleCondition indicates invalid rule condition.
+	ErrInvalidRuleCondition = errors.NewRequestError("invalid rule condition")
+
+	// ErrMissingMFAChallenge indicates missing MFA challenge ID.
+	ErrMissingMFAChallenge = errors.NewRequestError("missing MFA challenge id")
+
+	// ErrMissingMFACode indicates missing MFA code.
+	ErrMissingMFACode = errors.NewRequestError("missing MFA code")
+
+	// ErrMissingCert indicates missing client certificate.
+	ErrMissingCert = errors.NewRequestError("missing certificate or certificate subject")
+
+	// ErrInvalidCert indicates invalid client certificate.
+	ErrInvalidCert = errors.NewRequestError("invalid certificate")
+
+	// ErrUnsupportedArchiveVersion indicates unsupported domain archive version.
+	ErrUnsupportedArchiveVersion = errors.NewRequestError("unsupported archive version")
+
+	// ErrArchiveEntities indicates the domain archive containing the entities, which are imported by the SDK and the CLI.
+	ErrArchiveEntities = errors.NewRequestError("archive groups, channels, clients and connections must be imported using the SDK or the CLI")
+
+	// ErrInvalidQuotaResource indicates invalid domain quota resource.
+	ErrInvalidQuotaResource = errors.NewRequestError("invalid quota resource")
+
+	// ErrInvalidInvitee indicates that the invitee is not specified by either the user ID or the email.
+	ErrInvalidInvitee = errors.NewRequestError("invitee must be specified by either user ID or email")
+
 	// ErrMalformedRequest indicates malformed request body.
 	ErrMalformedRequestBody = errors.NewRequestError("request body is not a valid JSON, expecting a valid JSON")
 )
@@ -52,22 +52,22 @@ func (client authGrpcClient) Authenticate(ctx context.Context, token *grpcAuthV1
 	ctx, cancel := context.WithTimeout(ctx, client.timeout)
 	defer cancel()
 
-	res, err := client.authenticate(ctx, authenticateReq{token: token.GetToken()})
+	res, err := client.authenticate(ctx, authenticateReq{token: token.GetToken(), clientIP: token.GetClientIp(), userAgent: token.GetUserAgent()})
 	if err != nil {
 		return &grpcAuthV1.AuthNRes{}, grpcapi.DecodeError(err)
 	}
 	ir := res.(authenticateRes)
-	return &grpcAuthV1.AuthNRes{Id: ir.id, UserId: ir.userID, UserRole: uint32(ir.userRole), Verified: ir.verified}, nil
+	return &grpcAuthV1.AuthNRes{Id: ir.id, UserId: ir.userID, UserRole: uint32(ir.userRole), Verified: ir.verified, Mfa: ir.mfa}, nil
 }
 
 func encodeIdentifyRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(authenticateReq)
-	return &grpcAuthV1.AuthNReq{Token: req.token}, nil
+	return &grpcAuthV1.AuthNReq{Token: req.token, ClientIp: req.clientIP, UserAgent: req.userAgent}, nil
 }
 
 func decodeIdentifyResponse(_ context.Context, grpcRes any) (any, error) {
 	res := grpcRes.(*grpcAuthV1.AuthNRes)
-	return authenticateRes{id: res.GetId(), userID: res.GetUserId(), userRole: auth.Role(res.UserRole), verified: res.GetVerified()}, nil
+	return authenticateRes{id: res.GetId(), userID: res.GetUserId(), userRole: auth.Role(res.UserRole), verified: res.GetVerified(), mfa: res.GetMfa()}, nil
 }
 
 func (client authGrpcClient) Authorize(ctx context.Context, req *grpcAuthV1.PolicyReq, _ ...grpc.CallOption) (r *grpcAuthV1.AuthZRes, err error) {
@@ -91,6 +91,8 @@ func (client authGrpcClient) Authorize(ctx context.Context, req *grpcAuthV1.Poli
 			EntityType:  req.GetEntityType(),
 			Operation:   req.GetOperation(),
 			EntityID:    req.GetEntityId(),
+			ClientIP:    req.GetClientIp(),
+			MFA:         req.Mfa,
 		}
 	}
 
@@ -125,5 +127,7 @@ func encodeAuthorizeRequest(_ context.Context, grpcReq any) (any, error) {
 		EntityType:  req.EntityType,
 		Operation:   req.Operation,
 		EntityId:    req.EntityID,
+		ClientIp:    req.ClientIP,
+		Mfa:         req.MFA,
 	}, nil
 }
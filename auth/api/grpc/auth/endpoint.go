@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/policies"
 	"github.com/go-kit/kit/endpoint"
 )
@@ -18,12 +19,13 @@ func authenticateEndpoint(svc auth.Service) endpoint.Endpoint {
 			return authenticateRes{}, err
 		}
 
+		ctx = authn.WithClientInfo(ctx, authn.ClientInfo{IP: req.clientIP, UserAgent: req.userAgent})
 		key, err := svc.Identify(ctx, req.token)
 		if err != nil {
 			return authenticateRes{}, err
 		}
 
-		return authenticateRes{id: key.ID, userID: key.Subject, userRole: key.Role, verified: key.Verified}, nil
+		return authenticateRes{id: key.ID, userID: key.Subject, userRole: key.Role, verified: key.Verified, mfa: key.MFA}, nil
 	}
 }
 
@@ -35,6 +37,10 @@ func authorizeEndpoint(svc auth.Service) endpoint.Endpoint {
 			return authorizeRes{}, err
 		}
 
+		ctx = authn.WithClientInfo(ctx, authn.ClientInfo{IP: req.ClientIP})
+		if req.MFA != nil {
+			ctx = auth.WithMFA(ctx, *req.MFA)
+		}
 		err := svc.Authorize(ctx, policies.Policy{
 			Domain:      req.Domain,
 			SubjectType: req.SubjectType,
@@ -75,6 +75,13 @@ func TestIdentify(t *testing.T) {
 			idt:   &grpcAuthV1.AuthNRes{UserId: id, UserRole: uint32(auth.UserRole)},
 			err:   nil,
 		},
+		{
+			desc:  "authenticate user with valid user token issued after MFA",
+			token: validToken,
+			key:   auth.Key{ID: "", Subject: id, Role: auth.UserRole, MFA: true},
+			idt:   &grpcAuthV1.AuthNRes{UserId: id, UserRole: uint32(auth.UserRole), Mfa: true},
+			err:   nil,
+		},
 		{
 			desc:   "authenticate user with invalid user token",
 			token:  "invalid",
@@ -8,7 +8,9 @@ import (
 )
 
 type authenticateReq struct {
-	token string
+	token     string
+	clientIP  string
+	userAgent string
 }
 
 func (req authenticateReq) validate() error {
@@ -39,6 +41,10 @@ type authReq struct {
 	EntityType string
 	Operation  string
 	EntityID   string
+	ClientIP   string
+	// MFA tells if the user session passed the multi-factor authentication.
+	// It's nil if the request doesn't come from the user session.
+	MFA *bool
 }
 
 func (req authReq) validate() error {
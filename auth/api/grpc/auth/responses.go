@@ -10,6 +10,7 @@ type authenticateRes struct {
 	userID   string
 	userRole smqauth.Role
 	verified bool
+	mfa      bool
 }
 
 type authorizeRes struct {
@@ -55,12 +55,12 @@ func (s *authGrpcServer) Authorize(ctx context.Context, req *grpcAuthV1.PolicyRe
 
 func decodeAuthenticateRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(*grpcAuthV1.AuthNReq)
-	return authenticateReq{token: req.GetToken()}, nil
+	return authenticateReq{token: req.GetToken(), clientIP: req.GetClientIp(), userAgent: req.GetUserAgent()}, nil
 }
 
 func encodeAuthenticateResponse(_ context.Context, grpcRes any) (any, error) {
 	res := grpcRes.(authenticateRes)
-	return &grpcAuthV1.AuthNRes{Id: res.id, UserId: res.userID, UserRole: uint32(res.userRole), Verified: res.verified}, nil
+	return &grpcAuthV1.AuthNRes{Id: res.id, UserId: res.userID, UserRole: uint32(res.userRole), Verified: res.verified, Mfa: res.mfa}, nil
 }
 
 func decodeAuthorizeRequest(_ context.Context, grpcReq any) (any, error) {
@@ -83,6 +83,8 @@ func decodeAuthorizeRequest(_ context.Context, grpcReq any) (any, error) {
 		EntityType:  req.GetEntityType(),
 		Operation:   req.GetOperation(),
 		EntityID:    req.GetEntityId(),
+		ClientIP:    req.GetClientIp(),
+		MFA:         req.Mfa,
 	}, nil
 }
 
@@ -18,9 +18,13 @@ import (
 const tokenSvcName = "token.v1.TokenService"
 
 type tokenGrpcClient struct {
-	issue   endpoint.Endpoint
-	refresh endpoint.Endpoint
-	timeout time.Duration
+	issue            endpoint.Endpoint
+	refresh          endpoint.Endpoint
+	listSessions     endpoint.Endpoint
+	revokeSession    endpoint.Endpoint
+	revokeUserTokens endpoint.Endpoint
+	listRevocations  endpoint.Endpoint
+	timeout          time.Duration
 }
 
 var _ grpcTokenV1.TokenServiceClient = (*tokenGrpcClient)(nil)
@@ -44,6 +48,38 @@ func NewTokenClient(conn *grpc.ClientConn, timeout time.Duration) grpcTokenV1.To
 			decodeRefreshResponse,
 			grpcTokenV1.Token{},
 		).Endpoint(),
+		listSessions: kitgrpc.NewClient(
+			conn,
+			tokenSvcName,
+			"ListSessions",
+			encodeListSessionsRequest,
+			decodeListSessionsResponse,
+			grpcTokenV1.ListSessionsRes{},
+		).Endpoint(),
+		revokeSession: kitgrpc.NewClient(
+			conn,
+			tokenSvcName,
+			"RevokeSession",
+			encodeRevokeSessionRequest,
+			decodeRevokeSessionResponse,
+			grpcTokenV1.RevokeSessionRes{},
+		).Endpoint(),
+		revokeUserTokens: kitgrpc.NewClient(
+			conn,
+			tokenSvcName,
+			"RevokeUserTokens",
+			encodeRevokeUserTokensRequest,
+			decodeRevokeUserTokensResponse,
+			grpcTokenV1.RevokeUserTokensRes{},
+		).Endpoint(),
+		listRevocations: kitgrpc.NewClient(
+			conn,
+			tokenSvcName,
+			"ListRevocations",
+			encodeListRevocationsRequest,
+			decodeListRevocationsResponse,
+			grpcTokenV1.ListRevocationsRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -53,10 +89,13 @@ func (client tokenGrpcClient) Issue(ctx context.Context, req *grpcTokenV1.IssueR
 	defer cancel()
 
 	res, err := client.issue(ctx, issueReq{
-		userID:   req.GetUserId(),
-		userRole: auth.Role(req.GetUserRole()),
-		keyType:  auth.KeyType(req.GetType()),
-		verified: req.GetVerified(),
+		userID:    req.GetUserId(),
+		userRole:  auth.Role(req.GetUserRole()),
+		keyType:   auth.KeyType(req.GetType()),
+		verified:  req.GetVerified(),
+		mfa:       req.GetMfa(),
+		clientIP:  req.GetClientIp(),
+		userAgent: req.GetUserAgent(),
 	})
 	if err != nil {
 		return &grpcTokenV1.Token{}, grpcapi.DecodeError(err)
@@ -67,10 +106,13 @@ func (client tokenGrpcClient) Issue(ctx context.Context, req *grpcTokenV1.IssueR
 func encodeIssueRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(issueReq)
 	return &grpcTokenV1.IssueReq{
-		UserId:   req.userID,
-		UserRole: uint32(req.userRole),
-		Type:     uint32(req.keyType),
-		Verified: req.verified,
+		UserId:    req.userID,
+		UserRole:  uint32(req.userRole),
+		Type:      uint32(req.keyType),
+		Verified:  req.verified,
+		Mfa:       req.mfa,
+		ClientIp:  req.clientIP,
+		UserAgent: req.userAgent,
 	}, nil
 }
 
@@ -82,7 +124,12 @@ func (client tokenGrpcClient) Refresh(ctx context.Context, req *grpcTokenV1.Refr
 	ctx, cancel := context.WithTimeout(ctx, client.timeout)
 	defer cancel()
 
-	res, err := client.refresh(ctx, refreshReq{refreshToken: req.GetRefreshToken(), verified: req.GetVerified()})
+	res, err := client.refresh(ctx, refreshReq{
+		refreshToken: req.GetRefreshToken(),
+		verified:     req.GetVerified(),
+		clientIP:     req.GetClientIp(),
+		userAgent:    req.GetUserAgent(),
+	})
 	if err != nil {
 		return &grpcTokenV1.Token{}, grpcapi.DecodeError(err)
 	}
@@ -91,9 +138,94 @@ func (client tokenGrpcClient) Refresh(ctx context.Context, req *grpcTokenV1.Refr
 
 func encodeRefreshRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(refreshReq)
-	return &grpcTokenV1.RefreshReq{RefreshToken: req.refreshToken, Verified: req.verified}, nil
+	return &grpcTokenV1.RefreshReq{
+		RefreshToken: req.refreshToken,
+		Verified:     req.verified,
+		ClientIp:     req.clientIP,
+		UserAgent:    req.userAgent,
+	}, nil
 }
 
 func decodeRefreshResponse(_ context.Context, grpcRes any) (any, error) {
 	return grpcRes, nil
 }
+
+func (client tokenGrpcClient) ListSessions(ctx context.Context, req *grpcTokenV1.ListSessionsReq, _ ...grpc.CallOption) (*grpcTokenV1.ListSessionsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.listSessions(ctx, listSessionsReq{userID: req.GetUserId(), offset: req.GetOffset(), limit: req.GetLimit()})
+	if err != nil {
+		return &grpcTokenV1.ListSessionsRes{}, grpcapi.DecodeError(err)
+	}
+	return res.(*grpcTokenV1.ListSessionsRes), nil
+}
+
+func encodeListSessionsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(listSessionsReq)
+	return &grpcTokenV1.ListSessionsReq{UserId: req.userID, Offset: req.offset, Limit: req.limit}, nil
+}
+
+func decodeListSessionsResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes, nil
+}
+
+func (client tokenGrpcClient) RevokeSession(ctx context.Context, req *grpcTokenV1.RevokeSessionReq, _ ...grpc.CallOption) (*grpcTokenV1.RevokeSessionRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.revokeSession(ctx, revokeSessionReq{userID: req.GetUserId(), sessionID: req.GetSessionId()})
+	if err != nil {
+		return &grpcTokenV1.RevokeSessionRes{}, grpcapi.DecodeError(err)
+	}
+	return res.(*grpcTokenV1.RevokeSessionRes), nil
+}
+
+func encodeRevokeSessionRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(revokeSessionReq)
+	return &grpcTokenV1.RevokeSessionReq{UserId: req.userID, SessionId: req.sessionID}, nil
+}
+
+func decodeRevokeSessionResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes, nil
+}
+
+func (client tokenGrpcClient) RevokeUserTokens(ctx context.Context, req *grpcTokenV1.RevokeUserTokensReq, _ ...grpc.CallOption) (*grpcTokenV1.RevokeUserTokensRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.revokeUserTokens(ctx, revokeUserTokensReq{userID: req.GetUserId()})
+	if err != nil {
+		return &grpcTokenV1.RevokeUserTokensRes{}, grpcapi.DecodeError(err)
+	}
+	return res.(*grpcTokenV1.RevokeUserTokensRes), nil
+}
+
+func encodeRevokeUserTokensRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(revokeUserTokensReq)
+	return &grpcTokenV1.RevokeUserTokensReq{UserId: req.userID}, nil
+}
+
+func decodeRevokeUserTokensResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes, nil
+}
+
+func (client tokenGrpcClient) ListRevocations(ctx context.Context, req *grpcTokenV1.ListRevocationsReq, _ ...grpc.CallOption) (*grpcTokenV1.ListRevocationsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.listRevocations(ctx, listRevocationsReq{offset: req.GetOffset(), limit: req.GetLimit()})
+	if err != nil {
+		return &grpcTokenV1.ListRevocationsRes{}, grpcapi.DecodeError(err)
+	}
+	return res.(*grpcTokenV1.ListRevocationsRes), nil
+}
+
+func encodeListRevocationsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(listRevocationsReq)
+	return &grpcTokenV1.ListRevocationsReq{Offset: req.offset, Limit: req.limit}, nil
+}
+
+func decodeListRevocationsResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/go-kit/kit/endpoint"
 )
 
@@ -16,12 +17,14 @@ func issueEndpoint(svc auth.Service) endpoint.Endpoint {
 		if err := req.validate(); err != nil {
 			return issueRes{}, err
 		}
+		ctx = authn.WithClientInfo(ctx, authn.ClientInfo{IP: req.clientIP, UserAgent: req.userAgent})
 
 		key := auth.Key{
 			Type:     req.keyType,
 			Subject:  req.userID,
 			Role:     req.userRole,
 			Verified: req.verified,
+			MFA:      req.mfa,
 		}
 		tkn, err := svc.Issue(ctx, "", key)
 		if err != nil {
@@ -42,6 +45,7 @@ func refreshEndpoint(svc auth.Service) endpoint.Endpoint {
 		if err := req.validate(); err != nil {
 			return issueRes{}, err
 		}
+		ctx = authn.WithClientInfo(ctx, authn.ClientInfo{IP: req.clientIP, UserAgent: req.userAgent})
 
 		key := auth.Key{Type: auth.RefreshKey, Verified: req.verified}
 		tkn, err := svc.Issue(ctx, req.refreshToken, key)
@@ -56,3 +60,65 @@ func refreshEndpoint(svc auth.Service) endpoint.Endpoint {
 		return ret, nil
 	}
 }
+
+func listSessionsEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listSessionsReq)
+		if err := req.validate(); err != nil {
+			return listSessionsRes{}, err
+		}
+
+		page, err := svc.ListSessions(ctx, req.userID, auth.SessionsPageMeta{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return listSessionsRes{}, err
+		}
+
+		return listSessionsRes{SessionsPage: page}, nil
+	}
+}
+
+func revokeSessionEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(revokeSessionReq)
+		if err := req.validate(); err != nil {
+			return revokeSessionRes{}, err
+		}
+
+		if _, err := svc.RevokeSession(ctx, req.userID, req.sessionID); err != nil {
+			return revokeSessionRes{}, err
+		}
+
+		return revokeSessionRes{revoked: true}, nil
+	}
+}
+
+func revokeUserTokensEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(revokeUserTokensReq)
+		if err := req.validate(); err != nil {
+			return revokeUserTokensRes{}, err
+		}
+
+		if _, err := svc.RevokeUserTokens(ctx, req.userID); err != nil {
+			return revokeUserTokensRes{}, err
+		}
+
+		return revokeUserTokensRes{revoked: true}, nil
+	}
+}
+
+func listRevocationsEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listRevocationsReq)
+		if err := req.validate(); err != nil {
+			return listRevocationsRes{}, err
+		}
+
+		page, err := svc.ListRevocations(ctx, auth.RevocationsPageMeta{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return listRevocationsRes{}, err
+		}
+
+		return listRevocationsRes{RevocationsPage: page}, nil
+	}
+}
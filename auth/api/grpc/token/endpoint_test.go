@@ -15,6 +15,7 @@ import (
 	"github.com/absmach/supermq/auth"
 	grpcapi "github.com/absmach/supermq/auth/api/grpc/token"
 	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/stretchr/testify/assert"
@@ -167,3 +168,183 @@ func TestRefresh(t *testing.T) {
 		svcCall.Unset()
 	}
 }
+
+func TestIssueClientInfo(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	defer conn.Close()
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewTokenClient(conn, time.Second)
+
+	ci := authn.ClientInfo{IP: "192.0.2.1", UserAgent: "smq-cli/1.0"}
+	var got authn.ClientInfo
+	svcCall := svc.On("Issue", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		got = authn.ClientInfoFromContext(args.Get(0).(context.Context))
+	}).Return(auth.Token{AccessToken: validToken, RefreshToken: validToken}, nil)
+	_, err = grpcClient.Issue(context.Background(), &grpcTokenV1.IssueReq{UserId: validID, Type: uint32(auth.AccessKey), ClientIp: ci.IP, UserAgent: ci.UserAgent})
+	assert.Nil(t, err, fmt.Sprintf("issue with client info: unexpected error %s", err))
+	assert.Equal(t, ci, got, fmt.Sprintf("issue with client info: expected %v got %v", ci, got))
+	svcCall.Unset()
+}
+
+func TestListSessions(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	defer conn.Close()
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewTokenClient(conn, time.Second)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	session := auth.Session{
+		ID:        validID,
+		UserID:    validID,
+		IP:        "192.0.2.1",
+		UserAgent: "smq-cli/1.0",
+		IssuedAt:  now,
+		ExpiresAt: now.Add(refreshDuration),
+	}
+
+	cases := []struct {
+		desc   string
+		userID string
+		page   auth.SessionsPage
+		svcErr error
+		err    error
+	}{
+		{
+			desc:   "list sessions successfully",
+			userID: validID,
+			page:   auth.SessionsPage{Total: 1, Limit: 10, Sessions: []auth.Session{session}},
+		},
+		{
+			desc:   "list sessions with empty user ID",
+			userID: "",
+			err:    apiutil.ErrMissingUserID,
+		},
+		{
+			desc:   "list sessions with failed to retrieve",
+			userID: validID,
+			svcErr: svcerr.ErrViewEntity,
+			err:    svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		svcCall := svc.On("ListSessions", mock.Anything, tc.userID, auth.SessionsPageMeta{Limit: 10}).Return(tc.page, tc.svcErr)
+		res, err := grpcClient.ListSessions(context.Background(), &grpcTokenV1.ListSessionsReq{UserId: tc.userID, Limit: 10})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, tc.page.Total, res.GetTotal(), fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.page.Total, res.GetTotal()))
+			assert.Len(t, res.GetSessions(), len(tc.page.Sessions), fmt.Sprintf("%s: expected %d sessions\n", tc.desc, len(tc.page.Sessions)))
+			s := res.GetSessions()[0]
+			assert.Equal(t, session.ID, s.GetId(), fmt.Sprintf("%s: expected session ID %s got %s\n", tc.desc, session.ID, s.GetId()))
+			assert.Equal(t, session.IP, s.GetIp(), fmt.Sprintf("%s: expected IP %s got %s\n", tc.desc, session.IP, s.GetIp()))
+			assert.Equal(t, session.IssuedAt, s.GetIssuedAt().AsTime(), fmt.Sprintf("%s: expected issued at %s got %s\n", tc.desc, session.IssuedAt, s.GetIssuedAt().AsTime()))
+			assert.Nil(t, s.GetRefreshedAt(), fmt.Sprintf("%s: expected no refresh time\n", tc.desc))
+		}
+		svcCall.Unset()
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	defer conn.Close()
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewTokenClient(conn, time.Second)
+
+	cases := []struct {
+		desc      string
+		userID    string
+		sessionID string
+		svcErr    error
+		err       error
+	}{
+		{
+			desc:      "revoke session successfully",
+			userID:    validID,
+			sessionID: validID,
+		},
+		{
+			desc:      "revoke session with empty user ID",
+			sessionID: validID,
+			err:       apiutil.ErrMissingUserID,
+		},
+		{
+			desc:   "revoke session with empty session ID",
+			userID: validID,
+			err:    apiutil.ErrMissingID,
+		},
+		{
+			desc:      "revoke non-existent session",
+			userID:    validID,
+			sessionID: validID,
+			svcErr:    svcerr.ErrNotFound,
+			err:       svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		svcCall := svc.On("RevokeSession", mock.Anything, tc.userID, tc.sessionID).Return(auth.Revocation{SessionID: tc.sessionID}, tc.svcErr)
+		res, err := grpcClient.RevokeSession(context.Background(), &grpcTokenV1.RevokeSessionReq{UserId: tc.userID, SessionId: tc.sessionID})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.err == nil, res.GetRevoked(), fmt.Sprintf("%s: expected revoked %v got %v\n", tc.desc, tc.err == nil, res.GetRevoked()))
+		svcCall.Unset()
+	}
+}
+
+func TestListRevocations(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	defer conn.Close()
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewTokenClient(conn, time.Second)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	tokenRevocation := auth.Revocation{TokenID: validID, ExpiresAt: now.Add(refreshDuration)}
+	userRevocation := auth.Revocation{Subject: validID, NotBefore: now, ExpiresAt: now.Add(refreshDuration)}
+
+	cases := []struct {
+		desc   string
+		limit  uint64
+		page   auth.RevocationsPage
+		svcErr error
+		err    error
+	}{
+		{
+			desc:  "list revocations successfully",
+			limit: 10,
+			page:  auth.RevocationsPage{Total: 2, Limit: 10, Revocations: []auth.Revocation{tokenRevocation, userRevocation}},
+		},
+		{
+			desc:  "list revocations with zero limit",
+			limit: 0,
+			err:   apiutil.ErrLimitSize,
+		},
+		{
+			desc:  "list revocations with limit exceeding maximum",
+			limit: 1001,
+			err:   apiutil.ErrLimitSize,
+		},
+		{
+			desc:   "list revocations with failed to retrieve",
+			limit:  10,
+			svcErr: svcerr.ErrViewEntity,
+			err:    svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		svcCall := svc.On("ListRevocations", mock.Anything, auth.RevocationsPageMeta{Limit: tc.limit}).Return(tc.page, tc.svcErr)
+		res, err := grpcClient.ListRevocations(context.Background(), &grpcTokenV1.ListRevocationsReq{Limit: tc.limit})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, tc.page.Total, res.GetTotal(), fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.page.Total, res.GetTotal()))
+			assert.Len(t, res.GetRevocations(), len(tc.page.Revocations), fmt.Sprintf("%s: expected %d revocations\n", tc.desc, len(tc.page.Revocations)))
+			tr := res.GetRevocations()[0]
+			assert.Equal(t, tokenRevocation.TokenID, tr.GetTokenId(), fmt.Sprintf("%s: expected token ID %s got %s\n", tc.desc, tokenRevocation.TokenID, tr.GetTokenId()))
+			assert.Nil(t, tr.GetNotBefore(), fmt.Sprintf("%s: expected no not before time\n", tc.desc))
+			ur := res.GetRevocations()[1]
+			assert.Equal(t, userRevocation.Subject, ur.GetSubject(), fmt.Sprintf("%s: expected subject %s got %s\n", tc.desc, userRevocation.Subject, ur.GetSubject()))
+			assert.Equal(t, userRevocation.NotBefore, ur.GetNotBefore().AsTime(), fmt.Sprintf("%s: expected not before %s got %s\n", tc.desc, userRevocation.NotBefore, ur.GetNotBefore().AsTime()))
+			assert.Equal(t, userRevocation.ExpiresAt, ur.GetExpiresAt().AsTime(), fmt.Sprintf("%s: expected expires at %s got %s\n", tc.desc, userRevocation.ExpiresAt, ur.GetExpiresAt().AsTime()))
+		}
+		svcCall.Unset()
+	}
+}
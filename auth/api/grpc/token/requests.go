@@ -8,11 +8,17 @@ import (
 	"github.com/absmach/supermq/auth"
 )
 
+// maxRevocationsLimit is the maximum number of the revocations listed at once.
+const maxRevocationsLimit = 1000
+
 type issueReq struct {
-	userID   string
-	userRole auth.Role
-	keyType  auth.KeyType
-	verified bool
+	userID    string
+	userRole  auth.Role
+	keyType   auth.KeyType
+	verified  bool
+	mfa       bool
+	clientIP  string
+	userAgent string
 }
 
 func (req issueReq) validate() error {
@@ -29,6 +35,8 @@ func (req issueReq) validate() error {
 type refreshReq struct {
 	refreshToken string
 	verified     bool
+	clientIP     string
+	userAgent    string
 }
 
 func (req refreshReq) validate() error {
@@ -38,3 +46,58 @@ func (req refreshReq) validate() error {
 
 	return nil
 }
+
+type listSessionsReq struct {
+	userID string
+	offset uint64
+	limit  uint64
+}
+
+func (req listSessionsReq) validate() error {
+	if req.userID == "" {
+		return apiutil.ErrMissingUserID
+	}
+
+	return nil
+}
+
+type revokeSessionReq struct {
+	userID    string
+	sessionID string
+}
+
+func (req revokeSessionReq) validate() error {
+	if req.userID == "" {
+		return apiutil.ErrMissingUserID
+	}
+	if req.sessionID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type revokeUserTokensReq struct {
+	userID string
+}
+
+func (req revokeUserTokensReq) validate() error {
+	if req.userID == "" {
+		return apiutil.ErrMissingUserID
+	}
+
+	return nil
+}
+
+type listRevocationsReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req listRevocationsReq) validate() error {
+	if req.limit == 0 || req.limit > maxRevocationsLimit {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
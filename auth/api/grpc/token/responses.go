@@ -3,8 +3,26 @@
 
 package token
 
+import "github.com/absmach/supermq/auth"
+
 type issueRes struct {
 	accessToken  string
 	refreshToken string
 	accessType   string
 }
+
+type listSessionsRes struct {
+	auth.SessionsPage
+}
+
+type revokeSessionRes struct {
+	revoked bool
+}
+
+type revokeUserTokensRes struct {
+	revoked bool
+}
+
+type listRevocationsRes struct {
+	auth.RevocationsPage
+}
@@ -10,14 +10,19 @@ import (
 	"github.com/absmach/supermq/auth"
 	grpcapi "github.com/absmach/supermq/auth/api/grpc"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ grpcTokenV1.TokenServiceServer = (*tokenGrpcServer)(nil)
 
 type tokenGrpcServer struct {
 	grpcTokenV1.UnimplementedTokenServiceServer
-	issue   kitgrpc.Handler
-	refresh kitgrpc.Handler
+	issue            kitgrpc.Handler
+	refresh          kitgrpc.Handler
+	listSessions     kitgrpc.Handler
+	revokeSession    kitgrpc.Handler
+	revokeUserTokens kitgrpc.Handler
+	listRevocations  kitgrpc.Handler
 }
 
 // NewAuthServer returns new AuthnServiceServer instance.
@@ -33,6 +38,26 @@ func NewTokenServer(svc auth.Service) grpcTokenV1.TokenServiceServer {
 			decodeRefreshRequest,
 			encodeIssueResponse,
 		),
+		listSessions: kitgrpc.NewServer(
+			(listSessionsEndpoint(svc)),
+			decodeListSessionsRequest,
+			encodeListSessionsResponse,
+		),
+		revokeSession: kitgrpc.NewServer(
+			(revokeSessionEndpoint(svc)),
+			decodeRevokeSessionRequest,
+			encodeRevokeSessionResponse,
+		),
+		revokeUserTokens: kitgrpc.NewServer(
+			(revokeUserTokensEndpoint(svc)),
+			decodeRevokeUserTokensRequest,
+			encodeRevokeUserTokensResponse,
+		),
+		listRevocations: kitgrpc.NewServer(
+			(listRevocationsEndpoint(svc)),
+			decodeListRevocationsRequest,
+			encodeListRevocationsResponse,
+		),
 	}
 }
 
@@ -52,19 +77,138 @@ func (s *tokenGrpcServer) Refresh(ctx context.Context, req *grpcTokenV1.RefreshR
 	return res.(*grpcTokenV1.Token), nil
 }
 
+func (s *tokenGrpcServer) ListSessions(ctx context.Context, req *grpcTokenV1.ListSessionsReq) (*grpcTokenV1.ListSessionsRes, error) {
+	_, res, err := s.listSessions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+	return res.(*grpcTokenV1.ListSessionsRes), nil
+}
+
+func (s *tokenGrpcServer) RevokeSession(ctx context.Context, req *grpcTokenV1.RevokeSessionReq) (*grpcTokenV1.RevokeSessionRes, error) {
+	_, res, err := s.revokeSession.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+	return res.(*grpcTokenV1.RevokeSessionRes), nil
+}
+
+func (s *tokenGrpcServer) RevokeUserTokens(ctx context.Context, req *grpcTokenV1.RevokeUserTokensReq) (*grpcTokenV1.RevokeUserTokensRes, error) {
+	_, res, err := s.revokeUserTokens.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+	return res.(*grpcTokenV1.RevokeUserTokensRes), nil
+}
+
+func (s *tokenGrpcServer) ListRevocations(ctx context.Context, req *grpcTokenV1.ListRevocationsReq) (*grpcTokenV1.ListRevocationsRes, error) {
+	_, res, err := s.listRevocations.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+	return res.(*grpcTokenV1.ListRevocationsRes), nil
+}
+
 func decodeIssueRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(*grpcTokenV1.IssueReq)
 	return issueReq{
-		userID:   req.GetUserId(),
-		userRole: auth.Role(req.GetUserRole()),
-		keyType:  auth.KeyType(req.GetType()),
-		verified: req.Verified,
+		userID:    req.GetUserId(),
+		userRole:  auth.Role(req.GetUserRole()),
+		keyType:   auth.KeyType(req.GetType()),
+		verified:  req.Verified,
+		mfa:       req.GetMfa(),
+		clientIP:  req.GetClientIp(),
+		userAgent: req.GetUserAgent(),
 	}, nil
 }
 
 func decodeRefreshRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(*grpcTokenV1.RefreshReq)
-	return refreshReq{refreshToken: req.GetRefreshToken(), verified: req.Verified}, nil
+	return refreshReq{
+		refreshToken: req.GetRefreshToken(),
+		verified:     req.Verified,
+		clientIP:     req.GetClientIp(),
+		userAgent:    req.GetUserAgent(),
+	}, nil
+}
+
+func decodeListSessionsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcTokenV1.ListSessionsReq)
+	return listSessionsReq{userID: req.GetUserId(), offset: req.GetOffset(), limit: req.GetLimit()}, nil
+}
+
+func encodeListSessionsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(listSessionsRes)
+
+	sessions := make([]*grpcTokenV1.Session, len(res.Sessions))
+	for i, s := range res.Sessions {
+		sessions[i] = &grpcTokenV1.Session{
+			Id:        s.ID,
+			Ip:        s.IP,
+			UserAgent: s.UserAgent,
+			IssuedAt:  timestamppb.New(s.IssuedAt),
+			ExpiresAt: timestamppb.New(s.ExpiresAt),
+		}
+		if !s.RefreshedAt.IsZero() {
+			sessions[i].RefreshedAt = timestamppb.New(s.RefreshedAt)
+		}
+	}
+
+	return &grpcTokenV1.ListSessionsRes{
+		Total:    res.Total,
+		Offset:   res.Offset,
+		Limit:    res.Limit,
+		Sessions: sessions,
+	}, nil
+}
+
+func decodeRevokeSessionRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcTokenV1.RevokeSessionReq)
+	return revokeSessionReq{userID: req.GetUserId(), sessionID: req.GetSessionId()}, nil
+}
+
+func encodeRevokeSessionResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(revokeSessionRes)
+	return &grpcTokenV1.RevokeSessionRes{Revoked: res.revoked}, nil
+}
+
+func decodeRevokeUserTokensRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcTokenV1.RevokeUserTokensReq)
+	return revokeUserTokensReq{userID: req.GetUserId()}, nil
+}
+
+func encodeRevokeUserTokensResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(revokeUserTokensRes)
+	return &grpcTokenV1.RevokeUserTokensRes{Revoked: res.revoked}, nil
+}
+
+func decodeListRevocationsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcTokenV1.ListRevocationsReq)
+	return listRevocationsReq{offset: req.GetOffset(), limit: req.GetLimit()}, nil
+}
+
+func encodeListRevocationsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(listRevocationsRes)
+
+	revocations := make([]*grpcTokenV1.Revocation, len(res.Revocations))
+	for i, r := range res.Revocations {
+		revocations[i] = &grpcTokenV1.Revocation{
+			TokenId:   r.TokenID,
+			SessionId: r.SessionID,
+			Subject:   r.Subject,
+			ExpiresAt: timestamppb.New(r.ExpiresAt),
+		}
+		if !r.NotBefore.IsZero() {
+			revocations[i].NotBefore = timestamppb.New(r.NotBefore)
+		}
+	}
+
+	return &grpcTokenV1.ListRevocationsRes{
+		Total:       res.Total,
+		Offset:      res.Offset,
+		Limit:       res.Limit,
+		Revocations: revocations,
+	}, nil
 }
 
 func encodeIssueResponse(_ context.Context, grpcRes any) (any, error) {
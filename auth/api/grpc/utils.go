@@ -27,13 +27,16 @@ func EncodeError(err error) error {
 		err == apiutil.ErrMissingPolicyObj,
 		err == apiutil.ErrMalformedPolicyAct,
 		err == apiutil.ErrMissingUserID,
-		err == apiutil.ErrMissingPATID:
+		err == apiutil.ErrMissingPATID,
+		err == apiutil.ErrInvalidQuotaResource:
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Contains(err, svcerr.ErrAuthentication),
 		errors.Contains(err, auth.ErrKeyExpired),
 		err == apiutil.ErrMissingEmail,
 		err == apiutil.ErrBearerToken:
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Contains(err, svcerr.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
 	case errors.Contains(err, svcerr.ErrAuthorization),
 		errors.Contains(err, svcerr.ErrDomainAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
@@ -66,6 +69,8 @@ func DecodeError(err error) error {
 			return errors.Wrap(errors.ErrMalformedEntity, errors.New(st.Message()))
 		case codes.PermissionDenied:
 			return errors.Wrap(svcerr.ErrAuthorization, errors.New(st.Message()))
+		case codes.ResourceExhausted:
+			return errors.Wrap(svcerr.ErrQuotaExceeded, errors.New(st.Message()))
 		default:
 			return errors.Wrap(fmt.Errorf("unexpected gRPC status: %s (status code:%v)", st.Code().String(), st.Code()), errors.New(st.Message()))
 		}
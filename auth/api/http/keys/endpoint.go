@@ -78,7 +78,7 @@ func revokeEndpoint(svc auth.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		if err := svc.Revoke(ctx, req.token, req.id); err != nil {
+		if _, err := svc.Revoke(ctx, req.token, req.id); err != nil {
 			return nil, err
 		}
 
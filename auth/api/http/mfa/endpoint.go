@@ -0,0 +1,46 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package mfa
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func setMFAPolicyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(setMFAPolicyReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		policy, err := svc.SetMFAPolicy(ctx, req.token, auth.MFAPolicy{
+			DomainID: req.domainID,
+			Required: req.Required,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return mfaPolicyRes{policy}, nil
+	}
+}
+
+func retrieveMFAPolicyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(mfaPolicyReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		policy, err := svc.RetrieveMFAPolicy(ctx, req.token, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return mfaPolicyRes{policy}, nil
+	}
+}
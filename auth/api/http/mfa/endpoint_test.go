@@ -0,0 +1,199 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package mfa_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/auth"
+	httpapi "github.com/absmach/supermq/auth/api/http"
+	"github.com/absmach/supermq/auth/mocks"
+	smqlog "github.com/absmach/supermq/logger"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	contentType = "application/json"
+	domainID    = "123e4567-e89b-12d3-a456-000000000001"
+	accessToken = "valid token"
+	patToken    = "pat_token"
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newServer() (*httptest.Server, *mocks.Service) {
+	svc := new(mocks.Service)
+	mux := httpapi.MakeHandler(svc, smqlog.NewMock(), "", 900, 60)
+
+	return httptest.NewServer(mux), svc
+}
+
+func TestSetMFAPolicy(t *testing.T) {
+	ts, svc := newServer()
+	defer ts.Close()
+
+	policy := auth.MFAPolicy{DomainID: domainID, Required: true}
+
+	cases := []struct {
+		desc   string
+		req    string
+		ct     string
+		token  string
+		svcRes auth.MFAPolicy
+		svcErr error
+		status int
+	}{
+		{
+			desc:   "set MFA policy successfully",
+			req:    `{"required":true}`,
+			ct:     contentType,
+			token:  accessToken,
+			svcRes: policy,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "set MFA policy with empty token",
+			req:    `{"required":true}`,
+			ct:     contentType,
+			token:  "",
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "set MFA policy with PAT",
+			req:    `{"required":true}`,
+			ct:     contentType,
+			token:  patToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "set MFA policy with invalid content type",
+			req:    `{"required":true}`,
+			ct:     "",
+			token:  accessToken,
+			status: http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:   "set MFA policy with malformed body",
+			req:    `{"required":"yes"}`,
+			ct:     contentType,
+			token:  accessToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "set MFA policy by non domain admin",
+			req:    `{"required":true}`,
+			ct:     contentType,
+			token:  accessToken,
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPut,
+				url:         fmt.Sprintf("%s/mfa/policies/%s", ts.URL, domainID),
+				contentType: tc.ct,
+				token:       tc.token,
+				body:        strings.NewReader(tc.req),
+			}
+			svcCall := svc.On("SetMFAPolicy", mock.Anything, tc.token, policy).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusOK {
+				var body auth.MFAPolicy
+				err := json.NewDecoder(res.Body).Decode(&body)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes.Required, body.Required, fmt.Sprintf("%s: expected required %t got %t", tc.desc, tc.svcRes.Required, body.Required))
+			}
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveMFAPolicy(t *testing.T) {
+	ts, svc := newServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		token  string
+		svcRes auth.MFAPolicy
+		svcErr error
+		status int
+	}{
+		{
+			desc:   "retrieve MFA policy successfully",
+			token:  accessToken,
+			svcRes: auth.MFAPolicy{DomainID: domainID, Required: true},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "retrieve MFA policy with empty token",
+			token:  "",
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "retrieve MFA policy with PAT",
+			token:  patToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "retrieve MFA policy by non domain member",
+			token:  accessToken,
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/mfa/policies/%s", ts.URL, domainID),
+				token:  tc.token,
+			}
+			svcCall := svc.On("RetrieveMFAPolicy", mock.Anything, tc.token, domainID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package mfa
+
+import apiutil "github.com/absmach/supermq/api/http/util"
+
+type setMFAPolicyReq struct {
+	token    string
+	domainID string
+	Required bool `json:"required"`
+}
+
+func (req setMFAPolicyReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	return nil
+}
+
+type mfaPolicyReq struct {
+	token    string
+	domainID string
+}
+
+func (req mfaPolicyReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	return nil
+}
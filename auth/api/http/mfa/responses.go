@@ -0,0 +1,29 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package mfa
+
+import (
+	"net/http"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/auth"
+)
+
+var _ supermq.Response = (*mfaPolicyRes)(nil)
+
+type mfaPolicyRes struct {
+	auth.MFAPolicy `json:",inline"`
+}
+
+func (res mfaPolicyRes) Code() int {
+	return http.StatusOK
+}
+
+func (res mfaPolicyRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res mfaPolicyRes) Empty() bool {
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package mfa
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+)
+
+const (
+	contentType = "application/json"
+	patPrefix   = "pat_"
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(svc auth.Service, mux *chi.Mux, logger *slog.Logger) *chi.Mux {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+	mux.Route("/mfa/policies/{domainID}", func(r chi.Router) {
+		r.Put("/", kithttp.NewServer(
+			setMFAPolicyEndpoint(svc),
+			decodeSetMFAPolicyRequest,
+			api.EncodeResponse,
+			opts...,
+		).ServeHTTP)
+
+		r.Get("/", kithttp.NewServer(
+			retrieveMFAPolicyEndpoint(svc),
+			decodeMFAPolicyRequest,
+			api.EncodeResponse,
+			opts...,
+		).ServeHTTP)
+	})
+
+	return mux
+}
+
+func decodeSetMFAPolicyRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	token := apiutil.ExtractBearerToken(r)
+	if strings.HasPrefix(token, patPrefix) {
+		return nil, apiutil.ErrUnsupportedTokenType
+	}
+	req := setMFAPolicyReq{
+		token:    token,
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	return req, nil
+}
+
+func decodeMFAPolicyRequest(_ context.Context, r *http.Request) (any, error) {
+	token := apiutil.ExtractBearerToken(r)
+	if strings.HasPrefix(token, patPrefix) {
+		return nil, apiutil.ErrUnsupportedTokenType
+	}
+
+	return mfaPolicyReq{
+		token:    token,
+		domainID: chi.URLParam(r, "domainID"),
+	}, nil
+}
@@ -17,7 +17,7 @@ func createPATEndpoint(svc auth.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		pat, err := svc.CreatePAT(ctx, req.token, req.Name, req.Description, req.Duration)
+		pat, err := svc.CreatePAT(ctx, req.token, req.Name, req.Description, req.Duration, req.AllowedCIDRs, req.Scopes)
 		if err != nil {
 			return nil, err
 		}
@@ -74,6 +74,22 @@ func updatePATDescriptionEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func updatePATAllowedCIDRsEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updatePatAllowedCIDRsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pat, err := svc.UpdatePATAllowedCIDRs(ctx, req.token, req.id, req.AllowedCIDRs)
+		if err != nil {
+			return nil, err
+		}
+
+		return updatePatAllowedCIDRsRes{pat}, nil
+	}
+}
+
 func listPATSEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(listPatsReq)
@@ -82,11 +98,12 @@ func listPATSEndpoint(svc auth.Service) endpoint.Endpoint {
 		}
 
 		pm := auth.PATSPageMeta{
-			Limit:  req.limit,
-			Offset: req.offset,
-			Name:   req.name,
-			ID:     req.id,
-			Status: req.status,
+			Limit:      req.limit,
+			Offset:     req.offset,
+			Name:       req.name,
+			ID:         req.id,
+			Status:     req.status,
+			UnusedDays: req.unusedDays,
 		}
 		patsPage, err := svc.ListPATS(ctx, req.token, pm)
 		if err != nil {
@@ -223,3 +240,54 @@ func listScopesEndpoint(svc auth.Service) endpoint.Endpoint {
 		return listScopeRes{scopesPage}, nil
 	}
 }
+
+func setPATPolicyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(setPatPolicyReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		policy, err := svc.SetPATPolicy(ctx, req.token, auth.PATPolicy{
+			DomainID:      req.domainID,
+			MaxDuration:   req.MaxDuration,
+			AllowedScopes: req.AllowedScopes,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return toPatPolicyRes(policy), nil
+	}
+}
+
+func retrievePATPolicyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(patPolicyReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		policy, err := svc.RetrievePATPolicy(ctx, req.token, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return toPatPolicyRes(policy), nil
+	}
+}
+
+func removePATPolicyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(patPolicyReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemovePATPolicy(ctx, req.token, req.domainID); err != nil {
+			return nil, err
+		}
+
+		return removePatPolicyRes{}, nil
+	}
+}
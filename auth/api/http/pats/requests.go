@@ -14,17 +14,21 @@ import (
 )
 
 type createPatReq struct {
-	token       string
-	Name        string        `json:"name,omitempty"`
-	Description string        `json:"description,omitempty"`
-	Duration    time.Duration `json:"duration,omitempty"`
+	token        string
+	Name         string        `json:"name,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	Duration     time.Duration `json:"duration,omitempty"`
+	AllowedCIDRs []string      `json:"allowed_cidrs,omitempty"`
+	Scopes       []auth.Scope  `json:"scopes,omitempty"`
 }
 
 func (cpr *createPatReq) UnmarshalJSON(data []byte) error {
 	var temp struct {
-		Name        string `json:"name,omitempty"`
-		Description string `json:"description,omitempty"`
-		Duration    string `json:"duration,omitempty"`
+		Name         string       `json:"name,omitempty"`
+		Description  string       `json:"description,omitempty"`
+		Duration     string       `json:"duration,omitempty"`
+		AllowedCIDRs []string     `json:"allowed_cidrs,omitempty"`
+		Scopes       []auth.Scope `json:"scopes,omitempty"`
 	}
 	if err := json.Unmarshal(data, &temp); err != nil {
 		return err
@@ -36,6 +40,8 @@ func (cpr *createPatReq) UnmarshalJSON(data []byte) error {
 	cpr.Name = temp.Name
 	cpr.Description = temp.Description
 	cpr.Duration = duration
+	cpr.AllowedCIDRs = temp.AllowedCIDRs
+	cpr.Scopes = temp.Scopes
 	return nil
 }
 
@@ -48,6 +54,16 @@ func (req createPatReq) validate() (err error) {
 		return apiutil.ErrMissingName
 	}
 
+	if _, err := auth.ParseCIDRs(req.AllowedCIDRs); err != nil {
+		return errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	for _, scope := range req.Scopes {
+		if err := scope.Validate(); err != nil {
+			return errors.Wrap(apiutil.ErrValidation, err)
+		}
+	}
+
 	return nil
 }
 
@@ -104,13 +120,33 @@ func (req updatePatDescriptionReq) validate() (err error) {
 	return nil
 }
 
+type updatePatAllowedCIDRsReq struct {
+	token        string
+	id           string
+	AllowedCIDRs []string `json:"allowed_cidrs"`
+}
+
+func (req updatePatAllowedCIDRsReq) validate() (err error) {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.id == "" {
+		return apiutil.ErrMissingPATID
+	}
+	if _, err := auth.ParseCIDRs(req.AllowedCIDRs); err != nil {
+		return errors.Wrap(apiutil.ErrValidation, err)
+	}
+	return nil
+}
+
 type listPatsReq struct {
-	token  string
-	offset uint64
-	limit  uint64
-	name   string
-	id     string
-	status auth.Status
+	token      string
+	offset     uint64
+	limit      uint64
+	name       string
+	id         string
+	status     auth.Status
+	unusedDays uint64
 }
 
 func (req listPatsReq) validate() (err error) {
@@ -285,3 +321,59 @@ func (req listScopesReq) validate() (err error) {
 	}
 	return nil
 }
+
+type setPatPolicyReq struct {
+	token         string
+	domainID      string
+	MaxDuration   time.Duration    `json:"max_duration,omitempty"`
+	AllowedScopes []auth.ScopeRule `json:"allowed_scopes,omitempty"`
+}
+
+func (req *setPatPolicyReq) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		MaxDuration   string           `json:"max_duration,omitempty"`
+		AllowedScopes []auth.ScopeRule `json:"allowed_scopes,omitempty"`
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.MaxDuration != "" {
+		duration, err := time.ParseDuration(temp.MaxDuration)
+		if err != nil {
+			return err
+		}
+		req.MaxDuration = duration
+	}
+	req.AllowedScopes = temp.AllowedScopes
+	return nil
+}
+
+func (req setPatPolicyReq) validate() (err error) {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	policy := auth.PATPolicy{
+		DomainID:      req.domainID,
+		MaxDuration:   req.MaxDuration,
+		AllowedScopes: req.AllowedScopes,
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.Wrap(apiutil.ErrValidation, err)
+	}
+	return nil
+}
+
+type patPolicyReq struct {
+	token    string
+	domainID string
+}
+
+func (req patPolicyReq) validate() (err error) {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	return nil
+}
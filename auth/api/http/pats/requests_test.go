@@ -10,6 +10,7 @@ import (
 
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,6 +72,57 @@ func TestCreatePatReqValidate(t *testing.T) {
 	}
 }
 
+func TestCreatePatReqValidateAllowlistAndScopes(t *testing.T) {
+	cases := []struct {
+		desc string
+		req  createPatReq
+		err  error
+	}{
+		{
+			desc: "valid request with allowlist and scopes",
+			req: createPatReq{
+				token:        valid,
+				Name:         "test-pat",
+				Duration:     24 * time.Hour,
+				AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+				Scopes: []auth.Scope{
+					{DomainID: "domain", EntityType: auth.ClientsType, Operation: "view", EntityID: auth.AnyIDs},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "invalid CIDR",
+			req: createPatReq{
+				token:        valid,
+				Name:         "test-pat",
+				Duration:     24 * time.Hour,
+				AllowedCIDRs: []string{"10.0.0.1"},
+			},
+			err: apiutil.ErrValidation,
+		},
+		{
+			desc: "invalid scope",
+			req: createPatReq{
+				token:    valid,
+				Name:     "test-pat",
+				Duration: 24 * time.Hour,
+				Scopes: []auth.Scope{
+					{EntityType: auth.ClientsType, Operation: "view", EntityID: auth.AnyIDs},
+				},
+			},
+			err: apiutil.ErrValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.req.validate()
+			assert.True(t, errors.Contains(err, tc.err), "validate() error = %v, expected %v", err, tc.err)
+		})
+	}
+}
+
 func TestCreatePatReqUnmarshalJSON(t *testing.T) {
 	cases := []struct {
 		desc     string
@@ -278,6 +330,64 @@ func TestUpdatePatDescriptionReqValidate(t *testing.T) {
 	}
 }
 
+func TestUpdatePatAllowedCIDRsReqValidate(t *testing.T) {
+	cases := []struct {
+		desc string
+		req  updatePatAllowedCIDRsReq
+		err  error
+	}{
+		{
+			desc: "valid request",
+			req: updatePatAllowedCIDRsReq{
+				token:        valid,
+				id:           "pat-id",
+				AllowedCIDRs: []string{"192.0.2.0/24"},
+			},
+			err: nil,
+		},
+		{
+			desc: "valid request clearing allowlist",
+			req: updatePatAllowedCIDRsReq{
+				token: valid,
+				id:    "pat-id",
+			},
+			err: nil,
+		},
+		{
+			desc: "empty token",
+			req: updatePatAllowedCIDRsReq{
+				id:           "pat-id",
+				AllowedCIDRs: []string{"192.0.2.0/24"},
+			},
+			err: apiutil.ErrBearerToken,
+		},
+		{
+			desc: "empty id",
+			req: updatePatAllowedCIDRsReq{
+				token:        valid,
+				AllowedCIDRs: []string{"192.0.2.0/24"},
+			},
+			err: apiutil.ErrMissingPATID,
+		},
+		{
+			desc: "invalid CIDR",
+			req: updatePatAllowedCIDRsReq{
+				token:        valid,
+				id:           "pat-id",
+				AllowedCIDRs: []string{"192.0.2.0/40"},
+			},
+			err: apiutil.ErrValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.req.validate()
+			assert.True(t, errors.Contains(err, tc.err), "validate() error = %v, expected %v", err, tc.err)
+		})
+	}
+}
+
 func TestListPatsReqValidate(t *testing.T) {
 	cases := []struct {
 		desc string
@@ -722,3 +832,92 @@ func TestListScopesReqValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPatPolicyReqValidate(t *testing.T) {
+	cases := []struct {
+		desc string
+		req  setPatPolicyReq
+		err  error
+	}{
+		{
+			desc: "valid request",
+			req: setPatPolicyReq{
+				token:       valid,
+				domainID:    "domain-id",
+				MaxDuration: 24 * time.Hour,
+				AllowedScopes: []auth.ScopeRule{
+					{EntityType: auth.MessagesType, Operation: auth.OpMessagePublish, EntityID: auth.AnyIDs},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "empty token",
+			req: setPatPolicyReq{
+				domainID:    "domain-id",
+				MaxDuration: 24 * time.Hour,
+			},
+			err: apiutil.ErrBearerToken,
+		},
+		{
+			desc: "empty domain id",
+			req: setPatPolicyReq{
+				token:       valid,
+				MaxDuration: 24 * time.Hour,
+			},
+			err: apiutil.ErrMissingDomainID,
+		},
+		{
+			desc: "rule with invalid operation",
+			req: setPatPolicyReq{
+				token:    valid,
+				domainID: "domain-id",
+				AllowedScopes: []auth.ScopeRule{
+					{EntityType: auth.MessagesType, Operation: "delete", EntityID: auth.AnyIDs},
+				},
+			},
+			err: apiutil.ErrValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.req.validate()
+			assert.True(t, errors.Contains(err, tc.err), "validate() error = %v, expected %v", err, tc.err)
+		})
+	}
+}
+
+func TestSetPatPolicyReqUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		desc     string
+		data     string
+		expected time.Duration
+		err      bool
+	}{
+		{
+			desc:     "valid JSON with max duration",
+			data:     `{"max_duration":"720h","allowed_scopes":[{"entity_type":"messages","operation":"publish","entity_id":"*"}]}`,
+			expected: 720 * time.Hour,
+		},
+		{
+			desc: "invalid max duration format",
+			data: `{"max_duration":"invalid"}`,
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var req setPatPolicyReq
+			err := json.Unmarshal([]byte(tc.data), &req)
+			if tc.err {
+				assert.Error(t, err, "UnmarshalJSON() should return error")
+			} else {
+				assert.NoError(t, err, "UnmarshalJSON() should not return error")
+				assert.Equal(t, tc.expected, req.MaxDuration)
+				assert.Len(t, req.AllowedScopes, 1)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package pats
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/absmach/supermq"
 	"github.com/absmach/supermq/auth"
@@ -15,11 +16,14 @@ var (
 	_ supermq.Response = (*retrievePatRes)(nil)
 	_ supermq.Response = (*updatePatNameRes)(nil)
 	_ supermq.Response = (*updatePatDescriptionRes)(nil)
+	_ supermq.Response = (*updatePatAllowedCIDRsRes)(nil)
 	_ supermq.Response = (*deletePatRes)(nil)
 	_ supermq.Response = (*resetPatSecretRes)(nil)
 	_ supermq.Response = (*revokePatSecretRes)(nil)
 	_ supermq.Response = (*scopeRes)(nil)
 	_ supermq.Response = (*clearAllRes)(nil)
+	_ supermq.Response = (*patPolicyRes)(nil)
+	_ supermq.Response = (*removePatPolicyRes)(nil)
 )
 
 type createPatRes struct {
@@ -86,6 +90,22 @@ func (res updatePatDescriptionRes) Empty() bool {
 	return false
 }
 
+type updatePatAllowedCIDRsRes struct {
+	auth.PAT `json:",inline"`
+}
+
+func (res updatePatAllowedCIDRsRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res updatePatAllowedCIDRsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updatePatAllowedCIDRsRes) Empty() bool {
+	return false
+}
+
 type listPatsRes struct {
 	auth.PATSPage `json:",inline"`
 }
@@ -189,3 +209,51 @@ func (res listScopeRes) Headers() map[string]string {
 func (res listScopeRes) Empty() bool {
 	return false
 }
+
+type patPolicyRes struct {
+	DomainID      string           `json:"domain_id"`
+	MaxDuration   string           `json:"max_duration,omitempty"`
+	AllowedScopes []auth.ScopeRule `json:"allowed_scopes,omitempty"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	UpdatedBy     string           `json:"updated_by"`
+}
+
+func toPatPolicyRes(pp auth.PATPolicy) patPolicyRes {
+	res := patPolicyRes{
+		DomainID:      pp.DomainID,
+		AllowedScopes: pp.AllowedScopes,
+		UpdatedAt:     pp.UpdatedAt,
+		UpdatedBy:     pp.UpdatedBy,
+	}
+	if pp.MaxDuration > 0 {
+		res.MaxDuration = pp.MaxDuration.String()
+	}
+
+	return res
+}
+
+func (res patPolicyRes) Code() int {
+	return http.StatusOK
+}
+
+func (res patPolicyRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res patPolicyRes) Empty() bool {
+	return false
+}
+
+type removePatPolicyRes struct{}
+
+func (res removePatPolicyRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removePatPolicyRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removePatPolicyRes) Empty() bool {
+	return true
+}
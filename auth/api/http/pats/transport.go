@@ -19,9 +19,10 @@ import (
 )
 
 const (
-	contentType = "application/json"
-	defInterval = "30d"
-	patPrefix   = "pat_"
+	contentType   = "application/json"
+	defInterval   = "30d"
+	patPrefix     = "pat_"
+	unusedDaysKey = "unused_days"
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
@@ -51,6 +52,29 @@ func MakeHandler(svc auth.Service, mux *chi.Mux, logger *slog.Logger) *chi.Mux {
 			opts...,
 		).ServeHTTP)
 
+		r.Route("/policies/{domainID}", func(r chi.Router) {
+			r.Put("/", kithttp.NewServer(
+				setPATPolicyEndpoint(svc),
+				decodeSetPATPolicyRequest,
+				api.EncodeResponse,
+				opts...,
+			).ServeHTTP)
+
+			r.Get("/", kithttp.NewServer(
+				retrievePATPolicyEndpoint(svc),
+				decodePATPolicyRequest,
+				api.EncodeResponse,
+				opts...,
+			).ServeHTTP)
+
+			r.Delete("/", kithttp.NewServer(
+				removePATPolicyEndpoint(svc),
+				decodePATPolicyRequest,
+				api.EncodeResponse,
+				opts...,
+			).ServeHTTP)
+		})
+
 		r.Route("/{id}", func(r chi.Router) {
 			r.Get("/", kithttp.NewServer(
 				retrievePATEndpoint(svc),
@@ -73,6 +97,13 @@ func MakeHandler(svc auth.Service, mux *chi.Mux, logger *slog.Logger) *chi.Mux {
 				opts...,
 			).ServeHTTP)
 
+			r.Patch("/allowed_cidrs", kithttp.NewServer(
+				updatePATAllowedCIDRsEndpoint(svc),
+				decodeUpdatePATAllowedCIDRsRequest,
+				api.EncodeResponse,
+				opts...,
+			).ServeHTTP)
+
 			r.Delete("/", kithttp.NewServer(
 				deletePATEndpoint(svc),
 				decodeDeletePATRequest,
@@ -195,6 +226,25 @@ func decodeUpdatePATDescriptionRequest(_ context.Context, r *http.Request) (any,
 	return req, nil
 }
 
+func decodeUpdatePATAllowedCIDRsRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	token := apiutil.ExtractBearerToken(r)
+	if strings.HasPrefix(token, patPrefix) {
+		return nil, apiutil.ErrUnsupportedTokenType
+	}
+	req := updatePatAllowedCIDRsReq{
+		token: token,
+		id:    chi.URLParam(r, "id"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	return req, nil
+}
+
 func decodeListPATSRequest(_ context.Context, r *http.Request) (any, error) {
 	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
 	if err != nil {
@@ -224,14 +274,19 @@ func decodeListPATSRequest(_ context.Context, r *http.Request) (any, error) {
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
+	u, err := apiutil.ReadNumQuery[uint64](r, unusedDaysKey, 0)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
 
 	req := listPatsReq{
-		token:  token,
-		limit:  l,
-		offset: o,
-		name:   n,
-		id:     i,
-		status: patStatus,
+		token:      token,
+		limit:      l,
+		offset:     o,
+		name:       n,
+		id:         i,
+		status:     patStatus,
+		unusedDays: u,
 	}
 
 	return req, nil
@@ -364,3 +419,34 @@ func decodeClearAllScopeRequest(_ context.Context, r *http.Request) (any, error)
 		id:    chi.URLParam(r, "id"),
 	}, nil
 }
+
+func decodeSetPATPolicyRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	token := apiutil.ExtractBearerToken(r)
+	if strings.HasPrefix(token, patPrefix) {
+		return nil, apiutil.ErrUnsupportedTokenType
+	}
+	req := setPatPolicyReq{
+		token:    token,
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	return req, nil
+}
+
+func decodePATPolicyRequest(_ context.Context, r *http.Request) (any, error) {
+	token := apiutil.ExtractBearerToken(r)
+	if strings.HasPrefix(token, patPrefix) {
+		return nil, apiutil.ErrUnsupportedTokenType
+	}
+
+	return patPolicyReq{
+		token:    token,
+		domainID: chi.URLParam(r, "domainID"),
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/absmach/supermq"
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/auth/api/http/keys"
+	"github.com/absmach/supermq/auth/api/http/mfa"
 	"github.com/absmach/supermq/auth/api/http/pats"
 	"github.com/go-chi/chi/v5"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -20,6 +21,7 @@ func MakeHandler(svc auth.Service, logger *slog.Logger, instanceID string, jwksC
 
 	mux = keys.MakeHandler(svc, mux, logger, jwksCacheMaxAge, jwksCacheStaleWhileRevalidate)
 	mux = pats.MakeHandler(svc, mux, logger)
+	mux = mfa.MakeHandler(svc, mux, logger)
 
 	mux.Get("/health", supermq.Health("auth", instanceID))
 	mux.Handle("/metrics", promhttp.Handler())
@@ -0,0 +1,55 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+const mfaPolicyKeyPrefix = "mfa_policy:"
+
+type mfaPolicyCache struct {
+	client   *redis.Client
+	duration time.Duration
+}
+
+// NewMFAPolicyCache returns redis implementation of the domain MFA policy cache.
+func NewMFAPolicyCache(client *redis.Client, duration time.Duration) auth.MFAPolicyCache {
+	return &mfaPolicyCache{
+		client:   client,
+		duration: duration,
+	}
+}
+
+func (mc *mfaPolicyCache) Save(ctx context.Context, domainID string, policy *auth.MFAPolicy) error {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if err := mc.client.Set(ctx, mfaPolicyKeyPrefix+domainID, data, mc.duration).Err(); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (mc *mfaPolicyCache) Retrieve(ctx context.Context, domainID string) (*auth.MFAPolicy, error) {
+	data, err := mc.client.Get(ctx, mfaPolicyKeyPrefix+domainID).Bytes()
+	if err != nil {
+		return nil, errors.Wrap(repoerr.ErrNotFound, err)
+	}
+	var policy *auth.MFAPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, errors.Wrap(repoerr.ErrNotFound, err)
+	}
+
+	return policy, nil
+}
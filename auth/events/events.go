@@ -0,0 +1,61 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/events"
+)
+
+const (
+	tokenPrefix      = "token."
+	tokenRevoke      = tokenPrefix + "revoke"
+	sessionRevoke    = tokenPrefix + "revoke_session"
+	userTokensRevoke = tokenPrefix + "revoke_user"
+)
+
+var (
+	_ events.Event = (*revokeTokenEvent)(nil)
+	_ events.Event = (*revokeSessionEvent)(nil)
+	_ events.Event = (*revokeUserTokensEvent)(nil)
+)
+
+type revokeTokenEvent struct {
+	auth.Revocation
+}
+
+func (rte revokeTokenEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  tokenRevoke,
+		"token_id":   rte.TokenID,
+		"expires_at": rte.ExpiresAt.Format(time.RFC3339Nano),
+	}, nil
+}
+
+type revokeSessionEvent struct {
+	auth.Revocation
+}
+
+func (rse revokeSessionEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  sessionRevoke,
+		"session_id": rse.SessionID,
+		"expires_at": rse.ExpiresAt.Format(time.RFC3339Nano),
+	}, nil
+}
+
+type revokeUserTokensEvent struct {
+	auth.Revocation
+}
+
+func (rute revokeUserTokensEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  userTokensRevoke,
+		"subject":    rute.Subject,
+		"not_before": rute.NotBefore.Format(time.RFC3339Nano),
+		"expires_at": rute.ExpiresAt.Format(time.RFC3339Nano),
+	}, nil
+}
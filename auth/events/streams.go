@@ -0,0 +1,86 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+)
+
+const (
+	supermqPrefix          = "supermq."
+	revokeTokenStream      = supermqPrefix + tokenRevoke
+	revokeSessionStream    = supermqPrefix + sessionRevoke
+	revokeUserTokensStream = supermqPrefix + userTokensRevoke
+)
+
+var _ auth.Service = (*eventStore)(nil)
+
+// eventStore publishes the token revocations, so the services verifying
+// the tokens locally stop accepting them. The rest of the calls are passed
+// through to the wrapped service.
+type eventStore struct {
+	events.Publisher
+	auth.Service
+}
+
+// NewEventStoreMiddleware returns wrapper around auth service that sends
+// events to event store.
+func NewEventStoreMiddleware(ctx context.Context, svc auth.Service, url string) (auth.Service, error) {
+	publisher, err := store.NewPublisher(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventStore{
+		Publisher: publisher,
+		Service:   svc,
+	}, nil
+}
+
+func (es *eventStore) Revoke(ctx context.Context, token, id string) (auth.Revocation, error) {
+	revocation, err := es.Service.Revoke(ctx, token, id)
+	if err != nil {
+		return revocation, err
+	}
+	// Nothing is revoked if the key doesn't exist.
+	if revocation.TokenID == "" {
+		return revocation, nil
+	}
+
+	if err := es.Publish(ctx, revokeTokenStream, revokeTokenEvent{revocation}); err != nil {
+		return revocation, err
+	}
+
+	return revocation, nil
+}
+
+func (es *eventStore) RevokeSession(ctx context.Context, userID, sessionID string) (auth.Revocation, error) {
+	revocation, err := es.Service.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		return revocation, err
+	}
+
+	if err := es.Publish(ctx, revokeSessionStream, revokeSessionEvent{revocation}); err != nil {
+		return revocation, err
+	}
+
+	return revocation, nil
+}
+
+func (es *eventStore) RevokeUserTokens(ctx context.Context, userID string) (auth.Revocation, error) {
+	revocation, err := es.Service.RevokeUserTokens(ctx, userID)
+	if err != nil {
+		return revocation, err
+	}
+
+	if err := es.Publish(ctx, revokeUserTokensStream, revokeUserTokensEvent{revocation}); err != nil {
+		return revocation, err
+	}
+
+	return revocation, nil
+}
@@ -89,6 +89,8 @@ type Key struct {
 	IssuedAt  time.Time `json:"issued_at,omitempty"`
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	Verified  bool      `json:"verified,omitempty"`
+	MFA       bool      `json:"mfa,omitempty"`        // the user passed the multi-factor authentication
+	SessionID string    `json:"session_id,omitempty"` // login session of the access and refresh keys
 }
 
 func (key Key) String() string {
@@ -0,0 +1,70 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// ErrMFARequired indicates that the domain requires its members to use
+// multi-factor authentication, while the user session didn't pass it.
+var ErrMFARequired = errors.New("domain requires multi-factor authentication")
+
+type mfaKeyType string
+
+const mfaKey = mfaKeyType("mfa")
+
+// MFAPolicy represents the domain policy which requires the domain members
+// to log in with multi-factor authentication.
+type MFAPolicy struct {
+	DomainID  string    `json:"domain_id"`
+	Required  bool      `json:"required"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by"`
+}
+
+// MFAPolicies specifies the domain MFA policy management API.
+type MFAPolicies interface {
+	// SetMFAPolicy creates or replaces the domain MFA policy.
+	SetMFAPolicy(ctx context.Context, token string, policy MFAPolicy) (MFAPolicy, error)
+
+	// RetrieveMFAPolicy retrieves the domain MFA policy. The domain without
+	// the policy doesn't require MFA.
+	RetrieveMFAPolicy(ctx context.Context, token, domainID string) (MFAPolicy, error)
+}
+
+// MFAPolicyRepository specifies the domain MFA policy persistence API.
+type MFAPolicyRepository interface {
+	// Save creates or replaces the domain MFA policy.
+	Save(ctx context.Context, policy MFAPolicy) error
+
+	// Retrieve retrieves the domain MFA policy.
+	Retrieve(ctx context.Context, domainID string) (MFAPolicy, error)
+}
+
+// MFAPolicyCache caches the domain MFA policies, which are checked on each
+// authorization of the user session without MFA.
+type MFAPolicyCache interface {
+	// Save caches the domain MFA policy, or the absence of the policy if the
+	// policy is nil.
+	Save(ctx context.Context, domainID string, policy *MFAPolicy) error
+
+	// Retrieve retrieves the cached domain MFA policy, which is nil if the
+	// domain has no policy.
+	Retrieve(ctx context.Context, domainID string) (*MFAPolicy, error)
+}
+
+// WithMFA returns the context which carries whether the session of the
+// authorized user passed the multi-factor authentication.
+func WithMFA(ctx context.Context, mfa bool) context.Context {
+	return context.WithValue(ctx, mfaKey, mfa)
+}
+
+func mfaFromContext(ctx context.Context) (bool, bool) {
+	mfa, ok := ctx.Value(mfaKey).(bool)
+	return mfa, ok
+}
@@ -46,7 +46,7 @@ func (lm *loggingMiddleware) Issue(ctx context.Context, token string, key auth.K
 	return lm.svc.Issue(ctx, token, key)
 }
 
-func (lm *loggingMiddleware) Revoke(ctx context.Context, token, id string) (err error) {
+func (lm *loggingMiddleware) Revoke(ctx context.Context, token, id string) (revocation auth.Revocation, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -135,13 +135,15 @@ func (lm *loggingMiddleware) Authorize(ctx context.Context, pr policies.Policy)
 	return lm.svc.Authorize(ctx, pr)
 }
 
-func (lm *loggingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration) (pa auth.PAT, err error) {
+func (lm *loggingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
 			slog.String("name", name),
 			slog.String("description", description),
 			slog.String("pat_duration", duration.String()),
+			slog.Any("allowed_cidrs", allowedCIDRs),
+			slog.Int("scopes", len(scopes)),
 		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
@@ -150,7 +152,7 @@ func (lm *loggingMiddleware) CreatePAT(ctx context.Context, token, name, descrip
 		}
 		lm.logger.Info("Create PAT completed successfully", args...)
 	}(time.Now())
-	return lm.svc.CreatePAT(ctx, token, name, description, duration)
+	return lm.svc.CreatePAT(ctx, token, name, description, duration, allowedCIDRs, scopes)
 }
 
 func (lm *loggingMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (pa auth.PAT, err error) {
@@ -187,6 +189,23 @@ func (lm *loggingMiddleware) UpdatePATDescription(ctx context.Context, token, pa
 	return lm.svc.UpdatePATDescription(ctx, token, patID, description)
 }
 
+func (lm *loggingMiddleware) UpdatePATAllowedCIDRs(ctx context.Context, token, patID string, allowedCIDRs []string) (pa auth.PAT, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("pat_id", patID),
+			slog.Any("allowed_cidrs", allowedCIDRs),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Update PAT allowed CIDRs failed", args...)
+			return
+		}
+		lm.logger.Info("Update PAT allowed CIDRs completed successfully", args...)
+	}(time.Now())
+	return lm.svc.UpdatePATAllowedCIDRs(ctx, token, patID, allowedCIDRs)
+}
+
 func (lm *loggingMiddleware) RetrievePAT(ctx context.Context, token, patID string) (pa auth.PAT, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -397,3 +416,154 @@ func (lm *loggingMiddleware) AuthorizePAT(ctx context.Context, userID, patID str
 	}(time.Now())
 	return lm.svc.AuthorizePAT(ctx, userID, patID, entityType, domainID, operation, entityID)
 }
+
+func (lm *loggingMiddleware) SetPATPolicy(ctx context.Context, token string, policy auth.PATPolicy) (pp auth.PATPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", policy.DomainID),
+			slog.String("max_duration", policy.MaxDuration.String()),
+			slog.Int("allowed_scopes", len(policy.AllowedScopes)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Set PAT policy failed", args...)
+			return
+		}
+		lm.logger.Info("Set PAT policy completed successfully", args...)
+	}(time.Now())
+	return lm.svc.SetPATPolicy(ctx, token, policy)
+}
+
+func (lm *loggingMiddleware) RetrievePATPolicy(ctx context.Context, token, domainID string) (pp auth.PATPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", domainID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve PAT policy failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve PAT policy completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RetrievePATPolicy(ctx, token, domainID)
+}
+
+func (lm *loggingMiddleware) RemovePATPolicy(ctx context.Context, token, domainID string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", domainID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Remove PAT policy failed", args...)
+			return
+		}
+		lm.logger.Info("Remove PAT policy completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RemovePATPolicy(ctx, token, domainID)
+}
+
+func (lm *loggingMiddleware) SetMFAPolicy(ctx context.Context, token string, policy auth.MFAPolicy) (mp auth.MFAPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", policy.DomainID),
+			slog.Bool("required", policy.Required),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Set MFA policy failed", args...)
+			return
+		}
+		lm.logger.Info("Set MFA policy completed successfully", args...)
+	}(time.Now())
+	return lm.svc.SetMFAPolicy(ctx, token, policy)
+}
+
+func (lm *loggingMiddleware) RetrieveMFAPolicy(ctx context.Context, token, domainID string) (mp auth.MFAPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", domainID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve MFA policy failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve MFA policy completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RetrieveMFAPolicy(ctx, token, domainID)
+}
+
+func (lm *loggingMiddleware) ListSessions(ctx context.Context, userID string, pm auth.SessionsPageMeta) (sp auth.SessionsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("user_id", userID),
+			slog.Uint64("limit", pm.Limit),
+			slog.Uint64("offset", pm.Offset),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List sessions failed", args...)
+			return
+		}
+		lm.logger.Info("List sessions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListSessions(ctx, userID, pm)
+}
+
+func (lm *loggingMiddleware) RevokeSession(ctx context.Context, userID, sessionID string) (revocation auth.Revocation, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("user_id", userID),
+			slog.String("session_id", sessionID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Revoke session failed", args...)
+			return
+		}
+		lm.logger.Info("Revoke session completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RevokeSession(ctx, userID, sessionID)
+}
+
+func (lm *loggingMiddleware) RevokeUserTokens(ctx context.Context, userID string) (revocation auth.Revocation, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("user_id", userID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Revoke user tokens failed", args...)
+			return
+		}
+		lm.logger.Info("Revoke user tokens completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RevokeUserTokens(ctx, userID)
+}
+
+func (lm *loggingMiddleware) ListRevocations(ctx context.Context, pm auth.RevocationsPageMeta) (rp auth.RevocationsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Uint64("limit", pm.Limit),
+			slog.Uint64("offset", pm.Offset),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List revocations failed", args...)
+			return
+		}
+		lm.logger.Info("List revocations completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListRevocations(ctx, pm)
+}
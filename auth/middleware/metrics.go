@@ -40,7 +40,7 @@ func (ms *metricsMiddleware) Issue(ctx context.Context, token string, key auth.K
 	return ms.svc.Issue(ctx, token, key)
 }
 
-func (ms *metricsMiddleware) Revoke(ctx context.Context, token, id string) error {
+func (ms *metricsMiddleware) Revoke(ctx context.Context, token, id string) (auth.Revocation, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "revoke_key").Add(1)
 		ms.latency.With("method", "revoke_key").Observe(time.Since(begin).Seconds())
@@ -83,12 +83,12 @@ func (ms *metricsMiddleware) Authorize(ctx context.Context, pr policies.Policy)
 	return ms.svc.Authorize(ctx, pr)
 }
 
-func (ms *metricsMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration) (auth.PAT, error) {
+func (ms *metricsMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "create_pat").Add(1)
 		ms.latency.With("method", "create_pat").Observe(time.Since(begin).Seconds())
 	}(time.Now())
-	return ms.svc.CreatePAT(ctx, token, name, description, duration)
+	return ms.svc.CreatePAT(ctx, token, name, description, duration, allowedCIDRs, scopes)
 }
 
 func (ms *metricsMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (auth.PAT, error) {
@@ -107,6 +107,14 @@ func (ms *metricsMiddleware) UpdatePATDescription(ctx context.Context, token, pa
 	return ms.svc.UpdatePATDescription(ctx, token, patID, description)
 }
 
+func (ms *metricsMiddleware) UpdatePATAllowedCIDRs(ctx context.Context, token, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_pat_allowed_cidrs").Add(1)
+		ms.latency.With("method", "update_pat_allowed_cidrs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.UpdatePATAllowedCIDRs(ctx, token, patID, allowedCIDRs)
+}
+
 func (ms *metricsMiddleware) RetrievePAT(ctx context.Context, token, patID string) (auth.PAT, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "retrieve_pat").Add(1)
@@ -202,3 +210,75 @@ func (ms *metricsMiddleware) AuthorizePAT(ctx context.Context, userID, patID str
 	}(time.Now())
 	return ms.svc.AuthorizePAT(ctx, userID, patID, entityType, domainID, operation, entityID)
 }
+
+func (ms *metricsMiddleware) SetPATPolicy(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "set_pat_policy").Add(1)
+		ms.latency.With("method", "set_pat_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.SetPATPolicy(ctx, token, policy)
+}
+
+func (ms *metricsMiddleware) RetrievePATPolicy(ctx context.Context, token, domainID string) (auth.PATPolicy, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "retrieve_pat_policy").Add(1)
+		ms.latency.With("method", "retrieve_pat_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RetrievePATPolicy(ctx, token, domainID)
+}
+
+func (ms *metricsMiddleware) RemovePATPolicy(ctx context.Context, token, domainID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_pat_policy").Add(1)
+		ms.latency.With("method", "remove_pat_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RemovePATPolicy(ctx, token, domainID)
+}
+
+func (ms *metricsMiddleware) SetMFAPolicy(ctx context.Context, token string, policy auth.MFAPolicy) (auth.MFAPolicy, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "set_mfa_policy").Add(1)
+		ms.latency.With("method", "set_mfa_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.SetMFAPolicy(ctx, token, policy)
+}
+
+func (ms *metricsMiddleware) RetrieveMFAPolicy(ctx context.Context, token, domainID string) (auth.MFAPolicy, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "retrieve_mfa_policy").Add(1)
+		ms.latency.With("method", "retrieve_mfa_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RetrieveMFAPolicy(ctx, token, domainID)
+}
+
+func (ms *metricsMiddleware) ListSessions(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_sessions").Add(1)
+		ms.latency.With("method", "list_sessions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListSessions(ctx, userID, pm)
+}
+
+func (ms *metricsMiddleware) RevokeSession(ctx context.Context, userID, sessionID string) (auth.Revocation, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_session").Add(1)
+		ms.latency.With("method", "revoke_session").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RevokeSession(ctx, userID, sessionID)
+}
+
+func (ms *metricsMiddleware) RevokeUserTokens(ctx context.Context, userID string) (auth.Revocation, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_user_tokens").Add(1)
+		ms.latency.With("method", "revoke_user_tokens").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RevokeUserTokens(ctx, userID)
+}
+
+func (ms *metricsMiddleware) ListRevocations(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_revocations").Add(1)
+		ms.latency.With("method", "list_revocations").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListRevocations(ctx, pm)
+}
@@ -36,7 +36,7 @@ func (tm *tracingMiddleware) Issue(ctx context.Context, token string, key auth.K
 	return tm.svc.Issue(ctx, token, key)
 }
 
-func (tm *tracingMiddleware) Revoke(ctx context.Context, token, id string) error {
+func (tm *tracingMiddleware) Revoke(ctx context.Context, token, id string) (auth.Revocation, error) {
 	ctx, span := tm.tracer.Start(ctx, "revoke", trace.WithAttributes(
 		attribute.String("id", id),
 	))
@@ -80,14 +80,16 @@ func (tm *tracingMiddleware) Authorize(ctx context.Context, pr policies.Policy)
 	return tm.svc.Authorize(ctx, pr)
 }
 
-func (tm *tracingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration) (auth.PAT, error) {
+func (tm *tracingMiddleware) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error) {
 	ctx, span := tm.tracer.Start(ctx, "create_pat", trace.WithAttributes(
 		attribute.String("name", name),
 		attribute.String("description", description),
 		attribute.String("duration", duration.String()),
+		attribute.StringSlice("allowed_cidrs", allowedCIDRs),
+		attribute.Int("scopes", len(scopes)),
 	))
 	defer span.End()
-	return tm.svc.CreatePAT(ctx, token, name, description, duration)
+	return tm.svc.CreatePAT(ctx, token, name, description, duration, allowedCIDRs, scopes)
 }
 
 func (tm *tracingMiddleware) UpdatePATName(ctx context.Context, token, patID, name string) (auth.PAT, error) {
@@ -108,6 +110,15 @@ func (tm *tracingMiddleware) UpdatePATDescription(ctx context.Context, token, pa
 	return tm.svc.UpdatePATDescription(ctx, token, patID, description)
 }
 
+func (tm *tracingMiddleware) UpdatePATAllowedCIDRs(ctx context.Context, token, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	ctx, span := tm.tracer.Start(ctx, "update_pat_allowed_cidrs", trace.WithAttributes(
+		attribute.String("pat_id", patID),
+		attribute.StringSlice("allowed_cidrs", allowedCIDRs),
+	))
+	defer span.End()
+	return tm.svc.UpdatePATAllowedCIDRs(ctx, token, patID, allowedCIDRs)
+}
+
 func (tm *tracingMiddleware) RetrievePAT(ctx context.Context, token, patID string) (auth.PAT, error) {
 	ctx, span := tm.tracer.Start(ctx, "retrieve_pat", trace.WithAttributes(
 		attribute.String("pat_id", patID),
@@ -219,3 +230,82 @@ func (tm *tracingMiddleware) AuthorizePAT(ctx context.Context, userID, patID str
 	defer span.End()
 	return tm.svc.AuthorizePAT(ctx, userID, patID, entityType, domainID, operation, entityID)
 }
+
+func (tm *tracingMiddleware) SetPATPolicy(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error) {
+	ctx, span := tm.tracer.Start(ctx, "set_pat_policy", trace.WithAttributes(
+		attribute.String("domain_id", policy.DomainID),
+		attribute.String("max_duration", policy.MaxDuration.String()),
+		attribute.Int("allowed_scopes", len(policy.AllowedScopes)),
+	))
+	defer span.End()
+	return tm.svc.SetPATPolicy(ctx, token, policy)
+}
+
+func (tm *tracingMiddleware) RetrievePATPolicy(ctx context.Context, token, domainID string) (auth.PATPolicy, error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_pat_policy", trace.WithAttributes(
+		attribute.String("domain_id", domainID),
+	))
+	defer span.End()
+	return tm.svc.RetrievePATPolicy(ctx, token, domainID)
+}
+
+func (tm *tracingMiddleware) RemovePATPolicy(ctx context.Context, token, domainID string) error {
+	ctx, span := tm.tracer.Start(ctx, "remove_pat_policy", trace.WithAttributes(
+		attribute.String("domain_id", domainID),
+	))
+	defer span.End()
+	return tm.svc.RemovePATPolicy(ctx, token, domainID)
+}
+
+func (tm *tracingMiddleware) SetMFAPolicy(ctx context.Context, token string, policy auth.MFAPolicy) (auth.MFAPolicy, error) {
+	ctx, span := tm.tracer.Start(ctx, "set_mfa_policy", trace.WithAttributes(
+		attribute.String("domain_id", policy.DomainID),
+		attribute.Bool("required", policy.Required),
+	))
+	defer span.End()
+	return tm.svc.SetMFAPolicy(ctx, token, policy)
+}
+
+func (tm *tracingMiddleware) RetrieveMFAPolicy(ctx context.Context, token, domainID string) (auth.MFAPolicy, error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_mfa_policy", trace.WithAttributes(
+		attribute.String("domain_id", domainID),
+	))
+	defer span.End()
+	return tm.svc.RetrieveMFAPolicy(ctx, token, domainID)
+}
+
+func (tm *tracingMiddleware) ListSessions(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_sessions", trace.WithAttributes(
+		attribute.String("user_id", userID),
+		attribute.Int64("limit", int64(pm.Limit)),
+		attribute.Int64("offset", int64(pm.Offset)),
+	))
+	defer span.End()
+	return tm.svc.ListSessions(ctx, userID, pm)
+}
+
+func (tm *tracingMiddleware) RevokeSession(ctx context.Context, userID, sessionID string) (auth.Revocation, error) {
+	ctx, span := tm.tracer.Start(ctx, "revoke_session", trace.WithAttributes(
+		attribute.String("user_id", userID),
+		attribute.String("session_id", sessionID),
+	))
+	defer span.End()
+	return tm.svc.RevokeSession(ctx, userID, sessionID)
+}
+
+func (tm *tracingMiddleware) RevokeUserTokens(ctx context.Context, userID string) (auth.Revocation, error) {
+	ctx, span := tm.tracer.Start(ctx, "revoke_user_tokens", trace.WithAttributes(
+		attribute.String("user_id", userID),
+	))
+	defer span.End()
+	return tm.svc.RevokeUserTokens(ctx, userID)
+}
+
+func (tm *tracingMiddleware) ListRevocations(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error) {
+	ctx, span := tm.tracer.Start(ctx, "list_revocations", trace.WithAttributes(
+		attribute.Int64("limit", int64(pm.Limit)),
+		attribute.Int64("offset", int64(pm.Offset)),
+	))
+	defer span.End()
+	return tm.svc.ListRevocations(ctx, pm)
+}
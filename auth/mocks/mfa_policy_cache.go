@@ -0,0 +1,174 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMFAPolicyCache creates a new instance of MFAPolicyCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMFAPolicyCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MFAPolicyCache {
+	mock := &MFAPolicyCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MFAPolicyCache is an autogenerated mock type for the MFAPolicyCache type
+type MFAPolicyCache struct {
+	mock.Mock
+}
+
+type MFAPolicyCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MFAPolicyCache) EXPECT() *MFAPolicyCache_Expecter {
+	return &MFAPolicyCache_Expecter{mock: &_m.Mock}
+}
+
+// Retrieve provides a mock function for the type MFAPolicyCache
+func (_mock *MFAPolicyCache) Retrieve(ctx context.Context, domainID string) (*auth.MFAPolicy, error) {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retrieve")
+	}
+
+	var r0 *auth.MFAPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.MFAPolicy, error)); ok {
+		return returnFunc(ctx, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.MFAPolicy); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.MFAPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MFAPolicyCache_Retrieve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retrieve'
+type MFAPolicyCache_Retrieve_Call struct {
+	*mock.Call
+}
+
+// Retrieve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *MFAPolicyCache_Expecter) Retrieve(ctx interface{}, domainID interface{}) *MFAPolicyCache_Retrieve_Call {
+	return &MFAPolicyCache_Retrieve_Call{Call: _e.mock.On("Retrieve", ctx, domainID)}
+}
+
+func (_c *MFAPolicyCache_Retrieve_Call) Run(run func(ctx context.Context, domainID string)) *MFAPolicyCache_Retrieve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MFAPolicyCache_Retrieve_Call) Return(mFAPolicy *auth.MFAPolicy, err error) *MFAPolicyCache_Retrieve_Call {
+	_c.Call.Return(mFAPolicy, err)
+	return _c
+}
+
+func (_c *MFAPolicyCache_Retrieve_Call) RunAndReturn(run func(ctx context.Context, domainID string) (*auth.MFAPolicy, error)) *MFAPolicyCache_Retrieve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MFAPolicyCache
+func (_mock *MFAPolicyCache) Save(ctx context.Context, domainID string, policy *auth.MFAPolicy) error {
+	ret := _mock.Called(ctx, domainID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *auth.MFAPolicy) error); ok {
+		r0 = returnFunc(ctx, domainID, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MFAPolicyCache_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MFAPolicyCache_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - policy *auth.MFAPolicy
+func (_e *MFAPolicyCache_Expecter) Save(ctx interface{}, domainID interface{}, policy interface{}) *MFAPolicyCache_Save_Call {
+	return &MFAPolicyCache_Save_Call{Call: _e.mock.On("Save", ctx, domainID, policy)}
+}
+
+func (_c *MFAPolicyCache_Save_Call) Run(run func(ctx context.Context, domainID string, policy *auth.MFAPolicy)) *MFAPolicyCache_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *auth.MFAPolicy
+		if args[2] != nil {
+			arg2 = args[2].(*auth.MFAPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MFAPolicyCache_Save_Call) Return(err error) *MFAPolicyCache_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MFAPolicyCache_Save_Call) RunAndReturn(run func(ctx context.Context, domainID string, policy *auth.MFAPolicy) error) *MFAPolicyCache_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/absmach/supermq/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewPATUsageTracker creates a new instance of PATUsageTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPATUsageTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PATUsageTracker {
+	mock := &PATUsageTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// PATUsageTracker is an autogenerated mock type for the PATUsageTracker type
+type PATUsageTracker struct {
+	mock.Mock
+}
+
+type PATUsageTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PATUsageTracker) EXPECT() *PATUsageTracker_Expecter {
+	return &PATUsageTracker_Expecter{mock: &_m.Mock}
+}
+
+// Track provides a mock function for the type PATUsageTracker
+func (_mock *PATUsageTracker) Track(usage auth.PATUsage) {
+	_mock.Called(usage)
+	return
+}
+
+// PATUsageTracker_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type PATUsageTracker_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - usage auth.PATUsage
+func (_e *PATUsageTracker_Expecter) Track(usage interface{}) *PATUsageTracker_Track_Call {
+	return &PATUsageTracker_Track_Call{Call: _e.mock.On("Track", usage)}
+}
+
+func (_c *PATUsageTracker_Track_Call) Run(run func(usage auth.PATUsage)) *PATUsageTracker_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 auth.PATUsage
+		if args[0] != nil {
+			arg0 = args[0].(auth.PATUsage)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PATUsageTracker_Track_Call) Return() *PATUsageTracker_Track_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PATUsageTracker_Track_Call) RunAndReturn(run func(usage auth.PATUsage)) *PATUsageTracker_Track_Call {
+	_c.Run(run)
+	return _c
+}
@@ -200,8 +200,8 @@ func (_c *PATS_AuthorizePAT_Call) RunAndReturn(run func(ctx context.Context, use
 }
 
 // CreatePAT provides a mock function for the type PATS
-func (_mock *PATS) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration) (auth.PAT, error) {
-	ret := _mock.Called(ctx, token, name, description, duration)
+func (_mock *PATS) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error) {
+	ret := _mock.Called(ctx, token, name, description, duration, allowedCIDRs, scopes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePAT")
@@ -209,16 +209,16 @@ func (_mock *PATS) CreatePAT(ctx context.Context, token string, name string, des
 
 	var r0 auth.PAT
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) (auth.PAT, error)); ok {
-		return returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) (auth.PAT, error)); ok {
+		return returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) auth.PAT); ok {
-		r0 = returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) auth.PAT); ok {
+		r0 = returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	} else {
 		r0 = ret.Get(0).(auth.PAT)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration) error); ok {
-		r1 = returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) error); ok {
+		r1 = returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -236,11 +236,13 @@ type PATS_CreatePAT_Call struct {
 //   - name string
 //   - description string
 //   - duration time.Duration
-func (_e *PATS_Expecter) CreatePAT(ctx interface{}, token interface{}, name interface{}, description interface{}, duration interface{}) *PATS_CreatePAT_Call {
-	return &PATS_CreatePAT_Call{Call: _e.mock.On("CreatePAT", ctx, token, name, description, duration)}
+//   - allowedCIDRs []string
+//   - scopes []auth.Scope
+func (_e *PATS_Expecter) CreatePAT(ctx interface{}, token interface{}, name interface{}, description interface{}, duration interface{}, allowedCIDRs interface{}, scopes interface{}) *PATS_CreatePAT_Call {
+	return &PATS_CreatePAT_Call{Call: _e.mock.On("CreatePAT", ctx, token, name, description, duration, allowedCIDRs, scopes)}
 }
 
-func (_c *PATS_CreatePAT_Call) Run(run func(ctx context.Context, token string, name string, description string, duration time.Duration)) *PATS_CreatePAT_Call {
+func (_c *PATS_CreatePAT_Call) Run(run func(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope)) *PATS_CreatePAT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -262,12 +264,22 @@ func (_c *PATS_CreatePAT_Call) Run(run func(ctx context.Context, token string, n
 		if args[4] != nil {
 			arg4 = args[4].(time.Duration)
 		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
+		var arg6 []auth.Scope
+		if args[6] != nil {
+			arg6 = args[6].([]auth.Scope)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -278,7 +290,7 @@ func (_c *PATS_CreatePAT_Call) Return(pAT auth.PAT, err error) *PATS_CreatePAT_C
 	return _c
 }
 
-func (_c *PATS_CreatePAT_Call) RunAndReturn(run func(ctx context.Context, token string, name string, description string, duration time.Duration) (auth.PAT, error)) *PATS_CreatePAT_Call {
+func (_c *PATS_CreatePAT_Call) RunAndReturn(run func(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error)) *PATS_CreatePAT_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -676,6 +688,69 @@ func (_c *PATS_RemovePATAllScope_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// RemovePATPolicy provides a mock function for the type PATS
+func (_mock *PATS) RemovePATPolicy(ctx context.Context, token string, domainID string) error {
+	ret := _mock.Called(ctx, token, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePATPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, token, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PATS_RemovePATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePATPolicy'
+type PATS_RemovePATPolicy_Call struct {
+	*mock.Call
+}
+
+// RemovePATPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - domainID string
+func (_e *PATS_Expecter) RemovePATPolicy(ctx interface{}, token interface{}, domainID interface{}) *PATS_RemovePATPolicy_Call {
+	return &PATS_RemovePATPolicy_Call{Call: _e.mock.On("RemovePATPolicy", ctx, token, domainID)}
+}
+
+func (_c *PATS_RemovePATPolicy_Call) Run(run func(ctx context.Context, token string, domainID string)) *PATS_RemovePATPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PATS_RemovePATPolicy_Call) Return(err error) *PATS_RemovePATPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PATS_RemovePATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, domainID string) error) *PATS_RemovePATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveScope provides a mock function for the type PATS
 func (_mock *PATS) RemoveScope(ctx context.Context, token string, patID string, scopeIDs ...string) error {
 	var tmpRet mock.Arguments
@@ -904,6 +979,78 @@ func (_c *PATS_RetrievePAT_Call) RunAndReturn(run func(ctx context.Context, user
 	return _c
 }
 
+// RetrievePATPolicy provides a mock function for the type PATS
+func (_mock *PATS) RetrievePATPolicy(ctx context.Context, token string, domainID string) (auth.PATPolicy, error) {
+	ret := _mock.Called(ctx, token, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePATPolicy")
+	}
+
+	var r0 auth.PATPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.PATPolicy, error)); ok {
+		return returnFunc(ctx, token, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.PATPolicy); ok {
+		r0 = returnFunc(ctx, token, domainID)
+	} else {
+		r0 = ret.Get(0).(auth.PATPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PATS_RetrievePATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePATPolicy'
+type PATS_RetrievePATPolicy_Call struct {
+	*mock.Call
+}
+
+// RetrievePATPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - domainID string
+func (_e *PATS_Expecter) RetrievePATPolicy(ctx interface{}, token interface{}, domainID interface{}) *PATS_RetrievePATPolicy_Call {
+	return &PATS_RetrievePATPolicy_Call{Call: _e.mock.On("RetrievePATPolicy", ctx, token, domainID)}
+}
+
+func (_c *PATS_RetrievePATPolicy_Call) Run(run func(ctx context.Context, token string, domainID string)) *PATS_RetrievePATPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PATS_RetrievePATPolicy_Call) Return(pATPolicy auth.PATPolicy, err error) *PATS_RetrievePATPolicy_Call {
+	_c.Call.Return(pATPolicy, err)
+	return _c
+}
+
+func (_c *PATS_RetrievePATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, domainID string) (auth.PATPolicy, error)) *PATS_RetrievePATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokePATSecret provides a mock function for the type PATS
 func (_mock *PATS) RevokePATSecret(ctx context.Context, token string, patID string) error {
 	ret := _mock.Called(ctx, token, patID)
@@ -967,6 +1114,156 @@ func (_c *PATS_RevokePATSecret_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// SetPATPolicy provides a mock function for the type PATS
+func (_mock *PATS) SetPATPolicy(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error) {
+	ret := _mock.Called(ctx, token, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPATPolicy")
+	}
+
+	var r0 auth.PATPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.PATPolicy) (auth.PATPolicy, error)); ok {
+		return returnFunc(ctx, token, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.PATPolicy) auth.PATPolicy); ok {
+		r0 = returnFunc(ctx, token, policy)
+	} else {
+		r0 = ret.Get(0).(auth.PATPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.PATPolicy) error); ok {
+		r1 = returnFunc(ctx, token, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PATS_SetPATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPATPolicy'
+type PATS_SetPATPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPATPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - policy auth.PATPolicy
+func (_e *PATS_Expecter) SetPATPolicy(ctx interface{}, token interface{}, policy interface{}) *PATS_SetPATPolicy_Call {
+	return &PATS_SetPATPolicy_Call{Call: _e.mock.On("SetPATPolicy", ctx, token, policy)}
+}
+
+func (_c *PATS_SetPATPolicy_Call) Run(run func(ctx context.Context, token string, policy auth.PATPolicy)) *PATS_SetPATPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.PATPolicy
+		if args[2] != nil {
+			arg2 = args[2].(auth.PATPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PATS_SetPATPolicy_Call) Return(pATPolicy auth.PATPolicy, err error) *PATS_SetPATPolicy_Call {
+	_c.Call.Return(pATPolicy, err)
+	return _c
+}
+
+func (_c *PATS_SetPATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error)) *PATS_SetPATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePATAllowedCIDRs provides a mock function for the type PATS
+func (_mock *PATS) UpdatePATAllowedCIDRs(ctx context.Context, token string, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	ret := _mock.Called(ctx, token, patID, allowedCIDRs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePATAllowedCIDRs")
+	}
+
+	var r0 auth.PAT
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) (auth.PAT, error)); ok {
+		return returnFunc(ctx, token, patID, allowedCIDRs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) auth.PAT); ok {
+		r0 = returnFunc(ctx, token, patID, allowedCIDRs)
+	} else {
+		r0 = ret.Get(0).(auth.PAT)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, token, patID, allowedCIDRs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PATS_UpdatePATAllowedCIDRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePATAllowedCIDRs'
+type PATS_UpdatePATAllowedCIDRs_Call struct {
+	*mock.Call
+}
+
+// UpdatePATAllowedCIDRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - patID string
+//   - allowedCIDRs []string
+func (_e *PATS_Expecter) UpdatePATAllowedCIDRs(ctx interface{}, token interface{}, patID interface{}, allowedCIDRs interface{}) *PATS_UpdatePATAllowedCIDRs_Call {
+	return &PATS_UpdatePATAllowedCIDRs_Call{Call: _e.mock.On("UpdatePATAllowedCIDRs", ctx, token, patID, allowedCIDRs)}
+}
+
+func (_c *PATS_UpdatePATAllowedCIDRs_Call) Run(run func(ctx context.Context, token string, patID string, allowedCIDRs []string)) *PATS_UpdatePATAllowedCIDRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *PATS_UpdatePATAllowedCIDRs_Call) Return(pAT auth.PAT, err error) *PATS_UpdatePATAllowedCIDRs_Call {
+	_c.Call.Return(pAT, err)
+	return _c
+}
+
+func (_c *PATS_UpdatePATAllowedCIDRs_Call) RunAndReturn(run func(ctx context.Context, token string, patID string, allowedCIDRs []string) (auth.PAT, error)) *PATS_UpdatePATAllowedCIDRs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePATDescription provides a mock function for the type PATS
 func (_mock *PATS) UpdatePATDescription(ctx context.Context, token string, patID string, description string) (auth.PAT, error) {
 	ret := _mock.Called(ctx, token, patID, description)
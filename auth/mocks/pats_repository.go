@@ -433,6 +433,63 @@ func (_c *PATSRepository_RemoveAllScope_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// RemovePolicy provides a mock function for the type PATSRepository
+func (_mock *PATSRepository) RemovePolicy(ctx context.Context, domainID string) error {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PATSRepository_RemovePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePolicy'
+type PATSRepository_RemovePolicy_Call struct {
+	*mock.Call
+}
+
+// RemovePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *PATSRepository_Expecter) RemovePolicy(ctx interface{}, domainID interface{}) *PATSRepository_RemovePolicy_Call {
+	return &PATSRepository_RemovePolicy_Call{Call: _e.mock.On("RemovePolicy", ctx, domainID)}
+}
+
+func (_c *PATSRepository_RemovePolicy_Call) Run(run func(ctx context.Context, domainID string)) *PATSRepository_RemovePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PATSRepository_RemovePolicy_Call) Return(err error) *PATSRepository_RemovePolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PATSRepository_RemovePolicy_Call) RunAndReturn(run func(ctx context.Context, domainID string) error) *PATSRepository_RemovePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveScope provides a mock function for the type PATSRepository
 func (_mock *PATSRepository) RemoveScope(ctx context.Context, userID string, scopesIDs ...string) error {
 	var tmpRet mock.Arguments
@@ -649,6 +706,83 @@ func (_c *PATSRepository_RetrieveAll_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// RetrievePolicies provides a mock function for the type PATSRepository
+func (_mock *PATSRepository) RetrievePolicies(ctx context.Context, domainIDs ...string) ([]auth.PATPolicy, error) {
+	var tmpRet mock.Arguments
+	if len(domainIDs) > 0 {
+		tmpRet = _mock.Called(ctx, domainIDs)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePolicies")
+	}
+
+	var r0 []auth.PATPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) ([]auth.PATPolicy, error)); ok {
+		return returnFunc(ctx, domainIDs...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) []auth.PATPolicy); ok {
+		r0 = returnFunc(ctx, domainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.PATPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = returnFunc(ctx, domainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PATSRepository_RetrievePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePolicies'
+type PATSRepository_RetrievePolicies_Call struct {
+	*mock.Call
+}
+
+// RetrievePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainIDs ...string
+func (_e *PATSRepository_Expecter) RetrievePolicies(ctx interface{}, domainIDs ...interface{}) *PATSRepository_RetrievePolicies_Call {
+	return &PATSRepository_RetrievePolicies_Call{Call: _e.mock.On("RetrievePolicies",
+		append([]interface{}{ctx}, domainIDs...)...)}
+}
+
+func (_c *PATSRepository_RetrievePolicies_Call) Run(run func(ctx context.Context, domainIDs ...string)) *PATSRepository_RetrievePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		var variadicArgs []string
+		if len(args) > 1 {
+			variadicArgs = args[1].([]string)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *PATSRepository_RetrievePolicies_Call) Return(pATPolicys []auth.PATPolicy, err error) *PATSRepository_RetrievePolicies_Call {
+	_c.Call.Return(pATPolicys, err)
+	return _c
+}
+
+func (_c *PATSRepository_RetrievePolicies_Call) RunAndReturn(run func(ctx context.Context, domainIDs ...string) ([]auth.PATPolicy, error)) *PATSRepository_RetrievePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveScope provides a mock function for the type PATSRepository
 func (_mock *PATSRepository) RetrieveScope(ctx context.Context, pm auth.ScopesPageMeta) (auth.ScopesPage, error) {
 	ret := _mock.Called(ctx, pm)
@@ -716,7 +850,7 @@ func (_c *PATSRepository_RetrieveScope_Call) RunAndReturn(run func(ctx context.C
 }
 
 // RetrieveSecretAndRevokeStatus provides a mock function for the type PATSRepository
-func (_mock *PATSRepository) RetrieveSecretAndRevokeStatus(ctx context.Context, userID string, patID string) (string, bool, bool, error) {
+func (_mock *PATSRepository) RetrieveSecretAndRevokeStatus(ctx context.Context, userID string, patID string) (string, bool, bool, []string, error) {
 	ret := _mock.Called(ctx, userID, patID)
 
 	if len(ret) == 0 {
@@ -726,8 +860,9 @@ func (_mock *PATSRepository) RetrieveSecretAndRevokeStatus(ctx context.Context,
 	var r0 string
 	var r1 bool
 	var r2 bool
-	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, bool, bool, error)); ok {
+	var r3 []string
+	var r4 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, bool, bool, []string, error)); ok {
 		return returnFunc(ctx, userID, patID)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
@@ -745,12 +880,19 @@ func (_mock *PATSRepository) RetrieveSecretAndRevokeStatus(ctx context.Context,
 	} else {
 		r2 = ret.Get(2).(bool)
 	}
-	if returnFunc, ok := ret.Get(3).(func(context.Context, string, string) error); ok {
+	if returnFunc, ok := ret.Get(3).(func(context.Context, string, string) []string); ok {
 		r3 = returnFunc(ctx, userID, patID)
 	} else {
-		r3 = ret.Error(3)
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).([]string)
+		}
 	}
-	return r0, r1, r2, r3
+	if returnFunc, ok := ret.Get(4).(func(context.Context, string, string) error); ok {
+		r4 = returnFunc(ctx, userID, patID)
+	} else {
+		r4 = ret.Error(4)
+	}
+	return r0, r1, r2, r3, r4
 }
 
 // PATSRepository_RetrieveSecretAndRevokeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveSecretAndRevokeStatus'
@@ -789,12 +931,12 @@ func (_c *PATSRepository_RetrieveSecretAndRevokeStatus_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *PATSRepository_RetrieveSecretAndRevokeStatus_Call) Return(s string, b bool, b1 bool, err error) *PATSRepository_RetrieveSecretAndRevokeStatus_Call {
-	_c.Call.Return(s, b, b1, err)
+func (_c *PATSRepository_RetrieveSecretAndRevokeStatus_Call) Return(s string, b bool, b1 bool, strings []string, err error) *PATSRepository_RetrieveSecretAndRevokeStatus_Call {
+	_c.Call.Return(s, b, b1, strings, err)
 	return _c
 }
 
-func (_c *PATSRepository_RetrieveSecretAndRevokeStatus_Call) RunAndReturn(run func(ctx context.Context, userID string, patID string) (string, bool, bool, error)) *PATSRepository_RetrieveSecretAndRevokeStatus_Call {
+func (_c *PATSRepository_RetrieveSecretAndRevokeStatus_Call) RunAndReturn(run func(ctx context.Context, userID string, patID string) (string, bool, bool, []string, error)) *PATSRepository_RetrieveSecretAndRevokeStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -919,6 +1061,141 @@ func (_c *PATSRepository_Save_Call) RunAndReturn(run func(ctx context.Context, p
 	return _c
 }
 
+// SavePolicy provides a mock function for the type PATSRepository
+func (_mock *PATSRepository) SavePolicy(ctx context.Context, policy auth.PATPolicy) error {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.PATPolicy) error); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PATSRepository_SavePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePolicy'
+type PATSRepository_SavePolicy_Call struct {
+	*mock.Call
+}
+
+// SavePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy auth.PATPolicy
+func (_e *PATSRepository_Expecter) SavePolicy(ctx interface{}, policy interface{}) *PATSRepository_SavePolicy_Call {
+	return &PATSRepository_SavePolicy_Call{Call: _e.mock.On("SavePolicy", ctx, policy)}
+}
+
+func (_c *PATSRepository_SavePolicy_Call) Run(run func(ctx context.Context, policy auth.PATPolicy)) *PATSRepository_SavePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.PATPolicy
+		if args[1] != nil {
+			arg1 = args[1].(auth.PATPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PATSRepository_SavePolicy_Call) Return(err error) *PATSRepository_SavePolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PATSRepository_SavePolicy_Call) RunAndReturn(run func(ctx context.Context, policy auth.PATPolicy) error) *PATSRepository_SavePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAllowedCIDRs provides a mock function for the type PATSRepository
+func (_mock *PATSRepository) UpdateAllowedCIDRs(ctx context.Context, userID string, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	ret := _mock.Called(ctx, userID, patID, allowedCIDRs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAllowedCIDRs")
+	}
+
+	var r0 auth.PAT
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) (auth.PAT, error)); ok {
+		return returnFunc(ctx, userID, patID, allowedCIDRs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) auth.PAT); ok {
+		r0 = returnFunc(ctx, userID, patID, allowedCIDRs)
+	} else {
+		r0 = ret.Get(0).(auth.PAT)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, userID, patID, allowedCIDRs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PATSRepository_UpdateAllowedCIDRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAllowedCIDRs'
+type PATSRepository_UpdateAllowedCIDRs_Call struct {
+	*mock.Call
+}
+
+// UpdateAllowedCIDRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - patID string
+//   - allowedCIDRs []string
+func (_e *PATSRepository_Expecter) UpdateAllowedCIDRs(ctx interface{}, userID interface{}, patID interface{}, allowedCIDRs interface{}) *PATSRepository_UpdateAllowedCIDRs_Call {
+	return &PATSRepository_UpdateAllowedCIDRs_Call{Call: _e.mock.On("UpdateAllowedCIDRs", ctx, userID, patID, allowedCIDRs)}
+}
+
+func (_c *PATSRepository_UpdateAllowedCIDRs_Call) Run(run func(ctx context.Context, userID string, patID string, allowedCIDRs []string)) *PATSRepository_UpdateAllowedCIDRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *PATSRepository_UpdateAllowedCIDRs_Call) Return(pAT auth.PAT, err error) *PATSRepository_UpdateAllowedCIDRs_Call {
+	_c.Call.Return(pAT, err)
+	return _c
+}
+
+func (_c *PATSRepository_UpdateAllowedCIDRs_Call) RunAndReturn(run func(ctx context.Context, userID string, patID string, allowedCIDRs []string) (auth.PAT, error)) *PATSRepository_UpdateAllowedCIDRs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDescription provides a mock function for the type PATSRepository
 func (_mock *PATSRepository) UpdateDescription(ctx context.Context, userID string, patID string, description string) (auth.PAT, error) {
 	ret := _mock.Called(ctx, userID, patID, description)
@@ -1158,3 +1435,60 @@ func (_c *PATSRepository_UpdateTokenHash_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateUsage provides a mock function for the type PATSRepository
+func (_mock *PATSRepository) UpdateUsage(ctx context.Context, usages []auth.PATUsage) error {
+	ret := _mock.Called(ctx, usages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []auth.PATUsage) error); ok {
+		r0 = returnFunc(ctx, usages)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PATSRepository_UpdateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsage'
+type PATSRepository_UpdateUsage_Call struct {
+	*mock.Call
+}
+
+// UpdateUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usages []auth.PATUsage
+func (_e *PATSRepository_Expecter) UpdateUsage(ctx interface{}, usages interface{}) *PATSRepository_UpdateUsage_Call {
+	return &PATSRepository_UpdateUsage_Call{Call: _e.mock.On("UpdateUsage", ctx, usages)}
+}
+
+func (_c *PATSRepository_UpdateUsage_Call) Run(run func(ctx context.Context, usages []auth.PATUsage)) *PATSRepository_UpdateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []auth.PATUsage
+		if args[1] != nil {
+			arg1 = args[1].([]auth.PATUsage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PATSRepository_UpdateUsage_Call) Return(err error) *PATSRepository_UpdateUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PATSRepository_UpdateUsage_Call) RunAndReturn(run func(ctx context.Context, usages []auth.PATUsage) error) *PATSRepository_UpdateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
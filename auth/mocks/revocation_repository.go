@@ -0,0 +1,290 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewRevocationRepository creates a new instance of RevocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevocationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevocationRepository {
+	mock := &RevocationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// RevocationRepository is an autogenerated mock type for the RevocationRepository type
+type RevocationRepository struct {
+	mock.Mock
+}
+
+type RevocationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevocationRepository) EXPECT() *RevocationRepository_Expecter {
+	return &RevocationRepository_Expecter{mock: &_m.Mock}
+}
+
+// RemoveExpired provides a mock function for the type RevocationRepository
+func (_mock *RevocationRepository) RemoveExpired(ctx context.Context, expiredBefore time.Time) error {
+	ret := _mock.Called(ctx, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExpired")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, expiredBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationRepository_RemoveExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExpired'
+type RevocationRepository_RemoveExpired_Call struct {
+	*mock.Call
+}
+
+// RemoveExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore time.Time
+func (_e *RevocationRepository_Expecter) RemoveExpired(ctx interface{}, expiredBefore interface{}) *RevocationRepository_RemoveExpired_Call {
+	return &RevocationRepository_RemoveExpired_Call{Call: _e.mock.On("RemoveExpired", ctx, expiredBefore)}
+}
+
+func (_c *RevocationRepository_RemoveExpired_Call) Run(run func(ctx context.Context, expiredBefore time.Time)) *RevocationRepository_RemoveExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_RemoveExpired_Call) Return(err error) *RevocationRepository_RemoveExpired_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationRepository_RemoveExpired_Call) RunAndReturn(run func(ctx context.Context, expiredBefore time.Time) error) *RevocationRepository_RemoveExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveAll provides a mock function for the type RevocationRepository
+func (_mock *RevocationRepository) RetrieveAll(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveAll")
+	}
+
+	var r0 auth.RevocationsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RevocationsPageMeta) (auth.RevocationsPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RevocationsPageMeta) auth.RevocationsPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(auth.RevocationsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.RevocationsPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RevocationRepository_RetrieveAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveAll'
+type RevocationRepository_RetrieveAll_Call struct {
+	*mock.Call
+}
+
+// RetrieveAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm auth.RevocationsPageMeta
+func (_e *RevocationRepository_Expecter) RetrieveAll(ctx interface{}, pm interface{}) *RevocationRepository_RetrieveAll_Call {
+	return &RevocationRepository_RetrieveAll_Call{Call: _e.mock.On("RetrieveAll", ctx, pm)}
+}
+
+func (_c *RevocationRepository_RetrieveAll_Call) Run(run func(ctx context.Context, pm auth.RevocationsPageMeta)) *RevocationRepository_RetrieveAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.RevocationsPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(auth.RevocationsPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_RetrieveAll_Call) Return(revocationsPage auth.RevocationsPage, err error) *RevocationRepository_RetrieveAll_Call {
+	_c.Call.Return(revocationsPage, err)
+	return _c
+}
+
+func (_c *RevocationRepository_RetrieveAll_Call) RunAndReturn(run func(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error)) *RevocationRepository_RetrieveAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoked provides a mock function for the type RevocationRepository
+func (_mock *RevocationRepository) Revoked(ctx context.Context, key auth.Key) (bool, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Key) (bool, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Key) bool); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.Key) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RevocationRepository_Revoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoked'
+type RevocationRepository_Revoked_Call struct {
+	*mock.Call
+}
+
+// Revoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key auth.Key
+func (_e *RevocationRepository_Expecter) Revoked(ctx interface{}, key interface{}) *RevocationRepository_Revoked_Call {
+	return &RevocationRepository_Revoked_Call{Call: _e.mock.On("Revoked", ctx, key)}
+}
+
+func (_c *RevocationRepository_Revoked_Call) Run(run func(ctx context.Context, key auth.Key)) *RevocationRepository_Revoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Key
+		if args[1] != nil {
+			arg1 = args[1].(auth.Key)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_Revoked_Call) Return(b bool, err error) *RevocationRepository_Revoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *RevocationRepository_Revoked_Call) RunAndReturn(run func(ctx context.Context, key auth.Key) (bool, error)) *RevocationRepository_Revoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type RevocationRepository
+func (_mock *RevocationRepository) Save(ctx context.Context, revocation auth.Revocation) error {
+	ret := _mock.Called(ctx, revocation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Revocation) error); ok {
+		r0 = returnFunc(ctx, revocation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type RevocationRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revocation auth.Revocation
+func (_e *RevocationRepository_Expecter) Save(ctx interface{}, revocation interface{}) *RevocationRepository_Save_Call {
+	return &RevocationRepository_Save_Call{Call: _e.mock.On("Save", ctx, revocation)}
+}
+
+func (_c *RevocationRepository_Save_Call) Run(run func(ctx context.Context, revocation auth.Revocation)) *RevocationRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Revocation
+		if args[1] != nil {
+			arg1 = args[1].(auth.Revocation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_Save_Call) Return(err error) *RevocationRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationRepository_Save_Call) RunAndReturn(run func(ctx context.Context, revocation auth.Revocation) error) *RevocationRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
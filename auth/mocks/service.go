@@ -258,8 +258,8 @@ func (_c *Service_AuthorizePAT_Call) RunAndReturn(run func(ctx context.Context,
 }
 
 // CreatePAT provides a mock function for the type Service
-func (_mock *Service) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration) (auth.PAT, error) {
-	ret := _mock.Called(ctx, token, name, description, duration)
+func (_mock *Service) CreatePAT(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error) {
+	ret := _mock.Called(ctx, token, name, description, duration, allowedCIDRs, scopes)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePAT")
@@ -267,16 +267,16 @@ func (_mock *Service) CreatePAT(ctx context.Context, token string, name string,
 
 	var r0 auth.PAT
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) (auth.PAT, error)); ok {
-		return returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) (auth.PAT, error)); ok {
+		return returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) auth.PAT); ok {
-		r0 = returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) auth.PAT); ok {
+		r0 = returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	} else {
 		r0 = ret.Get(0).(auth.PAT)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration) error); ok {
-		r1 = returnFunc(ctx, token, name, description, duration)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration, []string, []auth.Scope) error); ok {
+		r1 = returnFunc(ctx, token, name, description, duration, allowedCIDRs, scopes)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -294,11 +294,13 @@ type Service_CreatePAT_Call struct {
 //   - name string
 //   - description string
 //   - duration time.Duration
-func (_e *Service_Expecter) CreatePAT(ctx interface{}, token interface{}, name interface{}, description interface{}, duration interface{}) *Service_CreatePAT_Call {
-	return &Service_CreatePAT_Call{Call: _e.mock.On("CreatePAT", ctx, token, name, description, duration)}
+//   - allowedCIDRs []string
+//   - scopes []auth.Scope
+func (_e *Service_Expecter) CreatePAT(ctx interface{}, token interface{}, name interface{}, description interface{}, duration interface{}, allowedCIDRs interface{}, scopes interface{}) *Service_CreatePAT_Call {
+	return &Service_CreatePAT_Call{Call: _e.mock.On("CreatePAT", ctx, token, name, description, duration, allowedCIDRs, scopes)}
 }
 
-func (_c *Service_CreatePAT_Call) Run(run func(ctx context.Context, token string, name string, description string, duration time.Duration)) *Service_CreatePAT_Call {
+func (_c *Service_CreatePAT_Call) Run(run func(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope)) *Service_CreatePAT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -320,12 +322,22 @@ func (_c *Service_CreatePAT_Call) Run(run func(ctx context.Context, token string
 		if args[4] != nil {
 			arg4 = args[4].(time.Duration)
 		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
+		var arg6 []auth.Scope
+		if args[6] != nil {
+			arg6 = args[6].([]auth.Scope)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -336,7 +348,7 @@ func (_c *Service_CreatePAT_Call) Return(pAT auth.PAT, err error) *Service_Creat
 	return _c
 }
 
-func (_c *Service_CreatePAT_Call) RunAndReturn(run func(ctx context.Context, token string, name string, description string, duration time.Duration) (auth.PAT, error)) *Service_CreatePAT_Call {
+func (_c *Service_CreatePAT_Call) RunAndReturn(run func(ctx context.Context, token string, name string, description string, duration time.Duration, allowedCIDRs []string, scopes []auth.Scope) (auth.PAT, error)) *Service_CreatePAT_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -680,6 +692,72 @@ func (_c *Service_ListPATS_Call) RunAndReturn(run func(ctx context.Context, toke
 	return _c
 }
 
+// ListRevocations provides a mock function for the type Service
+func (_mock *Service) ListRevocations(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevocations")
+	}
+
+	var r0 auth.RevocationsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RevocationsPageMeta) (auth.RevocationsPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RevocationsPageMeta) auth.RevocationsPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(auth.RevocationsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.RevocationsPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListRevocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevocations'
+type Service_ListRevocations_Call struct {
+	*mock.Call
+}
+
+// ListRevocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm auth.RevocationsPageMeta
+func (_e *Service_Expecter) ListRevocations(ctx interface{}, pm interface{}) *Service_ListRevocations_Call {
+	return &Service_ListRevocations_Call{Call: _e.mock.On("ListRevocations", ctx, pm)}
+}
+
+func (_c *Service_ListRevocations_Call) Run(run func(ctx context.Context, pm auth.RevocationsPageMeta)) *Service_ListRevocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.RevocationsPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(auth.RevocationsPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListRevocations_Call) Return(revocationsPage auth.RevocationsPage, err error) *Service_ListRevocations_Call {
+	_c.Call.Return(revocationsPage, err)
+	return _c
+}
+
+func (_c *Service_ListRevocations_Call) RunAndReturn(run func(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error)) *Service_ListRevocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListScopes provides a mock function for the type Service
 func (_mock *Service) ListScopes(ctx context.Context, token string, pm auth.ScopesPageMeta) (auth.ScopesPage, error) {
 	ret := _mock.Called(ctx, token, pm)
@@ -752,6 +830,78 @@ func (_c *Service_ListScopes_Call) RunAndReturn(run func(ctx context.Context, to
 	return _c
 }
 
+// ListSessions provides a mock function for the type Service
+func (_mock *Service) ListSessions(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error) {
+	ret := _mock.Called(ctx, userID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 auth.SessionsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.SessionsPageMeta) (auth.SessionsPage, error)); ok {
+		return returnFunc(ctx, userID, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.SessionsPageMeta) auth.SessionsPage); ok {
+		r0 = returnFunc(ctx, userID, pm)
+	} else {
+		r0 = ret.Get(0).(auth.SessionsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.SessionsPageMeta) error); ok {
+		r1 = returnFunc(ctx, userID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type Service_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pm auth.SessionsPageMeta
+func (_e *Service_Expecter) ListSessions(ctx interface{}, userID interface{}, pm interface{}) *Service_ListSessions_Call {
+	return &Service_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID, pm)}
+}
+
+func (_c *Service_ListSessions_Call) Run(run func(ctx context.Context, userID string, pm auth.SessionsPageMeta)) *Service_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.SessionsPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(auth.SessionsPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListSessions_Call) Return(sessionsPage auth.SessionsPage, err error) *Service_ListSessions_Call {
+	_c.Call.Return(sessionsPage, err)
+	return _c
+}
+
+func (_c *Service_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error)) *Service_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveAllPAT provides a mock function for the type Service
 func (_mock *Service) RemoveAllPAT(ctx context.Context, token string) error {
 	ret := _mock.Called(ctx, token)
@@ -872,6 +1022,69 @@ func (_c *Service_RemovePATAllScope_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// RemovePATPolicy provides a mock function for the type Service
+func (_mock *Service) RemovePATPolicy(ctx context.Context, token string, domainID string) error {
+	ret := _mock.Called(ctx, token, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePATPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, token, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemovePATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePATPolicy'
+type Service_RemovePATPolicy_Call struct {
+	*mock.Call
+}
+
+// RemovePATPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - domainID string
+func (_e *Service_Expecter) RemovePATPolicy(ctx interface{}, token interface{}, domainID interface{}) *Service_RemovePATPolicy_Call {
+	return &Service_RemovePATPolicy_Call{Call: _e.mock.On("RemovePATPolicy", ctx, token, domainID)}
+}
+
+func (_c *Service_RemovePATPolicy_Call) Run(run func(ctx context.Context, token string, domainID string)) *Service_RemovePATPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemovePATPolicy_Call) Return(err error) *Service_RemovePATPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemovePATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, domainID string) error) *Service_RemovePATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveScope provides a mock function for the type Service
 func (_mock *Service) RemoveScope(ctx context.Context, token string, patID string, scopeIDs ...string) error {
 	var tmpRet mock.Arguments
@@ -1146,46 +1359,46 @@ func (_c *Service_RetrieveKey_Call) RunAndReturn(run func(ctx context.Context, t
 	return _c
 }
 
-// RetrievePAT provides a mock function for the type Service
-func (_mock *Service) RetrievePAT(ctx context.Context, userID string, patID string) (auth.PAT, error) {
-	ret := _mock.Called(ctx, userID, patID)
+// RetrieveMFAPolicy provides a mock function for the type Service
+func (_mock *Service) RetrieveMFAPolicy(ctx context.Context, token string, domainID string) (auth.MFAPolicy, error) {
+	ret := _mock.Called(ctx, token, domainID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RetrievePAT")
+		panic("no return value specified for RetrieveMFAPolicy")
 	}
 
-	var r0 auth.PAT
+	var r0 auth.MFAPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.PAT, error)); ok {
-		return returnFunc(ctx, userID, patID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.MFAPolicy, error)); ok {
+		return returnFunc(ctx, token, domainID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.PAT); ok {
-		r0 = returnFunc(ctx, userID, patID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.MFAPolicy); ok {
+		r0 = returnFunc(ctx, token, domainID)
 	} else {
-		r0 = ret.Get(0).(auth.PAT)
+		r0 = ret.Get(0).(auth.MFAPolicy)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, userID, patID)
+		r1 = returnFunc(ctx, token, domainID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Service_RetrievePAT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePAT'
-type Service_RetrievePAT_Call struct {
+// Service_RetrieveMFAPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveMFAPolicy'
+type Service_RetrieveMFAPolicy_Call struct {
 	*mock.Call
 }
 
-// RetrievePAT is a helper method to define mock.On call
+// RetrieveMFAPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - patID string
-func (_e *Service_Expecter) RetrievePAT(ctx interface{}, userID interface{}, patID interface{}) *Service_RetrievePAT_Call {
-	return &Service_RetrievePAT_Call{Call: _e.mock.On("RetrievePAT", ctx, userID, patID)}
+//   - token string
+//   - domainID string
+func (_e *Service_Expecter) RetrieveMFAPolicy(ctx interface{}, token interface{}, domainID interface{}) *Service_RetrieveMFAPolicy_Call {
+	return &Service_RetrieveMFAPolicy_Call{Call: _e.mock.On("RetrieveMFAPolicy", ctx, token, domainID)}
 }
 
-func (_c *Service_RetrievePAT_Call) Run(run func(ctx context.Context, userID string, patID string)) *Service_RetrievePAT_Call {
+func (_c *Service_RetrieveMFAPolicy_Call) Run(run func(ctx context.Context, token string, domainID string)) *Service_RetrieveMFAPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1208,47 +1421,56 @@ func (_c *Service_RetrievePAT_Call) Run(run func(ctx context.Context, userID str
 	return _c
 }
 
-func (_c *Service_RetrievePAT_Call) Return(pAT auth.PAT, err error) *Service_RetrievePAT_Call {
-	_c.Call.Return(pAT, err)
+func (_c *Service_RetrieveMFAPolicy_Call) Return(mFAPolicy auth.MFAPolicy, err error) *Service_RetrieveMFAPolicy_Call {
+	_c.Call.Return(mFAPolicy, err)
 	return _c
 }
 
-func (_c *Service_RetrievePAT_Call) RunAndReturn(run func(ctx context.Context, userID string, patID string) (auth.PAT, error)) *Service_RetrievePAT_Call {
+func (_c *Service_RetrieveMFAPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, domainID string) (auth.MFAPolicy, error)) *Service_RetrieveMFAPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Revoke provides a mock function for the type Service
-func (_mock *Service) Revoke(ctx context.Context, token string, id string) error {
-	ret := _mock.Called(ctx, token, id)
+// RetrievePAT provides a mock function for the type Service
+func (_mock *Service) RetrievePAT(ctx context.Context, userID string, patID string) (auth.PAT, error) {
+	ret := _mock.Called(ctx, userID, patID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Revoke")
+		panic("no return value specified for RetrievePAT")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, token, id)
+	var r0 auth.PAT
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.PAT, error)); ok {
+		return returnFunc(ctx, userID, patID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.PAT); ok {
+		r0 = returnFunc(ctx, userID, patID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(auth.PAT)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, patID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Service_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
-type Service_Revoke_Call struct {
+// Service_RetrievePAT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePAT'
+type Service_RetrievePAT_Call struct {
 	*mock.Call
 }
 
-// Revoke is a helper method to define mock.On call
+// RetrievePAT is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token string
-//   - id string
-func (_e *Service_Expecter) Revoke(ctx interface{}, token interface{}, id interface{}) *Service_Revoke_Call {
-	return &Service_Revoke_Call{Call: _e.mock.On("Revoke", ctx, token, id)}
+//   - userID string
+//   - patID string
+func (_e *Service_Expecter) RetrievePAT(ctx interface{}, userID interface{}, patID interface{}) *Service_RetrievePAT_Call {
+	return &Service_RetrievePAT_Call{Call: _e.mock.On("RetrievePAT", ctx, userID, patID)}
 }
 
-func (_c *Service_Revoke_Call) Run(run func(ctx context.Context, token string, id string)) *Service_Revoke_Call {
+func (_c *Service_RetrievePAT_Call) Run(run func(ctx context.Context, userID string, patID string)) *Service_RetrievePAT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1271,47 +1493,56 @@ func (_c *Service_Revoke_Call) Run(run func(ctx context.Context, token string, i
 	return _c
 }
 
-func (_c *Service_Revoke_Call) Return(err error) *Service_Revoke_Call {
-	_c.Call.Return(err)
+func (_c *Service_RetrievePAT_Call) Return(pAT auth.PAT, err error) *Service_RetrievePAT_Call {
+	_c.Call.Return(pAT, err)
 	return _c
 }
 
-func (_c *Service_Revoke_Call) RunAndReturn(run func(ctx context.Context, token string, id string) error) *Service_Revoke_Call {
+func (_c *Service_RetrievePAT_Call) RunAndReturn(run func(ctx context.Context, userID string, patID string) (auth.PAT, error)) *Service_RetrievePAT_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokePATSecret provides a mock function for the type Service
-func (_mock *Service) RevokePATSecret(ctx context.Context, token string, patID string) error {
-	ret := _mock.Called(ctx, token, patID)
+// RetrievePATPolicy provides a mock function for the type Service
+func (_mock *Service) RetrievePATPolicy(ctx context.Context, token string, domainID string) (auth.PATPolicy, error) {
+	ret := _mock.Called(ctx, token, domainID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokePATSecret")
+		panic("no return value specified for RetrievePATPolicy")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, token, patID)
+	var r0 auth.PATPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.PATPolicy, error)); ok {
+		return returnFunc(ctx, token, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.PATPolicy); ok {
+		r0 = returnFunc(ctx, token, domainID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(auth.PATPolicy)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Service_RevokePATSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePATSecret'
-type Service_RevokePATSecret_Call struct {
+// Service_RetrievePATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePATPolicy'
+type Service_RetrievePATPolicy_Call struct {
 	*mock.Call
 }
 
-// RevokePATSecret is a helper method to define mock.On call
+// RetrievePATPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - token string
-//   - patID string
-func (_e *Service_Expecter) RevokePATSecret(ctx interface{}, token interface{}, patID interface{}) *Service_RevokePATSecret_Call {
-	return &Service_RevokePATSecret_Call{Call: _e.mock.On("RevokePATSecret", ctx, token, patID)}
+//   - domainID string
+func (_e *Service_Expecter) RetrievePATPolicy(ctx interface{}, token interface{}, domainID interface{}) *Service_RetrievePATPolicy_Call {
+	return &Service_RetrievePATPolicy_Call{Call: _e.mock.On("RetrievePATPolicy", ctx, token, domainID)}
 }
 
-func (_c *Service_RevokePATSecret_Call) Run(run func(ctx context.Context, token string, patID string)) *Service_RevokePATSecret_Call {
+func (_c *Service_RetrievePATPolicy_Call) Run(run func(ctx context.Context, token string, domainID string)) *Service_RetrievePATPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1334,12 +1565,507 @@ func (_c *Service_RevokePATSecret_Call) Run(run func(ctx context.Context, token
 	return _c
 }
 
-func (_c *Service_RevokePATSecret_Call) Return(err error) *Service_RevokePATSecret_Call {
-	_c.Call.Return(err)
+func (_c *Service_RetrievePATPolicy_Call) Return(pATPolicy auth.PATPolicy, err error) *Service_RetrievePATPolicy_Call {
+	_c.Call.Return(pATPolicy, err)
 	return _c
 }
 
-func (_c *Service_RevokePATSecret_Call) RunAndReturn(run func(ctx context.Context, token string, patID string) error) *Service_RevokePATSecret_Call {
+func (_c *Service_RetrievePATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, domainID string) (auth.PATPolicy, error)) *Service_RetrievePATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type Service
+func (_mock *Service) Revoke(ctx context.Context, token string, id string) (auth.Revocation, error) {
+	ret := _mock.Called(ctx, token, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 auth.Revocation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.Revocation, error)); ok {
+		return returnFunc(ctx, token, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.Revocation); ok {
+		r0 = returnFunc(ctx, token, id)
+	} else {
+		r0 = ret.Get(0).(auth.Revocation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, token, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type Service_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - id string
+func (_e *Service_Expecter) Revoke(ctx interface{}, token interface{}, id interface{}) *Service_Revoke_Call {
+	return &Service_Revoke_Call{Call: _e.mock.On("Revoke", ctx, token, id)}
+}
+
+func (_c *Service_Revoke_Call) Run(run func(ctx context.Context, token string, id string)) *Service_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_Revoke_Call) Return(revocation auth.Revocation, err error) *Service_Revoke_Call {
+	_c.Call.Return(revocation, err)
+	return _c
+}
+
+func (_c *Service_Revoke_Call) RunAndReturn(run func(ctx context.Context, token string, id string) (auth.Revocation, error)) *Service_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokePATSecret provides a mock function for the type Service
+func (_mock *Service) RevokePATSecret(ctx context.Context, token string, patID string) error {
+	ret := _mock.Called(ctx, token, patID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokePATSecret")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, token, patID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RevokePATSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePATSecret'
+type Service_RevokePATSecret_Call struct {
+	*mock.Call
+}
+
+// RevokePATSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - patID string
+func (_e *Service_Expecter) RevokePATSecret(ctx interface{}, token interface{}, patID interface{}) *Service_RevokePATSecret_Call {
+	return &Service_RevokePATSecret_Call{Call: _e.mock.On("RevokePATSecret", ctx, token, patID)}
+}
+
+func (_c *Service_RevokePATSecret_Call) Run(run func(ctx context.Context, token string, patID string)) *Service_RevokePATSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RevokePATSecret_Call) Return(err error) *Service_RevokePATSecret_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RevokePATSecret_Call) RunAndReturn(run func(ctx context.Context, token string, patID string) error) *Service_RevokePATSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type Service
+func (_mock *Service) RevokeSession(ctx context.Context, userID string, sessionID string) (auth.Revocation, error) {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 auth.Revocation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (auth.Revocation, error)); ok {
+		return returnFunc(ctx, userID, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) auth.Revocation); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Get(0).(auth.Revocation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type Service_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sessionID string
+func (_e *Service_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *Service_RevokeSession_Call {
+	return &Service_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *Service_RevokeSession_Call) Run(run func(ctx context.Context, userID string, sessionID string)) *Service_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RevokeSession_Call) Return(revocation auth.Revocation, err error) *Service_RevokeSession_Call {
+	_c.Call.Return(revocation, err)
+	return _c
+}
+
+func (_c *Service_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userID string, sessionID string) (auth.Revocation, error)) *Service_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function for the type Service
+func (_mock *Service) RevokeUserTokens(ctx context.Context, userID string) (auth.Revocation, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 auth.Revocation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (auth.Revocation, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) auth.Revocation); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(auth.Revocation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type Service_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Service_Expecter) RevokeUserTokens(ctx interface{}, userID interface{}) *Service_RevokeUserTokens_Call {
+	return &Service_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens", ctx, userID)}
+}
+
+func (_c *Service_RevokeUserTokens_Call) Run(run func(ctx context.Context, userID string)) *Service_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RevokeUserTokens_Call) Return(revocation auth.Revocation, err error) *Service_RevokeUserTokens_Call {
+	_c.Call.Return(revocation, err)
+	return _c
+}
+
+func (_c *Service_RevokeUserTokens_Call) RunAndReturn(run func(ctx context.Context, userID string) (auth.Revocation, error)) *Service_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMFAPolicy provides a mock function for the type Service
+func (_mock *Service) SetMFAPolicy(ctx context.Context, token string, policy auth.MFAPolicy) (auth.MFAPolicy, error) {
+	ret := _mock.Called(ctx, token, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMFAPolicy")
+	}
+
+	var r0 auth.MFAPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.MFAPolicy) (auth.MFAPolicy, error)); ok {
+		return returnFunc(ctx, token, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.MFAPolicy) auth.MFAPolicy); ok {
+		r0 = returnFunc(ctx, token, policy)
+	} else {
+		r0 = ret.Get(0).(auth.MFAPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.MFAPolicy) error); ok {
+		r1 = returnFunc(ctx, token, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_SetMFAPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMFAPolicy'
+type Service_SetMFAPolicy_Call struct {
+	*mock.Call
+}
+
+// SetMFAPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - policy auth.MFAPolicy
+func (_e *Service_Expecter) SetMFAPolicy(ctx interface{}, token interface{}, policy interface{}) *Service_SetMFAPolicy_Call {
+	return &Service_SetMFAPolicy_Call{Call: _e.mock.On("SetMFAPolicy", ctx, token, policy)}
+}
+
+func (_c *Service_SetMFAPolicy_Call) Run(run func(ctx context.Context, token string, policy auth.MFAPolicy)) *Service_SetMFAPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.MFAPolicy
+		if args[2] != nil {
+			arg2 = args[2].(auth.MFAPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_SetMFAPolicy_Call) Return(mFAPolicy auth.MFAPolicy, err error) *Service_SetMFAPolicy_Call {
+	_c.Call.Return(mFAPolicy, err)
+	return _c
+}
+
+func (_c *Service_SetMFAPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, policy auth.MFAPolicy) (auth.MFAPolicy, error)) *Service_SetMFAPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPATPolicy provides a mock function for the type Service
+func (_mock *Service) SetPATPolicy(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error) {
+	ret := _mock.Called(ctx, token, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPATPolicy")
+	}
+
+	var r0 auth.PATPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.PATPolicy) (auth.PATPolicy, error)); ok {
+		return returnFunc(ctx, token, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.PATPolicy) auth.PATPolicy); ok {
+		r0 = returnFunc(ctx, token, policy)
+	} else {
+		r0 = ret.Get(0).(auth.PATPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.PATPolicy) error); ok {
+		r1 = returnFunc(ctx, token, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_SetPATPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPATPolicy'
+type Service_SetPATPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPATPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - policy auth.PATPolicy
+func (_e *Service_Expecter) SetPATPolicy(ctx interface{}, token interface{}, policy interface{}) *Service_SetPATPolicy_Call {
+	return &Service_SetPATPolicy_Call{Call: _e.mock.On("SetPATPolicy", ctx, token, policy)}
+}
+
+func (_c *Service_SetPATPolicy_Call) Run(run func(ctx context.Context, token string, policy auth.PATPolicy)) *Service_SetPATPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.PATPolicy
+		if args[2] != nil {
+			arg2 = args[2].(auth.PATPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_SetPATPolicy_Call) Return(pATPolicy auth.PATPolicy, err error) *Service_SetPATPolicy_Call {
+	_c.Call.Return(pATPolicy, err)
+	return _c
+}
+
+func (_c *Service_SetPATPolicy_Call) RunAndReturn(run func(ctx context.Context, token string, policy auth.PATPolicy) (auth.PATPolicy, error)) *Service_SetPATPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePATAllowedCIDRs provides a mock function for the type Service
+func (_mock *Service) UpdatePATAllowedCIDRs(ctx context.Context, token string, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	ret := _mock.Called(ctx, token, patID, allowedCIDRs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePATAllowedCIDRs")
+	}
+
+	var r0 auth.PAT
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) (auth.PAT, error)); ok {
+		return returnFunc(ctx, token, patID, allowedCIDRs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) auth.PAT); ok {
+		r0 = returnFunc(ctx, token, patID, allowedCIDRs)
+	} else {
+		r0 = ret.Get(0).(auth.PAT)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, token, patID, allowedCIDRs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdatePATAllowedCIDRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePATAllowedCIDRs'
+type Service_UpdatePATAllowedCIDRs_Call struct {
+	*mock.Call
+}
+
+// UpdatePATAllowedCIDRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - patID string
+//   - allowedCIDRs []string
+func (_e *Service_Expecter) UpdatePATAllowedCIDRs(ctx interface{}, token interface{}, patID interface{}, allowedCIDRs interface{}) *Service_UpdatePATAllowedCIDRs_Call {
+	return &Service_UpdatePATAllowedCIDRs_Call{Call: _e.mock.On("UpdatePATAllowedCIDRs", ctx, token, patID, allowedCIDRs)}
+}
+
+func (_c *Service_UpdatePATAllowedCIDRs_Call) Run(run func(ctx context.Context, token string, patID string, allowedCIDRs []string)) *Service_UpdatePATAllowedCIDRs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdatePATAllowedCIDRs_Call) Return(pAT auth.PAT, err error) *Service_UpdatePATAllowedCIDRs_Call {
+	_c.Call.Return(pAT, err)
+	return _c
+}
+
+func (_c *Service_UpdatePATAllowedCIDRs_Call) RunAndReturn(run func(ctx context.Context, token string, patID string, allowedCIDRs []string) (auth.PAT, error)) *Service_UpdatePATAllowedCIDRs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,364 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewSessionRepository creates a new instance of SessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionRepository {
+	mock := &SessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SessionRepository is an autogenerated mock type for the SessionRepository type
+type SessionRepository struct {
+	mock.Mock
+}
+
+type SessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SessionRepository) EXPECT() *SessionRepository_Expecter {
+	return &SessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Remove provides a mock function for the type SessionRepository
+func (_mock *SessionRepository) Remove(ctx context.Context, userID string, id string) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SessionRepository_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type SessionRepository_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *SessionRepository_Expecter) Remove(ctx interface{}, userID interface{}, id interface{}) *SessionRepository_Remove_Call {
+	return &SessionRepository_Remove_Call{Call: _e.mock.On("Remove", ctx, userID, id)}
+}
+
+func (_c *SessionRepository_Remove_Call) Run(run func(ctx context.Context, userID string, id string)) *SessionRepository_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Remove_Call) Return(err error) *SessionRepository_Remove_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SessionRepository_Remove_Call) RunAndReturn(run func(ctx context.Context, userID string, id string) error) *SessionRepository_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retrieve provides a mock function for the type SessionRepository
+func (_mock *SessionRepository) Retrieve(ctx context.Context, id string) (auth.Session, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retrieve")
+	}
+
+	var r0 auth.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (auth.Session, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) auth.Session); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(auth.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SessionRepository_Retrieve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retrieve'
+type SessionRepository_Retrieve_Call struct {
+	*mock.Call
+}
+
+// Retrieve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SessionRepository_Expecter) Retrieve(ctx interface{}, id interface{}) *SessionRepository_Retrieve_Call {
+	return &SessionRepository_Retrieve_Call{Call: _e.mock.On("Retrieve", ctx, id)}
+}
+
+func (_c *SessionRepository_Retrieve_Call) Run(run func(ctx context.Context, id string)) *SessionRepository_Retrieve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Retrieve_Call) Return(session auth.Session, err error) *SessionRepository_Retrieve_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *SessionRepository_Retrieve_Call) RunAndReturn(run func(ctx context.Context, id string) (auth.Session, error)) *SessionRepository_Retrieve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveAll provides a mock function for the type SessionRepository
+func (_mock *SessionRepository) RetrieveAll(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error) {
+	ret := _mock.Called(ctx, userID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveAll")
+	}
+
+	var r0 auth.SessionsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.SessionsPageMeta) (auth.SessionsPage, error)); ok {
+		return returnFunc(ctx, userID, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, auth.SessionsPageMeta) auth.SessionsPage); ok {
+		r0 = returnFunc(ctx, userID, pm)
+	} else {
+		r0 = ret.Get(0).(auth.SessionsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, auth.SessionsPageMeta) error); ok {
+		r1 = returnFunc(ctx, userID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SessionRepository_RetrieveAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveAll'
+type SessionRepository_RetrieveAll_Call struct {
+	*mock.Call
+}
+
+// RetrieveAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pm auth.SessionsPageMeta
+func (_e *SessionRepository_Expecter) RetrieveAll(ctx interface{}, userID interface{}, pm interface{}) *SessionRepository_RetrieveAll_Call {
+	return &SessionRepository_RetrieveAll_Call{Call: _e.mock.On("RetrieveAll", ctx, userID, pm)}
+}
+
+func (_c *SessionRepository_RetrieveAll_Call) Run(run func(ctx context.Context, userID string, pm auth.SessionsPageMeta)) *SessionRepository_RetrieveAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 auth.SessionsPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(auth.SessionsPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SessionRepository_RetrieveAll_Call) Return(sessionsPage auth.SessionsPage, err error) *SessionRepository_RetrieveAll_Call {
+	_c.Call.Return(sessionsPage, err)
+	return _c
+}
+
+func (_c *SessionRepository_RetrieveAll_Call) RunAndReturn(run func(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error)) *SessionRepository_RetrieveAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function for the type SessionRepository
+func (_mock *SessionRepository) Rotate(ctx context.Context, session auth.Session, prevTokenID string) error {
+	ret := _mock.Called(ctx, session, prevTokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, prevTokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SessionRepository_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type SessionRepository_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session auth.Session
+//   - prevTokenID string
+func (_e *SessionRepository_Expecter) Rotate(ctx interface{}, session interface{}, prevTokenID interface{}) *SessionRepository_Rotate_Call {
+	return &SessionRepository_Rotate_Call{Call: _e.mock.On("Rotate", ctx, session, prevTokenID)}
+}
+
+func (_c *SessionRepository_Rotate_Call) Run(run func(ctx context.Context, session auth.Session, prevTokenID string)) *SessionRepository_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Session
+		if args[1] != nil {
+			arg1 = args[1].(auth.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Rotate_Call) Return(err error) *SessionRepository_Rotate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SessionRepository_Rotate_Call) RunAndReturn(run func(ctx context.Context, session auth.Session, prevTokenID string) error) *SessionRepository_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type SessionRepository
+func (_mock *SessionRepository) Save(ctx context.Context, session auth.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SessionRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type SessionRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session auth.Session
+func (_e *SessionRepository_Expecter) Save(ctx interface{}, session interface{}) *SessionRepository_Save_Call {
+	return &SessionRepository_Save_Call{Call: _e.mock.On("Save", ctx, session)}
+}
+
+func (_c *SessionRepository_Save_Call) Run(run func(ctx context.Context, session auth.Session)) *SessionRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Session
+		if args[1] != nil {
+			arg1 = args[1].(auth.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SessionRepository_Save_Call) Return(err error) *SessionRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SessionRepository_Save_Call) RunAndReturn(run func(ctx context.Context, session auth.Session) error) *SessionRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,226 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewSigningKeyRepository creates a new instance of SigningKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeyRepository {
+	mock := &SigningKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SigningKeyRepository is an autogenerated mock type for the SigningKeyRepository type
+type SigningKeyRepository struct {
+	mock.Mock
+}
+
+type SigningKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeyRepository) EXPECT() *SigningKeyRepository_Expecter {
+	return &SigningKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// RemoveExpired provides a mock function for the type SigningKeyRepository
+func (_mock *SigningKeyRepository) RemoveExpired(ctx context.Context, expiredBefore time.Time) error {
+	ret := _mock.Called(ctx, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExpired")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, expiredBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SigningKeyRepository_RemoveExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExpired'
+type SigningKeyRepository_RemoveExpired_Call struct {
+	*mock.Call
+}
+
+// RemoveExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore time.Time
+func (_e *SigningKeyRepository_Expecter) RemoveExpired(ctx interface{}, expiredBefore interface{}) *SigningKeyRepository_RemoveExpired_Call {
+	return &SigningKeyRepository_RemoveExpired_Call{Call: _e.mock.On("RemoveExpired", ctx, expiredBefore)}
+}
+
+func (_c *SigningKeyRepository_RemoveExpired_Call) Run(run func(ctx context.Context, expiredBefore time.Time)) *SigningKeyRepository_RemoveExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepository_RemoveExpired_Call) Return(err error) *SigningKeyRepository_RemoveExpired_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SigningKeyRepository_RemoveExpired_Call) RunAndReturn(run func(ctx context.Context, expiredBefore time.Time) error) *SigningKeyRepository_RemoveExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveAll provides a mock function for the type SigningKeyRepository
+func (_mock *SigningKeyRepository) RetrieveAll(ctx context.Context, expiresAfter time.Time) ([]auth.SigningKey, error) {
+	ret := _mock.Called(ctx, expiresAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveAll")
+	}
+
+	var r0 []auth.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]auth.SigningKey, error)); ok {
+		return returnFunc(ctx, expiresAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []auth.SigningKey); ok {
+		r0 = returnFunc(ctx, expiresAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, expiresAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SigningKeyRepository_RetrieveAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveAll'
+type SigningKeyRepository_RetrieveAll_Call struct {
+	*mock.Call
+}
+
+// RetrieveAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiresAfter time.Time
+func (_e *SigningKeyRepository_Expecter) RetrieveAll(ctx interface{}, expiresAfter interface{}) *SigningKeyRepository_RetrieveAll_Call {
+	return &SigningKeyRepository_RetrieveAll_Call{Call: _e.mock.On("RetrieveAll", ctx, expiresAfter)}
+}
+
+func (_c *SigningKeyRepository_RetrieveAll_Call) Run(run func(ctx context.Context, expiresAfter time.Time)) *SigningKeyRepository_RetrieveAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepository_RetrieveAll_Call) Return(signingKeys []auth.SigningKey, err error) *SigningKeyRepository_RetrieveAll_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *SigningKeyRepository_RetrieveAll_Call) RunAndReturn(run func(ctx context.Context, expiresAfter time.Time) ([]auth.SigningKey, error)) *SigningKeyRepository_RetrieveAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type SigningKeyRepository
+func (_mock *SigningKeyRepository) Save(ctx context.Context, key auth.SigningKey) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.SigningKey) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SigningKeyRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type SigningKeyRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key auth.SigningKey
+func (_e *SigningKeyRepository_Expecter) Save(ctx interface{}, key interface{}) *SigningKeyRepository_Save_Call {
+	return &SigningKeyRepository_Save_Call{Call: _e.mock.On("Save", ctx, key)}
+}
+
+func (_c *SigningKeyRepository_Save_Call) Run(run func(ctx context.Context, key auth.SigningKey)) *SigningKeyRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.SigningKey
+		if args[1] != nil {
+			arg1 = args[1].(auth.SigningKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepository_Save_Call) Return(err error) *SigningKeyRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *SigningKeyRepository_Save_Call) RunAndReturn(run func(ctx context.Context, key auth.SigningKey) error) *SigningKeyRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -126,6 +126,172 @@ func (_c *TokenServiceClient_Issue_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// ListRevocations provides a mock function for the type TokenServiceClient
+func (_mock *TokenServiceClient) ListRevocations(ctx context.Context, in *v1.ListRevocationsReq, opts ...grpc.CallOption) (*v1.ListRevocationsRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevocations")
+	}
+
+	var r0 *v1.ListRevocationsRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ListRevocationsReq, ...grpc.CallOption) (*v1.ListRevocationsRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ListRevocationsReq, ...grpc.CallOption) *v1.ListRevocationsRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListRevocationsRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ListRevocationsReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenServiceClient_ListRevocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevocations'
+type TokenServiceClient_ListRevocations_Call struct {
+	*mock.Call
+}
+
+// ListRevocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListRevocationsReq
+//   - opts ...grpc.CallOption
+func (_e *TokenServiceClient_Expecter) ListRevocations(ctx interface{}, in interface{}, opts ...interface{}) *TokenServiceClient_ListRevocations_Call {
+	return &TokenServiceClient_ListRevocations_Call{Call: _e.mock.On("ListRevocations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TokenServiceClient_ListRevocations_Call) Run(run func(ctx context.Context, in *v1.ListRevocationsReq, opts ...grpc.CallOption)) *TokenServiceClient_ListRevocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ListRevocationsReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ListRevocationsReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceClient_ListRevocations_Call) Return(listRevocationsRes *v1.ListRevocationsRes, err error) *TokenServiceClient_ListRevocations_Call {
+	_c.Call.Return(listRevocationsRes, err)
+	return _c
+}
+
+func (_c *TokenServiceClient_ListRevocations_Call) RunAndReturn(run func(ctx context.Context, in *v1.ListRevocationsReq, opts ...grpc.CallOption) (*v1.ListRevocationsRes, error)) *TokenServiceClient_ListRevocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type TokenServiceClient
+func (_mock *TokenServiceClient) ListSessions(ctx context.Context, in *v1.ListSessionsReq, opts ...grpc.CallOption) (*v1.ListSessionsRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *v1.ListSessionsRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ListSessionsReq, ...grpc.CallOption) (*v1.ListSessionsRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ListSessionsReq, ...grpc.CallOption) *v1.ListSessionsRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ListSessionsRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ListSessionsReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenServiceClient_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type TokenServiceClient_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ListSessionsReq
+//   - opts ...grpc.CallOption
+func (_e *TokenServiceClient_Expecter) ListSessions(ctx interface{}, in interface{}, opts ...interface{}) *TokenServiceClient_ListSessions_Call {
+	return &TokenServiceClient_ListSessions_Call{Call: _e.mock.On("ListSessions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TokenServiceClient_ListSessions_Call) Run(run func(ctx context.Context, in *v1.ListSessionsReq, opts ...grpc.CallOption)) *TokenServiceClient_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ListSessionsReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ListSessionsReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceClient_ListSessions_Call) Return(listSessionsRes *v1.ListSessionsRes, err error) *TokenServiceClient_ListSessions_Call {
+	_c.Call.Return(listSessionsRes, err)
+	return _c
+}
+
+func (_c *TokenServiceClient_ListSessions_Call) RunAndReturn(run func(ctx context.Context, in *v1.ListSessionsReq, opts ...grpc.CallOption) (*v1.ListSessionsRes, error)) *TokenServiceClient_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Refresh provides a mock function for the type TokenServiceClient
 func (_mock *TokenServiceClient) Refresh(ctx context.Context, in *v1.RefreshReq, opts ...grpc.CallOption) (*v1.Token, error) {
 	var tmpRet mock.Arguments
@@ -208,3 +374,169 @@ func (_c *TokenServiceClient_Refresh_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeSession provides a mock function for the type TokenServiceClient
+func (_mock *TokenServiceClient) RevokeSession(ctx context.Context, in *v1.RevokeSessionReq, opts ...grpc.CallOption) (*v1.RevokeSessionRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *v1.RevokeSessionRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RevokeSessionReq, ...grpc.CallOption) (*v1.RevokeSessionRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RevokeSessionReq, ...grpc.CallOption) *v1.RevokeSessionRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RevokeSessionRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RevokeSessionReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenServiceClient_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type TokenServiceClient_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RevokeSessionReq
+//   - opts ...grpc.CallOption
+func (_e *TokenServiceClient_Expecter) RevokeSession(ctx interface{}, in interface{}, opts ...interface{}) *TokenServiceClient_RevokeSession_Call {
+	return &TokenServiceClient_RevokeSession_Call{Call: _e.mock.On("RevokeSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TokenServiceClient_RevokeSession_Call) Run(run func(ctx context.Context, in *v1.RevokeSessionReq, opts ...grpc.CallOption)) *TokenServiceClient_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.RevokeSessionReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.RevokeSessionReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceClient_RevokeSession_Call) Return(revokeSessionRes *v1.RevokeSessionRes, err error) *TokenServiceClient_RevokeSession_Call {
+	_c.Call.Return(revokeSessionRes, err)
+	return _c
+}
+
+func (_c *TokenServiceClient_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, in *v1.RevokeSessionReq, opts ...grpc.CallOption) (*v1.RevokeSessionRes, error)) *TokenServiceClient_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokens provides a mock function for the type TokenServiceClient
+func (_mock *TokenServiceClient) RevokeUserTokens(ctx context.Context, in *v1.RevokeUserTokensReq, opts ...grpc.CallOption) (*v1.RevokeUserTokensRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokens")
+	}
+
+	var r0 *v1.RevokeUserTokensRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RevokeUserTokensReq, ...grpc.CallOption) (*v1.RevokeUserTokensRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RevokeUserTokensReq, ...grpc.CallOption) *v1.RevokeUserTokensRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RevokeUserTokensRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RevokeUserTokensReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenServiceClient_RevokeUserTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokens'
+type TokenServiceClient_RevokeUserTokens_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RevokeUserTokensReq
+//   - opts ...grpc.CallOption
+func (_e *TokenServiceClient_Expecter) RevokeUserTokens(ctx interface{}, in interface{}, opts ...interface{}) *TokenServiceClient_RevokeUserTokens_Call {
+	return &TokenServiceClient_RevokeUserTokens_Call{Call: _e.mock.On("RevokeUserTokens",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TokenServiceClient_RevokeUserTokens_Call) Run(run func(ctx context.Context, in *v1.RevokeUserTokensReq, opts ...grpc.CallOption)) *TokenServiceClient_RevokeUserTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.RevokeUserTokensReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.RevokeUserTokensReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenServiceClient_RevokeUserTokens_Call) Return(revokeUserTokensRes *v1.RevokeUserTokensRes, err error) *TokenServiceClient_RevokeUserTokens_Call {
+	_c.Call.Return(revokeUserTokensRes, err)
+	return _c
+}
+
+func (_c *TokenServiceClient_RevokeUserTokens_Call) RunAndReturn(run func(ctx context.Context, in *v1.RevokeUserTokensReq, opts ...grpc.CallOption) (*v1.RevokeUserTokensRes, error)) *TokenServiceClient_RevokeUserTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
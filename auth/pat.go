@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,9 @@ import (
 const (
 	AnyIDs              = "*"
 	RoleOperationPrefix = "role_"
+
+	// MaxAllowedCIDRs is the maximum number of CIDRs in the PAT allowlist.
+	MaxAllowedCIDRs = 32
 )
 
 const (
@@ -44,7 +49,12 @@ const (
 	OpMessageSubscribe = "message_subscribe"
 )
 
-var errInvalidEntityOp = errors.NewRequestError("operation not valid for entity type")
+var (
+	errInvalidEntityOp = errors.NewRequestError("operation not valid for entity type")
+
+	// ErrInvalidCIDR indicates that the PAT allowlist contains invalid CIDR.
+	ErrInvalidCIDR = errors.New("invalid CIDR in PAT allowlist")
+)
 
 type Operation = permissions.Operation
 
@@ -282,19 +292,34 @@ func (s *Scope) Validate() error {
 
 // PAT represents Personal Access Token.
 type PAT struct {
-	ID          string    `json:"id,omitempty"`
-	User        string    `json:"user_id,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Description string    `json:"description,omitempty"`
-	Secret      string    `json:"secret,omitempty"`
-	Role        Role      `json:"role,omitempty"`
-	IssuedAt    time.Time `json:"issued_at,omitempty"`
-	ExpiresAt   time.Time `json:"expires_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
-	LastUsedAt  time.Time `json:"last_used_at,omitempty"`
-	Revoked     bool      `json:"revoked,omitempty"`
-	RevokedAt   time.Time `json:"revoked_at,omitempty"`
-	Status      Status    `json:"status,omitempty"`
+	ID                string    `json:"id,omitempty"`
+	User              string    `json:"user_id,omitempty"`
+	Name              string    `json:"name,omitempty"`
+	Description       string    `json:"description,omitempty"`
+	AllowedCIDRs      []string  `json:"allowed_cidrs,omitempty"`
+	MFA               bool      `json:"mfa,omitempty"` // the PAT was created by the session which passed the multi-factor authentication
+	Secret            string    `json:"secret,omitempty"`
+	Role              Role      `json:"role,omitempty"`
+	IssuedAt          time.Time `json:"issued_at,omitempty"`
+	ExpiresAt         time.Time `json:"expires_at,omitempty"`
+	UpdatedAt         time.Time `json:"updated_at,omitempty"`
+	LastUsedAt        time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP        string    `json:"last_used_ip,omitempty"`
+	LastUsedUserAgent string    `json:"last_used_user_agent,omitempty"`
+	UsageCount        uint64    `json:"usage_count"`
+	Revoked           bool      `json:"revoked,omitempty"`
+	RevokedAt         time.Time `json:"revoked_at,omitempty"`
+	Status            Status    `json:"status,omitempty"`
+}
+
+// PATUsage represents the PAT usage accumulated since the last write.
+type PATUsage struct {
+	PatID      string
+	UserID     string
+	LastUsedAt time.Time
+	IP         string
+	UserAgent  string
+	Count      uint64
 }
 
 type PATSPageMeta struct {
@@ -303,6 +328,9 @@ type PATSPageMeta struct {
 	Name   string `json:"name"`
 	ID     string `json:"id"`
 	Status Status `json:"status"`
+	// UnusedDays filters the PATs which are not used for the given number
+	// of days. PATs which are never used are filtered by their issue time.
+	UnusedDays uint64 `json:"unused_days"`
 }
 type PATSPage struct {
 	Total  uint64 `json:"total"`
@@ -347,10 +375,53 @@ func (pat *PAT) Validate() error {
 	return nil
 }
 
+// AllowsIP reports whether the PAT may be used from the given client IP.
+// PAT without allowlist may be used from any IP, while PAT with allowlist
+// can't be used if the client IP is unknown.
+func (pat PAT) AllowsIP(ip string) bool {
+	if len(pat.AllowedCIDRs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, cidr := range pat.AllowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCIDRs validates the PAT allowlist and returns its canonical form,
+// with the host bits masked and the duplicates removed.
+func ParseCIDRs(cidrs []string) ([]string, error) {
+	if len(cidrs) > MaxAllowedCIDRs {
+		return nil, errors.Wrap(ErrInvalidCIDR, fmt.Errorf("allowlist exceeds %d CIDRs", MaxAllowedCIDRs))
+	}
+	parsed := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidCIDR, err)
+		}
+		c := prefix.Masked().String()
+		if !slices.Contains(parsed, c) {
+			parsed = append(parsed, c)
+		}
+	}
+
+	return parsed, nil
+}
+
 // PATS specifies function which are required for Personal access Token implementation.
 type PATS interface {
 	// Create function creates new PAT for given valid inputs.
-	CreatePAT(ctx context.Context, token, name, description string, duration time.Duration) (PAT, error)
+	CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, allowedCIDRs []string, scopes []Scope) (PAT, error)
 
 	// UpdateName function updates the name for the given PAT ID.
 	UpdatePATName(ctx context.Context, token, patID, name string) (PAT, error)
@@ -358,6 +429,9 @@ type PATS interface {
 	// UpdateDescription function updates the description for the given PAT ID.
 	UpdatePATDescription(ctx context.Context, token, patID, description string) (PAT, error)
 
+	// UpdatePATAllowedCIDRs function updates the CIDR allowlist for the given PAT ID.
+	UpdatePATAllowedCIDRs(ctx context.Context, token, patID string, allowedCIDRs []string) (PAT, error)
+
 	// Retrieve function retrieves the PAT for given ID.
 	RetrievePAT(ctx context.Context, userID string, patID string) (PAT, error)
 
@@ -393,6 +467,15 @@ type PATS interface {
 
 	// AuthorizePAT function will valid the secret and check the given scope exists.
 	AuthorizePAT(ctx context.Context, userID, patID string, entityType EntityType, domainID string, operation string, entityID string) error
+
+	// SetPATPolicy function creates or replaces the domain PAT policy.
+	SetPATPolicy(ctx context.Context, token string, policy PATPolicy) (PATPolicy, error)
+
+	// RetrievePATPolicy function retrieves the domain PAT policy.
+	RetrievePATPolicy(ctx context.Context, token, domainID string) (PATPolicy, error)
+
+	// RemovePATPolicy function removes the domain PAT policy.
+	RemovePATPolicy(ctx context.Context, token, domainID string) error
 }
 
 // PATSRepository specifies PATS persistence API.
@@ -406,8 +489,9 @@ type PATSRepository interface {
 	// RetrieveScope retrieves PAT scopes by its unique identifier.
 	RetrieveScope(ctx context.Context, pm ScopesPageMeta) (scopes ScopesPage, err error)
 
-	// RetrieveSecretAndRevokeStatus retrieves secret and revoke status of PAT by its unique identifier.
-	RetrieveSecretAndRevokeStatus(ctx context.Context, userID, patID string) (string, bool, bool, error)
+	// RetrieveSecretAndRevokeStatus retrieves secret, revoke and expiry status
+	// and CIDR allowlist of PAT by its unique identifier.
+	RetrieveSecretAndRevokeStatus(ctx context.Context, userID, patID string) (string, bool, bool, []string, error)
 
 	// UpdateName updates the name of a PAT.
 	UpdateName(ctx context.Context, userID, patID, name string) (PAT, error)
@@ -415,6 +499,9 @@ type PATSRepository interface {
 	// UpdateDescription updates the description of a PAT.
 	UpdateDescription(ctx context.Context, userID, patID, description string) (PAT, error)
 
+	// UpdateAllowedCIDRs updates the CIDR allowlist of a PAT.
+	UpdateAllowedCIDRs(ctx context.Context, userID, patID string, allowedCIDRs []string) (PAT, error)
+
 	// UpdateTokenHash updates the token hash of a PAT.
 	UpdateTokenHash(ctx context.Context, userID, patID, tokenHash string, expiryAt time.Time) (PAT, error)
 
@@ -440,6 +527,26 @@ type PATSRepository interface {
 	CheckScope(ctx context.Context, userID, patID string, entityType EntityType, domainID string, operation string, entityID string) error
 
 	RemoveAllScope(ctx context.Context, patID string) error
+
+	// UpdateUsage adds the accumulated usage to the PATs usage counters
+	// and updates their last use.
+	UpdateUsage(ctx context.Context, usages []PATUsage) error
+
+	// SavePolicy creates or replaces the domain PAT policy.
+	SavePolicy(ctx context.Context, policy PATPolicy) error
+
+	// RetrievePolicies retrieves the PAT policies of the given domains.
+	// Domains without PAT policy are omitted.
+	RetrievePolicies(ctx context.Context, domainIDs ...string) ([]PATPolicy, error)
+
+	// RemovePolicy removes the domain PAT policy.
+	RemovePolicy(ctx context.Context, domainID string) error
+}
+
+// PATUsageTracker records the usage of authenticated PATs.
+type PATUsageTracker interface {
+	// Track records a single use of the PAT. It does not block.
+	Track(usage PATUsage)
 }
 
 type Cache interface {
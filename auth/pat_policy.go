@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var (
+	// ErrPATPolicyViolation indicates that the PAT violates the domain PAT policy.
+	ErrPATPolicyViolation = errors.New("PAT violates domain policy")
+
+	errNegativeMaxDuration = errors.New("max duration must not be negative")
+)
+
+// PATPolicy represents the domain policy which constrains the lifetime and
+// the scopes of the PATs used in the domain.
+type PATPolicy struct {
+	DomainID string `json:"domain_id"`
+	// MaxDuration caps the validity of the PAT secret. Zero means no cap.
+	MaxDuration time.Duration `json:"max_duration"`
+	// AllowedScopes lists the scopes PATs may hold in the domain. Empty list
+	// allows any scope.
+	AllowedScopes []ScopeRule `json:"allowed_scopes"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	UpdatedBy     string      `json:"updated_by"`
+}
+
+// ScopeRule describes the scopes PATs may hold in the domain. The rule with
+// "*" entity ID allows the scope for any entity, including the wildcard scope,
+// while the rule with specific entity ID allows only the scope for that entity.
+type ScopeRule struct {
+	EntityType EntityType `json:"entity_type"`
+	Operation  string     `json:"operation"`
+	EntityID   string     `json:"entity_id"`
+}
+
+// UnmarshalJSON normalizes the rule operation the same way as the scope one.
+func (r *ScopeRule) UnmarshalJSON(data []byte) error {
+	var s Scope
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	r.EntityType = s.EntityType
+	r.Operation = s.Operation
+	r.EntityID = s.EntityID
+
+	return nil
+}
+
+func (r ScopeRule) allows(s Scope) bool {
+	rule := Scope{EntityType: r.EntityType, Operation: r.Operation, EntityID: r.EntityID}
+	return rule.Authorized(s.EntityType, s.DomainID, s.Operation, s.EntityID)
+}
+
+// Validate checks if the PAT policy has valid fields.
+func (p PATPolicy) Validate() error {
+	if p.DomainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if p.MaxDuration < 0 {
+		return errNegativeMaxDuration
+	}
+	for _, r := range p.AllowedScopes {
+		if r.EntityID == "" {
+			return apiutil.ErrMissingEntityID
+		}
+		if !IsValidOperationForEntity(r.EntityType, r.Operation) {
+			return errInvalidEntityOp
+		}
+	}
+
+	return nil
+}
+
+// Check returns an error if the PAT secret valid for the given duration, or
+// any of the given scopes which belong to the policy domain, violate the policy.
+func (p PATPolicy) Check(duration time.Duration, scopes []Scope) error {
+	if p.MaxDuration > 0 && duration > p.MaxDuration {
+		return errors.Wrap(ErrPATPolicyViolation, fmt.Errorf("duration %s exceeds maximum %s of domain %s", duration, p.MaxDuration, p.DomainID))
+	}
+	if len(p.AllowedScopes) == 0 {
+		return nil
+	}
+	for _, s := range scopes {
+		if s.DomainID != p.DomainID {
+			continue
+		}
+		if !slices.ContainsFunc(p.AllowedScopes, func(r ScopeRule) bool { return r.allows(s) }) {
+			return errors.Wrap(ErrPATPolicyViolation, fmt.Errorf("scope %s %s on %s is not allowed in domain %s", s.EntityType, s.Operation, s.EntityID, p.DomainID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeRuleUnmarshalJSON(t *testing.T) {
+	var rule auth.ScopeRule
+	err := json.Unmarshal([]byte(`{"entity_type":"clients","operation":"create","entity_id":"*"}`), &rule)
+	assert.NoError(t, err, "UnmarshalJSON() should not return error")
+	assert.Equal(t, auth.ScopeRule{EntityType: auth.ClientsType, Operation: auth.OpCreateClients, EntityID: auth.AnyIDs}, rule)
+}
+
+func TestPATPolicyValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		policy auth.PATPolicy
+		err    error
+	}{
+		{
+			desc: "Valid policy",
+			policy: auth.PATPolicy{
+				DomainID:    "domain",
+				MaxDuration: 24 * time.Hour,
+				AllowedScopes: []auth.ScopeRule{
+					{EntityType: auth.MessagesType, Operation: auth.OpMessagePublish, EntityID: auth.AnyIDs},
+				},
+			},
+		},
+		{
+			desc:   "Valid policy without constraints",
+			policy: auth.PATPolicy{DomainID: "domain"},
+		},
+		{
+			desc:   "Missing domain ID",
+			policy: auth.PATPolicy{MaxDuration: time.Hour},
+			err:    apiutil.ErrMissingDomainID,
+		},
+		{
+			desc:   "Negative max duration",
+			policy: auth.PATPolicy{DomainID: "domain", MaxDuration: -time.Hour},
+			err:    errors.New("max duration must not be negative"),
+		},
+		{
+			desc: "Rule without entity ID",
+			policy: auth.PATPolicy{
+				DomainID:      "domain",
+				AllowedScopes: []auth.ScopeRule{{EntityType: auth.ClientsType, Operation: "view"}},
+			},
+			err: apiutil.ErrMissingEntityID,
+		},
+		{
+			desc: "Rule with invalid operation",
+			policy: auth.PATPolicy{
+				DomainID:      "domain",
+				AllowedScopes: []auth.ScopeRule{{EntityType: auth.MessagesType, Operation: "delete", EntityID: auth.AnyIDs}},
+			},
+			err: errors.New("operation not valid for entity type"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assert.True(t, errors.Contains(err, tc.err), "Validate() expected error %v got %v", tc.err, err)
+		})
+	}
+}
+
+func TestPATPolicyCheck(t *testing.T) {
+	policy := auth.PATPolicy{
+		DomainID:    "domain",
+		MaxDuration: 30 * 24 * time.Hour,
+		AllowedScopes: []auth.ScopeRule{
+			{EntityType: auth.MessagesType, Operation: auth.OpMessagePublish, EntityID: auth.AnyIDs},
+			{EntityType: auth.ClientsType, Operation: "view", EntityID: "client"},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		policy   auth.PATPolicy
+		duration time.Duration
+		scopes   []auth.Scope
+		err      error
+	}{
+		{
+			desc:     "Allowed duration and scopes",
+			policy:   policy,
+			duration: 24 * time.Hour,
+			scopes: []auth.Scope{
+				{DomainID: "domain", EntityType: auth.MessagesType, Operation: auth.OpMessagePublish, EntityID: auth.AnyIDs},
+				{DomainID: "domain", EntityType: auth.ClientsType, Operation: "view", EntityID: "client"},
+			},
+		},
+		{
+			desc:     "Duration exceeding maximum",
+			policy:   policy,
+			duration: 90 * 24 * time.Hour,
+			err:      auth.ErrPATPolicyViolation,
+		},
+		{
+			desc:     "Scope not allowed",
+			policy:   policy,
+			duration: time.Hour,
+			scopes:   []auth.Scope{{DomainID: "domain", EntityType: auth.ClientsType, Operation: "delete", EntityID: "client"}},
+			err:      auth.ErrPATPolicyViolation,
+		},
+		{
+			desc:     "Wildcard scope allowed only for specific entity",
+			policy:   policy,
+			duration: time.Hour,
+			scopes:   []auth.Scope{{DomainID: "domain", EntityType: auth.ClientsType, Operation: "view", EntityID: auth.AnyIDs}},
+			err:      auth.ErrPATPolicyViolation,
+		},
+		{
+			desc:     "Scope in other domain",
+			policy:   policy,
+			duration: time.Hour,
+			scopes:   []auth.Scope{{DomainID: "other", EntityType: auth.ClientsType, Operation: "delete", EntityID: auth.AnyIDs}},
+		},
+		{
+			desc:     "Policy without constraints",
+			policy:   auth.PATPolicy{DomainID: "domain"},
+			duration: 365 * 24 * time.Hour,
+			scopes:   []auth.Scope{{DomainID: "domain", EntityType: auth.ClientsType, Operation: "delete", EntityID: auth.AnyIDs}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Check(tc.duration, tc.scopes)
+			assert.True(t, errors.Contains(err, tc.err), "Check() expected error %v got %v", tc.err, err)
+		})
+	}
+}
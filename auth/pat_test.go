@@ -4,9 +4,11 @@
 package auth_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -255,3 +257,113 @@ func TestEntityTypeUnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestPATAllowsIP(t *testing.T) {
+	cases := []struct {
+		desc         string
+		allowedCIDRs []string
+		ip           string
+		expected     bool
+	}{
+		{
+			desc:     "Allow any IP without allowlist",
+			ip:       "203.0.113.7",
+			expected: true,
+		},
+		{
+			desc:     "Allow unknown IP without allowlist",
+			expected: true,
+		},
+		{
+			desc:         "Allow IPv4 in allowlist",
+			allowedCIDRs: []string{"10.0.0.0/8", "192.0.2.0/24"},
+			ip:           "192.0.2.15",
+			expected:     true,
+		},
+		{
+			desc:         "Allow IPv4-mapped IPv6 in allowlist",
+			allowedCIDRs: []string{"192.0.2.0/24"},
+			ip:           "::ffff:192.0.2.15",
+			expected:     true,
+		},
+		{
+			desc:         "Allow IPv6 in allowlist",
+			allowedCIDRs: []string{"2001:db8::/32"},
+			ip:           "2001:db8::1",
+			expected:     true,
+		},
+		{
+			desc:         "Reject IP outside allowlist",
+			allowedCIDRs: []string{"10.0.0.0/8"},
+			ip:           "192.0.2.15",
+			expected:     false,
+		},
+		{
+			desc:         "Reject unknown IP with allowlist",
+			allowedCIDRs: []string{"10.0.0.0/8"},
+			expected:     false,
+		},
+		{
+			desc:         "Reject malformed IP with allowlist",
+			allowedCIDRs: []string{"10.0.0.0/8"},
+			ip:           "10.0.0.1:8080",
+			expected:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			pat := auth.PAT{AllowedCIDRs: tc.allowedCIDRs}
+			assert.Equal(t, tc.expected, pat.AllowsIP(tc.ip), "AllowsIP(%s) expected %v", tc.ip, tc.expected)
+		})
+	}
+}
+
+func TestParseCIDRs(t *testing.T) {
+	tooMany := make([]string, auth.MaxAllowedCIDRs+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("10.0.%d.0/24", i)
+	}
+
+	cases := []struct {
+		desc     string
+		cidrs    []string
+		expected []string
+		err      error
+	}{
+		{
+			desc:     "Parse empty allowlist",
+			expected: []string{},
+		},
+		{
+			desc:     "Parse and normalize allowlist",
+			cidrs:    []string{" 192.0.2.15/24", "2001:db8::1/32", "192.0.2.0/24"},
+			expected: []string{"192.0.2.0/24", "2001:db8::/32"},
+		},
+		{
+			desc:  "Parse IP without prefix length",
+			cidrs: []string{"192.0.2.15"},
+			err:   auth.ErrInvalidCIDR,
+		},
+		{
+			desc:  "Parse malformed CIDR",
+			cidrs: []string{"192.0.2.0/33"},
+			err:   auth.ErrInvalidCIDR,
+		},
+		{
+			desc:  "Parse too many CIDRs",
+			cidrs: tooMany,
+			err:   auth.ErrInvalidCIDR,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cidrs, err := auth.ParseCIDRs(tc.cidrs)
+			assert.True(t, errors.Contains(err, tc.err), "ParseCIDRs() expected error %v got %v", tc.err, err)
+			if tc.err == nil {
+				assert.Equal(t, tc.expected, cidrs, "ParseCIDRs() = %v, expected %v", cidrs, tc.expected)
+			}
+		})
+	}
+}
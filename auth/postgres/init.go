@@ -134,6 +134,108 @@ func Migration() *migrate.MemoryMigrationSource {
 					`ALTER TABLE pat_scopes RENAME COLUMN domain_id TO optional_domain_id;`,
 				},
 			},
+			{
+				Id: "auth_8",
+				Up: []string{
+					`ALTER TABLE pats ADD COLUMN IF NOT EXISTS last_used_ip VARCHAR(45);`,
+					`ALTER TABLE pats ADD COLUMN IF NOT EXISTS last_used_user_agent VARCHAR(512);`,
+					`ALTER TABLE pats ADD COLUMN IF NOT EXISTS usage_count BIGINT NOT NULL DEFAULT 0;`,
+					`CREATE INDEX IF NOT EXISTS idx_pats_user_last_used ON pats (user_id, last_used_at);`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_pats_user_last_used;`,
+					`ALTER TABLE pats DROP COLUMN IF EXISTS usage_count;`,
+					`ALTER TABLE pats DROP COLUMN IF EXISTS last_used_user_agent;`,
+					`ALTER TABLE pats DROP COLUMN IF EXISTS last_used_ip;`,
+				},
+			},
+			{
+				Id: "auth_9",
+				Up: []string{
+					`ALTER TABLE pats ADD COLUMN IF NOT EXISTS allowed_cidrs TEXT[];`,
+					`CREATE TABLE IF NOT EXISTS pat_policies (
+						domain_id		VARCHAR(36) PRIMARY KEY,
+						max_duration	BIGINT NOT NULL DEFAULT 0,
+						allowed_scopes	JSONB,
+						updated_at		TIMESTAMPTZ,
+						updated_by		VARCHAR(254)
+					);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS pat_policies;`,
+					`ALTER TABLE pats DROP COLUMN IF EXISTS allowed_cidrs;`,
+				},
+			},
+			{
+				Id: "auth_10",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS sessions (
+						id				VARCHAR(36) PRIMARY KEY,
+						user_id			VARCHAR(36) NOT NULL,
+						token_id		VARCHAR(36) NOT NULL,
+						ip				VARCHAR(45),
+						user_agent		TEXT,
+						issued_at		TIMESTAMPTZ NOT NULL,
+						refreshed_at	TIMESTAMPTZ,
+						expires_at		TIMESTAMPTZ NOT NULL
+					);`,
+					`CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS sessions;`,
+				},
+			},
+			{
+				Id: "auth_11",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS mfa_policies (
+						domain_id		VARCHAR(36) PRIMARY KEY,
+						required		BOOLEAN NOT NULL DEFAULT FALSE,
+						updated_at		TIMESTAMPTZ,
+						updated_by		VARCHAR(254)
+					);`,
+					`ALTER TABLE pats ADD COLUMN IF NOT EXISTS mfa BOOLEAN NOT NULL DEFAULT FALSE;`,
+				},
+				Down: []string{
+					`ALTER TABLE pats DROP COLUMN IF EXISTS mfa;`,
+					`DROP TABLE IF EXISTS mfa_policies;`,
+				},
+			},
+			{
+				Id: "auth_12",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS signing_keys (
+						id				VARCHAR(36) PRIMARY KEY,
+						private_key		BYTEA NOT NULL,
+						created_at		TIMESTAMPTZ NOT NULL,
+						activates_at	TIMESTAMPTZ NOT NULL UNIQUE,
+						expires_at		TIMESTAMPTZ NOT NULL
+					);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS signing_keys;`,
+				},
+			},
+			{
+				// Only one of the token ID, session ID and subject is set,
+				// depending on whether the single token, the tokens of the
+				// login session or all the subject tokens are revoked.
+				Id: "auth_13",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS revocations (
+						token_id		VARCHAR(36) NOT NULL DEFAULT '',
+						session_id		VARCHAR(36) NOT NULL DEFAULT '',
+						subject			VARCHAR(36) NOT NULL DEFAULT '',
+						not_before		TIMESTAMPTZ,
+						expires_at		TIMESTAMPTZ NOT NULL,
+						PRIMARY KEY (token_id, session_id, subject)
+					);`,
+					`CREATE INDEX IF NOT EXISTS idx_revocations_subject ON revocations (subject);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS revocations;`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+var _ auth.MFAPolicyRepository = (*mfaPolicyRepo)(nil)
+
+type mfaPolicyRepo struct {
+	db    postgres.Database
+	cache auth.MFAPolicyCache
+}
+
+// NewMFAPolicyRepo instantiates a PostgreSQL implementation of domain MFA
+// policy repository, which caches the retrieved policies.
+func NewMFAPolicyRepo(db postgres.Database, cache auth.MFAPolicyCache) auth.MFAPolicyRepository {
+	return &mfaPolicyRepo{
+		db:    db,
+		cache: cache,
+	}
+}
+
+func (mr *mfaPolicyRepo) Save(ctx context.Context, policy auth.MFAPolicy) error {
+	q := `INSERT INTO mfa_policies (domain_id, required, updated_at, updated_by)
+		VALUES (:domain_id, :required, :updated_at, :updated_by)
+		ON CONFLICT (domain_id) DO UPDATE SET required = EXCLUDED.required,
+		updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`
+
+	if _, err := mr.db.NamedExecContext(ctx, q, toDBMFAPolicy(policy)); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	if err := mr.cache.Save(ctx, policy.DomainID, &policy); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (mr *mfaPolicyRepo) Retrieve(ctx context.Context, domainID string) (auth.MFAPolicy, error) {
+	if policy, err := mr.cache.Retrieve(ctx, domainID); err == nil {
+		if policy == nil {
+			return auth.MFAPolicy{}, repoerr.ErrNotFound
+		}
+		return *policy, nil
+	}
+
+	q := `SELECT domain_id, required, updated_at, updated_by FROM mfa_policies WHERE domain_id = $1`
+
+	var dbp dbMFAPolicy
+	if err := mr.db.QueryRowxContext(ctx, q, domainID).StructScan(&dbp); err != nil {
+		if err != sql.ErrNoRows {
+			return auth.MFAPolicy{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		// Most of the domains don't have the policy, so its absence is
+		// cached as well.
+		if err := mr.cache.Save(ctx, domainID, nil); err != nil {
+			return auth.MFAPolicy{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		return auth.MFAPolicy{}, repoerr.ErrNotFound
+	}
+
+	policy := toMFAPolicy(dbp)
+	if err := mr.cache.Save(ctx, domainID, &policy); err != nil {
+		return auth.MFAPolicy{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return policy, nil
+}
+
+type dbMFAPolicy struct {
+	DomainID  string         `db:"domain_id"`
+	Required  bool           `db:"required"`
+	UpdatedAt sql.NullTime   `db:"updated_at"`
+	UpdatedBy sql.NullString `db:"updated_by"`
+}
+
+func toDBMFAPolicy(p auth.MFAPolicy) dbMFAPolicy {
+	var updatedAt sql.NullTime
+	if !p.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: p.UpdatedAt, Valid: true}
+	}
+	var updatedBy sql.NullString
+	if p.UpdatedBy != "" {
+		updatedBy = sql.NullString{String: p.UpdatedBy, Valid: true}
+	}
+
+	return dbMFAPolicy{
+		DomainID:  p.DomainID,
+		Required:  p.Required,
+		UpdatedAt: updatedAt,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func toMFAPolicy(dbp dbMFAPolicy) auth.MFAPolicy {
+	var updatedAt time.Time
+	if dbp.UpdatedAt.Valid {
+		updatedAt = dbp.UpdatedAt.Time.UTC()
+	}
+
+	return auth.MFAPolicy{
+		DomainID:  dbp.DomainID,
+		Required:  dbp.Required,
+		UpdatedAt: updatedAt,
+		UpdatedBy: dbp.UpdatedBy.String,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/mocks"
+	"github.com/absmach/supermq/auth/postgres"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFAPolicySave(t *testing.T) {
+	cache := new(mocks.MFAPolicyCache)
+	cache.On("Retrieve", mock.Anything, mock.Anything).Return(nil, repoerr.ErrNotFound)
+	cache.On("Save", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	repo := postgres.NewMFAPolicyRepo(database, cache)
+
+	policy := auth.MFAPolicy{
+		DomainID:  generateID(t),
+		Required:  true,
+		UpdatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		UpdatedBy: generateID(t),
+	}
+	updated := policy
+	updated.Required = false
+	updated.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc   string
+		policy auth.MFAPolicy
+	}{
+		{
+			desc:   "save a new policy",
+			policy: policy,
+		},
+		{
+			desc:   "replace an existing policy",
+			policy: updated,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Save(context.Background(), tc.policy)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s\n", tc.desc, err))
+			p, err := repo.Retrieve(context.Background(), tc.policy.DomainID)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s\n", tc.desc, err))
+			assert.Equal(t, tc.policy, p, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.policy, p))
+		})
+	}
+}
+
+func TestMFAPolicyRetrieve(t *testing.T) {
+	cache := new(mocks.MFAPolicyCache)
+	repo := postgres.NewMFAPolicyRepo(database, cache)
+
+	policy := auth.MFAPolicy{DomainID: generateID(t), Required: true}
+	saveCall := cache.On("Save", mock.Anything, policy.DomainID, &policy).Return(nil)
+	err := repo.Save(context.Background(), policy)
+	require.Nil(t, err, fmt.Sprintf("Storing MFA policy expected to succeed: %s", err))
+	saveCall.Unset()
+	cached := auth.MFAPolicy{DomainID: generateID(t), Required: true, UpdatedBy: generateID(t)}
+
+	cases := []struct {
+		desc         string
+		domainID     string
+		cacheRes     *auth.MFAPolicy
+		cacheErr     error
+		cacheSaved   *auth.MFAPolicy
+		cacheSaveErr error
+		policy       auth.MFAPolicy
+		err          error
+	}{
+		{
+			desc:       "retrieve an existing policy",
+			domainID:   policy.DomainID,
+			cacheErr:   repoerr.ErrNotFound,
+			cacheSaved: &policy,
+			policy:     policy,
+			err:        nil,
+		},
+		{
+			desc:     "retrieve non-existing policy",
+			domainID: generateID(t),
+			cacheErr: repoerr.ErrNotFound,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "retrieve cached policy",
+			domainID: cached.DomainID,
+			cacheRes: &cached,
+			policy:   cached,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve policy cached as absent",
+			domainID: policy.DomainID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:         "retrieve an existing policy with failed to cache",
+			domainID:     policy.DomainID,
+			cacheErr:     repoerr.ErrNotFound,
+			cacheSaved:   &policy,
+			cacheSaveErr: repoerr.ErrCreateEntity,
+			err:          repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var saved *auth.MFAPolicy
+			retrieveCall := cache.On("Retrieve", mock.Anything, tc.domainID).Return(tc.cacheRes, tc.cacheErr)
+			saveCall := cache.On("Save", mock.Anything, tc.domainID, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(2).(*auth.MFAPolicy)
+			}).Return(tc.cacheSaveErr)
+			p, err := repo.Retrieve(context.Background(), tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.policy, p, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.policy, p))
+			assert.Equal(t, tc.cacheSaved, saved, fmt.Sprintf("%s: expected cached %v got %v\n", tc.desc, tc.cacheSaved, saved))
+			retrieveCall.Unset()
+			saveCall.Unset()
+		})
+	}
+}
@@ -5,24 +5,52 @@ package postgres
 
 import (
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/absmach/supermq/auth"
+	"github.com/jackc/pgtype"
 )
 
+// maxUserAgentLen is the size of the last used user agent column.
+const maxUserAgentLen = 512
+
 type dbPat struct {
-	ID          string       `db:"id,omitempty"`
-	User        string       `db:"user_id,omitempty"`
-	Name        string       `db:"name,omitempty"`
-	Description string       `db:"description,omitempty"`
-	Secret      string       `db:"secret,omitempty"`
-	IssuedAt    time.Time    `db:"issued_at,omitempty"`
-	ExpiresAt   time.Time    `db:"expires_at,omitempty"`
-	UpdatedAt   sql.NullTime `db:"updated_at,omitempty"`
-	LastUsedAt  sql.NullTime `db:"last_used_at,omitempty"`
-	Revoked     bool         `db:"revoked,omitempty"`
-	RevokedAt   sql.NullTime `db:"revoked_at,omitempty"`
-	Status      auth.Status  `db:"status,omitempty"`
+	ID                string           `db:"id,omitempty"`
+	User              string           `db:"user_id,omitempty"`
+	Name              string           `db:"name,omitempty"`
+	Description       string           `db:"description,omitempty"`
+	Secret            string           `db:"secret,omitempty"`
+	IssuedAt          time.Time        `db:"issued_at,omitempty"`
+	ExpiresAt         time.Time        `db:"expires_at,omitempty"`
+	UpdatedAt         sql.NullTime     `db:"updated_at,omitempty"`
+	LastUsedAt        sql.NullTime     `db:"last_used_at,omitempty"`
+	LastUsedIP        sql.NullString   `db:"last_used_ip,omitempty"`
+	LastUsedUserAgent sql.NullString   `db:"last_used_user_agent,omitempty"`
+	UsageCount        uint64           `db:"usage_count,omitempty"`
+	AllowedCIDRs      pgtype.TextArray `db:"allowed_cidrs"`
+	MFA               bool             `db:"mfa"`
+	Revoked           bool             `db:"revoked,omitempty"`
+	RevokedAt         sql.NullTime     `db:"revoked_at,omitempty"`
+	Status            auth.Status      `db:"status,omitempty"`
+}
+
+type dbPatUsage struct {
+	ID         string    `db:"id"`
+	User       string    `db:"user_id"`
+	LastUsedAt time.Time `db:"last_used_at"`
+	IP         string    `db:"last_used_ip"`
+	UserAgent  string    `db:"last_used_user_agent"`
+	Count      uint64    `db:"usage_count"`
+}
+
+type dbPATPolicy struct {
+	DomainID      string       `db:"domain_id"`
+	MaxDuration   int64        `db:"max_duration"`
+	AllowedScopes []byte       `db:"allowed_scopes"`
+	UpdatedAt     sql.NullTime `db:"updated_at"`
+	UpdatedBy     string       `db:"updated_by"`
 }
 
 type dbScope struct {
@@ -49,6 +77,7 @@ type dbPagemeta struct {
 	Secret      string       `db:"secret"`
 	Status      auth.Status  `db:"status"`
 	Timestamp   time.Time    `db:"timestamp,omitempty"`
+	UnusedSince time.Time    `db:"unused_since"`
 }
 
 func toAuthPat(db dbPat) auth.PAT {
@@ -69,18 +98,23 @@ func toAuthPat(db dbPat) auth.PAT {
 	}
 
 	return auth.PAT{
-		ID:          db.ID,
-		User:        db.User,
-		Name:        db.Name,
-		Description: db.Description,
-		Secret:      db.Secret,
-		IssuedAt:    db.IssuedAt,
-		ExpiresAt:   db.ExpiresAt,
-		UpdatedAt:   updatedAt,
-		LastUsedAt:  lastUsedAt,
-		Revoked:     db.Revoked,
-		RevokedAt:   revokedAt,
-		Status:      db.Status,
+		ID:                db.ID,
+		User:              db.User,
+		Name:              db.Name,
+		Description:       db.Description,
+		Secret:            db.Secret,
+		IssuedAt:          db.IssuedAt,
+		ExpiresAt:         db.ExpiresAt,
+		UpdatedAt:         updatedAt,
+		LastUsedAt:        lastUsedAt,
+		LastUsedIP:        db.LastUsedIP.String,
+		LastUsedUserAgent: db.LastUsedUserAgent.String,
+		UsageCount:        db.UsageCount,
+		AllowedCIDRs:      toStrings(db.AllowedCIDRs),
+		MFA:               db.MFA,
+		Revoked:           db.Revoked,
+		RevokedAt:         revokedAt,
+		Status:            db.Status,
 	}
 }
 
@@ -129,18 +163,25 @@ func toDBPats(pat auth.PAT) (dbPat, error) {
 		}
 	}
 
+	var allowedCIDRs pgtype.TextArray
+	if err := allowedCIDRs.Set(pat.AllowedCIDRs); err != nil {
+		return dbPat{}, err
+	}
+
 	return dbPat{
-		ID:          pat.ID,
-		User:        pat.User,
-		Name:        pat.Name,
-		Description: pat.Description,
-		Secret:      pat.Secret,
-		IssuedAt:    pat.IssuedAt,
-		ExpiresAt:   pat.ExpiresAt,
-		Revoked:     pat.Revoked,
-		UpdatedAt:   updatedAt,
-		LastUsedAt:  lastUsedAt,
-		RevokedAt:   revokedAt,
+		ID:           pat.ID,
+		User:         pat.User,
+		Name:         pat.Name,
+		Description:  pat.Description,
+		Secret:       pat.Secret,
+		IssuedAt:     pat.IssuedAt,
+		ExpiresAt:    pat.ExpiresAt,
+		Revoked:      pat.Revoked,
+		UpdatedAt:    updatedAt,
+		LastUsedAt:   lastUsedAt,
+		RevokedAt:    revokedAt,
+		AllowedCIDRs: allowedCIDRs,
+		MFA:          pat.MFA,
 	}, nil
 }
 
@@ -158,3 +199,64 @@ func toDBScope(sc []auth.Scope) []dbScope {
 	}
 	return scopes
 }
+
+func toDBPatUsage(u auth.PATUsage) dbPatUsage {
+	return dbPatUsage{
+		ID:         u.PatID,
+		User:       u.UserID,
+		LastUsedAt: u.LastUsedAt,
+		IP:         u.IP,
+		UserAgent:  truncate(u.UserAgent, maxUserAgentLen),
+		Count:      u.Count,
+	}
+}
+
+// truncate shortens the string to at most n bytes without splitting characters.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:n], "")
+}
+
+func toDBPATPolicy(pp auth.PATPolicy) (dbPATPolicy, error) {
+	scopes, err := json.Marshal(pp.AllowedScopes)
+	if err != nil {
+		return dbPATPolicy{}, err
+	}
+
+	return dbPATPolicy{
+		DomainID:      pp.DomainID,
+		MaxDuration:   int64(pp.MaxDuration),
+		AllowedScopes: scopes,
+		UpdatedAt:     sql.NullTime{Time: pp.UpdatedAt, Valid: !pp.UpdatedAt.IsZero()},
+		UpdatedBy:     pp.UpdatedBy,
+	}, nil
+}
+
+func toAuthPATPolicy(dbpp dbPATPolicy) (auth.PATPolicy, error) {
+	var scopes []auth.ScopeRule
+	if len(dbpp.AllowedScopes) > 0 {
+		if err := json.Unmarshal(dbpp.AllowedScopes, &scopes); err != nil {
+			return auth.PATPolicy{}, err
+		}
+	}
+
+	return auth.PATPolicy{
+		DomainID:      dbpp.DomainID,
+		MaxDuration:   time.Duration(dbpp.MaxDuration),
+		AllowedScopes: scopes,
+		UpdatedAt:     dbpp.UpdatedAt.Time,
+		UpdatedBy:     dbpp.UpdatedBy,
+	}, nil
+}
+
+func toStrings(arr pgtype.TextArray) []string {
+	var s []string
+	for _, e := range arr.Elements {
+		s = append(s, e.String)
+	}
+
+	return s
+}
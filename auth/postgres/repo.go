@@ -14,6 +14,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/jackc/pgtype"
 )
 
 var _ auth.PATSRepository = (*patRepo)(nil)
@@ -36,10 +37,10 @@ func (pr *patRepo) Save(ctx context.Context, pat auth.PAT) error {
 	q := `
 	INSERT INTO pats (
 		id, user_id, name, description, secret, issued_at, expires_at, 
-		updated_at, last_used_at, revoked, revoked_at
+		updated_at, last_used_at, revoked, revoked_at, allowed_cidrs, mfa
 	) VALUES (
 		:id, :user_id, :name, :description, :secret, :issued_at, :expires_at,
-		:updated_at, :last_used_at, :revoked, :revoked_at
+		:updated_at, :last_used_at, :revoked, :revoked_at, :allowed_cidrs, :mfa
 	)`
 
 	dbPat, err := toDBPats(pat)
@@ -74,7 +75,8 @@ func (pr *patRepo) RetrieveAll(ctx context.Context, userID string, pm auth.PATSP
 	q := fmt.Sprintf(`
 		SELECT 
 			p.id, p.user_id, p.name, p.description, p.issued_at, p.expires_at,
-			p.updated_at, p.revoked, p.revoked_at,
+			p.updated_at, p.revoked, p.revoked_at, p.last_used_at, p.last_used_ip,
+			p.last_used_user_agent, p.usage_count, p.allowed_cidrs,
 		CASE 
 			WHEN p.revoked = TRUE THEN %d
 			WHEN expires_at IS NOT NULL AND expires_at < :timestamp THEN %d
@@ -84,14 +86,16 @@ func (pr *patRepo) RetrieveAll(ctx context.Context, userID string, pm auth.PATSP
 		ORDER BY issued_at DESC
 		LIMIT :limit OFFSET :offset`, auth.RevokedStatus, auth.ExpiredStatus, auth.ActiveStatus, pageQuery)
 
+	now := time.Now().UTC()
 	dbPage := dbPagemeta{
-		Limit:     pm.Limit,
-		Offset:    pm.Offset,
-		User:      userID,
-		Name:      pm.Name,
-		ID:        pm.ID,
-		Status:    pm.Status,
-		Timestamp: time.Now().UTC(),
+		Limit:       pm.Limit,
+		Offset:      pm.Offset,
+		User:        userID,
+		Name:        pm.Name,
+		ID:          pm.ID,
+		Status:      pm.Status,
+		Timestamp:   now,
+		UnusedSince: now.AddDate(0, 0, -int(pm.UnusedDays)),
 	}
 
 	rows, err := pr.db.NamedQueryContext(ctx, q, dbPage)
@@ -136,6 +140,10 @@ func PageQuery(pm auth.PATSPageMeta) (string, error) {
 		query = append(query, "p.id = :id")
 	}
 
+	if pm.UnusedDays > 0 {
+		query = append(query, "COALESCE(p.last_used_at, p.issued_at) < :unused_since")
+	}
+
 	if pm.Status != auth.AllStatus {
 		switch pm.Status {
 		case auth.RevokedStatus:
@@ -154,9 +162,9 @@ func PageQuery(pm auth.PATSPageMeta) (string, error) {
 	return emq, nil
 }
 
-func (pr *patRepo) RetrieveSecretAndRevokeStatus(ctx context.Context, userID, patID string) (string, bool, bool, error) {
+func (pr *patRepo) RetrieveSecretAndRevokeStatus(ctx context.Context, userID, patID string) (string, bool, bool, []string, error) {
 	q := `
-		SELECT p.secret, p.revoked, p.expires_at 
+		SELECT p.secret, p.revoked, p.expires_at, p.allowed_cidrs
 		FROM pats p
 		WHERE p.user_id = :user_id AND p.id = :pat_id`
 
@@ -168,24 +176,25 @@ func (pr *patRepo) RetrieveSecretAndRevokeStatus(ctx context.Context, userID, pa
 
 	rows, err := pr.db.NamedQueryContext(ctx, q, dbPage)
 	if err != nil {
-		return "", true, true, postgres.HandleError(repoerr.ErrNotFound, err)
+		return "", true, true, nil, postgres.HandleError(repoerr.ErrNotFound, err)
 	}
 	defer rows.Close()
 
 	var secret string
 	var revoked bool
 	var expiresAt time.Time
+	var allowedCIDRs pgtype.TextArray
 
 	if !rows.Next() {
-		return "", true, true, repoerr.ErrNotFound
+		return "", true, true, nil, repoerr.ErrNotFound
 	}
 
-	if err := rows.Scan(&secret, &revoked, &expiresAt); err != nil {
-		return "", true, true, postgres.HandleError(repoerr.ErrNotFound, err)
+	if err := rows.Scan(&secret, &revoked, &expiresAt, &allowedCIDRs); err != nil {
+		return "", true, true, nil, postgres.HandleError(repoerr.ErrNotFound, err)
 	}
 
 	expired := time.Now().UTC().After(expiresAt)
-	return secret, revoked, expired, nil
+	return secret, revoked, expired, toStrings(allowedCIDRs), nil
 }
 
 func (pr *patRepo) UpdateName(ctx context.Context, userID, patID, name string) (auth.PAT, error) {
@@ -193,7 +202,7 @@ func (pr *patRepo) UpdateName(ctx context.Context, userID, patID, name string) (
 		UPDATE pats p
 		SET name = :name, updated_at = :updated_at
 		WHERE user_id = :user_id AND id = :id
-		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at`
+		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at, last_used_ip, last_used_user_agent, usage_count, allowed_cidrs`
 
 	upm := dbPagemeta{
 		User: userID,
@@ -228,7 +237,7 @@ func (pr *patRepo) UpdateDescription(ctx context.Context, userID, patID, descrip
 		UPDATE pats 
 		SET description = :description, updated_at = :updated_at
 		WHERE user_id = :user_id AND id = :id
-		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at`
+		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at, last_used_ip, last_used_user_agent, usage_count, allowed_cidrs`
 
 	upm := dbPagemeta{
 		User: userID,
@@ -258,12 +267,51 @@ func (pr *patRepo) UpdateDescription(ctx context.Context, userID, patID, descrip
 	return toAuthPat(pat), nil
 }
 
+func (pr *patRepo) UpdateAllowedCIDRs(ctx context.Context, userID, patID string, allowedCIDRs []string) (auth.PAT, error) {
+	q := `
+		UPDATE pats
+		SET allowed_cidrs = :allowed_cidrs, updated_at = :updated_at
+		WHERE user_id = :user_id AND id = :id
+		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at, last_used_ip, last_used_user_agent, usage_count, allowed_cidrs`
+
+	var cidrs pgtype.TextArray
+	if err := cidrs.Set(allowedCIDRs); err != nil {
+		return auth.PAT{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	upm := dbPat{
+		User: userID,
+		ID:   patID,
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now().UTC(),
+			Valid: true,
+		},
+		AllowedCIDRs: cidrs,
+	}
+
+	rows, err := pr.db.NamedQueryContext(ctx, q, upm)
+	if err != nil {
+		return auth.PAT{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return auth.PAT{}, repoerr.ErrNotFound
+	}
+
+	var pat dbPat
+	if err := rows.StructScan(&pat); err != nil {
+		return auth.PAT{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return toAuthPat(pat), nil
+}
+
 func (pr *patRepo) UpdateTokenHash(ctx context.Context, userID, patID, tokenHash string, expiryAt time.Time) (auth.PAT, error) {
 	q := `
 		UPDATE pats 
 		SET secret = :secret, expires_at = :expires_at, updated_at = :updated_at
 		WHERE user_id = :user_id AND id = :id
-		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at`
+		RETURNING id, user_id, name, description, secret, issued_at, updated_at, expires_at, revoked, revoked_at, last_used_at, last_used_ip, last_used_user_agent, usage_count, allowed_cidrs`
 
 	upm := dbPagemeta{
 		User: userID,
@@ -652,7 +700,8 @@ func (pr *patRepo) retrievePATFromDB(ctx context.Context, userID, patID string)
 	q := fmt.Sprintf(`
 		SELECT 
 		id, user_id, name, description, secret, issued_at, expires_at,
-		updated_at, last_used_at, revoked, revoked_at,
+		updated_at, last_used_at, last_used_ip, last_used_user_agent, usage_count,
+		allowed_cidrs, mfa, revoked, revoked_at,
 		CASE 
 			WHEN revoked = TRUE THEN %d
 			WHEN expires_at IS NOT NULL AND expires_at < :timestamp THEN %d
@@ -681,3 +730,114 @@ func (pr *patRepo) retrievePATFromDB(ctx context.Context, userID, patID string)
 
 	return toAuthPat(record), nil
 }
+
+func (pr *patRepo) UpdateUsage(ctx context.Context, usages []auth.PATUsage) (err error) {
+	q := `
+		UPDATE pats
+		SET usage_count = usage_count + :usage_count,
+			last_used_at = :last_used_at,
+			last_used_ip = :last_used_ip,
+			last_used_user_agent = :last_used_user_agent
+		WHERE user_id = :user_id AND id = :id AND (last_used_at IS NULL OR last_used_at <= :last_used_at)`
+
+	// Usage written out of order only increments the counter.
+	cq := `
+		UPDATE pats
+		SET usage_count = usage_count + :usage_count
+		WHERE user_id = :user_id AND id = :id AND last_used_at > :last_used_at`
+
+	tx, err := pr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				err = errors.Wrap(err, txErr)
+			}
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+	}()
+
+	for _, u := range usages {
+		dbu := toDBPatUsage(u)
+		res, err := tx.NamedExecContext(ctx, q, dbu)
+		if err != nil {
+			return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			continue
+		}
+		if _, err := tx.NamedExecContext(ctx, cq, dbu); err != nil {
+			return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (pr *patRepo) SavePolicy(ctx context.Context, policy auth.PATPolicy) error {
+	q := `
+		INSERT INTO pat_policies (domain_id, max_duration, allowed_scopes, updated_at, updated_by)
+		VALUES (:domain_id, :max_duration, :allowed_scopes, :updated_at, :updated_by)
+		ON CONFLICT (domain_id) DO UPDATE SET
+			max_duration = EXCLUDED.max_duration,
+			allowed_scopes = EXCLUDED.allowed_scopes,
+			updated_at = EXCLUDED.updated_at,
+			updated_by = EXCLUDED.updated_by`
+
+	dbpp, err := toDBPATPolicy(policy)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	if _, err := pr.db.NamedExecContext(ctx, q, dbpp); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (pr *patRepo) RetrievePolicies(ctx context.Context, domainIDs ...string) ([]auth.PATPolicy, error) {
+	q := `
+		SELECT domain_id, max_duration, allowed_scopes, updated_at, updated_by
+		FROM pat_policies WHERE domain_id = ANY($1)`
+
+	rows, err := pr.db.QueryxContext(ctx, q, domainIDs)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var pps []auth.PATPolicy
+	for rows.Next() {
+		var dbpp dbPATPolicy
+		if err := rows.StructScan(&dbpp); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		pp, err := toAuthPATPolicy(dbpp)
+		if err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		pps = append(pps, pp)
+	}
+
+	return pps, nil
+}
+
+func (pr *patRepo) RemovePolicy(ctx context.Context, domainID string) error {
+	q := `DELETE FROM pat_policies WHERE domain_id = $1`
+
+	res, err := pr.db.ExecContext(ctx, q, domainID)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
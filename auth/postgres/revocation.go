@@ -0,0 +1,149 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+var _ auth.RevocationRepository = (*revocationRepo)(nil)
+
+type revocationRepo struct {
+	db postgres.Database
+}
+
+// NewRevocationRepo instantiates a PostgreSQL implementation of token
+// revocation repository.
+func NewRevocationRepo(db postgres.Database) auth.RevocationRepository {
+	return &revocationRepo{
+		db: db,
+	}
+}
+
+func (rr *revocationRepo) Save(ctx context.Context, revocation auth.Revocation) error {
+	q := `INSERT INTO revocations (token_id, session_id, subject, not_before, expires_at)
+		VALUES (:token_id, :session_id, :subject, :not_before, :expires_at)
+		ON CONFLICT (token_id, session_id, subject) DO UPDATE SET
+			not_before = GREATEST(revocations.not_before, EXCLUDED.not_before),
+			expires_at = GREATEST(revocations.expires_at, EXCLUDED.expires_at)`
+
+	if _, err := rr.db.NamedExecContext(ctx, q, toDBRevocation(revocation)); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (rr *revocationRepo) Revoked(ctx context.Context, key auth.Key) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM revocations WHERE expires_at > $4 AND (
+			($1 <> '' AND token_id = $1) OR
+			($5 <> '' AND session_id = $5) OR
+			($2 <> '' AND token_id = '' AND session_id = '' AND subject = $2 AND not_before >= $3)))`
+
+	var revoked bool
+	if err := rr.db.QueryRowxContext(ctx, q, key.ID, key.Subject, key.IssuedAt, time.Now().UTC(), key.SessionID).Scan(&revoked); err != nil {
+		return false, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return revoked, nil
+}
+
+func (rr *revocationRepo) RetrieveAll(ctx context.Context, pm auth.RevocationsPageMeta) (auth.RevocationsPage, error) {
+	q := `SELECT token_id, session_id, subject, not_before, expires_at FROM revocations
+		WHERE expires_at > :timestamp
+		ORDER BY expires_at DESC, token_id, session_id, subject
+		LIMIT :limit OFFSET :offset`
+
+	dbPage := dbRevocationsPage{
+		Timestamp: time.Now().UTC(),
+		Limit:     pm.Limit,
+		Offset:    pm.Offset,
+	}
+	rows, err := rr.db.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return auth.RevocationsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []auth.Revocation{}
+	for rows.Next() {
+		var dbr dbRevocation
+		if err := rows.StructScan(&dbr); err != nil {
+			return auth.RevocationsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, toRevocation(dbr))
+	}
+
+	cq := `SELECT COUNT(*) FROM revocations WHERE expires_at > :timestamp`
+	total, err := postgres.Total(ctx, rr.db, cq, dbPage)
+	if err != nil {
+		return auth.RevocationsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return auth.RevocationsPage{
+		Total:       total,
+		Offset:      pm.Offset,
+		Limit:       pm.Limit,
+		Revocations: items,
+	}, nil
+}
+
+func (rr *revocationRepo) RemoveExpired(ctx context.Context, expiredBefore time.Time) error {
+	q := `DELETE FROM revocations WHERE expires_at <= $1`
+
+	if _, err := rr.db.ExecContext(ctx, q, expiredBefore); err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbRevocation struct {
+	TokenID   string       `db:"token_id"`
+	SessionID string       `db:"session_id"`
+	Subject   string       `db:"subject"`
+	NotBefore sql.NullTime `db:"not_before"`
+	ExpiresAt time.Time    `db:"expires_at"`
+}
+
+type dbRevocationsPage struct {
+	Timestamp time.Time `db:"timestamp"`
+	Limit     uint64    `db:"limit"`
+	Offset    uint64    `db:"offset"`
+}
+
+func toDBRevocation(r auth.Revocation) dbRevocation {
+	dbr := dbRevocation{
+		TokenID:   r.TokenID,
+		SessionID: r.SessionID,
+		Subject:   r.Subject,
+		ExpiresAt: r.ExpiresAt,
+	}
+	if !r.NotBefore.IsZero() {
+		dbr.NotBefore = sql.NullTime{Time: r.NotBefore, Valid: true}
+	}
+
+	return dbr
+}
+
+func toRevocation(dbr dbRevocation) auth.Revocation {
+	r := auth.Revocation{
+		TokenID:   dbr.TokenID,
+		SessionID: dbr.SessionID,
+		Subject:   dbr.Subject,
+		ExpiresAt: dbr.ExpiresAt.UTC(),
+	}
+	if dbr.NotBefore.Valid {
+		r.NotBefore = dbr.NotBefore.Time.UTC()
+	}
+
+	return r
+}
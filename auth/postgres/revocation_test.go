@@ -0,0 +1,135 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationRevoked(t *testing.T) {
+	repo := postgres.NewRevocationRepo(database)
+
+	now := time.Now().UTC()
+	tokenID := generateID(t)
+	subject := generateID(t)
+	expiredTokenID := generateID(t)
+	sessionID := generateID(t)
+	revocations := []auth.Revocation{
+		{TokenID: tokenID, ExpiresAt: now.Add(time.Hour)},
+		{TokenID: expiredTokenID, ExpiresAt: now.Add(-time.Minute)},
+		{SessionID: sessionID, ExpiresAt: now.Add(time.Hour)},
+		{Subject: subject, NotBefore: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+		// Revoking the subject tokens again moves the revocation forward.
+		{Subject: subject, NotBefore: now, ExpiresAt: now.Add(time.Hour)},
+	}
+	for _, r := range revocations {
+		err := repo.Save(context.Background(), r)
+		require.Nil(t, err, fmt.Sprintf("Storing revocation expected to succeed: %s", err))
+	}
+
+	cases := []struct {
+		desc    string
+		key     auth.Key
+		revoked bool
+	}{
+		{
+			desc:    "check revoked token",
+			key:     auth.Key{ID: tokenID, Subject: generateID(t), IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc:    "check token with expired revocation",
+			key:     auth.Key{ID: expiredTokenID, Subject: generateID(t), IssuedAt: now},
+			revoked: false,
+		},
+		{
+			desc:    "check token of revoked session",
+			key:     auth.Key{SessionID: sessionID, Subject: generateID(t), IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc:    "check token of revoked subject issued before revocation",
+			key:     auth.Key{ID: generateID(t), Subject: subject, IssuedAt: now.Add(-time.Minute)},
+			revoked: true,
+		},
+		{
+			desc:    "check token of revoked subject issued after revocation",
+			key:     auth.Key{ID: generateID(t), Subject: subject, IssuedAt: now.Add(time.Minute)},
+			revoked: false,
+		},
+		{
+			desc:    "check token without revocation",
+			key:     auth.Key{ID: generateID(t), Subject: generateID(t), IssuedAt: now},
+			revoked: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			revoked, err := repo.Revoked(context.Background(), tc.key)
+			require.Nil(t, err, fmt.Sprintf("%s: expected no error got %s\n", tc.desc, err))
+			assert.Equal(t, tc.revoked, revoked, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.revoked, revoked))
+		})
+	}
+
+	err := repo.RemoveExpired(context.Background(), now.Add(2*time.Hour))
+	require.Nil(t, err, fmt.Sprintf("Removing expired revocations expected to succeed: %s", err))
+	revoked, err := repo.Revoked(context.Background(), auth.Key{ID: tokenID})
+	require.Nil(t, err, fmt.Sprintf("Checking revocation expected to succeed: %s", err))
+	assert.False(t, revoked, "expected the expired revocation to be removed")
+}
+
+func TestRevocationRetrieveAll(t *testing.T) {
+	repo := postgres.NewRevocationRepo(database)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	err := repo.RemoveExpired(context.Background(), now.Add(24*time.Hour))
+	require.Nil(t, err, fmt.Sprintf("Removing revocations expected to succeed: %s", err))
+
+	first := auth.Revocation{Subject: generateID(t), NotBefore: now, ExpiresAt: now.Add(3 * time.Hour)}
+	second := auth.Revocation{SessionID: generateID(t), ExpiresAt: now.Add(2 * time.Hour)}
+	third := auth.Revocation{TokenID: generateID(t), ExpiresAt: now.Add(time.Hour)}
+	for _, r := range []auth.Revocation{third, first, second, {TokenID: generateID(t), ExpiresAt: now.Add(-time.Minute)}} {
+		err := repo.Save(context.Background(), r)
+		require.Nil(t, err, fmt.Sprintf("Storing revocation expected to succeed: %s", err))
+	}
+
+	cases := []struct {
+		desc string
+		pm   auth.RevocationsPageMeta
+		page auth.RevocationsPage
+	}{
+		{
+			desc: "retrieve all revocations",
+			pm:   auth.RevocationsPageMeta{Limit: 10},
+			page: auth.RevocationsPage{Total: 3, Limit: 10, Revocations: []auth.Revocation{first, second, third}},
+		},
+		{
+			desc: "retrieve revocations with offset and limit",
+			pm:   auth.RevocationsPageMeta{Offset: 1, Limit: 1},
+			page: auth.RevocationsPage{Total: 3, Offset: 1, Limit: 1, Revocations: []auth.Revocation{second}},
+		},
+		{
+			desc: "retrieve revocations with offset out of range",
+			pm:   auth.RevocationsPageMeta{Offset: 10, Limit: 10},
+			page: auth.RevocationsPage{Total: 3, Offset: 10, Limit: 10, Revocations: []auth.Revocation{}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveAll(context.Background(), tc.pm)
+			require.Nil(t, err, fmt.Sprintf("%s: expected no error got %s\n", tc.desc, err))
+			assert.Equal(t, tc.page, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, page))
+		})
+	}
+}
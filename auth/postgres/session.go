@@ -0,0 +1,177 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+var _ auth.SessionRepository = (*sessionRepo)(nil)
+
+type sessionRepo struct {
+	db postgres.Database
+}
+
+// NewSessionRepo instantiates a PostgreSQL implementation of login session repository.
+func NewSessionRepo(db postgres.Database) auth.SessionRepository {
+	return &sessionRepo{
+		db: db,
+	}
+}
+
+func (sr *sessionRepo) Save(ctx context.Context, session auth.Session) error {
+	// Expired sessions of the user are removed on login so they do not pile up.
+	dq := `DELETE FROM sessions WHERE user_id = $1 AND expires_at < $2`
+	if _, err := sr.db.ExecContext(ctx, dq, session.UserID, time.Now().UTC()); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO sessions (id, user_id, token_id, ip, user_agent, issued_at, refreshed_at, expires_at)
+		VALUES (:id, :user_id, :token_id, :ip, :user_agent, :issued_at, :refreshed_at, :expires_at)`
+	if _, err := sr.db.NamedExecContext(ctx, q, toDBSession(session)); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (sr *sessionRepo) Retrieve(ctx context.Context, id string) (auth.Session, error) {
+	q := `SELECT id, user_id, token_id, ip, user_agent, issued_at, refreshed_at, expires_at FROM sessions WHERE id = $1`
+
+	var dbs dbSession
+	if err := sr.db.QueryRowxContext(ctx, q, id).StructScan(&dbs); err != nil {
+		if err == sql.ErrNoRows {
+			return auth.Session{}, repoerr.ErrNotFound
+		}
+		return auth.Session{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toSession(dbs), nil
+}
+
+func (sr *sessionRepo) RetrieveAll(ctx context.Context, userID string, pm auth.SessionsPageMeta) (auth.SessionsPage, error) {
+	q := `SELECT id, user_id, token_id, ip, user_agent, issued_at, refreshed_at, expires_at FROM sessions
+		WHERE user_id = :user_id AND expires_at >= :timestamp
+		ORDER BY issued_at DESC
+		LIMIT :limit OFFSET :offset`
+
+	dbPage := dbSessionsPage{
+		User:      userID,
+		Timestamp: time.Now().UTC(),
+		Limit:     pm.Limit,
+		Offset:    pm.Offset,
+	}
+	rows, err := sr.db.NamedQueryContext(ctx, q, dbPage)
+	if err != nil {
+		return auth.SessionsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []auth.Session{}
+	for rows.Next() {
+		var dbs dbSession
+		if err := rows.StructScan(&dbs); err != nil {
+			return auth.SessionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, toSession(dbs))
+	}
+
+	cq := `SELECT COUNT(*) FROM sessions WHERE user_id = :user_id AND expires_at >= :timestamp`
+	total, err := postgres.Total(ctx, sr.db, cq, dbPage)
+	if err != nil {
+		return auth.SessionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return auth.SessionsPage{
+		Total:    total,
+		Offset:   pm.Offset,
+		Limit:    pm.Limit,
+		Sessions: items,
+	}, nil
+}
+
+func (sr *sessionRepo) Rotate(ctx context.Context, session auth.Session, prevTokenID string) error {
+	q := `UPDATE sessions SET token_id = $3, ip = $4, user_agent = $5, refreshed_at = $6, expires_at = $7
+		WHERE id = $1 AND token_id = $2`
+
+	dbs := toDBSession(session)
+	res, err := sr.db.ExecContext(ctx, q, dbs.ID, prevTokenID, dbs.TokenID, dbs.IP, dbs.UserAgent, dbs.RefreshedAt, dbs.ExpiresAt)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (sr *sessionRepo) Remove(ctx context.Context, userID, id string) error {
+	q := `DELETE FROM sessions WHERE user_id = $1 AND id = $2`
+
+	res, err := sr.db.ExecContext(ctx, q, userID, id)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+type dbSession struct {
+	ID          string         `db:"id"`
+	UserID      string         `db:"user_id"`
+	TokenID     string         `db:"token_id"`
+	IP          sql.NullString `db:"ip"`
+	UserAgent   sql.NullString `db:"user_agent"`
+	IssuedAt    time.Time      `db:"issued_at"`
+	RefreshedAt sql.NullTime   `db:"refreshed_at"`
+	ExpiresAt   time.Time      `db:"expires_at"`
+}
+
+type dbSessionsPage struct {
+	User      string    `db:"user_id"`
+	Timestamp time.Time `db:"timestamp"`
+	Limit     uint64    `db:"limit"`
+	Offset    uint64    `db:"offset"`
+}
+
+func toDBSession(s auth.Session) dbSession {
+	return dbSession{
+		ID:          s.ID,
+		UserID:      s.UserID,
+		TokenID:     s.TokenID,
+		IP:          sql.NullString{String: s.IP, Valid: s.IP != ""},
+		UserAgent:   sql.NullString{String: s.UserAgent, Valid: s.UserAgent != ""},
+		IssuedAt:    s.IssuedAt,
+		RefreshedAt: sql.NullTime{Time: s.RefreshedAt, Valid: !s.RefreshedAt.IsZero()},
+		ExpiresAt:   s.ExpiresAt,
+	}
+}
+
+func toSession(dbs dbSession) auth.Session {
+	s := auth.Session{
+		ID:        dbs.ID,
+		UserID:    dbs.UserID,
+		TokenID:   dbs.TokenID,
+		IP:        dbs.IP.String,
+		UserAgent: dbs.UserAgent.String,
+		IssuedAt:  dbs.IssuedAt.UTC(),
+		ExpiresAt: dbs.ExpiresAt.UTC(),
+	}
+	if dbs.RefreshedAt.Valid {
+		s.RefreshedAt = dbs.RefreshedAt.Time.UTC()
+	}
+
+	return s
+}
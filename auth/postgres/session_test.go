@@ -0,0 +1,238 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/postgres"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSession(t *testing.T, userID string) auth.Session {
+	return auth.Session{
+		ID:        generateID(t),
+		UserID:    userID,
+		TokenID:   generateID(t),
+		IP:        "192.168.1.10",
+		UserAgent: "supermq-cli",
+		IssuedAt:  time.Now().UTC().Truncate(time.Millisecond),
+		ExpiresAt: expTime.UTC().Truncate(time.Millisecond),
+	}
+}
+
+func TestSessionSave(t *testing.T) {
+	repo := postgres.NewSessionRepo(database)
+
+	session := newSession(t, generateID(t))
+
+	cases := []struct {
+		desc    string
+		session auth.Session
+		err     error
+	}{
+		{
+			desc:    "save a new session",
+			session: session,
+			err:     nil,
+		},
+		{
+			desc:    "save with duplicate id",
+			session: session,
+			err:     repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Save(context.Background(), tc.session)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestSessionRetrieve(t *testing.T) {
+	repo := postgres.NewSessionRepo(database)
+
+	session := newSession(t, generateID(t))
+	err := repo.Save(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("Storing session expected to succeed: %s", err))
+
+	cases := []struct {
+		desc    string
+		id      string
+		session auth.Session
+		err     error
+	}{
+		{
+			desc:    "retrieve an existing session",
+			id:      session.ID,
+			session: session,
+			err:     nil,
+		},
+		{
+			desc: "retrieve non-existing session",
+			id:   generateID(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s, err := repo.Retrieve(context.Background(), tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.session.TokenID, s.TokenID, fmt.Sprintf("%s: expected token ID %s got %s\n", tc.desc, tc.session.TokenID, s.TokenID))
+				assert.Equal(t, tc.session.UserID, s.UserID, fmt.Sprintf("%s: expected user ID %s got %s\n", tc.desc, tc.session.UserID, s.UserID))
+			}
+		})
+	}
+}
+
+func TestSessionRetrieveAll(t *testing.T) {
+	repo := postgres.NewSessionRepo(database)
+
+	userID := generateID(t)
+	num := 5
+	for range num {
+		err := repo.Save(context.Background(), newSession(t, userID))
+		require.Nil(t, err, fmt.Sprintf("Storing session expected to succeed: %s", err))
+	}
+	expired := newSession(t, userID)
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	err := repo.Save(context.Background(), expired)
+	require.Nil(t, err, fmt.Sprintf("Storing session expected to succeed: %s", err))
+
+	cases := []struct {
+		desc   string
+		userID string
+		pm     auth.SessionsPageMeta
+		total  uint64
+		size   int
+	}{
+		{
+			desc:   "retrieve all sessions of the user",
+			userID: userID,
+			pm:     auth.SessionsPageMeta{Offset: 0, Limit: 10},
+			total:  uint64(num),
+			size:   num,
+		},
+		{
+			desc:   "retrieve sessions page of the user",
+			userID: userID,
+			pm:     auth.SessionsPageMeta{Offset: 2, Limit: 2},
+			total:  uint64(num),
+			size:   2,
+		},
+		{
+			desc:   "retrieve sessions of the user without sessions",
+			userID: generateID(t),
+			pm:     auth.SessionsPageMeta{Offset: 0, Limit: 10},
+			total:  0,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveAll(context.Background(), tc.userID, tc.pm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s\n", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+			assert.Len(t, page.Sessions, tc.size, fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Sessions)))
+		})
+	}
+}
+
+func TestSessionRotate(t *testing.T) {
+	repo := postgres.NewSessionRepo(database)
+
+	session := newSession(t, generateID(t))
+	err := repo.Save(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("Storing session expected to succeed: %s", err))
+
+	rotated := session
+	rotated.TokenID = generateID(t)
+	rotated.RefreshedAt = time.Now().UTC()
+
+	cases := []struct {
+		desc        string
+		session     auth.Session
+		prevTokenID string
+		err         error
+	}{
+		{
+			desc:        "rotate session with current token",
+			session:     rotated,
+			prevTokenID: session.TokenID,
+			err:         nil,
+		},
+		{
+			desc:        "rotate session with already rotated token",
+			session:     rotated,
+			prevTokenID: session.TokenID,
+			err:         repoerr.ErrNotFound,
+		},
+		{
+			desc:        "rotate non-existing session",
+			session:     newSession(t, generateID(t)),
+			prevTokenID: generateID(t),
+			err:         repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Rotate(context.Background(), tc.session, tc.prevTokenID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestSessionRemove(t *testing.T) {
+	repo := postgres.NewSessionRepo(database)
+
+	session := newSession(t, generateID(t))
+	err := repo.Save(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("Storing session expected to succeed: %s", err))
+
+	cases := []struct {
+		desc   string
+		userID string
+		id     string
+		err    error
+	}{
+		{
+			desc:   "remove session of other user",
+			userID: generateID(t),
+			id:     session.ID,
+			err:    repoerr.ErrNotFound,
+		},
+		{
+			desc:   "remove an existing session",
+			userID: session.UserID,
+			id:     session.ID,
+			err:    nil,
+		},
+		{
+			desc:   "remove removed session",
+			userID: session.UserID,
+			id:     session.ID,
+			err:    repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Remove(context.Background(), tc.userID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+)
+
+var _ auth.SigningKeyRepository = (*signingKeyRepo)(nil)
+
+type signingKeyRepo struct {
+	db postgres.Database
+}
+
+// NewSigningKeyRepo instantiates a PostgreSQL implementation of signing key repository.
+func NewSigningKeyRepo(db postgres.Database) auth.SigningKeyRepository {
+	return &signingKeyRepo{
+		db: db,
+	}
+}
+
+func (sr *signingKeyRepo) Save(ctx context.Context, key auth.SigningKey) error {
+	q := `INSERT INTO signing_keys (id, private_key, created_at, activates_at, expires_at)
+		VALUES (:id, :private_key, :created_at, :activates_at, :expires_at)`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, toDBSigningKey(key)); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (sr *signingKeyRepo) RetrieveAll(ctx context.Context, expiresAfter time.Time) ([]auth.SigningKey, error) {
+	q := `SELECT id, private_key, created_at, activates_at, expires_at FROM signing_keys
+		WHERE expires_at > $1 ORDER BY activates_at`
+
+	rows, err := sr.db.QueryxContext(ctx, q, expiresAfter)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var keys []auth.SigningKey
+	for rows.Next() {
+		var dbk dbSigningKey
+		if err := rows.StructScan(&dbk); err != nil {
+			return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		keys = append(keys, toSigningKey(dbk))
+	}
+
+	return keys, nil
+}
+
+func (sr *signingKeyRepo) RemoveExpired(ctx context.Context, expiredBefore time.Time) error {
+	q := `DELETE FROM signing_keys WHERE expires_at <= $1`
+
+	if _, err := sr.db.ExecContext(ctx, q, expiredBefore); err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbSigningKey struct {
+	ID          string    `db:"id"`
+	PrivateKey  []byte    `db:"private_key"`
+	CreatedAt   time.Time `db:"created_at"`
+	ActivatesAt time.Time `db:"activates_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+func toDBSigningKey(key auth.SigningKey) dbSigningKey {
+	return dbSigningKey{
+		ID:          key.ID,
+		PrivateKey:  key.PrivateKey,
+		CreatedAt:   key.CreatedAt,
+		ActivatesAt: key.ActivatesAt,
+		ExpiresAt:   key.ExpiresAt,
+	}
+}
+
+func toSigningKey(dbk dbSigningKey) auth.SigningKey {
+	return auth.SigningKey{
+		ID:          dbk.ID,
+		PrivateKey:  dbk.PrivateKey,
+		CreatedAt:   dbk.CreatedAt.UTC(),
+		ActivatesAt: dbk.ActivatesAt.UTC(),
+		ExpiresAt:   dbk.ExpiresAt.UTC(),
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/postgres"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigningKey(t *testing.T, activatesAt time.Time) auth.SigningKey {
+	return auth.SigningKey{
+		ID:          generateID(t),
+		PrivateKey:  []byte("encrypted-private-key"),
+		CreatedAt:   time.Now().UTC().Truncate(time.Microsecond),
+		ActivatesAt: activatesAt.UTC().Truncate(time.Microsecond),
+		ExpiresAt:   activatesAt.Add(time.Hour).UTC().Truncate(time.Microsecond),
+	}
+}
+
+func TestSigningKeySave(t *testing.T) {
+	repo := postgres.NewSigningKeyRepo(database)
+
+	key := newSigningKey(t, time.Now())
+	concurrent := newSigningKey(t, key.ActivatesAt)
+
+	cases := []struct {
+		desc string
+		key  auth.SigningKey
+		err  error
+	}{
+		{
+			desc: "save a new signing key",
+			key:  key,
+			err:  nil,
+		},
+		{
+			desc: "save with duplicate id",
+			key:  key,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save key activated at the same time",
+			key:  concurrent,
+			err:  repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Save(context.Background(), tc.key)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestSigningKeyRetrieveAll(t *testing.T) {
+	repo := postgres.NewSigningKeyRepo(database)
+
+	now := time.Now().Add(24 * time.Hour)
+	expired := newSigningKey(t, now.Add(-2*time.Hour))
+	active := newSigningKey(t, now.Add(-30*time.Minute))
+	next := newSigningKey(t, now.Add(30*time.Minute))
+	for _, k := range []auth.SigningKey{next, expired, active} {
+		err := repo.Save(context.Background(), k)
+		require.Nil(t, err, fmt.Sprintf("Storing signing key expected to succeed: %s", err))
+	}
+
+	keys, err := repo.RetrieveAll(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("Retrieving signing keys expected to succeed: %s", err))
+	assert.Equal(t, []auth.SigningKey{active, next}, keys, "expected the not expired keys ordered by activation")
+
+	err = repo.RemoveExpired(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("Removing expired signing keys expected to succeed: %s", err))
+	keys, err = repo.RetrieveAll(context.Background(), now.Add(-24*time.Hour))
+	require.Nil(t, err, fmt.Sprintf("Retrieving signing keys expected to succeed: %s", err))
+	for _, k := range keys {
+		assert.NotEqual(t, expired.ID, k.ID, "expected the expired key to be removed")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// ErrRevokedToken indicates that the token has been revoked.
+var ErrRevokedToken = errors.New("token is revoked")
+
+// neverExpires is the expiration of the revocation of the token which
+// doesn't expire itself.
+var neverExpires = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// Revocation represents the revocation of the single token, identified by its
+// key ID, of all the tokens of the login session, or of all the tokens of the
+// subject issued up to the given time.
+type Revocation struct {
+	TokenID   string `json:"token_id,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+	Subject   string `json:"subject,omitempty"`
+	// NotBefore is the time up to which the tokens of the subject are revoked.
+	NotBefore time.Time `json:"not_before,omitempty"`
+	// ExpiresAt is the time after which none of the revoked tokens is
+	// valid anymore, so the revocation can be forgotten.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Revokes returns true if the revocation applies to the given key. Since
+// the tokens carry the issue time in seconds, the tokens of the subject
+// issued in the second of the revocation are revoked as well.
+func (r Revocation) Revokes(key Key) bool {
+	if r.TokenID != "" {
+		return key.ID == r.TokenID
+	}
+	if r.SessionID != "" {
+		return key.SessionID == r.SessionID
+	}
+
+	return r.Subject != "" && key.Subject == r.Subject && !key.IssuedAt.After(r.NotBefore)
+}
+
+// RevocationsPageMeta contains page metadata that helps navigation.
+type RevocationsPageMeta struct {
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+// RevocationsPage contains page related metadata as well as list of revocations.
+type RevocationsPage struct {
+	Total       uint64       `json:"total"`
+	Offset      uint64       `json:"offset"`
+	Limit       uint64       `json:"limit"`
+	Revocations []Revocation `json:"revocations"`
+}
+
+// Revocations specifies the token revocation API.
+type Revocations interface {
+	// RevokeUserTokens revokes all the tokens of the user issued so far,
+	// which invalidates the live login sessions of the user.
+	RevokeUserTokens(ctx context.Context, userID string) (Revocation, error)
+
+	// ListRevocations lists the unexpired revocations, so the services which
+	// verify the tokens themselves load the revocations published before
+	// they started.
+	ListRevocations(ctx context.Context, pm RevocationsPageMeta) (RevocationsPage, error)
+}
+
+// RevocationRepository specifies the token revocation persistence API.
+type RevocationRepository interface {
+	// Save stores the revocation. Revoking the tokens of the same subject
+	// again moves the revocation time forward.
+	Save(ctx context.Context, revocation Revocation) error
+
+	// Revoked returns true if the key is revoked by any of the unexpired
+	// revocations.
+	Revoked(ctx context.Context, key Key) (bool, error)
+
+	// RetrieveAll retrieves the unexpired revocations, the latest expiring
+	// first, so the revocations saved while paging don't shift the pages
+	// which are not retrieved yet.
+	RetrieveAll(ctx context.Context, pm RevocationsPageMeta) (RevocationsPage, error)
+
+	// RemoveExpired removes the revocations which expired before the given time.
+	RemoveExpired(ctx context.Context, expiredBefore time.Time) error
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -36,6 +38,8 @@ var (
 	errIdentify  = errors.New("failed to validate token")
 	errPlatform  = errors.New("invalid platform id")
 	errRoleAuth  = errors.New("failed to authorize user role")
+	errNoSession = errors.New("refresh token does not belong to login session")
+	errRevokeAll = errors.New("failed to revoke user tokens")
 
 	errMalformedPAT        = errors.New("malformed personal access token")
 	errFailedToParseUUID   = errors.New("failed to parse string to UUID")
@@ -46,8 +50,12 @@ var (
 	errRetrievePAT         = errors.NewServiceError("failed to retrieve PAT")
 	errDeletePAT           = errors.NewServiceError("failed to delete PAT")
 	errInvalidScope        = errors.New("invalid scope")
+	errIPNotAllowed        = errors.New("client IP address is not allowed for PAT")
 )
 
+// maxPolicyScopes is the page size used to check PAT scopes against domain policies.
+const maxPolicyScopes = 100
+
 // Authz represents a authorization service. It exposes
 // functionalities through `auth` to perform authorization.
 type Authz interface {
@@ -68,8 +76,9 @@ type Authn interface {
 	Issue(ctx context.Context, token string, key Key) (Token, error)
 
 	// Revoke removes the Key with the provided id that is
-	// issued by the user identified by the provided key.
-	Revoke(ctx context.Context, token, id string) error
+	// issued by the user identified by the provided key, and
+	// revokes its token.
+	Revoke(ctx context.Context, token, id string) (Revocation, error)
 
 	// RetrieveKey retrieves data for the Key identified by the provided
 	// ID, that is issued by the user identified by the provided key.
@@ -92,6 +101,9 @@ type Service interface {
 	Authn
 	Authz
 	PATS
+	Sessions
+	Revocations
+	MFAPolicies
 }
 
 var _ Service = (*service)(nil)
@@ -99,7 +111,11 @@ var _ Service = (*service)(nil)
 type service struct {
 	keys               KeyRepository
 	pats               PATSRepository
+	sessions           SessionRepository
+	revocations        RevocationRepository
+	mfaPolicies        MFAPolicyRepository
 	cache              Cache
+	usage              PATUsageTracker
 	hasher             Hasher
 	idProvider         supermq.IDProvider
 	evaluator          policies.Evaluator
@@ -111,12 +127,16 @@ type service struct {
 }
 
 // New instantiates the auth service implementation.
-func New(keys KeyRepository, pats PATSRepository, cache Cache, hasher Hasher, idp supermq.IDProvider, tokenizer Tokenizer, policyEvaluator policies.Evaluator, policyService policies.Service, loginDuration, refreshDuration, invitationDuration time.Duration) Service {
+func New(keys KeyRepository, pats PATSRepository, sessions SessionRepository, revocations RevocationRepository, mfaPolicies MFAPolicyRepository, cache Cache, usage PATUsageTracker, hasher Hasher, idp supermq.IDProvider, tokenizer Tokenizer, policyEvaluator policies.Evaluator, policyService policies.Service, loginDuration, refreshDuration, invitationDuration time.Duration) Service {
 	return &service{
 		tokenizer:          tokenizer,
 		keys:               keys,
 		pats:               pats,
+		sessions:           sessions,
+		revocations:        revocations,
+		mfaPolicies:        mfaPolicies,
 		cache:              cache,
+		usage:              usage,
 		hasher:             hasher,
 		idProvider:         idp,
 		evaluator:          policyEvaluator,
@@ -143,14 +163,80 @@ func (svc service) Issue(ctx context.Context, token string, key Key) (Token, err
 	}
 }
 
-func (svc service) Revoke(ctx context.Context, token, id string) error {
+func (svc service) Revoke(ctx context.Context, token, id string) (Revocation, error) {
 	issuerID, _, err := svc.authenticate(ctx, token)
 	if err != nil {
-		return errors.Wrap(errRevoke, err)
+		return Revocation{}, errors.Wrap(errRevoke, err)
+	}
+	key, err := svc.keys.Retrieve(ctx, issuerID, id)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		// There is no token to revoke.
+		return Revocation{}, nil
+	case err != nil:
+		return Revocation{}, errors.Wrap(errRevoke, err)
 	}
 	if err := svc.keys.Remove(ctx, issuerID, id); err != nil {
-		return errors.Wrap(errRevoke, err)
+		return Revocation{}, errors.Wrap(errRevoke, err)
+	}
+
+	// The removed key is not valid in the auth service anymore, but its token
+	// is verified by the other services until it expires.
+	revocation := Revocation{TokenID: id, ExpiresAt: key.ExpiresAt}
+	if key.ExpiresAt.IsZero() {
+		revocation.ExpiresAt = neverExpires
+	}
+	if err := svc.saveRevocation(ctx, revocation); err != nil {
+		return Revocation{}, errors.Wrap(errRevoke, err)
+	}
+
+	return revocation, nil
+}
+
+func (svc service) RevokeUserTokens(ctx context.Context, userID string) (Revocation, error) {
+	now := time.Now().UTC()
+	revocation := Revocation{
+		Subject:   userID,
+		NotBefore: now,
+		ExpiresAt: now.Add(max(svc.loginDuration, svc.refreshDuration, svc.invitationDuration)),
+	}
+	if err := svc.saveRevocation(ctx, revocation); err != nil {
+		return Revocation{}, errors.Wrap(errRevokeAll, err)
+	}
+
+	return revocation, nil
+}
+
+func (svc service) ListRevocations(ctx context.Context, pm RevocationsPageMeta) (RevocationsPage, error) {
+	page, err := svc.revocations.RetrieveAll(ctx, pm)
+	if err != nil {
+		return RevocationsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (svc service) saveRevocation(ctx context.Context, revocation Revocation) error {
+	if err := svc.revocations.Save(ctx, revocation); err != nil {
+		return err
 	}
+	// The expired revocations are cleaned up along with the new ones, and
+	// the failed cleanup is retried on the next revocation.
+	_ = svc.revocations.RemoveExpired(ctx, time.Now().UTC())
+
+	return nil
+}
+
+// checkRevoked returns an error if the token of the key has been revoked.
+func (svc service) checkRevoked(ctx context.Context, key Key) error {
+	revoked, err := svc.revocations.Revoked(ctx, key)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if revoked {
+		return errors.Wrap(svcerr.ErrAuthentication, ErrRevokedToken)
+	}
+
 	return nil
 }
 
@@ -185,12 +271,18 @@ func (svc service) Identify(ctx context.Context, token string) (Key, error) {
 		}
 		return Key{ID: res.ID, Type: PersonalAccessToken, Subject: res.User, Role: res.Role}, nil
 	case RecoveryKey, AccessKey, InvitationKey, RefreshKey:
+		if err := svc.checkRevoked(ctx, key); err != nil {
+			return Key{}, err
+		}
 		return key, nil
 	case APIKey:
 		_, err := svc.keys.Retrieve(ctx, key.Issuer, key.ID)
 		if err != nil {
 			return Key{}, svcerr.ErrAuthentication
 		}
+		if err := svc.checkRevoked(ctx, key); err != nil {
+			return Key{}, err
+		}
 		return key, nil
 	default:
 		return Key{}, svcerr.ErrAuthentication
@@ -211,14 +303,21 @@ func (svc service) Authorize(ctx context.Context, pr policies.Policy) error {
 		if err != nil {
 			return err
 		}
-		if err := svc.AuthorizePAT(ctx, pr.UserID, pr.PatID, entityType, pr.Domain, pr.Operation, pr.EntityID); err != nil {
+		pat, err := svc.authorizePAT(ctx, pr.UserID, pr.PatID, entityType, pr.Domain, pr.Operation, pr.EntityID)
+		if err != nil {
 			return err
 		}
+		// The PAT requests are checked against the domain MFA policy
+		// with the MFA of the session which created the PAT.
+		ctx = WithMFA(ctx, pat.MFA)
 	}
 
 	if err := svc.PolicyValidation(pr); err != nil {
 		return errors.Wrap(svcerr.ErrMalformedEntity, err)
 	}
+	if err := svc.checkMFAPolicy(ctx, pr); err != nil {
+		return err
+	}
 	if err := svc.checkPolicy(ctx, pr); err != nil {
 		return err
 	}
@@ -226,6 +325,33 @@ func (svc service) Authorize(ctx context.Context, pr policies.Policy) error {
 	return nil
 }
 
+// checkMFAPolicy denies the request of the user session which didn't pass
+// the multi-factor authentication if the domain requires it.
+func (svc service) checkMFAPolicy(ctx context.Context, pr policies.Policy) error {
+	mfa, ok := mfaFromContext(ctx)
+	if !ok || mfa {
+		return nil
+	}
+	domainID := pr.Domain
+	if domainID == "" && pr.ObjectType == policies.DomainType {
+		domainID = pr.Object
+	}
+	if domainID == "" {
+		return nil
+	}
+	policy, err := svc.mfaPolicies.Retrieve(ctx, domainID)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		return nil
+	case err != nil:
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	case policy.Required:
+		return errors.Wrap(svcerr.ErrAuthorization, ErrMFARequired)
+	}
+
+	return nil
+}
+
 func (svc service) checkPolicy(ctx context.Context, pr policies.Policy) error {
 	if err := svc.evaluator.CheckPolicy(ctx, pr); err != nil {
 		return errors.Wrap(svcerr.ErrAuthorization, err)
@@ -262,18 +388,29 @@ func (svc service) accessKey(ctx context.Context, key Key) (Token, error) {
 		return Token{}, errors.Wrap(errIssueUser, err)
 	}
 
+	session, err := svc.newSession(ctx, key.Subject)
+	if err != nil {
+		return Token{}, errors.Wrap(errIssueUser, err)
+	}
+	key.SessionID = session.ID
+
 	access, err := svc.tokenizer.Issue(key)
 	if err != nil {
 		return Token{}, errors.Wrap(errIssueTmp, err)
 	}
 
-	key.ExpiresAt = time.Now().UTC().Add(svc.refreshDuration)
+	key.ID = session.TokenID
+	key.ExpiresAt = session.ExpiresAt
 	key.Type = RefreshKey
 	refresh, err := svc.tokenizer.Issue(key)
 	if err != nil {
 		return Token{}, errors.Wrap(errIssueTmp, err)
 	}
 
+	if err := svc.sessions.Save(ctx, session); err != nil {
+		return Token{}, errors.Wrap(errIssueUser, err)
+	}
+
 	return Token{AccessToken: access, RefreshToken: refresh}, nil
 }
 
@@ -302,7 +439,9 @@ func (svc service) refreshKey(ctx context.Context, token string, key Key) (Token
 	if k.Type != RefreshKey {
 		return Token{}, errIssueUser
 	}
-	key.ID = k.ID
+	if err := svc.checkRevoked(ctx, k); err != nil {
+		return Token{}, err
+	}
 	key.Type = AccessKey
 	key.Subject = k.Subject
 
@@ -310,6 +449,13 @@ func (svc service) refreshKey(ctx context.Context, token string, key Key) (Token
 		return Token{}, errors.Wrap(errIssueUser, err)
 	}
 	key.Role = k.Role
+	key.MFA = k.MFA
+
+	session, err := svc.rotateSession(ctx, k)
+	if err != nil {
+		return Token{}, err
+	}
+	key.SessionID = session.ID
 
 	key.ExpiresAt = time.Now().UTC().Add(svc.loginDuration)
 	access, err := svc.tokenizer.Issue(key)
@@ -317,7 +463,8 @@ func (svc service) refreshKey(ctx context.Context, token string, key Key) (Token
 		return Token{}, errors.Wrap(errIssueTmp, err)
 	}
 
-	key.ExpiresAt = time.Now().UTC().Add(svc.refreshDuration)
+	key.ID = session.TokenID
+	key.ExpiresAt = session.ExpiresAt
 	key.Type = RefreshKey
 	refresh, err := svc.tokenizer.Issue(key)
 	if err != nil {
@@ -327,6 +474,115 @@ func (svc service) refreshKey(ctx context.Context, token string, key Key) (Token
 	return Token{AccessToken: access, RefreshToken: refresh}, nil
 }
 
+func (svc service) newSession(ctx context.Context, userID string) (Session, error) {
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Session{}, err
+	}
+	tokenID, err := svc.idProvider.ID()
+	if err != nil {
+		return Session{}, err
+	}
+	now := time.Now().UTC()
+	ci := authn.ClientInfoFromContext(ctx)
+
+	return Session{
+		ID:        id,
+		UserID:    userID,
+		TokenID:   tokenID,
+		IP:        ci.IP,
+		UserAgent: ci.UserAgent,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(svc.refreshDuration),
+	}, nil
+}
+
+// rotateSession replaces the refresh token of the login session the given
+// refresh key belongs to. If the refresh key has already been rotated, it is
+// treated as stolen and the whole session is revoked.
+func (svc service) rotateSession(ctx context.Context, key Key) (Session, error) {
+	if key.SessionID == "" || key.ID == "" {
+		return Session{}, errors.Wrap(svcerr.ErrAuthentication, errNoSession)
+	}
+	session, err := svc.sessions.Retrieve(ctx, key.SessionID)
+	if err != nil {
+		return Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if session.UserID != key.Subject {
+		return Session{}, errors.Wrap(svcerr.ErrAuthentication, errNoSession)
+	}
+	if session.TokenID != key.ID {
+		return Session{}, svc.revokeReusedSession(ctx, session)
+	}
+
+	prevTokenID := session.TokenID
+	if session.TokenID, err = svc.idProvider.ID(); err != nil {
+		return Session{}, errors.Wrap(errIssueUser, err)
+	}
+	now := time.Now().UTC()
+	if ci := authn.ClientInfoFromContext(ctx); ci.IP != "" {
+		session.IP = ci.IP
+		session.UserAgent = ci.UserAgent
+	}
+	session.RefreshedAt = now
+	session.ExpiresAt = now.Add(svc.refreshDuration)
+	if err := svc.sessions.Rotate(ctx, session, prevTokenID); err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return Session{}, svc.revokeReusedSession(ctx, session)
+		}
+		return Session{}, errors.Wrap(errIssueUser, err)
+	}
+
+	return session, nil
+}
+
+func (svc service) revokeReusedSession(ctx context.Context, session Session) error {
+	err := errors.Wrap(svcerr.ErrAuthentication, ErrRefreshTokenReuse)
+	if errRemove := svc.sessions.Remove(ctx, session.UserID, session.ID); errRemove != nil && !errors.Contains(errRemove, repoerr.ErrNotFound) {
+		return errors.Wrap(err, errRemove)
+	}
+	if _, errRevoke := svc.revokeSessionTokens(ctx, session.ID); errRevoke != nil {
+		return errors.Wrap(err, errRevoke)
+	}
+	return err
+}
+
+// revokeSessionTokens revokes the tokens issued for the login session, which
+// stay valid until they expire even though the session itself is removed.
+func (svc service) revokeSessionTokens(ctx context.Context, sessionID string) (Revocation, error) {
+	revocation := Revocation{
+		SessionID: sessionID,
+		ExpiresAt: time.Now().UTC().Add(max(svc.loginDuration, svc.refreshDuration)),
+	}
+	if err := svc.saveRevocation(ctx, revocation); err != nil {
+		return Revocation{}, err
+	}
+
+	return revocation, nil
+}
+
+func (svc service) ListSessions(ctx context.Context, userID string, pm SessionsPageMeta) (SessionsPage, error) {
+	page, err := svc.sessions.RetrieveAll(ctx, userID, pm)
+	if err != nil {
+		return SessionsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return page, nil
+}
+
+func (svc service) RevokeSession(ctx context.Context, userID, sessionID string) (Revocation, error) {
+	if err := svc.sessions.Remove(ctx, userID, sessionID); err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return Revocation{}, svcerr.ErrNotFound
+		}
+		return Revocation{}, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+	revocation, err := svc.revokeSessionTokens(ctx, sessionID)
+	if err != nil {
+		return Revocation{}, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+	return revocation, nil
+}
+
 func (svc service) checkUserRole(ctx context.Context, key Key) (err error) {
 	switch key.Role {
 	case AdminRole:
@@ -459,12 +715,20 @@ func DecodeDomainUserID(domainUserID string) (string, string) {
 	}
 }
 
-func (svc service) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration) (PAT, error) {
+func (svc service) CreatePAT(ctx context.Context, token, name, description string, duration time.Duration, allowedCIDRs []string, scopes []Scope) (PAT, error) {
 	key, err := svc.Identify(ctx, token)
 	if err != nil {
 		return PAT{}, err
 	}
 
+	allowedCIDRs, err = ParseCIDRs(allowedCIDRs)
+	if err != nil {
+		return PAT{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	if err := svc.checkPATPolicies(ctx, duration, scopes); err != nil {
+		return PAT{}, err
+	}
+
 	id, err := svc.idProvider.ID()
 	if err != nil {
 		return PAT{}, errors.Wrap(svcerr.ErrCreateEntity, err)
@@ -476,15 +740,17 @@ func (svc service) CreatePAT(ctx context.Context, token, name, description strin
 
 	now := time.Now().UTC()
 	pat := PAT{
-		ID:          id,
-		User:        key.Subject,
-		Name:        name,
-		Description: description,
-		Secret:      hash,
-		IssuedAt:    now,
-		ExpiresAt:   now.Add(duration),
-		Status:      ActiveStatus,
-		Revoked:     false,
+		ID:           id,
+		User:         key.Subject,
+		Name:         name,
+		Description:  description,
+		AllowedCIDRs: allowedCIDRs,
+		Secret:       hash,
+		IssuedAt:     now,
+		ExpiresAt:    now.Add(duration),
+		Status:       ActiveStatus,
+		Revoked:      false,
+		MFA:          key.MFA,
 	}
 
 	if err := pat.Validate(); err != nil {
@@ -494,6 +760,14 @@ func (svc service) CreatePAT(ctx context.Context, token, name, description strin
 	if err := svc.pats.Save(ctx, pat); err != nil {
 		return PAT{}, errors.Wrap(errCreatePAT, err)
 	}
+	if len(scopes) > 0 {
+		if err := svc.addScopes(ctx, key.Subject, id, scopes); err != nil {
+			if errRollback := svc.pats.Remove(ctx, key.Subject, id); errRollback != nil {
+				err = errors.Wrap(err, errRollback)
+			}
+			return PAT{}, err
+		}
+	}
 	pat.Secret = secret
 
 	return pat, nil
@@ -523,6 +797,22 @@ func (svc service) UpdatePATDescription(ctx context.Context, token, patID, descr
 	return pat, nil
 }
 
+func (svc service) UpdatePATAllowedCIDRs(ctx context.Context, token, patID string, allowedCIDRs []string) (PAT, error) {
+	key, err := svc.authnAuthzUserPAT(ctx, token, patID)
+	if err != nil {
+		return PAT{}, err
+	}
+	allowedCIDRs, err = ParseCIDRs(allowedCIDRs)
+	if err != nil {
+		return PAT{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	pat, err := svc.pats.UpdateAllowedCIDRs(ctx, key.Subject, patID, allowedCIDRs)
+	if err != nil {
+		return PAT{}, errors.Wrap(errUpdatePAT, err)
+	}
+	return pat, nil
+}
+
 func (svc service) RetrievePAT(ctx context.Context, token, patID string) (PAT, error) {
 	key, err := svc.Identify(ctx, token)
 	if err != nil {
@@ -564,6 +854,14 @@ func (svc service) ResetPATSecret(ctx context.Context, token, patID string, dura
 		return PAT{}, err
 	}
 
+	scopes, err := svc.patScopes(ctx, patID)
+	if err != nil {
+		return PAT{}, err
+	}
+	if err := svc.checkPATPolicies(ctx, duration, scopes); err != nil {
+		return PAT{}, err
+	}
+
 	// Generate new HashToken take place here
 	secret, hash, err := svc.generateSecretAndHash(key.Subject, patID)
 	if err != nil {
@@ -608,11 +906,23 @@ func (svc service) RemoveAllPAT(ctx context.Context, token string) error {
 }
 
 func (svc service) AddScope(ctx context.Context, token, patID string, scopes []Scope) error {
-	key, err := svc.authnAuthzUserPAT(ctx, token, patID)
+	key, err := svc.Identify(ctx, token)
 	if err != nil {
 		return err
 	}
+	pat, err := svc.retrieveUserPAT(ctx, key.Subject, patID)
+	if err != nil {
+		return err
+	}
+	if err := svc.checkPATPolicies(ctx, time.Until(pat.ExpiresAt), scopes); err != nil {
+		return err
+	}
 
+	return svc.addScopes(ctx, key.Subject, patID, scopes)
+}
+
+func (svc service) addScopes(ctx context.Context, userID, patID string, scopes []Scope) error {
+	var err error
 	for i := range len(scopes) {
 		scopes[i].ID, err = svc.idProvider.ID()
 		if err != nil {
@@ -622,8 +932,7 @@ func (svc service) AddScope(ctx context.Context, token, patID string, scopes []S
 		scopes[i].PatID = patID
 	}
 
-	err = svc.pats.AddScope(ctx, key.Subject, scopes)
-	if err != nil {
+	if err := svc.pats.AddScope(ctx, userID, scopes); err != nil {
 		return errors.Wrap(svcerr.ErrCreateEntity, err)
 	}
 	return nil
@@ -675,7 +984,7 @@ func (svc service) IdentifyPAT(ctx context.Context, secret string) (PAT, error)
 	if err != nil {
 		return PAT{}, errors.Wrap(svcerr.ErrAuthentication, errMalformedPAT)
 	}
-	secretHash, revoked, expired, err := svc.pats.RetrieveSecretAndRevokeStatus(ctx, userID.String(), patID.String())
+	secretHash, revoked, expired, allowedCIDRs, err := svc.pats.RetrieveSecretAndRevokeStatus(ctx, userID.String(), patID.String())
 	if err != nil {
 		return PAT{}, errors.Wrap(svcerr.ErrAuthentication, err)
 	}
@@ -688,19 +997,178 @@ func (svc service) IdentifyPAT(ctx context.Context, secret string) (PAT, error)
 	if err := svc.hasher.Compare(secret, secretHash); err != nil {
 		return PAT{}, errors.Wrap(svcerr.ErrAuthentication, err)
 	}
+	ci := authn.ClientInfoFromContext(ctx)
+	if !(PAT{AllowedCIDRs: allowedCIDRs}).AllowsIP(ci.IP) {
+		return PAT{}, errors.Wrap(svcerr.ErrAuthentication, errIPNotAllowed)
+	}
+	svc.usage.Track(PATUsage{
+		PatID:      patID.String(),
+		UserID:     userID.String(),
+		LastUsedAt: time.Now().UTC(),
+		IP:         ci.IP,
+		UserAgent:  ci.UserAgent,
+		Count:      1,
+	})
 	role := svc.getUserRole(ctx, userID.String())
 	pat := PAT{ID: patID.String(), User: userID.String(), Role: role}
 	return pat, nil
 }
 
 func (svc service) AuthorizePAT(ctx context.Context, userID, patID string, entityType EntityType, domainID string, operation string, entityID string) error {
+	_, err := svc.authorizePAT(ctx, userID, patID, entityType, domainID, operation, entityID)
+	return err
+}
+
+func (svc service) authorizePAT(ctx context.Context, userID, patID string, entityType EntityType, domainID string, operation string, entityID string) (PAT, error) {
+	pat, err := svc.pats.Retrieve(ctx, userID, patID)
+	if err != nil {
+		return PAT{}, errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !pat.AllowsIP(authn.ClientInfoFromContext(ctx).IP) {
+		return PAT{}, errors.Wrap(svcerr.ErrAuthorization, errIPNotAllowed)
+	}
 	if err := svc.pats.CheckScope(ctx, userID, patID, entityType, domainID, operation, entityID); err != nil {
-		return errors.Wrap(svcerr.ErrAuthorization, err)
+		return PAT{}, errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+
+	return pat, nil
+}
+
+func (svc service) SetPATPolicy(ctx context.Context, token string, policy PATPolicy) (PATPolicy, error) {
+	key, err := svc.Identify(ctx, token)
+	if err != nil {
+		return PATPolicy{}, err
+	}
+	if err := svc.checkDomainPermission(ctx, key.Subject, policy.DomainID, policies.AdminPermission); err != nil {
+		return PATPolicy{}, err
+	}
+	if err := policy.Validate(); err != nil {
+		return PATPolicy{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	policy.UpdatedAt = time.Now().UTC()
+	policy.UpdatedBy = key.Subject
+	if err := svc.pats.SavePolicy(ctx, policy); err != nil {
+		return PATPolicy{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return policy, nil
+}
+
+func (svc service) RetrievePATPolicy(ctx context.Context, token, domainID string) (PATPolicy, error) {
+	key, err := svc.Identify(ctx, token)
+	if err != nil {
+		return PATPolicy{}, err
+	}
+	if err := svc.checkDomainPermission(ctx, key.Subject, domainID, policies.MembershipPermission); err != nil {
+		return PATPolicy{}, err
+	}
+	pps, err := svc.pats.RetrievePolicies(ctx, domainID)
+	if err != nil {
+		return PATPolicy{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if len(pps) == 0 {
+		return PATPolicy{}, svcerr.ErrNotFound
 	}
+	return pps[0], nil
+}
 
+func (svc service) RemovePATPolicy(ctx context.Context, token, domainID string) error {
+	key, err := svc.Identify(ctx, token)
+	if err != nil {
+		return err
+	}
+	if err := svc.checkDomainPermission(ctx, key.Subject, domainID, policies.AdminPermission); err != nil {
+		return err
+	}
+	if err := svc.pats.RemovePolicy(ctx, domainID); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
 	return nil
 }
 
+func (svc service) SetMFAPolicy(ctx context.Context, token string, policy MFAPolicy) (MFAPolicy, error) {
+	key, err := svc.Identify(ctx, token)
+	if err != nil {
+		return MFAPolicy{}, err
+	}
+	if err := svc.checkDomainPermission(ctx, key.Subject, policy.DomainID, policies.AdminPermission); err != nil {
+		return MFAPolicy{}, err
+	}
+	policy.UpdatedAt = time.Now().UTC()
+	policy.UpdatedBy = key.Subject
+	if err := svc.mfaPolicies.Save(ctx, policy); err != nil {
+		return MFAPolicy{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	return policy, nil
+}
+
+func (svc service) RetrieveMFAPolicy(ctx context.Context, token, domainID string) (MFAPolicy, error) {
+	key, err := svc.Identify(ctx, token)
+	if err != nil {
+		return MFAPolicy{}, err
+	}
+	if err := svc.checkDomainPermission(ctx, key.Subject, domainID, policies.MembershipPermission); err != nil {
+		return MFAPolicy{}, err
+	}
+	policy, err := svc.mfaPolicies.Retrieve(ctx, domainID)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		return MFAPolicy{DomainID: domainID}, nil
+	case err != nil:
+		return MFAPolicy{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	return policy, nil
+}
+
+func (svc service) checkDomainPermission(ctx context.Context, userID, domainID, permission string) error {
+	return svc.checkPolicy(ctx, policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		Permission:  permission,
+		Object:      domainID,
+		ObjectType:  policies.DomainType,
+	})
+}
+
+// checkPATPolicies checks the PAT secret duration and the scopes against the
+// policies of the domains the scopes belong to.
+func (svc service) checkPATPolicies(ctx context.Context, duration time.Duration, scopes []Scope) error {
+	var domainIDs []string
+	for _, s := range scopes {
+		if s.DomainID != "" && !slices.Contains(domainIDs, s.DomainID) {
+			domainIDs = append(domainIDs, s.DomainID)
+		}
+	}
+	if len(domainIDs) == 0 {
+		return nil
+	}
+	pps, err := svc.pats.RetrievePolicies(ctx, domainIDs...)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	for _, pp := range pps {
+		if err := pp.Check(duration, scopes); err != nil {
+			return errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+	}
+	return nil
+}
+
+func (svc service) patScopes(ctx context.Context, patID string) ([]Scope, error) {
+	var scopes []Scope
+	pm := ScopesPageMeta{PatID: patID, Limit: maxPolicyScopes}
+	for {
+		page, err := svc.pats.RetrieveScope(ctx, pm)
+		if err != nil {
+			return nil, errors.Wrap(errRetrievePAT, err)
+		}
+		scopes = append(scopes, page.Scopes...)
+		pm.Offset += uint64(len(page.Scopes))
+		if len(page.Scopes) == 0 || pm.Offset >= page.Total {
+			return scopes, nil
+		}
+	}
+}
+
 func (svc service) generateSecretAndHash(userID, patID string) (string, string, error) {
 	uID, err := uuid.Parse(userID)
 	if err != nil {
@@ -764,13 +1232,21 @@ func (svc service) authnAuthzUserPAT(ctx context.Context, token, patID string) (
 		return Key{}, err
 	}
 
-	_, err = svc.pats.Retrieve(ctx, key.Subject, patID)
+	if _, err := svc.retrieveUserPAT(ctx, key.Subject, patID); err != nil {
+		return Key{}, err
+	}
+
+	return key, nil
+}
+
+func (svc service) retrieveUserPAT(ctx context.Context, userID, patID string) (PAT, error) {
+	pat, err := svc.pats.Retrieve(ctx, userID, patID)
 	if err != nil {
 		if errors.Contains(err, repoerr.ErrNotFound) {
-			return Key{}, svcerr.ErrNotFound
+			return PAT{}, svcerr.ErrNotFound
 		}
-		return Key{}, errors.Wrap(svcerr.ErrAuthorization, err)
+		return PAT{}, errors.Wrap(svcerr.ErrAuthorization, err)
 	}
 
-	return key, nil
+	return pat, nil
 }
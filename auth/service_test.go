@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/base64"
 	"fmt"
 	"testing"
 	"time"
@@ -14,12 +15,14 @@ import (
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/auth/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
 	policymocks "github.com/absmach/supermq/pkg/policies/mocks"
 	"github.com/absmach/supermq/pkg/uuid"
+	guuid "github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/stretchr/testify/assert"
@@ -58,9 +61,15 @@ var (
 	pService   *policymocks.Service
 	pEvaluator *policymocks.Evaluator
 	patsrepo   *mocks.PATSRepository
-	cache      *mocks.Cache
-	hasher     *mocks.Hasher
-	tokenizer  *mocks.Tokenizer
+	sessrepo   *mocks.SessionRepository
+	revrepo    *mocks.RevocationRepository
+	// revokedCall is the default revocation check, which finds no revocation.
+	revokedCall *mock.Call
+	mfarepo     *mocks.MFAPolicyRepository
+	usage       *mocks.PATUsageTracker
+	cache       *mocks.Cache
+	hasher      *mocks.Hasher
+	tokenizer   *mocks.Tokenizer
 )
 
 func newService(t *testing.T) (auth.Service, string) {
@@ -69,6 +78,11 @@ func newService(t *testing.T) (auth.Service, string) {
 	pService = new(policymocks.Service)
 	pEvaluator = new(policymocks.Evaluator)
 	patsrepo = new(mocks.PATSRepository)
+	sessrepo = new(mocks.SessionRepository)
+	revrepo = new(mocks.RevocationRepository)
+	revokedCall = revrepo.On("Revoked", mock.Anything, mock.Anything).Return(false, nil)
+	mfarepo = new(mocks.MFAPolicyRepository)
+	usage = new(mocks.PATUsageTracker)
 	hasher = new(mocks.Hasher)
 	idProvider := uuid.NewMock()
 	tokenizer = new(mocks.Tokenizer)
@@ -76,7 +90,7 @@ func newService(t *testing.T) (auth.Service, string) {
 	token, _, err := signToken(t, issuerName, accessKey, false)
 	assert.Nil(t, err, fmt.Sprintf("Issuing access key expected to succeed: %s", err))
 
-	return auth.New(krepo, patsrepo, cache, hasher, idProvider, tokenizer, pEvaluator, pService, loginDuration, refreshDuration, invalidDuration), token
+	return auth.New(krepo, patsrepo, sessrepo, revrepo, mfarepo, cache, usage, hasher, idProvider, tokenizer, pEvaluator, pService, loginDuration, refreshDuration, invalidDuration), token
 }
 
 func TestIssue(t *testing.T) {
@@ -101,12 +115,23 @@ func TestIssue(t *testing.T) {
 	assert.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
 
 	refreshkey := auth.Key{
+		ID:        testsutil.GenerateUUID(t),
 		IssuedAt:  time.Now(),
 		ExpiresAt: time.Now().Add(refreshDuration),
 		Subject:   userID,
 		Type:      auth.RefreshKey,
 		Role:      auth.UserRole,
+		SessionID: testsutil.GenerateUUID(t),
 	}
+	session := auth.Session{
+		ID:        refreshkey.SessionID,
+		UserID:    userID,
+		TokenID:   refreshkey.ID,
+		IssuedAt:  time.Now(),
+		ExpiresAt: refreshkey.ExpiresAt,
+	}
+	legacyRefreshkey := refreshkey
+	legacyRefreshkey.SessionID = ""
 	refreshToken, _, err := signToken(t, issuerName, refreshkey, false)
 	assert.Nil(t, err, fmt.Sprintf("Issuing refresh key expected to succeed: %s", err))
 
@@ -149,14 +174,15 @@ func TestIssue(t *testing.T) {
 	}
 
 	cases2 := []struct {
-		desc         string
-		key          auth.Key
-		saveResponse auth.Key
-		token        string
-		tokenizerErr error
-		saveErr      error
-		roleCheckErr error
-		err          error
+		desc           string
+		key            auth.Key
+		saveResponse   auth.Key
+		token          string
+		tokenizerErr   error
+		saveErr        error
+		sessionSaveErr error
+		roleCheckErr   error
+		err            error
 	}{
 		{
 			desc: "issue access key",
@@ -169,11 +195,24 @@ func TestIssue(t *testing.T) {
 			token: accessToken,
 			err:   nil,
 		},
+		{
+			desc: "issue access key with failed to save session",
+			key: auth.Key{
+				Type:     auth.AccessKey,
+				Subject:  userID,
+				Role:     auth.UserRole,
+				IssuedAt: time.Now(),
+			},
+			token:          accessToken,
+			sessionSaveErr: repoerr.ErrCreateEntity,
+			err:            repoerr.ErrCreateEntity,
+		},
 	}
 	for _, tc := range cases2 {
 		t.Run(tc.desc, func(t *testing.T) {
 			tokenizerCall := tokenizer.On("Issue", mock.Anything).Return(tc.token, tc.tokenizerErr)
 			repoCall := krepo.On("Save", mock.Anything, mock.Anything).Return(mock.Anything, tc.saveErr)
+			sessionCall := sessrepo.On("Save", mock.Anything, mock.Anything).Return(tc.sessionSaveErr)
 			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, policies.Policy{
 				Subject:     tc.key.Subject,
 				SubjectType: policies.UserType,
@@ -185,6 +224,7 @@ func TestIssue(t *testing.T) {
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
 			tokenizerCall.Unset()
 			repoCall.Unset()
+			sessionCall.Unset()
 			policyCall.Unset()
 		})
 	}
@@ -291,7 +331,11 @@ func TestIssue(t *testing.T) {
 		parseRes     auth.Key
 		parseErr     error
 		roleCheckErr error
+		session      auth.Session
+		retrieveErr  error
+		rotateErr    error
 		issueErr     error
+		revoked      bool
 		err          error
 	}{
 		{
@@ -304,8 +348,84 @@ func TestIssue(t *testing.T) {
 			},
 			token:    refreshToken,
 			parseRes: refreshkey,
+			session:  session,
 			err:      nil,
 		},
+		{
+			desc: "issue refresh key with already rotated token",
+			key: auth.Key{
+				Type:     auth.RefreshKey,
+				IssuedAt: time.Now(),
+				Subject:  userID,
+				Role:     auth.UserRole,
+			},
+			token:    refreshToken,
+			parseRes: refreshkey,
+			session: auth.Session{
+				ID:      session.ID,
+				UserID:  userID,
+				TokenID: testsutil.GenerateUUID(t),
+			},
+			revoked: true,
+			err:     auth.ErrRefreshTokenReuse,
+		},
+		{
+			desc: "issue refresh key with concurrently rotated token",
+			key: auth.Key{
+				Type:     auth.RefreshKey,
+				IssuedAt: time.Now(),
+				Subject:  userID,
+				Role:     auth.UserRole,
+			},
+			token:     refreshToken,
+			parseRes:  refreshkey,
+			session:   session,
+			rotateErr: repoerr.ErrNotFound,
+			revoked:   true,
+			err:       auth.ErrRefreshTokenReuse,
+		},
+		{
+			desc: "issue refresh key with revoked session",
+			key: auth.Key{
+				Type:     auth.RefreshKey,
+				IssuedAt: time.Now(),
+				Subject:  userID,
+				Role:     auth.UserRole,
+			},
+			token:       refreshToken,
+			parseRes:    refreshkey,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc: "issue refresh key with session of other user",
+			key: auth.Key{
+				Type:     auth.RefreshKey,
+				IssuedAt: time.Now(),
+				Subject:  userID,
+				Role:     auth.UserRole,
+			},
+			token:    refreshToken,
+			parseRes: refreshkey,
+			session: auth.Session{
+				ID:      session.ID,
+				UserID:  testsutil.GenerateUUID(t),
+				TokenID: refreshkey.ID,
+			},
+			err: svcerr.ErrAuthentication,
+		},
+		{
+			desc: "issue refresh key without session",
+			key: auth.Key{
+				Type:     auth.RefreshKey,
+				IssuedAt: time.Now(),
+				Subject:  userID,
+				Role:     auth.UserRole,
+			},
+			token:    refreshToken,
+			parseRes: legacyRefreshkey,
+			err:      svcerr.ErrAuthentication,
+		},
 		{
 			desc: "issue refresh key with invalid token",
 			key: auth.Key{
@@ -355,11 +475,177 @@ func TestIssue(t *testing.T) {
 				Object:      policies.SuperMQObject,
 				ObjectType:  policies.PlatformType,
 			}).Return(tc.roleCheckErr)
+			retrieveCall := sessrepo.On("Retrieve", mock.Anything, refreshkey.SessionID).Return(tc.session, tc.retrieveErr)
+			var rotated auth.Session
+			rotateCall := sessrepo.On("Rotate", mock.Anything, mock.Anything, refreshkey.ID).Run(func(args mock.Arguments) {
+				rotated = args.Get(1).(auth.Session)
+			}).Return(tc.rotateErr)
+			removed := false
+			removeCall := sessrepo.On("Remove", mock.Anything, userID, session.ID).Run(func(_ mock.Arguments) {
+				removed = true
+			}).Return(nil)
+			var revocation auth.Revocation
+			saveCall := revrepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				revocation = args.Get(1).(auth.Revocation)
+			}).Return(nil)
+			removeExpiredCall := revrepo.On("RemoveExpired", mock.Anything, mock.Anything).Return(nil)
 			_, err := svc.Issue(context.Background(), tc.token, tc.key)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.NotEqual(t, refreshkey.ID, rotated.TokenID, fmt.Sprintf("%s: expected refresh token ID to be rotated\n", tc.desc))
+				assert.False(t, rotated.RefreshedAt.IsZero(), fmt.Sprintf("%s: expected session refresh time to be set\n", tc.desc))
+			}
+			assert.Equal(t, tc.revoked, removed, fmt.Sprintf("%s: expected session revoked %v got %v\n", tc.desc, tc.revoked, removed))
+			assert.Equal(t, tc.revoked, revocation.SessionID == session.ID, fmt.Sprintf("%s: expected session tokens revoked %v\n", tc.desc, tc.revoked))
 			tokenizerCall.Unset()
 			tokenizerCall1.Unset()
 			policyCall.Unset()
+			retrieveCall.Unset()
+			rotateCall.Unset()
+			removeCall.Unset()
+			saveCall.Unset()
+			removeExpiredCall.Unset()
+		})
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	svc, _ := newService(t)
+
+	page := auth.SessionsPage{
+		Total:  1,
+		Limit:  10,
+		Offset: 0,
+		Sessions: []auth.Session{
+			{ID: testsutil.GenerateUUID(t), UserID: userID, IP: "192.0.2.1", UserAgent: "smq-cli/1.0"},
+		},
+	}
+
+	cases := []struct {
+		desc    string
+		pm      auth.SessionsPageMeta
+		page    auth.SessionsPage
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "list sessions successfully",
+			pm:   auth.SessionsPageMeta{Limit: 10},
+			page: page,
+		},
+		{
+			desc:    "list sessions with failed to retrieve",
+			pm:      auth.SessionsPageMeta{Limit: 10},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := sessrepo.On("RetrieveAll", mock.Anything, userID, tc.pm).Return(tc.page, tc.repoErr)
+			res, err := svc.ListSessions(context.Background(), userID, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, res))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestListRevocations(t *testing.T) {
+	svc, _ := newService(t)
+
+	page := auth.RevocationsPage{
+		Total: 1,
+		Limit: 10,
+		Revocations: []auth.Revocation{
+			{TokenID: testsutil.GenerateUUID(t), ExpiresAt: time.Now().Add(time.Hour)},
+		},
+	}
+
+	cases := []struct {
+		desc    string
+		pm      auth.RevocationsPageMeta
+		page    auth.RevocationsPage
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "list revocations successfully",
+			pm:   auth.RevocationsPageMeta{Limit: 10},
+			page: page,
+		},
+		{
+			desc:    "list revocations with failed to retrieve",
+			pm:      auth.RevocationsPageMeta{Limit: 10},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := revrepo.On("RetrieveAll", mock.Anything, tc.pm).Return(tc.page, tc.repoErr)
+			res, err := svc.ListRevocations(context.Background(), tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, res))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	svc, _ := newService(t)
+
+	sessionID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc    string
+		repoErr error
+		saveErr error
+		err     error
+	}{
+		{
+			desc: "revoke session successfully",
+		},
+		{
+			desc:    "revoke non-existent session",
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrNotFound,
+		},
+		{
+			desc:    "revoke session with failed to remove",
+			repoErr: repoerr.ErrRemoveEntity,
+			err:     svcerr.ErrRemoveEntity,
+		},
+		{
+			desc:    "revoke session with failed to save revocation",
+			saveErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var saved auth.Revocation
+			repoCall := sessrepo.On("Remove", mock.Anything, userID, sessionID).Return(tc.repoErr)
+			repoCall1 := revrepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(auth.Revocation)
+			}).Return(tc.saveErr)
+			repoCall2 := revrepo.On("RemoveExpired", mock.Anything, mock.Anything).Return(nil)
+			revocation, err := svc.RevokeSession(context.Background(), userID, sessionID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.repoErr == nil {
+				assert.Equal(t, sessionID, saved.SessionID, fmt.Sprintf("%s: expected the session tokens to be revoked", tc.desc))
+				assert.True(t, saved.Revokes(auth.Key{SessionID: sessionID, Subject: userID, Type: auth.AccessKey}), fmt.Sprintf("%s: expected the revocation to revoke the session access tokens", tc.desc))
+				assert.False(t, saved.ExpiresAt.Before(time.Now().Add(refreshDuration-time.Minute)), fmt.Sprintf("%s: expected the revocation to outlive the session tokens", tc.desc))
+			}
+			if tc.err == nil {
+				assert.Equal(t, saved, revocation, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, saved, revocation))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
 		})
 	}
 }
@@ -383,26 +669,39 @@ func TestRevoke(t *testing.T) {
 	}
 	apiToken, _, err := signToken(t, issuerName, apikey, false)
 	assert.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
+	storedKey := apikey
+	storedKey.ID = validID
+	storedKey.ExpiresAt = time.Now().Add(time.Hour).UTC()
 
 	cases := []struct {
-		desc     string
-		id       string
-		token    string
-		parseRes auth.Key
-		parseErr error
-		err      error
+		desc        string
+		id          string
+		token       string
+		parseRes    auth.Key
+		parseErr    error
+		retrieveRes auth.Key
+		retrieveErr error
+		removeErr   error
+		saveErr     error
+		revocation  auth.Revocation
+		err         error
 	}{
 		{
-			desc:     "revoke login key",
-			token:    apiToken,
-			parseRes: accesskey,
-			err:      nil,
+			desc:        "revoke login key",
+			id:          validID,
+			token:       apiToken,
+			parseRes:    accesskey,
+			retrieveRes: storedKey,
+			revocation:  auth.Revocation{TokenID: validID, ExpiresAt: storedKey.ExpiresAt},
+			err:         nil,
 		},
 		{
-			desc:     "revoke non-existing login key",
-			token:    apiToken,
-			parseRes: accesskey,
-			err:      nil,
+			desc:        "revoke non-existing login key",
+			id:          validID,
+			token:       apiToken,
+			parseRes:    accesskey,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         nil,
 		},
 		{
 			desc:     "revoke with empty login key",
@@ -412,22 +711,93 @@ func TestRevoke(t *testing.T) {
 			err:      svcerr.ErrAuthentication,
 		},
 		{
-			desc:     "revoke login key with failed to remove",
-			id:       "invalidID",
-			token:    apiToken,
-			parseRes: accesskey,
-			err:      svcerr.ErrNotFound,
+			desc:        "revoke login key with failed to retrieve",
+			id:          validID,
+			token:       apiToken,
+			parseRes:    accesskey,
+			retrieveErr: repoerr.ErrViewEntity,
+			err:         repoerr.ErrViewEntity,
+		},
+		{
+			desc:        "revoke login key with failed to remove",
+			id:          "invalidID",
+			token:       apiToken,
+			parseRes:    accesskey,
+			retrieveRes: storedKey,
+			removeErr:   svcerr.ErrNotFound,
+			err:         svcerr.ErrNotFound,
+		},
+		{
+			desc:        "revoke login key with failed to save revocation",
+			id:          validID,
+			token:       apiToken,
+			parseRes:    accesskey,
+			retrieveRes: storedKey,
+			saveErr:     repoerr.ErrCreateEntity,
+			err:         repoerr.ErrCreateEntity,
 		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			tokenizerCall := tokenizer.On("Parse", mock.Anything, tc.token).Return(tc.parseRes, tc.parseErr)
-			repoCall := krepo.On("Remove", mock.Anything, mock.Anything, mock.Anything).Return(tc.err)
-			err := svc.Revoke(context.Background(), tc.token, tc.id)
+			repoCall := krepo.On("Retrieve", mock.Anything, mock.Anything, tc.id).Return(tc.retrieveRes, tc.retrieveErr)
+			repoCall1 := krepo.On("Remove", mock.Anything, mock.Anything, mock.Anything).Return(tc.removeErr)
+			repoCall2 := revrepo.On("Save", mock.Anything, mock.Anything).Return(tc.saveErr)
+			repoCall3 := revrepo.On("RemoveExpired", mock.Anything, mock.Anything).Return(nil)
+			revocation, err := svc.Revoke(context.Background(), tc.token, tc.id)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.revocation, revocation, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.revocation, revocation))
+			}
 			tokenizerCall.Unset()
 			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+		})
+	}
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	svc, _ := newService(t)
+
+	cases := []struct {
+		desc    string
+		userID  string
+		saveErr error
+		err     error
+	}{
+		{
+			desc:   "revoke user tokens successfully",
+			userID: userID,
+		},
+		{
+			desc:    "revoke user tokens with failed to save revocation",
+			userID:  userID,
+			saveErr: repoerr.ErrCreateEntity,
+			err:     repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var saved auth.Revocation
+			repoCall := revrepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(auth.Revocation)
+			}).Return(tc.saveErr)
+			repoCall1 := revrepo.On("RemoveExpired", mock.Anything, mock.Anything).Return(nil)
+			before := time.Now().UTC()
+			revocation, err := svc.RevokeUserTokens(context.Background(), tc.userID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.userID, saved.Subject)
+			assert.False(t, saved.NotBefore.Before(before), "expected the tokens issued so far to be revoked")
+			assert.Equal(t, saved.NotBefore.Add(invalidDuration), saved.ExpiresAt, "expected the revocation to outlive the longest living token")
+			if err == nil {
+				assert.Equal(t, saved, revocation)
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
 		})
 	}
 }
@@ -562,12 +932,14 @@ func TestIdentify(t *testing.T) {
 	assert.Nil(t, err, fmt.Sprintf("Issuing invalid token type key expected to succeed: %s", err))
 
 	cases := []struct {
-		desc     string
-		key      string
-		subject  string
-		parseRes auth.Key
-		parseErr error
-		err      error
+		desc       string
+		key        string
+		subject    string
+		parseRes   auth.Key
+		parseErr   error
+		revoked    bool
+		revokedErr error
+		err        error
 	}{
 		{
 			desc:     "identify login key",
@@ -597,6 +969,22 @@ func TestIdentify(t *testing.T) {
 			parseRes: apiKey,
 			err:      nil,
 		},
+		{
+			desc:     "identify revoked login key",
+			key:      accessToken,
+			subject:  "",
+			parseRes: accessKey,
+			revoked:  true,
+			err:      auth.ErrRevokedToken,
+		},
+		{
+			desc:       "identify login key with failed to check revocation",
+			key:        accessToken,
+			subject:    "",
+			parseRes:   accessKey,
+			revokedErr: repoerr.ErrViewEntity,
+			err:        svcerr.ErrAuthentication,
+		},
 		{
 			desc:     "identify expired API key",
 			key:      expSecret,
@@ -627,21 +1015,126 @@ func TestIdentify(t *testing.T) {
 		},
 	}
 
+	revokedCall.Unset()
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			tokenizerCall := tokenizer.On("Parse", mock.Anything, tc.key).Return(tc.parseRes, tc.parseErr)
+			revCall := revrepo.On("Revoked", mock.Anything, mock.Anything).Return(tc.revoked, tc.revokedErr)
 			repoCall := krepo.On("Retrieve", mock.Anything, mock.Anything, mock.Anything).Return(auth.Key{}, tc.err)
 			repoCall1 := krepo.On("Remove", mock.Anything, mock.Anything, mock.Anything).Return(tc.err)
 			idt, err := svc.Identify(context.Background(), tc.key)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
 			assert.Equal(t, tc.subject, idt.Subject, fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.subject, idt))
 			tokenizerCall.Unset()
+			revCall.Unset()
 			repoCall.Unset()
 			repoCall1.Unset()
 		})
 	}
 }
 
+func TestIdentifyPAT(t *testing.T) {
+	svc, _ := newService(t)
+
+	uid := guuid.MustParse(userID)
+	pid := guuid.New()
+	secret := "pat_" + base64.StdEncoding.EncodeToString(append(uid[:], pid[:]...)) + "_secret"
+	ci := authn.ClientInfo{IP: "192.0.2.1", UserAgent: "smq-cli/1.0"}
+
+	cases := []struct {
+		desc         string
+		secret       string
+		revoked      bool
+		expired      bool
+		allowedCIDRs []string
+		retrieveErr  error
+		compareErr   error
+		track        bool
+		err          error
+	}{
+		{
+			desc:   "identify valid PAT",
+			secret: secret,
+			track:  true,
+		},
+		{
+			desc:         "identify valid PAT from allowed IP",
+			secret:       secret,
+			allowedCIDRs: []string{"10.0.0.0/8", "192.0.2.0/24"},
+			track:        true,
+		},
+		{
+			desc:         "identify valid PAT from not allowed IP",
+			secret:       secret,
+			allowedCIDRs: []string{"10.0.0.0/8"},
+			err:          svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "identify malformed PAT",
+			secret: "pat_invalid_secret",
+			err:    svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "identify non-existent PAT",
+			secret:      secret,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:    "identify revoked PAT",
+			secret:  secret,
+			revoked: true,
+			err:     svcerr.ErrAuthentication,
+		},
+		{
+			desc:    "identify expired PAT",
+			secret:  secret,
+			expired: true,
+			err:     svcerr.ErrAuthentication,
+		},
+		{
+			desc:       "identify PAT with invalid secret",
+			secret:     secret,
+			compareErr: svcerr.ErrAuthentication,
+			err:        svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := patsrepo.On("RetrieveSecretAndRevokeStatus", mock.Anything, userID, pid.String()).Return("hash", tc.revoked, tc.expired, tc.allowedCIDRs, tc.retrieveErr)
+			hashCall := hasher.On("Compare", tc.secret, "hash").Return(tc.compareErr)
+			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, mock.Anything).Return(svcerr.ErrAuthorization)
+			var tracked []auth.PATUsage
+			trackCall := usage.On("Track", mock.Anything).Run(func(args mock.Arguments) {
+				tracked = append(tracked, args.Get(0).(auth.PATUsage))
+			}).Return()
+			pat, err := svc.IdentifyPAT(authn.WithClientInfo(context.Background(), ci), tc.secret)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, pid.String(), pat.ID, fmt.Sprintf("%s: expected PAT ID %s got %s\n", tc.desc, pid, pat.ID))
+				assert.Equal(t, userID, pat.User, fmt.Sprintf("%s: expected user ID %s got %s\n", tc.desc, userID, pat.User))
+			}
+			if tc.track {
+				assert.Len(t, tracked, 1, fmt.Sprintf("%s: expected PAT usage to be tracked\n", tc.desc))
+				u := tracked[0]
+				assert.Equal(t, pid.String(), u.PatID, fmt.Sprintf("%s: expected tracked PAT ID %s got %s\n", tc.desc, pid, u.PatID))
+				assert.Equal(t, userID, u.UserID, fmt.Sprintf("%s: expected tracked user ID %s got %s\n", tc.desc, userID, u.UserID))
+				assert.Equal(t, ci.IP, u.IP, fmt.Sprintf("%s: expected tracked IP %s got %s\n", tc.desc, ci.IP, u.IP))
+				assert.Equal(t, ci.UserAgent, u.UserAgent, fmt.Sprintf("%s: expected tracked user agent %s got %s\n", tc.desc, ci.UserAgent, u.UserAgent))
+				assert.Equal(t, uint64(1), u.Count, fmt.Sprintf("%s: expected tracked count 1 got %d\n", tc.desc, u.Count))
+				assert.False(t, u.LastUsedAt.IsZero(), fmt.Sprintf("%s: expected tracked last used time to be set\n", tc.desc))
+			} else {
+				assert.Empty(t, tracked, fmt.Sprintf("%s: expected PAT usage not to be tracked\n", tc.desc))
+			}
+			repoCall.Unset()
+			hashCall.Unset()
+			policyCall.Unset()
+			trackCall.Unset()
+		})
+	}
+}
+
 func TestAuthorize(t *testing.T) {
 	svc, _ := newService(t)
 
@@ -821,11 +1314,13 @@ func TestAuthorize(t *testing.T) {
 			if tc.expectCheckPolicy {
 				policyCall = pEvaluator.On("CheckPolicy", mock.Anything, tc.checkPolicyReq).Return(tc.checkPolicyErr)
 			}
-			var patCall *mock.Call
+			var patCall, retrieveCall *mock.Call
 			if tc.expectPATCheck {
+				retrieveCall = patsrepo.On("Retrieve", mock.Anything, tc.policyReq.UserID, tc.policyReq.PatID).Return(auth.PAT{}, nil)
 				patCall = patsrepo.On("CheckScope", mock.Anything, tc.policyReq.UserID, tc.policyReq.PatID, tc.patEntityType, tc.policyReq.Domain, tc.policyReq.Operation, tc.policyReq.EntityID).Return(tc.patScopeErr)
 			}
 			repoCall := krepo.On("Remove", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mfaCall := mfarepo.On("Retrieve", mock.Anything, mock.Anything).Return(auth.MFAPolicy{}, repoerr.ErrNotFound)
 			err := svc.Authorize(context.Background(), tc.policyReq)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
 			if policyCall != nil {
@@ -833,8 +1328,448 @@ func TestAuthorize(t *testing.T) {
 			}
 			if patCall != nil {
 				patCall.Unset()
+				retrieveCall.Unset()
 			}
 			repoCall.Unset()
+			mfaCall.Unset()
+		})
+	}
+}
+
+func TestAuthorizeMFA(t *testing.T) {
+	svc, _ := newService(t)
+
+	policyReq := policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      validID,
+		ObjectType:  policies.ChannelType,
+		Permission:  policies.ViewPermission,
+		Domain:      domainID,
+	}
+	domainReq := policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      domainID,
+		ObjectType:  policies.DomainType,
+		Permission:  policies.MembershipPermission,
+	}
+	patReq := policies.Policy{
+		Subject:     userID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      validID,
+		ObjectType:  policies.ChannelType,
+		Permission:  policies.ViewPermission,
+		Domain:      domainID,
+		PatID:       validID,
+		UserID:      userID,
+		EntityType:  auth.ChannelsScopeStr,
+		Operation:   auth.OpListChannels,
+		EntityID:    validID,
+	}
+
+	cases := []struct {
+		desc        string
+		ctx         context.Context
+		policyReq   policies.Policy
+		pat         auth.PAT
+		mfaPolicy   auth.MFAPolicy
+		retrieveErr error
+		expectCheck bool
+		err         error
+	}{
+		{
+			desc:        "authorize session without MFA in domain requiring MFA",
+			ctx:         auth.WithMFA(context.Background(), false),
+			policyReq:   policyReq,
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: false,
+			err:         auth.ErrMFARequired,
+		},
+		{
+			desc:        "authorize session without MFA on domain requiring MFA",
+			ctx:         auth.WithMFA(context.Background(), false),
+			policyReq:   domainReq,
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: false,
+			err:         auth.ErrMFARequired,
+		},
+		{
+			desc:        "authorize session with MFA in domain requiring MFA",
+			ctx:         auth.WithMFA(context.Background(), true),
+			policyReq:   policyReq,
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: true,
+			err:         nil,
+		},
+		{
+			desc:        "authorize session without MFA in domain not requiring MFA",
+			ctx:         auth.WithMFA(context.Background(), false),
+			policyReq:   policyReq,
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID},
+			expectCheck: true,
+			err:         nil,
+		},
+		{
+			desc:        "authorize session without MFA in domain without MFA policy",
+			ctx:         auth.WithMFA(context.Background(), false),
+			policyReq:   policyReq,
+			retrieveErr: repoerr.ErrNotFound,
+			expectCheck: true,
+			err:         nil,
+		},
+		{
+			desc:        "authorize session without MFA with failed to retrieve MFA policy",
+			ctx:         auth.WithMFA(context.Background(), false),
+			policyReq:   policyReq,
+			retrieveErr: repoerr.ErrViewEntity,
+			expectCheck: false,
+			err:         svcerr.ErrAuthorization,
+		},
+		{
+			desc:        "authorize PAT created without MFA in domain requiring MFA",
+			ctx:         context.Background(),
+			policyReq:   patReq,
+			pat:         auth.PAT{ID: validID, User: userID},
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: false,
+			err:         auth.ErrMFARequired,
+		},
+		{
+			desc:        "authorize PAT created with MFA in domain requiring MFA",
+			ctx:         context.Background(),
+			policyReq:   patReq,
+			pat:         auth.PAT{ID: validID, User: userID, MFA: true},
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: true,
+			err:         nil,
+		},
+		{
+			desc:        "authorize PAT created without MFA in domain not requiring MFA",
+			ctx:         context.Background(),
+			policyReq:   patReq,
+			pat:         auth.PAT{ID: validID, User: userID},
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID},
+			expectCheck: true,
+			err:         nil,
+		},
+		{
+			desc:        "authorize request without session",
+			ctx:         context.Background(),
+			policyReq:   policyReq,
+			mfaPolicy:   auth.MFAPolicy{DomainID: domainID, Required: true},
+			expectCheck: true,
+			err:         nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			checked := false
+			retrieveCall := mfarepo.On("Retrieve", mock.Anything, domainID).Return(tc.mfaPolicy, tc.retrieveErr)
+			patCall := patsrepo.On("Retrieve", mock.Anything, userID, validID).Return(tc.pat, nil)
+			scopeCall := patsrepo.On("CheckScope", mock.Anything, userID, validID, auth.ChannelsType, domainID, auth.OpListChannels, validID).Return(nil)
+			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, tc.policyReq).Return(nil).Run(func(args mock.Arguments) {
+				checked = true
+			})
+			err := svc.Authorize(tc.ctx, tc.policyReq)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.expectCheck, checked, fmt.Sprintf("%s: expected policy check %t got %t\n", tc.desc, tc.expectCheck, checked))
+			retrieveCall.Unset()
+			patCall.Unset()
+			scopeCall.Unset()
+			policyCall.Unset()
+		})
+	}
+}
+
+func TestCreatePAT(t *testing.T) {
+	svc, token := newService(t)
+
+	scope := auth.Scope{DomainID: domainID, EntityType: auth.ClientsType, Operation: "view", EntityID: auth.AnyIDs}
+	policy := auth.PATPolicy{
+		DomainID:    domainID,
+		MaxDuration: 30 * 24 * time.Hour,
+		AllowedScopes: []auth.ScopeRule{
+			{EntityType: auth.ClientsType, Operation: "view", EntityID: auth.AnyIDs},
+		},
+	}
+
+	cases := []struct {
+		desc          string
+		duration      time.Duration
+		allowedCIDRs  []string
+		scopes        []auth.Scope
+		policies      []auth.PATPolicy
+		retrievePPErr error
+		saveErr       error
+		addScopeErr   error
+		removeErr     error
+		err           error
+	}{
+		{
+			desc:         "create PAT successfully",
+			duration:     24 * time.Hour,
+			allowedCIDRs: []string{"192.0.2.15/24"},
+		},
+		{
+			desc:     "create PAT with scopes allowed by domain policy",
+			duration: 24 * time.Hour,
+			scopes:   []auth.Scope{scope},
+			policies: []auth.PATPolicy{policy},
+		},
+		{
+			desc:         "create PAT with invalid CIDR",
+			duration:     24 * time.Hour,
+			allowedCIDRs: []string{"192.0.2.15"},
+			err:          svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:     "create PAT with duration exceeding domain policy",
+			duration: 90 * 24 * time.Hour,
+			scopes:   []auth.Scope{scope},
+			policies: []auth.PATPolicy{policy},
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:     "create PAT with scope not allowed by domain policy",
+			duration: 24 * time.Hour,
+			scopes:   []auth.Scope{{DomainID: domainID, EntityType: auth.ClientsType, Operation: "delete", EntityID: auth.AnyIDs}},
+			policies: []auth.PATPolicy{policy},
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:          "create PAT with failed to retrieve domain policy",
+			duration:      24 * time.Hour,
+			scopes:        []auth.Scope{scope},
+			retrievePPErr: repoerr.ErrViewEntity,
+			err:           svcerr.ErrViewEntity,
+		},
+		{
+			desc:     "create PAT with failed to save",
+			duration: 24 * time.Hour,
+			saveErr:  repoerr.ErrCreateEntity,
+			err:      repoerr.ErrCreateEntity,
+		},
+		{
+			desc:        "create PAT with failed to add scopes",
+			duration:    24 * time.Hour,
+			scopes:      []auth.Scope{scope},
+			addScopeErr: repoerr.ErrCreateEntity,
+			removeErr:   repoerr.ErrRemoveEntity,
+			err:         svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tokenizerCall := tokenizer.On("Parse", mock.Anything, token).Return(accessKey, nil)
+			retrievePPCall := patsrepo.On("RetrievePolicies", mock.Anything, []string{domainID}).Return(tc.policies, tc.retrievePPErr)
+			hashCall := hasher.On("Hash", mock.Anything).Return("hash", nil)
+			saveCall := patsrepo.On("Save", mock.Anything, mock.Anything).Return(tc.saveErr)
+			addScopeCall := patsrepo.On("AddScope", mock.Anything, userID, mock.Anything).Return(tc.addScopeErr)
+			removeCall := patsrepo.On("Remove", mock.Anything, userID, mock.Anything).Return(tc.removeErr)
+			pat, err := svc.CreatePAT(context.Background(), token, "name", "description", tc.duration, tc.allowedCIDRs, tc.scopes)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, userID, pat.User, fmt.Sprintf("%s: expected user ID %s got %s\n", tc.desc, userID, pat.User))
+				assert.NotEmpty(t, pat.Secret, fmt.Sprintf("%s: expected PAT secret to be returned\n", tc.desc))
+				assert.Len(t, pat.AllowedCIDRs, len(tc.allowedCIDRs), fmt.Sprintf("%s: expected %d allowed CIDRs got %d\n", tc.desc, len(tc.allowedCIDRs), len(pat.AllowedCIDRs)))
+			}
+			if tc.addScopeErr != nil {
+				removeCall.Parent.AssertCalled(t, "Remove", mock.Anything, userID, mock.Anything)
+			}
+			tokenizerCall.Unset()
+			retrievePPCall.Unset()
+			hashCall.Unset()
+			saveCall.Unset()
+			addScopeCall.Unset()
+			removeCall.Unset()
+		})
+	}
+}
+
+func TestSetPATPolicy(t *testing.T) {
+	svc, token := newService(t)
+
+	policy := auth.PATPolicy{
+		DomainID:    domainID,
+		MaxDuration: 30 * 24 * time.Hour,
+		AllowedScopes: []auth.ScopeRule{
+			{EntityType: auth.MessagesType, Operation: auth.OpMessagePublish, EntityID: auth.AnyIDs},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		policy   auth.PATPolicy
+		checkErr error
+		saveErr  error
+		err      error
+	}{
+		{
+			desc:   "set PAT policy successfully",
+			policy: policy,
+		},
+		{
+			desc:     "set PAT policy by non domain admin",
+			policy:   policy,
+			checkErr: svcerr.ErrAuthorization,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:   "set invalid PAT policy",
+			policy: auth.PATPolicy{DomainID: domainID, MaxDuration: -time.Hour},
+			err:    svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "set PAT policy with failed to save",
+			policy:  policy,
+			saveErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tokenizerCall := tokenizer.On("Parse", mock.Anything, token).Return(accessKey, nil)
+			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, policies.Policy{
+				Subject:     userID,
+				SubjectType: policies.UserType,
+				Permission:  policies.AdminPermission,
+				Object:      domainID,
+				ObjectType:  policies.DomainType,
+			}).Return(tc.checkErr)
+			saveCall := patsrepo.On("SavePolicy", mock.Anything, mock.Anything).Return(tc.saveErr)
+			pp, err := svc.SetPATPolicy(context.Background(), token, tc.policy)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, userID, pp.UpdatedBy, fmt.Sprintf("%s: expected updated by %s got %s\n", tc.desc, userID, pp.UpdatedBy))
+				assert.Equal(t, tc.policy.MaxDuration, pp.MaxDuration, fmt.Sprintf("%s: expected max duration %s got %s\n", tc.desc, tc.policy.MaxDuration, pp.MaxDuration))
+			}
+			tokenizerCall.Unset()
+			policyCall.Unset()
+			saveCall.Unset()
+		})
+	}
+}
+
+func TestSetMFAPolicy(t *testing.T) {
+	svc, token := newService(t)
+
+	policy := auth.MFAPolicy{
+		DomainID: domainID,
+		Required: true,
+	}
+
+	cases := []struct {
+		desc     string
+		policy   auth.MFAPolicy
+		checkErr error
+		saveErr  error
+		err      error
+	}{
+		{
+			desc:   "set MFA policy successfully",
+			policy: policy,
+		},
+		{
+			desc:     "set MFA policy by non domain admin",
+			policy:   policy,
+			checkErr: svcerr.ErrAuthorization,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:    "set MFA policy with failed to save",
+			policy:  policy,
+			saveErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tokenizerCall := tokenizer.On("Parse", mock.Anything, token).Return(accessKey, nil)
+			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, policies.Policy{
+				Subject:     userID,
+				SubjectType: policies.UserType,
+				Permission:  policies.AdminPermission,
+				Object:      domainID,
+				ObjectType:  policies.DomainType,
+			}).Return(tc.checkErr)
+			saveCall := mfarepo.On("Save", mock.Anything, mock.Anything).Return(tc.saveErr)
+			mp, err := svc.SetMFAPolicy(context.Background(), token, tc.policy)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, userID, mp.UpdatedBy, fmt.Sprintf("%s: expected updated by %s got %s\n", tc.desc, userID, mp.UpdatedBy))
+				assert.Equal(t, tc.policy.Required, mp.Required, fmt.Sprintf("%s: expected required %t got %t\n", tc.desc, tc.policy.Required, mp.Required))
+			}
+			tokenizerCall.Unset()
+			policyCall.Unset()
+			saveCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveMFAPolicy(t *testing.T) {
+	svc, token := newService(t)
+
+	policy := auth.MFAPolicy{
+		DomainID:  domainID,
+		Required:  true,
+		UpdatedBy: userID,
+	}
+
+	cases := []struct {
+		desc        string
+		checkErr    error
+		retrieveRes auth.MFAPolicy
+		retrieveErr error
+		policy      auth.MFAPolicy
+		err         error
+	}{
+		{
+			desc:        "retrieve MFA policy successfully",
+			retrieveRes: policy,
+			policy:      policy,
+		},
+		{
+			desc:        "retrieve non-existing MFA policy",
+			retrieveErr: repoerr.ErrNotFound,
+			policy:      auth.MFAPolicy{DomainID: domainID},
+		},
+		{
+			desc:     "retrieve MFA policy by non domain member",
+			checkErr: svcerr.ErrAuthorization,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:        "retrieve MFA policy with failed to retrieve",
+			retrieveErr: repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tokenizerCall := tokenizer.On("Parse", mock.Anything, token).Return(accessKey, nil)
+			policyCall := pEvaluator.On("CheckPolicy", mock.Anything, policies.Policy{
+				Subject:     userID,
+				SubjectType: policies.UserType,
+				Permission:  policies.MembershipPermission,
+				Object:      domainID,
+				ObjectType:  policies.DomainType,
+			}).Return(tc.checkErr)
+			retrieveCall := mfarepo.On("Retrieve", mock.Anything, domainID).Return(tc.retrieveRes, tc.retrieveErr)
+			mp, err := svc.RetrieveMFAPolicy(context.Background(), token, domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.policy, mp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.policy, mp))
+			tokenizerCall.Unset()
+			policyCall.Unset()
+			retrieveCall.Unset()
 		})
 	}
 }
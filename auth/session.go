@@ -0,0 +1,74 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// ErrRefreshTokenReuse indicates that the already rotated refresh token was used
+// again, which revokes the whole login session.
+var ErrRefreshTokenReuse = errors.New("refresh token reuse detected")
+
+// Session represents the login session, i.e. the family of the refresh tokens
+// issued by rotation, starting with the one issued on login.
+type Session struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// TokenID is the ID of the only refresh token of the session which
+	// can be used to refresh the access token.
+	TokenID     string    `json:"-"`
+	IP          string    `json:"ip,omitempty"`
+	UserAgent   string    `json:"user_agent,omitempty"`
+	IssuedAt    time.Time `json:"issued_at"`
+	RefreshedAt time.Time `json:"refreshed_at,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// SessionsPageMeta contains page metadata that helps navigation.
+type SessionsPageMeta struct {
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+// SessionsPage contains page related metadata as well as list of sessions.
+type SessionsPage struct {
+	Total    uint64    `json:"total"`
+	Offset   uint64    `json:"offset"`
+	Limit    uint64    `json:"limit"`
+	Sessions []Session `json:"sessions"`
+}
+
+// Sessions specifies the login session management API.
+type Sessions interface {
+	// ListSessions lists the active login sessions of the user.
+	ListSessions(ctx context.Context, userID string, pm SessionsPageMeta) (SessionsPage, error)
+
+	// RevokeSession revokes the login session of the user, so none of
+	// its refresh tokens can be used anymore, and revokes the access tokens
+	// already issued for the session.
+	RevokeSession(ctx context.Context, userID, sessionID string) (Revocation, error)
+}
+
+// SessionRepository specifies login session persistence API.
+type SessionRepository interface {
+	// Save persists the session.
+	Save(ctx context.Context, session Session) error
+
+	// Retrieve retrieves the session by its ID.
+	Retrieve(ctx context.Context, id string) (Session, error)
+
+	// RetrieveAll retrieves the unexpired sessions of the user.
+	RetrieveAll(ctx context.Context, userID string, pm SessionsPageMeta) (SessionsPage, error)
+
+	// Rotate updates the session with the new refresh token ID, provided the
+	// session still holds the previous one. Otherwise, it returns not found error.
+	Rotate(ctx context.Context, session Session, prevTokenID string) error
+
+	// Remove removes the session of the user.
+	Remove(ctx context.Context, userID, id string) error
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// SigningKey represents the asymmetric key used to sign the tokens. The keys
+// are generated on schedule and shared by all the auth service instances.
+type SigningKey struct {
+	ID string
+	// PrivateKey is the encrypted private key.
+	PrivateKey []byte
+	CreatedAt  time.Time
+	// ActivatesAt is the time from which the key signs the tokens. The key is
+	// published before that, so the verifiers fetch it before it is used.
+	ActivatesAt time.Time
+	// ExpiresAt is the time after which no token signed with the key is valid
+	// and the key can be removed.
+	ExpiresAt time.Time
+}
+
+// SigningKeyRepository specifies the signing key persistence API.
+type SigningKeyRepository interface {
+	// Save stores the signing key. Saving the key activated at the same time
+	// as the existing one fails with the conflict error, so only one of the
+	// concurrently generated keys is kept.
+	Save(ctx context.Context, key SigningKey) error
+
+	// RetrieveAll retrieves the signing keys which expire after the given time.
+	RetrieveAll(ctx context.Context, expiresAfter time.Time) ([]SigningKey, error)
+
+	// RemoveExpired removes the signing keys which expired before the given time.
+	RemoveExpired(ctx context.Context, expiredBefore time.Time) error
+}
@@ -0,0 +1,337 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package asymmetric
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+)
+
+var (
+	errRotationConfig   = errors.New("invalid key rotation configuration")
+	errMissingEncKey    = errors.New("missing signing keys encryption key")
+	errEncryptKey       = errors.New("failed to encrypt signing key")
+	errDecryptKey       = errors.New("failed to decrypt signing key")
+	errGenerateKey      = errors.New("failed to generate signing key")
+	errRetrieveKeys     = errors.New("failed to retrieve signing keys")
+	errNoActiveSignKeys = errors.New("no active signing key")
+)
+
+// RotationConfig configures the signing keys rotation.
+type RotationConfig struct {
+	// Interval is the time each key signs the tokens before it is replaced
+	// by the next one.
+	Interval time.Duration
+	// PublishAhead is the time the next key is published in the JWKS before
+	// it starts signing. It has to exceed the time the verifiers cache JWKS.
+	PublishAhead time.Duration
+	// MaxTokenLifetime is the lifetime of the longest living token. The
+	// replaced key is kept for verification until all its tokens expire.
+	MaxTokenLifetime time.Duration
+	// SyncInterval is the interval of loading the keys generated by the other
+	// auth service instances and of the rotation check.
+	SyncInterval time.Duration
+	// EncryptionKey is the secret used to encrypt the stored private keys.
+	EncryptionKey string
+}
+
+func (cfg RotationConfig) validate() error {
+	switch {
+	case cfg.EncryptionKey == "":
+		return errMissingEncKey
+	case cfg.SyncInterval <= 0 || cfg.MaxTokenLifetime <= 0:
+		return errors.Wrap(errRotationConfig, errors.New("sync interval and max token lifetime must be positive"))
+	case cfg.PublishAhead <= cfg.SyncInterval:
+		return errors.Wrap(errRotationConfig, errors.New("publish ahead must exceed sync interval"))
+	case cfg.Interval <= cfg.PublishAhead:
+		return errors.Wrap(errRotationConfig, errors.New("rotation interval must exceed publish ahead"))
+	}
+
+	return nil
+}
+
+type signingKeyPair struct {
+	*keyPair
+	activatesAt time.Time
+}
+
+// keyring is the tokenizer which generates the signing keys on schedule and
+// keeps them encrypted in the repository shared by all the auth service
+// instances. Each instance loads the keys generated by the others.
+type keyring struct {
+	repo       auth.SigningKeyRepository
+	cfg        RotationConfig
+	aead       cipher.AEAD
+	idProvider supermq.IDProvider
+	now        func() time.Time
+	logger     *slog.Logger
+
+	mu   sync.RWMutex
+	keys []signingKeyPair
+}
+
+var _ auth.Tokenizer = (*keyring)(nil)
+
+// NewRotatingTokenizer creates a new asymmetric tokenizer with the signing
+// keys rotated on schedule. The keys are loaded, and the first one generated
+// if there is none, before the tokenizer is returned. The rotation runs in
+// the background until the context is canceled.
+func NewRotatingTokenizer(ctx context.Context, repo auth.SigningKeyRepository, cfg RotationConfig, idProvider supermq.IDProvider, clock func() time.Time, logger *slog.Logger) (auth.Tokenizer, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	encKey := sha256.Sum256([]byte(cfg.EncryptionKey))
+	block, err := aes.NewCipher(encKey[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &keyring{
+		repo:       repo,
+		cfg:        cfg,
+		aead:       aead,
+		idProvider: idProvider,
+		now:        clock,
+		logger:     logger,
+	}
+	if err := kr.sync(ctx); err != nil {
+		return nil, err
+	}
+	go kr.run(ctx)
+
+	return kr, nil
+}
+
+func (kr *keyring) Issue(key auth.Key) (string, error) {
+	kp := kr.signingKey()
+	if kp == nil {
+		return "", errNoActiveSignKeys
+	}
+
+	return issue(key, kp)
+}
+
+func (kr *keyring) Parse(ctx context.Context, tokenString string) (auth.Key, error) {
+	kr.mu.RLock()
+	keys := make([]*keyPair, 0, len(kr.keys))
+	for _, k := range kr.keys {
+		keys = append(keys, k.keyPair)
+	}
+	kr.mu.RUnlock()
+
+	return parse(tokenString, keys)
+}
+
+// RetrieveJWKS returns the public keys of all the loaded keys, including the
+// next key which doesn't sign the tokens yet.
+func (kr *keyring) RetrieveJWKS() ([]auth.PublicKeyInfo, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	publicKeys := make([]auth.PublicKeyInfo, 0, len(kr.keys))
+	for _, k := range kr.keys {
+		if pkInfo := extractPublicKeyInfo(k.keyPair); pkInfo != nil {
+			publicKeys = append(publicKeys, *pkInfo)
+		}
+	}
+	if len(publicKeys) == 0 {
+		return nil, errNoValidPublicKeys
+	}
+
+	return publicKeys, nil
+}
+
+func (kr *keyring) run(ctx context.Context) {
+	ticker := time.NewTicker(kr.cfg.SyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := kr.sync(ctx); err != nil {
+				kr.logger.Error("failed to rotate signing keys", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// sync loads the keys, generates the next key once the active one is about
+// to be replaced and removes the expired keys.
+func (kr *keyring) sync(ctx context.Context) error {
+	now := kr.now().UTC()
+	keys, err := kr.load(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	activatesAt, ok := kr.nextActivation(keys, now)
+	if ok {
+		key, err := kr.generate(activatesAt, now)
+		if err != nil {
+			return err
+		}
+		// The conflict means that the other instance generated the key
+		// activated at the same time, which is loaded instead.
+		switch err := kr.repo.Save(ctx, key); {
+		case err == nil:
+			kr.logger.Info("generated signing key", slog.String("key_id", key.ID), slog.Time("activates_at", activatesAt))
+		case !errors.Contains(err, repoerr.ErrConflict):
+			return errors.Wrap(errGenerateKey, err)
+		}
+		if keys, err = kr.load(ctx, now); err != nil {
+			return err
+		}
+	}
+
+	kr.mu.Lock()
+	kr.keys = keys
+	kr.mu.Unlock()
+
+	if err := kr.repo.RemoveExpired(ctx, now); err != nil {
+		kr.logger.Warn("failed to remove expired signing keys", slog.Any("error", err))
+	}
+
+	return nil
+}
+
+// nextActivation returns the activation time of the key to be generated, if
+// the next key is due to be published.
+func (kr *keyring) nextActivation(keys []signingKeyPair, now time.Time) (time.Time, bool) {
+	var active *signingKeyPair
+	for i := range keys {
+		if keys[i].activatesAt.After(now) {
+			// The next key is already published.
+			return time.Time{}, false
+		}
+		active = &keys[i]
+	}
+	if active == nil {
+		// The first key is activated immediately, since there is
+		// nothing to sign the tokens with in the meantime.
+		return now, true
+	}
+
+	next := active.activatesAt.Add(kr.cfg.Interval)
+	if now.Before(next.Add(-kr.cfg.PublishAhead)) {
+		return time.Time{}, false
+	}
+	if next.Before(now) {
+		// The rotation is overdue, e.g. no instance ran in the meantime.
+		next = now
+	}
+
+	return next, true
+}
+
+func (kr *keyring) generate(activatesAt, now time.Time) (auth.SigningKey, error) {
+	id, err := kr.idProvider.ID()
+	if err != nil {
+		return auth.SigningKey{}, errors.Wrap(errGenerateKey, err)
+	}
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return auth.SigningKey{}, errors.Wrap(errGenerateKey, err)
+	}
+	encrypted, err := kr.encrypt(id, privateKey.Seed())
+	if err != nil {
+		return auth.SigningKey{}, err
+	}
+
+	return auth.SigningKey{
+		ID:          id,
+		PrivateKey:  encrypted,
+		CreatedAt:   now,
+		ActivatesAt: activatesAt,
+		ExpiresAt:   activatesAt.Add(kr.cfg.Interval).Add(kr.cfg.MaxTokenLifetime),
+	}, nil
+}
+
+func (kr *keyring) load(ctx context.Context, now time.Time) ([]signingKeyPair, error) {
+	stored, err := kr.repo.RetrieveAll(ctx, now)
+	if err != nil {
+		return nil, errors.Wrap(errRetrieveKeys, err)
+	}
+
+	keys := make([]signingKeyPair, 0, len(stored))
+	for _, sk := range stored {
+		seed, err := kr.decrypt(sk.ID, sk.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		privateJwk, publicJwk, err := newKeyPair(ed25519.NewKeyFromSeed(seed), sk.ID)
+		if err != nil {
+			return nil, errors.Wrap(errRetrieveKeys, err)
+		}
+		keys = append(keys, signingKeyPair{
+			keyPair:     &keyPair{id: sk.ID, privateKey: privateJwk, publicKey: publicJwk},
+			activatesAt: sk.ActivatesAt,
+		})
+	}
+
+	return keys, nil
+}
+
+// signingKey returns the most recently activated key.
+func (kr *keyring) signingKey() *keyPair {
+	now := kr.now()
+
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	var kp *keyPair
+	for _, k := range kr.keys {
+		if k.activatesAt.After(now) {
+			break
+		}
+		kp = k.keyPair
+	}
+
+	return kp
+}
+
+// encrypt encrypts the private key seed, bound to the key ID, so the stored
+// key can't be swapped with the other one.
+func (kr *keyring) encrypt(id string, seed []byte) ([]byte, error) {
+	nonce := make([]byte, kr.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(errEncryptKey, err)
+	}
+
+	return kr.aead.Seal(nonce, nonce, seed, []byte(id)), nil
+}
+
+func (kr *keyring) decrypt(id string, data []byte) ([]byte, error) {
+	size := kr.aead.NonceSize()
+	if len(data) < size {
+		return nil, errors.Wrap(errDecryptKey, fmt.Errorf("key %s too short", id))
+	}
+	seed, err := kr.aead.Open(nil, data[:size], data[size:], []byte(id))
+	if err != nil {
+		return nil, errors.Wrap(errDecryptKey, fmt.Errorf("key %s: %w", id, err))
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.Wrap(errDecryptKey, errInvalidKeySize)
+	}
+
+	return seed, nil
+}
@@ -0,0 +1,268 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package asymmetric_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/tokenizer/asymmetric"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const encryptionKey = "signing-keys-secret"
+
+// signingKeyRepo is the in-memory signing key repository shared by the
+// tokenizers, as the database is shared by the auth service instances.
+type signingKeyRepo struct {
+	mu   sync.Mutex
+	keys map[string]auth.SigningKey
+}
+
+func newSigningKeyRepo() *signingKeyRepo {
+	return &signingKeyRepo{keys: make(map[string]auth.SigningKey)}
+}
+
+func (r *signingKeyRepo) Save(_ context.Context, key auth.SigningKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, k := range r.keys {
+		if k.ActivatesAt.Equal(key.ActivatesAt) {
+			return repoerr.ErrConflict
+		}
+	}
+	r.keys[key.ID] = key
+
+	return nil
+}
+
+func (r *signingKeyRepo) RetrieveAll(_ context.Context, expiresAfter time.Time) ([]auth.SigningKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keys []auth.SigningKey
+	for _, k := range r.keys {
+		if k.ExpiresAt.After(expiresAfter) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ActivatesAt.Before(keys[j].ActivatesAt) })
+
+	return keys, nil
+}
+
+func (r *signingKeyRepo) RemoveExpired(_ context.Context, expiredBefore time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, k := range r.keys {
+		if !k.ExpiresAt.After(expiredBefore) {
+			delete(r.keys, id)
+		}
+	}
+
+	return nil
+}
+
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+func rotationConfig() asymmetric.RotationConfig {
+	return asymmetric.RotationConfig{
+		Interval:         10 * time.Hour,
+		PublishAhead:     time.Hour,
+		MaxTokenLifetime: 2 * time.Hour,
+		SyncInterval:     5 * time.Millisecond,
+		EncryptionKey:    encryptionKey,
+	}
+}
+
+func testKey() auth.Key {
+	return auth.Key{
+		ID:        "test",
+		Type:      auth.AccessKey,
+		Subject:   "user",
+		Role:      auth.UserRole,
+		IssuedAt:  time.Now().UTC(),
+		ExpiresAt: time.Now().Add(time.Hour).UTC(),
+	}
+}
+
+func keyIDs(t *testing.T, tokenizer auth.Tokenizer) []string {
+	keys, err := tokenizer.RetrieveJWKS()
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ids = append(ids, k.KeyID)
+	}
+
+	return ids
+}
+
+func signingKeyID(t *testing.T, token string) string {
+	msg, err := jws.Parse([]byte(token))
+	require.NoError(t, err)
+
+	return msg.Signatures()[0].ProtectedHeaders().KeyID()
+}
+
+func TestNewRotatingTokenizer(t *testing.T) {
+	cases := []struct {
+		desc   string
+		config func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig
+		err    error
+	}{
+		{
+			desc:   "create with valid configuration",
+			config: func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig { return cfg },
+		},
+		{
+			desc: "create without encryption key",
+			config: func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig {
+				cfg.EncryptionKey = ""
+				return cfg
+			},
+			err: errors.New("missing signing keys encryption key"),
+		},
+		{
+			desc: "create with publish ahead shorter than sync interval",
+			config: func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig {
+				cfg.PublishAhead = cfg.SyncInterval
+				return cfg
+			},
+			err: errors.New("invalid key rotation configuration"),
+		},
+		{
+			desc: "create with rotation interval shorter than publish ahead",
+			config: func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig {
+				cfg.Interval = cfg.PublishAhead
+				return cfg
+			},
+			err: errors.New("invalid key rotation configuration"),
+		},
+		{
+			desc: "create without max token lifetime",
+			config: func(cfg asymmetric.RotationConfig) asymmetric.RotationConfig {
+				cfg.MaxTokenLifetime = 0
+				return cfg
+			},
+			err: errors.New("invalid key rotation configuration"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			repo := newSigningKeyRepo()
+			tokenizer, err := asymmetric.NewRotatingTokenizer(ctx, repo, tc.config(rotationConfig()), uuid.New(), time.Now, newTestLogger())
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if err != nil {
+				return
+			}
+
+			keys, err := repo.RetrieveAll(ctx, time.Now())
+			require.NoError(t, err)
+			require.Len(t, keys, 1, "the first key should be generated")
+			assert.Equal(t, []string{keys[0].ID}, keyIDs(t, tokenizer))
+
+			token, err := tokenizer.Issue(testKey())
+			require.NoError(t, err)
+			assert.Equal(t, keys[0].ID, signingKeyID(t, token))
+			parsed, err := tokenizer.Parse(ctx, token)
+			require.NoError(t, err)
+			assert.Equal(t, "user", parsed.Subject)
+		})
+	}
+}
+
+func TestRotatingTokenizerEncryption(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := newSigningKeyRepo()
+	_, err := asymmetric.NewRotatingTokenizer(ctx, repo, rotationConfig(), uuid.New(), time.Now, newTestLogger())
+	require.NoError(t, err)
+
+	cfg := rotationConfig()
+	cfg.EncryptionKey = "other-secret"
+	_, err = asymmetric.NewRotatingTokenizer(ctx, repo, cfg, uuid.New(), time.Now, newTestLogger())
+	assert.True(t, errors.Contains(err, errors.New("failed to decrypt signing key")), fmt.Sprintf("expected decryption error got %s", err))
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := time.Now().UTC()
+	clk := &clock{now: start}
+	repo := newSigningKeyRepo()
+	cfg := rotationConfig()
+
+	first, err := asymmetric.NewRotatingTokenizer(ctx, repo, cfg, uuid.New(), clk.Now, newTestLogger())
+	require.NoError(t, err)
+	second, err := asymmetric.NewRotatingTokenizer(ctx, repo, cfg, uuid.New(), clk.Now, newTestLogger())
+	require.NoError(t, err)
+
+	initial := keyIDs(t, first)
+	require.Len(t, initial, 1)
+	assert.Equal(t, initial, keyIDs(t, second), "instances should share the first key")
+	oldToken, err := first.Issue(testKey())
+	require.NoError(t, err)
+
+	// The next key is published ahead, but doesn't sign yet.
+	clk.Set(start.Add(cfg.Interval - cfg.PublishAhead))
+	require.Eventually(t, func() bool { return len(keyIDs(t, first)) == 2 && len(keyIDs(t, second)) == 2 }, time.Second, cfg.SyncInterval)
+	assert.ElementsMatch(t, keyIDs(t, first), keyIDs(t, second), "instances should share the next key")
+	token, err := second.Issue(testKey())
+	require.NoError(t, err)
+	assert.Equal(t, initial[0], signingKeyID(t, token), "the published key should not sign before activation")
+
+	// The next key signs once activated, while the replaced key still verifies.
+	clk.Set(start.Add(cfg.Interval))
+	token, err = second.Issue(testKey())
+	require.NoError(t, err)
+	next := signingKeyID(t, token)
+	assert.NotEqual(t, initial[0], next, "the activated key should sign")
+	_, err = first.Parse(ctx, token)
+	assert.NoError(t, err, "the token of the activated key should be verified by the other instance")
+	_, err = second.Parse(ctx, oldToken)
+	assert.NoError(t, err, "the token of the replaced key should be verified")
+
+	// The replaced key is retired once the tokens it signed expired.
+	clk.Set(start.Add(cfg.Interval + cfg.MaxTokenLifetime + time.Minute))
+	require.Eventually(t, func() bool {
+		ids := keyIDs(t, first)
+		return len(ids) == 1 && ids[0] == next
+	}, time.Second, cfg.SyncInterval)
+	_, err = first.Parse(ctx, oldToken)
+	assert.Error(t, err, "the token of the retired key should not be verified")
+}
@@ -100,16 +100,51 @@ func (km *tokenizer) Issue(key auth.Key) (string, error) {
 		return "", errNoActiveKey
 	}
 
+	return issue(key, km.activeKey)
+}
+
+func (km *tokenizer) Parse(ctx context.Context, tokenString string) (auth.Key, error) {
+	keys := []*keyPair{km.activeKey}
+	if km.retiringKey != nil {
+		keys = append(keys, km.retiringKey)
+	}
+
+	return parse(tokenString, keys)
+}
+
+func (km *tokenizer) RetrieveJWKS() ([]auth.PublicKeyInfo, error) {
+	publicKeys := make([]auth.PublicKeyInfo, 0, 2)
+
+	if km.activeKey != nil {
+		if pkInfo := extractPublicKeyInfo(km.activeKey); pkInfo != nil {
+			publicKeys = append(publicKeys, *pkInfo)
+		}
+	}
+
+	if km.retiringKey != nil {
+		if pkInfo := extractPublicKeyInfo(km.retiringKey); pkInfo != nil {
+			publicKeys = append(publicKeys, *pkInfo)
+		}
+	}
+
+	if len(publicKeys) == 0 {
+		return nil, errNoValidPublicKeys
+	}
+
+	return publicKeys, nil
+}
+
+func issue(key auth.Key, kp *keyPair) (string, error) {
 	tkn, err := smqjwt.BuildToken(key)
 	if err != nil {
 		return "", err
 	}
 	headers := jws.NewHeaders()
-	if err := headers.Set(jwk.KeyIDKey, km.activeKey.id); err != nil {
+	if err := headers.Set(jwk.KeyIDKey, kp.id); err != nil {
 		return "", err
 	}
 
-	signedBytes, err := jwt.Sign(tkn, jwt.WithKey(jwa.EdDSA, km.activeKey.privateKey, jws.WithProtectedHeaders(headers)))
+	signedBytes, err := jwt.Sign(tkn, jwt.WithKey(jwa.EdDSA, kp.privateKey, jws.WithProtectedHeaders(headers)))
 	if err != nil {
 		return "", err
 	}
@@ -117,17 +152,15 @@ func (km *tokenizer) Issue(key auth.Key) (string, error) {
 	return string(signedBytes), nil
 }
 
-func (km *tokenizer) Parse(ctx context.Context, tokenString string) (auth.Key, error) {
+// parse verifies the token against the public keys of the given key pairs.
+func parse(tokenString string, keys []*keyPair) (auth.Key, error) {
 	if len(tokenString) >= 3 && tokenString[:3] == patPrefix {
 		return auth.Key{Type: auth.PersonalAccessToken}, nil
 	}
 
 	set := jwk.NewSet()
-	if err := set.AddKey(km.activeKey.publicKey); err != nil {
-		return auth.Key{}, err
-	}
-	if km.retiringKey != nil {
-		if err := set.AddKey(km.retiringKey.publicKey); err != nil {
+	for _, kp := range keys {
+		if err := set.AddKey(kp.publicKey); err != nil {
 			return auth.Key{}, err
 		}
 	}
@@ -148,28 +181,6 @@ func (km *tokenizer) Parse(ctx context.Context, tokenString string) (auth.Key, e
 	return smqjwt.ToKey(tkn)
 }
 
-func (km *tokenizer) RetrieveJWKS() ([]auth.PublicKeyInfo, error) {
-	publicKeys := make([]auth.PublicKeyInfo, 0, 2)
-
-	if km.activeKey != nil {
-		if pkInfo := extractPublicKeyInfo(km.activeKey); pkInfo != nil {
-			publicKeys = append(publicKeys, *pkInfo)
-		}
-	}
-
-	if km.retiringKey != nil {
-		if pkInfo := extractPublicKeyInfo(km.retiringKey); pkInfo != nil {
-			publicKeys = append(publicKeys, *pkInfo)
-		}
-	}
-
-	if len(publicKeys) == 0 {
-		return nil, errNoValidPublicKeys
-	}
-
-	return publicKeys, nil
-}
-
 func extractPublicKeyInfo(kp *keyPair) *auth.PublicKeyInfo {
 	var rawKey ed25519.PublicKey
 	if err := kp.publicKey.Raw(&rawKey); err != nil {
@@ -212,6 +223,11 @@ func loadKeyPair(privateKeyPath string, kid string) (jwk.Key, jwk.Key, error) {
 		privateKey = ed25519.PrivateKey(privateKeyBytes)
 	}
 
+	return newKeyPair(privateKey, kid)
+}
+
+// newKeyPair returns the private and the public JWK of the ED25519 key.
+func newKeyPair(privateKey ed25519.PrivateKey, kid string) (jwk.Key, jwk.Key, error) {
 	publicKey := privateKey.Public().(ed25519.PublicKey)
 
 	privateJwk, err := jwk.FromRaw(privateKey)
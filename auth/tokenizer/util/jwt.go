@@ -28,6 +28,8 @@ const (
 	TokenType     = "type"
 	RoleField     = "role"
 	VerifiedField = "verified"
+	SessionField  = "session_id"
+	MFAField      = "mfa"
 )
 
 // ToKey converts a JWT token to an auth.Key by extracting claims.
@@ -105,6 +107,12 @@ func BuildToken(key auth.Key) (jwt.Token, error) {
 	if key.ID != "" {
 		builder.JwtID(key.ID)
 	}
+	if key.SessionID != "" {
+		builder.Claim(SessionField, key.SessionID)
+	}
+	if key.MFA {
+		builder.Claim(MFAField, true)
+	}
 
 	return builder.Build()
 }
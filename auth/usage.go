@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+var _ PATUsageTracker = (*usageTracker)(nil)
+
+type usageTracker struct {
+	repo       PATSRepository
+	maxPending int
+	logger     *slog.Logger
+	mu         sync.Mutex
+	pending    map[string]PATUsage
+	full       chan struct{}
+}
+
+// NewPATUsageTracker returns PAT usage tracker which accumulates the usage
+// in memory and writes it in batches every interval, or as soon as maxPending
+// distinct PATs are used. The pending usage is written once the context is
+// canceled.
+func NewPATUsageTracker(ctx context.Context, repo PATSRepository, interval time.Duration, maxPending int, logger *slog.Logger) PATUsageTracker {
+	t := &usageTracker{
+		repo:       repo,
+		maxPending: maxPending,
+		logger:     logger,
+		pending:    make(map[string]PATUsage),
+		full:       make(chan struct{}, 1),
+	}
+	go t.run(ctx, interval)
+
+	return t
+}
+
+func (t *usageTracker) Track(usage PATUsage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u, ok := t.pending[usage.PatID]
+	if !ok || usage.LastUsedAt.After(u.LastUsedAt) {
+		usage.Count += u.Count
+		u = usage
+	} else {
+		u.Count += usage.Count
+	}
+	t.pending[usage.PatID] = u
+
+	if len(t.pending) >= t.maxPending {
+		select {
+		case t.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *usageTracker) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.flush(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			t.flush(ctx)
+		case <-t.full:
+			t.flush(ctx)
+		}
+	}
+}
+
+func (t *usageTracker) flush(ctx context.Context) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = make(map[string]PATUsage)
+	t.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	usages := slices.Collect(maps.Values(pending))
+	if err := t.repo.UpdateUsage(ctx, usages); err != nil {
+		t.logger.Warn("Failed to write PAT usage", slog.Int("pats", len(usages)), slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/auth/mocks"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type usageRecorder struct {
+	mu      sync.Mutex
+	batches [][]auth.PATUsage
+	done    chan struct{}
+}
+
+func newUsageRecorder(repo *mocks.PATSRepository, err error) *usageRecorder {
+	r := &usageRecorder{done: make(chan struct{}, 10)}
+	repo.On("UpdateUsage", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		r.mu.Lock()
+		r.batches = append(r.batches, args.Get(1).([]auth.PATUsage))
+		r.mu.Unlock()
+		r.done <- struct{}{}
+	}).Return(err)
+
+	return r
+}
+
+func (r *usageRecorder) wait(t *testing.T) []auth.PATUsage {
+	select {
+	case <-r.done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for PAT usage to be written")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.batches[len(r.batches)-1]
+}
+
+func TestPATUsageTrackerAggregate(t *testing.T) {
+	repo := new(mocks.PATSRepository)
+	rec := newUsageRecorder(repo, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracker := auth.NewPATUsageTracker(ctx, repo, time.Hour, 2, smqlog.NewMock())
+
+	now := time.Now().UTC()
+	tracker.Track(auth.PATUsage{PatID: "pat-1", UserID: "user", LastUsedAt: now, IP: "192.0.2.1", UserAgent: "first", Count: 1})
+	tracker.Track(auth.PATUsage{PatID: "pat-1", UserID: "user", LastUsedAt: now.Add(-time.Second), IP: "192.0.2.2", UserAgent: "older", Count: 1})
+	tracker.Track(auth.PATUsage{PatID: "pat-1", UserID: "user", LastUsedAt: now.Add(time.Second), IP: "192.0.2.3", UserAgent: "latest", Count: 1})
+	tracker.Track(auth.PATUsage{PatID: "pat-2", UserID: "user", LastUsedAt: now, IP: "192.0.2.4", UserAgent: "other", Count: 1})
+
+	usages := rec.wait(t)
+	assert.Len(t, usages, 2)
+	for _, u := range usages {
+		switch u.PatID {
+		case "pat-1":
+			assert.Equal(t, uint64(3), u.Count)
+			assert.Equal(t, "192.0.2.3", u.IP)
+			assert.Equal(t, "latest", u.UserAgent)
+			assert.Equal(t, now.Add(time.Second), u.LastUsedAt)
+		case "pat-2":
+			assert.Equal(t, uint64(1), u.Count)
+			assert.Equal(t, "192.0.2.4", u.IP)
+		default:
+			t.Errorf("unexpected PAT usage %s", u.PatID)
+		}
+	}
+}
+
+func TestPATUsageTrackerFlush(t *testing.T) {
+	cases := []struct {
+		desc     string
+		interval time.Duration
+		cancel   bool
+		err      error
+	}{
+		{
+			desc:     "flush on interval",
+			interval: 10 * time.Millisecond,
+		},
+		{
+			desc:     "flush on context cancel",
+			interval: time.Hour,
+			cancel:   true,
+		},
+		{
+			desc:     "flush with repository error",
+			interval: 10 * time.Millisecond,
+			err:      errors.New("update failed"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.PATSRepository)
+			rec := newUsageRecorder(repo, tc.err)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			tracker := auth.NewPATUsageTracker(ctx, repo, tc.interval, 100, smqlog.NewMock())
+			tracker.Track(auth.PATUsage{PatID: "pat", UserID: "user", LastUsedAt: time.Now().UTC(), Count: 1})
+			if tc.cancel {
+				cancel()
+			}
+
+			usages := rec.wait(t)
+			assert.Len(t, usages, 1)
+			assert.Equal(t, "pat", usages[0].PatID)
+		})
+	}
+}
@@ -31,6 +31,7 @@ type grpcClient struct {
 	unsetParentGroupFromChannels endpoint.Endpoint
 	retrieveEntity               endpoint.Endpoint
 	retrieveIDByRoute            endpoint.Endpoint
+	retrieveSchema               endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -76,6 +77,14 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcChannelsV1.Chan
 			decodeRetrieveIDByRouteResponse,
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
+		retrieveSchema: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RetrieveSchema",
+			encodeRetrieveSchemaRequest,
+			decodeRetrieveSchemaResponse,
+			grpcChannelsV1.RetrieveSchemaRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -196,6 +205,26 @@ func decodeRetrieveIDByRouteResponse(_ context.Context, grpcRes any) (any, error
 	return grpcRes.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (client grpcClient) RetrieveSchema(ctx context.Context, req *grpcChannelsV1.RetrieveSchemaReq, _ ...grpc.CallOption) (r *grpcChannelsV1.RetrieveSchemaRes, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.retrieveSchema(ctx, req)
+	if err != nil {
+		return &grpcChannelsV1.RetrieveSchemaRes{}, decodeError(err)
+	}
+
+	return res.(*grpcChannelsV1.RetrieveSchemaRes), nil
+}
+
+func encodeRetrieveSchemaRequest(_ context.Context, grpcReq any) (any, error) {
+	return grpcReq.(*grpcChannelsV1.RetrieveSchemaReq), nil
+}
+
+func decodeRetrieveSchemaResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes.(*grpcChannelsV1.RetrieveSchemaRes), nil
+}
+
 func decodeError(err error) error {
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
@@ -83,3 +83,19 @@ func retrieveIDByRouteEndpoint(svc channels.Service) endpoint.Endpoint {
 		return retrieveIDByRouteRes{id: id}, nil
 	}
 }
+
+func retrieveSchemaEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(retrieveSchemaReq)
+		if err := req.validate(); err != nil {
+			return retrieveSchemaRes{}, err
+		}
+
+		s, err := svc.RetrieveSchema(ctx, req.domainID, req.channelID)
+		if err != nil {
+			return retrieveSchemaRes{}, err
+		}
+
+		return retrieveSchemaRes{schema: s}, nil
+	}
+}
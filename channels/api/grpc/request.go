@@ -54,3 +54,19 @@ func (req retrieveIDByRouteReq) validate() error {
 
 	return nil
 }
+
+type retrieveSchemaReq struct {
+	domainID  string
+	channelID string
+}
+
+func (req retrieveSchemaReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if req.channelID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@
 
 package grpc
 
+import "github.com/absmach/supermq/pkg/schema"
+
 type authorizeRes struct {
 	authorized bool
 }
@@ -23,3 +25,7 @@ type retrieveEntityRes channelBasic
 type retrieveIDByRouteRes struct {
 	id string
 }
+
+type retrieveSchemaRes struct {
+	schema schema.Schema
+}
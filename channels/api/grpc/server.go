@@ -14,6 +14,7 @@ import (
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/schema"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,6 +29,7 @@ type grpcServer struct {
 	unsetParentGroupFromChannels kitgrpc.Handler
 	retrieveEntity               kitgrpc.Handler
 	retrieveIDByRoute            kitgrpc.Handler
+	retrieveSchema               kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
@@ -58,6 +60,11 @@ func NewServer(svc channels.Service) grpcChannelsV1.ChannelsServiceServer {
 			decodeRetrieveIDByRouteRequest,
 			encodeRetrieveIDByRouteResponse,
 		),
+		retrieveSchema: kitgrpc.NewServer(
+			retrieveSchemaEndpoint(svc),
+			decodeRetrieveSchemaRequest,
+			encodeRetrieveSchemaResponse,
+		),
 	}
 }
 
@@ -186,6 +193,28 @@ func (s *grpcServer) RetrieveIDByRoute(ctx context.Context, req *grpcCommonV1.Re
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (s *grpcServer) RetrieveSchema(ctx context.Context, req *grpcChannelsV1.RetrieveSchemaReq) (*grpcChannelsV1.RetrieveSchemaRes, error) {
+	_, res, err := s.retrieveSchema.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcChannelsV1.RetrieveSchemaRes), nil
+}
+
+func decodeRetrieveSchemaRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcChannelsV1.RetrieveSchemaReq)
+	return retrieveSchemaReq{
+		domainID:  req.GetDomainId(),
+		channelID: req.GetChannelId(),
+	}, nil
+}
+
+func encodeRetrieveSchemaResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(retrieveSchemaRes)
+
+	return &grpcChannelsV1.RetrieveSchemaRes{Schema: schema.ToProto(res.schema)}, nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case errors.Contains(err, nil):
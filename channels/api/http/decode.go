@@ -303,3 +303,26 @@ func decodeDisconnectRequest(_ context.Context, r *http.Request) (any, error) {
 
 	return req, nil
 }
+
+func decodeSchemaReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := schemaReq{
+		channelID: chi.URLParam(r, "channelID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Schema); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeChannelSchemaReq(_ context.Context, r *http.Request) (any, error) {
+	req := channelSchemaReq{
+		channelID: chi.URLParam(r, "channelID"),
+	}
+
+	return req, nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
@@ -2184,6 +2185,299 @@ func TestDeleteChannelEndpoint(t *testing.T) {
 	}
 }
 
+func TestCreateSchemaEndpoint(t *testing.T) {
+	gs, svc, authn := newChannelsServer()
+	defer gs.Close()
+
+	validSchema := schema.Schema{
+		Type:  schema.SenMLType,
+		Names: []string{"temperature"},
+		Units: []string{"Cel"},
+	}
+	validSchemaResp := channels.Schema{
+		ChannelID: validID,
+		DomainID:  validID,
+		Schema:    validSchema,
+		CreatedAt: time.Now(),
+		CreatedBy: validID,
+	}
+
+	cases := []struct {
+		desc        string
+		token       string
+		id          string
+		domainID    string
+		req         string
+		contentType string
+		session     smqauthn.Session
+		svcResp     channels.Schema
+		svcErr      error
+		status      int
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "create schema successfully",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         toJSON(validSchema),
+			contentType: contentType,
+			svcResp:     validSchemaResp,
+			status:      http.StatusCreated,
+			err:         nil,
+		},
+		{
+			desc:        "create schema with invalid token",
+			token:       invalidToken,
+			session:     smqauthn.Session{},
+			domainID:    validID,
+			id:          validID,
+			req:         toJSON(validSchema),
+			contentType: contentType,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "create schema with empty domainID",
+			token:       validToken,
+			id:          validID,
+			req:         toJSON(validSchema),
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingDomainID,
+		},
+		{
+			desc:        "create schema with unsupported type",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         `{"type":"xml"}`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
+		{
+			desc:        "create schema with invalid JSON schema definition",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         `{"type":"json","definition":{"type":"invalid"}}`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
+		{
+			desc:        "create schema with malformed request body",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         `{"type":`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "create schema with invalid content type",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         toJSON(validSchema),
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "create schema with service error",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			req:         toJSON(validSchema),
+			contentType: contentType,
+			svcErr:      svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+			err:         svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      gs.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/channels/%s/schema", gs.URL, tc.domainID, tc.id),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.req),
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: validID + "_" + validID, UserID: validID, DomainID: validID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("CreateSchema", mock.Anything, tc.session, mock.Anything).Return(tc.svcResp, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var errRes respBody
+			err = json.NewDecoder(res.Body).Decode(&errRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if errRes.Err != "" || errRes.Message != "" {
+				err = errors.Wrap(errors.New(errRes.Err), errors.New(errRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewSchemaEndpoint(t *testing.T) {
+	gs, svc, authn := newChannelsServer()
+	defer gs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		domainID string
+		session  smqauthn.Session
+		svcResp  channels.Schema
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "view schema successfully",
+			token:    validToken,
+			domainID: validID,
+			id:       validID,
+			svcResp: channels.Schema{
+				ChannelID: validID,
+				DomainID:  validID,
+				Schema:    schema.Schema{Type: schema.SenMLType, Names: []string{"temperature"}},
+			},
+			status: http.StatusOK,
+			err:    nil,
+		},
+		{
+			desc:     "view schema with invalid token",
+			token:    invalidToken,
+			session:  smqauthn.Session{},
+			domainID: validID,
+			id:       validID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "view schema of channel without schema",
+			token:    validToken,
+			domainID: validID,
+			id:       validID,
+			svcErr:   svcerr.ErrNotFound,
+			status:   http.StatusNotFound,
+			err:      svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: gs.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/channels/%s/schema", gs.URL, tc.domainID, tc.id),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: validID + "_" + validID, UserID: validID, DomainID: validID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ViewSchema", mock.Anything, tc.session, tc.id).Return(tc.svcResp, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var errRes respBody
+			err = json.NewDecoder(res.Body).Decode(&errRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if errRes.Err != "" || errRes.Message != "" {
+				err = errors.Wrap(errors.New(errRes.Err), errors.New(errRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveSchemaEndpoint(t *testing.T) {
+	gs, svc, authn := newChannelsServer()
+	defer gs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		domainID string
+		session  smqauthn.Session
+		svcErr   error
+		status   int
+		authnErr error
+	}{
+		{
+			desc:     "remove schema successfully",
+			token:    validToken,
+			domainID: validID,
+			id:       validID,
+			status:   http.StatusNoContent,
+		},
+		{
+			desc:     "remove schema with invalid token",
+			token:    invalidToken,
+			domainID: validID,
+			id:       validID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove schema with empty domainID",
+			token:  validToken,
+			id:     validID,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:     "remove schema with service error",
+			token:    validToken,
+			domainID: validID,
+			id:       validID,
+			svcErr:   svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: gs.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/channels/%s/schema", gs.URL, tc.domainID, tc.id),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: validID + "_" + validID, UserID: validID, DomainID: validID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RemoveSchema", mock.Anything, tc.session, tc.id).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 type testRequest struct {
 	client      *http.Client
 	method      string
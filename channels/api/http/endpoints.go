@@ -362,3 +362,86 @@ func deleteChannelEndpoint(svc channels.Service) endpoint.Endpoint {
 		return deleteChannelRes{}, nil
 	}
 }
+
+func createSchemaEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(schemaReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		s, err := svc.CreateSchema(ctx, session, channels.Schema{ChannelID: req.channelID, Schema: req.Schema})
+		if err != nil {
+			return nil, err
+		}
+
+		return schemaRes{Schema: s, created: true}, nil
+	}
+}
+
+func viewSchemaEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(channelSchemaReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		s, err := svc.ViewSchema(ctx, session, req.channelID)
+		if err != nil {
+			return nil, err
+		}
+
+		return schemaRes{Schema: s}, nil
+	}
+}
+
+func updateSchemaEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(schemaReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		s, err := svc.UpdateSchema(ctx, session, channels.Schema{ChannelID: req.channelID, Schema: req.Schema})
+		if err != nil {
+			return nil, err
+		}
+
+		return schemaRes{Schema: s}, nil
+	}
+}
+
+func removeSchemaEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(channelSchemaReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		if err := svc.RemoveSchema(ctx, session, req.channelID); err != nil {
+			return nil, err
+		}
+
+		return removeSchemaRes{}, nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/channels"
 	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 type createChannelReq struct {
@@ -319,3 +320,28 @@ func (req deleteChannelReq) validate() error {
 	}
 	return nil
 }
+
+type schemaReq struct {
+	channelID string
+	schema.Schema
+}
+
+func (req schemaReq) validate() error {
+	if req.channelID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return req.Schema.Validate()
+}
+
+type channelSchemaReq struct {
+	channelID string
+}
+
+func (req channelSchemaReq) validate() error {
+	if req.channelID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
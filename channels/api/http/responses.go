@@ -22,6 +22,8 @@ var (
 	_ supermq.Response = (*connectRes)(nil)
 	_ supermq.Response = (*disconnectRes)(nil)
 	_ supermq.Response = (*changeChannelStatusRes)(nil)
+	_ supermq.Response = (*schemaRes)(nil)
+	_ supermq.Response = (*removeSchemaRes)(nil)
 )
 
 type pageRes struct {
@@ -219,3 +221,44 @@ func (res disconnectRes) Headers() map[string]string {
 func (res disconnectRes) Empty() bool {
 	return true
 }
+
+type schemaRes struct {
+	channels.Schema
+	created bool
+}
+
+func (res schemaRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res schemaRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/channels/%s/schema", res.ChannelID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res schemaRes) Empty() bool {
+	return false
+}
+
+type removeSchemaRes struct{}
+
+func (res removeSchemaRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeSchemaRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeSchemaRes) Empty() bool {
+	return true
+}
@@ -138,6 +138,36 @@ func MakeHandler(svc channels.Service, authn smqauthn.AuthNMiddleware, mux *chi.
 				opts...,
 			), "disconnect_channel_client").ServeHTTP)
 
+			r.Route("/schema", func(r chi.Router) {
+				r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+					createSchemaEndpoint(svc),
+					decodeSchemaReq,
+					api.EncodeResponse,
+					opts...,
+				), "create_channel_schema").ServeHTTP)
+
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					viewSchemaEndpoint(svc),
+					decodeChannelSchemaReq,
+					api.EncodeResponse,
+					opts...,
+				), "view_channel_schema").ServeHTTP)
+
+				r.Patch("/", otelhttp.NewHandler(kithttp.NewServer(
+					updateSchemaEndpoint(svc),
+					decodeSchemaReq,
+					api.EncodeResponse,
+					opts...,
+				), "update_channel_schema").ServeHTTP)
+
+				r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+					removeSchemaEndpoint(svc),
+					decodeChannelSchemaReq,
+					api.EncodeResponse,
+					opts...,
+				), "remove_channel_schema").ServeHTTP)
+			})
+
 			roleManagerHttp.EntityRoleMangerRouter(svc, d, r, opts)
 		})
 	})
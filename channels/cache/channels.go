@@ -5,11 +5,13 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/absmach/supermq/channels"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -19,6 +21,9 @@ var (
 	ErrEmptyChannelRoute = errors.New("channel route is empty")
 )
 
+// schemaKeyPrefix separates channel schema keys from channel route keys.
+const schemaKeyPrefix = "schema:"
+
 type channelsCache struct {
 	client   *redis.Client
 	duration time.Duration
@@ -71,6 +76,61 @@ func (cc *channelsCache) Remove(ctx context.Context, channelRoute, domainID stri
 	return nil
 }
 
+func (cc *channelsCache) SaveSchema(ctx context.Context, domainID, channelID string, s schema.Schema) error {
+	key, err := encodeSchemaKey(domainID, channelID)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if err := cc.client.Set(ctx, key, data, cc.duration).Err(); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (cc *channelsCache) Schema(ctx context.Context, domainID, channelID string) (schema.Schema, error) {
+	key, err := encodeSchemaKey(domainID, channelID)
+	if err != nil {
+		return schema.Schema{}, errors.Wrap(repoerr.ErrNotFound, err)
+	}
+	data, err := cc.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return schema.Schema{}, errors.Wrap(repoerr.ErrNotFound, err)
+	}
+	var s schema.Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return schema.Schema{}, errors.Wrap(repoerr.ErrNotFound, err)
+	}
+
+	return s, nil
+}
+
+func (cc *channelsCache) RemoveSchema(ctx context.Context, domainID, channelID string) error {
+	key, err := encodeSchemaKey(domainID, channelID)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	if err := cc.client.Del(ctx, key).Err(); err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func encodeSchemaKey(domainID, channelID string) (string, error) {
+	if domainID == "" {
+		return "", ErrEmptyDomainID
+	}
+	if channelID == "" {
+		return "", ErrEmptyChannelID
+	}
+	return schemaKeyPrefix + domainID + ":" + channelID, nil
+}
+
 func encodeKey(domainID, channelRoute string) (string, error) {
 	if domainID == "" {
 		return "", ErrEmptyDomainID
@@ -12,6 +12,7 @@ import (
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 // Metadata represents arbitrary JSON.
@@ -47,6 +48,18 @@ type Channel struct {
 	Roles                     []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
+// Schema represents the message schema attached to the channel.
+// Messages published to the channel must conform to the schema.
+type Schema struct {
+	ChannelID string `json:"channel_id"`
+	DomainID  string `json:"domain_id"`
+	schema.Schema
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
 type Operator uint8
 
 const (
@@ -162,6 +175,18 @@ type Service interface {
 
 	RemoveParentGroup(ctx context.Context, session authn.Session, id string) error
 
+	// CreateSchema attaches the message schema to the channel.
+	CreateSchema(ctx context.Context, session authn.Session, s Schema) (Schema, error)
+
+	// ViewSchema retrieves the message schema of the channel.
+	ViewSchema(ctx context.Context, session authn.Session, channelID string) (Schema, error)
+
+	// UpdateSchema updates the message schema of the channel.
+	UpdateSchema(ctx context.Context, session authn.Session, s Schema) (Schema, error)
+
+	// RemoveSchema removes the message schema from the channel.
+	RemoveSchema(ctx context.Context, session authn.Session, channelID string) error
+
 	roles.RoleManager
 }
 
@@ -196,6 +221,10 @@ type Repository interface {
 	// Remove removes the channel having the provided identifier
 	Remove(ctx context.Context, ids ...string) error
 
+	// CountByDomain returns the number of the channels in each of the given
+	// domains, or in all the domains if none is given.
+	CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error)
+
 	// SetParentGroup set parent group id to a given channel id
 	SetParentGroup(ctx context.Context, ch Channel) error
 
@@ -222,6 +251,18 @@ type Repository interface {
 
 	UnsetParentGroupFromChannels(ctx context.Context, parentGroupID string) error
 
+	// SaveSchema persists the channel message schema.
+	SaveSchema(ctx context.Context, s Schema) (Schema, error)
+
+	// RetrieveSchema retrieves the message schema of the channel from the given domain.
+	RetrieveSchema(ctx context.Context, domainID, channelID string) (Schema, error)
+
+	// UpdateSchema updates the channel message schema.
+	UpdateSchema(ctx context.Context, s Schema) (Schema, error)
+
+	// RemoveSchema removes the message schema of the channel from the given domain.
+	RemoveSchema(ctx context.Context, domainID, channelID string) error
+
 	roles.Repository
 }
 
@@ -235,4 +276,14 @@ type Cache interface {
 
 	// Remove removes the channel ID for the given domain ID and channel route.
 	Remove(ctx context.Context, channelRoute, domainID string) error
+
+	// SaveSchema stores the message schema for the given domain ID and channel ID.
+	// Empty schema is stored for channels without schema.
+	SaveSchema(ctx context.Context, domainID, channelID string, s schema.Schema) error
+
+	// Schema retrieves the message schema for the given domain ID and channel ID.
+	Schema(ctx context.Context, domainID, channelID string) (schema.Schema, error)
+
+	// RemoveSchema removes the message schema for the given domain ID and channel ID.
+	RemoveSchema(ctx context.Context, domainID, channelID string) error
 }
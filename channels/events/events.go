@@ -28,6 +28,9 @@ const (
 	channelDisconnect   = channelPrefix + "disconnect"
 	channelSetParent    = channelPrefix + "set_parent"
 	channelRemoveParent = channelPrefix + "remove_parent"
+	schemaCreate        = channelPrefix + "create_schema"
+	schemaUpdate        = channelPrefix + "update_schema"
+	schemaRemove        = channelPrefix + "remove_schema"
 )
 
 var (
@@ -39,6 +42,8 @@ var (
 	_ events.Event = (*removeChannelEvent)(nil)
 	_ events.Event = (*connectEvent)(nil)
 	_ events.Event = (*disconnectEvent)(nil)
+	_ events.Event = (*schemaEvent)(nil)
+	_ events.Event = (*removeSchemaEvent)(nil)
 )
 
 type createChannelEvent struct {
@@ -382,3 +387,58 @@ func (rpge removeParentGroupEvent) Encode() (map[string]any, error) {
 		"request_id":  rpge.requestID,
 	}, nil
 }
+
+type schemaEvent struct {
+	schema    channels.Schema
+	operation string
+	authn.Session
+	requestID string
+}
+
+func (se schemaEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation":   se.operation,
+		"channel_id":  se.schema.ChannelID,
+		"type":        string(se.schema.Type),
+		"domain":      se.schema.DomainID,
+		"user_id":     se.UserID,
+		"token_type":  se.Type.String(),
+		"super_admin": se.SuperAdmin,
+		"request_id":  se.requestID,
+	}
+	if len(se.schema.Definition) > 0 {
+		val["definition"] = string(se.schema.Definition)
+	}
+	if len(se.schema.Names) > 0 {
+		val["names"] = se.schema.Names
+	}
+	if len(se.schema.Units) > 0 {
+		val["units"] = se.schema.Units
+	}
+	if !se.schema.CreatedAt.IsZero() {
+		val["created_at"] = se.schema.CreatedAt
+	}
+	if !se.schema.UpdatedAt.IsZero() {
+		val["updated_at"] = se.schema.UpdatedAt
+	}
+
+	return val, nil
+}
+
+type removeSchemaEvent struct {
+	channelID string
+	authn.Session
+	requestID string
+}
+
+func (rse removeSchemaEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   schemaRemove,
+		"channel_id":  rse.channelID,
+		"domain":      rse.DomainID,
+		"user_id":     rse.UserID,
+		"token_type":  rse.Type.String(),
+		"super_admin": rse.SuperAdmin,
+		"request_id":  rse.requestID,
+	}, nil
+}
@@ -31,6 +31,9 @@ const (
 	disconnectStream   = supermqPrefix + channelDisconnect
 	setParentStream    = supermqPrefix + channelSetParent
 	removeParentStream = supermqPrefix + channelRemoveParent
+	createSchemaStream = supermqPrefix + schemaCreate
+	updateSchemaStream = supermqPrefix + schemaUpdate
+	removeSchemaStream = supermqPrefix + schemaRemove
 )
 
 var _ channels.Service = (*eventStore)(nil)
@@ -222,6 +225,65 @@ func (es *eventStore) RemoveChannel(ctx context.Context, session authn.Session,
 	return nil
 }
 
+func (es *eventStore) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	s, err := es.svc.CreateSchema(ctx, session, s)
+	if err != nil {
+		return s, err
+	}
+
+	event := schemaEvent{
+		schema:    s,
+		operation: schemaCreate,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+	if err := es.Publish(ctx, createSchemaStream, event); err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+func (es *eventStore) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	return es.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (es *eventStore) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	s, err := es.svc.UpdateSchema(ctx, session, s)
+	if err != nil {
+		return s, err
+	}
+
+	event := schemaEvent{
+		schema:    s,
+		operation: schemaUpdate,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+	if err := es.Publish(ctx, updateSchemaStream, event); err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+func (es *eventStore) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	if err := es.svc.RemoveSchema(ctx, session, channelID); err != nil {
+		return err
+	}
+
+	event := removeSchemaEvent{
+		channelID: channelID,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+	if err := es.Publish(ctx, removeSchemaStream, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (es *eventStore) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	if err := es.svc.Connect(ctx, session, chIDs, thIDs, connTypes); err != nil {
 		return err
@@ -41,6 +41,8 @@ var (
 	errGroupRemoveChildChannels = errors.New("not authorized to remove child channel for group")
 	errClientDisConnectChannels = errors.New("not authorized to disconnect channel for client")
 	errClientConnectChannels    = errors.New("not authorized to connect channel for client")
+	errViewSchema               = errors.New("not authorized to view channel schema")
+	errUpdateSchema             = errors.New("not authorized to update channel schema")
 )
 
 var _ channels.Service = (*authorizationMiddleware)(nil)
@@ -325,6 +327,62 @@ func (am *authorizationMiddleware) RemoveParentGroup(ctx context.Context, sessio
 	return nil
 }
 
+func (am *authorizationMiddleware) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	if err := am.authorize(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      s.ChannelID,
+	}); err != nil {
+		return channels.Schema{}, errors.Wrap(err, errUpdateSchema)
+	}
+
+	return am.svc.CreateSchema(ctx, session, s)
+}
+
+func (am *authorizationMiddleware) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	if err := am.authorize(ctx, session, policies.ChannelType, operations.OpViewChannelSchema, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      channelID,
+	}); err != nil {
+		return channels.Schema{}, errors.Wrap(err, errViewSchema)
+	}
+
+	return am.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (am *authorizationMiddleware) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	if err := am.authorize(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      s.ChannelID,
+	}); err != nil {
+		return channels.Schema{}, errors.Wrap(err, errUpdateSchema)
+	}
+
+	return am.svc.UpdateSchema(ctx, session, s)
+}
+
+func (am *authorizationMiddleware) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	if err := am.authorize(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ChannelType,
+		Object:      channelID,
+	}); err != nil {
+		return errors.Wrap(err, errUpdateSchema)
+	}
+
+	return am.svc.RemoveSchema(ctx, session, channelID)
+}
+
 func (am *authorizationMiddleware) authorize(ctx context.Context, session authn.Session, entityType string, op permissions.Operation, req smqauthz.PolicyReq) error {
 	req.UserID = session.UserID
 	req.PatID = session.PatID
@@ -160,6 +160,54 @@ func (cm *calloutMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return cm.svc.RemoveChannel(ctx, session, id)
 }
 
+func (cm *calloutMiddleware) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	params := map[string]any{
+		"entity_id": s.ChannelID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, params); err != nil {
+		return channels.Schema{}, err
+	}
+
+	return cm.svc.CreateSchema(ctx, session, s)
+}
+
+func (cm *calloutMiddleware) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	params := map[string]any{
+		"entity_id": channelID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ChannelType, operations.OpViewChannelSchema, params); err != nil {
+		return channels.Schema{}, err
+	}
+
+	return cm.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (cm *calloutMiddleware) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	params := map[string]any{
+		"entity_id": s.ChannelID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, params); err != nil {
+		return channels.Schema{}, err
+	}
+
+	return cm.svc.UpdateSchema(ctx, session, s)
+}
+
+func (cm *calloutMiddleware) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	params := map[string]any{
+		"entity_id": channelID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ChannelType, operations.OpUpdateChannelSchema, params); err != nil {
+		return err
+	}
+
+	return cm.svc.RemoveSchema(ctx, session, channelID)
+}
+
 func (cm *calloutMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	params := map[string]any{
 		"channel_ids":      chIDs,
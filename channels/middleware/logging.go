@@ -218,6 +218,84 @@ func (lm *loggingMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return lm.svc.RemoveChannel(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (cs channels.Schema, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("schema",
+				slog.String("channel_id", s.ChannelID),
+				slog.String("type", string(s.Type)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Create channel schema failed", args...)
+			return
+		}
+		lm.logger.Info("Create channel schema completed successfully", args...)
+	}(time.Now())
+	return lm.svc.CreateSchema(ctx, session, s)
+}
+
+func (lm *loggingMiddleware) ViewSchema(ctx context.Context, session authn.Session, channelID string) (s channels.Schema, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("channel_id", channelID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("View channel schema failed", args...)
+			return
+		}
+		lm.logger.Info("View channel schema completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (lm *loggingMiddleware) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (us channels.Schema, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("schema",
+				slog.String("channel_id", s.ChannelID),
+				slog.String("type", string(s.Type)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Update channel schema failed", args...)
+			return
+		}
+		lm.logger.Info("Update channel schema completed successfully", args...)
+	}(time.Now())
+	return lm.svc.UpdateSchema(ctx, session, s)
+}
+
+func (lm *loggingMiddleware) RemoveSchema(ctx context.Context, session authn.Session, channelID string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("channel_id", channelID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Remove channel schema failed", args...)
+			return
+		}
+		lm.logger.Info("Remove channel schema completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RemoveSchema(ctx, session, channelID)
+}
+
 func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -106,6 +106,38 @@ func (ms *metricsMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return ms.svc.RemoveChannel(ctx, session, id)
 }
 
+func (ms *metricsMiddleware) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_channel_schema").Add(1)
+		ms.latency.With("method", "create_channel_schema").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.CreateSchema(ctx, session, s)
+}
+
+func (ms *metricsMiddleware) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_channel_schema").Add(1)
+		ms.latency.With("method", "view_channel_schema").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (ms *metricsMiddleware) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_channel_schema").Add(1)
+		ms.latency.With("method", "update_channel_schema").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.UpdateSchema(ctx, session, s)
+}
+
+func (ms *metricsMiddleware) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_channel_schema").Add(1)
+		ms.latency.With("method", "remove_channel_schema").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RemoveSchema(ctx, session, channelID)
+}
+
 func (ms *metricsMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "connect").Add(1)
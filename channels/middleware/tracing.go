@@ -99,6 +99,40 @@ func (tm *tracingMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return tm.svc.RemoveChannel(ctx, session, id)
 }
 
+func (tm *tracingMiddleware) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_create_channel_schema", trace.WithAttributes(
+		attribute.String("channel_id", s.ChannelID),
+		attribute.String("type", string(s.Type)),
+	))
+	defer span.End()
+
+	return tm.svc.CreateSchema(ctx, session, s)
+}
+
+func (tm *tracingMiddleware) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_view_channel_schema", trace.WithAttributes(attribute.String("channel_id", channelID)))
+	defer span.End()
+
+	return tm.svc.ViewSchema(ctx, session, channelID)
+}
+
+func (tm *tracingMiddleware) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_update_channel_schema", trace.WithAttributes(
+		attribute.String("channel_id", s.ChannelID),
+		attribute.String("type", string(s.Type)),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateSchema(ctx, session, s)
+}
+
+func (tm *tracingMiddleware) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_remove_channel_schema", trace.WithAttributes(attribute.String("channel_id", channelID)))
+	defer span.End()
+
+	return tm.svc.RemoveSchema(ctx, session, channelID)
+}
+
 func (tm *tracingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "connect", trace.WithAttributes(
 		attribute.StringSlice("channel_ids", chIDs),
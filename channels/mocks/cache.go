@@ -11,6 +11,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/absmach/supermq/pkg/schema"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -176,6 +177,69 @@ func (_c *Cache_Remove_Call) RunAndReturn(run func(ctx context.Context, channelR
 	return _c
 }
 
+// RemoveSchema provides a mock function for the type Cache
+func (_mock *Cache) RemoveSchema(ctx context.Context, domainID string, channelID string) error {
+	ret := _mock.Called(ctx, domainID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSchema")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Cache_RemoveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchema'
+type Cache_RemoveSchema_Call struct {
+	*mock.Call
+}
+
+// RemoveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+func (_e *Cache_Expecter) RemoveSchema(ctx interface{}, domainID interface{}, channelID interface{}) *Cache_RemoveSchema_Call {
+	return &Cache_RemoveSchema_Call{Call: _e.mock.On("RemoveSchema", ctx, domainID, channelID)}
+}
+
+func (_c *Cache_RemoveSchema_Call) Run(run func(ctx context.Context, domainID string, channelID string)) *Cache_RemoveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Cache_RemoveSchema_Call) Return(err error) *Cache_RemoveSchema_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Cache_RemoveSchema_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string) error) *Cache_RemoveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type Cache
 func (_mock *Cache) Save(ctx context.Context, channelRoute string, domainID string, channelID string) error {
 	ret := _mock.Called(ctx, channelRoute, domainID, channelID)
@@ -244,3 +308,144 @@ func (_c *Cache_Save_Call) RunAndReturn(run func(ctx context.Context, channelRou
 	_c.Call.Return(run)
 	return _c
 }
+
+// SaveSchema provides a mock function for the type Cache
+func (_mock *Cache) SaveSchema(ctx context.Context, domainID string, channelID string, s schema.Schema) error {
+	ret := _mock.Called(ctx, domainID, channelID, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSchema")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, schema.Schema) error); ok {
+		r0 = returnFunc(ctx, domainID, channelID, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Cache_SaveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSchema'
+type Cache_SaveSchema_Call struct {
+	*mock.Call
+}
+
+// SaveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+//   - s schema.Schema
+func (_e *Cache_Expecter) SaveSchema(ctx interface{}, domainID interface{}, channelID interface{}, s interface{}) *Cache_SaveSchema_Call {
+	return &Cache_SaveSchema_Call{Call: _e.mock.On("SaveSchema", ctx, domainID, channelID, s)}
+}
+
+func (_c *Cache_SaveSchema_Call) Run(run func(ctx context.Context, domainID string, channelID string, s schema.Schema)) *Cache_SaveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 schema.Schema
+		if args[3] != nil {
+			arg3 = args[3].(schema.Schema)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Cache_SaveSchema_Call) Return(err error) *Cache_SaveSchema_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Cache_SaveSchema_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string, s schema.Schema) error) *Cache_SaveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schema provides a mock function for the type Cache
+func (_mock *Cache) Schema(ctx context.Context, domainID string, channelID string) (schema.Schema, error) {
+	ret := _mock.Called(ctx, domainID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schema")
+	}
+
+	var r0 schema.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (schema.Schema, error)); ok {
+		return returnFunc(ctx, domainID, channelID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) schema.Schema); ok {
+		r0 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r0 = ret.Get(0).(schema.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Cache_Schema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schema'
+type Cache_Schema_Call struct {
+	*mock.Call
+}
+
+// Schema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+func (_e *Cache_Expecter) Schema(ctx interface{}, domainID interface{}, channelID interface{}) *Cache_Schema_Call {
+	return &Cache_Schema_Call{Call: _e.mock.On("Schema", ctx, domainID, channelID)}
+}
+
+func (_c *Cache_Schema_Call) Run(run func(ctx context.Context, domainID string, channelID string)) *Cache_Schema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Cache_Schema_Call) Return(schema1 schema.Schema, err error) *Cache_Schema_Call {
+	_c.Call.Return(schema1, err)
+	return _c
+}
+
+func (_c *Cache_Schema_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string) (schema.Schema, error)) *Cache_Schema_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -376,6 +376,89 @@ func (_c *ChannelsServiceClient_RetrieveIDByRoute_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// RetrieveSchema provides a mock function for the type ChannelsServiceClient
+func (_mock *ChannelsServiceClient) RetrieveSchema(ctx context.Context, in *v1.RetrieveSchemaReq, opts ...grpc.CallOption) (*v1.RetrieveSchemaRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveSchema")
+	}
+
+	var r0 *v1.RetrieveSchemaRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveSchemaReq, ...grpc.CallOption) (*v1.RetrieveSchemaRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveSchemaReq, ...grpc.CallOption) *v1.RetrieveSchemaRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RetrieveSchemaRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RetrieveSchemaReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ChannelsServiceClient_RetrieveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveSchema'
+type ChannelsServiceClient_RetrieveSchema_Call struct {
+	*mock.Call
+}
+
+// RetrieveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RetrieveSchemaReq
+//   - opts ...grpc.CallOption
+func (_e *ChannelsServiceClient_Expecter) RetrieveSchema(ctx interface{}, in interface{}, opts ...interface{}) *ChannelsServiceClient_RetrieveSchema_Call {
+	return &ChannelsServiceClient_RetrieveSchema_Call{Call: _e.mock.On("RetrieveSchema",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ChannelsServiceClient_RetrieveSchema_Call) Run(run func(ctx context.Context, in *v1.RetrieveSchemaReq, opts ...grpc.CallOption)) *ChannelsServiceClient_RetrieveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.RetrieveSchemaReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.RetrieveSchemaReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ChannelsServiceClient_RetrieveSchema_Call) Return(retrieveSchemaRes *v1.RetrieveSchemaRes, err error) *ChannelsServiceClient_RetrieveSchema_Call {
+	_c.Call.Return(retrieveSchemaRes, err)
+	return _c
+}
+
+func (_c *ChannelsServiceClient_RetrieveSchema_Call) RunAndReturn(run func(ctx context.Context, in *v1.RetrieveSchemaReq, opts ...grpc.CallOption) (*v1.RetrieveSchemaRes, error)) *ChannelsServiceClient_RetrieveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnsetParentGroupFromChannels provides a mock function for the type ChannelsServiceClient
 func (_mock *ChannelsServiceClient) UnsetParentGroupFromChannels(ctx context.Context, in *v1.UnsetParentGroupFromChannelsReq, opts ...grpc.CallOption) (*v1.UnsetParentGroupFromChannelsRes, error) {
 	var tmpRet mock.Arguments
@@ -414,6 +414,83 @@ func (_c *Repository_ClientAuthorize_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// CountByDomain provides a mock function for the type Repository
+func (_mock *Repository) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	var tmpRet mock.Arguments
+	if len(domainIDs) > 0 {
+		tmpRet = _mock.Called(ctx, domainIDs)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByDomain")
+	}
+
+	var r0 map[string]uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) (map[string]uint64, error)); ok {
+		return returnFunc(ctx, domainIDs...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) map[string]uint64); ok {
+		r0 = returnFunc(ctx, domainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = returnFunc(ctx, domainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CountByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByDomain'
+type Repository_CountByDomain_Call struct {
+	*mock.Call
+}
+
+// CountByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainIDs ...string
+func (_e *Repository_Expecter) CountByDomain(ctx interface{}, domainIDs ...interface{}) *Repository_CountByDomain_Call {
+	return &Repository_CountByDomain_Call{Call: _e.mock.On("CountByDomain",
+		append([]interface{}{ctx}, domainIDs...)...)}
+}
+
+func (_c *Repository_CountByDomain_Call) Run(run func(ctx context.Context, domainIDs ...string)) *Repository_CountByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		var variadicArgs []string
+		if len(args) > 1 {
+			variadicArgs = args[1].([]string)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) Return(stringToUint64 map[string]uint64, err error) *Repository_CountByDomain_Call {
+	_c.Call.Return(stringToUint64, err)
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) RunAndReturn(run func(ctx context.Context, domainIDs ...string) (map[string]uint64, error)) *Repository_CountByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DoesChannelHaveConnections provides a mock function for the type Repository
 func (_mock *Repository) DoesChannelHaveConnections(ctx context.Context, id string) (bool, error) {
 	ret := _mock.Called(ctx, id)
@@ -1023,6 +1100,69 @@ func (_c *Repository_RemoveRoles_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// RemoveSchema provides a mock function for the type Repository
+func (_mock *Repository) RemoveSchema(ctx context.Context, domainID string, channelID string) error {
+	ret := _mock.Called(ctx, domainID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSchema")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchema'
+type Repository_RemoveSchema_Call struct {
+	*mock.Call
+}
+
+// RemoveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+func (_e *Repository_Expecter) RemoveSchema(ctx interface{}, domainID interface{}, channelID interface{}) *Repository_RemoveSchema_Call {
+	return &Repository_RemoveSchema_Call{Call: _e.mock.On("RemoveSchema", ctx, domainID, channelID)}
+}
+
+func (_c *Repository_RemoveSchema_Call) Run(run func(ctx context.Context, domainID string, channelID string)) *Repository_RemoveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveSchema_Call) Return(err error) *Repository_RemoveSchema_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveSchema_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string) error) *Repository_RemoveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAll provides a mock function for the type Repository
 func (_mock *Repository) RetrieveAll(ctx context.Context, pm channels.Page) (channels.ChannelsPage, error) {
 	ret := _mock.Called(ctx, pm)
@@ -1659,6 +1799,78 @@ func (_c *Repository_RetrieveRole_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// RetrieveSchema provides a mock function for the type Repository
+func (_mock *Repository) RetrieveSchema(ctx context.Context, domainID string, channelID string) (channels.Schema, error) {
+	ret := _mock.Called(ctx, domainID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (channels.Schema, error)); ok {
+		return returnFunc(ctx, domainID, channelID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) channels.Schema); ok {
+		r0 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveSchema'
+type Repository_RetrieveSchema_Call struct {
+	*mock.Call
+}
+
+// RetrieveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+func (_e *Repository_Expecter) RetrieveSchema(ctx interface{}, domainID interface{}, channelID interface{}) *Repository_RetrieveSchema_Call {
+	return &Repository_RetrieveSchema_Call{Call: _e.mock.On("RetrieveSchema", ctx, domainID, channelID)}
+}
+
+func (_c *Repository_RetrieveSchema_Call) Run(run func(ctx context.Context, domainID string, channelID string)) *Repository_RetrieveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveSchema_Call) Return(schema channels.Schema, err error) *Repository_RetrieveSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveSchema_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string) (channels.Schema, error)) *Repository_RetrieveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveUserChannels provides a mock function for the type Repository
 func (_mock *Repository) RetrieveUserChannels(ctx context.Context, domainID string, userID string, pm channels.Page) (channels.ChannelsPage, error) {
 	ret := _mock.Called(ctx, domainID, userID, pm)
@@ -2492,6 +2704,72 @@ func (_c *Repository_Save_Call) RunAndReturn(run func(ctx context.Context, chs .
 	return _c
 }
 
+// SaveSchema provides a mock function for the type Repository
+func (_mock *Repository) SaveSchema(ctx context.Context, s channels.Schema) (channels.Schema, error) {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Schema) (channels.Schema, error)); ok {
+		return returnFunc(ctx, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Schema) channels.Schema); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, channels.Schema) error); ok {
+		r1 = returnFunc(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_SaveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSchema'
+type Repository_SaveSchema_Call struct {
+	*mock.Call
+}
+
+// SaveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s channels.Schema
+func (_e *Repository_Expecter) SaveSchema(ctx interface{}, s interface{}) *Repository_SaveSchema_Call {
+	return &Repository_SaveSchema_Call{Call: _e.mock.On("SaveSchema", ctx, s)}
+}
+
+func (_c *Repository_SaveSchema_Call) Run(run func(ctx context.Context, s channels.Schema)) *Repository_SaveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 channels.Schema
+		if args[1] != nil {
+			arg1 = args[1].(channels.Schema)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveSchema_Call) Return(schema channels.Schema, err error) *Repository_SaveSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Repository_SaveSchema_Call) RunAndReturn(run func(ctx context.Context, s channels.Schema) (channels.Schema, error)) *Repository_SaveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetParentGroup provides a mock function for the type Repository
 func (_mock *Repository) SetParentGroup(ctx context.Context, ch channels.Channel) error {
 	ret := _mock.Called(ctx, ch)
@@ -2738,6 +3016,72 @@ func (_c *Repository_UpdateRole_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// UpdateSchema provides a mock function for the type Repository
+func (_mock *Repository) UpdateSchema(ctx context.Context, s channels.Schema) (channels.Schema, error) {
+	ret := _mock.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Schema) (channels.Schema, error)); ok {
+		return returnFunc(ctx, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Schema) channels.Schema); ok {
+		r0 = returnFunc(ctx, s)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, channels.Schema) error); ok {
+		r1 = returnFunc(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type Repository_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s channels.Schema
+func (_e *Repository_Expecter) UpdateSchema(ctx interface{}, s interface{}) *Repository_UpdateSchema_Call {
+	return &Repository_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", ctx, s)}
+}
+
+func (_c *Repository_UpdateSchema_Call) Run(run func(ctx context.Context, s channels.Schema)) *Repository_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 channels.Schema
+		if args[1] != nil {
+			arg1 = args[1].(channels.Schema)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateSchema_Call) Return(schema channels.Schema, err error) *Repository_UpdateSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Repository_UpdateSchema_Call) RunAndReturn(run func(ctx context.Context, s channels.Schema) (channels.Schema, error)) *Repository_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTags provides a mock function for the type Repository
 func (_mock *Repository) UpdateTags(ctx context.Context, ch channels.Channel) (channels.Channel, error) {
 	ret := _mock.Called(ctx, ch)
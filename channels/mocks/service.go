@@ -301,6 +301,78 @@ func (_c *Service_CreateChannels_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// CreateSchema provides a mock function for the type Service
+func (_mock *Service) CreateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	ret := _mock.Called(ctx, session, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Schema) (channels.Schema, error)); ok {
+		return returnFunc(ctx, session, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Schema) channels.Schema); ok {
+		r0 = returnFunc(ctx, session, s)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, channels.Schema) error); ok {
+		r1 = returnFunc(ctx, session, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_CreateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchema'
+type Service_CreateSchema_Call struct {
+	*mock.Call
+}
+
+// CreateSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - s channels.Schema
+func (_e *Service_Expecter) CreateSchema(ctx interface{}, session interface{}, s interface{}) *Service_CreateSchema_Call {
+	return &Service_CreateSchema_Call{Call: _e.mock.On("CreateSchema", ctx, session, s)}
+}
+
+func (_c *Service_CreateSchema_Call) Run(run func(ctx context.Context, session authn.Session, s channels.Schema)) *Service_CreateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 channels.Schema
+		if args[2] != nil {
+			arg2 = args[2].(channels.Schema)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_CreateSchema_Call) Return(schema channels.Schema, err error) *Service_CreateSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Service_CreateSchema_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error)) *Service_CreateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisableChannel provides a mock function for the type Service
 func (_mock *Service) DisableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
 	ret := _mock.Called(ctx, session, id)
@@ -1143,6 +1215,69 @@ func (_c *Service_RemoveRole_Call) RunAndReturn(run func(ctx context.Context, se
 	return _c
 }
 
+// RemoveSchema provides a mock function for the type Service
+func (_mock *Service) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	ret := _mock.Called(ctx, session, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSchema")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, channelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSchema'
+type Service_RemoveSchema_Call struct {
+	*mock.Call
+}
+
+// RemoveSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - channelID string
+func (_e *Service_Expecter) RemoveSchema(ctx interface{}, session interface{}, channelID interface{}) *Service_RemoveSchema_Call {
+	return &Service_RemoveSchema_Call{Call: _e.mock.On("RemoveSchema", ctx, session, channelID)}
+}
+
+func (_c *Service_RemoveSchema_Call) Run(run func(ctx context.Context, session authn.Session, channelID string)) *Service_RemoveSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveSchema_Call) Return(err error) *Service_RemoveSchema_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveSchema_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, channelID string) error) *Service_RemoveSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAllRoles provides a mock function for the type Service
 func (_mock *Service) RetrieveAllRoles(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.RolePage, error) {
 	ret := _mock.Called(ctx, session, entityID, limit, offset)
@@ -2400,6 +2535,78 @@ func (_c *Service_UpdateRoleName_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// UpdateSchema provides a mock function for the type Service
+func (_mock *Service) UpdateSchema(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error) {
+	ret := _mock.Called(ctx, session, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Schema) (channels.Schema, error)); ok {
+		return returnFunc(ctx, session, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, channels.Schema) channels.Schema); ok {
+		r0 = returnFunc(ctx, session, s)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, channels.Schema) error); ok {
+		r1 = returnFunc(ctx, session, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type Service_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - s channels.Schema
+func (_e *Service_Expecter) UpdateSchema(ctx interface{}, session interface{}, s interface{}) *Service_UpdateSchema_Call {
+	return &Service_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", ctx, session, s)}
+}
+
+func (_c *Service_UpdateSchema_Call) Run(run func(ctx context.Context, session authn.Session, s channels.Schema)) *Service_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 channels.Schema
+		if args[2] != nil {
+			arg2 = args[2].(channels.Schema)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateSchema_Call) Return(schema channels.Schema, err error) *Service_UpdateSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Service_UpdateSchema_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, s channels.Schema) (channels.Schema, error)) *Service_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ViewChannel provides a mock function for the type Service
 func (_mock *Service) ViewChannel(ctx context.Context, session authn.Session, id string, withRoles bool) (channels.Channel, error) {
 	ret := _mock.Called(ctx, session, id, withRoles)
@@ -2477,3 +2684,75 @@ func (_c *Service_ViewChannel_Call) RunAndReturn(run func(ctx context.Context, s
 	_c.Call.Return(run)
 	return _c
 }
+
+// ViewSchema provides a mock function for the type Service
+func (_mock *Service) ViewSchema(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error) {
+	ret := _mock.Called(ctx, session, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewSchema")
+	}
+
+	var r0 channels.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (channels.Schema, error)); ok {
+		return returnFunc(ctx, session, channelID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) channels.Schema); ok {
+		r0 = returnFunc(ctx, session, channelID)
+	} else {
+		r0 = ret.Get(0).(channels.Schema)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ViewSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewSchema'
+type Service_ViewSchema_Call struct {
+	*mock.Call
+}
+
+// ViewSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - channelID string
+func (_e *Service_Expecter) ViewSchema(ctx interface{}, session interface{}, channelID interface{}) *Service_ViewSchema_Call {
+	return &Service_ViewSchema_Call{Call: _e.mock.On("ViewSchema", ctx, session, channelID)}
+}
+
+func (_c *Service_ViewSchema_Call) Run(run func(ctx context.Context, session authn.Session, channelID string)) *Service_ViewSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ViewSchema_Call) Return(schema channels.Schema, err error) *Service_ViewSchema_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *Service_ViewSchema_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, channelID string) (channels.Schema, error)) *Service_ViewSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -20,6 +20,8 @@ const (
 	OpConnectClient
 	OpDisconnectClient
 	OpListUserChannels
+	OpViewChannelSchema
+	OpUpdateChannelSchema
 )
 
 func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
@@ -68,5 +70,13 @@ func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
 			Name:               "list_user_channels",
 			PermissionRequired: false, // hardcoded to superadmin
 		},
+		OpViewChannelSchema: {
+			Name:               "view_schema",
+			PermissionRequired: true,
+		},
+		OpUpdateChannelSchema: {
+			Name:               "update_schema",
+			PermissionRequired: true,
+		},
 	}
 }
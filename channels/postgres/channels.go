@@ -912,6 +912,35 @@ func (cr *channelRepository) Remove(ctx context.Context, ids ...string) error {
 	return nil
 }
 
+func (cr *channelRepository) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	q := "SELECT domain_id, COUNT(*) AS total FROM channels GROUP BY domain_id"
+	if len(domainIDs) > 0 {
+		q = "SELECT domain_id, COUNT(*) AS total FROM channels WHERE domain_id = ANY(:domain_ids) GROUP BY domain_id"
+	}
+	params := map[string]any{
+		"domain_ids": domainIDs,
+	}
+	rows, err := cr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, cr.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+	for rows.Next() {
+		var c struct {
+			DomainID string `db:"domain_id"`
+			Total    uint64 `db:"total"`
+		}
+		if err := rows.StructScan(&c); err != nil {
+			return nil, cr.eh.HandleError(repoerr.ErrViewEntity, err)
+		}
+		counts[c.DomainID] = c.Total
+	}
+
+	return counts, nil
+}
+
 func (cr *channelRepository) SetParentGroup(ctx context.Context, ch channels.Channel) error {
 	q := "UPDATE channels SET parent_group_id = :parent_group_id, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id"
 	dbCh, err := toDBChannel(ch)
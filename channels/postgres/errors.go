@@ -16,6 +16,8 @@ func (d duplicateErrors) GetError(constraint string) (error, bool) {
 		return errors.ErrRouteNotAvailable, true
 	case "channels_pkey":
 		return errors.NewRequestError("channel id already exists"), true
+	case "channel_schemas_pkey":
+		return errors.NewRequestError("channel schema already exists"), true
 	default:
 		return nil, false
 	}
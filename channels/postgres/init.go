@@ -84,6 +84,27 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`ALTER TABLE channels ALTER COLUMN updated_at TYPE TIMESTAMP;`,
 				},
 			},
+			{
+				Id: "channels_05",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS channel_schemas (
+						channel_id    VARCHAR(36) PRIMARY KEY,
+						domain_id     VARCHAR(36) NOT NULL,
+						type          VARCHAR(16) NOT NULL,
+						definition    JSONB,
+						names         TEXT[],
+						units         TEXT[],
+						created_at    TIMESTAMPTZ NOT NULL,
+						created_by    VARCHAR(254),
+						updated_at    TIMESTAMPTZ,
+						updated_by    VARCHAR(254),
+						FOREIGN KEY   (channel_id, domain_id) REFERENCES channels (id, domain_id) ON DELETE CASCADE ON UPDATE CASCADE
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS channel_schemas`,
+				},
+			},
 		},
 	}
 	channelsMigration.Migrations = append(channelsMigration.Migrations, rolesMigration.Migrations...)
@@ -0,0 +1,146 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/channels"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/schema"
+	"github.com/lib/pq"
+)
+
+const schemaColumns = `channel_id, domain_id, type, definition, names, units, created_at, COALESCE(created_by, '') AS created_by,
+	updated_at, COALESCE(updated_by, '') AS updated_by`
+
+func (cr *channelRepository) SaveSchema(ctx context.Context, s channels.Schema) (channels.Schema, error) {
+	q := fmt.Sprintf(`INSERT INTO channel_schemas (channel_id, domain_id, type, definition, names, units, created_at, created_by)
+		VALUES (:channel_id, :domain_id, :type, :definition, :names, :units, :created_at, :created_by)
+		RETURNING %s`, schemaColumns)
+
+	return cr.schemaReturning(ctx, q, toDBSchema(s), repoerr.ErrCreateEntity)
+}
+
+func (cr *channelRepository) RetrieveSchema(ctx context.Context, domainID, channelID string) (channels.Schema, error) {
+	q := fmt.Sprintf(`SELECT %s FROM channel_schemas WHERE channel_id = :channel_id AND domain_id = :domain_id`, schemaColumns)
+
+	dbs := dbSchema{
+		ChannelID: channelID,
+		DomainID:  domainID,
+	}
+
+	return cr.schemaReturning(ctx, q, dbs, repoerr.ErrViewEntity)
+}
+
+func (cr *channelRepository) UpdateSchema(ctx context.Context, s channels.Schema) (channels.Schema, error) {
+	q := fmt.Sprintf(`UPDATE channel_schemas SET type = :type, definition = :definition, names = :names, units = :units,
+		updated_at = :updated_at, updated_by = :updated_by
+		WHERE channel_id = :channel_id AND domain_id = :domain_id
+		RETURNING %s`, schemaColumns)
+
+	return cr.schemaReturning(ctx, q, toDBSchema(s), repoerr.ErrUpdateEntity)
+}
+
+func (cr *channelRepository) RemoveSchema(ctx context.Context, domainID, channelID string) error {
+	q := `DELETE FROM channel_schemas WHERE channel_id = :channel_id AND domain_id = :domain_id`
+
+	dbs := dbSchema{
+		ChannelID: channelID,
+		DomainID:  domainID,
+	}
+	result, err := cr.db.NamedExecContext(ctx, q, dbs)
+	if err != nil {
+		return cr.eh.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (cr *channelRepository) schemaReturning(ctx context.Context, q string, dbs dbSchema, wrap error) (channels.Schema, error) {
+	row, err := cr.db.NamedQueryContext(ctx, q, dbs)
+	if err != nil {
+		return channels.Schema{}, cr.eh.HandleError(wrap, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return channels.Schema{}, cr.eh.HandleError(wrap, err)
+		}
+		return channels.Schema{}, repoerr.ErrNotFound
+	}
+	dbs = dbSchema{}
+	if err := row.StructScan(&dbs); err != nil {
+		return channels.Schema{}, errors.Wrap(wrap, err)
+	}
+
+	return toSchema(dbs), nil
+}
+
+type dbSchema struct {
+	ChannelID  string         `db:"channel_id"`
+	DomainID   string         `db:"domain_id"`
+	Type       string         `db:"type"`
+	Definition []byte         `db:"definition"`
+	Names      pq.StringArray `db:"names"`
+	Units      pq.StringArray `db:"units"`
+	CreatedAt  time.Time      `db:"created_at"`
+	CreatedBy  string         `db:"created_by"`
+	UpdatedAt  sql.NullTime   `db:"updated_at"`
+	UpdatedBy  string         `db:"updated_by"`
+}
+
+func toDBSchema(s channels.Schema) dbSchema {
+	var definition []byte
+	if len(s.Definition) > 0 {
+		definition = s.Definition
+	}
+	var updatedAt sql.NullTime
+	if !s.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: s.UpdatedAt, Valid: true}
+	}
+
+	return dbSchema{
+		ChannelID:  s.ChannelID,
+		DomainID:   s.DomainID,
+		Type:       string(s.Type),
+		Definition: definition,
+		Names:      s.Names,
+		Units:      s.Units,
+		CreatedAt:  s.CreatedAt,
+		CreatedBy:  s.CreatedBy,
+		UpdatedAt:  updatedAt,
+		UpdatedBy:  s.UpdatedBy,
+	}
+}
+
+func toSchema(dbs dbSchema) channels.Schema {
+	var updatedAt time.Time
+	if dbs.UpdatedAt.Valid {
+		updatedAt = dbs.UpdatedAt.Time.UTC()
+	}
+
+	return channels.Schema{
+		ChannelID: dbs.ChannelID,
+		DomainID:  dbs.DomainID,
+		Schema: schema.Schema{
+			Type:       schema.Type(dbs.Type),
+			Definition: dbs.Definition,
+			Names:      dbs.Names,
+			Units:      dbs.Units,
+		},
+		CreatedAt: dbs.CreatedAt.UTC(),
+		CreatedBy: dbs.CreatedBy,
+		UpdatedAt: updatedAt,
+		UpdatedBy: dbs.UpdatedBy,
+	}
+}
@@ -10,8 +10,11 @@ import (
 	dom "github.com/absmach/supermq/domains"
 	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 var errDisabledDomain = errors.New("domain is disabled or frozen")
@@ -22,8 +25,15 @@ type Service interface {
 	RemoveClientConnections(ctx context.Context, clientID string) error
 	RetrieveByID(ctx context.Context, id string) (channels.Channel, error)
 	RetrieveIDByRoute(ctx context.Context, route, domainID string) (string, error)
+	// RetrieveSchema retrieves the message schema of the channel.
+	// Empty schema is returned for channels without schema.
+	RetrieveSchema(ctx context.Context, domainID, channelID string) (schema.Schema, error)
+	// DeleteDomainChannels removes all the channels of the domain together with their roles and policies.
+	DeleteDomainChannels(ctx context.Context, domainID string) error
 }
 
+const defLimit = uint64(100)
+
 type service struct {
 	repo      channels.Repository
 	cache     channels.Cache
@@ -138,3 +148,75 @@ func (svc service) RetrieveIDByRoute(ctx context.Context, route, domainID string
 
 	return chn.ID, nil
 }
+
+func (svc service) RetrieveSchema(ctx context.Context, domainID, channelID string) (schema.Schema, error) {
+	s, err := svc.cache.Schema(ctx, domainID, channelID)
+	if err == nil {
+		return s, nil
+	}
+	chs, err := svc.repo.RetrieveSchema(ctx, domainID, channelID)
+	switch {
+	case err == nil:
+		s = chs.Schema
+	case errors.Contains(err, repoerr.ErrNotFound):
+		s = schema.Schema{}
+	default:
+		return schema.Schema{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if err := svc.cache.SaveSchema(ctx, domainID, channelID, s); err != nil {
+		return schema.Schema{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return s, nil
+}
+
+func (svc service) DeleteDomainChannels(ctx context.Context, domainID string) error {
+	pm := channels.Page{Domain: domainID, Status: channels.AllStatus, Limit: defLimit}
+	for {
+		cp, err := svc.repo.RetrieveAll(ctx, pm)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		if len(cp.Channels) == 0 {
+			return nil
+		}
+
+		ids := []string{}
+		for _, ch := range cp.Channels {
+			ids = append(ids, ch.ID)
+		}
+		if err := roles.RemoveEntitiesRolesPolicies(ctx, svc.repo, svc.policy, policies.ChannelType, domainID, ids); err != nil {
+			return errors.Wrap(svcerr.ErrDeletePolicies, err)
+		}
+		for _, ch := range cp.Channels {
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.ChannelType,
+					Subject:     ch.ID,
+				},
+				{
+					ObjectType: policies.ChannelType,
+					Object:     ch.ID,
+				},
+			}
+			for _, fp := range filterDeletePolicies {
+				if err := svc.policy.DeletePolicyFilter(ctx, fp); err != nil {
+					return errors.Wrap(svcerr.ErrDeletePolicies, err)
+				}
+			}
+			if ch.Route != "" {
+				if err := svc.cache.Remove(ctx, ch.Route, domainID); err != nil {
+					return errors.Wrap(svcerr.ErrRemoveEntity, err)
+				}
+			}
+			if err := svc.cache.RemoveSchema(ctx, domainID, ch.ID); err != nil {
+				return errors.Wrap(svcerr.ErrRemoveEntity, err)
+			}
+		}
+
+		// Channel roles and connections are removed by the database cascade.
+		if err := svc.repo.Remove(ctx, ids...); err != nil {
+			return errors.Wrap(svcerr.ErrRemoveEntity, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package channels
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/absmach/supermq"
@@ -13,8 +14,10 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -27,6 +30,7 @@ var (
 	errRemoveConnectionsClients = errors.New("failed to remove connections from clients service")
 	errSetParentGroup           = errors.New("channel already have parent")
 	errSetSameParentGroup       = errors.New("channel already assigned to the parent group")
+	errReleaseQuota             = errors.New("failed to release domain quota")
 )
 
 type service struct {
@@ -36,12 +40,14 @@ type service struct {
 	idProvider supermq.IDProvider
 	clients    grpcClientsV1.ClientsServiceClient
 	groups     grpcGroupsV1.GroupsServiceClient
+	quotas     pkgDomains.Quotas
+	logger     *slog.Logger
 	roles.ProvisionManageService
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo Repository, cache Cache, policy policies.Service, idProvider supermq.IDProvider, clients grpcClientsV1.ClientsServiceClient, groups grpcGroupsV1.GroupsServiceClient, sidProvider supermq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func New(repo Repository, cache Cache, policy policies.Service, idProvider supermq.IDProvider, clients grpcClientsV1.ClientsServiceClient, groups grpcGroupsV1.GroupsServiceClient, quotas pkgDomains.Quotas, sidProvider supermq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action, logger *slog.Logger) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(policies.ChannelType, repo, policy, sidProvider, availableActions, builtInRoles)
 	if err != nil {
 		return nil, err
@@ -54,6 +60,8 @@ func New(repo Repository, cache Cache, policy policies.Service, idProvider super
 		idProvider:             idProvider,
 		clients:                clients,
 		groups:                 groups,
+		quotas:                 quotas,
+		logger:                 logger,
 		ProvisionManageService: rpms,
 	}, nil
 }
@@ -77,6 +85,21 @@ func (svc service) CreateChannels(ctx context.Context, session authn.Session, ch
 		reChs = append(reChs, c)
 	}
 
+	n := uint64(len(reChs))
+	if err := svc.quotas.Reserve(ctx, session.DomainID, domains.ChannelsResource, n); err != nil {
+		if errors.Contains(err, svcerr.ErrQuotaExceeded) {
+			return []Channel{}, []roles.RoleProvision{}, err
+		}
+		return []Channel{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRelease := svc.quotas.Release(ctx, session.DomainID, domains.ChannelsResource, n); errRelease != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errReleaseQuota, errRelease))
+			}
+		}
+	}()
+
 	savedChs, err := svc.repo.Save(ctx, reChs...)
 	if err != nil {
 		if errors.Contains(err, errors.ErrRouteNotAvailable) {
@@ -239,6 +262,9 @@ func (svc service) RemoveChannel(ctx context.Context, session authn.Session, id
 			return errors.Wrap(svcerr.ErrRemoveEntity, err)
 		}
 	}
+	if err := svc.cache.RemoveSchema(ctx, ch.Domain, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
 
 	deletePolicies := []policies.Policy{
 		{
@@ -279,9 +305,25 @@ func (svc service) RemoveChannel(ctx context.Context, session authn.Session, id
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
+	svc.releaseQuota(ctx, session.DomainID)
+
 	return nil
 }
 
+// releaseQuota releases the domain quota of the removed channel. Since the
+// channel is already removed, the failure doesn't fail the request, and the
+// domain usage is reconciled with the domain channels instead.
+func (svc service) releaseQuota(ctx context.Context, domainID string) {
+	err := svc.quotas.Release(ctx, domainID, domains.ChannelsResource, 1)
+	if err == nil {
+		return
+	}
+	svc.logger.Warn("failed to release domain quota", slog.String("domain_id", domainID), slog.Any("error", err))
+	if err := pkgDomains.ReconcileUsage(ctx, svc.quotas, domains.ChannelsResource, svc.repo.CountByDomain, domainID); err != nil {
+		svc.logger.Error("failed to reconcile domain usage", slog.String("domain_id", domainID), slog.Any("error", err))
+	}
+}
+
 func (svc service) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) (retErr error) {
 	for _, chID := range chIDs {
 		c, err := svc.repo.RetrieveByID(ctx, chID)
@@ -495,6 +537,65 @@ func (svc service) RemoveParentGroup(ctx context.Context, session authn.Session,
 	return nil
 }
 
+func (svc service) CreateSchema(ctx context.Context, session authn.Session, s Schema) (Schema, error) {
+	if err := s.Validate(); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	s.DomainID = session.DomainID
+	s.CreatedAt = time.Now().UTC()
+	s.CreatedBy = session.UserID
+
+	saved, err := svc.repo.SaveSchema(ctx, s)
+	if err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	// Adapters may have cached the channel without schema.
+	if err := svc.cache.RemoveSchema(ctx, saved.DomainID, saved.ChannelID); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return saved, nil
+}
+
+func (svc service) ViewSchema(ctx context.Context, session authn.Session, channelID string) (Schema, error) {
+	s, err := svc.repo.RetrieveSchema(ctx, session.DomainID, channelID)
+	if err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return s, nil
+}
+
+func (svc service) UpdateSchema(ctx context.Context, session authn.Session, s Schema) (Schema, error) {
+	if err := s.Validate(); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	s.DomainID = session.DomainID
+	s.UpdatedAt = time.Now().UTC()
+	s.UpdatedBy = session.UserID
+
+	updated, err := svc.repo.UpdateSchema(ctx, s)
+	if err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	if err := svc.cache.RemoveSchema(ctx, updated.DomainID, updated.ChannelID); err != nil {
+		return Schema{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return updated, nil
+}
+
+func (svc service) RemoveSchema(ctx context.Context, session authn.Session, channelID string) error {
+	if err := svc.repo.RemoveSchema(ctx, session.DomainID, channelID); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+	if err := svc.cache.RemoveSchema(ctx, session.DomainID, channelID); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
 func (svc service) changeChannelStatus(ctx context.Context, userID string, channel Channel) (Channel, error) {
 	dbchannel, err := svc.repo.RetrieveByID(ctx, channel.ID)
 	if err != nil {
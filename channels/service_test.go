@@ -16,17 +16,21 @@ import (
 	"github.com/absmach/supermq/channels"
 	"github.com/absmach/supermq/channels/mocks"
 	clmocks "github.com/absmach/supermq/clients/mocks"
+	"github.com/absmach/supermq/domains"
 	gpmocks "github.com/absmach/supermq/groups/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
 	"github.com/absmach/supermq/pkg/authn"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/connections"
+	dmocks "github.com/absmach/supermq/pkg/domains/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	policysvc "github.com/absmach/supermq/pkg/policies"
 	policymocks "github.com/absmach/supermq/pkg/policies/mocks"
 	"github.com/absmach/supermq/pkg/roles"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -74,6 +78,7 @@ var (
 	policies   *policymocks.Service
 	clientsSvc *clmocks.ClientsServiceClient
 	groupsSvc  *gpmocks.GroupsServiceClient
+	quotas     *dmocks.Quotas
 )
 
 func newService(t *testing.T) channels.Service {
@@ -82,11 +87,12 @@ func newService(t *testing.T) channels.Service {
 	policies = new(policymocks.Service)
 	clientsSvc = new(clmocks.ClientsServiceClient)
 	groupsSvc = new(gpmocks.GroupsServiceClient)
+	quotas = new(dmocks.Quotas)
 	availableActions := []roles.Action{}
 	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
 		channels.BuiltInRoleAdmin: availableActions,
 	}
-	svc, err := channels.New(repo, cache, policies, idProvider, clientsSvc, groupsSvc, idProvider, availableActions, builtInRoles)
+	svc, err := channels.New(repo, cache, policies, idProvider, clientsSvc, groupsSvc, quotas, idProvider, availableActions, builtInRoles, smqlog.NewMock())
 	assert.Nil(t, err, fmt.Sprintf(" Unexpected error  while creating service %v", err))
 	return svc
 }
@@ -106,6 +112,7 @@ func TestCreateChannel(t *testing.T) {
 		addPoliciesErr    error
 		deletePoliciesErr error
 		addRoleErr        error
+		reserveErr        error
 		err               error
 	}{
 		{
@@ -204,6 +211,18 @@ func TestCreateChannel(t *testing.T) {
 			deletePoliciesErr: svcerr.ErrRemoveEntity,
 			err:               svcerr.ErrRemoveEntity,
 		},
+		{
+			desc:       "create channel with exceeded quota",
+			channel:    validChannel,
+			reserveErr: svcerr.ErrQuotaExceeded,
+			err:        svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:       "create channel with failed to reserve quota",
+			channel:    validChannel,
+			reserveErr: errors.ErrMalformedEntity,
+			err:        svcerr.ErrCreateEntity,
+		},
 	}
 
 	for _, tc := range cases {
@@ -213,6 +232,8 @@ func TestCreateChannel(t *testing.T) {
 			policyCall1 := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			repoCall1 := repo.On("AddRoles", context.Background(), mock.Anything).Return([]roles.RoleProvision{}, tc.addRoleErr)
 			repoCall2 := repo.On("Remove", context.Background(), mock.Anything).Return(tc.deleteErr)
+			quotasCall := quotas.On("Reserve", context.Background(), validSession.DomainID, domains.ChannelsResource, uint64(1)).Return(tc.reserveErr)
+			quotasCall1 := quotas.On("Release", context.Background(), validSession.DomainID, domains.ChannelsResource, uint64(1)).Return(nil)
 			_, _, err := svc.CreateChannels(context.Background(), validSession, tc.channel)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v but got %v", tc.err, err))
 			if err == nil {
@@ -224,6 +245,8 @@ func TestCreateChannel(t *testing.T) {
 			policyCall1.Unset()
 			repoCall1.Unset()
 			repoCall2.Unset()
+			quotasCall.Unset()
+			quotasCall1.Unset()
 		})
 	}
 }
@@ -701,6 +724,10 @@ func TestRemoveChannel(t *testing.T) {
 		deletePoliciesErr     error
 		deletePolicyFilterErr error
 		removeErr             error
+		releaseErr            error
+		countErr              error
+		reconcileErr          error
+		reconciled            bool
 		err                   error
 	}{
 		{
@@ -767,6 +794,34 @@ func TestRemoveChannel(t *testing.T) {
 			removeErr:       repoerr.ErrNotFound,
 			err:             svcerr.ErrRemoveEntity,
 		},
+		{
+			desc:            "remove channel with failed to release quota",
+			id:              validChannel.ID,
+			connectionsRes:  false,
+			changeStatusRes: deletedChannel,
+			releaseErr:      errors.ErrMalformedEntity,
+			reconciled:      true,
+			err:             nil,
+		},
+		{
+			desc:            "remove channel with failed to release quota and count channels",
+			id:              validChannel.ID,
+			connectionsRes:  false,
+			changeStatusRes: deletedChannel,
+			releaseErr:      errors.ErrMalformedEntity,
+			countErr:        repoerr.ErrViewEntity,
+			err:             nil,
+		},
+		{
+			desc:            "remove channel with failed to release quota and reconcile usage",
+			id:              validChannel.ID,
+			connectionsRes:  false,
+			changeStatusRes: deletedChannel,
+			releaseErr:      errors.ErrMalformedEntity,
+			reconcileErr:    svcerr.ErrUpdateEntity,
+			reconciled:      true,
+			err:             nil,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -774,11 +829,19 @@ func TestRemoveChannel(t *testing.T) {
 			clientsCall := clientsSvc.On("RemoveChannelConnections", context.Background(), &grpcClientsV1.RemoveChannelConnectionsReq{ChannelId: tc.id}).Return(&grpcClientsV1.RemoveChannelConnectionsRes{}, tc.removeConnectionsErr)
 			repoCall1 := repo.On("ChangeStatus", context.Background(), channels.Channel{ID: tc.id, Status: channels.DeletedStatus}).Return(tc.changeStatusRes, tc.changeStatusErr)
 			cacheCall := cache.On("Remove", context.Background(), tc.changeStatusRes.Route, tc.changeStatusRes.Domain).Return(nil)
+			cacheCall1 := cache.On("RemoveSchema", context.Background(), tc.changeStatusRes.Domain, tc.id).Return(nil)
 			repoCall2 := repo.On("RetrieveEntitiesRolesActionsMembers", context.Background(), []string{tc.id}).Return([]roles.EntityActionRole{}, []roles.EntityMemberRole{}, nil)
 			policyCall := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			policyCall1 := policies.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(tc.deletePolicyFilterErr)
 			repoCall3 := repoCall.On("Remove", context.Background(), []string{tc.id}).Return(tc.removeErr)
+			quotasCall := quotas.On("Release", context.Background(), validSession.DomainID, domains.ChannelsResource, uint64(1)).Return(tc.releaseErr)
+			countCall := repo.On("CountByDomain", context.Background(), []string{validSession.DomainID}).Return(map[string]uint64{}, tc.countErr)
+			reconciled := false
+			reconcileCall := quotas.On("Reconcile", context.Background(), validSession.DomainID, domains.ChannelsResource, uint64(0)).Run(func(mock.Arguments) {
+				reconciled = true
+			}).Return(tc.reconcileErr)
 			err := svc.RemoveChannel(context.Background(), validSession, tc.id)
+			assert.Equal(t, tc.reconciled, reconciled, fmt.Sprintf("%s: expected reconciled %t got %t", tc.desc, tc.reconciled, reconciled))
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			repoCall.Unset()
 			clientsCall.Unset()
@@ -788,6 +851,10 @@ func TestRemoveChannel(t *testing.T) {
 			repoCall2.Unset()
 			repoCall3.Unset()
 			cacheCall.Unset()
+			cacheCall1.Unset()
+			quotasCall.Unset()
+			countCall.Unset()
+			reconcileCall.Unset()
 		})
 	}
 }
@@ -1452,3 +1519,237 @@ func TestRemoveParentGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateSchema(t *testing.T) {
+	svc := newService(t)
+
+	jsonSchema := channels.Schema{
+		ChannelID: validChannel.ID,
+		Schema: schema.Schema{
+			Type:       schema.JSONType,
+			Definition: []byte(`{"type":"object","required":["temperature"]}`),
+		},
+	}
+	senmlSchema := channels.Schema{
+		ChannelID: validChannel.ID,
+		Schema: schema.Schema{
+			Type:  schema.SenMLType,
+			Names: []string{"temperature"},
+			Units: []string{"Cel"},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		schema   channels.Schema
+		repoResp channels.Schema
+		repoErr  error
+		cacheErr error
+		err      error
+	}{
+		{
+			desc:     "create JSON schema successfully",
+			schema:   jsonSchema,
+			repoResp: jsonSchema,
+		},
+		{
+			desc:     "create SenML schema successfully",
+			schema:   senmlSchema,
+			repoResp: senmlSchema,
+		},
+		{
+			desc: "create schema with invalid JSON schema definition",
+			schema: channels.Schema{
+				ChannelID: validChannel.ID,
+				Schema: schema.Schema{
+					Type:       schema.JSONType,
+					Definition: []byte(`{"type":"invalid"}`),
+				},
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "create schema with unsupported type",
+			schema: channels.Schema{
+				ChannelID: validChannel.ID,
+				Schema:    schema.Schema{Type: "xml"},
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "create schema with failed to save",
+			schema:  jsonSchema,
+			repoErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrCreateEntity,
+		},
+		{
+			desc:     "create schema with failed to invalidate cache",
+			schema:   jsonSchema,
+			repoResp: jsonSchema,
+			cacheErr: repoerr.ErrRemoveEntity,
+			err:      svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("SaveSchema", context.Background(), mock.Anything).Return(tc.repoResp, tc.repoErr)
+			cacheCall := cache.On("RemoveSchema", context.Background(), tc.repoResp.DomainID, tc.repoResp.ChannelID).Return(tc.cacheErr)
+			got, err := svc.CreateSchema(context.Background(), validSession, tc.schema)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			if err == nil {
+				assert.Equal(t, tc.repoResp, got)
+			}
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestViewSchema(t *testing.T) {
+	svc := newService(t)
+
+	s := channels.Schema{
+		ChannelID: validChannel.ID,
+		DomainID:  validSession.DomainID,
+		Schema: schema.Schema{
+			Type:  schema.SenMLType,
+			Names: []string{"temperature"},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		id       string
+		repoResp channels.Schema
+		repoErr  error
+		err      error
+	}{
+		{
+			desc:     "view schema successfully",
+			id:       validChannel.ID,
+			repoResp: s,
+		},
+		{
+			desc:    "view schema with failed to retrieve",
+			id:      testsutil.GenerateUUID(t),
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveSchema", context.Background(), validSession.DomainID, tc.id).Return(tc.repoResp, tc.repoErr)
+			got, err := svc.ViewSchema(context.Background(), validSession, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			if err == nil {
+				assert.Equal(t, tc.repoResp, got)
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestUpdateSchema(t *testing.T) {
+	svc := newService(t)
+
+	s := channels.Schema{
+		ChannelID: validChannel.ID,
+		DomainID:  validSession.DomainID,
+		Schema: schema.Schema{
+			Type:  schema.SenMLType,
+			Units: []string{"Cel"},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		schema   channels.Schema
+		repoResp channels.Schema
+		repoErr  error
+		cacheErr error
+		err      error
+	}{
+		{
+			desc:     "update schema successfully",
+			schema:   s,
+			repoResp: s,
+		},
+		{
+			desc: "update schema with empty SenML constraints",
+			schema: channels.Schema{
+				ChannelID: validChannel.ID,
+				Schema:    schema.Schema{Type: schema.SenMLType},
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "update schema with failed to update",
+			schema:  s,
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:     "update schema with failed to invalidate cache",
+			schema:   s,
+			repoResp: s,
+			cacheErr: repoerr.ErrRemoveEntity,
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("UpdateSchema", context.Background(), mock.Anything).Return(tc.repoResp, tc.repoErr)
+			cacheCall := cache.On("RemoveSchema", context.Background(), tc.repoResp.DomainID, tc.repoResp.ChannelID).Return(tc.cacheErr)
+			got, err := svc.UpdateSchema(context.Background(), validSession, tc.schema)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			if err == nil {
+				assert.Equal(t, tc.repoResp, got)
+			}
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRemoveSchema(t *testing.T) {
+	svc := newService(t)
+
+	cases := []struct {
+		desc     string
+		id       string
+		repoErr  error
+		cacheErr error
+		err      error
+	}{
+		{
+			desc: "remove schema successfully",
+			id:   validChannel.ID,
+		},
+		{
+			desc:    "remove schema with failed to remove",
+			id:      validChannel.ID,
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrRemoveEntity,
+		},
+		{
+			desc:     "remove schema with failed to invalidate cache",
+			id:       validChannel.ID,
+			cacheErr: repoerr.ErrRemoveEntity,
+			err:      svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RemoveSchema", context.Background(), validSession.DomainID, tc.id).Return(tc.repoErr)
+			cacheCall := cache.On("RemoveSchema", context.Background(), validSession.DomainID, tc.id).Return(tc.cacheErr)
+			err := svc.RemoveSchema(context.Background(), validSession, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
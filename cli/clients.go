@@ -6,6 +6,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/absmach/supermq/clients"
 	smqsdk "github.com/absmach/supermq/pkg/sdk"
@@ -19,6 +21,10 @@ const (
 	actions    = "actions"
 	members    = "members"
 	secret     = "secret"
+	cert       = "cert"
+	bind       = "bind"
+	rotate     = "rotate"
+	subject    = "subject"
 
 	// Usage strings for client operations.
 	usageClientCreate       = "cli clients create <JSON_client> <domain_id> <user_auth_token>"
@@ -27,11 +33,13 @@ const (
 	usageClientUpdate       = "cli clients <client_id> update <JSON_string> <domain_id> <user_auth_token>"
 	usageClientUpdateTags   = "cli clients <client_id> update tags <tags> <domain_id> <user_auth_token>"
 	usageClientUpdateSecret = "cli clients <client_id> update secret <secret> <domain_id> <user_auth_token>"
+	usageClientRotateSecret = "cli clients <client_id> update secret rotate [<secret> [<grace_period>]] <domain_id> <user_auth_token>"
 	usageClientEnable       = "cli clients <client_id> enable <domain_id> <user_auth_token>"
 	usageClientDisable      = "cli clients <client_id> disable <domain_id> <user_auth_token>"
 	usageClientConnect      = "cli clients <client_id> connect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>"
 	usageClientDisconnect   = "cli clients <client_id> disconnect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>"
 	usageClientUsers        = "cli clients <client_id> users <domain_id> <user_auth_token>"
+	usageClientCert         = "cli clients <client_id> cert <bind|rotate> <cert_file|subject <subject>> <domain_id> <user_auth_token> | cert revoke <domain_id> <user_auth_token>"
 
 	// Usage strings for client roles operations.
 	usageClientRolesCreate = "cli clients <client_id> roles create <JSON_role> <domain_id> <user_auth_token>"
@@ -59,18 +67,23 @@ func NewClientsCmd() *cobra.Command {
   clients create [args...]
   clients <client_id|all> <operation> [args...]
 
-Operations (require client_id/all): get, update, delete, enable, disable, connect, disconnect, users, roles
+Operations (require client_id/all): get, update, delete, enable, disable, connect, disconnect, users, roles, cert
 
 Examples:
   clients create <JSON_client> <domain_id> <user_auth_token>
   clients all get <domain_id> <user_auth_token>
   clients <client_id> get <domain_id> <user_auth_token>
   clients <client_id> update <JSON_string> <domain_id> <user_auth_token>
+  clients <client_id> update secret rotate <secret> <grace_period> <domain_id> <user_auth_token>
   clients <client_id> delete <domain_id> <user_auth_token>
   clients <client_id> enable <domain_id> <user_auth_token>
   clients <client_id> disable <domain_id> <user_auth_token>
   clients <client_id> connect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>
-  clients <client_id> users <domain_id> <user_auth_token>`,
+  clients <client_id> users <domain_id> <user_auth_token>
+  clients <client_id> cert bind <cert_file> <domain_id> <user_auth_token>
+  clients <client_id> cert bind subject <subject> <domain_id> <user_auth_token>
+  clients <client_id> cert rotate <cert_file> <domain_id> <user_auth_token>
+  clients <client_id> cert revoke <domain_id> <user_auth_token>`,
 
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
@@ -84,7 +97,7 @@ Examples:
 			}
 
 			if len(args) < 2 {
-				logUsageCmd(*cmd, "clients <client_id|all> <get|update|delete|enable|disable|connect|disconnect|users|roles> [args...]")
+				logUsageCmd(*cmd, "clients <client_id|all> <get|update|delete|enable|disable|connect|disconnect|users|roles|cert> [args...]")
 				return
 			}
 
@@ -111,6 +124,8 @@ Examples:
 				handleClientUsers(cmd, clientParams, opArgs)
 			case roles:
 				handleClientRoles(cmd, clientParams, opArgs)
+			case cert:
+				handleClientCert(cmd, clientParams, opArgs)
 			default:
 				logErrorCmd(*cmd, fmt.Errorf("unknown operation: %s", operation))
 			}
@@ -181,6 +196,11 @@ func handleClientGet(cmd *cobra.Command, clientParams string, args []string) {
 }
 
 func handleClientUpdate(cmd *cobra.Command, clientID string, args []string) {
+	if len(args) > 1 && args[0] == secret && args[1] == rotate {
+		handleClientRotateSecret(cmd, clientID, args[2:])
+		return
+	}
+
 	if len(args) < 3 || len(args) > 4 {
 		if args[0] == tags {
 			logUsageCmd(*cmd, usageClientUpdateTags)
@@ -696,3 +716,78 @@ func handleClientRoleMembersDelete(cmd *cobra.Command, clientID string, args []s
 	}
 	logOKCmd(*cmd)
 }
+
+func handleClientRotateSecret(cmd *cobra.Command, clientID string, args []string) {
+	if len(args) < 2 || len(args) > 4 {
+		logUsageCmd(*cmd, usageClientRotateSecret)
+		return
+	}
+
+	var newSecret string
+	var gracePeriod time.Duration
+	if len(args) > 2 {
+		newSecret = args[0]
+	}
+	if len(args) == 4 {
+		var err error
+		if gracePeriod, err = time.ParseDuration(args[1]); err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+	}
+	domainID, token := args[len(args)-2], args[len(args)-1]
+
+	client, err := sdk.RotateClientSecret(cmd.Context(), clientID, newSecret, gracePeriod, domainID, token)
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, client)
+}
+
+func handleClientCert(cmd *cobra.Command, clientID string, args []string) {
+	if len(args) == 3 && args[0] == revoke {
+		client, err := sdk.RevokeClientCert(cmd.Context(), clientID, args[1], args[2])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		logJSONCmd(*cmd, client)
+		return
+	}
+
+	if len(args) < 4 || len(args) > 5 || (args[0] != bind && args[0] != rotate) {
+		logUsageCmd(*cmd, usageClientCert)
+		return
+	}
+
+	var certPEM, certSubject string
+	switch {
+	case len(args) == 5 && args[1] == subject:
+		certSubject = args[2]
+	case len(args) == 4:
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		certPEM = string(data)
+	default:
+		logUsageCmd(*cmd, usageClientCert)
+		return
+	}
+	domainID, token := args[len(args)-2], args[len(args)-1]
+
+	updateCert := sdk.BindClientCert
+	if args[0] == rotate {
+		updateCert = sdk.RotateClientCert
+	}
+	client, err := updateCert(cmd.Context(), clientID, certPEM, certSubject, domainID, token)
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, client)
+}
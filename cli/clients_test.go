@@ -7,8 +7,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/cli"
@@ -1940,3 +1943,221 @@ func TestClientUsersCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestClientCertCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+
+	certPEM := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	certFile := filepath.Join(t.TempDir(), "client.crt")
+	err := os.WriteFile(certFile, []byte(certPEM), 0o600)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error while writing certificate file: %s", err))
+	certSubject := "CN=client"
+	boundClient := client
+	boundClient.Credentials.Certificate = smqsdk.ClientCertificate{Fingerprint: "fingerprint", Subject: certSubject}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkMethod     string
+		cert          string
+		subject       string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		client        smqsdk.Client
+		logType       outputLog
+	}{
+		{
+			desc:      "bind client certificate from file successfully",
+			args:      []string{client.ID, certCmd, bindCmd, certFile, domainID, token},
+			sdkMethod: "BindClientCert",
+			cert:      certPEM,
+			client:    boundClient,
+			logType:   entityLog,
+		},
+		{
+			desc:      "bind client certificate subject successfully",
+			args:      []string{client.ID, certCmd, bindCmd, "subject", certSubject, domainID, token},
+			sdkMethod: "BindClientCert",
+			subject:   certSubject,
+			client:    boundClient,
+			logType:   entityLog,
+		},
+		{
+			desc:      "rotate client certificate successfully",
+			args:      []string{client.ID, certCmd, rotateCmd, certFile, domainID, token},
+			sdkMethod: "RotateClientCert",
+			cert:      certPEM,
+			client:    boundClient,
+			logType:   entityLog,
+		},
+		{
+			desc:          "bind client certificate with invalid token",
+			args:          []string{client.ID, certCmd, bindCmd, certFile, domainID, invalidToken},
+			sdkMethod:     "BindClientCert",
+			cert:          certPEM,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+		{
+			desc:          "bind client certificate with missing file",
+			args:          []string{client.ID, certCmd, bindCmd, filepath.Join(t.TempDir(), "missing.crt"), domainID, token},
+			errLogMessage: "missing.crt: no such file or directory",
+			logType:       errLog,
+		},
+		{
+			desc:    "bind client certificate with invalid args",
+			args:    []string{client.ID, certCmd, bindCmd, certFile, domainID, token, extraArg},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var cl smqsdk.Client
+			sdkCall := sdkMock.On(tc.sdkMethod, mock.Anything, client.ID, tc.cert, tc.subject, domainID, tc.args[len(tc.args)-1]).Return(tc.client, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.True(t, strings.Contains(out, tc.errLogMessage), fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &cl)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.client, cl, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.client, cl))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestRevokeClientCertCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+	var cl smqsdk.Client
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		client        smqsdk.Client
+		logType       outputLog
+	}{
+		{
+			desc:    "revoke client certificate successfully",
+			args:    []string{client.ID, certCmd, revokeCmd, domainID, token},
+			client:  client,
+			logType: entityLog,
+		},
+		{
+			desc:          "revoke client certificate with invalid token",
+			args:          []string{client.ID, certCmd, revokeCmd, domainID, invalidToken},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("RevokeClientCert", mock.Anything, tc.args[0], tc.args[3], tc.args[4]).Return(tc.client, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &cl)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.client, cl, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.client, cl))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestRotateClientSecretCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+	var cl smqsdk.Client
+
+	secretUpdateType := "secret"
+	newSecret := "newSecret"
+
+	cases := []struct {
+		desc          string
+		args          []string
+		secret        string
+		gracePeriod   time.Duration
+		sdkErr        errors.SDKError
+		errLogMessage string
+		client        smqsdk.Client
+		logType       outputLog
+	}{
+		{
+			desc:        "rotate client secret successfully",
+			args:        []string{client.ID, updateCmd, secretUpdateType, rotateCmd, newSecret, "1h", domainID, token},
+			secret:      newSecret,
+			gracePeriod: time.Hour,
+			client:      client,
+			logType:     entityLog,
+		},
+		{
+			desc:    "rotate client secret with generated secret and default grace period",
+			args:    []string{client.ID, updateCmd, secretUpdateType, rotateCmd, domainID, token},
+			client:  client,
+			logType: entityLog,
+		},
+		{
+			desc:          "rotate client secret with invalid grace period",
+			args:          []string{client.ID, updateCmd, secretUpdateType, rotateCmd, newSecret, "invalid", domainID, token},
+			errLogMessage: "\nerror: time: invalid duration \"invalid\"\n\n",
+			logType:       errLog,
+		},
+		{
+			desc:          "rotate client secret with invalid token",
+			args:          []string{client.ID, updateCmd, secretUpdateType, rotateCmd, newSecret, domainID, invalidToken},
+			secret:        newSecret,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+		{
+			desc:    "rotate client secret with invalid args",
+			args:    []string{client.ID, updateCmd, secretUpdateType, rotateCmd, domainID},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("RotateClientSecret", mock.Anything, tc.args[0], tc.secret, tc.gracePeriod, domainID, mock.Anything).Return(tc.client, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &cl)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.client, cl, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.client, cl))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli clients <client_id> update secret rotate"), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
@@ -5,13 +5,19 @@ package cli_test
 
 // CRUD and common commands
 const (
-	createCmd  = "create"
-	updateCmd  = "update"
-	getCmd     = "get"
-	enableCmd  = "enable"
-	disableCmd = "disable"
-	freezeCmd  = "freeze"
-	delCmd     = "delete"
+	createCmd   = "create"
+	updateCmd   = "update"
+	getCmd      = "get"
+	enableCmd   = "enable"
+	disableCmd  = "disable"
+	freezeCmd   = "freeze"
+	delCmd      = "delete"
+	restoreCmd  = "restore"
+	exportCmd   = "export"
+	importCmd   = "import"
+	usageCmd    = "usage"
+	quotasCmd   = "quotas"
+	transferCmd = "transfer"
 )
 
 // Users commands
@@ -22,6 +28,8 @@ const (
 	resPassReqCmd = "resetpasswordrequest"
 	resPassCmd    = "resetpassword"
 	passCmd       = "password"
+	sessionsCmd   = "sessions"
+	revokeCmd     = "revoke"
 )
 
 // Clients commands
@@ -29,6 +37,9 @@ const (
 	connCmd    = "connect"
 	disconnCmd = "disconnect"
 	usersCmd   = "users"
+	certCmd    = "cert"
+	bindCmd    = "bind"
+	rotateCmd  = "rotate"
 )
 
 // Messages commands
@@ -26,6 +26,7 @@ const (
 	defGroupsURL       string = defURL + ":9004"
 	defHTTPURL         string = defURL + ":8008"
 	defJournalURL      string = defURL + ":9021"
+	defRulesURL        string = defURL + ":9008"
 	defTLSVerification bool   = false
 	defOffset          string = "0"
 	defLimit           string = "10"
@@ -42,6 +43,7 @@ type remotes struct {
 	HTTPAdapterURL  string `toml:"http_adapter_url"`
 	CertsURL        string `toml:"certs_url"`
 	JournalURL      string `toml:"journal_url"`
+	RulesURL        string `toml:"rules_url"`
 	HostURL         string `toml:"host_url"`
 	TLSVerification bool   `toml:"tls_verification"`
 }
@@ -111,6 +113,7 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 				GroupsURL:       defGroupsURL,
 				HTTPAdapterURL:  defHTTPURL,
 				JournalURL:      defJournalURL,
+				RulesURL:        defRulesURL,
 				HostURL:         defURL,
 				TLSVerification: defTLSVerification,
 			},
@@ -198,6 +201,10 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 		sdkConf.JournalURL = config.Remotes.JournalURL
 	}
 
+	if sdkConf.RulesURL == "" && config.Remotes.RulesURL != "" {
+		sdkConf.RulesURL = config.Remotes.RulesURL
+	}
+
 	if sdkConf.HostURL == "" && config.Remotes.HostURL != "" {
 		sdkConf.HostURL = config.Remotes.HostURL
 	}
@@ -6,22 +6,43 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
 	smqsdk "github.com/absmach/supermq/pkg/sdk"
 	"github.com/spf13/cobra"
 )
 
 const (
-	freeze = "freeze"
+	freeze       = "freeze"
+	restore      = "restore"
+	export       = "export"
+	importDomain = "import"
+	usage        = "usage"
+	quotas       = "quotas"
+	transfer     = "transfer"
+	accept       = "accept"
+	reject       = "reject"
+	withSecrets  = "with-secrets"
+	preserveIDs  = "preserve-ids"
+
+	// The archive may contain client secrets, so it's readable only by the owner.
+	archivePermission = 0o600
 
 	// Usage strings for domain operations.
-	usageDomainCreate  = "cli domains create <domain_name> <route> <user_auth_token>"
-	usageDomainGet     = "cli domains <domain_id|all> get <user_auth_token>"
-	usageDomainUpdate  = "cli domains <domain_id> update <JSON_string> <user_auth_token>"
-	usageDomainEnable  = "cli domains <domain_id> enable <user_auth_token>"
-	usageDomainDisable = "cli domains <domain_id> disable <user_auth_token>"
-	usageDomainFreeze  = "cli domains <domain_id> freeze <user_auth_token>"
-	usageDomainUsers   = "cli domains <domain_id> users <user_auth_token>"
+	usageDomainCreate   = "cli domains create <domain_name> <route> <user_auth_token>"
+	usageDomainGet      = "cli domains <domain_id|all> get <user_auth_token>"
+	usageDomainUpdate   = "cli domains <domain_id> update <JSON_string> <user_auth_token>"
+	usageDomainEnable   = "cli domains <domain_id> enable <user_auth_token>"
+	usageDomainDisable  = "cli domains <domain_id> disable <user_auth_token>"
+	usageDomainFreeze   = "cli domains <domain_id> freeze <user_auth_token>"
+	usageDomainDelete   = "cli domains <domain_id> delete <user_auth_token>"
+	usageDomainRestore  = "cli domains <domain_id> restore <user_auth_token>"
+	usageDomainExport   = "cli domains <domain_id> export <file> [with-secrets] <user_auth_token>"
+	usageDomainImport   = "cli domains import <file> [preserve-ids] <user_auth_token>"
+	usageDomainUsers    = "cli domains <domain_id> users <user_auth_token>"
+	usageDomainUsage    = "cli domains <domain_id> usage <user_auth_token>"
+	usageDomainQuotas   = "cli domains <domain_id> quotas <JSON_quotas> <user_auth_token>"
+	usageDomainTransfer = "cli domains <domain_id> transfer <user_id|get|accept|reject> <user_auth_token>"
 
 	// Usage strings for domain roles operations.
 	usageDomainRolesCreate = "cli domains <domain_id> roles create <JSON_role> <user_auth_token>"
@@ -47,9 +68,10 @@ func NewDomainsCmd() *cobra.Command {
 		Short: "Domains management",
 		Long: `Format: 
   domains create [args...]
+  domains import [args...]
   domains <domain_id|all> <operation> [args...]
 
-Operations (require domain_id/all): get, update, enable, disable, freeze, users, roles
+Operations (require domain_id/all): get, update, enable, disable, freeze, delete, restore, export, users, usage, quotas, transfer, roles
 
 Examples:
   domains create <domain_name> <route> <user_auth_token>
@@ -59,7 +81,17 @@ Examples:
   domains <domain_id> enable <user_auth_token>
   domains <domain_id> disable <user_auth_token>
   domains <domain_id> freeze <user_auth_token>
-  domains <domain_id> users <user_auth_token>`,
+  domains <domain_id> delete <user_auth_token>
+  domains <domain_id> restore <user_auth_token>
+  domains <domain_id> export <file> [with-secrets] <user_auth_token>
+  domains import <file> [preserve-ids] <user_auth_token>
+  domains <domain_id> users <user_auth_token>
+  domains <domain_id> usage <user_auth_token>
+  domains <domain_id> quotas <JSON_quotas> <user_auth_token>
+  domains <domain_id> transfer <user_id> <user_auth_token>
+  domains <domain_id> transfer get <user_auth_token>
+  domains <domain_id> transfer accept <user_auth_token>
+  domains <domain_id> transfer reject <user_auth_token>`,
 
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
@@ -72,8 +104,13 @@ Examples:
 				return
 			}
 
+			if args[0] == importDomain {
+				handleDomainImport(cmd, args[1:])
+				return
+			}
+
 			if len(args) < 2 {
-				logUsageCmd(*cmd, "domains <domain_id|all> <get|update|enable|disable|freeze|users|roles> [args...]")
+				logUsageCmd(*cmd, "domains <domain_id|all> <get|update|enable|disable|freeze|delete|restore|export|users|usage|quotas|transfer|roles> [args...]")
 				return
 			}
 
@@ -92,8 +129,20 @@ Examples:
 				handleDomainDisable(cmd, domainParams, opArgs)
 			case freeze:
 				handleDomainFreeze(cmd, domainParams, opArgs)
+			case delete:
+				handleDomainDelete(cmd, domainParams, opArgs)
+			case restore:
+				handleDomainRestore(cmd, domainParams, opArgs)
+			case export:
+				handleDomainExport(cmd, domainParams, opArgs)
 			case users:
 				handleDomainUsers(cmd, domainParams, opArgs)
+			case usage:
+				handleDomainUsage(cmd, domainParams, opArgs)
+			case quotas:
+				handleDomainQuotas(cmd, domainParams, opArgs)
+			case transfer:
+				handleDomainTransfer(cmd, domainParams, opArgs)
 			case roles:
 				handleDomainRoles(cmd, domainParams, opArgs)
 			default:
@@ -221,6 +270,150 @@ func handleDomainFreeze(cmd *cobra.Command, domainID string, args []string) {
 	logOKCmd(*cmd)
 }
 
+func handleDomainDelete(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) != 1 {
+		logUsageCmd(*cmd, usageDomainDelete)
+		return
+	}
+
+	if err := sdk.DeleteDomain(cmd.Context(), domainID, args[0]); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logOKCmd(*cmd)
+}
+
+func handleDomainRestore(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) != 1 {
+		logUsageCmd(*cmd, usageDomainRestore)
+		return
+	}
+
+	if err := sdk.RestoreDomain(cmd.Context(), domainID, args[0]); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logOKCmd(*cmd)
+}
+
+func handleDomainExport(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) < 2 || len(args) > 3 || (len(args) == 3 && args[1] != withSecrets) {
+		logUsageCmd(*cmd, usageDomainExport)
+		return
+	}
+
+	archive, sdkErr := sdk.ExportDomain(cmd.Context(), domainID, len(args) == 3, args[len(args)-1])
+	if sdkErr != nil {
+		logErrorCmd(*cmd, sdkErr)
+		return
+	}
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	if err := os.WriteFile(args[0], data, archivePermission); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logOKCmd(*cmd)
+}
+
+func handleDomainImport(cmd *cobra.Command, args []string) {
+	if len(args) < 2 || len(args) > 3 || (len(args) == 3 && args[1] != preserveIDs) {
+		logUsageCmd(*cmd, usageDomainImport)
+		return
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	var archive smqsdk.DomainArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	d, err := sdk.ImportDomain(cmd.Context(), archive, len(args) == 3, args[len(args)-1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logJSONCmd(*cmd, d)
+}
+
+func handleDomainUsage(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) != 1 {
+		logUsageCmd(*cmd, usageDomainUsage)
+		return
+	}
+
+	u, err := sdk.DomainUsage(cmd.Context(), domainID, args[0])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logJSONCmd(*cmd, u)
+}
+
+func handleDomainQuotas(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageDomainQuotas)
+		return
+	}
+
+	var q smqsdk.DomainQuotas
+	if err := json.Unmarshal([]byte(args[0]), &q); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	q, err := sdk.UpdateDomainQuotas(cmd.Context(), domainID, q, args[1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logJSONCmd(*cmd, q)
+}
+
+func handleDomainTransfer(cmd *cobra.Command, domainID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageDomainTransfer)
+		return
+	}
+
+	switch args[0] {
+	case get:
+		t, err := sdk.DomainTransfer(cmd.Context(), domainID, args[1])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		logJSONCmd(*cmd, t)
+	case accept:
+		t, err := sdk.AcceptDomainTransfer(cmd.Context(), domainID, args[1])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		logJSONCmd(*cmd, t)
+	case reject:
+		if err := sdk.RejectDomainTransfer(cmd.Context(), domainID, args[1]); err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		logOKCmd(*cmd)
+	default:
+		t, err := sdk.TransferDomainOwnership(cmd.Context(), domainID, args[0], args[1])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+		logJSONCmd(*cmd, t)
+	}
+}
+
 func handleDomainUsers(cmd *cobra.Command, domainID string, args []string) {
 	if len(args) != 1 {
 		logUsageCmd(*cmd, usageDomainUsers)
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -517,6 +519,646 @@ func TestFreezeDomainCmd(t *testing.T) {
 	}
 }
 
+func TestDeleteDomainCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "delete domain successfully",
+			args: []string{
+				domain.ID,
+				delCmd,
+				validToken,
+			},
+			logType: okLog,
+		},
+		{
+			desc: "delete domain with invalid token",
+			args: []string{
+				domain.ID,
+				delCmd,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+		{
+			desc: "delete domain with invalid id",
+			args: []string{
+				invalidID,
+				delCmd,
+				token,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+		{
+			desc: "delete domain with invalid args",
+			args: []string{
+				domain.ID,
+				delCmd,
+				validToken,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("DeleteDomain", mock.Anything, tc.args[0], tc.args[2]).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestRestoreDomainCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "restore domain successfully",
+			args: []string{
+				domain.ID,
+				restoreCmd,
+				validToken,
+			},
+			logType: okLog,
+		},
+		{
+			desc: "restore domain with invalid token",
+			args: []string{
+				domain.ID,
+				restoreCmd,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+		{
+			desc: "restore domain with invalid id",
+			args: []string{
+				invalidID,
+				restoreCmd,
+				token,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+		{
+			desc: "restore domain with invalid args",
+			args: []string{
+				domain.ID,
+				restoreCmd,
+				validToken,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("RestoreDomain", mock.Anything, tc.args[0], tc.args[2]).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestExportDomainCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	archive := smqsdk.DomainArchive{
+		Version: 1,
+		Domain:  domain,
+	}
+	archiveFile := filepath.Join(t.TempDir(), "archive.json")
+
+	cases := []struct {
+		desc          string
+		args          []string
+		withSecrets   bool
+		sdkRes        smqsdk.DomainArchive
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "export domain successfully",
+			args: []string{
+				domain.ID,
+				exportCmd,
+				archiveFile,
+				validToken,
+			},
+			sdkRes:  archive,
+			logType: okLog,
+		},
+		{
+			desc: "export domain with secrets successfully",
+			args: []string{
+				domain.ID,
+				exportCmd,
+				archiveFile,
+				"with-secrets",
+				validToken,
+			},
+			withSecrets: true,
+			sdkRes:      archive,
+			logType:     okLog,
+		},
+		{
+			desc: "export domain with invalid token",
+			args: []string{
+				domain.ID,
+				exportCmd,
+				archiveFile,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+		{
+			desc: "export domain with invalid option",
+			args: []string{
+				domain.ID,
+				exportCmd,
+				archiveFile,
+				extraArg,
+				validToken,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "export domain with invalid args",
+			args: []string{
+				domain.ID,
+				exportCmd,
+				validToken,
+			},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("ExportDomain", mock.Anything, tc.args[0], tc.withSecrets, tc.args[len(tc.args)-1]).Return(tc.sdkRes, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+				data, err := os.ReadFile(archiveFile)
+				assert.Nil(t, err, fmt.Sprintf("%s unexpected error reading archive: %s", tc.desc, err))
+				var a smqsdk.DomainArchive
+				err = json.Unmarshal(data, &a)
+				assert.Nil(t, err, fmt.Sprintf("%s unexpected error decoding archive: %s", tc.desc, err))
+				assert.Equal(t, tc.sdkRes.Domain.ID, a.Domain.ID, fmt.Sprintf("%s unexpected archive: expected %v got %v", tc.desc, tc.sdkRes, a))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestImportDomainCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	archive := smqsdk.DomainArchive{
+		Version: 1,
+		Domain:  domain,
+	}
+	data, err := json.Marshal(archive)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error encoding archive: %s", err))
+	archiveFile := filepath.Join(t.TempDir(), "archive.json")
+	err = os.WriteFile(archiveFile, data, 0o600)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error writing archive: %s", err))
+	invalidFile := filepath.Join(t.TempDir(), "invalid.json")
+	err = os.WriteFile(invalidFile, []byte(invalidID), 0o600)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error writing archive: %s", err))
+
+	var dom smqsdk.Domain
+	cases := []struct {
+		desc          string
+		args          []string
+		preserveIDs   bool
+		sdkRes        smqsdk.Domain
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "import domain successfully",
+			args: []string{
+				importCmd,
+				archiveFile,
+				validToken,
+			},
+			sdkRes:  domain,
+			logType: entityLog,
+		},
+		{
+			desc: "import domain with preserved ids successfully",
+			args: []string{
+				importCmd,
+				archiveFile,
+				"preserve-ids",
+				validToken,
+			},
+			preserveIDs: true,
+			sdkRes:      domain,
+			logType:     entityLog,
+		},
+		{
+			desc: "import domain with invalid token",
+			args: []string{
+				importCmd,
+				archiveFile,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+		{
+			desc: "import domain with missing file",
+			args: []string{
+				importCmd,
+				filepath.Join(t.TempDir(), "missing.json"),
+				validToken,
+			},
+			logType: errLog,
+		},
+		{
+			desc: "import domain with invalid archive",
+			args: []string{
+				importCmd,
+				invalidFile,
+				validToken,
+			},
+			logType: errLog,
+		},
+		{
+			desc: "import domain with invalid args",
+			args: []string{
+				importCmd,
+				archiveFile,
+				extraArg,
+				validToken,
+			},
+			logType: usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("ImportDomain", mock.Anything, archive, tc.preserveIDs, tc.args[len(tc.args)-1]).Return(tc.sdkRes, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &dom)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.sdkRes, dom, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.sdkRes, dom))
+			case errLog:
+				if tc.errLogMessage != "" {
+					assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+				}
+				assert.True(t, strings.Contains(out, "error"), fmt.Sprintf("%s unexpected response: expected error message, got: %v", tc.desc, out))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli domains import"), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestDomainUsageCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	usage := smqsdk.DomainQuotasUsage{
+		DomainID: domain.ID,
+		Quotas:   smqsdk.DomainQuotas{Clients: 10, Channels: 20},
+		Usage:    smqsdk.DomainUsage{Clients: 1, Channels: 2},
+	}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		usage         smqsdk.DomainQuotasUsage
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "retrieve domain usage successfully",
+			args: []string{
+				domain.ID,
+				usageCmd,
+				token,
+			},
+			usage:   usage,
+			logType: entityLog,
+		},
+		{
+			desc: "retrieve domain usage with invalid args",
+			args: []string{
+				domain.ID,
+				usageCmd,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "retrieve domain usage with invalid token",
+			args: []string{
+				domain.ID,
+				usageCmd,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var u smqsdk.DomainQuotasUsage
+			sdkCall := sdkMock.On("DomainUsage", mock.Anything, tc.args[0], tc.args[2]).Return(tc.usage, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &u)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.usage, u, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.usage, u))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestDomainQuotasCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	quotasJSON := "{\"clients\": 10, \"message_rate\": 5}"
+	quotas := smqsdk.DomainQuotas{Clients: 10, MessageRate: 5}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		quotas        smqsdk.DomainQuotas
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "update domain quotas successfully",
+			args: []string{
+				domain.ID,
+				quotasCmd,
+				quotasJSON,
+				token,
+			},
+			quotas:  quotas,
+			logType: entityLog,
+		},
+		{
+			desc: "update domain quotas with invalid args",
+			args: []string{
+				domain.ID,
+				quotasCmd,
+				quotasJSON,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "update domain quotas with invalid json syntax",
+			args: []string{
+				domain.ID,
+				quotasCmd,
+				"{\"clients\": 10",
+				token,
+			},
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.New("unexpected end of JSON input")),
+			logType:       errLog,
+		},
+		{
+			desc: "update domain quotas with non super admin user",
+			args: []string{
+				domain.ID,
+				quotasCmd,
+				quotasJSON,
+				token,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrSuperAdminAction, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrSuperAdminAction, http.StatusForbidden)),
+			logType:       errLog,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var q smqsdk.DomainQuotas
+			sdkCall := sdkMock.On("UpdateDomainQuotas", mock.Anything, tc.args[0], quotas, tc.args[3]).Return(tc.quotas, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &q)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.quotas, q, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.quotas, q))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestDomainTransferCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	domainsCmd := cli.NewDomainsCmd()
+	rootCmd := setFlags(domainsCmd)
+
+	memberID := testsutil.GenerateUUID(t)
+	transfer := smqsdk.DomainTransfer{DomainID: domain.ID, FromUserID: user.ID, ToUserID: memberID}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		transfer      smqsdk.DomainTransfer
+		sdkErr        errors.SDKError
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "transfer domain ownership successfully",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				memberID,
+				token,
+			},
+			transfer: transfer,
+			logType:  entityLog,
+		},
+		{
+			desc: "get domain transfer successfully",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				getCmd,
+				token,
+			},
+			transfer: transfer,
+			logType:  entityLog,
+		},
+		{
+			desc: "accept domain transfer successfully",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				acceptCmd,
+				token,
+			},
+			transfer: transfer,
+			logType:  entityLog,
+		},
+		{
+			desc: "reject domain transfer successfully",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				rejectCmd,
+				token,
+			},
+			logType: okLog,
+		},
+		{
+			desc: "transfer domain ownership with invalid args",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				memberID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "transfer domain ownership with unauthorized user",
+			args: []string{
+				domain.ID,
+				transferCmd,
+				memberID,
+				token,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var tr smqsdk.DomainTransfer
+			sdkCall := sdkMock.On("TransferDomainOwnership", mock.Anything, tc.args[0], memberID, tc.args[len(tc.args)-1]).Return(tc.transfer, tc.sdkErr)
+			sdkCall1 := sdkMock.On("DomainTransfer", mock.Anything, tc.args[0], tc.args[len(tc.args)-1]).Return(tc.transfer, tc.sdkErr)
+			sdkCall2 := sdkMock.On("AcceptDomainTransfer", mock.Anything, tc.args[0], tc.args[len(tc.args)-1]).Return(tc.transfer, tc.sdkErr)
+			sdkCall3 := sdkMock.On("RejectDomainTransfer", mock.Anything, tc.args[0], tc.args[len(tc.args)-1]).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &tr)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.transfer, tr, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.transfer, tr))
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			}
+			sdkCall.Unset()
+			sdkCall1.Unset()
+			sdkCall2.Unset()
+			sdkCall3.Unset()
+		})
+	}
+}
+
 func TestCreateDomainRoleCmd(t *testing.T) {
 	sdkMock := new(sdkmocks.SDK)
 	cli.SetSDK(sdkMock)
@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"strings"
+
 	smqsdk "github.com/absmach/supermq/pkg/sdk"
 	"github.com/spf13/cobra"
 )
@@ -79,20 +81,26 @@ var cmdUserInvitations = []cobra.Command{
 
 var cmdDomainInvitations = []cobra.Command{
 	{
-		Use:   "send <user_id> <domain_id> <role_id> <user_auth_token>",
+		Use:   "send <user_id|email> <domain_id> <role_id> <user_auth_token>",
 		Short: "Send domain invitation",
 		Long: "Send invitation to user for a domain\n" +
+			"Unregistered users are invited by their email\n" +
 			"For example:\n" +
-			"\tsupermq-cli invitations domain send 39f97daf-d6b6-40f4-b229-2697be8006ef 4ef09eff-d500-4d56-b04f-d23a512d6f2a ba4c904c-e6d4-4978-9417-1694aac6793e $USER_AUTH_TOKEN\n",
+			"\tsupermq-cli invitations domain send 39f97daf-d6b6-40f4-b229-2697be8006ef 4ef09eff-d500-4d56-b04f-d23a512d6f2a ba4c904c-e6d4-4978-9417-1694aac6793e $USER_AUTH_TOKEN\n" +
+			"\tsupermq-cli invitations domain send user@example.com 4ef09eff-d500-4d56-b04f-d23a512d6f2a ba4c904c-e6d4-4978-9417-1694aac6793e $USER_AUTH_TOKEN\n",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) != 4 {
 				logUsageCmd(*cmd, cmd.Use)
 				return
 			}
 			inv := smqsdk.Invitation{
-				InviteeUserID: args[0],
-				DomainID:      args[1],
-				RoleID:        args[2],
+				DomainID: args[1],
+				RoleID:   args[2],
+			}
+			if strings.Contains(args[0], "@") {
+				inv.InviteeEmail = args[0]
+			} else {
+				inv.InviteeUserID = args[0]
 			}
 			if err := sdk.SendInvitation(cmd.Context(), inv, args[3]); err != nil {
 				logErrorCmd(*cmd, err)
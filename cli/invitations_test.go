@@ -49,6 +49,16 @@ func TestSendDomainInvitationCmd(t *testing.T) {
 			},
 			logType: okLog,
 		},
+		{
+			desc: "send domain invitation to email successfully",
+			args: []string{
+				"invitee@example.com",
+				domain.ID,
+				relation,
+				validToken,
+			},
+			logType: okLog,
+		},
 		{
 			desc: "send domain invitation with invalid args",
 			args: []string{
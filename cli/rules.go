@@ -0,0 +1,199 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	smqsdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usageRuleCreate  = "cli rules create <JSON_rule> <domain_id> <user_auth_token>"
+	usageRuleGet     = "cli rules <rule_id|all> get <domain_id> <user_auth_token>"
+	usageRuleUpdate  = "cli rules <rule_id> update <JSON_rule> <domain_id> <user_auth_token>"
+	usageRuleDelete  = "cli rules <rule_id> delete <domain_id> <user_auth_token>"
+	usageRuleEnable  = "cli rules <rule_id> enable <domain_id> <user_auth_token>"
+	usageRuleDisable = "cli rules <rule_id> disable <domain_id> <user_auth_token>"
+)
+
+func NewRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rules <rule_id|all|create> [operation] [args...]",
+		Short: "Rules engine management",
+		Long: `Format: 
+  rules create [args...]
+  rules <rule_id|all> <operation> [args...]
+
+Operations (require rule_id/all): get, update, delete, enable, disable
+
+Examples:
+  rules create <JSON_rule> <domain_id> <user_auth_token>
+  rules all get <domain_id> <user_auth_token>
+  rules <rule_id> get <domain_id> <user_auth_token>
+  rules <rule_id> update <JSON_rule> <domain_id> <user_auth_token>
+  rules <rule_id> delete <domain_id> <user_auth_token>
+  rules <rule_id> enable <domain_id> <user_auth_token>
+  rules <rule_id> disable <domain_id> <user_auth_token>`,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+
+			if args[0] == create {
+				handleRuleCreate(cmd, args[1:])
+				return
+			}
+
+			if len(args) < 2 {
+				logUsageCmd(*cmd, "rules <rule_id|all> <get|update|delete|enable|disable> [args...]")
+				return
+			}
+
+			ruleParams := args[0]
+			operation := args[1]
+			opArgs := args[2:]
+
+			switch operation {
+			case get:
+				handleRuleGet(cmd, ruleParams, opArgs)
+			case update:
+				handleRuleUpdate(cmd, ruleParams, opArgs)
+			case delete:
+				handleRuleDelete(cmd, ruleParams, opArgs)
+			case enable:
+				handleRuleEnable(cmd, ruleParams, opArgs)
+			case disable:
+				handleRuleDisable(cmd, ruleParams, opArgs)
+			default:
+				logErrorCmd(*cmd, fmt.Errorf("unknown operation: %s", operation))
+			}
+		},
+	}
+
+	return cmd
+}
+
+func handleRuleCreate(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		logUsageCmd(*cmd, usageRuleCreate)
+		return
+	}
+
+	var rule smqsdk.Rule
+	if err := json.Unmarshal([]byte(args[0]), &rule); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	rule, err := sdk.CreateRule(cmd.Context(), rule, args[1], args[2])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, rule)
+}
+
+func handleRuleGet(cmd *cobra.Command, ruleID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageRuleGet)
+		return
+	}
+
+	if ruleID == all {
+		pageMetadata := smqsdk.PageMetadata{
+			Name:   Name,
+			Offset: Offset,
+			Limit:  Limit,
+			Status: Status,
+		}
+
+		l, err := sdk.Rules(cmd.Context(), pageMetadata, args[0], args[1])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+
+		logJSONCmd(*cmd, l)
+		return
+	}
+
+	r, err := sdk.Rule(cmd.Context(), ruleID, args[0], args[1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, r)
+}
+
+func handleRuleUpdate(cmd *cobra.Command, ruleID string, args []string) {
+	if len(args) != 3 {
+		logUsageCmd(*cmd, usageRuleUpdate)
+		return
+	}
+
+	var rule smqsdk.Rule
+	if err := json.Unmarshal([]byte(args[0]), &rule); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	rule.ID = ruleID
+	rule, err := sdk.UpdateRule(cmd.Context(), rule, args[1], args[2])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, rule)
+}
+
+func handleRuleDelete(cmd *cobra.Command, ruleID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageRuleDelete)
+		return
+	}
+
+	if err := sdk.DeleteRule(cmd.Context(), ruleID, args[0], args[1]); err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+	logOKCmd(*cmd)
+}
+
+func handleRuleEnable(cmd *cobra.Command, ruleID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageRuleEnable)
+		return
+	}
+
+	rule, err := sdk.EnableRule(cmd.Context(), ruleID, args[0], args[1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, rule)
+}
+
+func handleRuleDisable(cmd *cobra.Command, ruleID string, args []string) {
+	if len(args) != 2 {
+		logUsageCmd(*cmd, usageRuleDisable)
+		return
+	}
+
+	rule, err := sdk.DisableRule(cmd.Context(), ruleID, args[0], args[1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, rule)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/absmach/supermq/cli"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	mgsdk "github.com/absmach/supermq/pkg/sdk"
+	sdkmocks "github.com/absmach/supermq/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var rule = mgsdk.Rule{
+	ID:           testsutil.GenerateUUID(&testing.T{}),
+	Name:         "testrule",
+	InputChannel: testsutil.GenerateUUID(&testing.T{}),
+	Actions:      []mgsdk.Action{{Type: "drop"}},
+	Status:       "enabled",
+}
+
+func TestCreateRuleCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	ruleJSON := fmt.Sprintf("{\"name\":\"testrule\", \"input_channel\":\"%s\", \"actions\":[{\"type\":\"drop\"}]}", rule.InputChannel)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	var r mgsdk.Rule
+	cases := []struct {
+		desc          string
+		args          []string
+		logType       outputLog
+		rule          mgsdk.Rule
+		sdkErr        errors.SDKError
+		errLogMessage string
+	}{
+		{
+			desc:    "create rule successfully",
+			args:    []string{createCmd, ruleJSON, domainID, token},
+			rule:    rule,
+			logType: entityLog,
+		},
+		{
+			desc:    "create rule with invalid args",
+			args:    []string{createCmd, ruleJSON, domainID, token, extraArg},
+			logType: usageLog,
+		},
+		{
+			desc:          "create rule with invalid json",
+			args:          []string{createCmd, "{\"name\":\"testrule\"", domainID, token},
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.New("unexpected end of JSON input")),
+			logType:       errLog,
+		},
+		{
+			desc:          "create rule with invalid token",
+			args:          []string{createCmd, ruleJSON, domainID, invalidToken},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sdkCall *mock.Call
+			if len(tc.args) >= 4 {
+				sdkCall = sdkMock.On("CreateRule", mock.Anything, mock.Anything, tc.args[2], tc.args[3]).Return(tc.rule, tc.sdkErr)
+			}
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &r)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.rule, r, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.rule, r))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli rules create"), fmt.Sprintf("%s invalid usage: expected to contain create usage, got: %s", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			}
+			if sdkCall != nil {
+				sdkCall.Unset()
+			}
+		})
+	}
+}
+
+func TestGetRulesCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	var r mgsdk.Rule
+	var page mgsdk.RulesPage
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		page          mgsdk.RulesPage
+		rule          mgsdk.Rule
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "get all rules successfully",
+			args: []string{all, getCmd, domainID, token},
+			page: mgsdk.RulesPage{
+				PageRes: mgsdk.PageRes{Total: 1, Offset: 0, Limit: 10},
+				Rules:   []mgsdk.Rule{rule},
+			},
+			logType: entityLog,
+		},
+		{
+			desc:    "get rule successfully",
+			args:    []string{rule.ID, getCmd, domainID, token},
+			rule:    rule,
+			logType: entityLog,
+		},
+		{
+			desc:    "get rules with invalid args",
+			args:    []string{all, getCmd, domainID, token, extraArg},
+			logType: usageLog,
+		},
+		{
+			desc:          "get rule with invalid id",
+			args:          []string{invalidID, getCmd, domainID, token},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("Rule", mock.Anything, tc.args[0], tc.args[2], tc.args[3]).Return(tc.rule, tc.sdkErr)
+			sdkCall1 := sdkMock.On("Rules", mock.Anything, mock.Anything, tc.args[2], tc.args[3]).Return(tc.page, tc.sdkErr)
+
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				if tc.args[0] == all {
+					err := json.Unmarshal([]byte(out), &page)
+					assert.Nil(t, err)
+					assert.Equal(t, tc.page, page, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.page, page))
+				} else {
+					err := json.Unmarshal([]byte(out), &r)
+					assert.Nil(t, err)
+					assert.Equal(t, tc.rule, r, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.rule, r))
+				}
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+			sdkCall1.Unset()
+		})
+	}
+}
+
+func TestUpdateRuleCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	ruleJSON := fmt.Sprintf("{\"name\":\"updated\", \"input_channel\":\"%s\", \"actions\":[{\"type\":\"drop\"}]}", rule.InputChannel)
+	updated := rule
+	updated.Name = "updated"
+
+	var r mgsdk.Rule
+	cases := []struct {
+		desc          string
+		args          []string
+		rule          mgsdk.Rule
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc:    "update rule successfully",
+			args:    []string{rule.ID, updateCmd, ruleJSON, domainID, token},
+			rule:    updated,
+			logType: entityLog,
+		},
+		{
+			desc:    "update rule with invalid args",
+			args:    []string{rule.ID, updateCmd, ruleJSON, domainID, token, extraArg},
+			logType: usageLog,
+		},
+		{
+			desc:          "update rule with invalid json",
+			args:          []string{rule.ID, updateCmd, "{\"name\":\"updated\"", domainID, token},
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.New("unexpected end of JSON input")),
+			logType:       errLog,
+		},
+		{
+			desc:          "update rule with invalid token",
+			args:          []string{rule.ID, updateCmd, ruleJSON, domainID, invalidToken},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sdkCall *mock.Call
+			if len(tc.args) >= 5 {
+				sdkCall = sdkMock.On("UpdateRule", mock.Anything, mock.Anything, tc.args[3], tc.args[4]).Return(tc.rule, tc.sdkErr)
+			}
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &r)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.rule, r, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.rule, r))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli rules <rule_id> update"), fmt.Sprintf("%s invalid usage: expected to contain update usage, got: %s", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			}
+			if sdkCall != nil {
+				sdkCall.Unset()
+			}
+		})
+	}
+}
+
+func TestDeleteRuleCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc:    "delete rule successfully",
+			args:    []string{rule.ID, delCmd, domainID, token},
+			logType: okLog,
+		},
+		{
+			desc:    "delete rule with invalid args",
+			args:    []string{rule.ID, delCmd, domainID, token, extraArg},
+			logType: usageLog,
+		},
+		{
+			desc:          "delete rule with invalid id",
+			args:          []string{invalidID, delCmd, domainID, token},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("DeleteRule", mock.Anything, tc.args[0], tc.args[2], tc.args[3]).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestChangeRuleStatusCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	disabled := rule
+	disabled.Status = "disabled"
+
+	var r mgsdk.Rule
+	cases := []struct {
+		desc          string
+		args          []string
+		method        string
+		rule          mgsdk.Rule
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc:    "enable rule successfully",
+			args:    []string{rule.ID, enableCmd, domainID, token},
+			method:  "EnableRule",
+			rule:    rule,
+			logType: entityLog,
+		},
+		{
+			desc:    "disable rule successfully",
+			args:    []string{rule.ID, disableCmd, domainID, token},
+			method:  "DisableRule",
+			rule:    disabled,
+			logType: entityLog,
+		},
+		{
+			desc:    "enable rule with invalid args",
+			args:    []string{rule.ID, enableCmd, domainID, token, extraArg},
+			method:  "EnableRule",
+			logType: usageLog,
+		},
+		{
+			desc:          "disable rule with invalid token",
+			args:          []string{rule.ID, disableCmd, domainID, invalidToken},
+			method:        "DisableRule",
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On(tc.method, mock.Anything, tc.args[0], tc.args[2], tc.args[3]).Return(tc.rule, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &r)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.rule, r, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.rule, r))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
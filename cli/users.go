@@ -25,6 +25,8 @@ const (
 	username             = "username"
 	email                = "email"
 	role                 = "role"
+	sessions             = "sessions"
+	revoke               = "revoke"
 
 	// Usage strings for user operations.
 	usageUserCreate         = "cli users create <first_name> <last_name> <email> <username> <password> [user_auth_token]"
@@ -53,14 +55,20 @@ Available update options:
 	usageUserSearch           = "cli users search <query> <user_auth_token>\nQuery format: username=<value>|firstname=<value>|lastname=<value>|id=<value>[&offset=<value>][&limit=<value>]\nExample: cli users search \"username=john_doe\" <user_auth_token>"
 	usageUserSendVerification = "cli users sendverification <user_auth_token>"
 	usageUserVerifyEmail      = "cli users verifyemail <verification_token>"
+	usageUserSessions         = `cli users sessions <list|revoke> [args...]
+Available sessions options:
+  cli users sessions list <user_auth_token>
+  cli users sessions revoke <session_id> <user_auth_token>`
+	usageUserSessionsList   = "cli users sessions list <user_auth_token>"
+	usageUserSessionsRevoke = "cli users sessions revoke <session_id> <user_auth_token>"
 )
 
 func NewUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "users <user_id|all|create|token|refreshtoken|profile|resetpasswordrequest|resetpassword|password|search|sendverification|verifyemail> [operation] [args...]",
+		Use:   "users <user_id|all|create|token|refreshtoken|profile|sessions|resetpasswordrequest|resetpassword|password|search|sendverification|verifyemail> [operation] [args...]",
 		Short: "Users management",
 		Long: `Format: 
-  users <create|token|refreshtoken|profile|resetpasswordrequest|resetpassword|password|search|sendverification|verifyemail> [args...]
+  users <create|token|refreshtoken|profile|sessions|resetpasswordrequest|resetpassword|password|search|sendverification|verifyemail> [args...]
   users <user_id|all> <operation> [args...]
 
 Operations (require user_id/all): get, update, enable, disable, delete
@@ -70,6 +78,8 @@ Examples:
   users token <username> <password>
   users refreshtoken <refresh_token>
   users profile <user_auth_token>
+  users sessions list <user_auth_token>
+  users sessions revoke <session_id> <user_auth_token>
   users resetpasswordrequest <email>
   users resetpassword <password> <confpass> <password_request_token>
   users password <old_password> <new_password> <user_auth_token>
@@ -136,6 +146,13 @@ Examples:
 				}
 				handleUserProfile(cmd, args[1], args[2:])
 				return
+			case sessions:
+				if len(args) < 2 {
+					logUsageCmd(*cmd, usageUserSessions)
+					return
+				}
+				handleUserSessions(cmd, args[1], args[2:])
+				return
 			case resetPasswordRequest:
 				if len(args) < 2 {
 					logUsageCmd(*cmd, usageUserResetPasswordReq)
@@ -510,6 +527,43 @@ func handleUserProfile(cmd *cobra.Command, token string, args []string) {
 	logJSONCmd(*cmd, user)
 }
 
+func handleUserSessions(cmd *cobra.Command, operation string, args []string) {
+	switch operation {
+	case list:
+		if len(args) != 1 {
+			logUsageCmd(*cmd, usageUserSessionsList)
+			return
+		}
+
+		pageMetadata := smqsdk.PageMetadata{
+			Offset: Offset,
+			Limit:  Limit,
+		}
+
+		sp, err := sdk.ListSessions(cmd.Context(), pageMetadata, args[0])
+		if err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+
+		logJSONCmd(*cmd, sp)
+	case revoke:
+		if len(args) != 2 {
+			logUsageCmd(*cmd, usageUserSessionsRevoke)
+			return
+		}
+
+		if err := sdk.RevokeSession(cmd.Context(), args[0], args[1]); err != nil {
+			logErrorCmd(*cmd, err)
+			return
+		}
+
+		logOKCmd(*cmd)
+	default:
+		logUsageCmd(*cmd, usageUserSessions)
+	}
+}
+
 func handleUserResetPasswordRequest(cmd *cobra.Command, email string, args []string) {
 	if len(args) != 0 {
 		logUsageCmd(*cmd, usageUserResetPasswordReq)
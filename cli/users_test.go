@@ -862,6 +862,141 @@ func TestGetUserProfileCmd(t *testing.T) {
 	}
 }
 
+func TestUserSessionsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	usersCmd := cli.NewUsersCmd()
+	rootCmd := setFlags(usersCmd)
+
+	sessionsPage := mgsdk.SessionsPage{
+		PageRes: mgsdk.PageRes{Total: 1, Limit: 10},
+		Sessions: []mgsdk.Session{
+			{
+				ID:        user.ID,
+				IP:        "192.168.1.10",
+				UserAgent: "supermq-cli",
+			},
+		},
+	}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		page          mgsdk.SessionsPage
+		errLogMessage string
+		logType       outputLog
+	}{
+		{
+			desc: "list sessions successfully",
+			args: []string{
+				sessionsCmd,
+				listCmd,
+				validToken,
+			},
+			page:    sessionsPage,
+			logType: entityLog,
+		},
+		{
+			desc: "list sessions with invalid args",
+			args: []string{
+				sessionsCmd,
+				listCmd,
+				validToken,
+				extraArg,
+			},
+			errLogMessage: "cli users sessions list <user_auth_token>",
+			logType:       usageLog,
+		},
+		{
+			desc: "list sessions with invalid token",
+			args: []string{
+				sessionsCmd,
+				listCmd,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized).Error()),
+			logType:       errLog,
+		},
+		{
+			desc: "revoke session successfully",
+			args: []string{
+				sessionsCmd,
+				revokeCmd,
+				user.ID,
+				validToken,
+			},
+			logType: okLog,
+		},
+		{
+			desc: "revoke session with missing token",
+			args: []string{
+				sessionsCmd,
+				revokeCmd,
+				user.ID,
+			},
+			errLogMessage: "cli users sessions revoke <session_id> <user_auth_token>",
+			logType:       usageLog,
+		},
+		{
+			desc: "revoke non-existing session",
+			args: []string{
+				sessionsCmd,
+				revokeCmd,
+				invalidID,
+				validToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound).Error()),
+			logType:       errLog,
+		},
+		{
+			desc: "sessions with unknown operation",
+			args: []string{
+				sessionsCmd,
+				getCmd,
+				validToken,
+			},
+			errLogMessage: "cli users sessions <list|revoke> [args...]",
+			logType:       usageLog,
+		},
+		{
+			desc: "sessions without operation",
+			args: []string{
+				sessionsCmd,
+			},
+			errLogMessage: "cli users sessions <list|revoke> [args...]",
+			logType:       usageLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			listCall := sdkMock.On("ListSessions", mock.Anything, mock.Anything, mock.Anything).Return(tc.page, tc.sdkErr)
+			revokeCall := sdkMock.On("RevokeSession", mock.Anything, mock.Anything, mock.Anything).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				var page mgsdk.SessionsPage
+				err := json.Unmarshal([]byte(out), &page)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.page, page, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.page, page))
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.True(t, strings.Contains(out, tc.errLogMessage), fmt.Sprintf("%s invalid usage: expected to contain %s, got: %s", tc.desc, tc.errLogMessage, out))
+			}
+
+			listCall.Unset()
+			revokeCall.Unset()
+		})
+	}
+}
+
 func TestResetPasswordRequestCmd(t *testing.T) {
 	sdkMock := new(sdkmocks.SDK)
 	cli.SetSDK(sdkMock)
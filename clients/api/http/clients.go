@@ -80,6 +80,34 @@ func clientsHandler(svc clients.Service, authn smqauthn.AuthNMiddleware, r *chi.
 					opts...,
 				), "update_client_credentials").ServeHTTP)
 
+				r.Post("/secret/rotate", otelhttp.NewHandler(kithttp.NewServer(
+					rotateClientSecretEndpoint(svc),
+					decodeRotateClientSecret,
+					api.EncodeResponse,
+					opts...,
+				), "rotate_client_secret").ServeHTTP)
+
+				r.Post("/certificate", otelhttp.NewHandler(kithttp.NewServer(
+					bindClientCertEndpoint(svc),
+					decodeClientCert,
+					api.EncodeResponse,
+					opts...,
+				), "bind_client_cert").ServeHTTP)
+
+				r.Put("/certificate", otelhttp.NewHandler(kithttp.NewServer(
+					rotateClientCertEndpoint(svc),
+					decodeClientCert,
+					api.EncodeResponse,
+					opts...,
+				), "rotate_client_cert").ServeHTTP)
+
+				r.Delete("/certificate", otelhttp.NewHandler(kithttp.NewServer(
+					revokeClientCertEndpoint(svc),
+					decodeChangeClientStatus,
+					api.EncodeResponse,
+					opts...,
+				), "revoke_client_cert").ServeHTTP)
+
 				r.Post("/enable", otelhttp.NewHandler(kithttp.NewServer(
 					enableClientEndpoint(svc),
 					decodeChangeClientStatus,
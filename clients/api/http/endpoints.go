@@ -203,6 +203,104 @@ func updateClientSecretEndpoint(svc clients.Service) endpoint.Endpoint {
 	}
 }
 
+func rotateClientSecretEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(rotateClientSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		gracePeriod, err := req.gracePeriod()
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+		client, err := svc.RotateSecret(ctx, session, req.id, req.Secret, gracePeriod)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateClientRes{Client: client}, nil
+	}
+}
+
+func bindClientCertEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(clientCertReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		cert, err := req.certificate()
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.BindCert(ctx, session, req.id, cert)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateClientRes{Client: client}, nil
+	}
+}
+
+func rotateClientCertEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(clientCertReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		cert, err := req.certificate()
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.RotateCert(ctx, session, req.id, cert)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateClientRes{Client: client}, nil
+	}
+}
+
+func revokeClientCertEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(changeClientStatusReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.RevokeCert(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateClientRes{Client: client}, nil
+	}
+}
+
 func enableClientEndpoint(svc clients.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(changeClientStatusReq)
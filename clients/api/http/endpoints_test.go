@@ -4,14 +4,22 @@
 package http_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/0x6flab/namegenerator"
 	api "github.com/absmach/supermq/api/http"
@@ -30,6 +38,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -1306,6 +1315,364 @@ func TestUpdateClientSecret(t *testing.T) {
 	}
 }
 
+func TestRotateClientSecret(t *testing.T) {
+	ts, svc, authn := newClientsServer()
+	defer ts.Close()
+
+	rotatedClient := clients.Client{
+		ID: client.ID,
+		Credentials: clients.Credentials{
+			Identity: "clientname",
+			Secret:   "strongersecret",
+			SecretRotation: clients.SecretRotation{
+				ExpiresAt: time.Now().Add(time.Hour),
+			},
+		},
+	}
+	validSession := smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+
+	cases := []struct {
+		desc        string
+		data        string
+		id          string
+		contentType string
+		token       string
+		authnRes    smqauthn.Session
+		authnErr    error
+		secret      string
+		gracePeriod time.Duration
+		svcRes      clients.Client
+		svcErr      error
+		status      int
+		err         error
+	}{
+		{
+			desc:        "rotate client secret successfully",
+			data:        `{"secret": "strongersecret", "grace_period": "1h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			secret:      "strongersecret",
+			gracePeriod: time.Hour,
+			svcRes:      rotatedClient,
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:        "rotate client secret with default values",
+			data:        `{}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			svcRes:      rotatedClient,
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:        "rotate client secret with invalid token",
+			data:        `{"secret": "strongersecret"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       inValidToken,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "rotate client secret with invalid grace period",
+			data:        `{"grace_period": "invalid"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			status:      http.StatusBadRequest,
+			err:         clients.ErrInvalidGracePeriod,
+		},
+		{
+			desc:        "rotate client secret with negative grace period",
+			data:        `{"grace_period": "-1h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			status:      http.StatusBadRequest,
+			err:         clients.ErrInvalidGracePeriod,
+		},
+		{
+			desc:        "rotate client secret with invalid content type",
+			data:        `{"secret": "strongersecret"}`,
+			id:          client.ID,
+			contentType: "application/xml",
+			token:       validToken,
+			authnRes:    validSession,
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "rotate client secret with malformed data",
+			data:        `{"secret": invalid}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "rotate client secret with service error",
+			data:        `{"secret": "strongersecret"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    validSession,
+			secret:      "strongersecret",
+			svcErr:      svcerr.ErrUpdateEntity,
+			status:      http.StatusUnprocessableEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/clients/%s/secret/rotate", ts.URL, domainID, tc.id),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("RotateSecret", mock.Anything, tc.authnRes, tc.id, tc.secret, tc.gracePeriod).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestBindClientCert(t *testing.T) {
+	ts, svc, authn := newClientsServer()
+	defer ts.Close()
+
+	certPEM, cert := generateClientCert(t)
+	boundClient := client
+	boundClient.Credentials.Certificate = clients.Certificate{
+		Fingerprint: smqauthn.CertFingerprint(cert),
+		Subject:     cert.Subject.String(),
+	}
+
+	cases := []struct {
+		desc        string
+		data        string
+		id          string
+		cert        clients.Certificate
+		contentType string
+		token       string
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcRes      clients.Client
+		svcErr      error
+		status      int
+		err         error
+	}{
+		{
+			desc:        "bind client certificate with valid certificate",
+			data:        toJSON(map[string]string{"certificate": certPEM}),
+			id:          client.ID,
+			cert:        boundClient.Credentials.Certificate,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			svcRes:      boundClient,
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:        "bind client certificate with valid subject",
+			data:        toJSON(map[string]string{"subject": "CN=client"}),
+			id:          client.ID,
+			cert:        clients.Certificate{Subject: "CN=client"},
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			svcRes:      client,
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:        "bind client certificate with invalid token",
+			data:        toJSON(map[string]string{"certificate": certPEM}),
+			id:          client.ID,
+			contentType: contentType,
+			token:       inValid,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "bind client certificate without certificate and subject",
+			data:        toJSON(map[string]string{}),
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingCert,
+		},
+		{
+			desc:        "bind client certificate with both certificate and subject",
+			data:        toJSON(map[string]string{"certificate": certPEM, "subject": "CN=client"}),
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidCert,
+		},
+		{
+			desc:        "bind client certificate with invalid certificate",
+			data:        toJSON(map[string]string{"certificate": "invalid"}),
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidCert,
+		},
+		{
+			desc:        "bind client certificate with invalid content type",
+			data:        toJSON(map[string]string{"certificate": certPEM}),
+			id:          client.ID,
+			contentType: "application/xml",
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "bind client certificate to client with bound certificate",
+			data:        toJSON(map[string]string{"certificate": certPEM}),
+			id:          client.ID,
+			cert:        boundClient.Credentials.Certificate,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			svcErr:      clients.ErrCertAlreadyBound,
+			status:      http.StatusBadRequest,
+			err:         clients.ErrCertAlreadyBound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/clients/%s/certificate", ts.URL, domainID, tc.id),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("BindCert", mock.Anything, tc.authnRes, tc.id, tc.cert).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRevokeClientCert(t *testing.T) {
+	ts, svc, authn := newClientsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		id       string
+		token    string
+		authnRes smqauthn.Session
+		authnErr error
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:     "revoke client certificate with valid token",
+			id:       client.ID,
+			token:    validToken,
+			authnRes: smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:   http.StatusOK,
+			err:      nil,
+		},
+		{
+			desc:     "revoke client certificate with invalid token",
+			id:       client.ID,
+			token:    inValid,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "revoke client certificate of client without bound certificate",
+			id:       client.ID,
+			token:    validToken,
+			authnRes: smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			svcErr:   clients.ErrCertNotBound,
+			status:   http.StatusBadRequest,
+			err:      clients.ErrCertNotBound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/clients/%s/certificate", ts.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("RevokeCert", mock.Anything, tc.authnRes, tc.id).Return(client, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestEnableClient(t *testing.T) {
 	ts, svc, authn := newClientsServer()
 	defer ts.Close()
@@ -1777,3 +2144,22 @@ type respBody struct {
 	Tags        []string       `json:"tags"`
 	Status      clients.Status `json:"status"`
 }
+
+func generateClientCert(t *testing.T) (string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err, "Failed to generate client private key")
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err, "Failed to create client certificate")
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err, "Failed to parse client certificate")
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), cert
+}
@@ -4,9 +4,15 @@
 package http
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
 )
 
 type createClientReq struct {
@@ -161,6 +167,87 @@ func (req updateClientCredentialsReq) validate() error {
 	return nil
 }
 
+// rotateClientSecretReq rotates the client secret. The generated secret is
+// used if the secret is not provided, and the default grace period is used
+// if the grace period is not provided.
+type rotateClientSecretReq struct {
+	id          string
+	Secret      string `json:"secret,omitempty"`
+	GracePeriod string `json:"grace_period,omitempty"`
+}
+
+func (req rotateClientSecretReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if _, err := req.gracePeriod(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (req rotateClientSecretReq) gracePeriod() (time.Duration, error) {
+	if req.GracePeriod == "" {
+		return 0, nil
+	}
+	gracePeriod, err := time.ParseDuration(req.GracePeriod)
+	if err != nil {
+		return 0, errors.Wrap(clients.ErrInvalidGracePeriod, err)
+	}
+	if gracePeriod <= 0 {
+		return 0, clients.ErrInvalidGracePeriod
+	}
+
+	return gracePeriod, nil
+}
+
+// clientCertReq binds the client certificate either by the PEM encoded
+// certificate, which is matched by its fingerprint, or by its subject.
+type clientCertReq struct {
+	id          string
+	Certificate string `json:"certificate,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+}
+
+func (req clientCertReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Certificate == "" && req.Subject == "" {
+		return apiutil.ErrMissingCert
+	}
+	if req.Certificate != "" && req.Subject != "" {
+		return apiutil.ErrInvalidCert
+	}
+	if req.Certificate != "" {
+		if _, err := req.certificate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (req clientCertReq) certificate() (clients.Certificate, error) {
+	if req.Certificate == "" {
+		return clients.Certificate{Subject: req.Subject}, nil
+	}
+	block, _ := pem.Decode([]byte(req.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return clients.Certificate{}, apiutil.ErrInvalidCert
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return clients.Certificate{}, errors.Wrap(apiutil.ErrInvalidCert, err)
+	}
+
+	return clients.Certificate{
+		Fingerprint: authn.CertFingerprint(cert),
+		Subject:     cert.Subject.String(),
+	}, nil
+}
+
 type changeClientStatusReq struct {
 	id string
 }
@@ -35,16 +35,36 @@ func NewCache(client *redis.Client, duration time.Duration) clients.Cache {
 }
 
 func (tc *clientCache) Save(ctx context.Context, clientKey, clientID string) error {
+	return tc.save(ctx, clientKey, clientID, tc.keyDuration)
+}
+
+func (tc *clientCache) SaveUntil(ctx context.Context, clientKey, clientID string, expiresAt time.Time) error {
+	duration := min(tc.keyDuration, time.Until(expiresAt))
+	if duration <= 0 {
+		return nil
+	}
+
+	return tc.save(ctx, clientKey, clientID, duration)
+}
+
+func (tc *clientCache) save(ctx context.Context, clientKey, clientID string, duration time.Duration) error {
 	if clientKey == "" || clientID == "" {
 		return errors.Wrap(repoerr.ErrCreateEntity, errors.New("client key or client id is empty"))
 	}
 	ckey := fmt.Sprintf("%s:%s", keyPrefix, clientKey)
-	if err := tc.client.Set(ctx, ckey, clientID, tc.keyDuration).Err(); err != nil {
+	if err := tc.client.Set(ctx, ckey, clientID, duration).Err(); err != nil {
 		return errors.Wrap(repoerr.ErrCreateEntity, err)
 	}
 
+	// The client may authenticate with several keys, such as the secret and
+	// the certificate, so all of them are kept to be removed together. The
+	// keys set outlives any of the keys.
 	tid := fmt.Sprintf("%s:%s", idPrefix, clientID)
-	if err := tc.client.Set(ctx, tid, clientKey, tc.keyDuration).Err(); err != nil {
+	if _, err := tc.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, tid, clientKey)
+		pipe.Expire(ctx, tid, tc.keyDuration)
+		return nil
+	}); err != nil {
 		return errors.Wrap(repoerr.ErrCreateEntity, err)
 	}
 
@@ -67,17 +87,19 @@ func (tc *clientCache) ID(ctx context.Context, clientKey string) (string, error)
 
 func (tc *clientCache) Remove(ctx context.Context, clientID string) error {
 	tid := fmt.Sprintf("%s:%s", idPrefix, clientID)
-	key, err := tc.client.Get(ctx, tid).Result()
-	// Redis returns Nil Reply when key does not exist.
-	if err == redis.Nil {
-		return nil
-	}
+	keys, err := tc.client.SMembers(ctx, tid).Result()
 	if err != nil {
 		return errors.Wrap(repoerr.ErrRemoveEntity, err)
 	}
+	if len(keys) == 0 {
+		return nil
+	}
 
-	tkey := fmt.Sprintf("%s:%s", keyPrefix, key)
-	if err := tc.client.Del(ctx, tkey, tid).Err(); err != nil {
+	dels := []string{tid}
+	for _, key := range keys {
+		dels = append(dels, fmt.Sprintf("%s:%s", keyPrefix, key))
+	}
+	if err := tc.client.Del(ctx, dels...).Err(); err != nil {
 		return errors.Wrap(repoerr.ErrRemoveEntity, err)
 	}
 
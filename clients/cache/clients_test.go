@@ -94,6 +94,69 @@ func TestSave(t *testing.T) {
 	}
 }
 
+func TestSaveUntil(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	tscache := cache.NewCache(redisClient, 1*time.Minute)
+	ctx := context.Background()
+
+	cases := []struct {
+		desc      string
+		key       string
+		id        string
+		expiresAt time.Time
+		cached    bool
+		err       error
+	}{
+		{
+			desc:      "Save client to cache until expiration",
+			key:       testKey,
+			id:        testID,
+			expiresAt: time.Now().Add(30 * time.Second),
+			cached:    true,
+			err:       nil,
+		},
+		{
+			desc:      "Save client to cache with expiration after key duration",
+			key:       testKey2,
+			id:        testID2,
+			expiresAt: time.Now().Add(time.Hour),
+			cached:    true,
+			err:       nil,
+		},
+		{
+			desc:      "Save client to cache with past expiration",
+			key:       "expiredKey",
+			id:        testID,
+			expiresAt: time.Now().Add(-time.Minute),
+			cached:    false,
+			err:       nil,
+		},
+		{
+			desc:      "Save client with empty key",
+			key:       "",
+			id:        testID,
+			expiresAt: time.Now().Add(time.Minute),
+			err:       repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tscache.SaveUntil(ctx, tc.key, tc.id, tc.expiresAt)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+		id, err := tscache.ID(ctx, tc.key)
+		if tc.cached {
+			assert.Equal(t, tc.id, id, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.id, id))
+			ttl := redisClient.TTL(ctx, "client_key:"+tc.key).Val()
+			assert.True(t, ttl > 0 && ttl <= time.Minute && ttl <= time.Until(tc.expiresAt), fmt.Sprintf("%s: unexpected expiration %s", tc.desc, ttl))
+		} else {
+			assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("%s: expected %s got %s", tc.desc, repoerr.ErrNotFound, err))
+		}
+	}
+}
+
 func TestID(t *testing.T) {
 	redisClient.FlushAll(context.Background())
 	tscache := cache.NewCache(redisClient, 1*time.Minute)
@@ -177,3 +240,23 @@ func TestRemove(t *testing.T) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestRemoveAllKeys(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	tscache := cache.NewCache(redisClient, 1*time.Minute)
+	ctx := context.Background()
+
+	keys := []string{testKey, testKey + "-cert"}
+	for _, key := range keys {
+		err := tscache.Save(ctx, key, testID)
+		assert.Nil(t, err, fmt.Sprintf("Unexpected error while trying to save: %s", err))
+	}
+
+	err := tscache.Remove(ctx, testID)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error while trying to remove: %s", err))
+
+	for _, key := range keys {
+		_, err := tscache.ID(ctx, key)
+		assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s\n", repoerr.ErrNotFound, err))
+	}
+}
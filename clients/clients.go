@@ -58,12 +58,23 @@ type Repository interface {
 	// UpdateSecret updates secret for client with given identity.
 	UpdateSecret(ctx context.Context, client Client) (Client, error)
 
+	// RotateSecret replaces the secret of the client with given id and keeps
+	// the replaced secret valid until the previous secret expiration time.
+	RotateSecret(ctx context.Context, client Client) (Client, error)
+
+	// UpdateCertificate updates the certificate bound to the client with given id.
+	UpdateCertificate(ctx context.Context, client Client) (Client, error)
+
 	// ChangeStatus changes client status to enabled or disabled
 	ChangeStatus(ctx context.Context, client Client) (Client, error)
 
 	// Delete deletes client with given id
 	Delete(ctx context.Context, clientIDs ...string) error
 
+	// CountByDomain returns the number of the clients in each of the given
+	// domains, or in all the domains if none is given.
+	CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error)
+
 	// Save persists the client account. A non-nil error is returned to indicate
 	// operation failure.
 	Save(ctx context.Context, client ...Client) ([]Client, error)
@@ -123,6 +134,21 @@ type Service interface {
 	// UpdateSecret updates the client's secret
 	UpdateSecret(ctx context.Context, session authn.Session, id, key string) (Client, error)
 
+	// RotateSecret replaces the client's secret with the given one, or the
+	// generated one if it's empty, and keeps accepting the replaced secret
+	// for the grace period. The default grace period is used if it's zero.
+	RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (Client, error)
+
+	// BindCert binds the X.509 certificate to the client, so the client can
+	// authenticate with it instead of the secret.
+	BindCert(ctx context.Context, session authn.Session, id string, cert Certificate) (Client, error)
+
+	// RotateCert replaces the certificate bound to the client.
+	RotateCert(ctx context.Context, session authn.Session, id string, cert Certificate) (Client, error)
+
+	// RevokeCert unbinds the certificate from the client.
+	RevokeCert(ctx context.Context, session authn.Session, id string) (Client, error)
+
 	// Enable logically enableds the client identified with the provided ID
 	Enable(ctx context.Context, session authn.Session, id string) (Client, error)
 
@@ -144,6 +170,10 @@ type Cache interface {
 	// Save stores pair client secret, client id.
 	Save(ctx context.Context, clientSecret, clientID string) error
 
+	// SaveUntil stores pair client secret, client id which is kept no longer
+	// than until the given time.
+	SaveUntil(ctx context.Context, clientSecret, clientID string, expiresAt time.Time) error
+
 	// ID returns client ID for given client secret.
 	ID(ctx context.Context, clientSecret string) (string, error)
 
@@ -258,8 +288,38 @@ type Metadata map[string]any
 
 // Credentials represent client credentials: its
 // "identity" which can be a username, email, generated name;
-// and "secret" which can be a password or access token.
+// "secret" which can be a password or access token;
+// and "certificate" which identifies the client X.509 certificate.
 type Credentials struct {
-	Identity string `json:"identity,omitempty"` // username or generated login ID
-	Secret   string `json:"secret,omitempty"`   // password or token
+	Identity    string      `json:"identity,omitempty"`   // username or generated login ID
+	Secret      string      `json:"secret,omitempty"`     // password or token
+	Certificate Certificate `json:"certificate,omitzero"` // X.509 certificate identity
+	// SecretRotation holds the secret replaced by the rotation, which is
+	// still accepted until it expires.
+	SecretRotation SecretRotation `json:"secret_rotation,omitzero"`
+}
+
+// SecretRotation represents the secret replaced by the rotation. The previous
+// secret is never exposed, only the time until it's accepted.
+type SecretRotation struct {
+	PreviousSecret string    `json:"-"`
+	ExpiresAt      time.Time `json:"previous_secret_expires_at,omitzero"`
+}
+
+// Active returns true if the previous secret is still accepted at the given time.
+func (sr SecretRotation) Active(now time.Time) bool {
+	return sr.PreviousSecret != "" && now.Before(sr.ExpiresAt)
+}
+
+// Certificate identifies the X.509 certificate the client authenticates with.
+// The certificate is matched by its fingerprint, or by its subject if the
+// fingerprint is not set, which allows the CA to reissue the certificate.
+type Certificate struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+}
+
+// IsZero returns true if no certificate is bound.
+func (c Certificate) IsZero() bool {
+	return c.Fingerprint == "" && c.Subject == ""
 }
@@ -3,7 +3,7 @@
 
 package clients
 
-import "errors"
+import "github.com/absmach/supermq/pkg/errors"
 
 var (
 	// ErrEnableClient indicates error in enabling client.
@@ -11,4 +11,13 @@ var (
 
 	// ErrDisableClient indicates error in disabling client.
 	ErrDisableClient = errors.New("failed to disable client")
+
+	// ErrCertAlreadyBound indicates that the client already has a bound certificate.
+	ErrCertAlreadyBound = errors.NewRequestError("client certificate is already bound")
+
+	// ErrCertNotBound indicates that the client has no bound certificate.
+	ErrCertNotBound = errors.NewRequestError("client certificate is not bound")
+
+	// ErrInvalidGracePeriod indicates invalid grace period of the secret rotation.
+	ErrInvalidGracePeriod = errors.NewRequestError("invalid secret rotation grace period")
 )
@@ -18,6 +18,10 @@ const (
 	clientUpdate       = clientPrefix + "update"
 	clientUpdateTags   = clientPrefix + "update_tags"
 	clientUpdateSecret = clientPrefix + "update_secret"
+	clientRotateSecret = clientPrefix + "rotate_secret"
+	clientBindCert     = clientPrefix + "bind_cert"
+	clientRotateCert   = clientPrefix + "rotate_cert"
+	clientRevokeCert   = clientPrefix + "revoke_cert"
 	clientEnable       = clientPrefix + "enable"
 	clientDisable      = clientPrefix + "disable"
 	clientRemove       = clientPrefix + "remove"
@@ -110,6 +114,13 @@ func (uce updateClientEvent) Encode() (map[string]any, error) {
 	if uce.Credentials.Identity != "" {
 		val["identity"] = uce.Credentials.Identity
 	}
+	if uce.operation == clientRotateSecret {
+		val["previous_secret_expires_at"] = uce.Credentials.SecretRotation.ExpiresAt
+	}
+	if uce.operation == clientBindCert || uce.operation == clientRotateCert {
+		val["cert_fingerprint"] = uce.Credentials.Certificate.Fingerprint
+		val["cert_subject"] = uce.Credentials.Certificate.Subject
+	}
 	if uce.Metadata != nil {
 		val["metadata"] = uce.Metadata
 	}
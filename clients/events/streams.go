@@ -5,6 +5,7 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
@@ -21,6 +22,10 @@ const (
 	updateStream       = supermqPrefix + clientUpdate
 	updateTagsStream   = supermqPrefix + clientUpdateTags
 	updateSecretStream = supermqPrefix + clientUpdateSecret
+	rotateSecretStream = supermqPrefix + clientRotateSecret
+	bindCertStream     = supermqPrefix + clientBindCert
+	rotateCertStream   = supermqPrefix + clientRotateCert
+	revokeCertStream   = supermqPrefix + clientRevokeCert
 	enableStream       = supermqPrefix + clientEnable
 	disableStream      = supermqPrefix + clientDisable
 	removeStream       = supermqPrefix + clientRemove
@@ -103,6 +108,42 @@ func (es *eventStore) UpdateSecret(ctx context.Context, session authn.Session, i
 	return es.update(ctx, session, clientUpdateSecret, updateSecretStream, cli)
 }
 
+func (es *eventStore) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (clients.Client, error) {
+	cli, err := es.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+	if err != nil {
+		return cli, err
+	}
+
+	return es.update(ctx, session, clientRotateSecret, rotateSecretStream, cli)
+}
+
+func (es *eventStore) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	cli, err := es.svc.BindCert(ctx, session, id, cert)
+	if err != nil {
+		return cli, err
+	}
+
+	return es.update(ctx, session, clientBindCert, bindCertStream, cli)
+}
+
+func (es *eventStore) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	cli, err := es.svc.RotateCert(ctx, session, id, cert)
+	if err != nil {
+		return cli, err
+	}
+
+	return es.update(ctx, session, clientRotateCert, rotateCertStream, cli)
+}
+
+func (es *eventStore) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	cli, err := es.svc.RevokeCert(ctx, session, id)
+	if err != nil {
+		return cli, err
+	}
+
+	return es.update(ctx, session, clientRevokeCert, revokeCertStream, cli)
+}
+
 func (es *eventStore) update(ctx context.Context, session authn.Session, operation, stream string, client clients.Client) (clients.Client, error) {
 	event := updateClientEvent{
 		Client:    client,
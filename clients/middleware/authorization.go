@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/clients"
@@ -26,6 +27,7 @@ var (
 	errUpdate                  = errors.New("not authorized to update client")
 	errUpdateTags              = errors.New("not authorized to update client tags")
 	errUpdateSecret            = errors.New("not authorized to update client secret")
+	errUpdateCert              = errors.New("not authorized to update client certificate")
 	errEnable                  = errors.New("not authorized to enable client")
 	errDisable                 = errors.New("not authorized to disable client")
 	errDelete                  = errors.New("not authorized to delete client")
@@ -158,6 +160,62 @@ func (am *authorizationMiddleware) UpdateSecret(ctx context.Context, session aut
 	return am.svc.UpdateSecret(ctx, session, id, key)
 }
 
+func (am *authorizationMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (clients.Client, error) {
+	if err := am.authorize(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errUpdateSecret)
+	}
+
+	return am.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+}
+
+func (am *authorizationMiddleware) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	if err := am.authorize(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errUpdateCert)
+	}
+
+	return am.svc.BindCert(ctx, session, id, cert)
+}
+
+func (am *authorizationMiddleware) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	if err := am.authorize(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errUpdateCert)
+	}
+
+	return am.svc.RotateCert(ctx, session, id, cert)
+}
+
+func (am *authorizationMiddleware) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	if err := am.authorize(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errUpdateCert)
+	}
+
+	return am.svc.RevokeCert(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	if err := am.authorize(ctx, session, policies.ClientType, operations.OpEnableClient, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
@@ -132,6 +132,55 @@ func (cm *calloutMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return cm.svc.UpdateSecret(ctx, session, id, key)
 }
 
+func (cm *calloutMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (clients.Client, error) {
+	params := map[string]any{
+		"entity_id":    id,
+		"grace_period": gracePeriod.String(),
+	}
+
+	if err := cm.callOut(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, params); err != nil {
+		return clients.Client{}, err
+	}
+
+	return cm.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+}
+
+func (cm *calloutMiddleware) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, params); err != nil {
+		return clients.Client{}, err
+	}
+
+	return cm.svc.BindCert(ctx, session, id, cert)
+}
+
+func (cm *calloutMiddleware) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, params); err != nil {
+		return clients.Client{}, err
+	}
+
+	return cm.svc.RotateCert(ctx, session, id, cert)
+}
+
+func (cm *calloutMiddleware) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, params); err != nil {
+		return clients.Client{}, err
+	}
+
+	return cm.svc.RevokeCert(ctx, session, id)
+}
+
 func (cm *calloutMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	params := map[string]any{
 		"entity_id": id,
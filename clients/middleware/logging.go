@@ -182,6 +182,100 @@ func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return lm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+func (lm *loggingMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+			slog.String("grace_period", gracePeriod.String()),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Rotate client secret failed", args...)
+			return
+		}
+		args = append(args, slog.Time("previous_secret_expires_at", c.Credentials.SecretRotation.ExpiresAt))
+		lm.logger.Info("Rotate client secret completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+}
+
+func (lm *loggingMiddleware) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+			slog.Group("certificate",
+				slog.String("fingerprint", cert.Fingerprint),
+				slog.String("subject", cert.Subject),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Bind client certificate failed", args...)
+			return
+		}
+		lm.logger.Info("Bind client certificate completed successfully", args...)
+	}(time.Now())
+	return lm.svc.BindCert(ctx, session, id, cert)
+}
+
+func (lm *loggingMiddleware) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+			slog.Group("certificate",
+				slog.String("fingerprint", cert.Fingerprint),
+				slog.String("subject", cert.Subject),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Rotate client certificate failed", args...)
+			return
+		}
+		lm.logger.Info("Rotate client certificate completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RotateCert(ctx, session, id, cert)
+}
+
+func (lm *loggingMiddleware) RevokeCert(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Revoke client certificate failed", args...)
+			return
+		}
+		lm.logger.Info("Revoke client certificate completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RevokeCert(ctx, session, id)
+}
+
 func (lm *loggingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -89,6 +89,38 @@ func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return ms.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+func (ms *metricsMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "rotate_client_secret").Add(1)
+		ms.latency.With("method", "rotate_client_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+}
+
+func (ms *metricsMiddleware) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "bind_client_cert").Add(1)
+		ms.latency.With("method", "bind_client_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.BindCert(ctx, session, id, cert)
+}
+
+func (ms *metricsMiddleware) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "rotate_client_cert").Add(1)
+		ms.latency.With("method", "rotate_client_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RotateCert(ctx, session, id, cert)
+}
+
+func (ms *metricsMiddleware) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_client_cert").Add(1)
+		ms.latency.With("method", "revoke_client_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RevokeCert(ctx, session, id)
+}
+
 func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "enable_client").Add(1)
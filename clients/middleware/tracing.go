@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
@@ -87,6 +88,41 @@ func (tm *tracingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return tm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+// RotateSecret traces the "RotateSecret" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_rotate_client_secret", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("grace_period", gracePeriod.String()),
+	))
+	defer span.End()
+
+	return tm.svc.RotateSecret(ctx, session, id, key, gracePeriod)
+}
+
+// BindCert traces the "BindCert" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_bind_client_cert", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return tm.svc.BindCert(ctx, session, id, cert)
+}
+
+// RotateCert traces the "RotateCert" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_rotate_client_cert", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return tm.svc.RotateCert(ctx, session, id, cert)
+}
+
+// RevokeCert traces the "RevokeCert" operation of the wrapped clients.Service.
+func (tm *tracingMiddleware) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_revoke_client_cert", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return tm.svc.RevokeCert(ctx, session, id)
+}
+
 // Enable traces the "Enable" operation of the wrapped clients.Service.
 func (tm *tracingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_enable_client", trace.WithAttributes(attribute.String("id", id)))
@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -226,3 +227,72 @@ func (_c *Cache_Save_Call) RunAndReturn(run func(ctx context.Context, clientSecr
 	_c.Call.Return(run)
 	return _c
 }
+
+// SaveUntil provides a mock function for the type Cache
+func (_mock *Cache) SaveUntil(ctx context.Context, clientSecret string, clientID string, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, clientSecret, clientID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUntil")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, clientSecret, clientID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Cache_SaveUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUntil'
+type Cache_SaveUntil_Call struct {
+	*mock.Call
+}
+
+// SaveUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientSecret string
+//   - clientID string
+//   - expiresAt time.Time
+func (_e *Cache_Expecter) SaveUntil(ctx interface{}, clientSecret interface{}, clientID interface{}, expiresAt interface{}) *Cache_SaveUntil_Call {
+	return &Cache_SaveUntil_Call{Call: _e.mock.On("SaveUntil", ctx, clientSecret, clientID, expiresAt)}
+}
+
+func (_c *Cache_SaveUntil_Call) Run(run func(ctx context.Context, clientSecret string, clientID string, expiresAt time.Time)) *Cache_SaveUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Cache_SaveUntil_Call) Return(err error) *Cache_SaveUntil_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Cache_SaveUntil_Call) RunAndReturn(run func(ctx context.Context, clientSecret string, clientID string, expiresAt time.Time) error) *Cache_SaveUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -301,6 +301,83 @@ func (_c *Repository_ClientConnectionsCount_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// CountByDomain provides a mock function for the type Repository
+func (_mock *Repository) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	var tmpRet mock.Arguments
+	if len(domainIDs) > 0 {
+		tmpRet = _mock.Called(ctx, domainIDs)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByDomain")
+	}
+
+	var r0 map[string]uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) (map[string]uint64, error)); ok {
+		return returnFunc(ctx, domainIDs...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) map[string]uint64); ok {
+		r0 = returnFunc(ctx, domainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = returnFunc(ctx, domainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CountByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByDomain'
+type Repository_CountByDomain_Call struct {
+	*mock.Call
+}
+
+// CountByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainIDs ...string
+func (_e *Repository_Expecter) CountByDomain(ctx interface{}, domainIDs ...interface{}) *Repository_CountByDomain_Call {
+	return &Repository_CountByDomain_Call{Call: _e.mock.On("CountByDomain",
+		append([]interface{}{ctx}, domainIDs...)...)}
+}
+
+func (_c *Repository_CountByDomain_Call) Run(run func(ctx context.Context, domainIDs ...string)) *Repository_CountByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		var variadicArgs []string
+		if len(args) > 1 {
+			variadicArgs = args[1].([]string)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) Return(stringToUint64 map[string]uint64, err error) *Repository_CountByDomain_Call {
+	_c.Call.Return(stringToUint64, err)
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) RunAndReturn(run func(ctx context.Context, domainIDs ...string) (map[string]uint64, error)) *Repository_CountByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type Repository
 func (_mock *Repository) Delete(ctx context.Context, clientIDs ...string) error {
 	var tmpRet mock.Arguments
@@ -2374,6 +2451,72 @@ func (_c *Repository_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// RotateSecret provides a mock function for the type Repository
+func (_mock *Repository) RotateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	ret := _mock.Called(ctx, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) (clients.Client, error)); ok {
+		return returnFunc(ctx, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) clients.Client); ok {
+		r0 = returnFunc(ctx, client)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, clients.Client) error); ok {
+		r1 = returnFunc(ctx, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type Repository_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - client clients.Client
+func (_e *Repository_Expecter) RotateSecret(ctx interface{}, client interface{}) *Repository_RotateSecret_Call {
+	return &Repository_RotateSecret_Call{Call: _e.mock.On("RotateSecret", ctx, client)}
+}
+
+func (_c *Repository_RotateSecret_Call) Run(run func(ctx context.Context, client clients.Client)) *Repository_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Client
+		if args[1] != nil {
+			arg1 = args[1].(clients.Client)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RotateSecret_Call) Return(client1 clients.Client, err error) *Repository_RotateSecret_Call {
+	_c.Call.Return(client1, err)
+	return _c
+}
+
+func (_c *Repository_RotateSecret_Call) RunAndReturn(run func(ctx context.Context, client clients.Client) (clients.Client, error)) *Repository_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type Repository
 func (_mock *Repository) Save(ctx context.Context, client ...clients.Client) ([]clients.Client, error) {
 	var tmpRet mock.Arguments
@@ -2697,6 +2840,72 @@ func (_c *Repository_Update_Call) RunAndReturn(run func(ctx context.Context, cli
 	return _c
 }
 
+// UpdateCertificate provides a mock function for the type Repository
+func (_mock *Repository) UpdateCertificate(ctx context.Context, client clients.Client) (clients.Client, error) {
+	ret := _mock.Called(ctx, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCertificate")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) (clients.Client, error)); ok {
+		return returnFunc(ctx, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) clients.Client); ok {
+		r0 = returnFunc(ctx, client)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, clients.Client) error); ok {
+		r1 = returnFunc(ctx, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateCertificate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCertificate'
+type Repository_UpdateCertificate_Call struct {
+	*mock.Call
+}
+
+// UpdateCertificate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - client clients.Client
+func (_e *Repository_Expecter) UpdateCertificate(ctx interface{}, client interface{}) *Repository_UpdateCertificate_Call {
+	return &Repository_UpdateCertificate_Call{Call: _e.mock.On("UpdateCertificate", ctx, client)}
+}
+
+func (_c *Repository_UpdateCertificate_Call) Run(run func(ctx context.Context, client clients.Client)) *Repository_UpdateCertificate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Client
+		if args[1] != nil {
+			arg1 = args[1].(clients.Client)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateCertificate_Call) Return(client1 clients.Client, err error) *Repository_UpdateCertificate_Call {
+	_c.Call.Return(client1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateCertificate_Call) RunAndReturn(run func(ctx context.Context, client clients.Client) (clients.Client, error)) *Repository_UpdateCertificate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateIdentity provides a mock function for the type Repository
 func (_mock *Repository) UpdateIdentity(ctx context.Context, client clients.Client) (clients.Client, error) {
 	ret := _mock.Called(ctx, client)
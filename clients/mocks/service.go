@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/clients"
 	"github.com/absmach/supermq/pkg/authn"
@@ -134,6 +135,84 @@ func (_c *Service_AddRole_Call) RunAndReturn(run func(ctx context.Context, sessi
 	return _c
 }
 
+// BindCert provides a mock function for the type Service
+func (_mock *Service) BindCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	ret := _mock.Called(ctx, session, id, cert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindCert")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, clients.Certificate) (clients.Client, error)); ok {
+		return returnFunc(ctx, session, id, cert)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, clients.Certificate) clients.Client); ok {
+		r0 = returnFunc(ctx, session, id, cert)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, clients.Certificate) error); ok {
+		r1 = returnFunc(ctx, session, id, cert)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_BindCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindCert'
+type Service_BindCert_Call struct {
+	*mock.Call
+}
+
+// BindCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - cert clients.Certificate
+func (_e *Service_Expecter) BindCert(ctx interface{}, session interface{}, id interface{}, cert interface{}) *Service_BindCert_Call {
+	return &Service_BindCert_Call{Call: _e.mock.On("BindCert", ctx, session, id, cert)}
+}
+
+func (_c *Service_BindCert_Call) Run(run func(ctx context.Context, session authn.Session, id string, cert clients.Certificate)) *Service_BindCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 clients.Certificate
+		if args[3] != nil {
+			arg3 = args[3].(clients.Certificate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_BindCert_Call) Return(client clients.Client, err error) *Service_BindCert_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *Service_BindCert_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error)) *Service_BindCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClients provides a mock function for the type Service
 func (_mock *Service) CreateClients(ctx context.Context, session authn.Session, client ...clients.Client) ([]clients.Client, []roles.RoleProvision, error) {
 	var tmpRet mock.Arguments
@@ -1154,6 +1233,78 @@ func (_c *Service_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// RevokeCert provides a mock function for the type Service
+func (_mock *Service) RevokeCert(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCert")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (clients.Client, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) clients.Client); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RevokeCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeCert'
+type Service_RevokeCert_Call struct {
+	*mock.Call
+}
+
+// RevokeCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RevokeCert(ctx interface{}, session interface{}, id interface{}) *Service_RevokeCert_Call {
+	return &Service_RevokeCert_Call{Call: _e.mock.On("RevokeCert", ctx, session, id)}
+}
+
+func (_c *Service_RevokeCert_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RevokeCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RevokeCert_Call) Return(client clients.Client, err error) *Service_RevokeCert_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *Service_RevokeCert_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (clients.Client, error)) *Service_RevokeCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RoleAddActions provides a mock function for the type Service
 func (_mock *Service) RoleAddActions(ctx context.Context, session authn.Session, entityID string, roleID string, actions []string) ([]string, error) {
 	ret := _mock.Called(ctx, session, entityID, roleID, actions)
@@ -1952,6 +2103,168 @@ func (_c *Service_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// RotateCert provides a mock function for the type Service
+func (_mock *Service) RotateCert(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error) {
+	ret := _mock.Called(ctx, session, id, cert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateCert")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, clients.Certificate) (clients.Client, error)); ok {
+		return returnFunc(ctx, session, id, cert)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, clients.Certificate) clients.Client); ok {
+		r0 = returnFunc(ctx, session, id, cert)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, clients.Certificate) error); ok {
+		r1 = returnFunc(ctx, session, id, cert)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RotateCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateCert'
+type Service_RotateCert_Call struct {
+	*mock.Call
+}
+
+// RotateCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - cert clients.Certificate
+func (_e *Service_Expecter) RotateCert(ctx interface{}, session interface{}, id interface{}, cert interface{}) *Service_RotateCert_Call {
+	return &Service_RotateCert_Call{Call: _e.mock.On("RotateCert", ctx, session, id, cert)}
+}
+
+func (_c *Service_RotateCert_Call) Run(run func(ctx context.Context, session authn.Session, id string, cert clients.Certificate)) *Service_RotateCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 clients.Certificate
+		if args[3] != nil {
+			arg3 = args[3].(clients.Certificate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RotateCert_Call) Return(client clients.Client, err error) *Service_RotateCert_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *Service_RotateCert_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, cert clients.Certificate) (clients.Client, error)) *Service_RotateCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSecret provides a mock function for the type Service
+func (_mock *Service) RotateSecret(ctx context.Context, session authn.Session, id string, key string, gracePeriod time.Duration) (clients.Client, error) {
+	ret := _mock.Called(ctx, session, id, key, gracePeriod)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, time.Duration) (clients.Client, error)); ok {
+		return returnFunc(ctx, session, id, key, gracePeriod)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, time.Duration) clients.Client); ok {
+		r0 = returnFunc(ctx, session, id, key, gracePeriod)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, session, id, key, gracePeriod)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type Service_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - key string
+//   - gracePeriod time.Duration
+func (_e *Service_Expecter) RotateSecret(ctx interface{}, session interface{}, id interface{}, key interface{}, gracePeriod interface{}) *Service_RotateSecret_Call {
+	return &Service_RotateSecret_Call{Call: _e.mock.On("RotateSecret", ctx, session, id, key, gracePeriod)}
+}
+
+func (_c *Service_RotateSecret_Call) Run(run func(ctx context.Context, session authn.Session, id string, key string, gracePeriod time.Duration)) *Service_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Duration
+		if args[4] != nil {
+			arg4 = args[4].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RotateSecret_Call) Return(client clients.Client, err error) *Service_RotateSecret_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *Service_RotateSecret_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, key string, gracePeriod time.Duration) (clients.Client, error)) *Service_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetParentGroup provides a mock function for the type Service
 func (_mock *Service) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	ret := _mock.Called(ctx, session, parentGroupID, id)
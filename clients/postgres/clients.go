@@ -92,14 +92,24 @@ func (repo *clientRepo) Save(ctx context.Context, cls ...clients.Client) ([]clie
 }
 
 func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key, id string, prefix authn.AuthPrefix) (clients.Client, error) {
-	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, metadata, private_metadata, created_at, updated_at, updated_by, status
+	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, previous_secret, previous_secret_expires_at, cert_fingerprint, cert_subject, metadata, private_metadata, created_at, updated_at, updated_by, status
         FROM clients
-        WHERE secret = :secret AND status = %d`, clients.EnabledStatus)
+        WHERE status = %d`, clients.EnabledStatus)
+	// The secret replaced by the rotation is accepted until it expires, but
+	// the client with the matching current secret takes precedence.
+	secretQuery := ` AND (secret = :secret OR (previous_secret <> '' AND previous_secret = :secret AND previous_secret_expires_at > NOW()))`
 	switch prefix {
 	case authn.DomainAuth:
-		q += " AND domain_id = :domain_id"
+		q += secretQuery + " AND domain_id = :domain_id ORDER BY secret = :secret DESC LIMIT 1"
 	case authn.BasicAuth:
-		q += " AND id = :id"
+		q += secretQuery + " AND id = :id"
+	case authn.CertAuth:
+		// The certificate bound by the subject only matches any certificate
+		// with the same subject issued by the trusted CA. The fingerprint match
+		// takes precedence, while the subject bound in several domains is ambiguous.
+		q += ` AND ((cert_fingerprint <> '' AND cert_fingerprint = :cert_fingerprint) OR
+			(cert_fingerprint = '' AND cert_subject <> '' AND cert_subject = :cert_subject))
+			ORDER BY cert_fingerprint <> '' DESC LIMIT 2`
 	default:
 		return clients.Client{}, repoerr.ErrNotFound
 	}
@@ -109,6 +119,12 @@ func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key, id string, pr
 		Domain: id,
 		ID:     id,
 	}
+	if prefix == authn.CertAuth {
+		dbc = DBClient{
+			CertFingerprint: id,
+			CertSubject:     key,
+		}
+	}
 
 	rows, err := repo.DB.NamedQueryContext(ctx, q, dbc)
 	if err != nil {
@@ -121,6 +137,9 @@ func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key, id string, pr
 		if err = rows.StructScan(&dbc); err != nil {
 			return clients.Client{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
 		}
+		if prefix == authn.CertAuth && dbc.CertFingerprint == "" && rows.Next() {
+			return clients.Client{}, repoerr.ErrNotFound
+		}
 
 		client, err := ToClient(dbc)
 		if err != nil {
@@ -174,13 +193,29 @@ func (repo *clientRepo) UpdateIdentity(ctx context.Context, client clients.Clien
 }
 
 func (repo *clientRepo) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET secret = :secret, updated_at = :updated_at, updated_by = :updated_by
+	q := `UPDATE clients SET secret = :secret, previous_secret = '', previous_secret_expires_at = NULL, updated_at = :updated_at, updated_by = :updated_by
         WHERE id = :id AND status = :status
         RETURNING id, name, tags, identity, metadata, private_metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
+func (repo *clientRepo) RotateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET previous_secret = secret, previous_secret_expires_at = :previous_secret_expires_at, secret = :secret, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status
+        RETURNING id, name, tags, identity, secret, previous_secret, previous_secret_expires_at, metadata, private_metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) UpdateCertificate(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET cert_fingerprint = :cert_fingerprint, cert_subject = :cert_subject, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id
+        RETURNING id, name, tags, identity, cert_fingerprint, cert_subject, metadata, private_metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
+
+	return repo.update(ctx, client, q)
+}
+
 func (repo *clientRepo) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
 	q := `UPDATE clients SET status = :status, updated_at = :updated_at, updated_by = :updated_by
 		WHERE id = :id
@@ -389,7 +424,7 @@ func (repo *clientRepo) RetrieveByIDWithRoles(ctx context.Context, id, memberID
 }
 
 func (repo *clientRepo) RetrieveByID(ctx context.Context, id string) (clients.Client, error) {
-	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, metadata, private_metadata, created_at, updated_at, updated_by, status
+	q := `SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, previous_secret, previous_secret_expires_at, cert_fingerprint, cert_subject, metadata, private_metadata, created_at, updated_at, updated_by, status
         FROM clients WHERE id = :id`
 
 	dbc := DBClient{
@@ -1030,6 +1065,35 @@ func (repo *clientRepo) Delete(ctx context.Context, clientIDs ...string) error {
 	return nil
 }
 
+func (repo *clientRepo) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	q := "SELECT domain_id, COUNT(*) AS total FROM clients GROUP BY domain_id"
+	if len(domainIDs) > 0 {
+		q = "SELECT domain_id, COUNT(*) AS total FROM clients WHERE domain_id = ANY(:domain_ids) GROUP BY domain_id"
+	}
+	params := map[string]any{
+		"domain_ids": domainIDs,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+	for rows.Next() {
+		var c struct {
+			DomainID string `db:"domain_id"`
+			Total    uint64 `db:"total"`
+		}
+		if err := rows.StructScan(&c); err != nil {
+			return nil, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+		}
+		counts[c.DomainID] = c.Total
+	}
+
+	return counts, nil
+}
+
 type DBClient struct {
 	ID                        string           `db:"id"`
 	Name                      string           `db:"name,omitempty"`
@@ -1038,6 +1102,10 @@ type DBClient struct {
 	Domain                    string           `db:"domain_id"`
 	ParentGroup               sql.NullString   `db:"parent_group_id,omitempty"`
 	Secret                    string           `db:"secret"`
+	PreviousSecret            string           `db:"previous_secret"`
+	PreviousSecretExpiresAt   sql.NullTime     `db:"previous_secret_expires_at"`
+	CertFingerprint           string           `db:"cert_fingerprint"`
+	CertSubject               string           `db:"cert_subject"`
 	Metadata                  []byte           `db:"metadata,omitempty"`
 	PrivateMetadata           []byte           `db:"private_metadata,omitempty"`
 	CreatedAt                 time.Time        `db:"created_at,omitempty"`
@@ -1088,20 +1156,29 @@ func ToDBClient(c clients.Client) (DBClient, error) {
 		updatedAt = sql.NullTime{Time: c.UpdatedAt, Valid: true}
 	}
 
+	var previousSecretExpiresAt sql.NullTime
+	if !c.Credentials.SecretRotation.ExpiresAt.IsZero() {
+		previousSecretExpiresAt = sql.NullTime{Time: c.Credentials.SecretRotation.ExpiresAt, Valid: true}
+	}
+
 	return DBClient{
-		ID:              c.ID,
-		Name:            c.Name,
-		Tags:            tags,
-		Domain:          c.Domain,
-		ParentGroup:     toNullString(c.ParentGroup),
-		Identity:        c.Credentials.Identity,
-		Secret:          c.Credentials.Secret,
-		Metadata:        metadata,
-		PrivateMetadata: privateMetadata,
-		CreatedAt:       c.CreatedAt,
-		UpdatedAt:       updatedAt,
-		UpdatedBy:       updatedBy,
-		Status:          c.Status,
+		ID:                      c.ID,
+		Name:                    c.Name,
+		Tags:                    tags,
+		Domain:                  c.Domain,
+		ParentGroup:             toNullString(c.ParentGroup),
+		Identity:                c.Credentials.Identity,
+		Secret:                  c.Credentials.Secret,
+		PreviousSecret:          c.Credentials.SecretRotation.PreviousSecret,
+		PreviousSecretExpiresAt: previousSecretExpiresAt,
+		CertFingerprint:         c.Credentials.Certificate.Fingerprint,
+		CertSubject:             c.Credentials.Certificate.Subject,
+		Metadata:                metadata,
+		PrivateMetadata:         privateMetadata,
+		CreatedAt:               c.CreatedAt,
+		UpdatedAt:               updatedAt,
+		UpdatedBy:               updatedBy,
+		Status:                  c.Status,
 	}, nil
 }
 
@@ -1142,6 +1219,18 @@ func ToClient(t DBClient) (clients.Client, error) {
 		connTypes = append(connTypes, connType)
 	}
 
+	// The expired previous secret is not accepted anymore, so it's not shown.
+	var rotation clients.SecretRotation
+	if t.PreviousSecretExpiresAt.Valid {
+		rotation = clients.SecretRotation{
+			PreviousSecret: t.PreviousSecret,
+			ExpiresAt:      t.PreviousSecretExpiresAt.Time.UTC(),
+		}
+	}
+	if !rotation.Active(time.Now()) {
+		rotation = clients.SecretRotation{}
+	}
+
 	var roles []roles.MemberRoleActions
 	if t.Roles != nil {
 		if err := json.Unmarshal(t.Roles, &roles); err != nil {
@@ -1158,6 +1247,11 @@ func ToClient(t DBClient) (clients.Client, error) {
 		Credentials: clients.Credentials{
 			Identity: t.Identity,
 			Secret:   t.Secret,
+			Certificate: clients.Certificate{
+				Fingerprint: t.CertFingerprint,
+				Subject:     t.CertSubject,
+			},
+			SecretRotation: rotation,
 		},
 		Metadata:                  metadata,
 		PrivateMetadata:           privateMetadata,
@@ -912,6 +912,184 @@ func TestUpdateSecret(t *testing.T) {
 	}
 }
 
+func TestRotateSecret(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	client1 := generateClient(t, clients.EnabledStatus, repo)
+	client2 := generateClient(t, clients.DisabledStatus, repo)
+	client3 := generateClient(t, clients.EnabledStatus, repo)
+
+	cases := []struct {
+		desc           string
+		client         clients.Client
+		previousSecret string
+		previousValid  bool
+		err            error
+	}{
+		{
+			desc: "for enabled client",
+			client: clients.Client{
+				ID: client1.ID,
+				Credentials: clients.Credentials{
+					Secret: "newpassword",
+					SecretRotation: clients.SecretRotation{
+						ExpiresAt: time.Now().UTC().Add(time.Hour).Truncate(time.Millisecond),
+					},
+				},
+			},
+			previousSecret: client1.Credentials.Secret,
+			previousValid:  true,
+			err:            nil,
+		},
+		{
+			desc: "for enabled client with expired grace period",
+			client: clients.Client{
+				ID: client3.ID,
+				Credentials: clients.Credentials{
+					Secret: "newpassword3",
+					SecretRotation: clients.SecretRotation{
+						ExpiresAt: time.Now().UTC().Add(-time.Hour).Truncate(time.Millisecond),
+					},
+				},
+			},
+			previousSecret: client3.Credentials.Secret,
+			previousValid:  false,
+			err:            nil,
+		},
+		{
+			desc: "for disabled client",
+			client: clients.Client{
+				ID: client2.ID,
+				Credentials: clients.Credentials{
+					Secret: "newpassword2",
+				},
+			},
+			err: repoerr.ErrNotFound,
+		},
+		{
+			desc: "for invalid client",
+			client: clients.Client{
+				ID: testsutil.GenerateUUID(t),
+				Credentials: clients.Credentials{
+					Secret: "newpassword",
+				},
+			},
+			err: repoerr.ErrNotFound,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			c.client.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+			c.client.UpdatedBy = testsutil.GenerateUUID(t)
+			_, err := repo.RotateSecret(context.Background(), c.client)
+			assert.True(t, errors.Contains(err, c.err), fmt.Sprintf("expected %s to contain %s\n", err, c.err))
+			if err != nil {
+				return
+			}
+			rc, err := repo.RetrieveBySecret(context.Background(), c.client.Credentials.Secret, c.client.ID, authn.BasicAuth)
+			require.Nil(t, err, fmt.Sprintf("retrieve client by new secret unexpected error: %s", err))
+			assert.Equal(t, c.client.Credentials.Secret, rc.Credentials.Secret)
+			assert.Equal(t, c.client.UpdatedAt, rc.UpdatedAt)
+			assert.Equal(t, c.client.UpdatedBy, rc.UpdatedBy)
+
+			rc, err = repo.RetrieveBySecret(context.Background(), c.previousSecret, c.client.ID, authn.BasicAuth)
+			if c.previousValid {
+				require.Nil(t, err, fmt.Sprintf("retrieve client by previous secret unexpected error: %s", err))
+				assert.Equal(t, c.client.ID, rc.ID)
+				assert.Equal(t, c.client.Credentials.SecretRotation.ExpiresAt, rc.Credentials.SecretRotation.ExpiresAt)
+			} else {
+				assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s to contain %s\n", err, repoerr.ErrNotFound))
+			}
+		})
+	}
+}
+
+func TestRetrieveByCertificate(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	bind := func(domainID string, cert clients.Certificate) (clients.Client, error) {
+		client := clients.Client{
+			ID:     testsutil.GenerateUUID(t),
+			Domain: domainID,
+			Credentials: clients.Credentials{
+				Identity: namegen.Generate() + emailSuffix,
+				Secret:   testsutil.GenerateUUID(t),
+			},
+			Status:    clients.EnabledStatus,
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+		_, err := repo.Save(context.Background(), client)
+		require.Nil(t, err, fmt.Sprintf("add new client: expected nil got %s\n", err))
+		client.Credentials.Certificate = cert
+		client.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+		return repo.UpdateCertificate(context.Background(), client)
+	}
+
+	domainID := testsutil.GenerateUUID(t)
+	fpClient, err := bind(domainID, clients.Certificate{Fingerprint: "fingerprint", Subject: "CN=device"})
+	require.Nil(t, err, fmt.Sprintf("bind certificate unexpected error: %s", err))
+	subjectClient, err := bind(domainID, clients.Certificate{Subject: "CN=device"})
+	require.Nil(t, err, fmt.Sprintf("bind certificate subject unexpected error: %s", err))
+	_, err = bind(domainID, clients.Certificate{Subject: "CN=device"})
+	assert.True(t, errors.Contains(err, repoerr.ErrConflict), fmt.Sprintf("bind the subject twice in the domain: expected %s got %s\n", repoerr.ErrConflict, err))
+	for range 2 {
+		_, err = bind(testsutil.GenerateUUID(t), clients.Certificate{Subject: "CN=shared"})
+		require.Nil(t, err, fmt.Sprintf("bind certificate subject in other domain unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc        string
+		fingerprint string
+		subject     string
+		response    clients.Client
+		err         error
+	}{
+		{
+			desc:        "retrieve by fingerprint over the subject match",
+			fingerprint: "fingerprint",
+			subject:     "CN=device",
+			response:    fpClient,
+			err:         nil,
+		},
+		{
+			desc:        "retrieve by subject",
+			fingerprint: "other-fingerprint",
+			subject:     "CN=device",
+			response:    subjectClient,
+			err:         nil,
+		},
+		{
+			desc:        "retrieve by subject bound in several domains",
+			fingerprint: "other-fingerprint",
+			subject:     "CN=shared",
+			err:         repoerr.ErrNotFound,
+		},
+		{
+			desc:        "retrieve by unknown certificate",
+			fingerprint: "other-fingerprint",
+			subject:     "CN=other",
+			err:         repoerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := repo.RetrieveBySecret(context.Background(), tc.subject, tc.fingerprint, authn.CertAuth)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.response.ID, res.ID, fmt.Sprintf("%s: expected client %s got %s\n", tc.desc, tc.response.ID, res.ID))
+		})
+	}
+}
+
 func TestChangeStatus(t *testing.T) {
 	t.Cleanup(func() {
 		_, err := db.Exec("DELETE FROM clients")
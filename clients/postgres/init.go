@@ -85,6 +85,45 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`ALTER TABLE clients DROP COLUMN private_metadata;`,
 				},
 			},
+			{
+				Id: "clients_05",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS cert_fingerprint VARCHAR(64) NOT NULL DEFAULT '';`,
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS cert_subject TEXT NOT NULL DEFAULT '';`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS idx_clients_cert_fingerprint ON clients (cert_fingerprint) WHERE cert_fingerprint <> '';`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS idx_clients_cert_subject ON clients (cert_subject) WHERE cert_fingerprint = '' AND cert_subject <> '';`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_clients_cert_subject;`,
+					`DROP INDEX IF EXISTS idx_clients_cert_fingerprint;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS cert_subject;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS cert_fingerprint;`,
+				},
+			},
+			{
+				Id: "clients_06",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS previous_secret VARCHAR(4096) NOT NULL DEFAULT '';`,
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS previous_secret_expires_at TIMESTAMPTZ;`,
+					`CREATE INDEX IF NOT EXISTS idx_clients_previous_secret ON clients (previous_secret) WHERE previous_secret <> '';`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_clients_previous_secret;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS previous_secret_expires_at;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS previous_secret;`,
+				},
+			},
+			{
+				Id: "clients_07",
+				Up: []string{
+					`DROP INDEX IF EXISTS idx_clients_cert_subject;`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS idx_clients_domain_cert_subject ON clients (domain_id, cert_subject) WHERE cert_fingerprint = '' AND cert_subject <> '';`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_clients_domain_cert_subject;`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS idx_clients_cert_subject ON clients (cert_subject) WHERE cert_fingerprint = '' AND cert_subject <> '';`,
+				},
+			},
 		},
 	}
 
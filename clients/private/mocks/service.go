@@ -165,6 +165,63 @@ func (_c *Service_Authenticate_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// DeleteDomainClients provides a mock function for the type Service
+func (_mock *Service) DeleteDomainClients(ctx context.Context, domainID string) error {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDomainClients")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_DeleteDomainClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomainClients'
+type Service_DeleteDomainClients_Call struct {
+	*mock.Call
+}
+
+// DeleteDomainClients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Service_Expecter) DeleteDomainClients(ctx interface{}, domainID interface{}) *Service_DeleteDomainClients_Call {
+	return &Service_DeleteDomainClients_Call{Call: _e.mock.On("DeleteDomainClients", ctx, domainID)}
+}
+
+func (_c *Service_DeleteDomainClients_Call) Run(run func(ctx context.Context, domainID string)) *Service_DeleteDomainClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DeleteDomainClients_Call) Return(err error) *Service_DeleteDomainClients_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_DeleteDomainClients_Call) RunAndReturn(run func(ctx context.Context, domainID string) error) *Service_DeleteDomainClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveChannelConnections provides a mock function for the type Service
 func (_mock *Service) RemoveChannelConnections(ctx context.Context, channelID string) error {
 	ret := _mock.Called(ctx, channelID)
@@ -11,6 +11,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/roles"
 )
 
 type Service interface {
@@ -28,8 +29,13 @@ type Service interface {
 	RemoveChannelConnections(ctx context.Context, channelID string) error
 
 	UnsetParentGroupFromClient(ctx context.Context, parentGroupID string) error
+
+	// DeleteDomainClients removes all the clients of the domain together with their roles and policies.
+	DeleteDomainClients(ctx context.Context, domainID string) error
 }
 
+const defLimit = uint64(100)
+
 var _ Service = (*service)(nil)
 
 func New(repo clients.Repository, cache clients.Cache, evaluator policies.Evaluator, policy policies.Service) Service {
@@ -61,7 +67,15 @@ func (svc service) Authenticate(ctx context.Context, token string) (string, erro
 	if err != nil {
 		return "", errors.Wrap(svcerr.ErrAuthorization, err)
 	}
-	if err := svc.cache.Save(ctx, token, client.ID); err != nil {
+
+	// The secret replaced by the rotation is cached no longer than it's accepted.
+	switch {
+	case prefix != authn.CertAuth && key != client.Credentials.Secret:
+		err = svc.cache.SaveUntil(ctx, token, client.ID, client.Credentials.SecretRotation.ExpiresAt)
+	default:
+		err = svc.cache.Save(ctx, token, client.ID)
+	}
+	if err != nil {
 		return "", errors.Wrap(svcerr.ErrAuthorization, err)
 	}
 
@@ -123,3 +137,49 @@ func (svc service) UnsetParentGroupFromClient(ctx context.Context, parentGroupID
 	}
 	return nil
 }
+
+func (svc service) DeleteDomainClients(ctx context.Context, domainID string) error {
+	pm := clients.Page{Domain: domainID, Status: clients.AllStatus, Limit: defLimit}
+	for {
+		cp, err := svc.repo.RetrieveAll(ctx, pm)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		if len(cp.Clients) == 0 {
+			return nil
+		}
+
+		ids := []string{}
+		for _, c := range cp.Clients {
+			ids = append(ids, c.ID)
+		}
+		if err := roles.RemoveEntitiesRolesPolicies(ctx, svc.repo, svc.policy, policies.ClientType, domainID, ids); err != nil {
+			return errors.Wrap(svcerr.ErrDeletePolicies, err)
+		}
+		for _, id := range ids {
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.ClientType,
+					Subject:     id,
+				},
+				{
+					ObjectType: policies.ClientType,
+					Object:     id,
+				},
+			}
+			for _, fp := range filterDeletePolicies {
+				if err := svc.policy.DeletePolicyFilter(ctx, fp); err != nil {
+					return errors.Wrap(svcerr.ErrDeletePolicies, err)
+				}
+			}
+			if err := svc.cache.Remove(ctx, id); err != nil {
+				return errors.Wrap(svcerr.ErrRemoveEntity, err)
+			}
+		}
+
+		// Client roles and connections are removed by the database cascade.
+		if err := svc.repo.Delete(ctx, ids...); err != nil {
+			return errors.Wrap(svcerr.ErrRemoveEntity, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ package clients
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	smq "github.com/absmach/supermq"
@@ -11,7 +12,9 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/pkg/authn"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
@@ -20,6 +23,7 @@ import (
 
 var (
 	errRollbackRepo        = errors.New("failed to rollback repo")
+	errReleaseQuota        = errors.New("failed to release domain quota")
 	errSetParentGroup      = errors.NewRequestError("client already have parent")
 	errSetSameParentGroup  = errors.NewRequestError("client already assigned to the parent group")
 	errParentGroupDomainID = errors.NewRequestError("parent group has invalid domain id")
@@ -32,13 +36,18 @@ type service struct {
 	policy     policies.Service
 	channels   grpcChannelsV1.ChannelsServiceClient
 	groups     grpcGroupsV1.GroupsServiceClient
+	quotas     pkgDomains.Quotas
+	logger     *slog.Logger
 	cache      Cache
 	idProvider smq.IDProvider
+	// secretGracePeriod is the default time the secret replaced by the
+	// rotation is still accepted for.
+	secretGracePeriod time.Duration
 	roles.ProvisionManageService
 }
 
 // NewService returns a new Clients service implementation.
-func NewService(repo Repository, policy policies.Service, cache Cache, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, idProvider smq.IDProvider, sIDProvider smq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func NewService(repo Repository, policy policies.Service, cache Cache, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, quotas pkgDomains.Quotas, idProvider smq.IDProvider, sIDProvider smq.IDProvider, secretGracePeriod time.Duration, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action, logger *slog.Logger) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(policies.ClientType, repo, policy, sIDProvider, availableActions, builtInRoles)
 	if err != nil {
 		return service{}, err
@@ -48,8 +57,11 @@ func NewService(repo Repository, policy policies.Service, cache Cache, channels
 		policy:                 policy,
 		channels:               channels,
 		groups:                 groups,
+		quotas:                 quotas,
+		logger:                 logger,
 		cache:                  cache,
 		idProvider:             idProvider,
+		secretGracePeriod:      secretGracePeriod,
 		ProvisionManageService: rpms,
 	}, nil
 }
@@ -79,6 +91,21 @@ func (svc service) CreateClients(ctx context.Context, session authn.Session, cls
 		clients = append(clients, c)
 	}
 
+	n := uint64(len(clients))
+	if err := svc.quotas.Reserve(ctx, session.DomainID, domains.ClientsResource, n); err != nil {
+		if errors.Contains(err, svcerr.ErrQuotaExceeded) {
+			return []Client{}, []roles.RoleProvision{}, err
+		}
+		return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRelease := svc.quotas.Release(ctx, session.DomainID, domains.ClientsResource, n); errRelease != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errReleaseQuota, errRelease))
+			}
+		}
+	}()
+
 	newClients, err := svc.repo.Save(ctx, clients...)
 	if err != nil {
 		return []Client{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
@@ -208,6 +235,111 @@ func (svc service) UpdateSecret(ctx context.Context, session authn.Session, id,
 	if err != nil {
 		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
+
+	// The replaced secret must not be accepted from the cache.
+	if err := svc.cache.Remove(ctx, client.ID); err != nil {
+		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return client, nil
+}
+
+func (svc service) RotateSecret(ctx context.Context, session authn.Session, id, key string, gracePeriod time.Duration) (Client, error) {
+	if gracePeriod == 0 {
+		gracePeriod = svc.secretGracePeriod
+	}
+	if gracePeriod <= 0 {
+		return Client{}, ErrInvalidGracePeriod
+	}
+	if key == "" {
+		var err error
+		if key, err = svc.idProvider.ID(); err != nil {
+			return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+	}
+
+	now := time.Now().UTC()
+	client := Client{
+		ID: id,
+		Credentials: Credentials{
+			Secret: key,
+			SecretRotation: SecretRotation{
+				ExpiresAt: now.Add(gracePeriod),
+			},
+		},
+		UpdatedAt: now,
+		UpdatedBy: session.UserID,
+		Status:    EnabledStatus,
+	}
+	client, err := svc.repo.RotateSecret(ctx, client)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	// The previous secret may be cached longer than it's accepted for.
+	if err := svc.cache.Remove(ctx, client.ID); err != nil {
+		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return client, nil
+}
+
+func (svc service) BindCert(ctx context.Context, session authn.Session, id string, cert Certificate) (Client, error) {
+	client, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if !client.Credentials.Certificate.IsZero() {
+		return Client{}, ErrCertAlreadyBound
+	}
+
+	return svc.updateCertificate(ctx, session, id, cert)
+}
+
+func (svc service) RotateCert(ctx context.Context, session authn.Session, id string, cert Certificate) (Client, error) {
+	client, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if client.Credentials.Certificate.IsZero() {
+		return Client{}, ErrCertNotBound
+	}
+
+	return svc.updateCertificate(ctx, session, id, cert)
+}
+
+func (svc service) RevokeCert(ctx context.Context, session authn.Session, id string) (Client, error) {
+	client, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if client.Credentials.Certificate.IsZero() {
+		return Client{}, ErrCertNotBound
+	}
+
+	return svc.updateCertificate(ctx, session, id, Certificate{})
+}
+
+// updateCertificate stores the client certificate and removes the client from
+// the cache, so the replaced certificate can't be used for authentication.
+func (svc service) updateCertificate(ctx context.Context, session authn.Session, id string, cert Certificate) (Client, error) {
+	client := Client{
+		ID: id,
+		Credentials: Credentials{
+			Certificate: cert,
+		},
+		UpdatedAt: time.Now().UTC(),
+		UpdatedBy: session.UserID,
+	}
+	client, err := svc.repo.UpdateCertificate(ctx, client)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	if err := svc.cache.Remove(ctx, client.ID); err != nil {
+		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
 	return client, nil
 }
 
@@ -381,9 +513,25 @@ func (svc service) Delete(ctx context.Context, session authn.Session, id string)
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
+	svc.releaseQuota(ctx, session.DomainID)
+
 	return nil
 }
 
+// releaseQuota releases the domain quota of the removed client. Since the
+// client is already removed, the failure doesn't fail the request, and the
+// domain usage is reconciled with the domain clients instead.
+func (svc service) releaseQuota(ctx context.Context, domainID string) {
+	err := svc.quotas.Release(ctx, domainID, domains.ClientsResource, 1)
+	if err == nil {
+		return
+	}
+	svc.logger.Warn("failed to release domain quota", slog.String("domain_id", domainID), slog.Any("error", err))
+	if err := pkgDomains.ReconcileUsage(ctx, svc.quotas, domains.ClientsResource, svc.repo.CountByDomain, domainID); err != nil {
+		svc.logger.Error("failed to reconcile domain usage", slog.String("domain_id", domainID), slog.Any("error", err))
+	}
+}
+
 func (svc service) changeClientStatus(ctx context.Context, session authn.Session, client Client) (Client, error) {
 	dbClient, err := svc.repo.RetrieveByID(ctx, client.ID)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
@@ -14,9 +15,12 @@ import (
 	chmocks "github.com/absmach/supermq/channels/mocks"
 	"github.com/absmach/supermq/clients"
 	climocks "github.com/absmach/supermq/clients/mocks"
+	"github.com/absmach/supermq/domains"
 	gpmocks "github.com/absmach/supermq/groups/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
+	dmocks "github.com/absmach/supermq/pkg/domains/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -56,9 +60,10 @@ var (
 			},
 		},
 	}
-	validToken = "token"
-	validID    = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
-	wrongID    = testsutil.GenerateUUID(&testing.T{})
+	validToken        = "token"
+	validID           = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
+	wrongID           = testsutil.GenerateUUID(&testing.T{})
+	secretGracePeriod = time.Hour
 )
 
 var (
@@ -67,6 +72,7 @@ var (
 	repo         *climocks.Repository
 	chgRPCClient *chmocks.ChannelsServiceClient
 	gpgRPCClient *gpmocks.GroupsServiceClient
+	quotas       *dmocks.Quotas
 )
 
 func newService() clients.Service {
@@ -77,11 +83,12 @@ func newService() clients.Service {
 	repo = new(climocks.Repository)
 	chgRPCClient = new(chmocks.ChannelsServiceClient)
 	gpgRPCClient = new(gpmocks.GroupsServiceClient)
+	quotas = new(dmocks.Quotas)
 	availableActions := []roles.Action{}
 	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
 		clients.BuiltInRoleAdmin: availableActions,
 	}
-	tsv, _ := clients.NewService(repo, pService, cache, chgRPCClient, gpgRPCClient, idProvider, sidProvider, availableActions, builtInRoles)
+	tsv, _ := clients.NewService(repo, pService, cache, chgRPCClient, gpgRPCClient, quotas, idProvider, sidProvider, secretGracePeriod, availableActions, builtInRoles, smqlog.NewMock())
 	return tsv
 }
 
@@ -97,6 +104,7 @@ func TestCreateClients(t *testing.T) {
 		saveErr         error
 		addRoleErr      error
 		deleteErr       error
+		reserveErr      error
 		err             error
 	}{
 		{
@@ -308,6 +316,20 @@ func TestCreateClients(t *testing.T) {
 			deletePolicyErr: svcerr.ErrInvalidPolicy,
 			err:             repoerr.ErrConflict,
 		},
+		{
+			desc:       "create a new client with exceeded quota",
+			client:     client,
+			token:      validToken,
+			reserveErr: svcerr.ErrQuotaExceeded,
+			err:        svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:       "create a new client with failed to reserve quota",
+			client:     client,
+			token:      validToken,
+			reserveErr: svcerr.ErrMalformedEntity,
+			err:        svcerr.ErrCreateEntity,
+		},
 	}
 
 	for _, tc := range cases {
@@ -317,6 +339,8 @@ func TestCreateClients(t *testing.T) {
 			policyCall1 := pService.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePolicyErr)
 			repoCall1 := repo.On("AddRoles", context.Background(), mock.Anything).Return([]roles.RoleProvision{}, tc.addRoleErr)
 			repoCall2 := repo.On("Delete", context.Background(), mock.Anything).Return(tc.deleteErr)
+			quotasCall := quotas.On("Reserve", context.Background(), mock.Anything, domains.ClientsResource, uint64(1)).Return(tc.reserveErr)
+			quotasCall1 := quotas.On("Release", context.Background(), mock.Anything, domains.ClientsResource, uint64(1)).Return(nil)
 			expected, _, err := svc.CreateClients(context.Background(), smqauthn.Session{}, tc.client)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			if err == nil {
@@ -333,6 +357,8 @@ func TestCreateClients(t *testing.T) {
 			policyCall1.Unset()
 			repoCall1.Unset()
 			repoCall2.Unset()
+			quotasCall.Unset()
+			quotasCall1.Unset()
 		})
 	}
 }
@@ -737,10 +763,330 @@ func TestUpdateSecret(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("UpdateSecret", context.Background(), mock.Anything).Return(tc.updateSecretResponse, tc.updateErr)
+			cacheCall := cache.On("Remove", mock.Anything, tc.updateSecretResponse.ID).Return(nil)
 			updatedClient, err := svc.UpdateSecret(context.Background(), tc.session, tc.client.ID, tc.newSecret)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			assert.Equal(t, tc.updateSecretResponse, updatedClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.updateSecretResponse, updatedClient))
 			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRotateSecret(t *testing.T) {
+	svc := newService()
+
+	rotatedClient := clients.Client{
+		ID: client.ID,
+		Credentials: clients.Credentials{
+			Identity: client.Credentials.Identity,
+			Secret:   "newSecret",
+			SecretRotation: clients.SecretRotation{
+				ExpiresAt: time.Now().Add(secretGracePeriod),
+			},
+		},
+	}
+
+	cases := []struct {
+		desc                 string
+		id                   string
+		newSecret            string
+		gracePeriod          time.Duration
+		session              smqauthn.Session
+		rotateSecretResponse clients.Client
+		rotateErr            error
+		removeErr            error
+		err                  error
+	}{
+		{
+			desc:                 "rotate client secret successfully",
+			id:                   client.ID,
+			newSecret:            "newSecret",
+			gracePeriod:          time.Minute,
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			err:                  nil,
+		},
+		{
+			desc:                 "rotate client secret with default grace period",
+			id:                   client.ID,
+			newSecret:            "newSecret",
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			err:                  nil,
+		},
+		{
+			desc:                 "rotate client secret with generated secret",
+			id:                   client.ID,
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			err:                  nil,
+		},
+		{
+			desc:        "rotate client secret with negative grace period",
+			id:          client.ID,
+			newSecret:   "newSecret",
+			gracePeriod: -time.Minute,
+			session:     smqauthn.Session{UserID: validID},
+			err:         clients.ErrInvalidGracePeriod,
+		},
+		{
+			desc:      "rotate client secret with failed to update repo",
+			id:        client.ID,
+			newSecret: "newSecret",
+			session:   smqauthn.Session{UserID: validID},
+			rotateErr: repoerr.ErrNotFound,
+			err:       svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:                 "rotate client secret with failed to remove from cache",
+			id:                   client.ID,
+			newSecret:            "newSecret",
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			removeErr:            svcerr.ErrRemoveEntity,
+			err:                  svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RotateSecret", context.Background(), mock.Anything).Return(tc.rotateSecretResponse, tc.rotateErr)
+			cacheCall := cache.On("Remove", mock.Anything, tc.rotateSecretResponse.ID).Return(tc.removeErr)
+			rotatedClient, err := svc.RotateSecret(context.Background(), tc.session, tc.id, tc.newSecret, tc.gracePeriod)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.rotateSecretResponse, rotatedClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.rotateSecretResponse, rotatedClient))
+			}
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestBindCert(t *testing.T) {
+	svc := newService()
+
+	cert := clients.Certificate{Fingerprint: "fingerprint", Subject: "CN=client"}
+	certClient := client
+	certClient.Credentials.Certificate = cert
+
+	cases := []struct {
+		desc                 string
+		id                   string
+		cert                 clients.Certificate
+		session              smqauthn.Session
+		retrieveByIDResponse clients.Client
+		retrieveByIDErr      error
+		updateCertResponse   clients.Client
+		updateCertErr        error
+		removeErr            error
+		err                  error
+	}{
+		{
+			desc:                 "bind client certificate successfully",
+			id:                   client.ID,
+			cert:                 cert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: client,
+			updateCertResponse:   certClient,
+			err:                  nil,
+		},
+		{
+			desc:            "bind certificate to non-existing client",
+			id:              wrongID,
+			cert:            cert,
+			session:         smqauthn.Session{UserID: validID},
+			retrieveByIDErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrViewEntity,
+		},
+		{
+			desc:                 "bind certificate to client with bound certificate",
+			id:                   client.ID,
+			cert:                 cert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: certClient,
+			err:                  clients.ErrCertAlreadyBound,
+		},
+		{
+			desc:                 "bind client certificate with failed to update repo",
+			id:                   client.ID,
+			cert:                 cert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: client,
+			updateCertErr:        repoerr.ErrConflict,
+			err:                  svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:                 "bind client certificate with failed to remove from cache",
+			id:                   client.ID,
+			cert:                 cert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: client,
+			updateCertResponse:   certClient,
+			removeErr:            svcerr.ErrRemoveEntity,
+			err:                  svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveByIDResponse, tc.retrieveByIDErr)
+			repoCall1 := repo.On("UpdateCertificate", context.Background(), mock.Anything).Return(tc.updateCertResponse, tc.updateCertErr)
+			cacheCall := cache.On("Remove", mock.Anything, tc.updateCertResponse.ID).Return(tc.removeErr)
+			_, err := svc.BindCert(context.Background(), tc.session, tc.id, tc.cert)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				ok := repoCall1.Parent.AssertCalled(t, "UpdateCertificate", context.Background(), mock.MatchedBy(func(c clients.Client) bool {
+					return c.ID == tc.id && c.Credentials.Certificate == tc.cert
+				}))
+				assert.True(t, ok, fmt.Sprintf("UpdateCertificate was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRotateCert(t *testing.T) {
+	svc := newService()
+
+	cert := clients.Certificate{Fingerprint: "fingerprint", Subject: "CN=client"}
+	newCert := clients.Certificate{Fingerprint: "newfingerprint", Subject: "CN=client"}
+	certClient := client
+	certClient.Credentials.Certificate = cert
+	rotatedClient := client
+	rotatedClient.Credentials.Certificate = newCert
+
+	cases := []struct {
+		desc                 string
+		id                   string
+		cert                 clients.Certificate
+		session              smqauthn.Session
+		retrieveByIDResponse clients.Client
+		retrieveByIDErr      error
+		updateCertResponse   clients.Client
+		updateCertErr        error
+		err                  error
+	}{
+		{
+			desc:                 "rotate client certificate successfully",
+			id:                   client.ID,
+			cert:                 newCert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: certClient,
+			updateCertResponse:   rotatedClient,
+			err:                  nil,
+		},
+		{
+			desc:            "rotate certificate of non-existing client",
+			id:              wrongID,
+			cert:            newCert,
+			session:         smqauthn.Session{UserID: validID},
+			retrieveByIDErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrViewEntity,
+		},
+		{
+			desc:                 "rotate certificate of client without bound certificate",
+			id:                   client.ID,
+			cert:                 newCert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: client,
+			err:                  clients.ErrCertNotBound,
+		},
+		{
+			desc:                 "rotate client certificate with failed to update repo",
+			id:                   client.ID,
+			cert:                 newCert,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: certClient,
+			updateCertErr:        repoerr.ErrConflict,
+			err:                  svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveByIDResponse, tc.retrieveByIDErr)
+			repoCall1 := repo.On("UpdateCertificate", context.Background(), mock.Anything).Return(tc.updateCertResponse, tc.updateCertErr)
+			cacheCall := cache.On("Remove", mock.Anything, tc.updateCertResponse.ID).Return(nil)
+			updatedClient, err := svc.RotateCert(context.Background(), tc.session, tc.id, tc.cert)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.updateCertResponse, updatedClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.updateCertResponse, updatedClient))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRevokeCert(t *testing.T) {
+	svc := newService()
+
+	certClient := client
+	certClient.Credentials.Certificate = clients.Certificate{Fingerprint: "fingerprint", Subject: "CN=client"}
+
+	cases := []struct {
+		desc                 string
+		id                   string
+		session              smqauthn.Session
+		retrieveByIDResponse clients.Client
+		retrieveByIDErr      error
+		updateCertResponse   clients.Client
+		updateCertErr        error
+		err                  error
+	}{
+		{
+			desc:                 "revoke client certificate successfully",
+			id:                   client.ID,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: certClient,
+			updateCertResponse:   client,
+			err:                  nil,
+		},
+		{
+			desc:            "revoke certificate of non-existing client",
+			id:              wrongID,
+			session:         smqauthn.Session{UserID: validID},
+			retrieveByIDErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrViewEntity,
+		},
+		{
+			desc:                 "revoke certificate of client without bound certificate",
+			id:                   client.ID,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: client,
+			err:                  clients.ErrCertNotBound,
+		},
+		{
+			desc:                 "revoke client certificate with failed to update repo",
+			id:                   client.ID,
+			session:              smqauthn.Session{UserID: validID},
+			retrieveByIDResponse: certClient,
+			updateCertErr:        repoerr.ErrNotFound,
+			err:                  svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveByIDResponse, tc.retrieveByIDErr)
+			repoCall1 := repo.On("UpdateCertificate", context.Background(), mock.Anything).Return(tc.updateCertResponse, tc.updateCertErr)
+			cacheCall := cache.On("Remove", mock.Anything, tc.updateCertResponse.ID).Return(nil)
+			_, err := svc.RevokeCert(context.Background(), tc.session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				ok := repoCall1.Parent.AssertCalled(t, "UpdateCertificate", context.Background(), mock.MatchedBy(func(c clients.Client) bool {
+					return c.ID == tc.id && c.Credentials.Certificate.IsZero()
+				}))
+				assert.True(t, ok, fmt.Sprintf("UpdateCertificate was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			cacheCall.Unset()
 		})
 	}
 }
@@ -919,6 +1265,10 @@ func TestDelete(t *testing.T) {
 		deletePoliciesErr    error
 		removeErr            error
 		deleteErr            error
+		releaseErr           error
+		countErr             error
+		reconcileErr         error
+		reconciled           bool
 		err                  error
 	}{
 		{
@@ -969,6 +1319,28 @@ func TestDelete(t *testing.T) {
 			deleteErr: svcerr.ErrNotFound,
 			err:       svcerr.ErrRemoveEntity,
 		},
+		{
+			desc:       "Delete client with failed to release quota",
+			clientID:   client.ID,
+			releaseErr: svcerr.ErrMalformedEntity,
+			reconciled: true,
+			err:        nil,
+		},
+		{
+			desc:       "Delete client with failed to release quota and count clients",
+			clientID:   client.ID,
+			releaseErr: svcerr.ErrMalformedEntity,
+			countErr:   svcerr.ErrViewEntity,
+			err:        nil,
+		},
+		{
+			desc:         "Delete client with failed to release quota and reconcile usage",
+			clientID:     client.ID,
+			releaseErr:   svcerr.ErrMalformedEntity,
+			reconcileErr: svcerr.ErrUpdateEntity,
+			reconciled:   true,
+			err:          nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -981,7 +1353,14 @@ func TestDelete(t *testing.T) {
 			policyCall1 := pService.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			policyCall2 := pService.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			repoCall4 := repo.On("Delete", context.Background(), []string{tc.clientID}).Return(tc.deleteErr)
+			quotasCall := quotas.On("Release", context.Background(), mock.Anything, domains.ClientsResource, uint64(1)).Return(tc.releaseErr)
+			countCall := repo.On("CountByDomain", context.Background(), []string{""}).Return(map[string]uint64{}, tc.countErr)
+			reconciled := false
+			reconcileCall := quotas.On("Reconcile", context.Background(), "", domains.ClientsResource, uint64(0)).Run(func(mock.Arguments) {
+				reconciled = true
+			}).Return(tc.reconcileErr)
 			err := svc.Delete(context.Background(), smqauthn.Session{}, tc.clientID)
+			assert.Equal(t, tc.reconciled, reconciled, fmt.Sprintf("%s: expected reconciled %t got %t", tc.desc, tc.reconciled, reconciled))
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			repoCall.Unset()
 			repoCall1.Unset()
@@ -991,6 +1370,9 @@ func TestDelete(t *testing.T) {
 			repoCall3.Unset()
 			repoCall4.Unset()
 			policyCall2.Unset()
+			quotasCall.Unset()
+			countCall.Unset()
+			reconcileCall.Unset()
 		})
 	}
 }
@@ -21,6 +21,7 @@ import (
 	tokengrpcapi "github.com/absmach/supermq/auth/api/grpc/token"
 	httpapi "github.com/absmach/supermq/auth/api/http"
 	"github.com/absmach/supermq/auth/cache"
+	"github.com/absmach/supermq/auth/events"
 	"github.com/absmach/supermq/auth/hasher"
 	"github.com/absmach/supermq/auth/middleware"
 	apostgres "github.com/absmach/supermq/auth/postgres"
@@ -70,6 +71,10 @@ type config struct {
 	KeyAlgorithm                  string        `env:"SMQ_AUTH_KEYS_ALGORITHM"                    envDefault:"EdDSA"`
 	ActiveKeyPath                 string        `env:"SMQ_AUTH_KEYS_ACTIVE_KEY_PATH"              envDefault:"./keys/active.key"`
 	RetiringKeyPath               string        `env:"SMQ_AUTH_KEYS_RETIRING_KEY_PATH"            envDefault:""`
+	KeyRotationInterval           time.Duration `env:"SMQ_AUTH_KEYS_ROTATION_INTERVAL"            envDefault:"0"`
+	KeyPublishAhead               time.Duration `env:"SMQ_AUTH_KEYS_ROTATION_PUBLISH_AHEAD"       envDefault:"1h"`
+	KeySyncInterval               time.Duration `env:"SMQ_AUTH_KEYS_ROTATION_SYNC_INTERVAL"       envDefault:"1m"`
+	KeysEncryptionKey             string        `env:"SMQ_AUTH_KEYS_ENCRYPTION_KEY"               envDefault:""`
 	InvitationDuration            time.Duration `env:"SMQ_AUTH_INVITATION_DURATION"               envDefault:"168h"`
 	SpicedbHost                   string        `env:"SMQ_SPICEDB_HOST"                           envDefault:"localhost"`
 	SpicedbPort                   string        `env:"SMQ_SPICEDB_PORT"                           envDefault:"50051"`
@@ -81,6 +86,8 @@ type config struct {
 	CacheKeyDuration              time.Duration `env:"SMQ_AUTH_CACHE_KEY_DURATION"                envDefault:"10m"`
 	JWKSCacheMaxAge               int           `env:"SMQ_AUTH_JWKS_CACHE_MAX_AGE"                envDefault:"900"`
 	JWKSCacheStaleWhileRevalidate int           `env:"SMQ_AUTH_JWKS_CACHE_STALE_WHILE_REVALIDATE" envDefault:"60"`
+	PATUsageFlushInterval         time.Duration `env:"SMQ_AUTH_PAT_USAGE_FLUSH_INTERVAL"          envDefault:"10s"`
+	PATUsageMaxPending            int           `env:"SMQ_AUTH_PAT_USAGE_MAX_PENDING"             envDefault:"1000"`
 }
 
 func main() {
@@ -174,6 +181,21 @@ func main() {
 			exitCode = 1
 			return
 		}
+	case cfg.KeyRotationInterval > 0:
+		rotationCfg := asymmetric.RotationConfig{
+			Interval:         cfg.KeyRotationInterval,
+			PublishAhead:     cfg.KeyPublishAhead,
+			MaxTokenLifetime: max(cfg.AccessDuration, cfg.RefreshDuration, cfg.InvitationDuration),
+			SyncInterval:     cfg.KeySyncInterval,
+			EncryptionKey:    cfg.KeysEncryptionKey,
+		}
+		signingKeysRepo := apostgres.NewSigningKeyRepo(pgclient.NewDatabase(db, dbConfig, tracer))
+		tokenizer, err = asymmetric.NewRotatingTokenizer(ctx, signingKeysRepo, rotationCfg, idProvider, time.Now, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create rotating asymmetric key manager: %s", err.Error()))
+			exitCode = 1
+			return
+		}
 	default:
 		tokenizer, err = asymmetric.NewTokenizer(cfg.ActiveKeyPath, cfg.RetiringKeyPath, idProvider, logger)
 		if err != nil {
@@ -183,7 +205,7 @@ func main() {
 		}
 	}
 
-	svc, err := newService(db, tracer, cfg, dbConfig, logger, spicedbclient, cacheclient, cfg.CacheKeyDuration, tokenizer, idProvider)
+	svc, err := newService(ctx, db, tracer, cfg, dbConfig, logger, spicedbclient, cacheclient, cfg.CacheKeyDuration, tokenizer, idProvider)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create service : %s\n", err.Error()))
 		exitCode = 1
@@ -272,6 +294,11 @@ func validateKeyConfig(isSymmetric bool, cfg config, l *slog.Logger) error {
 		return nil
 	}
 
+	// Keys are generated and stored in the database.
+	if cfg.KeyRotationInterval > 0 {
+		return nil
+	}
+
 	// Validate active key path
 	_, err := os.Stat(cfg.ActiveKeyPath)
 	if err != nil {
@@ -291,18 +318,27 @@ func validateKeyConfig(isSymmetric bool, cfg config, l *slog.Logger) error {
 	return nil
 }
 
-func newService(db *sqlx.DB, tracer trace.Tracer, cfg config, dbConfig pgclient.Config, logger *slog.Logger, spicedbClient *authzed.ClientWithExperimental, cacheClient *redis.Client, keyDuration time.Duration, tokenizer auth.Tokenizer, idProvider supermq.IDProvider) (auth.Service, error) {
+func newService(ctx context.Context, db *sqlx.DB, tracer trace.Tracer, cfg config, dbConfig pgclient.Config, logger *slog.Logger, spicedbClient *authzed.ClientWithExperimental, cacheClient *redis.Client, keyDuration time.Duration, tokenizer auth.Tokenizer, idProvider supermq.IDProvider) (auth.Service, error) {
+	mfaPoliciesCache := cache.NewMFAPolicyCache(cacheClient, keyDuration)
 	cache := cache.NewPatsCache(cacheClient, keyDuration)
 
 	database := pgclient.NewDatabase(db, dbConfig, tracer)
 	keysRepo := apostgres.New(database)
 	patsRepo := apostgres.NewPatRepo(database, cache)
+	sessionsRepo := apostgres.NewSessionRepo(database)
+	revocationsRepo := apostgres.NewRevocationRepo(database)
+	mfaPoliciesRepo := apostgres.NewMFAPolicyRepo(database, mfaPoliciesCache)
 	hasher := hasher.New()
+	usage := auth.NewPATUsageTracker(ctx, patsRepo, cfg.PATUsageFlushInterval, cfg.PATUsageMaxPending, logger)
 
 	pEvaluator := spicedb.NewPolicyEvaluator(spicedbClient, logger)
 	pService := spicedb.NewPolicyService(spicedbClient, logger)
 
-	svc := auth.New(keysRepo, patsRepo, nil, hasher, idProvider, tokenizer, pEvaluator, pService, cfg.AccessDuration, cfg.RefreshDuration, cfg.InvitationDuration)
+	svc := auth.New(keysRepo, patsRepo, sessionsRepo, revocationsRepo, mfaPoliciesRepo, nil, usage, hasher, idProvider, tokenizer, pEvaluator, pService, cfg.AccessDuration, cfg.RefreshDuration, cfg.InvitationDuration)
+	svc, err := events.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
+	if err != nil {
+		return nil, err
+	}
 	svc = middleware.NewLogging(svc, logger)
 	counter, latency := prometheus.MakeMetrics("auth", "api")
 	svc = middleware.NewMetrics(svc, counter, latency)
@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
@@ -29,6 +30,7 @@ import (
 	"github.com/absmach/supermq/channels/postgres"
 	pChannels "github.com/absmach/supermq/channels/private"
 	clientsOps "github.com/absmach/supermq/clients/operations"
+	"github.com/absmach/supermq/domains"
 	domainsOps "github.com/absmach/supermq/domains/operations"
 	dpostgres "github.com/absmach/supermq/domains/postgres"
 	groupsOps "github.com/absmach/supermq/groups/operations"
@@ -37,7 +39,9 @@ import (
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	externalAuthn "github.com/absmach/supermq/pkg/authn/external"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	"github.com/absmach/supermq/pkg/callout"
@@ -78,6 +82,8 @@ const (
 	envPrefixHTTP           = "SMQ_CHANNELS_HTTP_"
 	envPrefixGRPC           = "SMQ_CHANNELS_GRPC_"
 	envPrefixAuth           = "SMQ_AUTH_GRPC_"
+	envPrefixUsers          = "SMQ_USERS_GRPC_"
+	envPrefixExternal       = "SMQ_AUTHN_EXTERNAL_"
 	envPrefixClients        = "SMQ_CLIENTS_GRPC_"
 	envPrefixGroups         = "SMQ_GROUPS_GRPC_"
 	envPrefixDomains        = "SMQ_DOMAINS_GRPC_"
@@ -103,6 +109,7 @@ type config struct {
 	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"          envDefault:"schema.zed"`
 	AuthKeyAlgorithm    string        `env:"SMQ_AUTH_KEYS_ALGORITHM"          envDefault:"RS256"`
 	JWKSURL             string        `env:"SMQ_AUTH_JWKS_URL"                envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	ExternalIssuers     []string      `env:"SMQ_AUTHN_EXTERNAL_ISSUERS"       envDefault:""`
 	PermissionsFile     string        `env:"SMQ_PERMISSIONS_FILE"             envDefault:"permission.yaml"`
 }
 
@@ -190,9 +197,16 @@ func main() {
 	}
 	var authn smqauthn.Authentication
 	var authnClient grpcclient.Handler
+	var revocations *jwksAuthn.Revocations
 	switch {
 	case !isSymmetric:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, grpcCfg)
+		revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, grpcCfg, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, grpcCfg, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -210,6 +224,48 @@ func main() {
 		defer authnClient.Close()
 		logger.Info("AuthN successfully connected to auth gRPC server " + authnClient.Secure())
 	}
+	if len(cfg.ExternalIssuers) > 0 {
+		issuers := make(map[string]externalAuthn.Config, len(cfg.ExternalIssuers))
+		for _, name := range cfg.ExternalIssuers {
+			issuerCfg := externalAuthn.Config{}
+			if err := env.ParseWithOptions(&issuerCfg, env.Options{Prefix: envPrefixExternal + strings.ToUpper(name) + "_"}); err != nil {
+				logger.Error(fmt.Sprintf("failed to load %s external issuer configuration : %s", name, err))
+				exitCode = 1
+				return
+			}
+			issuers[name] = issuerCfg
+		}
+
+		usersClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&usersClientCfg, env.Options{Prefix: envPrefixUsers}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load users gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		usersClient, usersHandler, err := grpcclient.SetupUsersClient(ctx, usersClientCfg)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup users gRPC client: %s", err))
+			exitCode = 1
+			return
+		}
+		defer usersHandler.Close()
+
+		if revocations == nil {
+			revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, grpcCfg, logger)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+				exitCode = 1
+				return
+			}
+		}
+		authn, err = externalAuthn.NewAuthentication(authn, usersClient, issuers, revocations)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup external authentication: %s", err))
+			exitCode = 1
+			return
+		}
+		logger.Info("AuthN successfully set up external issuers " + strings.Join(cfg.ExternalIssuers, ", "))
+	}
 	authnMiddleware := smqauthn.NewAuthNMiddleware(authn)
 
 	domsGrpcCfg := grpcclient.Config{}
@@ -218,7 +274,7 @@ func main() {
 		exitCode = 1
 		return
 	}
-	domAuthz, _, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	domAuthz, domainsClient, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -296,7 +352,7 @@ func main() {
 	}
 
 	svc, psvc, err := newService(ctx, db, dbConfig, cache, authz, policyEvaluator, policyService,
-		cfg, tracer, clientsClient, groupsClient, domAuthz, logger, callout, permConfig)
+		cfg, tracer, clientsClient, groupsClient, domAuthz, domainsAuthz.NewQuotas(domainsClient), logger, callout, permConfig)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
@@ -306,7 +362,7 @@ func main() {
 	ddatabase := pg.NewDatabase(db, dbConfig, tracer)
 	drepo := dpostgres.NewRepository(ddatabase)
 
-	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
+	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, psvc.DeleteDomainChannels, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
 		logger.Error(fmt.Sprintf("failed to create domains event store : %s", err))
 		exitCode = 1
 		return
@@ -369,7 +425,7 @@ func main() {
 
 func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, cache channels.Cache, authz smqauthz.Authorization,
 	pe policies.Evaluator, ps policies.Service, cfg config, tracer trace.Tracer, clientsClient grpcClientsV1.ClientsServiceClient,
-	groupsClient grpcGroupsV1.GroupsServiceClient, da pkgDomains.Authorization, logger *slog.Logger, callout callout.Callout,
+	groupsClient grpcGroupsV1.GroupsServiceClient, da pkgDomains.Authorization, quotas pkgDomains.Quotas, logger *slog.Logger, callout callout.Callout,
 	permConfig *permissions.PermissionConfig,
 ) (channels.Service, pChannels.Service, error) {
 	database := pg.NewDatabase(db, dbConfig, tracer)
@@ -386,10 +442,17 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, cach
 		return nil, nil, err
 	}
 
-	svc, err := channels.New(repo, cache, ps, idp, clientsClient, groupsClient, sidp, availableActions, buildInRoles)
+	svc, err := channels.New(repo, cache, ps, idp, clientsClient, groupsClient, quotas, sidp, availableActions, buildInRoles, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	// The domain usage may have drifted from the channels, or have not been
+	// counted at all before the domain quotas were introduced.
+	go func() {
+		if err := pkgDomains.ReconcileUsage(ctx, quotas, domains.ChannelsResource, repo.CountByDomain); err != nil {
+			logger.Warn(fmt.Sprintf("failed to reconcile domain channels usage : %s", err))
+		}
+	}()
 
 	svc, err = events.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
 	if err != nil {
@@ -44,6 +44,7 @@ func main() {
 	configCmd := cli.NewConfigCmd()
 	invitationsCmd := cli.NewInvitationsCmd()
 	journalCmd := cli.NewJournalCmd()
+	rulesCmd := cli.NewRulesCmd()
 
 	// Root Commands
 	rootCmd.AddCommand(healthCmd)
@@ -56,6 +57,7 @@ func main() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(invitationsCmd)
 	rootCmd.AddCommand(journalCmd)
+	rootCmd.AddCommand(rulesCmd)
 
 	// Root Flags
 	rootCmd.PersistentFlags().StringVarP(
@@ -98,6 +100,14 @@ func main() {
 		"Journal Log URL",
 	)
 
+	rootCmd.PersistentFlags().StringVarP(
+		&sdkConf.RulesURL,
+		"rules-url",
+		"e",
+		sdkConf.RulesURL,
+		"Rules engine service URL",
+	)
+
 	rootCmd.PersistentFlags().StringVarP(
 		&sdkConf.HostURL,
 		"host-url",
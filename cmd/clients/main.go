@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
@@ -28,6 +29,7 @@ import (
 	clientsOps "github.com/absmach/supermq/clients/operations"
 	"github.com/absmach/supermq/clients/postgres"
 	pClients "github.com/absmach/supermq/clients/private"
+	"github.com/absmach/supermq/domains"
 	doperations "github.com/absmach/supermq/domains/operations"
 	dpostgres "github.com/absmach/supermq/domains/postgres"
 	goperations "github.com/absmach/supermq/groups/operations"
@@ -36,10 +38,13 @@ import (
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	externalAuthn "github.com/absmach/supermq/pkg/authn/external"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	"github.com/absmach/supermq/pkg/callout"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	dconsumer "github.com/absmach/supermq/pkg/domains/events/consumer"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
 	gconsumer "github.com/absmach/supermq/pkg/groups/events/consumer"
@@ -77,6 +82,8 @@ const (
 	envPrefixHTTP          = "SMQ_CLIENTS_HTTP_"
 	envPrefixGRPC          = "SMQ_CLIENTS_GRPC_"
 	envPrefixAuth          = "SMQ_AUTH_GRPC_"
+	envPrefixUsers         = "SMQ_USERS_GRPC_"
+	envPrefixExternal      = "SMQ_AUTHN_EXTERNAL_"
 	envPrefixChannels      = "SMQ_CHANNELS_GRPC_"
 	envPrefixGroups        = "SMQ_GROUPS_GRPC_"
 	envPrefixDomains       = "SMQ_DOMAINS_GRPC_"
@@ -87,24 +94,26 @@ const (
 )
 
 type config struct {
-	InstanceID          string        `env:"SMQ_CLIENTS_INSTANCE_ID"        envDefault:""`
-	LogLevel            string        `env:"SMQ_CLIENTS_LOG_LEVEL"          envDefault:"info"`
-	StandaloneID        string        `env:"SMQ_CLIENTS_STANDALONE_ID"      envDefault:""`
-	StandaloneToken     string        `env:"SMQ_CLIENTS_STANDALONE_TOKEN"   envDefault:""`
-	CacheURL            string        `env:"SMQ_CLIENTS_CACHE_URL"          envDefault:"redis://localhost:6379/0"`
-	CacheKeyDuration    time.Duration `env:"SMQ_CLIENTS_CACHE_KEY_DURATION" envDefault:"10m"`
-	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                 envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"             envDefault:"true"`
-	ESURL               string        `env:"SMQ_ES_URL"                     envDefault:"nats://localhost:4222"`
-	ESConsumerName      string        `env:"SMQ_CLIENTS_EVENT_CONSUMER"     envDefault:"clients"`
-	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"         envDefault:"1.0"`
-	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"               envDefault:"localhost"`
-	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"               envDefault:"50051"`
-	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"     envDefault:"12345678"`
-	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"        envDefault:"schema.zed"`
-	AuthKeyAlgorithm    string        `env:"SMQ_AUTH_KEYS_ALGORITHM"        envDefault:"RS256"`
-	JWKSURL             string        `env:"SMQ_AUTH_JWKS_URL"              envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
-	PermissionsFile     string        `env:"SMQ_PERMISSIONS_FILE"           envDefault:"permission.yaml"`
+	InstanceID          string        `env:"SMQ_CLIENTS_INSTANCE_ID"         envDefault:""`
+	LogLevel            string        `env:"SMQ_CLIENTS_LOG_LEVEL"           envDefault:"info"`
+	StandaloneID        string        `env:"SMQ_CLIENTS_STANDALONE_ID"       envDefault:""`
+	StandaloneToken     string        `env:"SMQ_CLIENTS_STANDALONE_TOKEN"    envDefault:""`
+	CacheURL            string        `env:"SMQ_CLIENTS_CACHE_URL"           envDefault:"redis://localhost:6379/0"`
+	CacheKeyDuration    time.Duration `env:"SMQ_CLIENTS_CACHE_KEY_DURATION"  envDefault:"10m"`
+	SecretGracePeriod   time.Duration `env:"SMQ_CLIENTS_SECRET_GRACE_PERIOD" envDefault:"24h"`
+	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                  envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"              envDefault:"true"`
+	ESURL               string        `env:"SMQ_ES_URL"                      envDefault:"nats://localhost:4222"`
+	ESConsumerName      string        `env:"SMQ_CLIENTS_EVENT_CONSUMER"      envDefault:"clients"`
+	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"          envDefault:"1.0"`
+	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"                envDefault:"localhost"`
+	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"                envDefault:"50051"`
+	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"      envDefault:"12345678"`
+	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"         envDefault:"schema.zed"`
+	AuthKeyAlgorithm    string        `env:"SMQ_AUTH_KEYS_ALGORITHM"         envDefault:"RS256"`
+	JWKSURL             string        `env:"SMQ_AUTH_JWKS_URL"               envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	ExternalIssuers     []string      `env:"SMQ_AUTHN_EXTERNAL_ISSUERS"      envDefault:""`
+	PermissionsFile     string        `env:"SMQ_PERMISSIONS_FILE"            envDefault:"permission.yaml"`
 }
 
 func main() {
@@ -200,9 +209,16 @@ func main() {
 	}
 	var authn smqauthn.Authentication
 	var authnClient grpcclient.Handler
+	var revocations *jwksAuthn.Revocations
 	switch {
 	case !alg:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, grpcCfg)
+		revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, grpcCfg, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, grpcCfg, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -220,6 +236,48 @@ func main() {
 		defer authnClient.Close()
 		logger.Info("AuthN successfully connected to auth gRPC server " + authnClient.Secure())
 	}
+	if len(cfg.ExternalIssuers) > 0 {
+		issuers := make(map[string]externalAuthn.Config, len(cfg.ExternalIssuers))
+		for _, name := range cfg.ExternalIssuers {
+			issuerCfg := externalAuthn.Config{}
+			if err := env.ParseWithOptions(&issuerCfg, env.Options{Prefix: envPrefixExternal + strings.ToUpper(name) + "_"}); err != nil {
+				logger.Error(fmt.Sprintf("failed to load %s external issuer configuration : %s", name, err))
+				exitCode = 1
+				return
+			}
+			issuers[name] = issuerCfg
+		}
+
+		usersClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&usersClientCfg, env.Options{Prefix: envPrefixUsers}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load users gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		usersClient, usersHandler, err := grpcclient.SetupUsersClient(ctx, usersClientCfg)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup users gRPC client: %s", err))
+			exitCode = 1
+			return
+		}
+		defer usersHandler.Close()
+
+		if revocations == nil {
+			revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, grpcCfg, logger)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+				exitCode = 1
+				return
+			}
+		}
+		authn, err = externalAuthn.NewAuthentication(authn, usersClient, issuers, revocations)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup external authentication: %s", err))
+			exitCode = 1
+			return
+		}
+		logger.Info("AuthN successfully set up external issuers " + strings.Join(cfg.ExternalIssuers, ", "))
+	}
 	authnMiddleware := smqauthn.NewAuthNMiddleware(authn)
 
 	domsGrpcCfg := grpcclient.Config{}
@@ -228,7 +286,7 @@ func main() {
 		exitCode = 1
 		return
 	}
-	domAuthz, _, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	domAuthz, domainsClient, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -297,7 +355,7 @@ func main() {
 	}
 
 	svc, psvc, err := newService(ctx, db, dbConfig, authz, policyEvaluator, policyService, cacheclient,
-		cfg, channelsgRPC, groupsClient, tracer, logger, callout, permConfig)
+		cfg, channelsgRPC, groupsClient, domainsAuthz.NewQuotas(domainsClient), tracer, logger, callout, permConfig)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
@@ -307,7 +365,7 @@ func main() {
 	ddatabase := pg.NewDatabase(db, dbConfig, tracer)
 	drepo := dpostgres.NewRepository(ddatabase)
 
-	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
+	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, psvc.DeleteDomainClients, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
 		logger.Error(fmt.Sprintf("failed to create domains event store : %s", err))
 		exitCode = 1
 		return
@@ -368,7 +426,7 @@ func main() {
 	}
 }
 
-func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization, pe policies.Evaluator, ps policies.Service, cacheClient *redis.Client, cfg config, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, tracer trace.Tracer, logger *slog.Logger, callout callout.Callout, permConfig *permissions.PermissionConfig) (clients.Service, pClients.Service, error) {
+func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, authz smqauthz.Authorization, pe policies.Evaluator, ps policies.Service, cacheClient *redis.Client, cfg config, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, quotas pkgDomains.Quotas, tracer trace.Tracer, logger *slog.Logger, callout callout.Callout, permConfig *permissions.PermissionConfig) (clients.Service, pClients.Service, error) {
 	database := pg.NewDatabase(db, dbConfig, tracer)
 	repo := postgres.NewRepository(database)
 
@@ -386,10 +444,17 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, auth
 		return nil, nil, err
 	}
 
-	csvc, err := clients.NewService(repo, ps, cache, channels, groups, idp, sidp, availableActions, builtInRoles)
+	csvc, err := clients.NewService(repo, ps, cache, channels, groups, quotas, idp, sidp, cfg.SecretGracePeriod, availableActions, builtInRoles, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	// The domain usage may have drifted from the clients, or have not been
+	// counted at all before the domain quotas were introduced.
+	go func() {
+		if err := pkgDomains.ReconcileUsage(ctx, quotas, domains.ClientsResource, repo.CountByDomain); err != nil {
+			logger.Warn(fmt.Sprintf("failed to reconcile domain clients usage : %s", err))
+		}
+	}()
 
 	csvc, err = events.NewEventStoreMiddleware(ctx, csvc, cfg.ESURL)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/mgate"
@@ -18,9 +19,11 @@ import (
 	"github.com/absmach/mgate/pkg/session"
 	mgtls "github.com/absmach/mgate/pkg/tls"
 	"github.com/absmach/supermq"
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	"github.com/absmach/supermq/coap"
 	httpapi "github.com/absmach/supermq/coap/api"
 	"github.com/absmach/supermq/coap/middleware"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
 	smqlog "github.com/absmach/supermq/logger"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
 	"github.com/absmach/supermq/pkg/grpcclient"
@@ -29,7 +32,12 @@ import (
 	"github.com/absmach/supermq/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
 	msgevents "github.com/absmach/supermq/pkg/messaging/events"
+	"github.com/absmach/supermq/pkg/messaging/handler"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	lvcache "github.com/absmach/supermq/pkg/messaging/lastvalue/cache"
 	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/absmach/supermq/pkg/server"
 	coapserver "github.com/absmach/supermq/pkg/server/coap"
 	httpserver "github.com/absmach/supermq/pkg/server/http"
@@ -55,13 +63,17 @@ const (
 )
 
 type config struct {
-	LogLevel      string  `env:"SMQ_COAP_ADAPTER_LOG_LEVEL"   envDefault:"info"`
-	BrokerURL     string  `env:"SMQ_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
-	JaegerURL     url.URL `env:"SMQ_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry bool    `env:"SMQ_SEND_TELEMETRY"           envDefault:"true"`
-	InstanceID    string  `env:"SMQ_COAP_ADAPTER_INSTANCE_ID" envDefault:""`
-	TraceRatio    float64 `env:"SMQ_JAEGER_TRACE_RATIO"       envDefault:"1.0"`
-	ESURL         string  `env:"SMQ_ES_URL"                   envDefault:"nats://localhost:4222"`
+	LogLevel             string        `env:"SMQ_COAP_ADAPTER_LOG_LEVEL"        envDefault:"info"`
+	BrokerURL            string        `env:"SMQ_MESSAGE_BROKER_URL"            envDefault:"nats://localhost:4222"`
+	JaegerURL            url.URL       `env:"SMQ_JAEGER_URL"                    envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry        bool          `env:"SMQ_SEND_TELEMETRY"                envDefault:"true"`
+	InstanceID           string        `env:"SMQ_COAP_ADAPTER_INSTANCE_ID"      envDefault:""`
+	TraceRatio           float64       `env:"SMQ_JAEGER_TRACE_RATIO"            envDefault:"1.0"`
+	ESURL                string        `env:"SMQ_ES_URL"                        envDefault:"nats://localhost:4222"`
+	SchemaCacheTTL       time.Duration `env:"SMQ_COAP_ADAPTER_SCHEMA_CACHE_TTL" envDefault:"1m"`
+	QuotasCacheTTL       time.Duration `env:"SMQ_COAP_ADAPTER_QUOTAS_CACHE_TTL" envDefault:"1m"`
+	LastValueCacheURL    string        `env:"SMQ_LAST_VALUE_CACHE_URL"          envDefault:"redis://localhost:6379/0"`
+	LastValueKeyDuration time.Duration `env:"SMQ_LAST_VALUE_CACHE_KEY_DURATION" envDefault:"24h"`
 }
 
 func main() {
@@ -195,7 +207,21 @@ func main() {
 		return
 	}
 
-	svc := coap.New(clientsClient, channelsClient, nps)
+	lastValuesClient, err := redisclient.Connect(cfg.LastValueCacheURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to last value cache: %s", err))
+		exitCode = 1
+		return
+	}
+	defer lastValuesClient.Close()
+	lastValues := lvcache.NewStore(lastValuesClient, cfg.LastValueKeyDuration)
+	if err := lastvalue.Subscribe(ctx, nps, lastValues); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe last value store to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
+	svc := coap.New(clientsClient, channelsClient, nps, lastValues)
 
 	svc = middleware.NewTracing(tracer, svc)
 
@@ -212,6 +238,20 @@ func main() {
 		exitCode = 1
 		return
 	}
+	validator, err := schema.NewValidator(cacheConfig, cfg.SchemaCacheTTL, channelsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create schema validator: %s", err))
+		exitCode = 1
+		return
+	}
+
+	limiter, err := ratelimit.NewLimiter(cacheConfig, cfg.QuotasCacheTTL, domainsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create message rate limiter: %s", err))
+		exitCode = 1
+		return
+	}
+
 	cs := coapserver.NewServer(ctx, cancel, svcName, server.Config{Host: coapServerConfig.Host, Port: targetCoapPort}, httpapi.MakeCoAPHandler(svc, channelsClient, parser, logger), logger)
 
 	if cfg.SendTelemetry {
@@ -226,8 +266,10 @@ func main() {
 		g.Go(func() error {
 			return cs.Start()
 		})
-		handler := coap.NewHandler(logger, clientsClient, channelsClient, parser)
-		return proxyCoAP(ctx, coapServerConfig, dtlsCfg, handler, logger)
+		h := coap.NewHandler(logger, clientsClient, channelsClient, parser, validator, limiter)
+		counter, latency := prometheus.MakeMetrics(svcName, "handler")
+		h = handler.NewMetrics(h, counter, latency)
+		return proxyCoAP(ctx, coapServerConfig, dtlsCfg, h, clientsClient, logger)
 	})
 	g.Go(func() error {
 		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs, cs)
@@ -238,7 +280,7 @@ func main() {
 	}
 }
 
-func proxyCoAP(ctx context.Context, cfg server.Config, dtlsCfg mgtls.Config, handler session.Handler, logger *slog.Logger) error {
+func proxyCoAP(ctx context.Context, cfg server.Config, dtlsCfg mgtls.Config, handler session.Handler, clients grpcClientsV1.ClientsServiceClient, logger *slog.Logger) error {
 	var err error
 	config := mgate.Config{
 		Host:           "",
@@ -256,6 +298,12 @@ func proxyCoAP(ctx context.Context, cfg server.Config, dtlsCfg mgtls.Config, han
 	if err != nil {
 		return err
 	}
+	// The clients with the certificate issued by the client CA must have it
+	// bound, while the others keep using the secret.
+	if config.DTLSConfig != nil && config.DTLSConfig.ClientCAs != nil {
+		config.DTLSConfig.ClientAuth = dtls.VerifyClientCertIfGiven
+		config.DTLSConfig.VerifyPeerCertificate = coap.NewCertVerifier(clients, config.DTLSConfig.VerifyPeerCertificate)
+	}
 
 	switch {
 	case config.DTLSConfig != nil:
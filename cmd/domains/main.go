@@ -10,31 +10,38 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
 	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	"github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/domains"
 	domainsSvc "github.com/absmach/supermq/domains"
 	domainsgrpcapi "github.com/absmach/supermq/domains/api/grpc"
 	httpapi "github.com/absmach/supermq/domains/api/http"
 	cache "github.com/absmach/supermq/domains/cache"
+	"github.com/absmach/supermq/domains/emailer"
 	"github.com/absmach/supermq/domains/events"
 	dmw "github.com/absmach/supermq/domains/middleware"
 	doperations "github.com/absmach/supermq/domains/operations"
 	dpostgres "github.com/absmach/supermq/domains/postgres"
 	"github.com/absmach/supermq/domains/private"
 	redisclient "github.com/absmach/supermq/internal/clients/redis"
+	"github.com/absmach/supermq/internal/email"
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	externalAuthn "github.com/absmach/supermq/pkg/authn/external"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	"github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	"github.com/absmach/supermq/pkg/callout"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/psvc"
+	"github.com/absmach/supermq/pkg/events/store"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	"github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/permissions"
@@ -67,6 +74,8 @@ const (
 	envPrefixGrpc          = "SMQ_DOMAINS_GRPC_"
 	envPrefixDB            = "SMQ_DOMAINS_DB_"
 	envPrefixAuth          = "SMQ_AUTH_GRPC_"
+	envPrefixUsers         = "SMQ_USERS_GRPC_"
+	envPrefixExternal      = "SMQ_AUTHN_EXTERNAL_"
 	envPrefixDomainCallout = "SMQ_DOMAINS_CALLOUT_"
 	defDB                  = "domains"
 	defSvcHTTPPort         = "9004"
@@ -74,21 +83,45 @@ const (
 )
 
 type config struct {
-	LogLevel            string        `env:"SMQ_DOMAINS_LOG_LEVEL"            envDefault:"info"`
-	JaegerURL           url.URL       `env:"SMQ_JAEGER_URL"                   envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry       bool          `env:"SMQ_SEND_TELEMETRY"               envDefault:"true"`
-	CacheURL            string        `env:"SMQ_DOMAINS_CACHE_URL"            envDefault:"redis://localhost:6379/0"`
-	CacheKeyDuration    time.Duration `env:"SMQ_DOMAINS_CACHE_KEY_DURATION"   envDefault:"10m"`
-	InstanceID          string        `env:"SMQ_DOMAINS_INSTANCE_ID"          envDefault:""`
-	SpicedbHost         string        `env:"SMQ_SPICEDB_HOST"                 envDefault:"localhost"`
-	SpicedbPort         string        `env:"SMQ_SPICEDB_PORT"                 envDefault:"50051"`
-	SpicedbSchemaFile   string        `env:"SMQ_SPICEDB_SCHEMA_FILE"          envDefault:"schema.zed"`
-	SpicedbPreSharedKey string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"       envDefault:"12345678"`
-	TraceRatio          float64       `env:"SMQ_JAEGER_TRACE_RATIO"           envDefault:"1.0"`
-	ESURL               string        `env:"SMQ_ES_URL"                       envDefault:"nats://localhost:4222"`
-	AuthKeyAlgorithm    string        `env:"SMQ_AUTH_KEYS_ALGORITHM"          envDefault:"RS256"`
-	JWKSURL             string        `env:"SMQ_AUTH_JWKS_URL"                envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
-	PermissionsFile     string        `env:"SMQ_PERMISSIONS_FILE"             envDefault:"permission.yaml"`
+	LogLevel                string        `env:"SMQ_DOMAINS_LOG_LEVEL"                 envDefault:"info"`
+	JaegerURL               url.URL       `env:"SMQ_JAEGER_URL"                        envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry           bool          `env:"SMQ_SEND_TELEMETRY"                    envDefault:"true"`
+	CacheURL                string        `env:"SMQ_DOMAINS_CACHE_URL"                 envDefault:"redis://localhost:6379/0"`
+	CacheKeyDuration        time.Duration `env:"SMQ_DOMAINS_CACHE_KEY_DURATION"        envDefault:"10m"`
+	InstanceID              string        `env:"SMQ_DOMAINS_INSTANCE_ID"               envDefault:""`
+	SpicedbHost             string        `env:"SMQ_SPICEDB_HOST"                      envDefault:"localhost"`
+	SpicedbPort             string        `env:"SMQ_SPICEDB_PORT"                      envDefault:"50051"`
+	SpicedbSchemaFile       string        `env:"SMQ_SPICEDB_SCHEMA_FILE"               envDefault:"schema.zed"`
+	SpicedbPreSharedKey     string        `env:"SMQ_SPICEDB_PRE_SHARED_KEY"            envDefault:"12345678"`
+	TraceRatio              float64       `env:"SMQ_JAEGER_TRACE_RATIO"                envDefault:"1.0"`
+	ESURL                   string        `env:"SMQ_ES_URL"                            envDefault:"nats://localhost:4222"`
+	AuthKeyAlgorithm        string        `env:"SMQ_AUTH_KEYS_ALGORITHM"               envDefault:"RS256"`
+	JWKSURL                 string        `env:"SMQ_AUTH_JWKS_URL"                     envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	ExternalIssuers         []string      `env:"SMQ_AUTHN_EXTERNAL_ISSUERS"            envDefault:""`
+	PermissionsFile         string        `env:"SMQ_PERMISSIONS_FILE"                  envDefault:"permission.yaml"`
+	DeleteInterval          time.Duration `env:"SMQ_DOMAINS_DELETE_INTERVAL"           envDefault:"24h"`
+	DeleteAfter             time.Duration `env:"SMQ_DOMAINS_DELETE_AFTER"              envDefault:"720h"`
+	QuotaClients            uint64        `env:"SMQ_DOMAINS_QUOTA_CLIENTS"             envDefault:"0"`
+	QuotaChannels           uint64        `env:"SMQ_DOMAINS_QUOTA_CHANNELS"            envDefault:"0"`
+	QuotaGroups             uint64        `env:"SMQ_DOMAINS_QUOTA_GROUPS"              envDefault:"0"`
+	QuotaInvitations        uint64        `env:"SMQ_DOMAINS_QUOTA_INVITATIONS"         envDefault:"0"`
+	QuotaMessageRate        uint64        `env:"SMQ_DOMAINS_QUOTA_MESSAGE_RATE"        envDefault:"0"`
+	InvitationURLPrefix     string        `env:"SMQ_DOMAINS_INVITATION_URL_PREFIX"     envDefault:"http://localhost/register"`
+	InvitationEmailTemplate string        `env:"SMQ_DOMAINS_INVITATION_EMAIL_TEMPLATE" envDefault:"invitation-signup-email.tmpl"`
+	InvitationDuration      time.Duration `env:"SMQ_DOMAINS_INVITATION_DURATION"       envDefault:"168h"`
+	InvitationMaxResends    uint64        `env:"SMQ_DOMAINS_INVITATION_MAX_RESENDS"    envDefault:"3"`
+	TransferDuration        time.Duration `env:"SMQ_DOMAINS_TRANSFER_DURATION"         envDefault:"168h"`
+}
+
+// quotas returns the platform default quotas of the domains.
+func (c config) quotas() domainsSvc.Quotas {
+	return domainsSvc.Quotas{
+		Clients:     c.QuotaClients,
+		Channels:    c.QuotaChannels,
+		Groups:      c.QuotaGroups,
+		Invitations: c.QuotaInvitations,
+		MessageRate: c.QuotaMessageRate,
+	}
 }
 
 func main() {
@@ -116,6 +149,14 @@ func main() {
 		}
 	}
 
+	invitationEmailConfig := email.Config{}
+	if err := env.Parse(&invitationEmailConfig); err != nil {
+		logger.Error(fmt.Sprintf("failed to load invitation email configuration : %s", err.Error()))
+		exitCode = 1
+		return
+	}
+	invitationEmailConfig.Template = cfg.InvitationEmailTemplate
+
 	dbConfig := pgclient.Config{Name: defDB}
 	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
 		logger.Error(err.Error())
@@ -158,6 +199,15 @@ func main() {
 		return
 	}
 
+	tokenClient, tokenHandler, err := grpcclient.SetupTokenClient(ctx, clientConfig)
+	if err != nil {
+		logger.Error("failed to create token gRPC client " + err.Error())
+		exitCode = 1
+		return
+	}
+	defer tokenHandler.Close()
+	logger.Info("Token service client successfully connected to auth gRPC server " + tokenHandler.Secure())
+
 	isSymmetric, err := auth.IsSymmetricAlgorithm(cfg.AuthKeyAlgorithm)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to parse auth key algorithm : %s", err))
@@ -166,9 +216,16 @@ func main() {
 	}
 	var authn smqauthn.Authentication
 	var authnClient grpcclient.Handler
+	var revocations *jwksAuthn.Revocations
 	switch {
 	case !isSymmetric:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, clientConfig)
+		revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, clientConfig, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, clientConfig, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -186,6 +243,48 @@ func main() {
 		defer authnClient.Close()
 		logger.Info("AuthN successfully connected to auth gRPC server " + authnClient.Secure())
 	}
+	if len(cfg.ExternalIssuers) > 0 {
+		issuers := make(map[string]externalAuthn.Config, len(cfg.ExternalIssuers))
+		for _, name := range cfg.ExternalIssuers {
+			issuerCfg := externalAuthn.Config{}
+			if err := env.ParseWithOptions(&issuerCfg, env.Options{Prefix: envPrefixExternal + strings.ToUpper(name) + "_"}); err != nil {
+				logger.Error(fmt.Sprintf("failed to load %s external issuer configuration : %s", name, err))
+				exitCode = 1
+				return
+			}
+			issuers[name] = issuerCfg
+		}
+
+		usersClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&usersClientCfg, env.Options{Prefix: envPrefixUsers}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load users gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		usersClient, usersHandler, err := grpcclient.SetupUsersClient(ctx, usersClientCfg)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup users gRPC client: %s", err))
+			exitCode = 1
+			return
+		}
+		defer usersHandler.Close()
+
+		if revocations == nil {
+			revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, clientConfig, logger)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+				exitCode = 1
+				return
+			}
+		}
+		authn, err = externalAuthn.NewAuthentication(authn, usersClient, issuers, revocations)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup external authentication: %s", err))
+			exitCode = 1
+			return
+		}
+		logger.Info("AuthN successfully set up external issuers " + strings.Join(cfg.ExternalIssuers, ", "))
+	}
 	authnMiddleware := smqauthn.NewAuthNMiddleware(authn)
 
 	database := postgres.NewDatabase(db, dbConfig, tracer)
@@ -200,7 +299,15 @@ func main() {
 	defer cacheclient.Close()
 	cache := cache.NewDomainsCache(cacheclient, cfg.CacheKeyDuration)
 
-	psvc := private.New(domainsRepo, cache)
+	policyService, err := newPolicyService(cfg, logger)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	logger.Info("Policy client successfully connected to spicedb gRPC server")
+
+	psvc := private.New(domainsRepo, cache, policyService, cfg.quotas())
 
 	domAuthz := domainsAuthz.NewAuthorization(psvc)
 
@@ -213,14 +320,6 @@ func main() {
 	defer authzHandler.Close()
 	logger.Info("Authz successfully connected to auth gRPC server " + authzHandler.Secure())
 
-	policyService, err := newPolicyService(cfg, logger)
-	if err != nil {
-		logger.Error(err.Error())
-		exitCode = 1
-		return
-	}
-	logger.Info("Policy client successfully connected to spicedb gRPC server")
-
 	callCfg := callout.Config{}
 	if err := env.ParseWithOptions(&callCfg, env.Options{Prefix: envPrefixDomainCallout}); err != nil {
 		logger.Error(fmt.Sprintf("failed to parse callout config : %s", err))
@@ -235,13 +334,23 @@ func main() {
 		return
 	}
 
-	svc, err := newDomainService(ctx, domainsRepo, cache, tracer, cfg, authz, policyService, logger, call)
+	svc, err := newDomainService(ctx, domainsRepo, cache, tracer, cfg, authz, policyService, tokenClient, invitationEmailConfig, logger, call)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create %s service: %s", svcName, err.Error()))
 		exitCode = 1
 		return
 	}
 
+	publisher, err := store.NewPublisher(ctx, cfg.ESURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create %s event store publisher: %s", svcName, err.Error()))
+		exitCode = 1
+		return
+	}
+	defer publisher.Close()
+
+	domains.NewDeleteHandler(ctx, domainsRepo, cache, policyService, publisher, cfg.DeleteInterval, cfg.DeleteAfter, logger)
+
 	grpcServerConfig := server.Config{Port: defSvcGRPCPort}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixGrpc}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load %s gRPC server configuration : %s", svcName, err.Error()))
@@ -287,7 +396,7 @@ func main() {
 	}
 }
 
-func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, cache domainsSvc.Cache, tracer trace.Tracer, cfg config, authz authz.Authorization, policiessvc policies.Service, logger *slog.Logger, callout callout.Callout) (domains.Service, error) {
+func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, cache domainsSvc.Cache, tracer trace.Tracer, cfg config, authz authz.Authorization, policiessvc policies.Service, token grpcTokenV1.TokenServiceClient, invitationEmailConfig email.Config, logger *slog.Logger, callout callout.Callout) (domains.Service, error) {
 	idProvider := uuid.New()
 	sidProvider, err := sid.New()
 	if err != nil {
@@ -304,7 +413,12 @@ func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, ca
 		return nil, fmt.Errorf("failed to parse permissions file: %w", err)
 	}
 
-	svc, err := domainsSvc.New(domainsRepo, cache, policiessvc, idProvider, sidProvider, availableActions, builtInRoles)
+	emailerClient, err := emailer.New(cfg.InvitationURLPrefix, &invitationEmailConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure e-mailing util: %w", err)
+	}
+
+	svc, err := domainsSvc.New(domainsRepo, cache, policiessvc, idProvider, sidProvider, token, emailerClient, cfg.InvitationDuration, cfg.InvitationMaxResends, cfg.TransferDuration, cfg.quotas(), availableActions, builtInRoles)
 	if err != nil {
 		return nil, fmt.Errorf("failed to init domain service: %w", err)
 	}
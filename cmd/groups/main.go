@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"strings"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/supermq"
@@ -18,6 +19,7 @@ import (
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	grpcGroupsV1 "github.com/absmach/supermq/api/grpc/groups/v1"
 	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/domains"
 	doperations "github.com/absmach/supermq/domains/operations"
 	dpostgres "github.com/absmach/supermq/domains/postgres"
 	"github.com/absmach/supermq/groups"
@@ -32,10 +34,13 @@ import (
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
+	externalAuthn "github.com/absmach/supermq/pkg/authn/external"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	"github.com/absmach/supermq/pkg/callout"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	dconsumer "github.com/absmach/supermq/pkg/domains/events/consumer"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
 	"github.com/absmach/supermq/pkg/grpcclient"
@@ -71,6 +76,8 @@ const (
 	envPrefixHTTP         = "SMQ_GROUPS_HTTP_"
 	envPrefixgRPC         = "SMQ_GROUPS_GRPC_"
 	envPrefixAuth         = "SMQ_AUTH_GRPC_"
+	envPrefixUsers        = "SMQ_USERS_GRPC_"
+	envPrefixExternal     = "SMQ_AUTHN_EXTERNAL_"
 	envPrefixDomains      = "SMQ_DOMAINS_GRPC_"
 	envPrefixChannels     = "SMQ_CHANNELS_GRPC_"
 	envPrefixClients      = "SMQ_CLIENTS_GRPC_"
@@ -81,20 +88,21 @@ const (
 )
 
 type config struct {
-	LogLevel            string  `env:"SMQ_GROUPS_LOG_LEVEL"          envDefault:"info"`
-	InstanceID          string  `env:"SMQ_GROUPS_INSTANCE_ID"        envDefault:""`
-	JaegerURL           url.URL `env:"SMQ_JAEGER_URL"                envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry       bool    `env:"SMQ_SEND_TELEMETRY"            envDefault:"true"`
-	ESURL               string  `env:"SMQ_ES_URL"                    envDefault:"nats://localhost:4222"`
-	ESConsumerName      string  `env:"SMQ_GROUPS_EVENT_CONSUMER"     envDefault:"groups"`
-	TraceRatio          float64 `env:"SMQ_JAEGER_TRACE_RATIO"        envDefault:"1.0"`
-	SpicedbHost         string  `env:"SMQ_SPICEDB_HOST"              envDefault:"localhost"`
-	SpicedbPort         string  `env:"SMQ_SPICEDB_PORT"              envDefault:"50051"`
-	SpicedbSchemaFile   string  `env:"SMQ_SPICEDB_SCHEMA_FILE"       envDefault:"schema.zed"`
-	SpicedbPreSharedKey string  `env:"SMQ_SPICEDB_PRE_SHARED_KEY"    envDefault:"12345678"`
-	AuthKeyAlgorithm    string  `env:"SMQ_AUTH_KEYS_ALGORITHM"       envDefault:"RS256"`
-	JWKSURL             string  `env:"SMQ_AUTH_JWKS_URL"             envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
-	PermissionsFile     string  `env:"SMQ_PERMISSIONS_FILE"          envDefault:"permission.yaml"`
+	LogLevel            string   `env:"SMQ_GROUPS_LOG_LEVEL"          envDefault:"info"`
+	InstanceID          string   `env:"SMQ_GROUPS_INSTANCE_ID"        envDefault:""`
+	JaegerURL           url.URL  `env:"SMQ_JAEGER_URL"                envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry       bool     `env:"SMQ_SEND_TELEMETRY"            envDefault:"true"`
+	ESURL               string   `env:"SMQ_ES_URL"                    envDefault:"nats://localhost:4222"`
+	ESConsumerName      string   `env:"SMQ_GROUPS_EVENT_CONSUMER"     envDefault:"groups"`
+	TraceRatio          float64  `env:"SMQ_JAEGER_TRACE_RATIO"        envDefault:"1.0"`
+	SpicedbHost         string   `env:"SMQ_SPICEDB_HOST"              envDefault:"localhost"`
+	SpicedbPort         string   `env:"SMQ_SPICEDB_PORT"              envDefault:"50051"`
+	SpicedbSchemaFile   string   `env:"SMQ_SPICEDB_SCHEMA_FILE"       envDefault:"schema.zed"`
+	SpicedbPreSharedKey string   `env:"SMQ_SPICEDB_PRE_SHARED_KEY"    envDefault:"12345678"`
+	AuthKeyAlgorithm    string   `env:"SMQ_AUTH_KEYS_ALGORITHM"       envDefault:"RS256"`
+	JWKSURL             string   `env:"SMQ_AUTH_JWKS_URL"             envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	ExternalIssuers     []string `env:"SMQ_AUTHN_EXTERNAL_ISSUERS"    envDefault:""`
+	PermissionsFile     string   `env:"SMQ_PERMISSIONS_FILE"          envDefault:"permission.yaml"`
 }
 
 func main() {
@@ -170,9 +178,16 @@ func main() {
 	}
 	var authn smqauthn.Authentication
 	var authnClient grpcclient.Handler
+	var revocations *jwksAuthn.Revocations
 	switch {
 	case !isSymmetric:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authClientConfig)
+		revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, authClientConfig, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authClientConfig, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -190,6 +205,48 @@ func main() {
 		defer authnClient.Close()
 		logger.Info("AuthN successfully connected to auth gRPC server " + authnClient.Secure())
 	}
+	if len(cfg.ExternalIssuers) > 0 {
+		issuers := make(map[string]externalAuthn.Config, len(cfg.ExternalIssuers))
+		for _, name := range cfg.ExternalIssuers {
+			issuerCfg := externalAuthn.Config{}
+			if err := env.ParseWithOptions(&issuerCfg, env.Options{Prefix: envPrefixExternal + strings.ToUpper(name) + "_"}); err != nil {
+				logger.Error(fmt.Sprintf("failed to load %s external issuer configuration : %s", name, err))
+				exitCode = 1
+				return
+			}
+			issuers[name] = issuerCfg
+		}
+
+		usersClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&usersClientCfg, env.Options{Prefix: envPrefixUsers}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load users gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		usersClient, usersHandler, err := grpcclient.SetupUsersClient(ctx, usersClientCfg)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup users gRPC client: %s", err))
+			exitCode = 1
+			return
+		}
+		defer usersHandler.Close()
+
+		if revocations == nil {
+			revocations, err = jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, authClientConfig, logger)
+			if err != nil {
+				logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+				exitCode = 1
+				return
+			}
+		}
+		authn, err = externalAuthn.NewAuthentication(authn, usersClient, issuers, revocations)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to setup external authentication: %s", err))
+			exitCode = 1
+			return
+		}
+		logger.Info("AuthN successfully set up external issuers " + strings.Join(cfg.ExternalIssuers, ", "))
+	}
 	authnMiddleware := smqauthn.NewAuthNMiddleware(authn)
 
 	domsGrpcCfg := grpcclient.Config{}
@@ -198,7 +255,7 @@ func main() {
 		exitCode = 1
 		return
 	}
-	domAuthz, _, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
+	domAuthz, domainsClient, domainsHandler, err := domainsAuthz.NewAuthorization(ctx, domsGrpcCfg)
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -274,7 +331,7 @@ func main() {
 		return
 	}
 
-	svc, psvc, err := newService(ctx, authz, policyService, db, dbConfig, channelsClient, clientsClient, tracer, logger, cfg, callout, permConfig)
+	svc, psvc, err := newService(ctx, authz, policyService, db, dbConfig, channelsClient, clientsClient, domainsAuthz.NewQuotas(domainsClient), tracer, logger, cfg, callout, permConfig)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to setup service: %s", err))
 		exitCode = 1
@@ -284,7 +341,7 @@ func main() {
 	ddatabase := pg.NewDatabase(db, dbConfig, tracer)
 	drepo := dpostgres.NewRepository(ddatabase)
 
-	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
+	if err := dconsumer.DomainsEventsSubscribe(ctx, drepo, psvc.DeleteDomainGroups, cfg.ESURL, cfg.ESConsumerName, logger); err != nil {
 		logger.Error(fmt.Sprintf("failed to create domains event store : %s", err))
 		exitCode = 1
 		return
@@ -338,7 +395,7 @@ func main() {
 
 func newService(ctx context.Context, authz smqauthz.Authorization, policy policies.Service, db *sqlx.DB,
 	dbConfig pgclient.Config, channels grpcChannelsV1.ChannelsServiceClient,
-	clients grpcClientsV1.ClientsServiceClient, tracer trace.Tracer, logger *slog.Logger, c config, callout callout.Callout, permConfig *permissions.PermissionConfig,
+	clients grpcClientsV1.ClientsServiceClient, quotas pkgDomains.Quotas, tracer trace.Tracer, logger *slog.Logger, c config, callout callout.Callout, permConfig *permissions.PermissionConfig,
 ) (groups.Service, pgroups.Service, error) {
 	database := pg.NewDatabase(db, dbConfig, tracer)
 	idp := uuid.New()
@@ -354,10 +411,17 @@ func newService(ctx context.Context, authz smqauthz.Authorization, policy polici
 
 	// Creating groups service
 	repo := postgres.New(database)
-	svc, err := gpsvc.NewService(repo, policy, idp, channels, clients, sid, availableActions, builtInRoles)
+	svc, err := gpsvc.NewService(repo, policy, idp, channels, clients, quotas, sid, availableActions, builtInRoles, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	// The domain usage may have drifted from the groups, or have not been
+	// counted at all before the domain quotas were introduced.
+	go func() {
+		if err := pkgDomains.ReconcileUsage(ctx, quotas, domains.GroupsResource, repo.CountByDomain); err != nil {
+			logger.Warn(fmt.Sprintf("failed to reconcile domain groups usage : %s", err))
+		}
+	}()
 	svc, err = events.New(ctx, svc, c.ESURL)
 	if err != nil {
 		return nil, nil, err
@@ -407,7 +471,7 @@ func newService(ctx context.Context, authz smqauthz.Authorization, policy polici
 	counter, latency := prometheus.MakeMetrics("groups", "api")
 	svc = middleware.NewMetrics(svc, counter, latency)
 
-	psvc := pgroups.New(repo)
+	psvc := pgroups.New(repo, policy)
 	return svc, psvc, err
 }
 
@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/mgate"
@@ -26,10 +28,12 @@ import (
 	adapter "github.com/absmach/supermq/http"
 	httpapi "github.com/absmach/supermq/http/api"
 	"github.com/absmach/supermq/http/middleware"
+	redisclient "github.com/absmach/supermq/internal/clients/redis"
 	smqlog "github.com/absmach/supermq/logger"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
 	"github.com/absmach/supermq/pkg/grpcclient"
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
@@ -38,7 +42,11 @@ import (
 	brokerstracing "github.com/absmach/supermq/pkg/messaging/brokers/tracing"
 	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/messaging/handler"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	lvcache "github.com/absmach/supermq/pkg/messaging/lastvalue/cache"
 	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/absmach/supermq/pkg/server"
 	httpserver "github.com/absmach/supermq/pkg/server/http"
 	"github.com/absmach/supermq/pkg/uuid"
@@ -60,18 +68,25 @@ const (
 	targetHTTPHost     = "localhost"
 	targetHTTPPort     = "81"
 	targetHTTPPath     = ""
+	// Multiplexed WebSocket connections authorize every frame in the
+	// adapter service, so they bypass the proxy session handler.
+	muxWSPath = "^/m/[^/]+/ws$"
 )
 
 type config struct {
-	LogLevel         string  `env:"SMQ_HTTP_ADAPTER_LOG_LEVEL"   envDefault:"info"`
-	BrokerURL        string  `env:"SMQ_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
-	JaegerURL        url.URL `env:"SMQ_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry    bool    `env:"SMQ_SEND_TELEMETRY"           envDefault:"true"`
-	InstanceID       string  `env:"SMQ_HTTP_ADAPTER_INSTANCE_ID" envDefault:""`
-	TraceRatio       float64 `env:"SMQ_JAEGER_TRACE_RATIO"       envDefault:"1.0"`
-	ESURL            string  `env:"SMQ_ES_URL"                   envDefault:"nats://localhost:4222"`
-	AuthKeyAlgorithm string  `env:"SMQ_AUTH_KEYS_ALGORITHM"      envDefault:"RS256"`
-	JWKSURL          string  `env:"SMQ_AUTH_JWKS_URL"            envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	LogLevel             string        `env:"SMQ_HTTP_ADAPTER_LOG_LEVEL"        envDefault:"info"`
+	BrokerURL            string        `env:"SMQ_MESSAGE_BROKER_URL"            envDefault:"nats://localhost:4222"`
+	JaegerURL            url.URL       `env:"SMQ_JAEGER_URL"                    envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry        bool          `env:"SMQ_SEND_TELEMETRY"                envDefault:"true"`
+	InstanceID           string        `env:"SMQ_HTTP_ADAPTER_INSTANCE_ID"      envDefault:""`
+	TraceRatio           float64       `env:"SMQ_JAEGER_TRACE_RATIO"            envDefault:"1.0"`
+	ESURL                string        `env:"SMQ_ES_URL"                        envDefault:"nats://localhost:4222"`
+	AuthKeyAlgorithm     string        `env:"SMQ_AUTH_KEYS_ALGORITHM"           envDefault:"RS256"`
+	JWKSURL              string        `env:"SMQ_AUTH_JWKS_URL"                 envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	SchemaCacheTTL       time.Duration `env:"SMQ_HTTP_ADAPTER_SCHEMA_CACHE_TTL" envDefault:"1m"`
+	QuotasCacheTTL       time.Duration `env:"SMQ_HTTP_ADAPTER_QUOTAS_CACHE_TTL" envDefault:"1m"`
+	LastValueCacheURL    string        `env:"SMQ_LAST_VALUE_CACHE_URL"          envDefault:"redis://localhost:6379/0"`
+	LastValueKeyDuration time.Duration `env:"SMQ_LAST_VALUE_CACHE_KEY_DURATION" envDefault:"24h"`
 }
 
 func main() {
@@ -178,7 +193,13 @@ func main() {
 	var authnClient grpcclient.Handler
 	switch {
 	case !isSymmetric:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authnCfg)
+		revocations, err := jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, authnCfg, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authnCfg, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -226,14 +247,42 @@ func main() {
 		return
 	}
 
+	validator, err := schema.NewValidator(cacheConfig, cfg.SchemaCacheTTL, channelsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create schema validator: %s", err))
+		exitCode = 1
+		return
+	}
+
+	limiter, err := ratelimit.NewLimiter(cacheConfig, cfg.QuotasCacheTTL, domainsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create message rate limiter: %s", err))
+		exitCode = 1
+		return
+	}
+
+	lastValuesClient, err := redisclient.Connect(cfg.LastValueCacheURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to last value cache: %s", err))
+		exitCode = 1
+		return
+	}
+	defer lastValuesClient.Close()
+	lastValues := lvcache.NewStore(lastValuesClient, cfg.LastValueKeyDuration)
+	if err := lastvalue.Subscribe(ctx, nps, lastValues); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe last value store to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+
 	resolver := messaging.NewTopicResolver(channelsClient, domainsClient)
-	handler, err := newHandler(nps, authn, cacheConfig, clientsClient, channelsClient, domainsClient, logger, tracer)
+	handler, err := newHandler(nps, authn, cacheConfig, clientsClient, channelsClient, domainsClient, validator, limiter, logger, tracer)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create service: %s", err))
 		exitCode = 1
 		return
 	}
-	svc := newService(clientsClient, channelsClient, authn, nps, logger, tracer)
+	svc := newService(clientsClient, channelsClient, authn, nps, validator, limiter, lastValues, logger, tracer)
 
 	targetServerCfg := server.Config{Port: targetHTTPPort}
 
@@ -261,12 +310,12 @@ func main() {
 	}
 }
 
-func newHandler(pubsub messaging.PubSub, authn smqauthn.Authentication, cacheCfg messaging.CacheConfig, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, domains grpcDomainsV1.DomainsServiceClient, logger *slog.Logger, tracer trace.Tracer) (session.Handler, error) {
+func newHandler(pubsub messaging.PubSub, authn smqauthn.Authentication, cacheCfg messaging.CacheConfig, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, domains grpcDomainsV1.DomainsServiceClient, validator schema.Validator, limiter ratelimit.Limiter, logger *slog.Logger, tracer trace.Tracer) (session.Handler, error) {
 	parser, err := messaging.NewTopicParser(cacheCfg, channels, domains)
 	if err != nil {
 		return nil, err
 	}
-	h := adapter.NewHandler(pubsub, logger, authn, clients, channels, parser)
+	h := adapter.NewHandler(pubsub, logger, authn, clients, channels, parser, validator, limiter)
 	h = handler.NewTracing(tracer, h)
 	h = handler.NewLogging(h, logger)
 	counter, latency := prometheus.MakeMetrics(svcName, "handler")
@@ -275,8 +324,8 @@ func newHandler(pubsub messaging.PubSub, authn smqauthn.Authentication, cacheCfg
 	return h, nil
 }
 
-func newService(clientsClient grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, nps messaging.PubSub, logger *slog.Logger, tracer trace.Tracer) adapter.Service {
-	svc := adapter.NewService(clientsClient, channels, authn, nps)
+func newService(clientsClient grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, nps messaging.PubSub, validator schema.Validator, limiter ratelimit.Limiter, lastValues lastvalue.Store, logger *slog.Logger, tracer trace.Tracer) adapter.Service {
+	svc := adapter.NewService(clientsClient, channels, authn, nps, validator, limiter, lastValues)
 	svc = middleware.NewTracing(tracer, svc)
 	svc = middleware.NewLogging(svc, logger)
 	counter, latency := prometheus.MakeMetrics(svcName, "api")
@@ -292,40 +341,66 @@ func proxyHTTP(ctx context.Context, cfg server.Config, logger *slog.Logger, sess
 		TargetPort:     targetHTTPPort,
 		TargetPath:     targetHTTPPath,
 	}
-	if cfg.CertFile != "" || cfg.KeyFile != "" {
-		tlsCert, err := server.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
-		if err != nil {
-			return err
-		}
-		config.TLSConfig = &tls.Config{
-			Certificates: []tls.Certificate{tlsCert},
-		}
-	}
-	mp, err := mgatehttp.NewProxy(config, sessionHandler, logger, []string{}, []string{"/health", "/metrics"})
+	mp, err := mgatehttp.NewProxy(config, sessionHandler, logger, []string{}, []string{"/health", "/metrics", muxWSPath})
 	if err != nil {
 		return err
 	}
-	http.HandleFunc("/", mp.ServeHTTP)
 
-	errCh := make(chan error)
+	// The proxy is served directly, instead of using its listener, so the
+	// request metadata and the client certificate reach the session handler.
+	l, err := net.Listen("tcp", net.JoinHostPort(config.Host, config.Port))
+	if err != nil {
+		return err
+	}
 	switch {
 	case cfg.CertFile != "" || cfg.KeyFile != "":
-		go func() {
-			errCh <- mp.Listen(ctx)
-		}()
+		tlsConfig, err := proxyTLSConfig(cfg)
+		if err != nil {
+			return err
+		}
+		l = tls.NewListener(l, tlsConfig)
 		logger.Info(fmt.Sprintf("%s service HTTPS server listening at %s:%s with TLS", svcName, cfg.Host, cfg.Port))
 	default:
-		go func() {
-			errCh <- mp.Listen(ctx)
-		}()
 		logger.Info(fmt.Sprintf("%s service HTTP server listening at %s:%s without TLS", svcName, cfg.Host, cfg.Port))
 	}
 
+	srv := &http.Server{
+		Handler:           adapter.WithMetadata(adapter.WithClientCert(mp)),
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+	}
+	errCh := make(chan error)
+	go func() {
+		errCh <- srv.Serve(l)
+	}()
+
 	select {
 	case <-ctx.Done():
 		logger.Info(fmt.Sprintf("proxy HTTP shutdown at %s:%s", config.Host, config.Port))
-		return nil
+		return srv.Close()
 	case err := <-errCh:
 		return err
 	}
 }
+
+// proxyTLSConfig returns the TLS configuration of the proxy. If the client CA
+// certificates are set, the clients may authenticate with the certificate
+// issued by the CA instead of the secret.
+func proxyTLSConfig(cfg server.Config) (*tls.Config, error) {
+	tlsCert, err := server.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{tlsCert},
+	}
+	if cfg.ClientCAFile != "" {
+		clientCAs, err := server.LoadRootCACerts(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsConfig, nil
+}
@@ -6,6 +6,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"log"
@@ -22,6 +23,7 @@ import (
 	mgatemqtt "github.com/absmach/mgate/pkg/mqtt"
 	"github.com/absmach/mgate/pkg/mqtt/websocket"
 	"github.com/absmach/mgate/pkg/session"
+	mgtls "github.com/absmach/mgate/pkg/tls"
 	"github.com/absmach/supermq"
 	smqlog "github.com/absmach/supermq/logger"
 	"github.com/absmach/supermq/mqtt"
@@ -37,6 +39,9 @@ import (
 	msgevents "github.com/absmach/supermq/pkg/messaging/events"
 	"github.com/absmach/supermq/pkg/messaging/handler"
 	mqttpub "github.com/absmach/supermq/pkg/messaging/mqtt"
+	"github.com/absmach/supermq/pkg/prometheus"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/absmach/supermq/pkg/server"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/caarlos0/env/v11"
@@ -51,6 +56,8 @@ const (
 	envPrefixClients  = "SMQ_CLIENTS_GRPC_"
 	envPrefixChannels = "SMQ_CHANNELS_GRPC_"
 	envPrefixDomains  = "SMQ_DOMAINS_GRPC_"
+	envPrefixRetry    = "SMQ_MQTT_ADAPTER_FORWARDER_RETRY_"
+	envPrefixTLS      = "SMQ_MQTT_ADAPTER_MQTT_"
 	wsPathPrefix      = "/mqtt"
 )
 
@@ -77,6 +84,8 @@ type config struct {
 	InstanceID            string        `env:"SMQ_MQTT_ADAPTER_INSTANCE_ID"                  envDefault:""`
 	ESURL                 string        `env:"SMQ_ES_URL"                                    envDefault:"nats://localhost:4222"`
 	TraceRatio            float64       `env:"SMQ_JAEGER_TRACE_RATIO"                        envDefault:"1.0"`
+	SchemaCacheTTL        time.Duration `env:"SMQ_MQTT_ADAPTER_SCHEMA_CACHE_TTL"             envDefault:"1m"`
+	QuotasCacheTTL        time.Duration `env:"SMQ_MQTT_ADAPTER_QUOTAS_CACHE_TTL"             envDefault:"1m"`
 }
 
 func main() {
@@ -152,7 +161,14 @@ func main() {
 	}
 	defer mpub.Close()
 
-	fwd := mqtt.NewForwarder(brokers.SubjectAllMessages, logger)
+	retryPolicy := messaging.RetryPolicy{}
+	if err := env.ParseWithOptions(&retryPolicy, env.Options{Prefix: envPrefixRetry}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load forwarder retry configuration : %s", err))
+		exitCode = 1
+		return
+	}
+
+	fwd := mqtt.NewForwarder(brokers.SubjectAllMessages, retryPolicy, logger)
 	fwd = mqtttracing.New(serverConfig, tracer, fwd, brokers.SubjectAllMessages)
 	if err := fwd.Forward(ctx, svcName, bsub, mpub); err != nil {
 		logger.Error(fmt.Sprintf("failed to forward message broker messages: %s", err))
@@ -235,7 +251,21 @@ func main() {
 		return
 	}
 
-	h := mqtt.NewHandler(np, logger, clientsClient, channelsClient, parser)
+	validator, err := schema.NewValidator(cacheConfig, cfg.SchemaCacheTTL, channelsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create schema validator: %s", err))
+		exitCode = 1
+		return
+	}
+
+	limiter, err := ratelimit.NewLimiter(cacheConfig, cfg.QuotasCacheTTL, domainsClient)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create message rate limiter: %s", err))
+		exitCode = 1
+		return
+	}
+
+	h := mqtt.NewHandler(np, logger, clientsClient, channelsClient, parser, validator, limiter)
 
 	h, err = events.NewEventStoreMiddleware(ctx, h, cfg.ESURL, cfg.Instance)
 	if err != nil {
@@ -245,6 +275,8 @@ func main() {
 	}
 
 	h = handler.NewTracing(tracer, h)
+	counter, latency := prometheus.MakeMetrics(svcName, "handler")
+	h = handler.NewMetrics(h, counter, latency)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -284,6 +316,19 @@ func proxyMQTT(ctx context.Context, cfg config, logger *slog.Logger, sessionHand
 		TargetHost: cfg.MQTTTargetHost,
 		TargetPort: cfg.MQTTTargetPort,
 	}
+	tlsCfg, err := mgtls.NewConfig(env.Options{Prefix: envPrefixTLS})
+	if err != nil {
+		return err
+	}
+	config.TLSConfig, err = mgtls.LoadTLSConfig(&tlsCfg, &tls.Config{})
+	if err != nil {
+		return err
+	}
+	// The clients with the certificate issued by the client CA authenticate
+	// with it, while the others keep using the secret.
+	if config.TLSConfig != nil && config.TLSConfig.ClientCAs != nil {
+		config.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
 	mproxy := mgatemqtt.New(config, sessionHandler, beforeHandler, afterHandler, logger)
 
 	errCh := make(chan error)
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
@@ -25,6 +26,7 @@ import (
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authsvcAuthn "github.com/absmach/supermq/pkg/authn/authsvc"
 	jwksAuthn "github.com/absmach/supermq/pkg/authn/jwks"
+	jwksConsumer "github.com/absmach/supermq/pkg/authn/jwks/consumer"
 	smqauthz "github.com/absmach/supermq/pkg/authz"
 	authsvcAuthz "github.com/absmach/supermq/pkg/authz/authsvc"
 	domainsAuthz "github.com/absmach/supermq/pkg/domains/grpcclient"
@@ -32,6 +34,7 @@ import (
 	jaegerclient "github.com/absmach/supermq/pkg/jaeger"
 	"github.com/absmach/supermq/pkg/oauth2"
 	googleoauth "github.com/absmach/supermq/pkg/oauth2/google"
+	"github.com/absmach/supermq/pkg/oauth2/oidc"
 	"github.com/absmach/supermq/pkg/policies"
 	"github.com/absmach/supermq/pkg/policies/spicedb"
 	pg "github.com/absmach/supermq/pkg/postgres"
@@ -50,6 +53,7 @@ import (
 	"github.com/absmach/supermq/users/middleware"
 	"github.com/absmach/supermq/users/postgres"
 	pusers "github.com/absmach/supermq/users/private"
+	"github.com/absmach/supermq/users/totp"
 	"github.com/authzed/authzed-go/v1"
 	"github.com/authzed/grpcutil"
 	"github.com/caarlos0/env/v11"
@@ -69,6 +73,7 @@ const (
 	envPrefixAuth    = "SMQ_AUTH_GRPC_"
 	envPrefixDomains = "SMQ_DOMAINS_GRPC_"
 	envPrefixGoogle  = "SMQ_GOOGLE_"
+	envPrefixOIDC    = "SMQ_OIDC_"
 	defDB            = "users"
 	defSvcHTTPPort   = "9002"
 	defSvcGRPCPort   = "7002"
@@ -101,6 +106,8 @@ type config struct {
 	VerificationEmailTemplate  string        `env:"SMQ_VERIFICATION_EMAIL_TEMPLATE"       envDefault:"verification-email.tmpl"`
 	AuthKeyAlgorithm           string        `env:"SMQ_AUTH_KEYS_ALGORITHM"               envDefault:"RS256"`
 	JWKSURL                    string        `env:"SMQ_AUTH_JWKS_URL"                     envDefault:"http://auth:9001/keys/.well-known/jwks.json"`
+	MFAIssuer                  string        `env:"SMQ_USERS_MFA_ISSUER"                  envDefault:"SuperMQ"`
+	OIDCProviders              []string      `env:"SMQ_OIDC_PROVIDERS"                    envDefault:""`
 	PassRegex                  *regexp.Regexp
 }
 
@@ -208,7 +215,13 @@ func main() {
 	var authnClient grpcclient.Handler
 	switch {
 	case !isSymmetric:
-		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authClientConfig)
+		revocations, err := jwksConsumer.RevocationsSubscribe(ctx, cfg.ESURL, svcName, authClientConfig, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to token revocations : %s", err))
+			exitCode = 1
+			return
+		}
+		authn, authnClient, err = jwksAuthn.NewAuthentication(ctx, cfg.JWKSURL, authClientConfig, revocations)
 		if err != nil {
 			logger.Error(err.Error())
 			exitCode = 1
@@ -265,7 +278,7 @@ func main() {
 		return
 	}
 
-	psvc := pusers.New(repo)
+	psvc := pusers.New(repo, policyService)
 
 	grpcServerConfig := server.Config{Port: defSvcGRPCPort}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixGRPC}); err != nil {
@@ -293,11 +306,27 @@ func main() {
 		exitCode = 1
 		return
 	}
-	oauthProvider := googleoauth.NewProvider(oauthConfig, cfg.OAuthUIRedirectURL, cfg.OAuthUIErrorURL)
+	oauthProviders := []oauth2.Provider{googleoauth.NewProvider(oauthConfig, cfg.OAuthUIRedirectURL, cfg.OAuthUIErrorURL)}
+	for _, name := range cfg.OIDCProviders {
+		oidcConfig := oidc.Config{}
+		prefix := envPrefixOIDC + strings.ToUpper(name) + "_"
+		if err := env.ParseWithOptions(&oidcConfig, env.Options{Prefix: prefix}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load %s OIDC provider %s configuration : %s", svcName, name, err.Error()))
+			exitCode = 1
+			return
+		}
+		oidcProvider, err := oidc.NewProvider(ctx, name, oidcConfig, cfg.OAuthUIRedirectURL, cfg.OAuthUIErrorURL)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create %s OIDC provider %s : %s", svcName, name, err.Error()))
+			exitCode = 1
+			return
+		}
+		oauthProviders = append(oauthProviders, oidcProvider)
+	}
 
 	mux := chi.NewRouter()
 	idp := uuid.New()
-	httpSrv := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(csvc, authnMiddleware, tokenClient, cfg.SelfRegister, mux, logger, cfg.InstanceID, cfg.PassRegex, idp, oauthProvider), logger)
+	httpSrv := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(csvc, authnMiddleware, tokenClient, cfg.SelfRegister, mux, logger, cfg.InstanceID, cfg.PassRegex, idp, oauthProviders...), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, supermq.Version, logger, cancel)
@@ -337,7 +366,7 @@ func newService(ctx context.Context, authz smqauthz.Authorization, token grpcTok
 		return nil, err
 	}
 
-	svc := users.NewService(token, repo, policyService, emailerClient, hsr, idp)
+	svc := users.NewService(token, repo, policyService, emailerClient, hsr, idp, totp.New(c.MFAIssuer, time.Now), domainsClient, logger)
 
 	svc, err = events.NewEventStoreMiddleware(ctx, svc, c.ESURL)
 	if err != nil {
@@ -399,7 +428,7 @@ func createAdmin(ctx context.Context, c config, repo users.Repository, hsr users
 	if _, err = repo.Save(ctx, user); err != nil {
 		return "", err
 	}
-	if _, err = svc.IssueToken(ctx, c.AdminUsername, c.AdminPassword); err != nil {
+	if _, _, err = svc.IssueToken(ctx, c.AdminUsername, c.AdminPassword); err != nil {
 		return "", err
 	}
 	return user.ID, nil
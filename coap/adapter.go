@@ -16,10 +16,14 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
 	"github.com/absmach/supermq/pkg/policies"
 )
 
-var errFailedToDisconnectClient = errors.New("failed to disconnect client")
+var (
+	errFailedToDisconnectClient = errors.New("failed to disconnect client")
+	errFailedLastValues         = errors.New("failed to retrieve last values of a channel")
+)
 
 // Service specifies CoAP service API.
 type Service interface {
@@ -28,31 +32,38 @@ type Service interface {
 	Publish(ctx context.Context, key string, msg *messaging.Message, topicType messaging.TopicType) error
 
 	// Subscribes to channel with specified id, domainID, subtopic and adds subscription to
-	// service map of subscriptions under given ID.
-	Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, c Client) error
+	// service map of subscriptions under given ID. Replay optionally defines the point
+	// in the channel history from which the messages are delivered.
+	Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, replay messaging.Replay, c Client) error
 
 	// Unsubscribe method is used to stop observing resource.
 	Unsubscribe(ctx context.Context, key, domainID, chanID, subptopic, token string) error
 
 	// DisconnectHandler method is used to disconnected the client
 	DisconnectHandler(ctx context.Context, domainID, chanID, subptopic, token string) error
+
+	// LastValues returns the last messages published to the channel.
+	// Key is used to authorize subscriber. Subtopic is optional.
+	LastValues(ctx context.Context, key, domainID, chanID, subtopic string) ([]*messaging.Message, error)
 }
 
 var _ Service = (*adapterService)(nil)
 
 // Observers is a map of maps,.
 type adapterService struct {
-	clients  grpcClientsV1.ClientsServiceClient
-	channels grpcChannelsV1.ChannelsServiceClient
-	pubsub   messaging.PubSub
+	clients    grpcClientsV1.ClientsServiceClient
+	channels   grpcChannelsV1.ChannelsServiceClient
+	pubsub     messaging.PubSub
+	lastValues lastvalue.Store
 }
 
 // New instantiates the CoAP adapter implementation.
-func New(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, pubsub messaging.PubSub) Service {
+func New(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, pubsub messaging.PubSub, lastValues lastvalue.Store) Service {
 	as := &adapterService{
-		clients:  clients,
-		channels: channels,
-		pubsub:   pubsub,
+		clients:    clients,
+		channels:   channels,
+		pubsub:     pubsub,
+		lastValues: lastValues,
 	}
 
 	return as
@@ -78,7 +89,7 @@ func (svc *adapterService) Publish(ctx context.Context, key string, msg *messagi
 	return svc.pubsub.Publish(ctx, messaging.EncodeMessageTopic(msg), msg)
 }
 
-func (svc *adapterService) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, c Client) error {
+func (svc *adapterService) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, replay messaging.Replay, c Client) error {
 	authnRes, err := svc.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{
 		Token: authn.AuthPack(authn.DomainAuth, domainID, key),
 	})
@@ -99,6 +110,7 @@ func (svc *adapterService) Subscribe(ctx context.Context, key, domainID, chanID,
 		Topic:    subject,
 		Handler:  authzc,
 	}
+	replay.Apply(&subCfg)
 	return svc.pubsub.Subscribe(ctx, subCfg)
 }
 
@@ -123,6 +135,41 @@ func (svc *adapterService) DisconnectHandler(ctx context.Context, domainID, chan
 	return svc.pubsub.Unsubscribe(ctx, token, subject)
 }
 
+// LastValues authorizes the client itself since the proxy
+// does not authorize GET requests without observe option.
+func (svc *adapterService) LastValues(ctx context.Context, key, domainID, chanID, subtopic string) ([]*messaging.Message, error) {
+	authnRes, err := svc.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{
+		Token: authn.AuthPack(authn.DomainAuth, domainID, key),
+	})
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if !authnRes.Authenticated {
+		return nil, svcerr.ErrAuthentication
+	}
+
+	authzRes, err := svc.channels.Authorize(ctx, &grpcChannelsV1.AuthzReq{
+		ClientId:   authnRes.GetId(),
+		ClientType: policies.ClientType,
+		ChannelId:  chanID,
+		DomainId:   domainID,
+		Type:       uint32(connections.Subscribe),
+	})
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !authzRes.GetAuthorized() {
+		return nil, svcerr.ErrAuthorization
+	}
+
+	msgs, err := svc.lastValues.Retrieve(ctx, domainID, chanID, subtopic)
+	if err != nil {
+		return nil, errors.Wrap(errFailedLastValues, err)
+	}
+
+	return msgs, nil
+}
+
 type authzClient interface {
 	// Handle handles incoming messages.
 	Handle(m *messaging.Message) error
@@ -4,11 +4,14 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
 	"github.com/go-chi/chi/v5"
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/go-coap/v3/message/codes"
@@ -27,9 +31,10 @@ import (
 )
 
 const (
-	protocol     = "coap"
-	authQuery    = "auth"
-	startObserve = 0 // observe option value that indicates start of observation
+	protocol      = "coap"
+	authQuery     = "auth"
+	subtopicQuery = "subtopic"
+	startObserve  = 0 // observe option value that indicates start of observation
 )
 
 var (
@@ -88,6 +93,10 @@ func (h *CoAPHandler) ServeCOAP(w mux.ResponseWriter, m *mux.Message) {
 	switch m.Code() {
 	case codes.GET:
 		resp.SetCode(codes.Content)
+		if msg.GetSubtopic() == lastvalue.Subtopic {
+			err = h.handleLastValues(m, resp, msg, key)
+			break
+		}
 		err = h.handleGet(m, w, topicType, msg, key)
 	case codes.POST:
 		resp.SetCode(codes.Created)
@@ -120,15 +129,51 @@ func (h *CoAPHandler) handleGet(m *mux.Message, w mux.ResponseWriter, topicType
 		return errBadOptions
 	}
 	if obs == startObserve {
+		replay, err := parseReplay(m)
+		if err != nil {
+			h.logger.Warn(fmt.Sprintf("Error parsing replay options: %s", err))
+			return errBadOptions
+		}
 		c := coap.NewClient(w.Conn(), m.Token(), h.logger)
 		w.Conn().AddOnClose(func() {
 			_ = h.service.DisconnectHandler(context.Background(), msg.GetDomain(), msg.GetChannel(), msg.GetSubtopic(), c.Token())
 		})
-		return h.service.Subscribe(w.Conn().Context(), key, msg.GetDomain(), msg.GetChannel(), msg.GetSubtopic(), c)
+		return h.service.Subscribe(w.Conn().Context(), key, msg.GetDomain(), msg.GetChannel(), msg.GetSubtopic(), replay, c)
 	}
 	return h.service.Unsubscribe(w.Conn().Context(), key, msg.GetDomain(), msg.GetChannel(), msg.GetSubtopic(), m.Token().String())
 }
 
+func (h *CoAPHandler) handleLastValues(m *mux.Message, resp *pool.Message, msg *messaging.Message, key string) error {
+	subtopic, err := parseSubtopic(m)
+	if err != nil {
+		h.logger.Warn(fmt.Sprintf("Error parsing subtopic: %s", err))
+		return errBadOptions
+	}
+	msgs, err := h.service.LastValues(m.Context(), key, msg.GetDomain(), msg.GetChannel(), subtopic)
+	if err != nil {
+		return err
+	}
+
+	res := lastValuesRes{Messages: []lastValue{}}
+	for _, lv := range msgs {
+		res.Messages = append(res.Messages, lastValue{
+			Subtopic:  lv.GetSubtopic(),
+			Publisher: lv.GetPublisher(),
+			Protocol:  lv.GetProtocol(),
+			Payload:   lv.GetPayload(),
+			Created:   lv.GetCreated(),
+		})
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	resp.SetContentFormat(message.AppJSON)
+	resp.SetBody(bytes.NewReader(data))
+
+	return nil
+}
+
 func (h *CoAPHandler) decodeMessage(msg *mux.Message) (*messaging.Message, messaging.TopicType, error) {
 	if msg.Options() == nil {
 		return &messaging.Message{}, messaging.InvalidType, errBadOptions
@@ -151,12 +196,13 @@ func (h *CoAPHandler) decodeMessage(msg *mux.Message) (*messaging.Message, messa
 	}
 
 	ret := &messaging.Message{
-		Protocol: protocol,
-		Domain:   domainID,
-		Channel:  channelID,
-		Subtopic: subTopic,
-		Payload:  []byte{},
-		Created:  time.Now().UnixNano(),
+		Protocol:    protocol,
+		Domain:      domainID,
+		Channel:     channelID,
+		Subtopic:    subTopic,
+		Payload:     []byte{},
+		Created:     time.Now().UnixNano(),
+		ContentType: contentType(msg),
 	}
 
 	if msg.Body() != nil {
@@ -169,6 +215,24 @@ func (h *CoAPHandler) decodeMessage(msg *mux.Message) (*messaging.Message, messa
 	return ret, topicType, nil
 }
 
+// contentType returns the media type of the registered content format option.
+// Unknown and missing content formats are not propagated.
+func contentType(msg *mux.Message) string {
+	cf, err := msg.Options().ContentFormat()
+	if err != nil {
+		return ""
+	}
+	if _, err := message.ToMediaType(cf.String()); err != nil {
+		return ""
+	}
+	ct, err := messaging.ParseContentType(cf.String())
+	if err != nil {
+		return ""
+	}
+
+	return ct
+}
+
 func (h *CoAPHandler) sendResp(w mux.ResponseWriter, resp *pool.Message) {
 	if err := w.Conn().WriteMessage(resp); err != nil {
 		h.logger.Warn(fmt.Sprintf("Can't set response: %s", err))
@@ -186,3 +250,46 @@ func parseKey(msg *mux.Message) (string, error) {
 	}
 	return vars[1], nil
 }
+
+// parseSubtopic returns the optional subtopic of the last values request.
+func parseSubtopic(msg *mux.Message) (string, error) {
+	queries, err := msg.Options().Queries()
+	if err != nil {
+		return "", err
+	}
+	for _, q := range queries {
+		if val, ok := strings.CutPrefix(q, subtopicQuery+"="); ok {
+			return messaging.ParsePublishSubtopic(val)
+		}
+	}
+
+	return "", nil
+}
+
+// parseReplay returns the optional replay options of the observe request.
+func parseReplay(msg *mux.Message) (messaging.Replay, error) {
+	queries, err := msg.Options().Queries()
+	if err != nil {
+		return messaging.Replay{}, err
+	}
+	vals := url.Values{}
+	for _, q := range queries {
+		if key, val, ok := strings.Cut(q, "="); ok {
+			vals.Set(key, val)
+		}
+	}
+
+	return messaging.ParseReplay(vals)
+}
+
+type lastValue struct {
+	Subtopic  string `json:"subtopic,omitempty"`
+	Publisher string `json:"publisher"`
+	Protocol  string `json:"protocol"`
+	Payload   []byte `json:"payload"`
+	Created   int64  `json:"created"`
+}
+
+type lastValuesRes struct {
+	Messages []lastValue `json:"messages"`
+}
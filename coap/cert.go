@@ -0,0 +1,49 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package coap
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+)
+
+const certVerifyTimeout = 5 * time.Second
+
+// CertVerifier verifies the peer certificate in the DTLS handshake.
+type CertVerifier func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+
+// NewCertVerifier returns the DTLS peer certificate verifier which accepts only
+// the certificates bound to the enabled clients, after the certificate passed
+// the given verification. The clients which present no certificate are left
+// to authenticate with the secret.
+func NewCertVerifier(clients grpcClientsV1.ClientsServiceClient, next CertVerifier) CertVerifier {
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if next != nil {
+			if err := next(rawCerts, verifiedChains); err != nil {
+				return err
+			}
+		}
+		if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), certVerifyTimeout)
+		defer cancel()
+		res, err := clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{Token: smqauthn.CertAuthPack(verifiedChains[0][0])})
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthentication, err)
+		}
+		if !res.GetAuthenticated() {
+			return svcerr.ErrAuthentication
+		}
+
+		return nil
+	}
+}
@@ -80,10 +80,11 @@ func (c *client) Handle(msg *messaging.Message) error {
 	atomic.AddUint32(&c.observe, 1)
 	var opts message.Options
 	var buff []byte
-	opts, n, err := opts.SetContentFormat(buff, message.TextPlain)
+	cf := mediaType(msg.GetContentType())
+	opts, n, err := opts.SetContentFormat(buff, cf)
 	if err == message.ErrTooSmall {
 		buff = append(buff, make([]byte, n)...)
-		_, _, err = opts.SetContentFormat(buff, message.TextPlain)
+		_, _, err = opts.SetContentFormat(buff, cf)
 	}
 	if err != nil {
 		c.logger.Error(fmt.Sprintf("Can't set content format: %s.", err))
@@ -103,3 +104,14 @@ func (c *client) Handle(msg *messaging.Message) error {
 	}
 	return c.conn.WriteMessage(pm)
 }
+
+// mediaType returns the CoAP content format of the message content type.
+// Messages without content type or with the content type which has no
+// registered content format are sent as plain text.
+func mediaType(ct string) message.MediaType {
+	if mt, err := message.ToMediaType(ct); err == nil {
+		return mt
+	}
+
+	return message.TextPlain
+}
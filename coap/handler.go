@@ -20,6 +20,8 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 var _ session.Handler = (*handler)(nil)
@@ -36,22 +38,28 @@ var (
 	errMissingTopicPub      = errors.New("failed to publish due to missing topic")
 	errMissingTopicSub      = errors.New("failed to subscribe due to missing topic")
 	errFailedPublish        = errors.New("failed to publish")
+	errValidatePayload      = errors.New("failed to validate message payload")
+	errLimitRate            = errors.New("failed to check domain message rate")
 )
 
 type handler struct {
-	clients  grpcClientsV1.ClientsServiceClient
-	channels grpcChannelsV1.ChannelsServiceClient
-	logger   *slog.Logger
-	parser   messaging.TopicParser
+	clients   grpcClientsV1.ClientsServiceClient
+	channels  grpcChannelsV1.ChannelsServiceClient
+	logger    *slog.Logger
+	parser    messaging.TopicParser
+	validator schema.Validator
+	limiter   ratelimit.Limiter
 }
 
 // NewHandler creates new Handler entity.
-func NewHandler(logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser) session.Handler {
+func NewHandler(logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser, validator schema.Validator, limiter ratelimit.Limiter) session.Handler {
 	return &handler{
-		logger:   logger,
-		clients:  clients,
-		channels: channels,
-		parser:   parser,
+		logger:    logger,
+		clients:   clients,
+		channels:  channels,
+		parser:    parser,
+		validator: validator,
+		limiter:   limiter,
 	}
 }
 
@@ -83,6 +91,24 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 	}
 	s.Username = clientID
 
+	if topicType == messaging.MessageType {
+		if err := h.limiter.Allow(ctx, domainID); err != nil {
+			if errors.Contains(err, ratelimit.ErrRateExceeded) {
+				return mgate.NewCOAPProxyError(http.StatusTooManyRequests, err)
+			}
+			return mgate.NewCOAPProxyError(http.StatusInternalServerError, errors.Wrap(errLimitRate, err))
+		}
+	}
+
+	if topicType == messaging.MessageType && payload != nil {
+		if err := h.validator.Validate(ctx, domainID, channelID, *payload); err != nil {
+			if schema.IsViolation(err) {
+				return mgate.NewCOAPProxyError(http.StatusBadRequest, err)
+			}
+			return mgate.NewCOAPProxyError(http.StatusInternalServerError, errors.Wrap(errValidatePayload, err))
+		}
+	}
+
 	return nil
 }
 
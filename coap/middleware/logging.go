@@ -51,7 +51,7 @@ func (lm *loggingMiddleware) Publish(ctx context.Context, key string, msg *messa
 
 // Subscribe logs the subscribe request. It logs the channel ID, subtopic (if any) and the time it took to complete the request.
 // If the request fails, it logs the error.
-func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, c coap.Client) (err error) {
+func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, replay messaging.Replay, c coap.Client) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -61,6 +61,14 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, domainID, chanI
 		if subtopic != "" {
 			args = append(args, slog.String("subtopic", subtopic))
 		}
+		switch {
+		case !replay.StartTime.IsZero():
+			args = append(args, slog.Time("replay_start_time", replay.StartTime))
+		case replay.StartSequence > 0:
+			args = append(args, slog.Uint64("replay_start_seq", replay.StartSequence))
+		case replay.LastN > 0:
+			args = append(args, slog.Uint64("replay_last", replay.LastN))
+		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
 			lm.logger.Warn("Subscribe failed", args...)
@@ -69,7 +77,7 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, domainID, chanI
 		lm.logger.Info("Subscribe completed successfully", args...)
 	}(time.Now())
 
-	return lm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, c)
+	return lm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, replay, c)
 }
 
 // Unsubscribe logs the unsubscribe request. It logs the channel ID, subtopic (if any) and the time it took to complete the request.
@@ -118,3 +126,26 @@ func (lm *loggingMiddleware) DisconnectHandler(ctx context.Context, domainID, ch
 
 	return lm.svc.DisconnectHandler(ctx, domainID, chanID, subtopic, token)
 }
+
+// LastValues logs the last values request. It logs the channel ID, subtopic (if any) and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) LastValues(ctx context.Context, key, domainID, chanID, subtopic string) (msgs []*messaging.Message, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", chanID),
+			slog.String("domain_id", domainID),
+		}
+		if subtopic != "" {
+			args = append(args, slog.String("subtopic", subtopic))
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve last values failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve last values completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.LastValues(ctx, key, domainID, chanID, subtopic)
+}
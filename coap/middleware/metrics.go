@@ -42,13 +42,13 @@ func (mm *metricsMiddleware) Publish(ctx context.Context, key string, msg *messa
 }
 
 // Subscribe instruments Subscribe method with metrics.
-func (mm *metricsMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, c coap.Client) error {
+func (mm *metricsMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, replay messaging.Replay, c coap.Client) error {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "subscribe").Add(1)
 		mm.latency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return mm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, c)
+	return mm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, replay, c)
 }
 
 // Unsubscribe instruments Unsubscribe method with metrics.
@@ -70,3 +70,13 @@ func (mm *metricsMiddleware) DisconnectHandler(ctx context.Context, domainID, ch
 
 	return mm.svc.DisconnectHandler(ctx, domainID, chanID, subtopic, token)
 }
+
+// LastValues instruments LastValues method with metrics.
+func (mm *metricsMiddleware) LastValues(ctx context.Context, key, domainID, chanID, subtopic string) ([]*messaging.Message, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "last_values").Add(1)
+		mm.latency.With("method", "last_values").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.LastValues(ctx, key, domainID, chanID, subtopic)
+}
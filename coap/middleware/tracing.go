@@ -20,6 +20,7 @@ const (
 	subscribeOP         = "subscribe_op"
 	unsubscribeOP       = "unsubscribe_op"
 	disconnectHandlerOp = "disconnect_handler_op"
+	lastValuesOP        = "last_values_op"
 )
 
 // tracingServiceMiddleware is a middleware implementation for tracing CoAP service operations using OpenTelemetry.
@@ -48,14 +49,14 @@ func (tm *tracingServiceMiddleware) Publish(ctx context.Context, key string, msg
 }
 
 // Subscribe traces a CoAP subscribe operation.
-func (tm *tracingServiceMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, c coap.Client) error {
+func (tm *tracingServiceMiddleware) Subscribe(ctx context.Context, key, domainID, chanID, subtopic string, replay messaging.Replay, c coap.Client) error {
 	ctx, span := tm.tracer.Start(ctx, subscribeOP, trace.WithAttributes(
 		attribute.String("channel_id", chanID),
 		attribute.String("domain_id", domainID),
 		attribute.String("subtopic", subtopic),
 	))
 	defer span.End()
-	return tm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, c)
+	return tm.svc.Subscribe(ctx, key, domainID, chanID, subtopic, replay, c)
 }
 
 // Unsubscribe traces a CoAP unsubscribe operation.
@@ -79,3 +80,14 @@ func (tm *tracingServiceMiddleware) DisconnectHandler(ctx context.Context, domai
 	defer span.End()
 	return tm.svc.DisconnectHandler(ctx, domainID, chanID, subptopic, token)
 }
+
+// LastValues traces a CoAP last values operation.
+func (tm *tracingServiceMiddleware) LastValues(ctx context.Context, key, domainID, chanID, subtopic string) ([]*messaging.Message, error) {
+	ctx, span := tm.tracer.Start(ctx, lastValuesOP, trace.WithAttributes(
+		attribute.String("channel_id", chanID),
+		attribute.String("domain_id", domainID),
+		attribute.String("subtopic", subtopic),
+	))
+	defer span.End()
+	return tm.svc.LastValues(ctx, key, domainID, chanID, subtopic)
+}
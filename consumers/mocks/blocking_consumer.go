@@ -0,0 +1,99 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewBlockingConsumer creates a new instance of BlockingConsumer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBlockingConsumer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BlockingConsumer {
+	mock := &BlockingConsumer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// BlockingConsumer is an autogenerated mock type for the BlockingConsumer type
+type BlockingConsumer struct {
+	mock.Mock
+}
+
+type BlockingConsumer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BlockingConsumer) EXPECT() *BlockingConsumer_Expecter {
+	return &BlockingConsumer_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeBlocking provides a mock function for the type BlockingConsumer
+func (_mock *BlockingConsumer) ConsumeBlocking(ctx context.Context, messages any) error {
+	ret := _mock.Called(ctx, messages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeBlocking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any) error); ok {
+		r0 = returnFunc(ctx, messages)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// BlockingConsumer_ConsumeBlocking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeBlocking'
+type BlockingConsumer_ConsumeBlocking_Call struct {
+	*mock.Call
+}
+
+// ConsumeBlocking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messages any
+func (_e *BlockingConsumer_Expecter) ConsumeBlocking(ctx interface{}, messages interface{}) *BlockingConsumer_ConsumeBlocking_Call {
+	return &BlockingConsumer_ConsumeBlocking_Call{Call: _e.mock.On("ConsumeBlocking", ctx, messages)}
+}
+
+func (_c *BlockingConsumer_ConsumeBlocking_Call) Run(run func(ctx context.Context, messages any)) *BlockingConsumer_ConsumeBlocking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BlockingConsumer_ConsumeBlocking_Call) Return(err error) *BlockingConsumer_ConsumeBlocking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *BlockingConsumer_ConsumeBlocking_Call) RunAndReturn(run func(ctx context.Context, messages any) error) *BlockingConsumer_ConsumeBlocking_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package writers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+var (
+	// ErrWriteMessages indicates failure to write the batch of messages.
+	ErrWriteMessages = errors.New("failed to write messages")
+
+	errUnknownMessages = errors.New("unknown messages type")
+)
+
+// batch buffers transformed messages and writes them at once.
+// SenML messages are written together, while JSON messages are
+// grouped by their format.
+type batch struct {
+	mu     sync.Mutex
+	size   int
+	count  int
+	senml  []senml.Message
+	json   map[string][]json.Message
+	writer consumers.BlockingConsumer
+	logger *slog.Logger
+}
+
+func newBatch(writer consumers.BlockingConsumer, size int, logger *slog.Logger) *batch {
+	if size < 1 {
+		size = 1
+	}
+
+	return &batch{
+		size:   size,
+		json:   make(map[string][]json.Message),
+		writer: writer,
+		logger: logger,
+	}
+}
+
+func (b *batch) add(ctx context.Context, msgs any) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch m := msgs.(type) {
+	case []senml.Message:
+		b.senml = append(b.senml, m...)
+		b.count += len(m)
+	case json.Messages:
+		b.json[m.Format] = append(b.json[m.Format], m.Data...)
+		b.count += len(m.Data)
+	default:
+		return errUnknownMessages
+	}
+
+	if b.count < b.size {
+		return nil
+	}
+
+	return b.write(ctx)
+}
+
+func (b *batch) flush(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.write(ctx)
+}
+
+// run periodically writes the messages which did not fill the batch.
+func (b *batch) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := b.flush(context.WithoutCancel(ctx)); err != nil {
+				b.logger.Warn(fmt.Sprintf("Failed to write messages on shutdown: %s", err))
+			}
+			return
+		case <-ticker.C:
+			if err := b.flush(ctx); err != nil {
+				b.logger.Warn(fmt.Sprintf("Failed to write messages: %s", err))
+			}
+		}
+	}
+}
+
+// write writes and resets the buffered messages. Buffered messages are not
+// retained if writing fails; only the message which triggered the write is
+// redelivered by the broker.
+func (b *batch) write(ctx context.Context) error {
+	if b.count == 0 {
+		return nil
+	}
+
+	var errs error
+	if len(b.senml) > 0 {
+		if err := b.writer.ConsumeBlocking(ctx, b.senml); err != nil {
+			errs = errors.Wrap(ErrWriteMessages, err)
+		}
+	}
+	for format, msgs := range b.json {
+		if err := b.writer.ConsumeBlocking(ctx, json.Messages{Format: format, Data: msgs}); err != nil {
+			errs = errors.Wrap(ErrWriteMessages, err)
+		}
+	}
+
+	b.senml = nil
+	b.json = make(map[string][]json.Message)
+	b.count = 0
+
+	return errs
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package writers
+
+import (
+	"os"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/pelletier/go-toml"
+)
+
+const (
+	defBatchSize    = 1
+	defBatchTimeout = time.Second
+)
+
+var (
+	errOpenConfFile  = errors.New("unable to open configuration file")
+	errParseConfFile = errors.New("unable to parse configuration file")
+)
+
+// Config represents the message writer configuration.
+type Config struct {
+	// Subjects are the broker subjects the writer subscribes to.
+	Subjects []string
+	// ContentType is the default messages content type. It is used
+	// when the content type can not be determined from the subtopic.
+	ContentType string
+	// TimeFields are used by the JSON transformer to extract message time.
+	TimeFields []json.TimeField
+	// BatchSize is the maximal number of messages written at once.
+	BatchSize int
+	// BatchTimeout is the maximal time messages are kept in the batch before writing.
+	BatchTimeout time.Duration
+	// RetryPolicy configures redelivery and dead-lettering of the messages which could not be written.
+	RetryPolicy messaging.RetryPolicy
+}
+
+type fileConfig struct {
+	Subscriber struct {
+		Subjects []string `toml:"subjects"`
+	} `toml:"subscriber"`
+	Transformer struct {
+		ContentType string           `toml:"content_type"`
+		TimeFields  []json.TimeField `toml:"time_fields"`
+	} `toml:"transformer"`
+	Batch struct {
+		Size    int    `toml:"size"`
+		Timeout string `toml:"timeout"`
+	} `toml:"batch"`
+}
+
+// DefaultConfig returns the configuration which subscribes to all the
+// messages and writes them one by one as SenML messages.
+func DefaultConfig() Config {
+	return Config{
+		Subjects:     []string{brokers.SubjectAllMessages},
+		ContentType:  senml.JSON,
+		BatchSize:    defBatchSize,
+		BatchTimeout: defBatchTimeout,
+	}
+}
+
+// LoadConfig loads the configuration from the TOML file. Values which are
+// not present in the file are set to the DefaultConfig values.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, errors.Wrap(errOpenConfFile, err)
+	}
+
+	var fc fileConfig
+	if err := toml.Unmarshal(data, &fc); err != nil {
+		return cfg, errors.Wrap(errParseConfFile, err)
+	}
+
+	if len(fc.Subscriber.Subjects) > 0 {
+		cfg.Subjects = fc.Subscriber.Subjects
+	}
+	if fc.Transformer.ContentType != "" {
+		cfg.ContentType = fc.Transformer.ContentType
+	}
+	cfg.TimeFields = fc.Transformer.TimeFields
+	if fc.Batch.Size > 0 {
+		cfg.BatchSize = fc.Batch.Size
+	}
+	if fc.Batch.Timeout != "" {
+		timeout, err := time.ParseDuration(fc.Batch.Timeout)
+		if err != nil {
+			return cfg, errors.Wrap(errParseConfFile, err)
+		}
+		cfg.BatchTimeout = timeout
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package writers contains the runner used by message writers: it subscribes
+// to the message broker, transforms received messages to SenML or JSON format
+// and stores them in batches using the configured writer.
+package writers
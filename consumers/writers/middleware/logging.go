@@ -0,0 +1,51 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+var _ consumers.BlockingConsumer = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger   *slog.Logger
+	consumer consumers.BlockingConsumer
+}
+
+// NewLogging adds logging facilities to the message writer.
+func NewLogging(consumer consumers.BlockingConsumer, logger *slog.Logger) consumers.BlockingConsumer {
+	return &loggingMiddleware{
+		logger:   logger,
+		consumer: consumer,
+	}
+}
+
+func (lm *loggingMiddleware) ConsumeBlocking(ctx context.Context, msgs any) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+		}
+		switch m := msgs.(type) {
+		case []senml.Message:
+			args = append(args, slog.String("format", "senml"), slog.Int("count", len(m)))
+		case json.Messages:
+			args = append(args, slog.String("format", m.Format), slog.Int("count", len(m.Data)))
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Blocking consumer failed to consume messages", args...)
+			return
+		}
+		lm.logger.Info("Blocking consumer consumed messages successfully", args...)
+	}(time.Now())
+
+	return lm.consumer.ConsumeBlocking(ctx, msgs)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ consumers.BlockingConsumer = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter  metrics.Counter
+	latency  metrics.Histogram
+	consumer consumers.BlockingConsumer
+}
+
+// NewMetrics returns new message writer with ConsumeBlocking method wrapped to expose metrics.
+func NewMetrics(consumer consumers.BlockingConsumer, counter metrics.Counter, latency metrics.Histogram) consumers.BlockingConsumer {
+	return &metricsMiddleware{
+		counter:  counter,
+		latency:  latency,
+		consumer: consumer,
+	}
+}
+
+func (mm *metricsMiddleware) ConsumeBlocking(ctx context.Context, msgs any) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "consume_blocking").Add(1)
+		mm.latency.With("method", "consume_blocking").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.consumer.ConsumeBlocking(ctx, msgs)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	jsont "github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+// Postgres error codes:
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const errUndefinedTable = "42P01" // undefined_table
+
+var (
+	// ErrInvalidMessage indicates that service received message that
+	// doesn't fit required format.
+	ErrInvalidMessage = errors.New("invalid message representation")
+
+	errSaveMessage       = errors.New("failed to save message to postgres database")
+	errTransRollback     = errors.New("failed to rollback transaction")
+	errNoTable           = errors.New("relation does not exist")
+	errCreateJSONTable   = errors.New("failed to create JSON messages table")
+	errUnsupportedFormat = errors.New("unsupported messages format")
+)
+
+var _ consumers.BlockingConsumer = (*postgresRepo)(nil)
+
+type postgresRepo struct {
+	db  *sqlx.DB
+	idp supermq.IDProvider
+}
+
+// New returns new PostgreSQL writer.
+func New(db *sqlx.DB, idp supermq.IDProvider) consumers.BlockingConsumer {
+	return &postgresRepo{
+		db:  db,
+		idp: idp,
+	}
+}
+
+func (pr postgresRepo) ConsumeBlocking(ctx context.Context, message any) error {
+	switch m := message.(type) {
+	case jsont.Messages:
+		return pr.saveJSON(ctx, m)
+	case []senml.Message:
+		return pr.saveSenml(ctx, m)
+	default:
+		return errUnsupportedFormat
+	}
+}
+
+func (pr postgresRepo) saveSenml(ctx context.Context, msgs []senml.Message) (err error) {
+	q := `INSERT INTO messages (id, channel, subtopic, publisher, protocol,
+          name, unit, value, string_value, bool_value, data_value, sum,
+          time, update_time)
+          VALUES (:id, :channel, :subtopic, :publisher, :protocol, :name, :unit,
+          :value, :string_value, :bool_value, :data_value, :sum,
+          :time, :update_time);`
+
+	tx, err := pr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+	defer func() {
+		if err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				err = errors.Wrap(err, errors.Wrap(errTransRollback, txErr))
+			}
+			return
+		}
+
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(errSaveMessage, err)
+		}
+	}()
+
+	for _, msg := range msgs {
+		id, err := pr.idp.ID()
+		if err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
+		m := senmlMessage{Message: msg, ID: id}
+		if _, err := tx.NamedExecContext(ctx, q, m); err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
+	}
+
+	return nil
+}
+
+func (pr postgresRepo) saveJSON(ctx context.Context, msgs jsont.Messages) error {
+	if err := pr.insertJSON(ctx, msgs); err != nil {
+		if !errors.Contains(err, errNoTable) {
+			return err
+		}
+		if err := pr.createTable(ctx, msgs.Format); err != nil {
+			return err
+		}
+
+		return pr.insertJSON(ctx, msgs)
+	}
+
+	return nil
+}
+
+func (pr postgresRepo) insertJSON(ctx context.Context, msgs jsont.Messages) (err error) {
+	tx, err := pr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+	defer func() {
+		if err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				err = errors.Wrap(err, errors.Wrap(errTransRollback, txErr))
+			}
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(errSaveMessage, err)
+		}
+	}()
+
+	q := fmt.Sprintf(`INSERT INTO %s (id, channel, created, subtopic, publisher, protocol, payload)
+          VALUES (:id, :channel, :created, :subtopic, :publisher, :protocol, :payload);`, pgx.Identifier{msgs.Format}.Sanitize())
+
+	for _, m := range msgs.Data {
+		id, err := pr.idp.ID()
+		if err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
+		dbmsg, err := toJSONMessage(id, m)
+		if err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
+		if _, err := tx.NamedExecContext(ctx, q, dbmsg); err != nil {
+			if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == errUndefinedTable {
+				return errNoTable
+			}
+			return errors.Wrap(errSaveMessage, err)
+		}
+	}
+
+	return nil
+}
+
+func (pr postgresRepo) createTable(ctx context.Context, name string) error {
+	q := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id        UUID,
+		created   BIGINT,
+		channel   VARCHAR(36),
+		subtopic  VARCHAR(254),
+		publisher VARCHAR(36),
+		protocol  TEXT,
+		payload   JSONB,
+		PRIMARY KEY (id)
+	)`, pgx.Identifier{name}.Sanitize())
+
+	if _, err := pr.db.ExecContext(ctx, q); err != nil {
+		return errors.Wrap(errCreateJSONTable, err)
+	}
+
+	return nil
+}
+
+type senmlMessage struct {
+	senml.Message
+	ID string `db:"id"`
+}
+
+type jsonMessage struct {
+	ID        string `db:"id"`
+	Channel   string `db:"channel"`
+	Created   int64  `db:"created"`
+	Subtopic  string `db:"subtopic"`
+	Publisher string `db:"publisher"`
+	Protocol  string `db:"protocol"`
+	Payload   []byte `db:"payload"`
+}
+
+func toJSONMessage(id string, msg jsont.Message) (jsonMessage, error) {
+	data := []byte("{}")
+	if msg.Payload != nil {
+		b, err := json.Marshal(msg.Payload)
+		if err != nil {
+			return jsonMessage{}, errors.Wrap(ErrInvalidMessage, err)
+		}
+		data = b
+	}
+
+	return jsonMessage{
+		ID:        id,
+		Channel:   msg.Channel,
+		Created:   msg.Created,
+		Subtopic:  msg.Subtopic,
+		Publisher: msg.Publisher,
+		Protocol:  msg.Protocol,
+		Payload:   data,
+	}, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	writerpg "github.com/absmach/supermq/consumers/writers/postgres"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	msgsNum     = 42
+	valueFields = 5
+	subtopic    = "topic"
+)
+
+var (
+	v       float64 = 5
+	stringV         = "value"
+	boolV           = true
+	dataV           = "base64"
+	sum     float64 = 42
+)
+
+func TestSaveSenml(t *testing.T) {
+	repo := writerpg.New(db, uuid.New())
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	msg := senml.Message{
+		Channel:    chanID,
+		Publisher:  pubID,
+		Subtopic:   subtopic,
+		Protocol:   "mqtt",
+		Name:       "name",
+		Unit:       "U",
+		UpdateTime: 5456565466,
+	}
+
+	now := time.Now().UnixNano()
+	var msgs []senml.Message
+	for i := 0; i < msgsNum; i++ {
+		// Mix possible values as well as value sum.
+		count := i % valueFields
+		switch count {
+		case 0:
+			msg.Value = &v
+		case 1:
+			msg.BoolValue = &boolV
+		case 2:
+			msg.StringValue = &stringV
+		case 3:
+			msg.DataValue = &dataV
+		case 4:
+			msg.Sum = &sum
+		}
+
+		msg.Time = float64(now + int64(i))
+		msgs = append(msgs, msg)
+	}
+
+	err := repo.ConsumeBlocking(context.TODO(), msgs)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+
+	var total int
+	err = db.Get(&total, "SELECT COUNT(*) FROM messages WHERE channel = $1", chanID)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+	assert.Equal(t, msgsNum, total, fmt.Sprintf("expected %d messages got %d", msgsNum, total))
+}
+
+func TestSaveJSON(t *testing.T) {
+	repo := writerpg.New(db, uuid.New())
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	msg := json.Message{
+		Channel:   chanID,
+		Publisher: pubID,
+		Created:   time.Now().UnixNano(),
+		Subtopic:  "subtopic/format/some_json",
+		Protocol:  "mqtt",
+		Payload: map[string]any{
+			"field_1": 123,
+			"field_2": "value",
+			"field_3": false,
+			"field_4": 12.344,
+			"field_5": map[string]any{
+				"field_1": "value",
+				"field_2": 42,
+			},
+		},
+	}
+
+	now := time.Now().Unix()
+	msgs := json.Messages{
+		Format: "some_json",
+	}
+	for i := 0; i < msgsNum; i++ {
+		msg.Created = now + int64(i)
+		msgs.Data = append(msgs.Data, msg)
+	}
+
+	cases := []struct {
+		desc string
+		msgs json.Messages
+	}{
+		{
+			desc: "save JSON messages creating the table",
+			msgs: msgs,
+		},
+		{
+			desc: "save JSON messages to existing table",
+			msgs: msgs,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.ConsumeBlocking(context.TODO(), tc.msgs)
+			assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+
+			var total int
+			err = db.Get(&total, "SELECT COUNT(*) FROM some_json WHERE channel = $1", chanID)
+			assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+			assert.Equal(t, (i+1)*msgsNum, total, fmt.Sprintf("%s: expected %d messages got %d", tc.desc, (i+1)*msgsNum, total))
+		})
+	}
+}
+
+func TestSaveUnsupported(t *testing.T) {
+	repo := writerpg.New(db, uuid.New())
+
+	err := repo.ConsumeBlocking(context.TODO(), "unsupported")
+	assert.NotNil(t, err, "expected error saving unsupported messages")
+}
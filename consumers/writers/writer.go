@@ -0,0 +1,116 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package writers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/absmach/supermq/consumers"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/transformers"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+// JSONContentType represents JSON messages content type.
+const JSONContentType = "application/json"
+
+// subtopicContentTypes maps the last subtopic segment to the messages content type.
+var subtopicContentTypes = map[string]string{
+	"senml":      senml.JSON,
+	"senml_json": senml.JSON,
+	"senml_cbor": senml.CBOR,
+	"json":       JSONContentType,
+}
+
+// ErrUnsupportedContentType indicates that the message can not be transformed
+// because there is no transformer for its content type.
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+var _ messaging.MessageHandler = (*runner)(nil)
+
+type runner struct {
+	ctx          context.Context
+	contentType  string
+	transformers map[string]transformers.Transformer
+	batch        *batch
+}
+
+// Start subscribes to the configured subjects and writes received messages
+// using the writer. Every message is transformed either to the list of SenML
+// messages ([]senml.Message) or to the list of JSON messages (json.Messages)
+// depending on its content type, so the writer needs to support both types.
+// Content type is taken from the message if it has a transformer, otherwise
+// it is determined from the last subtopic segment and falls back to the
+// configured content type.
+//
+// Messages are buffered until the batch size is reached or the batch timeout
+// expires. The handler of the message which fills the batch returns the write
+// error, while the errors of the periodic writes are logged.
+func Start(ctx context.Context, id string, sub messaging.Subscriber, writer consumers.BlockingConsumer, cfg Config, logger *slog.Logger) error {
+	r := &runner{
+		ctx:         ctx,
+		contentType: cfg.ContentType,
+		transformers: map[string]transformers.Transformer{
+			senml.JSON:      senml.New(senml.JSON),
+			senml.CBOR:      senml.New(senml.CBOR),
+			JSONContentType: json.New(cfg.TimeFields),
+		},
+		batch: newBatch(writer, cfg.BatchSize, logger),
+	}
+	if _, ok := r.transformers[r.contentType]; !ok {
+		return ErrUnsupportedContentType
+	}
+
+	if cfg.BatchSize > 1 {
+		go r.batch.run(ctx, cfg.BatchTimeout)
+	}
+
+	for _, subject := range cfg.Subjects {
+		subCfg := messaging.SubscriberConfig{
+			ID:             id,
+			Topic:          subject,
+			DeliveryPolicy: messaging.DeliverAllPolicy,
+			Handler:        r,
+			RetryPolicy:    cfg.RetryPolicy,
+		}
+		if err := sub.Subscribe(ctx, subCfg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *runner) Handle(msg *messaging.Message) error {
+	t := r.transformers[r.messageContentType(msg)]
+
+	m, err := t.Transform(msg)
+	if err != nil {
+		// Message which can not be transformed is never going to be written.
+		return messaging.NewError(err, messaging.Term)
+	}
+
+	return r.batch.add(r.ctx, m)
+}
+
+func (r *runner) Cancel() error {
+	return r.batch.flush(context.WithoutCancel(r.ctx))
+}
+
+func (r *runner) messageContentType(msg *messaging.Message) string {
+	if _, ok := r.transformers[msg.GetContentType()]; ok {
+		return msg.GetContentType()
+	}
+	subtopic := msg.GetSubtopic()
+	suffix := subtopic[strings.LastIndex(subtopic, ".")+1:]
+	if ct, ok := subtopicContentTypes[suffix]; ok {
+		return ct
+	}
+
+	return r.contentType
+}
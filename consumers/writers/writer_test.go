@@ -0,0 +1,252 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package writers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/consumers/mocks"
+	"github.com/absmach/supermq/consumers/writers"
+	smqlog "github.com/absmach/supermq/logger"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	msgmocks "github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senmlPayload = `[{"bn":"base-name","bt":1e9,"n":"temperature","u":"C","v":21.5}]`
+	jsonPayload  = `{"temperature":21.5,"unit":"C"}`
+)
+
+var errWrite = errors.New("write failed")
+
+func start(t *testing.T, cfg writers.Config) (messaging.MessageHandler, *mocks.BlockingConsumer) {
+	pubsub := new(msgmocks.PubSub)
+	writer := new(mocks.BlockingConsumer)
+
+	var handler messaging.MessageHandler
+	pubsub.On("Subscribe", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		handler = args.Get(1).(messaging.SubscriberConfig).Handler
+	}).Return(nil)
+
+	err := writers.Start(context.Background(), "test", pubsub, writer, cfg, smqlog.NewMock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error starting writer: %s", err))
+	require.NotNil(t, handler, "handler is expected to be registered")
+
+	return handler, writer
+}
+
+func TestStart(t *testing.T) {
+	cfg := writers.DefaultConfig()
+	cfg.ContentType = "text/plain"
+
+	err := writers.Start(context.Background(), "test", new(msgmocks.PubSub), new(mocks.BlockingConsumer), cfg, smqlog.NewMock())
+	assert.True(t, errors.Contains(err, writers.ErrUnsupportedContentType), fmt.Sprintf("expected error %s got %s", writers.ErrUnsupportedContentType, err))
+}
+
+func TestHandle(t *testing.T) {
+	cases := []struct {
+		desc     string
+		msg      *messaging.Message
+		writeErr error
+		write    bool
+		check    func(msgs any) bool
+		err      error
+	}{
+		{
+			desc:  "handle SenML message using default content type",
+			msg:   &messaging.Message{Channel: "channel", Subtopic: "temp", Payload: []byte(senmlPayload)},
+			write: true,
+			check: func(msgs any) bool {
+				m, ok := msgs.([]senml.Message)
+				return ok && len(m) == 1 && m[0].Name == "base-nametemperature"
+			},
+		},
+		{
+			desc:  "handle JSON message using subtopic suffix",
+			msg:   &messaging.Message{Channel: "channel", Subtopic: "temp.json", Payload: []byte(jsonPayload)},
+			write: true,
+			check: func(msgs any) bool {
+				m, ok := msgs.(json.Messages)
+				return ok && m.Format == "json" && len(m.Data) == 1
+			},
+		},
+		{
+			desc:  "handle SenML message using subtopic suffix",
+			msg:   &messaging.Message{Channel: "channel", Subtopic: "temp.senml", Payload: []byte(senmlPayload)},
+			write: true,
+			check: func(msgs any) bool {
+				_, ok := msgs.([]senml.Message)
+				return ok
+			},
+		},
+		{
+			desc:  "handle JSON message using message content type",
+			msg:   &messaging.Message{Channel: "channel", Subtopic: "temp", ContentType: writers.JSONContentType, Payload: []byte(jsonPayload)},
+			write: true,
+			check: func(msgs any) bool {
+				m, ok := msgs.(json.Messages)
+				return ok && len(m.Data) == 1
+			},
+		},
+		{
+			desc:  "handle JSON message with unsupported content type using subtopic suffix",
+			msg:   &messaging.Message{Channel: "channel", Subtopic: "temp.json", ContentType: "text/plain", Payload: []byte(jsonPayload)},
+			write: true,
+			check: func(msgs any) bool {
+				m, ok := msgs.(json.Messages)
+				return ok && m.Format == "json" && len(m.Data) == 1
+			},
+		},
+		{
+			desc: "handle malformed SenML message",
+			msg:  &messaging.Message{Channel: "channel", Payload: []byte(jsonPayload)},
+			err:  messaging.NewError(errors.New("failed to decode senml"), messaging.Term),
+		},
+		{
+			desc:     "handle message with write error",
+			msg:      &messaging.Message{Channel: "channel", Payload: []byte(senmlPayload)},
+			write:    true,
+			writeErr: errWrite,
+			check: func(msgs any) bool {
+				return true
+			},
+			err: writers.ErrWriteMessages,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handler, writer := start(t, writers.DefaultConfig())
+			if tc.write {
+				writer.On("ConsumeBlocking", mock.Anything, mock.MatchedBy(tc.check)).Return(tc.writeErr)
+			}
+			err := handler.Handle(tc.msg)
+			switch tc.err {
+			case nil:
+				assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+			default:
+				assert.NotNil(t, err, fmt.Sprintf("%s: expected error %s", tc.desc, tc.err))
+			}
+			if e, ok := tc.err.(messaging.Error); ok {
+				merr, ok := err.(messaging.Error)
+				assert.True(t, ok, fmt.Sprintf("%s: expected messaging error got %T", tc.desc, err))
+				if ok {
+					assert.Equal(t, e.Ack(), merr.Ack(), fmt.Sprintf("%s: expected ack type %s got %s", tc.desc, e.Ack(), merr.Ack()))
+				}
+			}
+			if tc.err == writers.ErrWriteMessages {
+				assert.True(t, errors.Contains(err, writers.ErrWriteMessages), fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+			}
+			writer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	cfg := writers.DefaultConfig()
+	cfg.BatchSize = 3
+	cfg.BatchTimeout = time.Hour
+	handler, writer := start(t, cfg)
+
+	writer.On("ConsumeBlocking", mock.Anything, mock.MatchedBy(func(msgs any) bool {
+		m, ok := msgs.([]senml.Message)
+		return ok && len(m) == 2
+	})).Return(nil).Once()
+	writer.On("ConsumeBlocking", mock.Anything, mock.MatchedBy(func(msgs any) bool {
+		m, ok := msgs.(json.Messages)
+		return ok && len(m.Data) == 1
+	})).Return(nil).Once()
+
+	senmlMsg := &messaging.Message{Channel: "channel", Subtopic: "temp", Payload: []byte(senmlPayload)}
+	jsonMsg := &messaging.Message{Channel: "channel", Subtopic: "temp.json", Payload: []byte(jsonPayload)}
+
+	err := handler.Handle(senmlMsg)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+	err = handler.Handle(jsonMsg)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+	writer.AssertNotCalled(t, "ConsumeBlocking", mock.Anything, mock.Anything)
+
+	err = handler.Handle(senmlMsg)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+	writer.AssertExpectations(t)
+
+	// Nothing is written when the batch is empty.
+	err = handler.Cancel()
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+	writer.AssertNumberOfCalls(t, "ConsumeBlocking", 2)
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.toml")
+	err := os.WriteFile(valid, []byte(`
+[subscriber]
+subjects = ["m.domain.c.channel"]
+
+[transformer]
+content_type = "application/json"
+
+[batch]
+size = 100
+timeout = "5s"
+`), 0o600)
+	require.Nil(t, err, fmt.Sprintf("failed to write config file: %s", err))
+
+	invalid := filepath.Join(dir, "invalid.toml")
+	err = os.WriteFile(invalid, []byte(`[batch]
+timeout = "five seconds"`), 0o600)
+	require.Nil(t, err, fmt.Sprintf("failed to write config file: %s", err))
+
+	cases := []struct {
+		desc string
+		path string
+		cfg  writers.Config
+		err  bool
+	}{
+		{
+			desc: "load valid config",
+			path: valid,
+			cfg: writers.Config{
+				Subjects:     []string{"m.domain.c.channel"},
+				ContentType:  writers.JSONContentType,
+				BatchSize:    100,
+				BatchTimeout: 5 * time.Second,
+			},
+		},
+		{
+			desc: "load config with invalid batch timeout",
+			path: invalid,
+			err:  true,
+		},
+		{
+			desc: "load non-existent config",
+			path: filepath.Join(dir, "missing.toml"),
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := writers.LoadConfig(tc.path)
+			if tc.err {
+				assert.NotNil(t, err, fmt.Sprintf("%s: expected error", tc.desc))
+				return
+			}
+			assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+			assert.Equal(t, tc.cfg, cfg, fmt.Sprintf("%s: expected config %v got %v", tc.desc, tc.cfg, cfg))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/deadletters"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func listDeadLettersEndpoint(svc deadletters.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listDeadLettersReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListDeadLetters(ctx, session, req.PageMeta)
+		if err != nil {
+			return nil, err
+		}
+
+		return deadLettersPageRes{DeadLetterPage: page}, nil
+	}
+}
+
+func viewDeadLetterEndpoint(svc deadletters.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(deadLetterIDReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		dl, err := svc.ViewDeadLetter(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return deadLetterRes{DeadLetter: dl}, nil
+	}
+}
+
+func replayDeadLetterEndpoint(svc deadletters.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(deadLetterIDReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		if err := svc.ReplayDeadLetter(ctx, session, req.id); err != nil {
+			return nil, err
+		}
+
+		return replayDeadLetterRes{}, nil
+	}
+}
+
+func removeDeadLetterEndpoint(svc deadletters.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(deadLetterIDReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveDeadLetter(ctx, session, req.id); err != nil {
+			return nil, err
+		}
+
+		return removeDeadLetterRes{}, nil
+	}
+}
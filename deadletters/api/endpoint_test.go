@@ -0,0 +1,325 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/deadletters"
+	httpapi "github.com/absmach/supermq/deadletters/api"
+	"github.com/absmach/supermq/deadletters/mocks"
+	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	validToken   = "valid"
+	invalidToken = "invalid"
+	domainID     = testsutil.GenerateUUID(&testing.T{})
+	userID       = testsutil.GenerateUUID(&testing.T{})
+	validSession = smqauthn.Session{
+		UserID:       userID,
+		DomainID:     domainID,
+		DomainUserID: domainID + "_" + userID,
+	}
+	deadLetter = messaging.DeadLetter{
+		ID:         "1",
+		Subscriber: "rules",
+		Subject:    "m." + domainID + ".c." + testsutil.GenerateUUID(&testing.T{}),
+		Error:      "failed to handle message",
+		Attempts:   4,
+		FailedAt:   time.Now().UTC(),
+		Message:    &messaging.Message{Domain: domainID, Payload: []byte("payload")},
+	}
+)
+
+type testRequest struct {
+	client *http.Client
+	method string
+	url    string
+	token  string
+	body   io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newDeadLettersServer() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	svc := new(mocks.Service)
+
+	logger := smqlog.NewMock()
+	authn := new(authnmocks.Authentication)
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	mux := httpapi.MakeHandler(svc, am, logger, "dead-letters", "test")
+	return httptest.NewServer(mux), svc, authn
+}
+
+func TestListDeadLettersEndpoint(t *testing.T) {
+	ds, svc, authn := newDeadLettersServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		query    string
+		pm       deadletters.PageMeta
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "list dead letters successfully",
+			token:  validToken,
+			pm:     deadletters.PageMeta{Limit: 10},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "list dead letters with offset and limit",
+			token:  validToken,
+			query:  "?offset=1&limit=5",
+			pm:     deadletters.PageMeta{Offset: 1, Limit: 5},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list dead letters with invalid token",
+			token:    invalidToken,
+			pm:       deadletters.PageMeta{Limit: 10},
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "list dead letters with invalid limit",
+			token:  validToken,
+			query:  "?limit=1000",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list dead letters with malformed offset",
+			token:  validToken,
+			query:  "?offset=invalid",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list dead letters with unauthorized user",
+			token:  validToken,
+			pm:     deadletters.PageMeta{Limit: 10},
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("ListDeadLetters", mock.Anything, validSession, tc.pm).Return(messaging.DeadLetterPage{DeadLetters: []messaging.DeadLetter{deadLetter}}, tc.svcErr)
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/dead-letters%s", ds.URL, domainID, tc.query),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewDeadLetterEndpoint(t *testing.T) {
+	ds, svc, authn := newDeadLettersServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "view dead letter successfully",
+			token:  validToken,
+			id:     deadLetter.ID,
+			status: http.StatusOK,
+		},
+		{
+			desc:     "view dead letter with invalid token",
+			token:    invalidToken,
+			id:       deadLetter.ID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "view non-existing dead letter",
+			token:  validToken,
+			id:     "2",
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("ViewDeadLetter", mock.Anything, validSession, tc.id).Return(deadLetter, tc.svcErr)
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/dead-letters/%s", ds.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestReplayDeadLetterEndpoint(t *testing.T) {
+	ds, svc, authn := newDeadLettersServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "replay dead letter successfully",
+			token:  validToken,
+			id:     deadLetter.ID,
+			status: http.StatusAccepted,
+		},
+		{
+			desc:     "replay dead letter with invalid token",
+			token:    invalidToken,
+			id:       deadLetter.ID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "replay non-existing dead letter",
+			token:  validToken,
+			id:     "2",
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "replay dead letter with broker error",
+			token:  validToken,
+			id:     deadLetter.ID,
+			svcErr: deadletters.ErrReplay,
+			status: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("ReplayDeadLetter", mock.Anything, validSession, tc.id).Return(tc.svcErr)
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodPost,
+				url:    fmt.Sprintf("%s/%s/dead-letters/%s/replay", ds.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveDeadLetterEndpoint(t *testing.T) {
+	ds, svc, authn := newDeadLettersServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "remove dead letter successfully",
+			token:  validToken,
+			id:     deadLetter.ID,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:     "remove dead letter with invalid token",
+			token:    invalidToken,
+			id:       deadLetter.ID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove non-existing dead letter",
+			token:  validToken,
+			id:     "2",
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("RemoveDeadLetter", mock.Anything, validSession, tc.id).Return(tc.svcErr)
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/dead-letters/%s", ds.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+var (
+	_ supermq.Response = (*deadLetterRes)(nil)
+	_ supermq.Response = (*deadLettersPageRes)(nil)
+	_ supermq.Response = (*replayDeadLetterRes)(nil)
+	_ supermq.Response = (*removeDeadLetterRes)(nil)
+)
+
+type deadLetterRes struct {
+	messaging.DeadLetter `json:",inline"`
+}
+
+func (res deadLetterRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deadLetterRes) Code() int {
+	return http.StatusOK
+}
+
+func (res deadLetterRes) Empty() bool {
+	return false
+}
+
+type deadLettersPageRes struct {
+	messaging.DeadLetterPage `json:",inline"`
+}
+
+func (res deadLettersPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deadLettersPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res deadLettersPageRes) Empty() bool {
+	return false
+}
+
+type replayDeadLetterRes struct{}
+
+func (res replayDeadLetterRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res replayDeadLetterRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res replayDeadLetterRes) Empty() bool {
+	return true
+}
+
+type removeDeadLetterRes struct{}
+
+func (res removeDeadLetterRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeDeadLetterRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeDeadLetterRes) Empty() bool {
+	return true
+}
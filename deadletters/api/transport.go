@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/deadletters"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const deadLetterIDKey = "deadLetterID"
+
+// MakeHandler returns a HTTP API handler with health check and metrics.
+func MakeHandler(svc deadletters.Service, authn smqauthn.AuthNMiddleware, logger *slog.Logger, svcName, instanceID string) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+
+	mux := chi.NewRouter()
+	idp := uuid.New()
+	mux.Use(api.RequestIDMiddleware(idp))
+
+	mux.Route("/{domainID}/dead-letters", func(r chi.Router) {
+		r.Use(authn.Middleware())
+
+		r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+			listDeadLettersEndpoint(svc),
+			decodeListDeadLettersReq,
+			api.EncodeResponse,
+			opts...,
+		), "list_dead_letters").ServeHTTP)
+
+		r.Route("/{deadLetterID}", func(r chi.Router) {
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				viewDeadLetterEndpoint(svc),
+				decodeDeadLetterIDReq,
+				api.EncodeResponse,
+				opts...,
+			), "view_dead_letter").ServeHTTP)
+
+			r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+				removeDeadLetterEndpoint(svc),
+				decodeDeadLetterIDReq,
+				api.EncodeResponse,
+				opts...,
+			), "remove_dead_letter").ServeHTTP)
+
+			r.Post("/replay", otelhttp.NewHandler(kithttp.NewServer(
+				replayDeadLetterEndpoint(svc),
+				decodeDeadLetterIDReq,
+				api.EncodeResponse,
+				opts...,
+			), "replay_dead_letter").ServeHTTP)
+		})
+	})
+
+	mux.Get("/health", supermq.Health(svcName, instanceID))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+func decodeDeadLetterIDReq(_ context.Context, r *http.Request) (any, error) {
+	req := deadLetterIDReq{
+		id: chi.URLParam(r, deadLetterIDKey),
+	}
+
+	return req, nil
+}
+
+func decodeListDeadLettersReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req := listDeadLettersReq{
+		PageMeta: deadletters.PageMeta{
+			Offset: offset,
+			Limit:  limit,
+		},
+	}
+
+	return req, nil
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deadletters contains the dead letters service.
+// This service provides a REST API to list, inspect, replay and remove the
+// domain messages which subscribers failed to handle after all the retries.
+package deadletters
@@ -0,0 +1,77 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/deadletters"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/policies"
+)
+
+var _ deadletters.Service = (*authorizationMiddleware)(nil)
+
+type authorizationMiddleware struct {
+	svc   deadletters.Service
+	authz smqauthz.Authorization
+}
+
+// NewAuthorization adds authorization to the dead letters service.
+// Dead letters contain the messages of all the domain channels,
+// so they can be managed only by the domain administrators.
+func NewAuthorization(svc deadletters.Service, authz smqauthz.Authorization) deadletters.Service {
+	return &authorizationMiddleware{
+		svc:   svc,
+		authz: authz,
+	}
+}
+
+func (am *authorizationMiddleware) ListDeadLetters(ctx context.Context, session smqauthn.Session, pm deadletters.PageMeta) (messaging.DeadLetterPage, error) {
+	if err := am.authorize(ctx, session); err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+
+	return am.svc.ListDeadLetters(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (messaging.DeadLetter, error) {
+	if err := am.authorize(ctx, session); err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return am.svc.ViewDeadLetter(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := am.authorize(ctx, session); err != nil {
+		return err
+	}
+
+	return am.svc.ReplayDeadLetter(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := am.authorize(ctx, session); err != nil {
+		return err
+	}
+
+	return am.svc.RemoveDeadLetter(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) authorize(ctx context.Context, session smqauthn.Session) error {
+	req := smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     session.DomainUserID,
+		Permission:  policies.AdminPermission,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}
+
+	return am.authz.Authorize(ctx, req)
+}
@@ -0,0 +1,9 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package middleware provides authorization, tracing, logging and metrics
+// middleware for SuperMQ Dead Letters service.
+//
+// For more details about tracing instrumentation for SuperMQ refer to the
+// documentation at https://docs.supermq.absmach.eu/tracing/.
+package middleware
@@ -0,0 +1,110 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/deadletters"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+var _ deadletters.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger  *slog.Logger
+	service deadletters.Service
+}
+
+// NewLogging adds logging facilities to the dead letters service.
+func NewLogging(service deadletters.Service, logger *slog.Logger) deadletters.Service {
+	return &loggingMiddleware{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (lm *loggingMiddleware) ListDeadLetters(ctx context.Context, session smqauthn.Session, pm deadletters.PageMeta) (page messaging.DeadLetterPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List dead letters failed", args...)
+			return
+		}
+		lm.logger.Info("List dead letters completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListDeadLetters(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (dl messaging.DeadLetter, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("dead_letter_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("View dead letter failed", args...)
+			return
+		}
+		lm.logger.Info("View dead letter completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewDeadLetter(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("dead_letter_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Replay dead letter failed", args...)
+			return
+		}
+		lm.logger.Info("Replay dead letter completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ReplayDeadLetter(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("dead_letter_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Remove dead letter failed", args...)
+			return
+		}
+		lm.logger.Info("Remove dead letter completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveDeadLetter(ctx, session, id)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/deadletters"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ deadletters.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	service deadletters.Service
+}
+
+// NewMetrics returns new dead letters service with all methods wrapped to expose metrics.
+func NewMetrics(service deadletters.Service, counter metrics.Counter, latency metrics.Histogram) deadletters.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		service: service,
+	}
+}
+
+func (mm *metricsMiddleware) ListDeadLetters(ctx context.Context, session smqauthn.Session, pm deadletters.PageMeta) (messaging.DeadLetterPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_dead_letters").Add(1)
+		mm.latency.With("method", "list_dead_letters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListDeadLetters(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (messaging.DeadLetter, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_dead_letter").Add(1)
+		mm.latency.With("method", "view_dead_letter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewDeadLetter(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "replay_dead_letter").Add(1)
+		mm.latency.With("method", "replay_dead_letter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ReplayDeadLetter(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_dead_letter").Add(1)
+		mm.latency.With("method", "remove_dead_letter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveDeadLetter(ctx, session, id)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/deadletters"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	smqTracing "github.com/absmach/supermq/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ deadletters.Service = (*tracing)(nil)
+
+type tracing struct {
+	tracer trace.Tracer
+	svc    deadletters.Service
+}
+
+// NewTracing returns a new dead letters service with tracing capabilities.
+func NewTracing(svc deadletters.Service, tracer trace.Tracer) deadletters.Service {
+	return &tracing{tracer, svc}
+}
+
+func (tm *tracing) ListDeadLetters(ctx context.Context, session smqauthn.Session, pm deadletters.PageMeta) (messaging.DeadLetterPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_dead_letters", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListDeadLetters(ctx, session, pm)
+}
+
+func (tm *tracing) ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (messaging.DeadLetter, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_dead_letter", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewDeadLetter(ctx, session, id)
+}
+
+func (tm *tracing) ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "replay_dead_letter", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ReplayDeadLetter(ctx, session, id)
+}
+
+func (tm *tracing) RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_dead_letter", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveDeadLetter(ctx, session, id)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/deadletters"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+type Service_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Service) EXPECT() *Service_Expecter {
+	return &Service_Expecter{mock: &_m.Mock}
+}
+
+// ListDeadLetters provides a mock function for the type Service
+func (_mock *Service) ListDeadLetters(ctx context.Context, session authn.Session, pm deadletters.PageMeta) (messaging.DeadLetterPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetters")
+	}
+
+	var r0 messaging.DeadLetterPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, deadletters.PageMeta) (messaging.DeadLetterPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, deadletters.PageMeta) messaging.DeadLetterPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(messaging.DeadLetterPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, deadletters.PageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetters'
+type Service_ListDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm deadletters.PageMeta
+func (_e *Service_Expecter) ListDeadLetters(ctx interface{}, session interface{}, pm interface{}) *Service_ListDeadLetters_Call {
+	return &Service_ListDeadLetters_Call{Call: _e.mock.On("ListDeadLetters", ctx, session, pm)}
+}
+
+func (_c *Service_ListDeadLetters_Call) Run(run func(ctx context.Context, session authn.Session, pm deadletters.PageMeta)) *Service_ListDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 deadletters.PageMeta
+		if args[2] != nil {
+			arg2 = args[2].(deadletters.PageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListDeadLetters_Call) Return(deadLetterPage messaging.DeadLetterPage, err error) *Service_ListDeadLetters_Call {
+	_c.Call.Return(deadLetterPage, err)
+	return _c
+}
+
+func (_c *Service_ListDeadLetters_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm deadletters.PageMeta) (messaging.DeadLetterPage, error)) *Service_ListDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDeadLetter provides a mock function for the type Service
+func (_mock *Service) RemoveDeadLetter(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDeadLetter")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDeadLetter'
+type Service_RemoveDeadLetter_Call struct {
+	*mock.Call
+}
+
+// RemoveDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RemoveDeadLetter(ctx interface{}, session interface{}, id interface{}) *Service_RemoveDeadLetter_Call {
+	return &Service_RemoveDeadLetter_Call{Call: _e.mock.On("RemoveDeadLetter", ctx, session, id)}
+}
+
+func (_c *Service_RemoveDeadLetter_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RemoveDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveDeadLetter_Call) Return(err error) *Service_RemoveDeadLetter_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveDeadLetter_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RemoveDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDeadLetter provides a mock function for the type Service
+func (_mock *Service) ReplayDeadLetter(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetter")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_ReplayDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeadLetter'
+type Service_ReplayDeadLetter_Call struct {
+	*mock.Call
+}
+
+// ReplayDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ReplayDeadLetter(ctx interface{}, session interface{}, id interface{}) *Service_ReplayDeadLetter_Call {
+	return &Service_ReplayDeadLetter_Call{Call: _e.mock.On("ReplayDeadLetter", ctx, session, id)}
+}
+
+func (_c *Service_ReplayDeadLetter_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ReplayDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ReplayDeadLetter_Call) Return(err error) *Service_ReplayDeadLetter_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_ReplayDeadLetter_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_ReplayDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViewDeadLetter provides a mock function for the type Service
+func (_mock *Service) ViewDeadLetter(ctx context.Context, session authn.Session, id string) (messaging.DeadLetter, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewDeadLetter")
+	}
+
+	var r0 messaging.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (messaging.DeadLetter, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) messaging.DeadLetter); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(messaging.DeadLetter)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ViewDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewDeadLetter'
+type Service_ViewDeadLetter_Call struct {
+	*mock.Call
+}
+
+// ViewDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ViewDeadLetter(ctx interface{}, session interface{}, id interface{}) *Service_ViewDeadLetter_Call {
+	return &Service_ViewDeadLetter_Call{Call: _e.mock.On("ViewDeadLetter", ctx, session, id)}
+}
+
+func (_c *Service_ViewDeadLetter_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ViewDeadLetter_Call) Return(deadLetter messaging.DeadLetter, err error) *Service_ViewDeadLetter_Call {
+	_c.Call.Return(deadLetter, err)
+	return _c
+}
+
+func (_c *Service_ViewDeadLetter_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (messaging.DeadLetter, error)) *Service_ViewDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
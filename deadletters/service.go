@@ -0,0 +1,92 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletters
+
+import (
+	"context"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// ErrReplay indicates that the dead-lettered message could not be replayed.
+var ErrReplay = errors.NewServiceError("failed to replay dead letter")
+
+// PageMeta contains page metadata that helps navigation.
+type PageMeta struct {
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+// Service specifies an API that must be fulfilled by the dead letters service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// ListDeadLetters retrieves the page of the session domain dead letters.
+	ListDeadLetters(ctx context.Context, session smqauthn.Session, pm PageMeta) (messaging.DeadLetterPage, error)
+
+	// ViewDeadLetter retrieves the session domain dead letter.
+	ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (messaging.DeadLetter, error)
+
+	// ReplayDeadLetter publishes the dead-lettered message to its original
+	// subject and removes the dead letter.
+	ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) error
+
+	// RemoveDeadLetter removes the dead letter without replaying it.
+	RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) error
+}
+
+type service struct {
+	deadLetters messaging.DeadLetters
+}
+
+// NewService returns a new dead letters service.
+func NewService(deadLetters messaging.DeadLetters) Service {
+	return &service{
+		deadLetters: deadLetters,
+	}
+}
+
+func (svc *service) ListDeadLetters(ctx context.Context, session smqauthn.Session, pm PageMeta) (messaging.DeadLetterPage, error) {
+	page, err := svc.deadLetters.List(ctx, session.DomainID, pm.Offset, pm.Limit)
+	if err != nil {
+		return messaging.DeadLetterPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (svc *service) ViewDeadLetter(ctx context.Context, session smqauthn.Session, id string) (messaging.DeadLetter, error) {
+	dl, err := svc.deadLetters.View(ctx, session.DomainID, id)
+	if err != nil {
+		return messaging.DeadLetter{}, wrapError(svcerr.ErrViewEntity, err)
+	}
+
+	return dl, nil
+}
+
+func (svc *service) ReplayDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := svc.deadLetters.Replay(ctx, session.DomainID, id); err != nil {
+		return wrapError(ErrReplay, err)
+	}
+
+	return nil
+}
+
+func (svc *service) RemoveDeadLetter(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := svc.deadLetters.Remove(ctx, session.DomainID, id); err != nil {
+		return wrapError(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func wrapError(wrapper, err error) error {
+	if errors.Contains(err, messaging.ErrDeadLetterNotFound) {
+		return errors.Wrap(svcerr.ErrNotFound, err)
+	}
+
+	return errors.Wrap(wrapper, err)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletters_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/deadletters"
+	"github.com/absmach/supermq/internal/testsutil"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqerrors "github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	msgmocks "github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	domainID = testsutil.GenerateUUID(&testing.T{})
+	session  = smqauthn.Session{
+		UserID:   testsutil.GenerateUUID(&testing.T{}),
+		DomainID: domainID,
+	}
+	deadLetter = messaging.DeadLetter{
+		ID:         "1",
+		Subscriber: "rules",
+		Subject:    "m." + domainID + ".c." + testsutil.GenerateUUID(&testing.T{}),
+		Error:      "failed to handle message",
+		Attempts:   4,
+		FailedAt:   time.Now().UTC(),
+		Message:    &messaging.Message{Domain: domainID, Payload: []byte("payload")},
+	}
+	errBroker = errors.New("broker error")
+)
+
+func newService() (deadletters.Service, *msgmocks.DeadLetters) {
+	dl := new(msgmocks.DeadLetters)
+
+	return deadletters.NewService(dl), dl
+}
+
+func TestListDeadLetters(t *testing.T) {
+	svc, dl := newService()
+
+	cases := []struct {
+		desc    string
+		pm      deadletters.PageMeta
+		page    messaging.DeadLetterPage
+		listErr error
+		err     error
+	}{
+		{
+			desc: "list dead letters successfully",
+			pm:   deadletters.PageMeta{Limit: 10},
+			page: messaging.DeadLetterPage{
+				Total:       1,
+				Limit:       10,
+				DeadLetters: []messaging.DeadLetter{deadLetter},
+			},
+		},
+		{
+			desc:    "list dead letters with broker error",
+			pm:      deadletters.PageMeta{Limit: 10},
+			listErr: errBroker,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			call := dl.On("List", context.Background(), domainID, tc.pm.Offset, tc.pm.Limit).Return(tc.page, tc.listErr)
+			page, err := svc.ListDeadLetters(context.Background(), session, tc.pm)
+			assert.True(t, smqerrors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			assert.Equal(t, tc.page, page)
+			call.Unset()
+		})
+	}
+}
+
+func TestViewDeadLetter(t *testing.T) {
+	svc, dl := newService()
+
+	cases := []struct {
+		desc    string
+		id      string
+		dl      messaging.DeadLetter
+		viewErr error
+		err     error
+	}{
+		{
+			desc: "view dead letter successfully",
+			id:   deadLetter.ID,
+			dl:   deadLetter,
+		},
+		{
+			desc:    "view non-existing dead letter",
+			id:      "2",
+			viewErr: messaging.ErrDeadLetterNotFound,
+			err:     svcerr.ErrNotFound,
+		},
+		{
+			desc:    "view dead letter with broker error",
+			id:      deadLetter.ID,
+			viewErr: errBroker,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			call := dl.On("View", context.Background(), domainID, tc.id).Return(tc.dl, tc.viewErr)
+			d, err := svc.ViewDeadLetter(context.Background(), session, tc.id)
+			assert.True(t, smqerrors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			assert.Equal(t, tc.dl, d)
+			call.Unset()
+		})
+	}
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	svc, dl := newService()
+
+	cases := []struct {
+		desc      string
+		id        string
+		replayErr error
+		err       error
+	}{
+		{
+			desc: "replay dead letter successfully",
+			id:   deadLetter.ID,
+		},
+		{
+			desc:      "replay non-existing dead letter",
+			id:        "2",
+			replayErr: messaging.ErrDeadLetterNotFound,
+			err:       svcerr.ErrNotFound,
+		},
+		{
+			desc:      "replay dead letter with broker error",
+			id:        deadLetter.ID,
+			replayErr: errBroker,
+			err:       deadletters.ErrReplay,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			call := dl.On("Replay", context.Background(), domainID, tc.id).Return(tc.replayErr)
+			err := svc.ReplayDeadLetter(context.Background(), session, tc.id)
+			assert.True(t, smqerrors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			call.Unset()
+		})
+	}
+}
+
+func TestRemoveDeadLetter(t *testing.T) {
+	svc, dl := newService()
+
+	cases := []struct {
+		desc      string
+		id        string
+		removeErr error
+		err       error
+	}{
+		{
+			desc: "remove dead letter successfully",
+			id:   deadLetter.ID,
+		},
+		{
+			desc:      "remove non-existing dead letter",
+			id:        "2",
+			removeErr: messaging.ErrDeadLetterNotFound,
+			err:       svcerr.ErrNotFound,
+		},
+		{
+			desc:      "remove dead letter with broker error",
+			id:        deadLetter.ID,
+			removeErr: errBroker,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			call := dl.On("Remove", context.Background(), domainID, tc.id).Return(tc.removeErr)
+			err := svc.RemoveDeadLetter(context.Background(), session, tc.id)
+			assert.True(t, smqerrors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			call.Unset()
+		})
+	}
+}
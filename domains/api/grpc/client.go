@@ -10,6 +10,7 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
 	grpcapi "github.com/absmach/supermq/auth/api/grpc"
+	"github.com/absmach/supermq/domains"
 	"github.com/go-kit/kit/endpoint"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"google.golang.org/grpc"
@@ -23,6 +24,10 @@ type domainsGrpcClient struct {
 	deleteUserFromDomains endpoint.Endpoint
 	retrieveStatus        endpoint.Endpoint
 	retrieveIDByRoute     endpoint.Endpoint
+	updateUsage           endpoint.Endpoint
+	setUsage              endpoint.Endpoint
+	retrieveQuotas        endpoint.Endpoint
+	claimInvitations      endpoint.Endpoint
 	timeout               time.Duration
 }
 
@@ -53,6 +58,38 @@ func NewDomainsClient(conn *grpc.ClientConn, timeout time.Duration) grpcDomainsV
 			decodeRetrieveIDByRouteResponse,
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
+		updateUsage: kitgrpc.NewClient(
+			conn,
+			domainsSvcName,
+			"UpdateUsage",
+			encodeUpdateUsageRequest,
+			decodeUpdateUsageResponse,
+			grpcDomainsV1.UpdateUsageRes{},
+		).Endpoint(),
+		setUsage: kitgrpc.NewClient(
+			conn,
+			domainsSvcName,
+			"SetUsage",
+			encodeSetUsageRequest,
+			decodeSetUsageResponse,
+			grpcDomainsV1.SetUsageRes{},
+		).Endpoint(),
+		retrieveQuotas: kitgrpc.NewClient(
+			conn,
+			domainsSvcName,
+			"RetrieveQuotas",
+			encodeRetrieveQuotasRequest,
+			decodeRetrieveQuotasResponse,
+			grpcDomainsV1.RetrieveQuotasRes{},
+		).Endpoint(),
+		claimInvitations: kitgrpc.NewClient(
+			conn,
+			domainsSvcName,
+			"ClaimInvitations",
+			encodeClaimInvitationsRequest,
+			decodeClaimInvitationsResponse,
+			grpcDomainsV1.ClaimInvitationsRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -145,3 +182,125 @@ func encodeRetrieveIDByRouteRequest(_ context.Context, grpcReq any) (any, error)
 		Route: req.Route,
 	}, nil
 }
+
+func (client domainsGrpcClient) UpdateUsage(ctx context.Context, in *grpcDomainsV1.UpdateUsageReq, opts ...grpc.CallOption) (*grpcDomainsV1.UpdateUsageRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.updateUsage(ctx, updateUsageReq{
+		DomainID: in.GetDomainId(),
+		Resource: domains.Resource(in.GetResource()),
+		Delta:    in.GetDelta(),
+	})
+	if err != nil {
+		return &grpcDomainsV1.UpdateUsageRes{}, grpcapi.DecodeError(err)
+	}
+
+	uur := res.(updateUsageRes)
+	return &grpcDomainsV1.UpdateUsageRes{Updated: uur.updated}, nil
+}
+
+func decodeUpdateUsageResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcDomainsV1.UpdateUsageRes)
+	return updateUsageRes{updated: res.GetUpdated()}, nil
+}
+
+func encodeUpdateUsageRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(updateUsageReq)
+	return &grpcDomainsV1.UpdateUsageReq{
+		DomainId: req.DomainID,
+		Resource: string(req.Resource),
+		Delta:    req.Delta,
+	}, nil
+}
+
+func (client domainsGrpcClient) SetUsage(ctx context.Context, in *grpcDomainsV1.SetUsageReq, opts ...grpc.CallOption) (*grpcDomainsV1.SetUsageRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.setUsage(ctx, setUsageReq{
+		DomainID: in.GetDomainId(),
+		Resource: domains.Resource(in.GetResource()),
+		Count:    in.GetCount(),
+	})
+	if err != nil {
+		return &grpcDomainsV1.SetUsageRes{}, grpcapi.DecodeError(err)
+	}
+
+	sur := res.(setUsageRes)
+	return &grpcDomainsV1.SetUsageRes{Updated: sur.updated}, nil
+}
+
+func decodeSetUsageResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcDomainsV1.SetUsageRes)
+	return setUsageRes{updated: res.GetUpdated()}, nil
+}
+
+func encodeSetUsageRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(setUsageReq)
+	return &grpcDomainsV1.SetUsageReq{
+		DomainId: req.DomainID,
+		Resource: string(req.Resource),
+		Count:    req.Count,
+	}, nil
+}
+
+func (client domainsGrpcClient) RetrieveQuotas(ctx context.Context, in *grpcCommonV1.RetrieveEntityReq, opts ...grpc.CallOption) (*grpcDomainsV1.RetrieveQuotasRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.retrieveQuotas(ctx, retrieveQuotasReq{
+		DomainID: in.GetId(),
+	})
+	if err != nil {
+		return &grpcDomainsV1.RetrieveQuotasRes{}, grpcapi.DecodeError(err)
+	}
+
+	rqr := res.(retrieveQuotasRes)
+	return &grpcDomainsV1.RetrieveQuotasRes{
+		Quotas: toProtoQuotas(rqr.quotas),
+	}, nil
+}
+
+func decodeRetrieveQuotasResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcDomainsV1.RetrieveQuotasRes)
+	return retrieveQuotasRes{quotas: fromProtoQuotas(res.GetQuotas())}, nil
+}
+
+func encodeRetrieveQuotasRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(retrieveQuotasReq)
+	return &grpcCommonV1.RetrieveEntityReq{
+		Id: req.DomainID,
+	}, nil
+}
+
+func (client domainsGrpcClient) ClaimInvitations(ctx context.Context, in *grpcDomainsV1.ClaimInvitationsReq, opts ...grpc.CallOption) (*grpcDomainsV1.ClaimInvitationsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.claimInvitations(ctx, claimInvitationsReq{
+		UserID: in.GetUserId(),
+		Email:  in.GetEmail(),
+		Token:  in.GetToken(),
+	})
+	if err != nil {
+		return &grpcDomainsV1.ClaimInvitationsRes{}, grpcapi.DecodeError(err)
+	}
+
+	cir := res.(claimInvitationsRes)
+	return &grpcDomainsV1.ClaimInvitationsRes{DomainIds: cir.domainIDs}, nil
+}
+
+func decodeClaimInvitationsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcDomainsV1.ClaimInvitationsRes)
+	return claimInvitationsRes{domainIDs: res.GetDomainIds()}, nil
+}
+
+func encodeClaimInvitationsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(claimInvitationsReq)
+	return &grpcDomainsV1.ClaimInvitationsReq{
+		UserId: req.UserID,
+		Email:  req.Email,
+		Token:  req.Token,
+	}, nil
+}
@@ -60,3 +60,70 @@ func retrieveIDByRouteEndpoint(svc domains.Service) endpoint.Endpoint {
 		}, nil
 	}
 }
+
+func updateUsageEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateUsageReq)
+		if err := req.validate(); err != nil {
+			return updateUsageRes{}, err
+		}
+
+		if err := svc.UpdateUsage(ctx, req.DomainID, req.Resource, req.Delta); err != nil {
+			return updateUsageRes{}, err
+		}
+
+		return updateUsageRes{updated: true}, nil
+	}
+}
+
+func setUsageEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(setUsageReq)
+		if err := req.validate(); err != nil {
+			return setUsageRes{}, err
+		}
+
+		if err := svc.SetUsage(ctx, req.DomainID, req.Resource, req.Count); err != nil {
+			return setUsageRes{}, err
+		}
+
+		return setUsageRes{updated: true}, nil
+	}
+}
+
+func retrieveQuotasEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(retrieveQuotasReq)
+		if err := req.validate(); err != nil {
+			return retrieveQuotasRes{}, err
+		}
+
+		q, err := svc.RetrieveQuotas(ctx, req.DomainID)
+		if err != nil {
+			return retrieveQuotasRes{}, err
+		}
+
+		return retrieveQuotasRes{quotas: q}, nil
+	}
+}
+
+func claimInvitationsEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(claimInvitationsReq)
+		if err := req.validate(); err != nil {
+			return claimInvitationsRes{}, err
+		}
+
+		invs, err := svc.ClaimInvitations(ctx, req.UserID, req.Email, req.Token)
+		if err != nil {
+			return claimInvitationsRes{}, err
+		}
+
+		domainIDs := []string{}
+		for _, inv := range invs {
+			domainIDs = append(domainIDs, inv.DomainID)
+		}
+
+		return claimInvitationsRes{domainIDs: domainIDs}, nil
+	}
+}
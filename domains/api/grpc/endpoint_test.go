@@ -218,3 +218,282 @@ func TestRetrieveIDByRoute(t *testing.T) {
 		svcCall.Unset()
 	}
 }
+
+func TestUpdateUsage(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewDomainsClient(conn, time.Second)
+
+	cases := []struct {
+		desc   string
+		req    *grpcDomainsV1.UpdateUsageReq
+		svcErr error
+		res    *grpcDomainsV1.UpdateUsageRes
+		err    error
+	}{
+		{
+			desc: "reserve clients successfully",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				DomainId: id,
+				Resource: string(domains.ClientsResource),
+				Delta:    2,
+			},
+			res: &grpcDomainsV1.UpdateUsageRes{Updated: true},
+			err: nil,
+		},
+		{
+			desc: "release channels successfully",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				DomainId: id,
+				Resource: string(domains.ChannelsResource),
+				Delta:    -1,
+			},
+			res: &grpcDomainsV1.UpdateUsageRes{Updated: true},
+			err: nil,
+		},
+		{
+			desc: "update usage with empty domain id",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				Resource: string(domains.ClientsResource),
+				Delta:    1,
+			},
+			res: &grpcDomainsV1.UpdateUsageRes{},
+			err: apiutil.ErrMissingDomainID,
+		},
+		{
+			desc: "update usage with invalid resource",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				DomainId: id,
+				Resource: string(domains.InvitationsResource),
+				Delta:    1,
+			},
+			res: &grpcDomainsV1.UpdateUsageRes{},
+			err: apiutil.ErrInvalidQuotaResource,
+		},
+		{
+			desc: "update usage with exceeded quota",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				DomainId: id,
+				Resource: string(domains.GroupsResource),
+				Delta:    1,
+			},
+			svcErr: svcerr.ErrQuotaExceeded,
+			res:    &grpcDomainsV1.UpdateUsageRes{},
+			err:    svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc: "update usage of non-existing domain",
+			req: &grpcDomainsV1.UpdateUsageReq{
+				DomainId: "invalid",
+				Resource: string(domains.GroupsResource),
+				Delta:    1,
+			},
+			svcErr: svcerr.ErrNotFound,
+			res:    &grpcDomainsV1.UpdateUsageRes{},
+			err:    svcerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		svcCall := svc.On("UpdateUsage", mock.Anything, tc.req.DomainId, domains.Resource(tc.req.Resource), tc.req.Delta).Return(tc.svcErr)
+		res, err := grpcClient.UpdateUsage(context.Background(), tc.req)
+		assert.Equal(t, tc.res.Updated, res.Updated, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res.Updated, res.Updated))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		svcCall.Unset()
+	}
+}
+
+func TestSetUsage(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewDomainsClient(conn, time.Second)
+
+	cases := []struct {
+		desc   string
+		req    *grpcDomainsV1.SetUsageReq
+		svcErr error
+		res    *grpcDomainsV1.SetUsageRes
+		err    error
+	}{
+		{
+			desc: "set clients usage successfully",
+			req: &grpcDomainsV1.SetUsageReq{
+				DomainId: id,
+				Resource: string(domains.ClientsResource),
+				Count:    5,
+			},
+			res: &grpcDomainsV1.SetUsageRes{Updated: true},
+			err: nil,
+		},
+		{
+			desc: "set usage to zero successfully",
+			req: &grpcDomainsV1.SetUsageReq{
+				DomainId: id,
+				Resource: string(domains.GroupsResource),
+			},
+			res: &grpcDomainsV1.SetUsageRes{Updated: true},
+			err: nil,
+		},
+		{
+			desc: "set usage with empty domain id",
+			req: &grpcDomainsV1.SetUsageReq{
+				Resource: string(domains.ClientsResource),
+				Count:    1,
+			},
+			res: &grpcDomainsV1.SetUsageRes{},
+			err: apiutil.ErrMissingDomainID,
+		},
+		{
+			desc: "set usage with invalid resource",
+			req: &grpcDomainsV1.SetUsageReq{
+				DomainId: id,
+				Resource: string(domains.InvitationsResource),
+				Count:    1,
+			},
+			res: &grpcDomainsV1.SetUsageRes{},
+			err: apiutil.ErrInvalidQuotaResource,
+		},
+		{
+			desc: "set usage with failed update",
+			req: &grpcDomainsV1.SetUsageReq{
+				DomainId: id,
+				Resource: string(domains.ChannelsResource),
+				Count:    1,
+			},
+			svcErr: svcerr.ErrUpdateEntity,
+			res:    &grpcDomainsV1.SetUsageRes{},
+			err:    svcerr.ErrUpdateEntity,
+		},
+	}
+	for _, tc := range cases {
+		svcCall := svc.On("SetUsage", mock.Anything, tc.req.DomainId, domains.Resource(tc.req.Resource), tc.req.Count).Return(tc.svcErr)
+		res, err := grpcClient.SetUsage(context.Background(), tc.req)
+		assert.Equal(t, tc.res.Updated, res.Updated, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res.Updated, res.Updated))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		svcCall.Unset()
+	}
+}
+
+func TestRetrieveQuotas(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewDomainsClient(conn, time.Second)
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+
+	cases := []struct {
+		desc   string
+		req    *grpcCommonV1.RetrieveEntityReq
+		svcRes domains.Quotas
+		svcErr error
+		res    *grpcDomainsV1.Quotas
+		err    error
+	}{
+		{
+			desc:   "retrieve quotas successfully",
+			req:    &grpcCommonV1.RetrieveEntityReq{Id: id},
+			svcRes: quotas,
+			res: &grpcDomainsV1.Quotas{
+				Clients:     quotas.Clients,
+				Channels:    quotas.Channels,
+				Groups:      quotas.Groups,
+				Invitations: quotas.Invitations,
+				MessageRate: quotas.MessageRate,
+			},
+			err: nil,
+		},
+		{
+			desc: "retrieve quotas with empty id",
+			req:  &grpcCommonV1.RetrieveEntityReq{},
+			err:  apiutil.ErrMissingDomainID,
+		},
+		{
+			desc:   "retrieve quotas of non-existing domain",
+			req:    &grpcCommonV1.RetrieveEntityReq{Id: "invalid"},
+			svcErr: svcerr.ErrNotFound,
+			err:    svcerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		svcCall := svc.On("RetrieveQuotas", mock.Anything, tc.req.Id).Return(tc.svcRes, tc.svcErr)
+		res, err := grpcClient.RetrieveQuotas(context.Background(), tc.req)
+		assert.Equal(t, tc.res.GetMessageRate(), res.GetQuotas().GetMessageRate(), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, res.GetQuotas()))
+		assert.Equal(t, tc.res.GetClients(), res.GetQuotas().GetClients(), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, res.GetQuotas()))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		svcCall.Unset()
+	}
+}
+
+func TestClaimInvitations(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewDomainsClient(conn, time.Second)
+
+	invitations := []domains.Invitation{
+		{DomainID: "domain1", InviteeUserID: id, InviteeEmail: email},
+		{DomainID: "domain2", InviteeUserID: id, InviteeEmail: email},
+	}
+
+	cases := []struct {
+		desc   string
+		req    *grpcDomainsV1.ClaimInvitationsReq
+		svcRes []domains.Invitation
+		svcErr error
+		res    []string
+		err    error
+	}{
+		{
+			desc: "claim invitations successfully",
+			req: &grpcDomainsV1.ClaimInvitationsReq{
+				UserId: id,
+				Email:  email,
+				Token:  validToken,
+			},
+			svcRes: invitations,
+			res:    []string{"domain1", "domain2"},
+			err:    nil,
+		},
+		{
+			desc: "claim invitations without token",
+			req: &grpcDomainsV1.ClaimInvitationsReq{
+				UserId: id,
+				Email:  email,
+			},
+			svcRes: invitations,
+			res:    []string{"domain1", "domain2"},
+			err:    nil,
+		},
+		{
+			desc: "claim invitations with empty user id",
+			req: &grpcDomainsV1.ClaimInvitationsReq{
+				Email: email,
+				Token: validToken,
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "claim invitations with empty email",
+			req: &grpcDomainsV1.ClaimInvitationsReq{
+				UserId: id,
+				Token:  validToken,
+			},
+			err: svcerr.ErrAuthentication,
+		},
+		{
+			desc: "claim invitations with invalid token",
+			req: &grpcDomainsV1.ClaimInvitationsReq{
+				UserId: id,
+				Email:  email,
+				Token:  inValidToken,
+			},
+			svcErr: svcerr.ErrAuthentication,
+			err:    svcerr.ErrAuthentication,
+		},
+	}
+	for _, tc := range cases {
+		svcCall := svc.On("ClaimInvitations", mock.Anything, tc.req.UserId, tc.req.Email, tc.req.Token).Return(tc.svcRes, tc.svcErr)
+		res, err := grpcClient.ClaimInvitations(context.Background(), tc.req)
+		assert.Equal(t, tc.res, res.GetDomainIds(), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, res.GetDomainIds()))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		svcCall.Unset()
+	}
+}
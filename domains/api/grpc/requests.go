@@ -5,6 +5,7 @@ package grpc
 
 import (
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
 )
 
 type deleteUserPoliciesReq struct {
@@ -42,3 +43,66 @@ func (req retrieveIDByRouteReq) validate() error {
 
 	return nil
 }
+
+type updateUsageReq struct {
+	DomainID string
+	Resource domains.Resource
+	Delta    int64
+}
+
+func (req updateUsageReq) validate() error {
+	if req.DomainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if !req.Resource.Counted() {
+		return apiutil.ErrInvalidQuotaResource
+	}
+
+	return nil
+}
+
+type setUsageReq struct {
+	DomainID string
+	Resource domains.Resource
+	Count    uint64
+}
+
+func (req setUsageReq) validate() error {
+	if req.DomainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if !req.Resource.Counted() {
+		return apiutil.ErrInvalidQuotaResource
+	}
+
+	return nil
+}
+
+type retrieveQuotasReq struct {
+	DomainID string
+}
+
+func (req retrieveQuotasReq) validate() error {
+	if req.DomainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+
+	return nil
+}
+
+type claimInvitationsReq struct {
+	UserID string
+	Email  string
+	Token  string
+}
+
+func (req claimInvitationsReq) validate() error {
+	if req.UserID == "" {
+		return apiutil.ErrMissingUserID
+	}
+	if req.Email == "" {
+		return apiutil.ErrMissingEmail
+	}
+
+	return nil
+}
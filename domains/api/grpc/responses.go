@@ -3,6 +3,8 @@
 
 package grpc
 
+import "github.com/absmach/supermq/domains"
+
 type deleteUserRes struct {
 	deleted bool
 }
@@ -14,3 +16,19 @@ type retrieveIDByRouteRes struct {
 type retrieveStatusRes struct {
 	status uint8
 }
+
+type updateUsageRes struct {
+	updated bool
+}
+
+type setUsageRes struct {
+	updated bool
+}
+
+type retrieveQuotasRes struct {
+	quotas domains.Quotas
+}
+
+type claimInvitationsRes struct {
+	domainIDs []string
+}
@@ -9,7 +9,8 @@ import (
 	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
 	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
 	grpcapi "github.com/absmach/supermq/auth/api/grpc"
-	domains "github.com/absmach/supermq/domains/private"
+	"github.com/absmach/supermq/domains"
+	pDomains "github.com/absmach/supermq/domains/private"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 )
 
@@ -20,9 +21,13 @@ type domainsGrpcServer struct {
 	deleteUserFromDomains kitgrpc.Handler
 	retrieveStatus        kitgrpc.Handler
 	retrieveIDByRoute     kitgrpc.Handler
+	updateUsage           kitgrpc.Handler
+	setUsage              kitgrpc.Handler
+	retrieveQuotas        kitgrpc.Handler
+	claimInvitations      kitgrpc.Handler
 }
 
-func NewDomainsServer(svc domains.Service) grpcDomainsV1.DomainsServiceServer {
+func NewDomainsServer(svc pDomains.Service) grpcDomainsV1.DomainsServiceServer {
 	return &domainsGrpcServer{
 		deleteUserFromDomains: kitgrpc.NewServer(
 			(deleteUserFromDomainsEndpoint(svc)),
@@ -39,6 +44,26 @@ func NewDomainsServer(svc domains.Service) grpcDomainsV1.DomainsServiceServer {
 			decodeRetrieveIDByRouteRequest,
 			encodeRetrieveIDByRouteResponse,
 		),
+		updateUsage: kitgrpc.NewServer(
+			updateUsageEndpoint(svc),
+			decodeUpdateUsageRequest,
+			encodeUpdateUsageResponse,
+		),
+		setUsage: kitgrpc.NewServer(
+			setUsageEndpoint(svc),
+			decodeSetUsageRequest,
+			encodeSetUsageResponse,
+		),
+		retrieveQuotas: kitgrpc.NewServer(
+			retrieveQuotasEndpoint(svc),
+			decodeRetrieveQuotasRequest,
+			encodeRetrieveQuotasResponse,
+		),
+		claimInvitations: kitgrpc.NewServer(
+			claimInvitationsEndpoint(svc),
+			decodeClaimInvitationsRequest,
+			encodeClaimInvitationsResponse,
+		),
 	}
 }
 
@@ -115,3 +140,123 @@ func (s *domainsGrpcServer) RetrieveIDByRoute(ctx context.Context, req *grpcComm
 
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
+
+func decodeUpdateUsageRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcDomainsV1.UpdateUsageReq)
+
+	return updateUsageReq{
+		DomainID: req.GetDomainId(),
+		Resource: domains.Resource(req.GetResource()),
+		Delta:    req.GetDelta(),
+	}, nil
+}
+
+func encodeUpdateUsageResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(updateUsageRes)
+
+	return &grpcDomainsV1.UpdateUsageRes{Updated: res.updated}, nil
+}
+
+func (s *domainsGrpcServer) UpdateUsage(ctx context.Context, req *grpcDomainsV1.UpdateUsageReq) (*grpcDomainsV1.UpdateUsageRes, error) {
+	_, res, err := s.updateUsage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcDomainsV1.UpdateUsageRes), nil
+}
+
+func decodeSetUsageRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcDomainsV1.SetUsageReq)
+
+	return setUsageReq{
+		DomainID: req.GetDomainId(),
+		Resource: domains.Resource(req.GetResource()),
+		Count:    req.GetCount(),
+	}, nil
+}
+
+func encodeSetUsageResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(setUsageRes)
+
+	return &grpcDomainsV1.SetUsageRes{Updated: res.updated}, nil
+}
+
+func (s *domainsGrpcServer) SetUsage(ctx context.Context, req *grpcDomainsV1.SetUsageReq) (*grpcDomainsV1.SetUsageRes, error) {
+	_, res, err := s.setUsage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcDomainsV1.SetUsageRes), nil
+}
+
+func decodeRetrieveQuotasRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcCommonV1.RetrieveEntityReq)
+
+	return retrieveQuotasReq{
+		DomainID: req.GetId(),
+	}, nil
+}
+
+func encodeRetrieveQuotasResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(retrieveQuotasRes)
+
+	return &grpcDomainsV1.RetrieveQuotasRes{
+		Quotas: toProtoQuotas(res.quotas),
+	}, nil
+}
+
+func (s *domainsGrpcServer) RetrieveQuotas(ctx context.Context, req *grpcCommonV1.RetrieveEntityReq) (*grpcDomainsV1.RetrieveQuotasRes, error) {
+	_, res, err := s.retrieveQuotas.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcDomainsV1.RetrieveQuotasRes), nil
+}
+
+func decodeClaimInvitationsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcDomainsV1.ClaimInvitationsReq)
+
+	return claimInvitationsReq{
+		UserID: req.GetUserId(),
+		Email:  req.GetEmail(),
+		Token:  req.GetToken(),
+	}, nil
+}
+
+func encodeClaimInvitationsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(claimInvitationsRes)
+
+	return &grpcDomainsV1.ClaimInvitationsRes{DomainIds: res.domainIDs}, nil
+}
+
+func (s *domainsGrpcServer) ClaimInvitations(ctx context.Context, req *grpcDomainsV1.ClaimInvitationsReq) (*grpcDomainsV1.ClaimInvitationsRes, error) {
+	_, res, err := s.claimInvitations.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcDomainsV1.ClaimInvitationsRes), nil
+}
+
+func toProtoQuotas(q domains.Quotas) *grpcDomainsV1.Quotas {
+	return &grpcDomainsV1.Quotas{
+		Clients:     q.Clients,
+		Channels:    q.Channels,
+		Groups:      q.Groups,
+		Invitations: q.Invitations,
+		MessageRate: q.MessageRate,
+	}
+}
+
+func fromProtoQuotas(q *grpcDomainsV1.Quotas) domains.Quotas {
+	return domains.Quotas{
+		Clients:     q.GetClients(),
+		Channels:    q.GetChannels(),
+		Groups:      q.GetGroups(),
+		Invitations: q.GetInvitations(),
+		MessageRate: q.GetMessageRate(),
+	}
+}
@@ -97,6 +97,83 @@ func decodeFreezeDomainRequest(_ context.Context, r *http.Request) (any, error)
 	return req, nil
 }
 
+func decodeDeleteDomainRequest(_ context.Context, r *http.Request) (any, error) {
+	req := deleteDomainReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	return req, nil
+}
+
+func decodeRestoreDomainRequest(_ context.Context, r *http.Request) (any, error) {
+	req := restoreDomainReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	return req, nil
+}
+
+func decodeExportDomainRequest(_ context.Context, r *http.Request) (any, error) {
+	req := exportDomainReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	return req, nil
+}
+
+func decodeRetrieveDomainUsageRequest(_ context.Context, r *http.Request) (any, error) {
+	req := retrieveDomainUsageReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	return req, nil
+}
+
+func decodeUpdateDomainQuotasRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := updateDomainQuotasReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Quotas); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeTransferOwnershipRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := transferOwnershipReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeTransferRequest(_ context.Context, r *http.Request) (any, error) {
+	req := transferReq{
+		domainID: chi.URLParam(r, "domainID"),
+	}
+	return req, nil
+}
+
+func decodeImportDomainRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+	req := importDomainReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
 func decodePageRequest(_ context.Context, r *http.Request) (domains.Page, error) {
 	s, err := apiutil.ReadStringQuery(r, api.StatusKey, api.DefClientStatus)
 	if err != nil {
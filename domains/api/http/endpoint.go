@@ -168,6 +168,211 @@ func freezeDomainEndpoint(svc domains.Service) endpoint.Endpoint {
 	}
 }
 
+func deleteDomainEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(deleteDomainReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		if _, err := svc.DeleteDomain(ctx, session, req.domainID); err != nil {
+			return nil, err
+		}
+		return deleteDomainRes{}, nil
+	}
+}
+
+func restoreDomainEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(restoreDomainReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		if _, err := svc.RestoreDomain(ctx, session, req.domainID); err != nil {
+			return nil, err
+		}
+		return restoreDomainRes{}, nil
+	}
+}
+
+func exportDomainEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(exportDomainReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		archive, err := svc.ExportDomain(ctx, session, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return exportDomainRes{archive}, nil
+	}
+}
+
+func importDomainEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(importDomainReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		domain, _, err := svc.ImportDomain(ctx, session, req.Archive.Archive, req.PreserveIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		return importDomainRes{domain}, nil
+	}
+}
+
+func retrieveDomainUsageEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(retrieveDomainUsageReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		usage, err := svc.RetrieveUsage(ctx, session, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return retrieveDomainUsageRes{usage}, nil
+	}
+}
+
+func updateDomainQuotasEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateDomainQuotasReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		quotas, err := svc.UpdateQuotas(ctx, session, req.domainID, req.Quotas)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateDomainQuotasRes{quotas}, nil
+	}
+}
+
+func transferOwnershipEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(transferOwnershipReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		transfer, err := svc.TransferOwnership(ctx, session, req.domainID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return transferOwnershipRes{transfer}, nil
+	}
+}
+
+func retrieveTransferEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(transferReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		transfer, err := svc.RetrieveTransfer(ctx, session, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return retrieveTransferRes{transfer}, nil
+	}
+}
+
+func acceptTransferEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(transferReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		transfer, err := svc.AcceptTransfer(ctx, session, req.domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return acceptTransferRes{transfer}, nil
+	}
+}
+
+func rejectTransferEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(transferReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RejectTransfer(ctx, session, req.domainID); err != nil {
+			return nil, err
+		}
+
+		return rejectTransferRes{}, nil
+	}
+}
+
 func sendInvitationEndpoint(svc domains.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(sendInvitationReq)
@@ -181,6 +386,7 @@ func sendInvitationEndpoint(svc domains.Service) endpoint.Endpoint {
 
 		invitation := domains.Invitation{
 			InviteeUserID: req.InviteeUserID,
+			InviteeEmail:  req.InviteeEmail,
 			DomainID:      session.DomainID,
 			RoleID:        req.RoleID,
 			Resend:        req.Resend,
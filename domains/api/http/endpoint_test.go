@@ -1136,6 +1136,978 @@ func TestFreezeDomain(t *testing.T) {
 	}
 }
 
+func TestDeleteDomain(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		domainID string
+		status   int
+		svcErr   error
+		svcRes   domains.Domain
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "delete domain with valid token",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusNoContent,
+			svcRes:   domain,
+			err:      nil,
+		},
+		{
+			desc:     "delete domain with invalid token",
+			token:    inValidToken,
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "delete domain with empty token",
+			token:    "",
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			err:      apiutil.ErrBearerToken,
+		},
+		{
+			desc:     "delete domain with invalid id",
+			token:    validToken,
+			domainID: invalid,
+			status:   http.StatusUnprocessableEntity,
+			svcErr:   svcerr.ErrUpdateEntity,
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ds.Client(),
+				method:      http.MethodDelete,
+				url:         fmt.Sprintf("%s/domains/%s", ds.URL, tc.domainID),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("DeleteDomain", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRestoreDomain(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		domainID string
+		status   int
+		svcErr   error
+		svcRes   domains.Domain
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "restore domain with valid token",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusOK,
+			svcRes:   domain,
+			err:      nil,
+		},
+		{
+			desc:     "restore domain with invalid token",
+			token:    inValidToken,
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "restore domain with empty token",
+			token:    "",
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			err:      apiutil.ErrBearerToken,
+		},
+		{
+			desc:     "restore domain with empty id",
+			token:    validToken,
+			domainID: "",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrMissingID,
+		},
+		{
+			desc:     "restore domain with invalid id",
+			token:    validToken,
+			domainID: invalid,
+			status:   http.StatusUnprocessableEntity,
+			svcErr:   svcerr.ErrUpdateEntity,
+			err:      svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:     "restore domain which is not deleted",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusBadRequest,
+			svcErr:   svcerr.ErrInvalidStatus,
+			err:      svcerr.ErrInvalidStatus,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ds.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/domains/%s/restore", ds.URL, tc.domainID),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RestoreDomain", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestExportDomain(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain:  domain,
+		Roles: []domains.ArchiveRole{
+			{
+				ID:      testsutil.GenerateUUID(t),
+				Name:    "admin",
+				Members: []string{userID},
+			},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		domainID string
+		status   int
+		svcRes   domains.Archive
+		svcErr   error
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "export domain with valid token",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusOK,
+			svcRes:   archive,
+			err:      nil,
+		},
+		{
+			desc:     "export domain with invalid token",
+			token:    inValidToken,
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "export domain with empty token",
+			token:    "",
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			err:      apiutil.ErrBearerToken,
+		},
+		{
+			desc:     "export domain with unauthorized user",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusForbidden,
+			svcErr:   svcerr.ErrAuthorization,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:     "export domain with service error",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusUnprocessableEntity,
+			svcErr:   svcerr.ErrViewEntity,
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/domains/%s/export", ds.URL, tc.domainID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ExportDomain", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				var resArchive domains.Archive
+				err = json.NewDecoder(res.Body).Decode(&resArchive)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes.Version, resArchive.Version)
+				assert.Equal(t, tc.svcRes.Domain.ID, resArchive.Domain.ID)
+				assert.Equal(t, tc.svcRes.Roles, resArchive.Roles)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveDomainUsage(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	usage := domains.QuotasUsage{
+		DomainID: domain.ID,
+		Quotas:   domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100},
+		Usage:    domains.Usage{Clients: 1, Channels: 2, Groups: 3, Invitations: 1},
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		domainID string
+		status   int
+		svcRes   domains.QuotasUsage
+		svcErr   error
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "retrieve domain usage with valid token",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusOK,
+			svcRes:   usage,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve domain usage with invalid token",
+			token:    inValidToken,
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "retrieve domain usage with empty token",
+			token:    "",
+			domainID: domain.ID,
+			status:   http.StatusUnauthorized,
+			err:      apiutil.ErrBearerToken,
+		},
+		{
+			desc:     "retrieve domain usage with unauthorized user",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusForbidden,
+			svcErr:   svcerr.ErrAuthorization,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:     "retrieve domain usage with service error",
+			token:    validToken,
+			domainID: domain.ID,
+			status:   http.StatusUnprocessableEntity,
+			svcErr:   svcerr.ErrViewEntity,
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/domains/%s/usage", ds.URL, tc.domainID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RetrieveUsage", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				var resUsage domains.QuotasUsage
+				err = json.NewDecoder(res.Body).Decode(&resUsage)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes, resUsage, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.svcRes, resUsage))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestUpdateDomainQuotas(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+
+	cases := []struct {
+		desc        string
+		token       string
+		session     authn.Session
+		domainID    string
+		data        string
+		quotas      domains.Quotas
+		contentType string
+		status      int
+		svcRes      domains.Quotas
+		svcErr      error
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "update domain quotas successfully",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: contentType,
+			status:      http.StatusOK,
+			svcRes:      quotas,
+			err:         nil,
+		},
+		{
+			desc:        "update domain quotas with invalid token",
+			token:       inValidToken,
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			authnErr:    svcerr.ErrAuthentication,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "update domain quotas with empty token",
+			token:       "",
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "update domain quotas with invalid content type",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "update domain quotas with malformed body",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        `{"clients": -1}`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "update domain quotas with non super admin user",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: contentType,
+			status:      http.StatusForbidden,
+			svcErr:      svcerr.ErrSuperAdminAction,
+			err:         svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:        "update domain quotas with service error",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        toJSON(quotas),
+			quotas:      quotas,
+			contentType: contentType,
+			status:      http.StatusUnprocessableEntity,
+			svcErr:      svcerr.ErrUpdateEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ds.Client(),
+				method:      http.MethodPut,
+				url:         fmt.Sprintf("%s/domains/%s/quotas", ds.URL, tc.domainID),
+				body:        strings.NewReader(tc.data),
+				contentType: tc.contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("UpdateQuotas", mock.Anything, tc.session, tc.domainID, tc.quotas).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				var resQuotas domains.Quotas
+				err = json.NewDecoder(res.Body).Decode(&resQuotas)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes, resQuotas, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.svcRes, resQuotas))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	memberID := testsutil.GenerateUUID(t)
+	transfer := domains.Transfer{
+		DomainID:   domain.ID,
+		FromUserID: userID,
+		ToUserID:   memberID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc        string
+		token       string
+		session     authn.Session
+		domainID    string
+		data        string
+		userID      string
+		contentType string
+		status      int
+		svcRes      domains.Transfer
+		svcErr      error
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "transfer ownership successfully",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        fmt.Sprintf(`{"user_id": "%s"}`, memberID),
+			userID:      memberID,
+			contentType: contentType,
+			status:      http.StatusCreated,
+			svcRes:      transfer,
+			err:         nil,
+		},
+		{
+			desc:        "transfer ownership with invalid token",
+			token:       inValidToken,
+			domainID:    domain.ID,
+			data:        fmt.Sprintf(`{"user_id": "%s"}`, memberID),
+			userID:      memberID,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			authnErr:    svcerr.ErrAuthentication,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "transfer ownership with invalid content type",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        fmt.Sprintf(`{"user_id": "%s"}`, memberID),
+			userID:      memberID,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "transfer ownership with malformed body",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        `data`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "transfer ownership with missing user id",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        `{}`,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingUserID,
+		},
+		{
+			desc:        "transfer ownership with invalid transfer",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        fmt.Sprintf(`{"user_id": "%s"}`, memberID),
+			userID:      memberID,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			svcErr:      svcerr.ErrInvalidOwnershipTransfer,
+			err:         svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:        "transfer ownership with unauthorized user",
+			token:       validToken,
+			domainID:    domain.ID,
+			data:        fmt.Sprintf(`{"user_id": "%s"}`, memberID),
+			userID:      memberID,
+			contentType: contentType,
+			status:      http.StatusForbidden,
+			svcErr:      svcerr.ErrAuthorization,
+			err:         svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ds.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/domains/%s/transfer", ds.URL, tc.domainID),
+				body:        strings.NewReader(tc.data),
+				contentType: tc.contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: tc.domainID, DomainUserID: tc.domainID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("TransferOwnership", mock.Anything, tc.session, tc.domainID, tc.userID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				var resTransfer domains.Transfer
+				err = json.NewDecoder(res.Body).Decode(&resTransfer)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes, resTransfer, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.svcRes, resTransfer))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveTransfer(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	transfer := domains.Transfer{
+		DomainID:   domain.ID,
+		FromUserID: testsutil.GenerateUUID(t),
+		ToUserID:   userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		status   int
+		svcRes   domains.Transfer
+		svcErr   error
+		authnErr error
+		err      error
+	}{
+		{
+			desc:   "retrieve transfer successfully",
+			token:  validToken,
+			status: http.StatusOK,
+			svcRes: transfer,
+		},
+		{
+			desc:     "retrieve transfer with invalid token",
+			token:    inValidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "retrieve non-existing transfer",
+			token:  validToken,
+			status: http.StatusNotFound,
+			svcErr: svcerr.ErrNotFound,
+			err:    svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/domains/%s/transfer", ds.URL, domain.ID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: domain.ID, DomainUserID: domain.ID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RetrieveTransfer", mock.Anything, tc.session, domain.ID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				var resTransfer domains.Transfer
+				err = json.NewDecoder(res.Body).Decode(&resTransfer)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.svcRes, resTransfer, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.svcRes, resTransfer))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestAcceptTransfer(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	transfer := domains.Transfer{
+		DomainID:   domain.ID,
+		FromUserID: testsutil.GenerateUUID(t),
+		ToUserID:   userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		status   int
+		svcRes   domains.Transfer
+		svcErr   error
+		authnErr error
+	}{
+		{
+			desc:   "accept transfer successfully",
+			token:  validToken,
+			status: http.StatusOK,
+			svcRes: transfer,
+		},
+		{
+			desc:     "accept transfer with invalid token",
+			token:    inValidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "accept transfer of other user",
+			token:  validToken,
+			status: http.StatusForbidden,
+			svcErr: svcerr.ErrAuthorization,
+		},
+		{
+			desc:   "accept transfer from user who isn't admin",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			svcErr: svcerr.ErrInvalidOwnershipTransfer,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodPost,
+				url:    fmt.Sprintf("%s/domains/%s/transfer/accept", ds.URL, domain.ID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: domain.ID, DomainUserID: domain.ID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("AcceptTransfer", mock.Anything, tc.session, domain.ID).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRejectTransfer(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  authn.Session
+		status   int
+		svcErr   error
+		authnErr error
+	}{
+		{
+			desc:   "reject transfer successfully",
+			token:  validToken,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:     "reject transfer with invalid token",
+			token:    inValidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "reject transfer of other users",
+			token:  validToken,
+			status: http.StatusForbidden,
+			svcErr: svcerr.ErrAuthorization,
+		},
+		{
+			desc:   "reject non-existing transfer",
+			token:  validToken,
+			status: http.StatusNotFound,
+			svcErr: svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ds.Client(),
+				method: http.MethodPost,
+				url:    fmt.Sprintf("%s/domains/%s/transfer/reject", ds.URL, domain.ID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID, DomainID: domain.ID, DomainUserID: domain.ID + "_" + userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RejectTransfer", mock.Anything, tc.session, domain.ID).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestImportDomain(t *testing.T) {
+	ds, svc, auth := newDomainsServer()
+	defer ds.Close()
+
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain: domains.Domain{
+			ID:       validID,
+			Name:     "test",
+			Metadata: domains.Metadata{"role": "domain"},
+			Tags:     []string{"tag1", "tag2"},
+			Route:    "test",
+			Status:   domains.EnabledStatus,
+		},
+		Roles: []domains.ArchiveRole{
+			{
+				ID:      testsutil.GenerateUUID(t),
+				Name:    "admin",
+				Members: []string{userID},
+			},
+		},
+	}
+	withArchive := func(f func(a *domains.Archive)) domains.Archive {
+		a := archive
+		f(&a)
+		return a
+	}
+
+	cases := []struct {
+		desc        string
+		archive     domains.Archive
+		data        string
+		preserveIDs bool
+		token       string
+		session     authn.Session
+		contentType string
+		svcErr      error
+		status      int
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "import domain successfully",
+			archive:     archive,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusCreated,
+			err:         nil,
+		},
+		{
+			desc:        "import domain with preserved ids",
+			archive:     archive,
+			preserveIDs: true,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusCreated,
+			err:         nil,
+		},
+		{
+			desc:        "import domain with empty token",
+			archive:     archive,
+			token:       "",
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "import domain with invalid token",
+			archive:     archive,
+			token:       inValidToken,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			authnErr:    svcerr.ErrAuthentication,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "import domain with unsupported version",
+			archive:     withArchive(func(a *domains.Archive) { a.Version = domains.ArchiveVersion + 1 }),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrUnsupportedArchiveVersion,
+		},
+		{
+			desc:        "import domain with archived entities",
+			archive:     archive,
+			data:        fmt.Sprintf(`{"archive": {"version": %d, "domain": {"name": "test", "route": "test"}, "groups": [{"group": {"name": "group"}}]}}`, domains.ArchiveVersion),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrArchiveEntities,
+		},
+		{
+			desc:        "import domain with invalid preserved id",
+			archive:     withArchive(func(a *domains.Archive) { a.Domain.ID = invalid }),
+			preserveIDs: true,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidIDFormat,
+		},
+		{
+			desc:        "import domain with empty name",
+			archive:     withArchive(func(a *domains.Archive) { a.Domain.Name = "" }),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingName,
+		},
+		{
+			desc:        "import domain with empty route",
+			archive:     withArchive(func(a *domains.Archive) { a.Domain.Route = "" }),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingRoute,
+		},
+		{
+			desc:        "import domain with invalid content type",
+			archive:     archive,
+			token:       validToken,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "import domain with service error",
+			archive:     archive,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusUnprocessableEntity,
+			svcErr:      svcerr.ErrCreateEntity,
+			err:         svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			data := toJSON(map[string]any{
+				"archive":      tc.archive,
+				"preserve_ids": tc.preserveIDs,
+			})
+			if tc.data != "" {
+				data = tc.data
+			}
+			req := testRequest{
+				client:      ds.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/domains/import", ds.URL),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(data),
+			}
+			if tc.token == validToken {
+				tc.session = authn.Session{UserID: userID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ImportDomain", mock.Anything, tc.session, mock.Anything, tc.preserveIDs).Return(tc.archive.Domain, []roles.RoleProvision{}, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var errRes respBody
+			err = json.NewDecoder(res.Body).Decode(&errRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if errRes.Err != "" || errRes.Message != "" {
+				err = errors.Wrap(errors.New(errRes.Err), errors.New(errRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestSendInvitation(t *testing.T) {
 	is, svc, auth := newDomainsServer()
 
@@ -1204,6 +2176,42 @@ func TestSendInvitation(t *testing.T) {
 			contentType: contentType,
 			svcErr:      svcerr.ErrAuthorization,
 		},
+		{
+			desc:        "send invitation to email",
+			token:       validToken,
+			domainID:    domainID,
+			data:        fmt.Sprintf(`{"invitee_email": "invitee@example.com","role_id": "%s"}`, validID),
+			status:      http.StatusCreated,
+			contentType: contentType,
+			svcErr:      nil,
+		},
+		{
+			desc:        "send invitation to invalid email",
+			token:       validToken,
+			domainID:    domainID,
+			data:        fmt.Sprintf(`{"invitee_email": "invalid","role_id": "%s"}`, validID),
+			status:      http.StatusBadRequest,
+			contentType: contentType,
+			svcErr:      nil,
+		},
+		{
+			desc:        "send invitation with both invitee user ID and email",
+			token:       validToken,
+			domainID:    domainID,
+			data:        fmt.Sprintf(`{"invitee_user_id": "%s","invitee_email": "invitee@example.com","role_id": "%s"}`, validID, validID),
+			status:      http.StatusBadRequest,
+			contentType: contentType,
+			svcErr:      nil,
+		},
+		{
+			desc:        "send invitation to email over the resend limit",
+			token:       validToken,
+			domainID:    domainID,
+			data:        fmt.Sprintf(`{"invitee_email": "invitee@example.com","role_id": "%s","resend": true}`, validID),
+			status:      http.StatusBadRequest,
+			contentType: contentType,
+			svcErr:      svcerr.ErrInvitationResendLimit,
+		},
 	}
 
 	for _, tc := range cases {
@@ -4,6 +4,8 @@
 package http
 
 import (
+	"encoding/json"
+
 	api "github.com/absmach/supermq/api/http"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/domains"
@@ -118,14 +120,153 @@ func (req freezeDomainReq) validate() error {
 	return nil
 }
 
+type deleteDomainReq struct {
+	domainID string
+}
+
+func (req deleteDomainReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type restoreDomainReq struct {
+	domainID string
+}
+
+func (req restoreDomainReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type exportDomainReq struct {
+	domainID string
+}
+
+func (req exportDomainReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type retrieveDomainUsageReq struct {
+	domainID string
+}
+
+func (req retrieveDomainUsageReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type updateDomainQuotasReq struct {
+	domainID string
+	domains.Quotas
+}
+
+func (req updateDomainQuotasReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type transferOwnershipReq struct {
+	domainID string
+	UserID   string `json:"user_id"`
+}
+
+func (req transferOwnershipReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.UserID == "" {
+		return apiutil.ErrMissingUserID
+	}
+
+	return nil
+}
+
+type transferReq struct {
+	domainID string
+}
+
+func (req transferReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type importDomainReq struct {
+	Archive     importArchive `json:"archive"`
+	PreserveIDs bool          `json:"preserve_ids,omitempty"`
+}
+
+// importArchive is the domain archive which may be extended with the
+// entities by the SDK. The entities are not imported by the domains service,
+// so they are rejected instead of being dropped.
+type importArchive struct {
+	domains.Archive
+	Groups      []json.RawMessage `json:"groups,omitempty"`
+	Channels    []json.RawMessage `json:"channels,omitempty"`
+	Clients     []json.RawMessage `json:"clients,omitempty"`
+	Connections []json.RawMessage `json:"connections,omitempty"`
+}
+
+func (req importDomainReq) validate() error {
+	if req.Archive.Version != domains.ArchiveVersion {
+		return apiutil.ErrUnsupportedArchiveVersion
+	}
+	if len(req.Archive.Groups) > 0 || len(req.Archive.Channels) > 0 || len(req.Archive.Clients) > 0 || len(req.Archive.Connections) > 0 {
+		return apiutil.ErrArchiveEntities
+	}
+	if req.PreserveIDs && req.Archive.Domain.ID != "" {
+		if err := api.ValidateUUID(req.Archive.Domain.ID); err != nil {
+			return err
+		}
+	}
+	if req.Archive.Domain.Name == "" {
+		return apiutil.ErrMissingName
+	}
+	if req.Archive.Domain.Route == "" {
+		return apiutil.ErrMissingRoute
+	}
+	if err := validateRoute(req.Archive.Domain.Route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 type sendInvitationReq struct {
 	InviteeUserID string `json:"invitee_user_id,omitempty"`
+	InviteeEmail  string `json:"invitee_email,omitempty"`
 	RoleID        string `json:"role_id,omitempty"`
 	Resend        bool   `json:"resend,omitempty"`
 }
 
 func (req *sendInvitationReq) validate() error {
-	if req.InviteeUserID == "" || req.RoleID == "" {
+	if req.InviteeUserID != "" && req.InviteeEmail != "" {
+		return apiutil.ErrInvalidInvitee
+	}
+	if req.InviteeEmail != "" {
+		if err := api.ValidateEmail(req.InviteeEmail); err != nil {
+			return err
+		}
+	}
+	if (req.InviteeUserID == "" && req.InviteeEmail == "") || req.RoleID == "" {
 		return apiutil.ErrMissingID
 	}
 
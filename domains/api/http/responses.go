@@ -17,6 +17,16 @@ var (
 	_ supermq.Response = (*enableDomainRes)(nil)
 	_ supermq.Response = (*disableDomainRes)(nil)
 	_ supermq.Response = (*freezeDomainRes)(nil)
+	_ supermq.Response = (*deleteDomainRes)(nil)
+	_ supermq.Response = (*restoreDomainRes)(nil)
+	_ supermq.Response = (*exportDomainRes)(nil)
+	_ supermq.Response = (*importDomainRes)(nil)
+	_ supermq.Response = (*retrieveDomainUsageRes)(nil)
+	_ supermq.Response = (*updateDomainQuotasRes)(nil)
+	_ supermq.Response = (*transferOwnershipRes)(nil)
+	_ supermq.Response = (*retrieveTransferRes)(nil)
+	_ supermq.Response = (*acceptTransferRes)(nil)
+	_ supermq.Response = (*rejectTransferRes)(nil)
 	_ supermq.Response = (*sendInvitationRes)(nil)
 	_ supermq.Response = (*listInvitationsRes)(nil)
 	_ supermq.Response = (*acceptInvitationRes)(nil)
@@ -130,6 +140,160 @@ func (res freezeDomainRes) Empty() bool {
 	return true
 }
 
+type deleteDomainRes struct{}
+
+func (res deleteDomainRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res deleteDomainRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deleteDomainRes) Empty() bool {
+	return true
+}
+
+type restoreDomainRes struct{}
+
+func (res restoreDomainRes) Code() int {
+	return http.StatusOK
+}
+
+func (res restoreDomainRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res restoreDomainRes) Empty() bool {
+	return true
+}
+
+type exportDomainRes struct {
+	domains.Archive
+}
+
+func (res exportDomainRes) Code() int {
+	return http.StatusOK
+}
+
+func (res exportDomainRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res exportDomainRes) Empty() bool {
+	return false
+}
+
+type importDomainRes struct {
+	domains.Domain
+}
+
+func (res importDomainRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res importDomainRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res importDomainRes) Empty() bool {
+	return false
+}
+
+type retrieveDomainUsageRes struct {
+	domains.QuotasUsage
+}
+
+func (res retrieveDomainUsageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res retrieveDomainUsageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res retrieveDomainUsageRes) Empty() bool {
+	return false
+}
+
+type updateDomainQuotasRes struct {
+	domains.Quotas
+}
+
+func (res updateDomainQuotasRes) Code() int {
+	return http.StatusOK
+}
+
+func (res updateDomainQuotasRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updateDomainQuotasRes) Empty() bool {
+	return false
+}
+
+type transferOwnershipRes struct {
+	domains.Transfer
+}
+
+func (res transferOwnershipRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res transferOwnershipRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res transferOwnershipRes) Empty() bool {
+	return false
+}
+
+type retrieveTransferRes struct {
+	domains.Transfer
+}
+
+func (res retrieveTransferRes) Code() int {
+	return http.StatusOK
+}
+
+func (res retrieveTransferRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res retrieveTransferRes) Empty() bool {
+	return false
+}
+
+type acceptTransferRes struct {
+	domains.Transfer
+}
+
+func (res acceptTransferRes) Code() int {
+	return http.StatusOK
+}
+
+func (res acceptTransferRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res acceptTransferRes) Empty() bool {
+	return false
+}
+
+type rejectTransferRes struct{}
+
+func (res rejectTransferRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res rejectTransferRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res rejectTransferRes) Empty() bool {
+	return true
+}
+
 type sendInvitationRes struct {
 	Message string `json:"message"`
 }
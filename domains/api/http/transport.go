@@ -45,6 +45,13 @@ func MakeHandler(svc domains.Service, authn smqauthn.AuthNMiddleware, mux *chi.M
 				opts...,
 			), "list_domains").ServeHTTP)
 
+			r.Post("/import", otelhttp.NewHandler(kithttp.NewServer(
+				importDomainEndpoint(svc),
+				decodeImportDomainRequest,
+				api.EncodeResponse,
+				opts...,
+			), "import_domain").ServeHTTP)
+
 			roleManagerHttp.EntityAvailableActionsRouter(svc, d, r, opts)
 		})
 
@@ -84,6 +91,72 @@ func MakeHandler(svc domains.Service, authn smqauthn.AuthNMiddleware, mux *chi.M
 				api.EncodeResponse,
 				opts...,
 			), "freeze_domain").ServeHTTP)
+
+			r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+				deleteDomainEndpoint(svc),
+				decodeDeleteDomainRequest,
+				api.EncodeResponse,
+				opts...,
+			), "delete_domain").ServeHTTP)
+
+			r.Post("/restore", otelhttp.NewHandler(kithttp.NewServer(
+				restoreDomainEndpoint(svc),
+				decodeRestoreDomainRequest,
+				api.EncodeResponse,
+				opts...,
+			), "restore_domain").ServeHTTP)
+
+			r.Get("/export", otelhttp.NewHandler(kithttp.NewServer(
+				exportDomainEndpoint(svc),
+				decodeExportDomainRequest,
+				api.EncodeResponse,
+				opts...,
+			), "export_domain").ServeHTTP)
+
+			r.Get("/usage", otelhttp.NewHandler(kithttp.NewServer(
+				retrieveDomainUsageEndpoint(svc),
+				decodeRetrieveDomainUsageRequest,
+				api.EncodeResponse,
+				opts...,
+			), "retrieve_domain_usage").ServeHTTP)
+
+			r.Put("/quotas", otelhttp.NewHandler(kithttp.NewServer(
+				updateDomainQuotasEndpoint(svc),
+				decodeUpdateDomainQuotasRequest,
+				api.EncodeResponse,
+				opts...,
+			), "update_domain_quotas").ServeHTTP)
+
+			r.Route("/transfer", func(r chi.Router) {
+				r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+					transferOwnershipEndpoint(svc),
+					decodeTransferOwnershipRequest,
+					api.EncodeResponse,
+					opts...,
+				), "transfer_domain_ownership").ServeHTTP)
+
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					retrieveTransferEndpoint(svc),
+					decodeTransferRequest,
+					api.EncodeResponse,
+					opts...,
+				), "retrieve_domain_transfer").ServeHTTP)
+
+				r.Post("/accept", otelhttp.NewHandler(kithttp.NewServer(
+					acceptTransferEndpoint(svc),
+					decodeTransferRequest,
+					api.EncodeResponse,
+					opts...,
+				), "accept_domain_transfer").ServeHTTP)
+
+				r.Post("/reject", otelhttp.NewHandler(kithttp.NewServer(
+					rejectTransferEndpoint(svc),
+					decodeTransferRequest,
+					api.EncodeResponse,
+					opts...,
+				), "reject_domain_transfer").ServeHTTP)
+			})
+
 			roleManagerHttp.EntityRoleMangerRouter(svc, d, r, opts)
 		})
 
@@ -0,0 +1,27 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+import "time"
+
+// ArchiveVersion is the version of the domain archive format.
+const ArchiveVersion = 1
+
+// Archive is the versioned snapshot of the domain and its roles. It's used
+// to move the domain between SuperMQ installations. The domain's entities are
+// not part of it, since they are exported by the SDK using their services.
+type Archive struct {
+	Version    uint64        `json:"version"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Domain     Domain        `json:"domain"`
+	Roles      []ArchiveRole `json:"roles,omitempty"`
+}
+
+// ArchiveRole is the domain role with its actions and members.
+type ArchiveRole struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Actions []string `json:"actions,omitempty"`
+	Members []string `json:"members,omitempty"`
+}
@@ -0,0 +1,164 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// The DeleteHandler is a cron job that runs periodically to remove domains that have been marked as deleted
+// for a certain period of time together with the domain's roles, invitations and policies.
+// The handler runs in a separate goroutine and checks for domains that have been marked as deleted for a certain period of time.
+// If the domain has been marked as deleted for more than the specified period, the handler publishes
+// the domain remove event, so the other services remove the domain's clients, channels, groups and journals,
+// and removes the domain from the database.
+
+package domains
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+const (
+	defLimit = uint64(100)
+
+	removeOp     = "domain.remove"
+	removeStream = "supermq." + removeOp
+)
+
+var (
+	errRemovePolicies = errors.New("failed to remove domain policies")
+	errPublishRemove  = errors.New("failed to publish domain remove event")
+)
+
+type handler struct {
+	domains       Repository
+	cache         Cache
+	policies      policies.Service
+	publisher     events.Publisher
+	checkInterval time.Duration
+	deleteAfter   time.Duration
+	logger        *slog.Logger
+}
+
+func NewDeleteHandler(ctx context.Context, repo Repository, cache Cache, policyService policies.Service, publisher events.Publisher, defCheckInterval, deleteAfter time.Duration, logger *slog.Logger) {
+	handler := &handler{
+		domains:       repo,
+		cache:         cache,
+		policies:      policyService,
+		publisher:     publisher,
+		checkInterval: defCheckInterval,
+		deleteAfter:   deleteAfter,
+		logger:        logger,
+	}
+
+	go func() {
+		ticker := time.NewTicker(handler.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				handler.handle(ctx)
+			}
+		}
+	}()
+}
+
+func (h *handler) handle(ctx context.Context) {
+	pm := Page{Limit: defLimit, Offset: 0, Status: DeletedStatus}
+
+	for {
+		dp, err := h.domains.ListDomains(ctx, pm)
+		if err != nil {
+			h.logger.Error("failed to retrieve domains", slog.Any("error", err))
+			return
+		}
+		if len(dp.Domains) == 0 {
+			return
+		}
+
+		for _, d := range dp.Domains {
+			// Domains which are kept are skipped by the next page.
+			if time.Since(d.DeletedAt) < h.deleteAfter {
+				pm.Offset++
+				continue
+			}
+
+			if err := h.remove(ctx, d); err != nil {
+				h.logger.Error("failed to remove domain", slog.String("id", d.ID), slog.Any("error", err))
+				pm.Offset++
+				continue
+			}
+
+			h.logger.Info("domain removed", slog.Group("domain",
+				slog.String("id", d.ID),
+				slog.String("name", d.Name),
+			))
+		}
+	}
+}
+
+func (h *handler) remove(ctx context.Context, d Domain) error {
+	if err := roles.RemoveEntitiesRolesPolicies(ctx, h.domains, h.policies, policies.DomainType, d.ID, []string{d.ID}); err != nil {
+		return errors.Wrap(errRemovePolicies, err)
+	}
+
+	// Removes the domain relations of the domain entities and roles,
+	// as well as the platform relation of the domain.
+	filterDeletePolicies := []policies.Policy{
+		{
+			SubjectType: policies.DomainType,
+			Subject:     d.ID,
+		},
+		{
+			ObjectType: policies.DomainType,
+			Object:     d.ID,
+		},
+	}
+	for _, fp := range filterDeletePolicies {
+		if err := h.policies.DeletePolicyFilter(ctx, fp); err != nil {
+			return errors.Wrap(errRemovePolicies, err)
+		}
+	}
+
+	// The event is published before the domain is removed, so the removal
+	// is repeated by the next run if publishing fails.
+	if err := h.publisher.Publish(ctx, removeStream, removeDomainEvent{id: d.ID}); err != nil {
+		return errors.Wrap(errPublishRemove, err)
+	}
+
+	// Domain roles and invitations are removed by the database cascade.
+	if err := h.domains.DeleteDomain(ctx, d.ID); err != nil {
+		return err
+	}
+
+	// The domain is no longer listed once it is removed from the database,
+	// so the cache removal failures are only logged and the removal is not
+	// reported as failed.
+	if err := h.cache.RemoveStatus(ctx, d.ID); err != nil {
+		h.logger.Warn("failed to remove domain status from cache", slog.String("id", d.ID), slog.Any("error", err))
+	}
+	if d.Route != "" {
+		if err := h.cache.RemoveID(ctx, d.Route); err != nil {
+			h.logger.Warn("failed to remove domain route from cache", slog.String("id", d.ID), slog.Any("error", err))
+		}
+	}
+
+	return nil
+}
+
+type removeDomainEvent struct {
+	id string
+}
+
+func (rde removeDomainEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation": removeOp,
+		"id":        rde.id,
+	}, nil
+}
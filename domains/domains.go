@@ -10,10 +10,15 @@ import (
 	"time"
 
 	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/roles"
 )
 
+// ErrRetainBuiltInRoleMember indicates that the removal of the members would
+// leave the built-in role, such as the domain administrator, without members.
+var ErrRetainBuiltInRoleMember = errors.New("built-in role must retain at least one member")
+
 // Status represents Domain status.
 type Status uint8
 
@@ -97,30 +102,33 @@ func (s *Status) UnmarshalJSON(data []byte) error {
 type Metadata map[string]any
 
 type DomainReq struct {
-	Name      *string    `json:"name,omitempty"`
-	Metadata  *Metadata  `json:"metadata,omitempty"`
-	Tags      *[]string  `json:"tags,omitempty"`
-	Status    *Status    `json:"status,omitempty"`
-	UpdatedBy *string    `json:"updated_by,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Name           *string    `json:"name,omitempty"`
+	Metadata       *Metadata  `json:"metadata,omitempty"`
+	Tags           *[]string  `json:"tags,omitempty"`
+	Status         *Status    `json:"status,omitempty"`
+	PreviousStatus *Status    `json:"-"`
+	UpdatedBy      *string    `json:"updated_by,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 }
 
 type Domain struct {
-	ID        string                    `json:"id"`
-	Name      string                    `json:"name"`
-	Metadata  Metadata                  `json:"metadata,omitempty"`
-	Tags      []string                  `json:"tags,omitempty"`
-	Route     string                    `json:"route,omitempty"`
-	Status    Status                    `json:"status"`
-	RoleID    string                    `json:"role_id,omitempty"`
-	RoleName  string                    `json:"role_name,omitempty"`
-	Actions   []string                  `json:"actions,omitempty"`
-	CreatedBy string                    `json:"created_by,omitempty"`
-	CreatedAt time.Time                 `json:"created_at"`
-	UpdatedBy string                    `json:"updated_by,omitempty"`
-	UpdatedAt time.Time                 `json:"updated_at,omitempty"`
-	MemberID  string                    `json:"member_id,omitempty"`
-	Roles     []roles.MemberRoleActions `json:"roles,omitempty"`
+	ID             string                    `json:"id"`
+	Name           string                    `json:"name"`
+	Metadata       Metadata                  `json:"metadata,omitempty"`
+	Tags           []string                  `json:"tags,omitempty"`
+	Route          string                    `json:"route,omitempty"`
+	Status         Status                    `json:"status"`
+	PreviousStatus Status                    `json:"-"`
+	DeletedAt      time.Time                 `json:"-"`
+	RoleID         string                    `json:"role_id,omitempty"`
+	RoleName       string                    `json:"role_name,omitempty"`
+	Actions        []string                  `json:"actions,omitempty"`
+	CreatedBy      string                    `json:"created_by,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+	UpdatedBy      string                    `json:"updated_by,omitempty"`
+	UpdatedAt      time.Time                 `json:"updated_at,omitempty"`
+	MemberID       string                    `json:"member_id,omitempty"`
+	Roles          []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
 type Operator uint8
@@ -217,11 +225,58 @@ type Service interface {
 	// Only platform administrators can freeze domains.
 	FreezeDomain(ctx context.Context, sesssion authn.Session, id string) (Domain, error)
 
+	// DeleteDomain marks the domain specified by the provided ID as deleted.
+	// Access to the deleted domain is blocked, and the domain is removed
+	// together with its entities once the deletion grace period expires.
+	DeleteDomain(ctx context.Context, session authn.Session, id string) (Domain, error)
+
+	// RestoreDomain enables the deleted domain specified by the provided ID.
+	// The domain can be restored only before the deletion grace period expires.
+	RestoreDomain(ctx context.Context, session authn.Session, id string) (Domain, error)
+
+	// ExportDomain returns the archive of the domain specified by the provided ID
+	// together with its roles, their actions and members.
+	ExportDomain(ctx context.Context, session authn.Session, id string) (Archive, error)
+
+	// ImportDomain creates a new domain with the roles from the archive.
+	// The archived domain ID is kept if preserveID is set, otherwise a new ID is assigned.
+	ImportDomain(ctx context.Context, session authn.Session, archive Archive, preserveID bool) (Domain, []roles.RoleProvision, error)
+
 	// ListDomains returns a list of domains.
 	ListDomains(ctx context.Context, sesssion authn.Session, page Page) (DomainsPage, error)
 
+	// RetrieveUsage returns the quotas of the domain specified by the provided ID
+	// together with the current usage of the domain resources.
+	RetrieveUsage(ctx context.Context, session authn.Session, id string) (QuotasUsage, error)
+
+	// UpdateQuotas replaces the quotas of the domain specified by the provided ID.
+	// Only platform administrators can update domain quotas.
+	UpdateQuotas(ctx context.Context, session authn.Session, id string, q Quotas) (Quotas, error)
+
+	// TransferOwnership starts the transfer of the built-in admin role of the
+	// session user to the domain member specified by the provided user ID.
+	// The pending transfer of the domain is replaced by the new one.
+	TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (Transfer, error)
+
+	// RetrieveTransfer retrieves the pending ownership transfer of the domain.
+	// Only the users who take part in the transfer can retrieve it.
+	RetrieveTransfer(ctx context.Context, session authn.Session, id string) (Transfer, error)
+
+	// AcceptTransfer accepts the pending ownership transfer of the domain.
+	// The recipient joins the built-in admin role, while the user who started
+	// the transfer takes over the former role of the recipient.
+	AcceptTransfer(ctx context.Context, session authn.Session, id string) (Transfer, error)
+
+	// RejectTransfer rejects the pending ownership transfer of the domain.
+	// The transfer can be rejected by the recipient or canceled by the
+	// user who started it.
+	RejectTransfer(ctx context.Context, session authn.Session, id string) error
+
 	// SendInvitation sends an invitation to the given user.
 	// Only domain administrators and platform administrators can send invitations.
+	// The invitation addressed to the email of the unregistered user is sent
+	// by the email containing the sign-up link, and it's accepted once the user
+	// registers with that email.
 	// Returns the enriched invitation with domain and role names populated.
 	SendInvitation(ctx context.Context, session authn.Session, invitation Invitation) (Invitation, error)
 
@@ -274,6 +329,9 @@ type Repository interface {
 	// UpdateDomain updates the domain name and metadata.
 	UpdateDomain(ctx context.Context, id string, d DomainReq) (Domain, error)
 
+	// MarkDomainDeleted marks the domain as deleted, unless it is already deleted.
+	MarkDomainDeleted(ctx context.Context, id, deletedBy string, deletedAt time.Time) (Domain, error)
+
 	// DeleteDomain deletes the domain.
 	DeleteDomain(ctx context.Context, id string) error
 
@@ -298,6 +356,61 @@ type Repository interface {
 	// DeleteUsersInvitations deletes invitation to a provided domain for users with provided user IDs.
 	DeleteUsersInvitations(ctx context.Context, domainID string, userID ...string) (err error)
 
+	// RetrieveEmailInvitation retrieves the domain invitation sent to the email.
+	RetrieveEmailInvitation(ctx context.Context, domainID, email string) (Invitation, error)
+
+	// RetrievePendingEmailInvitations retrieves the pending invitations sent to
+	// the email which are not expired.
+	RetrievePendingEmailInvitations(ctx context.Context, email string) ([]Invitation, error)
+
+	// UpdateEmailInvitation updates the token hash, expiration time and resend count
+	// of the invitation sent to the email.
+	UpdateEmailInvitation(ctx context.Context, invitation Invitation) error
+
+	// ClaimInvitation binds the invitation sent to the email to the invitee user
+	// and sets the confirmation time.
+	ClaimInvitation(ctx context.Context, pendingID string, invitation Invitation) error
+
+	// SaveQuotas saves the quotas of the domain.
+	SaveQuotas(ctx context.Context, domainID string, q Quotas) error
+
+	// RetrieveQuotas retrieves the quotas of the domain.
+	RetrieveQuotas(ctx context.Context, domainID string) (Quotas, error)
+
+	// RetrieveUsage retrieves the usage of the domain resources.
+	RetrieveUsage(ctx context.Context, domainID string) (Usage, error)
+
+	// UpdateUsage adds the delta to the usage of the domain resource.
+	// The usage is not updated and false is returned if it would exceed the non-zero limit.
+	UpdateUsage(ctx context.Context, domainID string, resource Resource, delta int64, limit uint64) (bool, error)
+
+	// SetUsage sets the usage of the domain resource.
+	SetUsage(ctx context.Context, domainID string, resource Resource, n uint64) error
+
+	// RetrieveMemberRole retrieves the role of the domain member.
+	RetrieveMemberRole(ctx context.Context, domainID, memberID string) (roles.Role, error)
+
+	// RemoveRoleMembers removes the members from their roles of the domain,
+	// or only from the role if roleID is set, and returns the removed role
+	// memberships. The removal is rejected with ErrRetainBuiltInRoleMember if
+	// any of the named built-in roles would be left without members. The
+	// built-in roles are locked, so the concurrent removals can't empty them.
+	RemoveRoleMembers(ctx context.Context, domainID, roleID string, members, builtInRoles []string, updatedBy string) ([]roles.EntityMemberRole, error)
+
+	// SaveTransfer saves the ownership transfer. It returns ErrConflict if the
+	// domain has a pending transfer, while the expired transfer is replaced.
+	SaveTransfer(ctx context.Context, t Transfer) error
+
+	// RetrieveTransfer retrieves the pending ownership transfer of the domain.
+	RetrieveTransfer(ctx context.Context, domainID string) (Transfer, error)
+
+	// DeleteTransfer deletes the pending ownership transfer of the domain.
+	DeleteTransfer(ctx context.Context, domainID string) error
+
+	// CompleteTransfer swaps the roles of the users who take part in the
+	// transfer and deletes the transfer in a single transaction.
+	CompleteTransfer(ctx context.Context, t Transfer, adminRole, memberRole roles.Role) error
+
 	roles.Repository
 }
 
@@ -0,0 +1,11 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+// Emailer wrapper around the email.
+type Emailer interface {
+	// SendInvitation sends an email with the sign-up link containing the
+	// invitation token to the invited user.
+	SendInvitation(to []string, domainName, roleName, token string) error
+}
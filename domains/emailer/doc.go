@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package emailer contains the implementation of the emailer used by the
+// SuperMQ domains service to send the invitations to unregistered users.
+package emailer
@@ -0,0 +1,38 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package emailer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/internal/email"
+)
+
+var _ domains.Emailer = (*emailer)(nil)
+
+type emailer struct {
+	invitationURL   string
+	invitationAgent *email.Agent
+}
+
+// New creates new emailer utility.
+func New(invitationURL string, invitationConfig *email.Config) (domains.Emailer, error) {
+	invitationAgent, err := email.New(invitationConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailer{
+		invitationURL:   invitationURL,
+		invitationAgent: invitationAgent,
+	}, nil
+}
+
+func (e *emailer) SendInvitation(to []string, domainName, roleName, token string) error {
+	url := fmt.Sprintf("%s?token=%s", e.invitationURL, token)
+	header := fmt.Sprintf("You have been invited to join the domain %s as %s.", domainName, roleName)
+	return e.invitationAgent.Send(to, "", "Domain Invitation", header, strings.Join(to, ", "), url, "")
+}
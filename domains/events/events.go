@@ -20,6 +20,16 @@ const (
 	domainEnable         = domainPrefix + "enable"
 	domainDisable        = domainPrefix + "disable"
 	domainFreeze         = domainPrefix + "freeze"
+	domainDelete         = domainPrefix + "delete"
+	domainRestore        = domainPrefix + "restore"
+	domainExport         = domainPrefix + "export"
+	domainImport         = domainPrefix + "import"
+	domainUsage          = domainPrefix + "usage"
+	domainUpdateQuotas   = domainPrefix + "update_quotas"
+	domainTransfer       = domainPrefix + "transfer"
+	domainViewTransfer   = domainPrefix + "view_transfer"
+	domainAcceptTransfer = domainPrefix + "accept_transfer"
+	domainRejectTransfer = domainPrefix + "reject_transfer"
 	domainList           = domainPrefix + "list"
 	invitationPrefix     = "invitation."
 	invitationSend       = invitationPrefix + "send"
@@ -37,6 +47,16 @@ var (
 	_ events.Event = (*enableDomainEvent)(nil)
 	_ events.Event = (*disableDomainEvent)(nil)
 	_ events.Event = (*freezeDomainEvent)(nil)
+	_ events.Event = (*deleteDomainEvent)(nil)
+	_ events.Event = (*restoreDomainEvent)(nil)
+	_ events.Event = (*exportDomainEvent)(nil)
+	_ events.Event = (*importDomainEvent)(nil)
+	_ events.Event = (*retrieveUsageEvent)(nil)
+	_ events.Event = (*updateQuotasEvent)(nil)
+	_ events.Event = (*transferOwnershipEvent)(nil)
+	_ events.Event = (*retrieveTransferEvent)(nil)
+	_ events.Event = (*acceptTransferEvent)(nil)
+	_ events.Event = (*rejectTransferEvent)(nil)
 	_ events.Event = (*listDomainsEvent)(nil)
 	_ events.Event = (*sendInvitationEvent)(nil)
 	_ events.Event = (*listInvitationsEvent)(nil)
@@ -217,6 +237,204 @@ func (cdse freezeDomainEvent) Encode() (map[string]any, error) {
 	}, nil
 }
 
+type deleteDomainEvent struct {
+	domainID  string
+	updatedAt time.Time
+	updatedBy string
+	authn.Session
+	requestID string
+}
+
+func (cdse deleteDomainEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   domainDelete,
+		"id":          cdse.domainID,
+		"updated_at":  cdse.updatedAt,
+		"updated_by":  cdse.updatedBy,
+		"user_id":     cdse.UserID,
+		"token_type":  cdse.Type.String(),
+		"super_admin": cdse.SuperAdmin,
+		"request_id":  cdse.requestID,
+	}, nil
+}
+
+type restoreDomainEvent struct {
+	domainID  string
+	status    domains.Status
+	updatedAt time.Time
+	updatedBy string
+	authn.Session
+	requestID string
+}
+
+func (cdse restoreDomainEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   domainRestore,
+		"id":          cdse.domainID,
+		"status":      cdse.status.String(),
+		"updated_at":  cdse.updatedAt,
+		"updated_by":  cdse.updatedBy,
+		"user_id":     cdse.UserID,
+		"token_type":  cdse.Type.String(),
+		"super_admin": cdse.SuperAdmin,
+		"request_id":  cdse.requestID,
+	}, nil
+}
+
+type exportDomainEvent struct {
+	domainID string
+	version  uint64
+	authn.Session
+	requestID string
+}
+
+func (ede exportDomainEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   domainExport,
+		"id":          ede.domainID,
+		"version":     ede.version,
+		"user_id":     ede.UserID,
+		"token_type":  ede.Type.String(),
+		"super_admin": ede.SuperAdmin,
+		"request_id":  ede.requestID,
+	}, nil
+}
+
+type retrieveUsageEvent struct {
+	domainID string
+	authn.Session
+	requestID string
+}
+
+func (rue retrieveUsageEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   domainUsage,
+		"id":          rue.domainID,
+		"user_id":     rue.UserID,
+		"token_type":  rue.Type.String(),
+		"super_admin": rue.SuperAdmin,
+		"request_id":  rue.requestID,
+	}, nil
+}
+
+type updateQuotasEvent struct {
+	domainID string
+	domains.Quotas
+	authn.Session
+	requestID string
+}
+
+func (uqe updateQuotasEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":    domainUpdateQuotas,
+		"id":           uqe.domainID,
+		"clients":      uqe.Clients,
+		"channels":     uqe.Channels,
+		"groups":       uqe.Groups,
+		"invitations":  uqe.Invitations,
+		"message_rate": uqe.MessageRate,
+		"user_id":      uqe.UserID,
+		"token_type":   uqe.Type.String(),
+		"super_admin":  uqe.SuperAdmin,
+		"request_id":   uqe.requestID,
+	}, nil
+}
+
+type transferOwnershipEvent struct {
+	domains.Transfer
+	authn.Session
+	requestID string
+}
+
+func (toe transferOwnershipEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":    domainTransfer,
+		"id":           toe.Transfer.DomainID,
+		"from_user_id": toe.FromUserID,
+		"to_user_id":   toe.ToUserID,
+		"created_at":   toe.CreatedAt,
+		"user_id":      toe.UserID,
+		"token_type":   toe.Type.String(),
+		"super_admin":  toe.SuperAdmin,
+		"request_id":   toe.requestID,
+	}, nil
+}
+
+type retrieveTransferEvent struct {
+	domains.Transfer
+	authn.Session
+	requestID string
+}
+
+func (rte retrieveTransferEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":    domainViewTransfer,
+		"id":           rte.Transfer.DomainID,
+		"from_user_id": rte.FromUserID,
+		"to_user_id":   rte.ToUserID,
+		"user_id":      rte.UserID,
+		"token_type":   rte.Type.String(),
+		"super_admin":  rte.SuperAdmin,
+		"request_id":   rte.requestID,
+	}, nil
+}
+
+type acceptTransferEvent struct {
+	domains.Transfer
+	authn.Session
+	requestID string
+}
+
+func (ate acceptTransferEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":    domainAcceptTransfer,
+		"id":           ate.Transfer.DomainID,
+		"from_user_id": ate.FromUserID,
+		"to_user_id":   ate.ToUserID,
+		"user_id":      ate.UserID,
+		"token_type":   ate.Type.String(),
+		"super_admin":  ate.SuperAdmin,
+		"request_id":   ate.requestID,
+	}, nil
+}
+
+type rejectTransferEvent struct {
+	domainID string
+	authn.Session
+	requestID string
+}
+
+func (rte rejectTransferEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":   domainRejectTransfer,
+		"id":          rte.domainID,
+		"user_id":     rte.UserID,
+		"token_type":  rte.Type.String(),
+		"super_admin": rte.SuperAdmin,
+		"request_id":  rte.requestID,
+	}, nil
+}
+
+// importDomainEvent carries the same payload as the create event, so the
+// domain replicas in the other services are created the same way.
+type importDomainEvent struct {
+	createDomainEvent
+	sourceID string
+}
+
+func (ide importDomainEvent) Encode() (map[string]any, error) {
+	val, err := ide.createDomainEvent.Encode()
+	if err != nil {
+		return nil, err
+	}
+	val["operation"] = domainImport
+	if ide.sourceID != "" {
+		val["source_id"] = ide.sourceID
+	}
+
+	return val, nil
+}
+
 type listDomainsEvent struct {
 	domains.Page
 	total      uint64
@@ -299,6 +517,9 @@ func (sie sendInvitationEvent) Encode() (map[string]any, error) {
 		"request_id":      sie.requestID,
 	}
 
+	if sie.invitation.InviteeEmail != "" {
+		val["invitee_email"] = sie.invitation.InviteeEmail
+	}
 	if sie.invitation.DomainName != "" {
 		val["domain_name"] = sie.invitation.DomainName
 	}
@@ -23,6 +23,16 @@ const (
 	enableStream                = supermqPrefix + domainEnable
 	disableStream               = supermqPrefix + domainDisable
 	freezeStream                = supermqPrefix + domainFreeze
+	deleteStream                = supermqPrefix + domainDelete
+	restoreStream               = supermqPrefix + domainRestore
+	exportStream                = supermqPrefix + domainExport
+	importStream                = supermqPrefix + domainImport
+	usageStream                 = supermqPrefix + domainUsage
+	updateQuotasStream          = supermqPrefix + domainUpdateQuotas
+	transferStream              = supermqPrefix + domainTransfer
+	viewTransferStream          = supermqPrefix + domainViewTransfer
+	acceptTransferStream        = supermqPrefix + domainAcceptTransfer
+	rejectTransferStream        = supermqPrefix + domainRejectTransfer
 	listStream                  = supermqPrefix + domainList
 	sendInvitationStream        = supermqPrefix + invitationSend
 	acceptInvitationStream      = supermqPrefix + invitationAccept
@@ -178,6 +188,202 @@ func (es *eventStore) FreezeDomain(ctx context.Context, session authn.Session, i
 	return domain, nil
 }
 
+func (es *eventStore) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	domain, err := es.svc.DeleteDomain(ctx, session, id)
+	if err != nil {
+		return domain, err
+	}
+
+	event := deleteDomainEvent{
+		domainID:  id,
+		updatedAt: domain.UpdatedAt,
+		updatedBy: domain.UpdatedBy,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, deleteStream, event); err != nil {
+		return domain, err
+	}
+
+	return domain, nil
+}
+
+func (es *eventStore) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	domain, err := es.svc.RestoreDomain(ctx, session, id)
+	if err != nil {
+		return domain, err
+	}
+
+	event := restoreDomainEvent{
+		domainID:  id,
+		status:    domain.Status,
+		updatedAt: domain.UpdatedAt,
+		updatedBy: domain.UpdatedBy,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, restoreStream, event); err != nil {
+		return domain, err
+	}
+
+	return domain, nil
+}
+
+func (es *eventStore) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	archive, err := es.svc.ExportDomain(ctx, session, id)
+	if err != nil {
+		return archive, err
+	}
+
+	event := exportDomainEvent{
+		domainID:  id,
+		version:   archive.Version,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, exportStream, event); err != nil {
+		return archive, err
+	}
+
+	return archive, nil
+}
+
+func (es *eventStore) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	domain, rps, err := es.svc.ImportDomain(ctx, session, archive, preserveID)
+	if err != nil {
+		return domain, rps, err
+	}
+
+	event := importDomainEvent{
+		createDomainEvent: createDomainEvent{
+			Domain:           domain,
+			rolesProvisioned: rps,
+			Session:          session,
+			requestID:        middleware.GetReqID(ctx),
+		},
+		sourceID: archive.Domain.ID,
+	}
+
+	if err := es.Publish(ctx, importStream, event); err != nil {
+		return domain, rps, err
+	}
+
+	return domain, rps, nil
+}
+
+func (es *eventStore) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	qu, err := es.svc.RetrieveUsage(ctx, session, id)
+	if err != nil {
+		return qu, err
+	}
+
+	event := retrieveUsageEvent{
+		domainID:  id,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, usageStream, event); err != nil {
+		return qu, err
+	}
+
+	return qu, nil
+}
+
+func (es *eventStore) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	q, err := es.svc.UpdateQuotas(ctx, session, id, q)
+	if err != nil {
+		return q, err
+	}
+
+	event := updateQuotasEvent{
+		domainID:  id,
+		Quotas:    q,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, updateQuotasStream, event); err != nil {
+		return q, err
+	}
+
+	return q, nil
+}
+
+func (es *eventStore) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (domains.Transfer, error) {
+	t, err := es.svc.TransferOwnership(ctx, session, id, userID)
+	if err != nil {
+		return t, err
+	}
+
+	event := transferOwnershipEvent{
+		Transfer:  t,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, transferStream, event); err != nil {
+		return t, err
+	}
+
+	return t, nil
+}
+
+func (es *eventStore) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	t, err := es.svc.RetrieveTransfer(ctx, session, id)
+	if err != nil {
+		return t, err
+	}
+
+	event := retrieveTransferEvent{
+		Transfer:  t,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, viewTransferStream, event); err != nil {
+		return t, err
+	}
+
+	return t, nil
+}
+
+func (es *eventStore) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	t, err := es.svc.AcceptTransfer(ctx, session, id)
+	if err != nil {
+		return t, err
+	}
+
+	event := acceptTransferEvent{
+		Transfer:  t,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, acceptTransferStream, event); err != nil {
+		return t, err
+	}
+
+	return t, nil
+}
+
+func (es *eventStore) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	if err := es.svc.RejectTransfer(ctx, session, id); err != nil {
+		return err
+	}
+
+	event := rejectTransferEvent{
+		domainID:  id,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	return es.Publish(ctx, rejectTransferStream, event)
+}
+
 func (es *eventStore) ListDomains(ctx context.Context, session authn.Session, p domains.Page) (domains.DomainsPage, error) {
 	dp, err := es.svc.ListDomains(ctx, session, p)
 	if err != nil {
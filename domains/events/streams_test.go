@@ -350,6 +350,425 @@ func TestFreezeDomain(t *testing.T) {
 	}
 }
 
+func TestDeleteDomain(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		svcRes   domains.Domain
+		svcErr   error
+		resp     domains.Domain
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   validDomain,
+			svcErr:   nil,
+			resp:     validDomain,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   svcerr.ErrUpdateEntity,
+			resp:     domains.Domain{},
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("DeleteDomain", validCtx, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.DeleteDomain(validCtx, tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestRestoreDomain(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		svcRes   domains.Domain
+		svcErr   error
+		resp     domains.Domain
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   validDomain,
+			svcErr:   nil,
+			resp:     validDomain,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   svcerr.ErrUpdateEntity,
+			resp:     domains.Domain{},
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("RestoreDomain", validCtx, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.RestoreDomain(validCtx, tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestExportDomain(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain:  validDomain,
+	}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		svcRes   domains.Archive
+		svcErr   error
+		resp     domains.Archive
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   archive,
+			svcErr:   nil,
+			resp:     archive,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   domains.Archive{},
+			svcErr:   svcerr.ErrViewEntity,
+			resp:     domains.Archive{},
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("ExportDomain", validCtx, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.ExportDomain(validCtx, tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestImportDomain(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain:  validDomain,
+	}
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		archive     domains.Archive
+		preserveID  bool
+		svcRes      domains.Domain
+		svcRoleRes  []roles.RoleProvision
+		svcErr      error
+		resp        domains.Domain
+		respRoleRes []roles.RoleProvision
+		err         error
+	}{
+		{
+			desc:        "publish successfully",
+			session:     validSession,
+			archive:     archive,
+			svcRes:      validDomain,
+			svcRoleRes:  []roles.RoleProvision{},
+			svcErr:      nil,
+			resp:        validDomain,
+			respRoleRes: []roles.RoleProvision{},
+			err:         nil,
+		},
+		{
+			desc:        "publish successfully with preserved id",
+			session:     validSession,
+			archive:     archive,
+			preserveID:  true,
+			svcRes:      validDomain,
+			svcRoleRes:  []roles.RoleProvision{},
+			svcErr:      nil,
+			resp:        validDomain,
+			respRoleRes: []roles.RoleProvision{},
+			err:         nil,
+		},
+		{
+			desc:        "failed to publish with service error",
+			session:     validSession,
+			archive:     archive,
+			svcRes:      domains.Domain{},
+			svcRoleRes:  []roles.RoleProvision{},
+			svcErr:      svcerr.ErrCreateEntity,
+			resp:        domains.Domain{},
+			respRoleRes: []roles.RoleProvision{},
+			err:         svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("ImportDomain", validCtx, tc.session, tc.archive, tc.preserveID).Return(tc.svcRes, tc.svcRoleRes, tc.svcErr)
+			resp, respRoleRes, err := nsvc.ImportDomain(validCtx, tc.session, tc.archive, tc.preserveID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			assert.Equal(t, tc.respRoleRes, respRoleRes, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.respRoleRes, respRoleRes))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestRetrieveUsage(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	usage := domains.QuotasUsage{
+		DomainID: validDomain.ID,
+		Quotas:   domains.Quotas{Clients: 10, Channels: 20},
+		Usage:    domains.Usage{Clients: 1, Channels: 2},
+	}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		svcRes   domains.QuotasUsage
+		svcErr   error
+		resp     domains.QuotasUsage
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   usage,
+			svcErr:   nil,
+			resp:     usage,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   domains.QuotasUsage{},
+			svcErr:   svcerr.ErrViewEntity,
+			resp:     domains.QuotasUsage{},
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("RetrieveUsage", validCtx, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.RetrieveUsage(validCtx, tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestUpdateQuotas(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		quotas   domains.Quotas
+		svcRes   domains.Quotas
+		svcErr   error
+		resp     domains.Quotas
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			quotas:   quotas,
+			svcRes:   quotas,
+			svcErr:   nil,
+			resp:     quotas,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			quotas:   quotas,
+			svcRes:   domains.Quotas{},
+			svcErr:   svcerr.ErrUpdateEntity,
+			resp:     domains.Quotas{},
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("UpdateQuotas", validCtx, tc.session, tc.domainID, tc.quotas).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.UpdateQuotas(validCtx, tc.session, tc.domainID, tc.quotas)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	memberID := testsutil.GenerateUUID(t)
+	transfer := domains.Transfer{
+		DomainID:   validDomain.ID,
+		FromUserID: validSession.UserID,
+		ToUserID:   memberID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		userID   string
+		svcRes   domains.Transfer
+		svcErr   error
+		resp     domains.Transfer
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			userID:   memberID,
+			svcRes:   transfer,
+			svcErr:   nil,
+			resp:     transfer,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			userID:   memberID,
+			svcRes:   domains.Transfer{},
+			svcErr:   svcerr.ErrInvalidOwnershipTransfer,
+			resp:     domains.Transfer{},
+			err:      svcerr.ErrInvalidOwnershipTransfer,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("TransferOwnership", validCtx, tc.session, tc.domainID, tc.userID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.TransferOwnership(validCtx, tc.session, tc.domainID, tc.userID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestAcceptTransfer(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	transfer := domains.Transfer{
+		DomainID:   validDomain.ID,
+		FromUserID: testsutil.GenerateUUID(t),
+		ToUserID:   validSession.UserID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		domainID string
+		svcRes   domains.Transfer
+		svcErr   error
+		resp     domains.Transfer
+		err      error
+	}{
+		{
+			desc:     "publish successfully",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   transfer,
+			svcErr:   nil,
+			resp:     transfer,
+			err:      nil,
+		},
+		{
+			desc:     "failed to publish with service error",
+			session:  validSession,
+			domainID: validDomain.ID,
+			svcRes:   domains.Transfer{},
+			svcErr:   svcerr.ErrAuthorization,
+			resp:     domains.Transfer{},
+			err:      svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("AcceptTransfer", validCtx, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.AcceptTransfer(validCtx, tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
 func TestListDomains(t *testing.T) {
 	svc, nsvc := newEventStoreMiddleware(t)
 
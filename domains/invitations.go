@@ -4,14 +4,20 @@
 package domains
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"time"
 )
 
 // Invitation is an invitation to join a domain.
+//
+// The invitation sent to the email of the unregistered user has the generated
+// invitee user ID until the user registers and the invitation is claimed.
 type Invitation struct {
 	InvitedBy     string    `json:"invited_by"`
 	InviteeUserID string    `json:"invitee_user_id"`
+	InviteeEmail  string    `json:"invitee_email,omitempty"`
 	DomainID      string    `json:"domain_id"`
 	DomainName    string    `json:"domain_name,omitempty"`
 	RoleID        string    `json:"role_id,omitempty"`
@@ -21,7 +27,18 @@ type Invitation struct {
 	UpdatedAt     time.Time `json:"updated_at,omitempty"`
 	ConfirmedAt   time.Time `json:"confirmed_at,omitempty"`
 	RejectedAt    time.Time `json:"rejected_at,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at,omitzero"`
+	ResendCount   uint64    `json:"resend_count,omitempty"`
 	Resend        bool      `json:"resend,omitempty"`
+	// TokenHash is the hash of the invitation token sent to the email.
+	TokenHash string `json:"-"`
+}
+
+// HashInvitationToken returns the hash of the invitation token
+// under which the token is stored.
+func HashInvitationToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
 }
 
 // InvitationPage is a page of invitations.
@@ -116,6 +116,34 @@ func (am *authorizationMiddleware) DisableDomain(ctx context.Context, session au
 	return am.svc.DisableDomain(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	if err := am.authorize(ctx, policies.DomainType, operations.OpDeleteDomain, authz.PolicyReq{
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      id,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return domains.Domain{}, err
+	}
+
+	return am.svc.DeleteDomain(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	if err := am.authorize(ctx, policies.DomainType, operations.OpRestoreDomain, authz.PolicyReq{
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      id,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return domains.Domain{}, err
+	}
+
+	return am.svc.RestoreDomain(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) FreezeDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
 	// Only SuperAdmin can freeze the domain
 	if err := am.authz.Authorize(ctx, authz.PolicyReq{
@@ -132,6 +160,75 @@ func (am *authorizationMiddleware) FreezeDomain(ctx context.Context, session aut
 	return am.svc.FreezeDomain(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	if err := am.authorize(ctx, policies.DomainType, operations.OpExportDomain, authz.PolicyReq{
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      id,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return domains.Archive{}, err
+	}
+
+	return am.svc.ExportDomain(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	return am.svc.ImportDomain(ctx, session, archive, preserveID)
+}
+
+func (am *authorizationMiddleware) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	if err := am.authorize(ctx, policies.DomainType, operations.OpRetrieveDomainUsage, authz.PolicyReq{
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      id,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return domains.QuotasUsage{}, err
+	}
+
+	return am.svc.RetrieveUsage(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	// Only SuperAdmin can update the domain quotas
+	if err := am.checkSuperAdmin(ctx, session); err != nil {
+		return domains.Quotas{}, err
+	}
+
+	return am.svc.UpdateQuotas(ctx, session, id, q)
+}
+
+func (am *authorizationMiddleware) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (domains.Transfer, error) {
+	if err := am.authorize(ctx, policies.DomainType, operations.OpTransferDomainOwnership, authz.PolicyReq{
+		Subject:     session.DomainUserID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Object:      id,
+		ObjectType:  policies.DomainType,
+	}); err != nil {
+		return domains.Transfer{}, err
+	}
+
+	return am.svc.TransferOwnership(ctx, session, id, userID)
+}
+
+// Only the users taking part in the transfer can retrieve, accept and reject it,
+// which is checked by the service.
+func (am *authorizationMiddleware) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	return am.svc.RetrieveTransfer(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	return am.svc.AcceptTransfer(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	return am.svc.RejectTransfer(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (domains.DomainsPage, error) {
 	if err := am.checkSuperAdmin(ctx, session); err == nil {
 		session.SuperAdmin = true
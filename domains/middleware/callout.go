@@ -118,6 +118,129 @@ func (cm *calloutMiddleware) FreezeDomain(ctx context.Context, session authn.Ses
 	return cm.svc.FreezeDomain(ctx, session, id)
 }
 
+func (cm *calloutMiddleware) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpDeleteDomain, params); err != nil {
+		return domains.Domain{}, err
+	}
+
+	return cm.svc.DeleteDomain(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpRestoreDomain, params); err != nil {
+		return domains.Domain{}, err
+	}
+
+	return cm.svc.RestoreDomain(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpExportDomain, params); err != nil {
+		return domains.Archive{}, err
+	}
+
+	return cm.svc.ExportDomain(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	params := map[string]any{
+		"entity_id":   archive.Domain.ID,
+		"preserve_id": preserveID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpImportDomain, params); err != nil {
+		return domains.Domain{}, nil, err
+	}
+
+	return cm.svc.ImportDomain(ctx, session, archive, preserveID)
+}
+
+func (cm *calloutMiddleware) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpRetrieveDomainUsage, params); err != nil {
+		return domains.QuotasUsage{}, err
+	}
+
+	return cm.svc.RetrieveUsage(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	params := map[string]any{
+		"entity_id": id,
+		"quotas":    q,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpUpdateDomainQuotas, params); err != nil {
+		return domains.Quotas{}, err
+	}
+
+	return cm.svc.UpdateQuotas(ctx, session, id, q)
+}
+
+func (cm *calloutMiddleware) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (domains.Transfer, error) {
+	params := map[string]any{
+		"entity_id": id,
+		"user_id":   userID,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpTransferDomainOwnership, params); err != nil {
+		return domains.Transfer{}, err
+	}
+
+	return cm.svc.TransferOwnership(ctx, session, id, userID)
+}
+
+func (cm *calloutMiddleware) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpRetrieveDomainTransfer, params); err != nil {
+		return domains.Transfer{}, err
+	}
+
+	return cm.svc.RetrieveTransfer(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpAcceptDomainTransfer, params); err != nil {
+		return domains.Transfer{}, err
+	}
+
+	return cm.svc.AcceptTransfer(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, policies.DomainType, operations.OpRejectDomainTransfer, params); err != nil {
+		return err
+	}
+
+	return cm.svc.RejectTransfer(ctx, session, id)
+}
+
 func (cm *calloutMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (domains.DomainsPage, error) {
 	params := map[string]any{
 		"page": page,
@@ -154,6 +154,196 @@ func (lm *loggingMiddleware) FreezeDomain(ctx context.Context, session authn.Ses
 	return lm.svc.FreezeDomain(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) DeleteDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("domain",
+				slog.String("id", id),
+				slog.String("name", do.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Delete domain failed", args...)
+			return
+		}
+		lm.logger.Info("Delete domain completed successfully", args...)
+	}(time.Now())
+	return lm.svc.DeleteDomain(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) RestoreDomain(ctx context.Context, session authn.Session, id string) (do domains.Domain, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("domain",
+				slog.String("id", id),
+				slog.String("name", do.Name),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Restore domain failed", args...)
+			return
+		}
+		lm.logger.Info("Restore domain completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RestoreDomain(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ExportDomain(ctx context.Context, session authn.Session, id string) (a domains.Archive, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Export domain failed", args...)
+			return
+		}
+		lm.logger.Info("Export domain completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ExportDomain(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (do domains.Domain, rps []roles.RoleProvision, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("domain",
+				slog.String("id", do.ID),
+				slog.String("name", archive.Domain.Name),
+				slog.String("route", archive.Domain.Route),
+			),
+			slog.Bool("preserve_id", preserveID),
+		}
+		if err != nil {
+			args := append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Import domain failed", args...)
+			return
+		}
+		lm.logger.Info("Import domain completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ImportDomain(ctx, session, archive, preserveID)
+}
+
+func (lm *loggingMiddleware) RetrieveUsage(ctx context.Context, session authn.Session, id string) (qu domains.QuotasUsage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve domain usage failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve domain usage completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RetrieveUsage(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (uq domains.Quotas, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+			slog.Group("quotas",
+				slog.Uint64("clients", q.Clients),
+				slog.Uint64("channels", q.Channels),
+				slog.Uint64("groups", q.Groups),
+				slog.Uint64("invitations", q.Invitations),
+				slog.Uint64("message_rate", q.MessageRate),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Update domain quotas failed", args...)
+			return
+		}
+		lm.logger.Info("Update domain quotas completed successfully", args...)
+	}(time.Now())
+	return lm.svc.UpdateQuotas(ctx, session, id, q)
+}
+
+func (lm *loggingMiddleware) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (t domains.Transfer, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+			slog.String("user_id", userID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Transfer domain ownership failed", args...)
+			return
+		}
+		lm.logger.Info("Transfer domain ownership completed successfully", args...)
+	}(time.Now())
+	return lm.svc.TransferOwnership(ctx, session, id, userID)
+}
+
+func (lm *loggingMiddleware) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (t domains.Transfer, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve domain transfer failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve domain transfer completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RetrieveTransfer(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) AcceptTransfer(ctx context.Context, session authn.Session, id string) (t domains.Transfer, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+			slog.String("from_user_id", t.FromUserID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Accept domain transfer failed", args...)
+			return
+		}
+		lm.logger.Info("Accept domain transfer completed successfully", args...)
+	}(time.Now())
+	return lm.svc.AcceptTransfer(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) RejectTransfer(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Reject domain transfer failed", args...)
+			return
+		}
+		lm.logger.Info("Reject domain transfer completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RejectTransfer(ctx, session, id)
+}
+
 func (lm *loggingMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (do domains.DomainsPage, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -85,6 +85,86 @@ func (ms *metricsMiddleware) FreezeDomain(ctx context.Context, session authn.Ses
 	return ms.svc.FreezeDomain(ctx, session, id)
 }
 
+func (ms *metricsMiddleware) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_domain").Add(1)
+		ms.latency.With("method", "delete_domain").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.DeleteDomain(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "restore_domain").Add(1)
+		ms.latency.With("method", "restore_domain").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RestoreDomain(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "export_domain").Add(1)
+		ms.latency.With("method", "export_domain").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ExportDomain(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "import_domain").Add(1)
+		ms.latency.With("method", "import_domain").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ImportDomain(ctx, session, archive, preserveID)
+}
+
+func (ms *metricsMiddleware) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "retrieve_domain_usage").Add(1)
+		ms.latency.With("method", "retrieve_domain_usage").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RetrieveUsage(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_domain_quotas").Add(1)
+		ms.latency.With("method", "update_domain_quotas").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.UpdateQuotas(ctx, session, id, q)
+}
+
+func (ms *metricsMiddleware) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (domains.Transfer, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "transfer_domain_ownership").Add(1)
+		ms.latency.With("method", "transfer_domain_ownership").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.TransferOwnership(ctx, session, id, userID)
+}
+
+func (ms *metricsMiddleware) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "retrieve_domain_transfer").Add(1)
+		ms.latency.With("method", "retrieve_domain_transfer").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RetrieveTransfer(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "accept_domain_transfer").Add(1)
+		ms.latency.With("method", "accept_domain_transfer").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.AcceptTransfer(ctx, session, id)
+}
+
+func (ms *metricsMiddleware) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "reject_domain_transfer").Add(1)
+		ms.latency.With("method", "reject_domain_transfer").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RejectTransfer(ctx, session, id)
+}
+
 func (ms *metricsMiddleware) ListDomains(ctx context.Context, session authn.Session, page domains.Page) (domains.DomainsPage, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_domains").Add(1)
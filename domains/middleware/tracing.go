@@ -77,6 +77,88 @@ func (tm *tracingMiddleware) FreezeDomain(ctx context.Context, session authn.Ses
 	return tm.svc.FreezeDomain(ctx, session, id)
 }
 
+func (tm *tracingMiddleware) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "delete_domain", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.DeleteDomain(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "restore_domain", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.RestoreDomain(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "export_domain", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.ExportDomain(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "import_domain", trace.WithAttributes(
+		attribute.String("name", archive.Domain.Name),
+		attribute.Bool("preserve_id", preserveID),
+	))
+	defer span.End()
+	return tm.svc.ImportDomain(ctx, session, archive, preserveID)
+}
+
+func (tm *tracingMiddleware) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "retrieve_domain_usage", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.RetrieveUsage(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "update_domain_quotas", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.UpdateQuotas(ctx, session, id, q)
+}
+
+func (tm *tracingMiddleware) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (domains.Transfer, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "transfer_domain_ownership", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("user_id", userID),
+	))
+	defer span.End()
+	return tm.svc.TransferOwnership(ctx, session, id, userID)
+}
+
+func (tm *tracingMiddleware) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "retrieve_domain_transfer", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.RetrieveTransfer(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "accept_domain_transfer", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.AcceptTransfer(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "reject_domain_transfer", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+	return tm.svc.RejectTransfer(ctx, session, id)
+}
+
 func (tm *tracingMiddleware) ListDomains(ctx context.Context, session authn.Session, p domains.Page) (domains.DomainsPage, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "list_domains")
 	defer span.End()
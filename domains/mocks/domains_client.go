@@ -44,6 +44,89 @@ func (_m *DomainsServiceClient) EXPECT() *DomainsServiceClient_Expecter {
 	return &DomainsServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// ClaimInvitations provides a mock function for the type DomainsServiceClient
+func (_mock *DomainsServiceClient) ClaimInvitations(ctx context.Context, in *v1.ClaimInvitationsReq, opts ...grpc.CallOption) (*v1.ClaimInvitationsRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimInvitations")
+	}
+
+	var r0 *v1.ClaimInvitationsRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ClaimInvitationsReq, ...grpc.CallOption) (*v1.ClaimInvitationsRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ClaimInvitationsReq, ...grpc.CallOption) *v1.ClaimInvitationsRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ClaimInvitationsRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ClaimInvitationsReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DomainsServiceClient_ClaimInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimInvitations'
+type DomainsServiceClient_ClaimInvitations_Call struct {
+	*mock.Call
+}
+
+// ClaimInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ClaimInvitationsReq
+//   - opts ...grpc.CallOption
+func (_e *DomainsServiceClient_Expecter) ClaimInvitations(ctx interface{}, in interface{}, opts ...interface{}) *DomainsServiceClient_ClaimInvitations_Call {
+	return &DomainsServiceClient_ClaimInvitations_Call{Call: _e.mock.On("ClaimInvitations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *DomainsServiceClient_ClaimInvitations_Call) Run(run func(ctx context.Context, in *v1.ClaimInvitationsReq, opts ...grpc.CallOption)) *DomainsServiceClient_ClaimInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ClaimInvitationsReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ClaimInvitationsReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *DomainsServiceClient_ClaimInvitations_Call) Return(claimInvitationsRes *v1.ClaimInvitationsRes, err error) *DomainsServiceClient_ClaimInvitations_Call {
+	_c.Call.Return(claimInvitationsRes, err)
+	return _c
+}
+
+func (_c *DomainsServiceClient_ClaimInvitations_Call) RunAndReturn(run func(ctx context.Context, in *v1.ClaimInvitationsReq, opts ...grpc.CallOption) (*v1.ClaimInvitationsRes, error)) *DomainsServiceClient_ClaimInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserFromDomains provides a mock function for the type DomainsServiceClient
 func (_mock *DomainsServiceClient) DeleteUserFromDomains(ctx context.Context, in *v1.DeleteUserReq, opts ...grpc.CallOption) (*v1.DeleteUserRes, error) {
 	var tmpRet mock.Arguments
@@ -210,6 +293,89 @@ func (_c *DomainsServiceClient_RetrieveIDByRoute_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// RetrieveQuotas provides a mock function for the type DomainsServiceClient
+func (_mock *DomainsServiceClient) RetrieveQuotas(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveQuotasRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveQuotas")
+	}
+
+	var r0 *v1.RetrieveQuotasRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) (*v1.RetrieveQuotasRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) *v1.RetrieveQuotasRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RetrieveQuotasRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DomainsServiceClient_RetrieveQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveQuotas'
+type DomainsServiceClient_RetrieveQuotas_Call struct {
+	*mock.Call
+}
+
+// RetrieveQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v10.RetrieveEntityReq
+//   - opts ...grpc.CallOption
+func (_e *DomainsServiceClient_Expecter) RetrieveQuotas(ctx interface{}, in interface{}, opts ...interface{}) *DomainsServiceClient_RetrieveQuotas_Call {
+	return &DomainsServiceClient_RetrieveQuotas_Call{Call: _e.mock.On("RetrieveQuotas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *DomainsServiceClient_RetrieveQuotas_Call) Run(run func(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption)) *DomainsServiceClient_RetrieveQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v10.RetrieveEntityReq
+		if args[1] != nil {
+			arg1 = args[1].(*v10.RetrieveEntityReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *DomainsServiceClient_RetrieveQuotas_Call) Return(retrieveQuotasRes *v1.RetrieveQuotasRes, err error) *DomainsServiceClient_RetrieveQuotas_Call {
+	_c.Call.Return(retrieveQuotasRes, err)
+	return _c
+}
+
+func (_c *DomainsServiceClient_RetrieveQuotas_Call) RunAndReturn(run func(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveQuotasRes, error)) *DomainsServiceClient_RetrieveQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveStatus provides a mock function for the type DomainsServiceClient
 func (_mock *DomainsServiceClient) RetrieveStatus(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption) (*v10.RetrieveEntityRes, error) {
 	var tmpRet mock.Arguments
@@ -292,3 +458,169 @@ func (_c *DomainsServiceClient_RetrieveStatus_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetUsage provides a mock function for the type DomainsServiceClient
+func (_mock *DomainsServiceClient) SetUsage(ctx context.Context, in *v1.SetUsageReq, opts ...grpc.CallOption) (*v1.SetUsageRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUsage")
+	}
+
+	var r0 *v1.SetUsageRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.SetUsageReq, ...grpc.CallOption) (*v1.SetUsageRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.SetUsageReq, ...grpc.CallOption) *v1.SetUsageRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.SetUsageRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.SetUsageReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DomainsServiceClient_SetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUsage'
+type DomainsServiceClient_SetUsage_Call struct {
+	*mock.Call
+}
+
+// SetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.SetUsageReq
+//   - opts ...grpc.CallOption
+func (_e *DomainsServiceClient_Expecter) SetUsage(ctx interface{}, in interface{}, opts ...interface{}) *DomainsServiceClient_SetUsage_Call {
+	return &DomainsServiceClient_SetUsage_Call{Call: _e.mock.On("SetUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *DomainsServiceClient_SetUsage_Call) Run(run func(ctx context.Context, in *v1.SetUsageReq, opts ...grpc.CallOption)) *DomainsServiceClient_SetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.SetUsageReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.SetUsageReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *DomainsServiceClient_SetUsage_Call) Return(setUsageRes *v1.SetUsageRes, err error) *DomainsServiceClient_SetUsage_Call {
+	_c.Call.Return(setUsageRes, err)
+	return _c
+}
+
+func (_c *DomainsServiceClient_SetUsage_Call) RunAndReturn(run func(ctx context.Context, in *v1.SetUsageReq, opts ...grpc.CallOption) (*v1.SetUsageRes, error)) *DomainsServiceClient_SetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUsage provides a mock function for the type DomainsServiceClient
+func (_mock *DomainsServiceClient) UpdateUsage(ctx context.Context, in *v1.UpdateUsageReq, opts ...grpc.CallOption) (*v1.UpdateUsageRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsage")
+	}
+
+	var r0 *v1.UpdateUsageRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.UpdateUsageReq, ...grpc.CallOption) (*v1.UpdateUsageRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.UpdateUsageReq, ...grpc.CallOption) *v1.UpdateUsageRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.UpdateUsageRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.UpdateUsageReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DomainsServiceClient_UpdateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsage'
+type DomainsServiceClient_UpdateUsage_Call struct {
+	*mock.Call
+}
+
+// UpdateUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.UpdateUsageReq
+//   - opts ...grpc.CallOption
+func (_e *DomainsServiceClient_Expecter) UpdateUsage(ctx interface{}, in interface{}, opts ...interface{}) *DomainsServiceClient_UpdateUsage_Call {
+	return &DomainsServiceClient_UpdateUsage_Call{Call: _e.mock.On("UpdateUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *DomainsServiceClient_UpdateUsage_Call) Run(run func(ctx context.Context, in *v1.UpdateUsageReq, opts ...grpc.CallOption)) *DomainsServiceClient_UpdateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.UpdateUsageReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.UpdateUsageReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *DomainsServiceClient_UpdateUsage_Call) Return(updateUsageRes *v1.UpdateUsageRes, err error) *DomainsServiceClient_UpdateUsage_Call {
+	_c.Call.Return(updateUsageRes, err)
+	return _c
+}
+
+func (_c *DomainsServiceClient_UpdateUsage_Call) RunAndReturn(run func(ctx context.Context, in *v1.UpdateUsageReq, opts ...grpc.CallOption) (*v1.UpdateUsageRes, error)) *DomainsServiceClient_UpdateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewEmailer creates a new instance of Emailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Emailer {
+	mock := &Emailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Emailer is an autogenerated mock type for the Emailer type
+type Emailer struct {
+	mock.Mock
+}
+
+type Emailer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Emailer) EXPECT() *Emailer_Expecter {
+	return &Emailer_Expecter{mock: &_m.Mock}
+}
+
+// SendInvitation provides a mock function for the type Emailer
+func (_mock *Emailer) SendInvitation(to []string, domainName string, roleName string, token string) error {
+	ret := _mock.Called(to, domainName, roleName, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]string, string, string, string) error); ok {
+		r0 = returnFunc(to, domainName, roleName, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Emailer_SendInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInvitation'
+type Emailer_SendInvitation_Call struct {
+	*mock.Call
+}
+
+// SendInvitation is a helper method to define mock.On call
+//   - to []string
+//   - domainName string
+//   - roleName string
+//   - token string
+func (_e *Emailer_Expecter) SendInvitation(to interface{}, domainName interface{}, roleName interface{}, token interface{}) *Emailer_SendInvitation_Call {
+	return &Emailer_SendInvitation_Call{Call: _e.mock.On("SendInvitation", to, domainName, roleName, token)}
+}
+
+func (_c *Emailer_SendInvitation_Call) Run(run func(to []string, domainName string, roleName string, token string)) *Emailer_SendInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Emailer_SendInvitation_Call) Return(err error) *Emailer_SendInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Emailer_SendInvitation_Call) RunAndReturn(run func(to []string, domainName string, roleName string, token string) error) *Emailer_SendInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
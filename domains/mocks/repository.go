@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/pkg/roles"
@@ -111,6 +112,138 @@ func (_c *Repository_AddRoles_Call) RunAndReturn(run func(ctx context.Context, r
 	return _c
 }
 
+// ClaimInvitation provides a mock function for the type Repository
+func (_mock *Repository) ClaimInvitation(ctx context.Context, pendingID string, invitation domains.Invitation) error {
+	ret := _mock.Called(ctx, pendingID, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Invitation) error); ok {
+		r0 = returnFunc(ctx, pendingID, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_ClaimInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimInvitation'
+type Repository_ClaimInvitation_Call struct {
+	*mock.Call
+}
+
+// ClaimInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingID string
+//   - invitation domains.Invitation
+func (_e *Repository_Expecter) ClaimInvitation(ctx interface{}, pendingID interface{}, invitation interface{}) *Repository_ClaimInvitation_Call {
+	return &Repository_ClaimInvitation_Call{Call: _e.mock.On("ClaimInvitation", ctx, pendingID, invitation)}
+}
+
+func (_c *Repository_ClaimInvitation_Call) Run(run func(ctx context.Context, pendingID string, invitation domains.Invitation)) *Repository_ClaimInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Invitation
+		if args[2] != nil {
+			arg2 = args[2].(domains.Invitation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ClaimInvitation_Call) Return(err error) *Repository_ClaimInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_ClaimInvitation_Call) RunAndReturn(run func(ctx context.Context, pendingID string, invitation domains.Invitation) error) *Repository_ClaimInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteTransfer provides a mock function for the type Repository
+func (_mock *Repository) CompleteTransfer(ctx context.Context, t domains.Transfer, adminRole roles.Role, memberRole roles.Role) error {
+	ret := _mock.Called(ctx, t, adminRole, memberRole)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteTransfer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domains.Transfer, roles.Role, roles.Role) error); ok {
+		r0 = returnFunc(ctx, t, adminRole, memberRole)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_CompleteTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTransfer'
+type Repository_CompleteTransfer_Call struct {
+	*mock.Call
+}
+
+// CompleteTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t domains.Transfer
+//   - adminRole roles.Role
+//   - memberRole roles.Role
+func (_e *Repository_Expecter) CompleteTransfer(ctx interface{}, t interface{}, adminRole interface{}, memberRole interface{}) *Repository_CompleteTransfer_Call {
+	return &Repository_CompleteTransfer_Call{Call: _e.mock.On("CompleteTransfer", ctx, t, adminRole, memberRole)}
+}
+
+func (_c *Repository_CompleteTransfer_Call) Run(run func(ctx context.Context, t domains.Transfer, adminRole roles.Role, memberRole roles.Role)) *Repository_CompleteTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domains.Transfer
+		if args[1] != nil {
+			arg1 = args[1].(domains.Transfer)
+		}
+		var arg2 roles.Role
+		if args[2] != nil {
+			arg2 = args[2].(roles.Role)
+		}
+		var arg3 roles.Role
+		if args[3] != nil {
+			arg3 = args[3].(roles.Role)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CompleteTransfer_Call) Return(err error) *Repository_CompleteTransfer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_CompleteTransfer_Call) RunAndReturn(run func(ctx context.Context, t domains.Transfer, adminRole roles.Role, memberRole roles.Role) error) *Repository_CompleteTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteDomain provides a mock function for the type Repository
 func (_mock *Repository) DeleteDomain(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
@@ -168,6 +301,63 @@ func (_c *Repository_DeleteDomain_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// DeleteTransfer provides a mock function for the type Repository
+func (_mock *Repository) DeleteTransfer(ctx context.Context, domainID string) error {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransfer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_DeleteTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransfer'
+type Repository_DeleteTransfer_Call struct {
+	*mock.Call
+}
+
+// DeleteTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Repository_Expecter) DeleteTransfer(ctx interface{}, domainID interface{}) *Repository_DeleteTransfer_Call {
+	return &Repository_DeleteTransfer_Call{Call: _e.mock.On("DeleteTransfer", ctx, domainID)}
+}
+
+func (_c *Repository_DeleteTransfer_Call) Run(run func(ctx context.Context, domainID string)) *Repository_DeleteTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteTransfer_Call) Return(err error) *Repository_DeleteTransfer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_DeleteTransfer_Call) RunAndReturn(run func(ctx context.Context, domainID string) error) *Repository_DeleteTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUsersInvitations provides a mock function for the type Repository
 func (_mock *Repository) DeleteUsersInvitations(ctx context.Context, domainID string, userID ...string) error {
 	var tmpRet mock.Arguments
@@ -378,6 +568,84 @@ func (_c *Repository_ListEntityMembers_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// MarkDomainDeleted provides a mock function for the type Repository
+func (_mock *Repository) MarkDomainDeleted(ctx context.Context, id string, deletedBy string, deletedAt time.Time) (domains.Domain, error) {
+	ret := _mock.Called(ctx, id, deletedBy, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDomainDeleted")
+	}
+
+	var r0 domains.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (domains.Domain, error)); ok {
+		return returnFunc(ctx, id, deletedBy, deletedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) domains.Domain); ok {
+		r0 = returnFunc(ctx, id, deletedBy, deletedAt)
+	} else {
+		r0 = ret.Get(0).(domains.Domain)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, deletedBy, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_MarkDomainDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDomainDeleted'
+type Repository_MarkDomainDeleted_Call struct {
+	*mock.Call
+}
+
+// MarkDomainDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - deletedBy string
+//   - deletedAt time.Time
+func (_e *Repository_Expecter) MarkDomainDeleted(ctx interface{}, id interface{}, deletedBy interface{}, deletedAt interface{}) *Repository_MarkDomainDeleted_Call {
+	return &Repository_MarkDomainDeleted_Call{Call: _e.mock.On("MarkDomainDeleted", ctx, id, deletedBy, deletedAt)}
+}
+
+func (_c *Repository_MarkDomainDeleted_Call) Run(run func(ctx context.Context, id string, deletedBy string, deletedAt time.Time)) *Repository_MarkDomainDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_MarkDomainDeleted_Call) Return(domain domains.Domain, err error) *Repository_MarkDomainDeleted_Call {
+	_c.Call.Return(domain, err)
+	return _c
+}
+
+func (_c *Repository_MarkDomainDeleted_Call) RunAndReturn(run func(ctx context.Context, id string, deletedBy string, deletedAt time.Time) (domains.Domain, error)) *Repository_MarkDomainDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveEntityMembers provides a mock function for the type Repository
 func (_mock *Repository) RemoveEntityMembers(ctx context.Context, entityID string, members []string) error {
 	ret := _mock.Called(ctx, entityID, members)
@@ -498,6 +766,98 @@ func (_c *Repository_RemoveMemberFromAllRoles_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// RemoveRoleMembers provides a mock function for the type Repository
+func (_mock *Repository) RemoveRoleMembers(ctx context.Context, domainID string, roleID string, members []string, builtInRoles []string, updatedBy string) ([]roles.EntityMemberRole, error) {
+	ret := _mock.Called(ctx, domainID, roleID, members, builtInRoles, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRoleMembers")
+	}
+
+	var r0 []roles.EntityMemberRole
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string, []string, string) ([]roles.EntityMemberRole, error)); ok {
+		return returnFunc(ctx, domainID, roleID, members, builtInRoles, updatedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string, []string, string) []roles.EntityMemberRole); ok {
+		r0 = returnFunc(ctx, domainID, roleID, members, builtInRoles, updatedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]roles.EntityMemberRole)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string, []string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, roleID, members, builtInRoles, updatedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RemoveRoleMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRoleMembers'
+type Repository_RemoveRoleMembers_Call struct {
+	*mock.Call
+}
+
+// RemoveRoleMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - roleID string
+//   - members []string
+//   - builtInRoles []string
+//   - updatedBy string
+func (_e *Repository_Expecter) RemoveRoleMembers(ctx interface{}, domainID interface{}, roleID interface{}, members interface{}, builtInRoles interface{}, updatedBy interface{}) *Repository_RemoveRoleMembers_Call {
+	return &Repository_RemoveRoleMembers_Call{Call: _e.mock.On("RemoveRoleMembers", ctx, domainID, roleID, members, builtInRoles, updatedBy)}
+}
+
+func (_c *Repository_RemoveRoleMembers_Call) Run(run func(ctx context.Context, domainID string, roleID string, members []string, builtInRoles []string, updatedBy string)) *Repository_RemoveRoleMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveRoleMembers_Call) Return(entityMemberRoles []roles.EntityMemberRole, err error) *Repository_RemoveRoleMembers_Call {
+	_c.Call.Return(entityMemberRoles, err)
+	return _c
+}
+
+func (_c *Repository_RemoveRoleMembers_Call) RunAndReturn(run func(ctx context.Context, domainID string, roleID string, members []string, builtInRoles []string, updatedBy string) ([]roles.EntityMemberRole, error)) *Repository_RemoveRoleMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveRoles provides a mock function for the type Repository
 func (_mock *Repository) RemoveRoles(ctx context.Context, roleIDs []string) error {
 	ret := _mock.Called(ctx, roleIDs)
@@ -969,15 +1329,87 @@ func (_c *Repository_RetrieveDomainByRoute_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
-// RetrieveEntitiesRolesActionsMembers provides a mock function for the type Repository
-func (_mock *Repository) RetrieveEntitiesRolesActionsMembers(ctx context.Context, entityIDs []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error) {
-	ret := _mock.Called(ctx, entityIDs)
+// RetrieveEmailInvitation provides a mock function for the type Repository
+func (_mock *Repository) RetrieveEmailInvitation(ctx context.Context, domainID string, email string) (domains.Invitation, error) {
+	ret := _mock.Called(ctx, domainID, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RetrieveEntitiesRolesActionsMembers")
+		panic("no return value specified for RetrieveEmailInvitation")
 	}
 
-	var r0 []roles.EntityActionRole
+	var r0 domains.Invitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (domains.Invitation, error)); ok {
+		return returnFunc(ctx, domainID, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) domains.Invitation); ok {
+		r0 = returnFunc(ctx, domainID, email)
+	} else {
+		r0 = ret.Get(0).(domains.Invitation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveEmailInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveEmailInvitation'
+type Repository_RetrieveEmailInvitation_Call struct {
+	*mock.Call
+}
+
+// RetrieveEmailInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - email string
+func (_e *Repository_Expecter) RetrieveEmailInvitation(ctx interface{}, domainID interface{}, email interface{}) *Repository_RetrieveEmailInvitation_Call {
+	return &Repository_RetrieveEmailInvitation_Call{Call: _e.mock.On("RetrieveEmailInvitation", ctx, domainID, email)}
+}
+
+func (_c *Repository_RetrieveEmailInvitation_Call) Run(run func(ctx context.Context, domainID string, email string)) *Repository_RetrieveEmailInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveEmailInvitation_Call) Return(invitation domains.Invitation, err error) *Repository_RetrieveEmailInvitation_Call {
+	_c.Call.Return(invitation, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveEmailInvitation_Call) RunAndReturn(run func(ctx context.Context, domainID string, email string) (domains.Invitation, error)) *Repository_RetrieveEmailInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveEntitiesRolesActionsMembers provides a mock function for the type Repository
+func (_mock *Repository) RetrieveEntitiesRolesActionsMembers(ctx context.Context, entityIDs []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error) {
+	ret := _mock.Called(ctx, entityIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveEntitiesRolesActionsMembers")
+	}
+
+	var r0 []roles.EntityActionRole
 	var r1 []roles.EntityMemberRole
 	var r2 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]roles.EntityActionRole, []roles.EntityMemberRole, error)); ok {
@@ -1189,6 +1621,212 @@ func (_c *Repository_RetrieveInvitation_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// RetrieveMemberRole provides a mock function for the type Repository
+func (_mock *Repository) RetrieveMemberRole(ctx context.Context, domainID string, memberID string) (roles.Role, error) {
+	ret := _mock.Called(ctx, domainID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveMemberRole")
+	}
+
+	var r0 roles.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (roles.Role, error)); ok {
+		return returnFunc(ctx, domainID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) roles.Role); ok {
+		r0 = returnFunc(ctx, domainID, memberID)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveMemberRole'
+type Repository_RetrieveMemberRole_Call struct {
+	*mock.Call
+}
+
+// RetrieveMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - memberID string
+func (_e *Repository_Expecter) RetrieveMemberRole(ctx interface{}, domainID interface{}, memberID interface{}) *Repository_RetrieveMemberRole_Call {
+	return &Repository_RetrieveMemberRole_Call{Call: _e.mock.On("RetrieveMemberRole", ctx, domainID, memberID)}
+}
+
+func (_c *Repository_RetrieveMemberRole_Call) Run(run func(ctx context.Context, domainID string, memberID string)) *Repository_RetrieveMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveMemberRole_Call) Return(role roles.Role, err error) *Repository_RetrieveMemberRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveMemberRole_Call) RunAndReturn(run func(ctx context.Context, domainID string, memberID string) (roles.Role, error)) *Repository_RetrieveMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrievePendingEmailInvitations provides a mock function for the type Repository
+func (_mock *Repository) RetrievePendingEmailInvitations(ctx context.Context, email string) ([]domains.Invitation, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePendingEmailInvitations")
+	}
+
+	var r0 []domains.Invitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domains.Invitation, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domains.Invitation); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Invitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrievePendingEmailInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePendingEmailInvitations'
+type Repository_RetrievePendingEmailInvitations_Call struct {
+	*mock.Call
+}
+
+// RetrievePendingEmailInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *Repository_Expecter) RetrievePendingEmailInvitations(ctx interface{}, email interface{}) *Repository_RetrievePendingEmailInvitations_Call {
+	return &Repository_RetrievePendingEmailInvitations_Call{Call: _e.mock.On("RetrievePendingEmailInvitations", ctx, email)}
+}
+
+func (_c *Repository_RetrievePendingEmailInvitations_Call) Run(run func(ctx context.Context, email string)) *Repository_RetrievePendingEmailInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrievePendingEmailInvitations_Call) Return(invitations []domains.Invitation, err error) *Repository_RetrievePendingEmailInvitations_Call {
+	_c.Call.Return(invitations, err)
+	return _c
+}
+
+func (_c *Repository_RetrievePendingEmailInvitations_Call) RunAndReturn(run func(ctx context.Context, email string) ([]domains.Invitation, error)) *Repository_RetrievePendingEmailInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveQuotas provides a mock function for the type Repository
+func (_mock *Repository) RetrieveQuotas(ctx context.Context, domainID string) (domains.Quotas, error) {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveQuotas")
+	}
+
+	var r0 domains.Quotas
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domains.Quotas, error)); ok {
+		return returnFunc(ctx, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domains.Quotas); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Get(0).(domains.Quotas)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveQuotas'
+type Repository_RetrieveQuotas_Call struct {
+	*mock.Call
+}
+
+// RetrieveQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Repository_Expecter) RetrieveQuotas(ctx interface{}, domainID interface{}) *Repository_RetrieveQuotas_Call {
+	return &Repository_RetrieveQuotas_Call{Call: _e.mock.On("RetrieveQuotas", ctx, domainID)}
+}
+
+func (_c *Repository_RetrieveQuotas_Call) Run(run func(ctx context.Context, domainID string)) *Repository_RetrieveQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveQuotas_Call) Return(quotas domains.Quotas, err error) *Repository_RetrieveQuotas_Call {
+	_c.Call.Return(quotas, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveQuotas_Call) RunAndReturn(run func(ctx context.Context, domainID string) (domains.Quotas, error)) *Repository_RetrieveQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveRole provides a mock function for the type Repository
 func (_mock *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
 	ret := _mock.Called(ctx, roleID)
@@ -1199,35 +1837,167 @@ func (_mock *Repository) RetrieveRole(ctx context.Context, roleID string) (roles
 
 	var r0 roles.Role
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (roles.Role, error)); ok {
-		return returnFunc(ctx, roleID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (roles.Role, error)); ok {
+		return returnFunc(ctx, roleID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) roles.Role); ok {
+		r0 = returnFunc(ctx, roleID)
+	} else {
+		r0 = ret.Get(0).(roles.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveRole'
+type Repository_RetrieveRole_Call struct {
+	*mock.Call
+}
+
+// RetrieveRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID string
+func (_e *Repository_Expecter) RetrieveRole(ctx interface{}, roleID interface{}) *Repository_RetrieveRole_Call {
+	return &Repository_RetrieveRole_Call{Call: _e.mock.On("RetrieveRole", ctx, roleID)}
+}
+
+func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID string)) *Repository_RetrieveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveRole_Call) Return(role roles.Role, err error) *Repository_RetrieveRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context, roleID string) (roles.Role, error)) *Repository_RetrieveRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveTransfer provides a mock function for the type Repository
+func (_mock *Repository) RetrieveTransfer(ctx context.Context, domainID string) (domains.Transfer, error) {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveTransfer")
+	}
+
+	var r0 domains.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domains.Transfer, error)); ok {
+		return returnFunc(ctx, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domains.Transfer); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Get(0).(domains.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveTransfer'
+type Repository_RetrieveTransfer_Call struct {
+	*mock.Call
+}
+
+// RetrieveTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Repository_Expecter) RetrieveTransfer(ctx interface{}, domainID interface{}) *Repository_RetrieveTransfer_Call {
+	return &Repository_RetrieveTransfer_Call{Call: _e.mock.On("RetrieveTransfer", ctx, domainID)}
+}
+
+func (_c *Repository_RetrieveTransfer_Call) Run(run func(ctx context.Context, domainID string)) *Repository_RetrieveTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveTransfer_Call) Return(transfer domains.Transfer, err error) *Repository_RetrieveTransfer_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveTransfer_Call) RunAndReturn(run func(ctx context.Context, domainID string) (domains.Transfer, error)) *Repository_RetrieveTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveUsage provides a mock function for the type Repository
+func (_mock *Repository) RetrieveUsage(ctx context.Context, domainID string) (domains.Usage, error) {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveUsage")
+	}
+
+	var r0 domains.Usage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domains.Usage, error)); ok {
+		return returnFunc(ctx, domainID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) roles.Role); ok {
-		r0 = returnFunc(ctx, roleID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domains.Usage); ok {
+		r0 = returnFunc(ctx, domainID)
 	} else {
-		r0 = ret.Get(0).(roles.Role)
+		r0 = ret.Get(0).(domains.Usage)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, roleID)
+		r1 = returnFunc(ctx, domainID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_RetrieveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveRole'
-type Repository_RetrieveRole_Call struct {
+// Repository_RetrieveUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveUsage'
+type Repository_RetrieveUsage_Call struct {
 	*mock.Call
 }
 
-// RetrieveRole is a helper method to define mock.On call
+// RetrieveUsage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roleID string
-func (_e *Repository_Expecter) RetrieveRole(ctx interface{}, roleID interface{}) *Repository_RetrieveRole_Call {
-	return &Repository_RetrieveRole_Call{Call: _e.mock.On("RetrieveRole", ctx, roleID)}
+//   - domainID string
+func (_e *Repository_Expecter) RetrieveUsage(ctx interface{}, domainID interface{}) *Repository_RetrieveUsage_Call {
+	return &Repository_RetrieveUsage_Call{Call: _e.mock.On("RetrieveUsage", ctx, domainID)}
 }
 
-func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID string)) *Repository_RetrieveRole_Call {
+func (_c *Repository_RetrieveUsage_Call) Run(run func(ctx context.Context, domainID string)) *Repository_RetrieveUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1245,12 +2015,12 @@ func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID
 	return _c
 }
 
-func (_c *Repository_RetrieveRole_Call) Return(role roles.Role, err error) *Repository_RetrieveRole_Call {
-	_c.Call.Return(role, err)
+func (_c *Repository_RetrieveUsage_Call) Return(usage domains.Usage, err error) *Repository_RetrieveUsage_Call {
+	_c.Call.Return(usage, err)
 	return _c
 }
 
-func (_c *Repository_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context, roleID string) (roles.Role, error)) *Repository_RetrieveRole_Call {
+func (_c *Repository_RetrieveUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string) (domains.Usage, error)) *Repository_RetrieveUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2056,6 +2826,195 @@ func (_c *Repository_SaveInvitation_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// SaveQuotas provides a mock function for the type Repository
+func (_mock *Repository) SaveQuotas(ctx context.Context, domainID string, q domains.Quotas) error {
+	ret := _mock.Called(ctx, domainID, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuotas")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Quotas) error); ok {
+		r0 = returnFunc(ctx, domainID, q)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SaveQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveQuotas'
+type Repository_SaveQuotas_Call struct {
+	*mock.Call
+}
+
+// SaveQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - q domains.Quotas
+func (_e *Repository_Expecter) SaveQuotas(ctx interface{}, domainID interface{}, q interface{}) *Repository_SaveQuotas_Call {
+	return &Repository_SaveQuotas_Call{Call: _e.mock.On("SaveQuotas", ctx, domainID, q)}
+}
+
+func (_c *Repository_SaveQuotas_Call) Run(run func(ctx context.Context, domainID string, q domains.Quotas)) *Repository_SaveQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Quotas
+		if args[2] != nil {
+			arg2 = args[2].(domains.Quotas)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveQuotas_Call) Return(err error) *Repository_SaveQuotas_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SaveQuotas_Call) RunAndReturn(run func(ctx context.Context, domainID string, q domains.Quotas) error) *Repository_SaveQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTransfer provides a mock function for the type Repository
+func (_mock *Repository) SaveTransfer(ctx context.Context, t domains.Transfer) error {
+	ret := _mock.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTransfer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domains.Transfer) error); ok {
+		r0 = returnFunc(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SaveTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTransfer'
+type Repository_SaveTransfer_Call struct {
+	*mock.Call
+}
+
+// SaveTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t domains.Transfer
+func (_e *Repository_Expecter) SaveTransfer(ctx interface{}, t interface{}) *Repository_SaveTransfer_Call {
+	return &Repository_SaveTransfer_Call{Call: _e.mock.On("SaveTransfer", ctx, t)}
+}
+
+func (_c *Repository_SaveTransfer_Call) Run(run func(ctx context.Context, t domains.Transfer)) *Repository_SaveTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domains.Transfer
+		if args[1] != nil {
+			arg1 = args[1].(domains.Transfer)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveTransfer_Call) Return(err error) *Repository_SaveTransfer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SaveTransfer_Call) RunAndReturn(run func(ctx context.Context, t domains.Transfer) error) *Repository_SaveTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUsage provides a mock function for the type Repository
+func (_mock *Repository) SetUsage(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	ret := _mock.Called(ctx, domainID, resource, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, uint64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUsage'
+type Repository_SetUsage_Call struct {
+	*mock.Call
+}
+
+// SetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - n uint64
+func (_e *Repository_Expecter) SetUsage(ctx interface{}, domainID interface{}, resource interface{}, n interface{}) *Repository_SetUsage_Call {
+	return &Repository_SetUsage_Call{Call: _e.mock.On("SetUsage", ctx, domainID, resource, n)}
+}
+
+func (_c *Repository_SetUsage_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64)) *Repository_SetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SetUsage_Call) Return(err error) *Repository_SetUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SetUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64) error) *Repository_SetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateConfirmation provides a mock function for the type Repository
 func (_mock *Repository) UpdateConfirmation(ctx context.Context, invitation domains.Invitation) error {
 	ret := _mock.Called(ctx, invitation)
@@ -2185,6 +3144,63 @@ func (_c *Repository_UpdateDomain_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// UpdateEmailInvitation provides a mock function for the type Repository
+func (_mock *Repository) UpdateEmailInvitation(ctx context.Context, invitation domains.Invitation) error {
+	ret := _mock.Called(ctx, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmailInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domains.Invitation) error); ok {
+		r0 = returnFunc(ctx, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_UpdateEmailInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmailInvitation'
+type Repository_UpdateEmailInvitation_Call struct {
+	*mock.Call
+}
+
+// UpdateEmailInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation domains.Invitation
+func (_e *Repository_Expecter) UpdateEmailInvitation(ctx interface{}, invitation interface{}) *Repository_UpdateEmailInvitation_Call {
+	return &Repository_UpdateEmailInvitation_Call{Call: _e.mock.On("UpdateEmailInvitation", ctx, invitation)}
+}
+
+func (_c *Repository_UpdateEmailInvitation_Call) Run(run func(ctx context.Context, invitation domains.Invitation)) *Repository_UpdateEmailInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domains.Invitation
+		if args[1] != nil {
+			arg1 = args[1].(domains.Invitation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateEmailInvitation_Call) Return(err error) *Repository_UpdateEmailInvitation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_UpdateEmailInvitation_Call) RunAndReturn(run func(ctx context.Context, invitation domains.Invitation) error) *Repository_UpdateEmailInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRejection provides a mock function for the type Repository
 func (_mock *Repository) UpdateRejection(ctx context.Context, invitation domains.Invitation) error {
 	ret := _mock.Called(ctx, invitation)
@@ -2307,3 +3323,87 @@ func (_c *Repository_UpdateRole_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateUsage provides a mock function for the type Repository
+func (_mock *Repository) UpdateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64, limit uint64) (bool, error) {
+	ret := _mock.Called(ctx, domainID, resource, delta, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsage")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, int64, uint64) (bool, error)); ok {
+		return returnFunc(ctx, domainID, resource, delta, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, int64, uint64) bool); ok {
+		r0 = returnFunc(ctx, domainID, resource, delta, limit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domains.Resource, int64, uint64) error); ok {
+		r1 = returnFunc(ctx, domainID, resource, delta, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsage'
+type Repository_UpdateUsage_Call struct {
+	*mock.Call
+}
+
+// UpdateUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - delta int64
+//   - limit uint64
+func (_e *Repository_Expecter) UpdateUsage(ctx interface{}, domainID interface{}, resource interface{}, delta interface{}, limit interface{}) *Repository_UpdateUsage_Call {
+	return &Repository_UpdateUsage_Call{Call: _e.mock.On("UpdateUsage", ctx, domainID, resource, delta, limit)}
+}
+
+func (_c *Repository_UpdateUsage_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, delta int64, limit uint64)) *Repository_UpdateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		var arg4 uint64
+		if args[4] != nil {
+			arg4 = args[4].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateUsage_Call) Return(b bool, err error) *Repository_UpdateUsage_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Repository_UpdateUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, delta int64, limit uint64) (bool, error)) *Repository_UpdateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
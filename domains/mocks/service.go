@@ -116,6 +116,78 @@ func (_c *Service_AcceptInvitation_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// AcceptTransfer provides a mock function for the type Service
+func (_mock *Service) AcceptTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptTransfer")
+	}
+
+	var r0 domains.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.Transfer, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.Transfer); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(domains.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AcceptTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptTransfer'
+type Service_AcceptTransfer_Call struct {
+	*mock.Call
+}
+
+// AcceptTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) AcceptTransfer(ctx interface{}, session interface{}, id interface{}) *Service_AcceptTransfer_Call {
+	return &Service_AcceptTransfer_Call{Call: _e.mock.On("AcceptTransfer", ctx, session, id)}
+}
+
+func (_c *Service_AcceptTransfer_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_AcceptTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AcceptTransfer_Call) Return(transfer domains.Transfer, err error) *Service_AcceptTransfer_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *Service_AcceptTransfer_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.Transfer, error)) *Service_AcceptTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddRole provides a mock function for the type Service
 func (_mock *Service) AddRole(ctx context.Context, session authn.Session, entityID string, roleName string, optionalActions []string, optionalMembers []string) (roles.RoleProvision, error) {
 	ret := _mock.Called(ctx, session, entityID, roleName, optionalActions, optionalMembers)
@@ -286,6 +358,78 @@ func (_c *Service_CreateDomain_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// DeleteDomain provides a mock function for the type Service
+func (_mock *Service) DeleteDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDomain")
+	}
+
+	var r0 domains.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.Domain, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.Domain); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(domains.Domain)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_DeleteDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomain'
+type Service_DeleteDomain_Call struct {
+	*mock.Call
+}
+
+// DeleteDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) DeleteDomain(ctx interface{}, session interface{}, id interface{}) *Service_DeleteDomain_Call {
+	return &Service_DeleteDomain_Call{Call: _e.mock.On("DeleteDomain", ctx, session, id)}
+}
+
+func (_c *Service_DeleteDomain_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_DeleteDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DeleteDomain_Call) Return(domain domains.Domain, err error) *Service_DeleteDomain_Call {
+	_c.Call.Return(domain, err)
+	return _c
+}
+
+func (_c *Service_DeleteDomain_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.Domain, error)) *Service_DeleteDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteInvitation provides a mock function for the type Service
 func (_mock *Service) DeleteInvitation(ctx context.Context, session authn.Session, inviteeUserID string, domainID string) error {
 	ret := _mock.Called(ctx, session, inviteeUserID, domainID)
@@ -499,6 +643,78 @@ func (_c *Service_EnableDomain_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ExportDomain provides a mock function for the type Service
+func (_mock *Service) ExportDomain(ctx context.Context, session authn.Session, id string) (domains.Archive, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportDomain")
+	}
+
+	var r0 domains.Archive
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.Archive, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.Archive); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(domains.Archive)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ExportDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportDomain'
+type Service_ExportDomain_Call struct {
+	*mock.Call
+}
+
+// ExportDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ExportDomain(ctx interface{}, session interface{}, id interface{}) *Service_ExportDomain_Call {
+	return &Service_ExportDomain_Call{Call: _e.mock.On("ExportDomain", ctx, session, id)}
+}
+
+func (_c *Service_ExportDomain_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ExportDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ExportDomain_Call) Return(archive domains.Archive, err error) *Service_ExportDomain_Call {
+	_c.Call.Return(archive, err)
+	return _c
+}
+
+func (_c *Service_ExportDomain_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.Archive, error)) *Service_ExportDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FreezeDomain provides a mock function for the type Service
 func (_mock *Service) FreezeDomain(ctx context.Context, sesssion authn.Session, id string) (domains.Domain, error) {
 	ret := _mock.Called(ctx, sesssion, id)
@@ -571,6 +787,92 @@ func (_c *Service_FreezeDomain_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ImportDomain provides a mock function for the type Service
+func (_mock *Service) ImportDomain(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error) {
+	ret := _mock.Called(ctx, session, archive, preserveID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportDomain")
+	}
+
+	var r0 domains.Domain
+	var r1 []roles.RoleProvision
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, domains.Archive, bool) (domains.Domain, []roles.RoleProvision, error)); ok {
+		return returnFunc(ctx, session, archive, preserveID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, domains.Archive, bool) domains.Domain); ok {
+		r0 = returnFunc(ctx, session, archive, preserveID)
+	} else {
+		r0 = ret.Get(0).(domains.Domain)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, domains.Archive, bool) []roles.RoleProvision); ok {
+		r1 = returnFunc(ctx, session, archive, preserveID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]roles.RoleProvision)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, authn.Session, domains.Archive, bool) error); ok {
+		r2 = returnFunc(ctx, session, archive, preserveID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// Service_ImportDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportDomain'
+type Service_ImportDomain_Call struct {
+	*mock.Call
+}
+
+// ImportDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - archive domains.Archive
+//   - preserveID bool
+func (_e *Service_Expecter) ImportDomain(ctx interface{}, session interface{}, archive interface{}, preserveID interface{}) *Service_ImportDomain_Call {
+	return &Service_ImportDomain_Call{Call: _e.mock.On("ImportDomain", ctx, session, archive, preserveID)}
+}
+
+func (_c *Service_ImportDomain_Call) Run(run func(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool)) *Service_ImportDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 domains.Archive
+		if args[2] != nil {
+			arg2 = args[2].(domains.Archive)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ImportDomain_Call) Return(domain domains.Domain, roleProvisions []roles.RoleProvision, err error) *Service_ImportDomain_Call {
+	_c.Call.Return(domain, roleProvisions, err)
+	return _c
+}
+
+func (_c *Service_ImportDomain_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, archive domains.Archive, preserveID bool) (domains.Domain, []roles.RoleProvision, error)) *Service_ImportDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAvailableActions provides a mock function for the type Service
 func (_mock *Service) ListAvailableActions(ctx context.Context, session authn.Session) ([]string, error) {
 	ret := _mock.Called(ctx, session)
@@ -1005,6 +1307,69 @@ func (_c *Service_RejectInvitation_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// RejectTransfer provides a mock function for the type Service
+func (_mock *Service) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectTransfer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RejectTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectTransfer'
+type Service_RejectTransfer_Call struct {
+	*mock.Call
+}
+
+// RejectTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RejectTransfer(ctx interface{}, session interface{}, id interface{}) *Service_RejectTransfer_Call {
+	return &Service_RejectTransfer_Call{Call: _e.mock.On("RejectTransfer", ctx, session, id)}
+}
+
+func (_c *Service_RejectTransfer_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RejectTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RejectTransfer_Call) Return(err error) *Service_RejectTransfer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RejectTransfer_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RejectTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveEntityMembers provides a mock function for the type Service
 func (_mock *Service) RemoveEntityMembers(ctx context.Context, session authn.Session, entityID string, members []string) error {
 	ret := _mock.Called(ctx, session, entityID, members)
@@ -1132,43 +1497,120 @@ func (_c *Service_RemoveMemberFromAllRoles_Call) Return(err error) *Service_Remo
 	return _c
 }
 
-func (_c *Service_RemoveMemberFromAllRoles_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, memberID string) error) *Service_RemoveMemberFromAllRoles_Call {
+func (_c *Service_RemoveMemberFromAllRoles_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, memberID string) error) *Service_RemoveMemberFromAllRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRole provides a mock function for the type Service
+func (_mock *Service) RemoveRole(ctx context.Context, session authn.Session, entityID string, roleID string) error {
+	ret := _mock.Called(ctx, session, entityID, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) error); ok {
+		r0 = returnFunc(ctx, session, entityID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRole'
+type Service_RemoveRole_Call struct {
+	*mock.Call
+}
+
+// RemoveRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - entityID string
+//   - roleID string
+func (_e *Service_Expecter) RemoveRole(ctx interface{}, session interface{}, entityID interface{}, roleID interface{}) *Service_RemoveRole_Call {
+	return &Service_RemoveRole_Call{Call: _e.mock.On("RemoveRole", ctx, session, entityID, roleID)}
+}
+
+func (_c *Service_RemoveRole_Call) Run(run func(ctx context.Context, session authn.Session, entityID string, roleID string)) *Service_RemoveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveRole_Call) Return(err error) *Service_RemoveRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveRole_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, entityID string, roleID string) error) *Service_RemoveRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveRole provides a mock function for the type Service
-func (_mock *Service) RemoveRole(ctx context.Context, session authn.Session, entityID string, roleID string) error {
-	ret := _mock.Called(ctx, session, entityID, roleID)
+// RestoreDomain provides a mock function for the type Service
+func (_mock *Service) RestoreDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
+	ret := _mock.Called(ctx, session, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveRole")
+		panic("no return value specified for RestoreDomain")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) error); ok {
-		r0 = returnFunc(ctx, session, entityID, roleID)
+	var r0 domains.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.Domain, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.Domain); ok {
+		r0 = returnFunc(ctx, session, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domains.Domain)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Service_RemoveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRole'
-type Service_RemoveRole_Call struct {
+// Service_RestoreDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDomain'
+type Service_RestoreDomain_Call struct {
 	*mock.Call
 }
 
-// RemoveRole is a helper method to define mock.On call
+// RestoreDomain is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
-//   - entityID string
-//   - roleID string
-func (_e *Service_Expecter) RemoveRole(ctx interface{}, session interface{}, entityID interface{}, roleID interface{}) *Service_RemoveRole_Call {
-	return &Service_RemoveRole_Call{Call: _e.mock.On("RemoveRole", ctx, session, entityID, roleID)}
+//   - id string
+func (_e *Service_Expecter) RestoreDomain(ctx interface{}, session interface{}, id interface{}) *Service_RestoreDomain_Call {
+	return &Service_RestoreDomain_Call{Call: _e.mock.On("RestoreDomain", ctx, session, id)}
 }
 
-func (_c *Service_RemoveRole_Call) Run(run func(ctx context.Context, session authn.Session, entityID string, roleID string)) *Service_RemoveRole_Call {
+func (_c *Service_RestoreDomain_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RestoreDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1182,26 +1624,21 @@ func (_c *Service_RemoveRole_Call) Run(run func(ctx context.Context, session aut
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *Service_RemoveRole_Call) Return(err error) *Service_RemoveRole_Call {
-	_c.Call.Return(err)
+func (_c *Service_RestoreDomain_Call) Return(domain domains.Domain, err error) *Service_RestoreDomain_Call {
+	_c.Call.Return(domain, err)
 	return _c
 }
 
-func (_c *Service_RemoveRole_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, entityID string, roleID string) error) *Service_RemoveRole_Call {
+func (_c *Service_RestoreDomain_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.Domain, error)) *Service_RestoreDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1446,6 +1883,150 @@ func (_c *Service_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// RetrieveTransfer provides a mock function for the type Service
+func (_mock *Service) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (domains.Transfer, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveTransfer")
+	}
+
+	var r0 domains.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.Transfer, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.Transfer); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(domains.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveTransfer'
+type Service_RetrieveTransfer_Call struct {
+	*mock.Call
+}
+
+// RetrieveTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RetrieveTransfer(ctx interface{}, session interface{}, id interface{}) *Service_RetrieveTransfer_Call {
+	return &Service_RetrieveTransfer_Call{Call: _e.mock.On("RetrieveTransfer", ctx, session, id)}
+}
+
+func (_c *Service_RetrieveTransfer_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RetrieveTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveTransfer_Call) Return(transfer domains.Transfer, err error) *Service_RetrieveTransfer_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *Service_RetrieveTransfer_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.Transfer, error)) *Service_RetrieveTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveUsage provides a mock function for the type Service
+func (_mock *Service) RetrieveUsage(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveUsage")
+	}
+
+	var r0 domains.QuotasUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (domains.QuotasUsage, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) domains.QuotasUsage); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(domains.QuotasUsage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveUsage'
+type Service_RetrieveUsage_Call struct {
+	*mock.Call
+}
+
+// RetrieveUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RetrieveUsage(ctx interface{}, session interface{}, id interface{}) *Service_RetrieveUsage_Call {
+	return &Service_RetrieveUsage_Call{Call: _e.mock.On("RetrieveUsage", ctx, session, id)}
+}
+
+func (_c *Service_RetrieveUsage_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RetrieveUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveUsage_Call) Return(quotasUsage domains.QuotasUsage, err error) *Service_RetrieveUsage_Call {
+	_c.Call.Return(quotasUsage, err)
+	return _c
+}
+
+func (_c *Service_RetrieveUsage_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (domains.QuotasUsage, error)) *Service_RetrieveUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RoleAddActions provides a mock function for the type Service
 func (_mock *Service) RoleAddActions(ctx context.Context, session authn.Session, entityID string, roleID string, actions []string) ([]string, error) {
 	ret := _mock.Called(ctx, session, entityID, roleID, actions)
@@ -2316,6 +2897,84 @@ func (_c *Service_SendInvitation_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// TransferOwnership provides a mock function for the type Service
+func (_mock *Service) TransferOwnership(ctx context.Context, session authn.Session, id string, userID string) (domains.Transfer, error) {
+	ret := _mock.Called(ctx, session, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwnership")
+	}
+
+	var r0 domains.Transfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) (domains.Transfer, error)); ok {
+		return returnFunc(ctx, session, id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) domains.Transfer); ok {
+		r0 = returnFunc(ctx, session, id, userID)
+	} else {
+		r0 = ret.Get(0).(domains.Transfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, string) error); ok {
+		r1 = returnFunc(ctx, session, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_TransferOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferOwnership'
+type Service_TransferOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - userID string
+func (_e *Service_Expecter) TransferOwnership(ctx interface{}, session interface{}, id interface{}, userID interface{}) *Service_TransferOwnership_Call {
+	return &Service_TransferOwnership_Call{Call: _e.mock.On("TransferOwnership", ctx, session, id, userID)}
+}
+
+func (_c *Service_TransferOwnership_Call) Run(run func(ctx context.Context, session authn.Session, id string, userID string)) *Service_TransferOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_TransferOwnership_Call) Return(transfer domains.Transfer, err error) *Service_TransferOwnership_Call {
+	_c.Call.Return(transfer, err)
+	return _c
+}
+
+func (_c *Service_TransferOwnership_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, userID string) (domains.Transfer, error)) *Service_TransferOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDomain provides a mock function for the type Service
 func (_mock *Service) UpdateDomain(ctx context.Context, sesssion authn.Session, id string, d domains.DomainReq) (domains.Domain, error) {
 	ret := _mock.Called(ctx, sesssion, id, d)
@@ -2394,6 +3053,84 @@ func (_c *Service_UpdateDomain_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// UpdateQuotas provides a mock function for the type Service
+func (_mock *Service) UpdateQuotas(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error) {
+	ret := _mock.Called(ctx, session, id, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuotas")
+	}
+
+	var r0 domains.Quotas
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, domains.Quotas) (domains.Quotas, error)); ok {
+		return returnFunc(ctx, session, id, q)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, domains.Quotas) domains.Quotas); ok {
+		r0 = returnFunc(ctx, session, id, q)
+	} else {
+		r0 = ret.Get(0).(domains.Quotas)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, domains.Quotas) error); ok {
+		r1 = returnFunc(ctx, session, id, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdateQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQuotas'
+type Service_UpdateQuotas_Call struct {
+	*mock.Call
+}
+
+// UpdateQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - q domains.Quotas
+func (_e *Service_Expecter) UpdateQuotas(ctx interface{}, session interface{}, id interface{}, q interface{}) *Service_UpdateQuotas_Call {
+	return &Service_UpdateQuotas_Call{Call: _e.mock.On("UpdateQuotas", ctx, session, id, q)}
+}
+
+func (_c *Service_UpdateQuotas_Call) Run(run func(ctx context.Context, session authn.Session, id string, q domains.Quotas)) *Service_UpdateQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domains.Quotas
+		if args[3] != nil {
+			arg3 = args[3].(domains.Quotas)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateQuotas_Call) Return(quotas domains.Quotas, err error) *Service_UpdateQuotas_Call {
+	_c.Call.Return(quotas, err)
+	return _c
+}
+
+func (_c *Service_UpdateQuotas_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, q domains.Quotas) (domains.Quotas, error)) *Service_UpdateQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRoleName provides a mock function for the type Service
 func (_mock *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _mock.Called(ctx, session, entityID, roleID, newRoleName)
@@ -15,6 +15,16 @@ const (
 	OpDisableDomain
 	OpFreezeDomain
 	OpListDomains
+	OpDeleteDomain
+	OpRestoreDomain
+	OpExportDomain
+	OpImportDomain
+	OpRetrieveDomainUsage
+	OpUpdateDomainQuotas
+	OpTransferDomainOwnership
+	OpRetrieveDomainTransfer
+	OpAcceptDomainTransfer
+	OpRejectDomainTransfer
 
 	OpSendDomainInvitation
 	OpListDomainInvitations
@@ -56,16 +66,62 @@ func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
 			PermissionRequired: true,
 		},
 
+		OpDeleteDomain: {
+			Name:               "delete",
+			PermissionRequired: true,
+		},
+		OpRestoreDomain: {
+			Name:               "restore",
+			PermissionRequired: true,
+		},
+		OpExportDomain: {
+			Name:               "export",
+			PermissionRequired: true,
+		},
+		OpRetrieveDomainUsage: {
+			Name:               "usage",
+			PermissionRequired: true,
+		},
+
 		// Permission not required, only Super Admin can freeze the domain
 		OpFreezeDomain: {
 			Name:               "freeze",
 			PermissionRequired: false,
 		},
 
+		// Permission not required, only Super Admin can update the domain quotas
+		OpUpdateDomainQuotas: {
+			Name:               "update_quotas",
+			PermissionRequired: false,
+		},
+
+		OpTransferDomainOwnership: {
+			Name:               "transfer_ownership",
+			PermissionRequired: true,
+		},
+
+		// Permission not required, only the users taking part in the transfer can access it
+		OpRetrieveDomainTransfer: {
+			Name:               "view_transfer",
+			PermissionRequired: false,
+		},
+		OpAcceptDomainTransfer: {
+			Name:               "accept_transfer",
+			PermissionRequired: false,
+		},
+		OpRejectDomainTransfer: {
+			Name:               "reject_transfer",
+			PermissionRequired: false,
+		},
+
 		OpCreateDomain: {
 			Name:               "create",
 			PermissionRequired: false,
 		},
+		OpImportDomain: {
+			Name:               "import",
+			PermissionRequired: false,
+		},
 
 		// Domain Invitation related permissions
 		OpSendDomainInvitation: {
@@ -190,7 +190,7 @@ func (repo domainRepo) RetrieveDomainByIDWithRoles(ctx context.Context, id strin
 
 // RetrieveDomainByID retrieves Domain by its unique ID.
 func (repo domainRepo) RetrieveDomainByID(ctx context.Context, id string) (domains.Domain, error) {
-	q := `SELECT d.id as id, d.name as name, d.tags as tags,  d.route as route, d.metadata as metadata, d.created_at as created_at, d.updated_at as updated_at, d.updated_by as updated_by, d.created_by as created_by, d.status as status
+	q := `SELECT d.id as id, d.name as name, d.tags as tags,  d.route as route, d.metadata as metadata, d.created_at as created_at, d.updated_at as updated_at, d.updated_by as updated_by, d.created_by as created_by, d.status as status, d.previous_status as previous_status, d.deleted_at as deleted_at
         FROM domains d WHERE d.id = :id`
 
 	dbdp := dbDomainsPage{
@@ -321,7 +321,8 @@ func (repo domainRepo) ListDomains(ctx context.Context, pm domains.Page) (domain
 			d.updated_at as updated_at,
 			d.updated_by as updated_by,
 			d.created_by as created_by,
-			d.status as status
+			d.status as status,
+			d.deleted_at as deleted_at
 		FROM
 			domains as d
 		%s
@@ -418,6 +419,10 @@ func (repo domainRepo) UpdateDomain(ctx context.Context, id string, dr domains.D
 		query = append(query, "status = :status")
 		d.Status = *dr.Status
 	}
+	if dr.PreviousStatus != nil {
+		query = append(query, "previous_status = :previous_status")
+		d.PreviousStatus = *dr.PreviousStatus
+	}
 	d.UpdatedAt = time.Now().UTC()
 	if dr.UpdatedAt != nil {
 		query = append(query, "updated_at = :updated_at")
@@ -434,7 +439,7 @@ func (repo domainRepo) UpdateDomain(ctx context.Context, id string, dr domains.D
 
 	q := fmt.Sprintf(`UPDATE domains SET %s
 		WHERE id = :id
-		RETURNING id, name, tags, route, metadata, created_at, updated_at, updated_by, created_by, status;`, upq)
+		RETURNING id, name, tags, route, metadata, created_at, updated_at, updated_by, created_by, status, previous_status;`, upq)
 
 	dbd, err := toDBDomain(d)
 	if err != nil {
@@ -464,6 +469,41 @@ func (repo domainRepo) UpdateDomain(ctx context.Context, id string, dr domains.D
 	return domain, nil
 }
 
+// MarkDomainDeleted sets the domain status to deleted and keeps the current
+// status as previous status, unless the domain is already deleted.
+func (repo domainRepo) MarkDomainDeleted(ctx context.Context, id, deletedBy string, deletedAt time.Time) (domains.Domain, error) {
+	q := `UPDATE domains SET previous_status = status, status = :status, deleted_at = :updated_at, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND status <> :status
+		RETURNING id, name, tags, route, metadata, created_at, updated_at, updated_by, created_by, status, previous_status, deleted_at;`
+
+	dbd, err := toDBDomain(domains.Domain{ID: id, Status: domains.DeletedStatus, UpdatedBy: deletedBy, UpdatedAt: deletedAt})
+	if err != nil {
+		return domains.Domain{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	row, err := repo.db.NamedQueryContext(ctx, q, dbd)
+	if err != nil {
+		return domains.Domain{}, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return domains.Domain{}, repoerr.ErrNotFound
+	}
+
+	dbd = dbDomain{}
+	if err := row.StructScan(&dbd); err != nil {
+		return domains.Domain{}, repo.eh.HandleError(repoerr.ErrFailedOpDB, err)
+	}
+
+	domain, err := toDomain(dbd)
+	if err != nil {
+		return domains.Domain{}, errors.Wrap(repoerr.ErrFailedOpDB, err)
+	}
+
+	return domain, nil
+}
+
 // Delete delete domain from database.
 func (repo domainRepo) DeleteDomain(ctx context.Context, id string) error {
 	q := "DELETE FROM domains WHERE id = $1;"
@@ -550,21 +590,23 @@ func applyOrdering(emq string, pm domains.Page) string {
 }
 
 type dbDomain struct {
-	ID        string           `db:"id"`
-	Name      string           `db:"name"`
-	Metadata  []byte           `db:"metadata,omitempty"`
-	Tags      pgtype.TextArray `db:"tags,omitempty"`
-	Route     *string          `db:"route,omitempty"`
-	Status    domains.Status   `db:"status"`
-	RoleID    string           `db:"role_id"`
-	RoleName  string           `db:"role_name"`
-	Actions   pq.StringArray   `db:"actions"`
-	CreatedBy string           `db:"created_by"`
-	CreatedAt time.Time        `db:"created_at"`
-	UpdatedBy *string          `db:"updated_by,omitempty"`
-	UpdatedAt sql.NullTime     `db:"updated_at,omitempty"`
-	MemberID  string           `db:"member_id,omitempty"`
-	Roles     json.RawMessage  `db:"roles,omitempty"`
+	ID             string           `db:"id"`
+	Name           string           `db:"name"`
+	Metadata       []byte           `db:"metadata,omitempty"`
+	Tags           pgtype.TextArray `db:"tags,omitempty"`
+	Route          *string          `db:"route,omitempty"`
+	Status         domains.Status   `db:"status"`
+	PreviousStatus domains.Status   `db:"previous_status"`
+	DeletedAt      sql.NullTime     `db:"deleted_at,omitempty"`
+	RoleID         string           `db:"role_id"`
+	RoleName       string           `db:"role_name"`
+	Actions        pq.StringArray   `db:"actions"`
+	CreatedBy      string           `db:"created_by"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedBy      *string          `db:"updated_by,omitempty"`
+	UpdatedAt      sql.NullTime     `db:"updated_at,omitempty"`
+	MemberID       string           `db:"member_id,omitempty"`
+	Roles          json.RawMessage  `db:"roles,omitempty"`
 }
 
 func toDBDomain(d domains.Domain) (dbDomain, error) {
@@ -595,17 +637,18 @@ func toDBDomain(d domains.Domain) (dbDomain, error) {
 	}
 
 	return dbDomain{
-		ID:        d.ID,
-		Name:      d.Name,
-		Metadata:  data,
-		Tags:      tags,
-		Route:     route,
-		Status:    d.Status,
-		RoleID:    d.RoleID,
-		CreatedBy: d.CreatedBy,
-		CreatedAt: d.CreatedAt,
-		UpdatedBy: updatedBy,
-		UpdatedAt: updatedAt,
+		ID:             d.ID,
+		Name:           d.Name,
+		Metadata:       data,
+		Tags:           tags,
+		Route:          route,
+		Status:         d.Status,
+		PreviousStatus: d.PreviousStatus,
+		RoleID:         d.RoleID,
+		CreatedBy:      d.CreatedBy,
+		CreatedAt:      d.CreatedAt,
+		UpdatedBy:      updatedBy,
+		UpdatedAt:      updatedAt,
 	}, nil
 }
 
@@ -633,6 +676,11 @@ func toDomain(d dbDomain) (domains.Domain, error) {
 		updatedAt = d.UpdatedAt.Time.UTC()
 	}
 
+	var deletedAt time.Time
+	if d.DeletedAt.Valid {
+		deletedAt = d.DeletedAt.Time.UTC()
+	}
+
 	var mra []roles.MemberRoleActions
 	if d.Roles != nil {
 		if err := json.Unmarshal(d.Roles, &mra); err != nil {
@@ -641,21 +689,23 @@ func toDomain(d dbDomain) (domains.Domain, error) {
 	}
 
 	return domains.Domain{
-		ID:        d.ID,
-		Name:      d.Name,
-		Metadata:  metadata,
-		Tags:      tags,
-		Route:     route,
-		RoleID:    d.RoleID,
-		RoleName:  d.RoleName,
-		Actions:   d.Actions,
-		Status:    d.Status,
-		CreatedBy: d.CreatedBy,
-		CreatedAt: d.CreatedAt.UTC(),
-		UpdatedBy: updatedBy,
-		UpdatedAt: updatedAt,
-		MemberID:  d.MemberID,
-		Roles:     mra,
+		ID:             d.ID,
+		Name:           d.Name,
+		Metadata:       metadata,
+		Tags:           tags,
+		Route:          route,
+		RoleID:         d.RoleID,
+		RoleName:       d.RoleName,
+		Actions:        d.Actions,
+		Status:         d.Status,
+		PreviousStatus: d.PreviousStatus,
+		DeletedAt:      deletedAt,
+		CreatedBy:      d.CreatedBy,
+		CreatedAt:      d.CreatedAt.UTC(),
+		UpdatedBy:      updatedBy,
+		UpdatedAt:      updatedAt,
+		MemberID:       d.MemberID,
+		Roles:          mra,
 	}, nil
 }
 
@@ -527,6 +527,7 @@ func TestUpdate(t *testing.T) {
 	}
 	updatedTags := []string{"test1"}
 	updatedStatus := domains.DisabledStatus
+	deletedStatus := domains.DeletedStatus
 
 	repo := postgres.NewRepository(database)
 
@@ -599,6 +600,29 @@ func TestUpdate(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc:     "update existing domain status and previous status",
+			domainID: domain.ID,
+			d: domains.DomainReq{
+				Status:         &deletedStatus,
+				PreviousStatus: &updatedStatus,
+			},
+			response: domains.Domain{
+				ID:    domainID,
+				Name:  "test1",
+				Route: "test",
+				Tags:  []string{"test1"},
+				Metadata: map[string]any{
+					"test1": "test1",
+				},
+				CreatedBy:      userID,
+				UpdatedBy:      userID,
+				Status:         domains.DeletedStatus,
+				PreviousStatus: domains.DisabledStatus,
+				UpdatedAt:      time.Now(),
+			},
+			err: nil,
+		},
 		{
 			desc:     "update non-existing domain",
 			domainID: invalid,
@@ -641,6 +665,62 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestMarkDomainDeleted(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM domains")
+		require.Nil(t, err, fmt.Sprintf("clean domains unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	domain := domains.Domain{
+		ID:        domainID,
+		Name:      "test",
+		Route:     "test",
+		CreatedBy: userID,
+		Status:    domains.FreezeStatus,
+	}
+
+	_, err := repo.SaveDomain(context.Background(), domain)
+	require.Nil(t, err, fmt.Sprintf("failed to save domain %s", domain.ID))
+
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc     string
+		domainID string
+		err      error
+	}{
+		{
+			desc:     "mark existing domain deleted",
+			domainID: domain.ID,
+			err:      nil,
+		},
+		{
+			desc:     "mark already deleted domain deleted",
+			domainID: domain.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "mark non-existing domain deleted",
+			domainID: invalid,
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			d, err := repo.MarkDomainDeleted(context.Background(), tc.domainID, userID, deletedAt)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, domains.DeletedStatus, d.Status, fmt.Sprintf("%s: expected status %s got %s\n", tc.desc, domains.DeletedStatus, d.Status))
+				assert.Equal(t, domains.FreezeStatus, d.PreviousStatus, fmt.Sprintf("%s: expected previous status %s got %s\n", tc.desc, domains.FreezeStatus, d.PreviousStatus))
+				assert.Equal(t, deletedAt, d.DeletedAt, fmt.Sprintf("%s: expected deleted at %s got %s\n", tc.desc, deletedAt, d.DeletedAt))
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	t.Cleanup(func() {
 		_, err := db.Exec("DELETE FROM domains")
@@ -160,6 +160,75 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					END $$;`,
 				},
 			},
+			{
+				Id: "domain_6",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS domain_quotas (
+						domain_id       VARCHAR(36) PRIMARY KEY,
+						clients         BIGINT NOT NULL DEFAULT 0 CHECK (clients >= 0),
+						channels        BIGINT NOT NULL DEFAULT 0 CHECK (channels >= 0),
+						groups          BIGINT NOT NULL DEFAULT 0 CHECK (groups >= 0),
+						invitations     BIGINT NOT NULL DEFAULT 0 CHECK (invitations >= 0),
+						message_rate    BIGINT NOT NULL DEFAULT 0 CHECK (message_rate >= 0),
+						FOREIGN KEY (domain_id) REFERENCES domains(id) ON DELETE CASCADE
+					)`,
+					`CREATE TABLE IF NOT EXISTS domain_usage (
+						domain_id       VARCHAR(36) PRIMARY KEY,
+						clients         BIGINT NOT NULL DEFAULT 0 CHECK (clients >= 0),
+						channels        BIGINT NOT NULL DEFAULT 0 CHECK (channels >= 0),
+						groups          BIGINT NOT NULL DEFAULT 0 CHECK (groups >= 0),
+						FOREIGN KEY (domain_id) REFERENCES domains(id) ON DELETE CASCADE
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS domain_usage`,
+					`DROP TABLE IF EXISTS domain_quotas`,
+				},
+			},
+			{
+				Id: "domain_7",
+				Up: []string{
+					`ALTER TABLE invitations ADD COLUMN IF NOT EXISTS invitee_email VARCHAR(254)`,
+					`ALTER TABLE invitations ADD COLUMN IF NOT EXISTS token_hash VARCHAR(64)`,
+					`ALTER TABLE invitations ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ`,
+					`ALTER TABLE invitations ADD COLUMN IF NOT EXISTS resend_count BIGINT NOT NULL DEFAULT 0`,
+					`CREATE UNIQUE INDEX IF NOT EXISTS invitations_domain_id_invitee_email_idx ON invitations (domain_id, invitee_email) WHERE invitee_email IS NOT NULL`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS invitations_domain_id_invitee_email_idx`,
+					`ALTER TABLE invitations DROP COLUMN IF EXISTS resend_count`,
+					`ALTER TABLE invitations DROP COLUMN IF EXISTS expires_at`,
+					`ALTER TABLE invitations DROP COLUMN IF EXISTS token_hash`,
+					`ALTER TABLE invitations DROP COLUMN IF EXISTS invitee_email`,
+				},
+			},
+			{
+				Id: "domain_8",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS domain_transfers (
+						domain_id       VARCHAR(36) PRIMARY KEY,
+						from_user_id    VARCHAR(36) NOT NULL,
+						to_user_id      VARCHAR(36) NOT NULL,
+						created_at      TIMESTAMPTZ NOT NULL,
+						expires_at      TIMESTAMPTZ NOT NULL,
+						FOREIGN KEY (domain_id) REFERENCES domains(id) ON DELETE CASCADE
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS domain_transfers`,
+				},
+			},
+			{
+				Id: "domain_9",
+				Up: []string{
+					`ALTER TABLE domains ADD COLUMN IF NOT EXISTS previous_status SMALLINT NOT NULL DEFAULT 0 CHECK (previous_status >= 0)`,
+					`ALTER TABLE domains ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP`,
+				},
+				Down: []string{
+					`ALTER TABLE domains DROP COLUMN IF EXISTS previous_status`,
+					`ALTER TABLE domains DROP COLUMN IF EXISTS deleted_at`,
+				},
+			},
 		},
 	}
 
@@ -16,8 +16,8 @@ import (
 )
 
 func (repo domainRepo) SaveInvitation(ctx context.Context, invitation domains.Invitation) (err error) {
-	q := `INSERT INTO invitations (invited_by, invitee_user_id, domain_id, role_id, created_at)
-		VALUES (:invited_by, :invitee_user_id, :domain_id, :role_id, :created_at)`
+	q := `INSERT INTO invitations (invited_by, invitee_user_id, invitee_email, domain_id, role_id, created_at, token_hash, expires_at)
+		VALUES (:invited_by, :invitee_user_id, :invitee_email, :domain_id, :role_id, :created_at, :token_hash, :expires_at)`
 
 	dbInv := toDBInvitation(invitation)
 	if _, err = repo.db.NamedExecContext(ctx, q, dbInv); err != nil {
@@ -28,7 +28,7 @@ func (repo domainRepo) SaveInvitation(ctx context.Context, invitation domains.In
 }
 
 func (repo domainRepo) RetrieveInvitation(ctx context.Context, inviteeUserID, domainID string) (domains.Invitation, error) {
-	q := `SELECT invited_by, invitee_user_id, domain_id, role_id, created_at, updated_at, confirmed_at, rejected_at FROM invitations WHERE invitee_user_id = :invitee_user_id AND domain_id = :domain_id;`
+	q := `SELECT invited_by, invitee_user_id, invitee_email, domain_id, role_id, created_at, updated_at, confirmed_at, rejected_at, expires_at, resend_count FROM invitations WHERE invitee_user_id = :invitee_user_id AND domain_id = :domain_id;`
 
 	dbinv := dbInvitation{
 		InviteeUserID: inviteeUserID,
@@ -59,6 +59,7 @@ func (repo domainRepo) RetrieveAllInvitations(ctx context.Context, pm domains.In
 		SELECT
 			i.invited_by,
 			i.invitee_user_id,
+			i.invitee_email,
 			i.domain_id,
 			d."name"  AS domain_name,
 			i.role_id,
@@ -66,7 +67,9 @@ func (repo domainRepo) RetrieveAllInvitations(ctx context.Context, pm domains.In
 			i.created_at,
 			i.updated_at,
 			i.confirmed_at,
-			i.rejected_at
+			i.rejected_at,
+			i.expires_at,
+			i.resend_count
 		FROM
 			invitations i
 		LEFT JOIN domains d ON
@@ -172,6 +175,92 @@ func (repo domainRepo) DeleteUsersInvitations(ctx context.Context, domain string
 	return nil
 }
 
+func (repo domainRepo) RetrieveEmailInvitation(ctx context.Context, domainID, email string) (domains.Invitation, error) {
+	q := `SELECT invited_by, invitee_user_id, invitee_email, domain_id, role_id, created_at, updated_at, confirmed_at, rejected_at, expires_at, resend_count
+		FROM invitations WHERE domain_id = :domain_id AND invitee_email = :invitee_email;`
+
+	dbinv := dbInvitation{
+		DomainID:     domainID,
+		InviteeEmail: toNullString(email),
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbinv)
+	if err != nil {
+		return domains.Invitation{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	dbinv = dbInvitation{}
+	if rows.Next() {
+		if err = rows.StructScan(&dbinv); err != nil {
+			return domains.Invitation{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return toInvitation(dbinv), nil
+	}
+
+	return domains.Invitation{}, repoerr.ErrNotFound
+}
+
+func (repo domainRepo) RetrievePendingEmailInvitations(ctx context.Context, email string) ([]domains.Invitation, error) {
+	q := `SELECT invited_by, invitee_user_id, invitee_email, domain_id, role_id, created_at, updated_at, confirmed_at, rejected_at, token_hash, expires_at, resend_count
+		FROM invitations WHERE invitee_email = :invitee_email AND confirmed_at IS NULL AND rejected_at IS NULL AND expires_at > NOW();`
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbInvitation{InviteeEmail: toNullString(email)})
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []domains.Invitation
+	for rows.Next() {
+		var dbinv dbInvitation
+		if err = rows.StructScan(&dbinv); err != nil {
+			return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, toInvitation(dbinv))
+	}
+
+	return items, nil
+}
+
+func (repo domainRepo) UpdateEmailInvitation(ctx context.Context, invitation domains.Invitation) error {
+	q := `UPDATE invitations SET token_hash = :token_hash, expires_at = :expires_at, resend_count = :resend_count, updated_at = :updated_at
+		WHERE invitee_user_id = :invitee_user_id AND domain_id = :domain_id AND invitee_email IS NOT NULL`
+
+	result, err := repo.db.NamedExecContext(ctx, q, toDBInvitation(invitation))
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo domainRepo) ClaimInvitation(ctx context.Context, pendingID string, invitation domains.Invitation) error {
+	q := `UPDATE invitations SET invitee_user_id = :invitee_user_id, confirmed_at = :confirmed_at, updated_at = :updated_at, token_hash = NULL
+		WHERE invitee_user_id = :pending_id AND domain_id = :domain_id AND confirmed_at IS NULL`
+
+	dbinv := toDBInvitation(invitation)
+	params := map[string]any{
+		"invitee_user_id": dbinv.InviteeUserID,
+		"confirmed_at":    dbinv.ConfirmedAt,
+		"updated_at":      dbinv.UpdatedAt,
+		"pending_id":      pendingID,
+		"domain_id":       dbinv.DomainID,
+	}
+	result, err := repo.db.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
 func pageQuery(pm domains.InvitationPageMeta) string {
 	var query []string
 	var emq string
@@ -210,6 +299,7 @@ func pageQuery(pm domains.InvitationPageMeta) string {
 type dbInvitation struct {
 	InvitedBy     string         `db:"invited_by"`
 	InviteeUserID string         `db:"invitee_user_id"`
+	InviteeEmail  sql.NullString `db:"invitee_email,omitempty"`
 	DomainID      string         `db:"domain_id"`
 	DomainName    sql.NullString `db:"domain_name,omitempty"`
 	RoleID        string         `db:"role_id,omitempty"`
@@ -219,10 +309,13 @@ type dbInvitation struct {
 	UpdatedAt     sql.NullTime   `db:"updated_at,omitempty"`
 	ConfirmedAt   sql.NullTime   `db:"confirmed_at,omitempty"`
 	RejectedAt    sql.NullTime   `db:"rejected_at,omitempty"`
+	TokenHash     sql.NullString `db:"token_hash,omitempty"`
+	ExpiresAt     sql.NullTime   `db:"expires_at,omitempty"`
+	ResendCount   int64          `db:"resend_count"`
 }
 
 func toDBInvitation(inv domains.Invitation) dbInvitation {
-	var updatedAt, confirmedAt, rejectedAt sql.NullTime
+	var updatedAt, confirmedAt, rejectedAt, expiresAt sql.NullTime
 	if inv.UpdatedAt != (time.Time{}) {
 		updatedAt = sql.NullTime{Time: inv.UpdatedAt, Valid: true}
 	}
@@ -232,21 +325,28 @@ func toDBInvitation(inv domains.Invitation) dbInvitation {
 	if inv.RejectedAt != (time.Time{}) {
 		rejectedAt = sql.NullTime{Time: inv.RejectedAt, Valid: true}
 	}
+	if inv.ExpiresAt != (time.Time{}) {
+		expiresAt = sql.NullTime{Time: inv.ExpiresAt, Valid: true}
+	}
 
 	return dbInvitation{
 		InvitedBy:     inv.InvitedBy,
 		InviteeUserID: inv.InviteeUserID,
+		InviteeEmail:  toNullString(inv.InviteeEmail),
 		DomainID:      inv.DomainID,
 		RoleID:        inv.RoleID,
 		CreatedAt:     inv.CreatedAt,
 		UpdatedAt:     updatedAt,
 		ConfirmedAt:   confirmedAt,
 		RejectedAt:    rejectedAt,
+		TokenHash:     toNullString(inv.TokenHash),
+		ExpiresAt:     expiresAt,
+		ResendCount:   int64(inv.ResendCount),
 	}
 }
 
 func toInvitation(dbinv dbInvitation) domains.Invitation {
-	var updatedAt, confirmedAt, rejectedAt time.Time
+	var updatedAt, confirmedAt, rejectedAt, expiresAt time.Time
 	if dbinv.UpdatedAt.Valid {
 		updatedAt = dbinv.UpdatedAt.Time
 	}
@@ -256,10 +356,14 @@ func toInvitation(dbinv dbInvitation) domains.Invitation {
 	if dbinv.RejectedAt.Valid {
 		rejectedAt = dbinv.RejectedAt.Time.UTC()
 	}
+	if dbinv.ExpiresAt.Valid {
+		expiresAt = dbinv.ExpiresAt.Time.UTC()
+	}
 
 	return domains.Invitation{
 		InvitedBy:     dbinv.InvitedBy,
 		InviteeUserID: dbinv.InviteeUserID,
+		InviteeEmail:  toString(dbinv.InviteeEmail),
 		DomainID:      dbinv.DomainID,
 		DomainName:    toString(dbinv.DomainName),
 		RoleID:        dbinv.RoleID,
@@ -268,6 +372,9 @@ func toInvitation(dbinv dbInvitation) domains.Invitation {
 		UpdatedAt:     updatedAt,
 		ConfirmedAt:   confirmedAt,
 		RejectedAt:    rejectedAt,
+		TokenHash:     toString(dbinv.TokenHash),
+		ExpiresAt:     expiresAt,
+		ResendCount:   uint64(dbinv.ResendCount),
 	}
 }
 
@@ -277,3 +384,10 @@ func toString(s sql.NullString) string {
 	}
 	return ""
 }
+
+func toNullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
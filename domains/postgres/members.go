@@ -0,0 +1,107 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+func (repo domainRepo) RemoveRoleMembers(ctx context.Context, domainID, roleID string, members, builtInRoles []string, updatedBy string) (removed []roles.EntityMemberRole, err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	params := map[string]any{
+		"domain_id":      domainID,
+		"role_id":        roleID,
+		"member_ids":     members,
+		"built_in_roles": builtInRoles,
+		"updated_at":     time.Now().UTC(),
+		"updated_by":     updatedBy,
+	}
+
+	// The built-in roles are locked, so the concurrent removals
+	// wait for each other to check the remaining members.
+	lq := `SELECT id FROM domains_roles WHERE entity_id = :domain_id AND name = ANY(:built_in_roles) ORDER BY id FOR UPDATE`
+	lrows, err := tx.NamedQuery(lq, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	lrows.Close()
+
+	dq := `DELETE FROM domains_role_members
+		WHERE entity_id = :domain_id AND member_id = ANY(:member_ids) AND (:role_id = '' OR role_id = :role_id)
+		RETURNING entity_id, member_id, role_id`
+	rows, err := tx.NamedQuery(dq, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	roleIDs := []string{}
+	for rows.Next() {
+		dbm := dbEntityMemberRole{}
+		if err := rows.StructScan(&dbm); err != nil {
+			rows.Close()
+			return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+		}
+		removed = append(removed, roles.EntityMemberRole(dbm))
+		roleIDs = append(roleIDs, dbm.RoleID)
+	}
+	rows.Close()
+	if len(removed) == 0 {
+		return nil, tx.Commit()
+	}
+	params["role_ids"] = roleIDs
+
+	cq := `SELECT EXISTS (SELECT 1 FROM domains_roles r
+		WHERE r.id = ANY(:role_ids) AND r.name = ANY(:built_in_roles)
+		AND NOT EXISTS (SELECT 1 FROM domains_role_members m WHERE m.role_id = r.id))`
+	crows, err := tx.NamedQuery(cq, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	var emptied bool
+	if crows.Next() {
+		if err := crows.Scan(&emptied); err != nil {
+			crows.Close()
+			return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+		}
+	}
+	crows.Close()
+	if emptied {
+		return nil, domains.ErrRetainBuiltInRoleMember
+	}
+
+	uq := `UPDATE domains_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = ANY(:role_ids)`
+	if _, err := tx.NamedExecContext(ctx, uq, params); err != nil {
+		return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
+	return removed, nil
+}
+
+type dbEntityMemberRole struct {
+	EntityID string `db:"entity_id"`
+	MemberID string `db:"member_id"`
+	RoleID   string `db:"role_id"`
+}
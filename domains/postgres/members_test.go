@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/domains/postgres"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveRoleMembers(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM domains_roles")
+		require.Nil(t, err, fmt.Sprintf("clean roles unexpected error: %s", err))
+		_, err = db.Exec("DELETE FROM domains")
+		require.Nil(t, err, fmt.Sprintf("clean domains unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	dom := saveDomain(t, repo)
+	admin1, admin2 := testsutil.GenerateUUID(t), testsutil.GenerateUUID(t)
+	member1, member2 := testsutil.GenerateUUID(t), testsutil.GenerateUUID(t)
+	adminRole := saveRole(t, repo, dom.ID, domains.BuiltInRoleAdmin.String(), admin1, admin2)
+	memberRole := saveRole(t, repo, dom.ID, "member", member1, member2)
+	builtInRoles := []string{domains.BuiltInRoleAdmin.String()}
+
+	cases := []struct {
+		desc    string
+		roleID  string
+		members []string
+		removed []roles.EntityMemberRole
+		err     error
+	}{
+		{
+			desc:    "remove member of role",
+			roleID:  memberRole.ID,
+			members: []string{member1},
+			removed: []roles.EntityMemberRole{{EntityID: dom.ID, MemberID: member1, RoleID: memberRole.ID}},
+		},
+		{
+			desc:    "remove member of other role",
+			roleID:  adminRole.ID,
+			members: []string{member2},
+		},
+		{
+			desc:    "remove non-existing member",
+			members: []string{testsutil.GenerateUUID(t)},
+		},
+		{
+			desc:    "remove all admins",
+			members: []string{admin1, admin2, member2},
+			err:     domains.ErrRetainBuiltInRoleMember,
+		},
+		{
+			desc:    "remove admin while another admin remains",
+			members: []string{admin1, member2},
+			removed: []roles.EntityMemberRole{
+				{EntityID: dom.ID, MemberID: admin1, RoleID: adminRole.ID},
+				{EntityID: dom.ID, MemberID: member2, RoleID: memberRole.ID},
+			},
+		},
+		{
+			desc:    "remove last admin",
+			roleID:  adminRole.ID,
+			members: []string{admin2},
+			err:     domains.ErrRetainBuiltInRoleMember,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			removed, err := repo.RemoveRoleMembers(context.Background(), dom.ID, tc.roleID, tc.members, builtInRoles, userID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.ElementsMatch(t, tc.removed, removed, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.removed, removed))
+		})
+	}
+
+	mp, err := repo.RoleListMembers(context.Background(), adminRole.ID, 10, 0)
+	require.Nil(t, err, fmt.Sprintf("list members unexpected error: %s", err))
+	assert.Equal(t, []string{admin2}, mp.Members, fmt.Sprintf("expected remaining admin %s got %v\n", admin2, mp.Members))
+}
+
+func saveRole(t *testing.T, repo domains.Repository, domainID, name string, members ...string) roles.Role {
+	rp := roles.RoleProvision{
+		Role: roles.Role{
+			ID:        testsutil.GenerateUUID(t),
+			Name:      name,
+			EntityID:  domainID,
+			CreatedBy: userID,
+			CreatedAt: time.Now().UTC(),
+		},
+		OptionalMembers: members,
+	}
+
+	_, err := repo.AddRoles(context.Background(), []roles.RoleProvision{rp})
+	require.Nil(t, err, fmt.Sprintf("failed to save role %s", rp.ID))
+
+	return rp.Role
+}
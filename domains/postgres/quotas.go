@@ -0,0 +1,155 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+)
+
+var errInvalidResource = errors.New("invalid quota resource")
+
+func (repo domainRepo) SaveQuotas(ctx context.Context, domainID string, q domains.Quotas) error {
+	query := `INSERT INTO domain_quotas (domain_id, clients, channels, groups, invitations, message_rate)
+		VALUES (:domain_id, :clients, :channels, :groups, :invitations, :message_rate)
+		ON CONFLICT (domain_id) DO UPDATE SET clients = EXCLUDED.clients, channels = EXCLUDED.channels,
+		groups = EXCLUDED.groups, invitations = EXCLUDED.invitations, message_rate = EXCLUDED.message_rate`
+
+	dbq := toDBQuotas(domainID, q)
+	if _, err := repo.db.NamedExecContext(ctx, query, dbq); err != nil {
+		return repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo domainRepo) RetrieveQuotas(ctx context.Context, domainID string) (domains.Quotas, error) {
+	q := `SELECT domain_id, clients, channels, groups, invitations, message_rate FROM domain_quotas WHERE domain_id = :domain_id`
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbQuotas{DomainID: domainID})
+	if err != nil {
+		return domains.Quotas{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	dbq := dbQuotas{}
+	if rows.Next() {
+		if err := rows.StructScan(&dbq); err != nil {
+			return domains.Quotas{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return toQuotas(dbq), nil
+	}
+
+	return domains.Quotas{}, repoerr.ErrNotFound
+}
+
+func (repo domainRepo) RetrieveUsage(ctx context.Context, domainID string) (domains.Usage, error) {
+	q := `SELECT COALESCE(u.clients, 0) AS clients, COALESCE(u.channels, 0) AS channels, COALESCE(u.groups, 0) AS groups,
+		(SELECT COUNT(*) FROM invitations i WHERE i.domain_id = d.id AND i.confirmed_at IS NULL AND i.rejected_at IS NULL
+			AND (i.expires_at IS NULL OR i.expires_at > NOW())) AS invitations
+		FROM domains d LEFT JOIN domain_usage u ON u.domain_id = d.id
+		WHERE d.id = $1`
+
+	var dbu dbUsage
+	if err := repo.db.QueryRowxContext(ctx, q, domainID).StructScan(&dbu); err != nil {
+		if err == sql.ErrNoRows {
+			return domains.Usage{}, repoerr.ErrNotFound
+		}
+		return domains.Usage{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return domains.Usage{
+		Clients:     uint64(dbu.Clients),
+		Channels:    uint64(dbu.Channels),
+		Groups:      uint64(dbu.Groups),
+		Invitations: uint64(dbu.Invitations),
+	}, nil
+}
+
+func (repo domainRepo) UpdateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64, limit uint64) (bool, error) {
+	if !resource.Counted() {
+		return false, errors.Wrap(repoerr.ErrMalformedEntity, errInvalidResource)
+	}
+	// The column name is one of the known resources, so it's safe to use in the query.
+	col := string(resource)
+
+	insert := `INSERT INTO domain_usage (domain_id) VALUES ($1) ON CONFLICT (domain_id) DO NOTHING`
+	if _, err := repo.db.ExecContext(ctx, insert, domainID); err != nil {
+		return false, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	// Released resources never make the usage negative, and the limit
+	// is checked only when the resources are reserved.
+	q := fmt.Sprintf(`UPDATE domain_usage SET %[1]s = GREATEST(%[1]s + $2, 0)
+		WHERE domain_id = $1 AND ($2 <= 0 OR $3 = 0 OR %[1]s + $2 <= $3)`, col)
+
+	res, err := repo.db.ExecContext(ctx, q, domainID, delta, int64(limit))
+	if err != nil {
+		return false, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return rows > 0, nil
+}
+
+func (repo domainRepo) SetUsage(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	if !resource.Counted() {
+		return errors.Wrap(repoerr.ErrMalformedEntity, errInvalidResource)
+	}
+	// The column name is one of the known resources, so it's safe to use in the query.
+	q := fmt.Sprintf(`INSERT INTO domain_usage (domain_id, %[1]s) VALUES ($1, $2)
+		ON CONFLICT (domain_id) DO UPDATE SET %[1]s = EXCLUDED.%[1]s`, string(resource))
+
+	if _, err := repo.db.ExecContext(ctx, q, domainID, int64(n)); err != nil {
+		return repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+type dbQuotas struct {
+	DomainID    string `db:"domain_id"`
+	Clients     int64  `db:"clients"`
+	Channels    int64  `db:"channels"`
+	Groups      int64  `db:"groups"`
+	Invitations int64  `db:"invitations"`
+	MessageRate int64  `db:"message_rate"`
+}
+
+type dbUsage struct {
+	Clients     int64 `db:"clients"`
+	Channels    int64 `db:"channels"`
+	Groups      int64 `db:"groups"`
+	Invitations int64 `db:"invitations"`
+}
+
+func toDBQuotas(domainID string, q domains.Quotas) dbQuotas {
+	return dbQuotas{
+		DomainID:    domainID,
+		Clients:     int64(q.Clients),
+		Channels:    int64(q.Channels),
+		Groups:      int64(q.Groups),
+		Invitations: int64(q.Invitations),
+		MessageRate: int64(q.MessageRate),
+	}
+}
+
+func toQuotas(dbq dbQuotas) domains.Quotas {
+	return domains.Quotas{
+		Clients:     uint64(dbq.Clients),
+		Channels:    uint64(dbq.Channels),
+		Groups:      uint64(dbq.Groups),
+		Invitations: uint64(dbq.Invitations),
+		MessageRate: uint64(dbq.MessageRate),
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/pkg/roles"
+)
+
+func (repo domainRepo) RetrieveMemberRole(ctx context.Context, domainID, memberID string) (roles.Role, error) {
+	q := `SELECT r.id, r.name, r.entity_id FROM domains_roles r
+		JOIN domains_role_members m ON m.role_id = r.id
+		WHERE r.entity_id = :domain_id AND m.member_id = :member_id`
+
+	params := map[string]any{
+		"domain_id": domainID,
+		"member_id": memberID,
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return roles.Role{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		dbr := dbMemberRole{}
+		if err := rows.StructScan(&dbr); err != nil {
+			return roles.Role{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return roles.Role{ID: dbr.ID, Name: dbr.Name, EntityID: dbr.EntityID}, nil
+	}
+
+	return roles.Role{}, repoerr.ErrNotFound
+}
+
+func (repo domainRepo) SaveTransfer(ctx context.Context, t domains.Transfer) error {
+	// Only the expired transfer is replaced, the pending one is kept
+	// until it's accepted or rejected by the users taking part in it.
+	q := `INSERT INTO domain_transfers (domain_id, from_user_id, to_user_id, created_at, expires_at)
+		VALUES (:domain_id, :from_user_id, :to_user_id, :created_at, :expires_at)
+		ON CONFLICT (domain_id) DO UPDATE SET from_user_id = EXCLUDED.from_user_id,
+		to_user_id = EXCLUDED.to_user_id, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE domain_transfers.expires_at <= EXCLUDED.created_at`
+
+	res, err := repo.db.NamedExecContext(ctx, q, dbTransfer(t))
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrConflict
+	}
+
+	return nil
+}
+
+func (repo domainRepo) RetrieveTransfer(ctx context.Context, domainID string) (domains.Transfer, error) {
+	q := `SELECT domain_id, from_user_id, to_user_id, created_at, expires_at FROM domain_transfers WHERE domain_id = :domain_id`
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbTransfer{DomainID: domainID})
+	if err != nil {
+		return domains.Transfer{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		dbt := dbTransfer{}
+		if err := rows.StructScan(&dbt); err != nil {
+			return domains.Transfer{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+
+		return domains.Transfer(dbt), nil
+	}
+
+	return domains.Transfer{}, repoerr.ErrNotFound
+}
+
+func (repo domainRepo) DeleteTransfer(ctx context.Context, domainID string) error {
+	q := `DELETE FROM domain_transfers WHERE domain_id = $1`
+
+	res, err := repo.db.ExecContext(ctx, q, domainID)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo domainRepo) CompleteTransfer(ctx context.Context, t domains.Transfer, adminRole, memberRole roles.Role) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+			}
+		}
+	}()
+
+	// The member can hold a single role of the domain, so both users
+	// are removed from their roles before they are added to the new ones.
+	dq := `DELETE FROM domains_role_members WHERE entity_id = :domain_id AND member_id IN (:from_user_id, :to_user_id)`
+	res, err := tx.NamedExecContext(ctx, dq, dbTransfer(t))
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows != 2 {
+		return repoerr.ErrNotFound
+	}
+
+	iq := `INSERT INTO domains_role_members (role_id, entity_id, member_id) VALUES (:role_id, :entity_id, :member_id)`
+	mems := []dbTransferMember{
+		{RoleID: adminRole.ID, EntityID: t.DomainID, MemberID: t.ToUserID},
+		{RoleID: memberRole.ID, EntityID: t.DomainID, MemberID: t.FromUserID},
+	}
+	if _, err := tx.NamedExecContext(ctx, iq, mems); err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	uq := `UPDATE domains_roles SET updated_at = :updated_at, updated_by = :updated_by WHERE id = ANY(:ids)`
+	params := map[string]any{
+		"updated_at": time.Now().UTC(),
+		"updated_by": t.ToUserID,
+		"ids":        []string{adminRole.ID, memberRole.ID},
+	}
+	if _, err := tx.NamedExecContext(ctx, uq, params); err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM domain_transfers WHERE domain_id = $1`, t.DomainID); err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+type dbTransfer struct {
+	DomainID   string    `db:"domain_id"`
+	FromUserID string    `db:"from_user_id"`
+	ToUserID   string    `db:"to_user_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+}
+
+type dbMemberRole struct {
+	ID       string `db:"id"`
+	Name     string `db:"name"`
+	EntityID string `db:"entity_id"`
+}
+
+type dbTransferMember struct {
+	RoleID   string `db:"role_id"`
+	EntityID string `db:"entity_id"`
+	MemberID string `db:"member_id"`
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/domains/postgres"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveTransfer(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM domain_transfers")
+		require.Nil(t, err, fmt.Sprintf("clean transfers unexpected error: %s", err))
+		_, err = db.Exec("DELETE FROM domains")
+		require.Nil(t, err, fmt.Sprintf("clean domains unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	dom := saveDomain(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	pending := domains.Transfer{
+		DomainID:   dom.ID,
+		FromUserID: userID,
+		ToUserID:   testsutil.GenerateUUID(t),
+		CreatedAt:  now.Add(-2 * time.Hour),
+		ExpiresAt:  now.Add(-time.Hour),
+	}
+
+	cases := []struct {
+		desc     string
+		transfer domains.Transfer
+		err      error
+	}{
+		{
+			desc:     "save transfer successfully",
+			transfer: pending,
+		},
+		{
+			desc: "save transfer replacing expired transfer",
+			transfer: domains.Transfer{
+				DomainID:   dom.ID,
+				FromUserID: userID,
+				ToUserID:   testsutil.GenerateUUID(t),
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+		},
+		{
+			desc: "save transfer with pending transfer",
+			transfer: domains.Transfer{
+				DomainID:   dom.ID,
+				FromUserID: testsutil.GenerateUUID(t),
+				ToUserID:   testsutil.GenerateUUID(t),
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+			err: repoerr.ErrConflict,
+		},
+		{
+			desc: "save transfer of non-existing domain",
+			transfer: domains.Transfer{
+				DomainID:   testsutil.GenerateUUID(t),
+				FromUserID: userID,
+				ToUserID:   testsutil.GenerateUUID(t),
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.SaveTransfer(context.Background(), tc.transfer)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				saved, err := repo.RetrieveTransfer(context.Background(), tc.transfer.DomainID)
+				require.Nil(t, err, fmt.Sprintf("%s: retrieve transfer unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.transfer.ToUserID, saved.ToUserID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.transfer.ToUserID, saved.ToUserID))
+				assert.True(t, tc.transfer.ExpiresAt.Equal(saved.ExpiresAt), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.transfer.ExpiresAt, saved.ExpiresAt))
+			}
+		})
+	}
+}
@@ -42,6 +42,86 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// ClaimInvitations provides a mock function for the type Service
+func (_mock *Service) ClaimInvitations(ctx context.Context, userID string, email string, token string) ([]domains.Invitation, error) {
+	ret := _mock.Called(ctx, userID, email, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimInvitations")
+	}
+
+	var r0 []domains.Invitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]domains.Invitation, error)); ok {
+		return returnFunc(ctx, userID, email, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []domains.Invitation); ok {
+		r0 = returnFunc(ctx, userID, email, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Invitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, email, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ClaimInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimInvitations'
+type Service_ClaimInvitations_Call struct {
+	*mock.Call
+}
+
+// ClaimInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+//   - token string
+func (_e *Service_Expecter) ClaimInvitations(ctx interface{}, userID interface{}, email interface{}, token interface{}) *Service_ClaimInvitations_Call {
+	return &Service_ClaimInvitations_Call{Call: _e.mock.On("ClaimInvitations", ctx, userID, email, token)}
+}
+
+func (_c *Service_ClaimInvitations_Call) Run(run func(ctx context.Context, userID string, email string, token string)) *Service_ClaimInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ClaimInvitations_Call) Return(invitations []domains.Invitation, err error) *Service_ClaimInvitations_Call {
+	_c.Call.Return(invitations, err)
+	return _c
+}
+
+func (_c *Service_ClaimInvitations_Call) RunAndReturn(run func(ctx context.Context, userID string, email string, token string) ([]domains.Invitation, error)) *Service_ClaimInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserFromDomains provides a mock function for the type Service
 func (_mock *Service) DeleteUserFromDomains(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
@@ -165,6 +245,72 @@ func (_c *Service_RetrieveIDByRoute_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// RetrieveQuotas provides a mock function for the type Service
+func (_mock *Service) RetrieveQuotas(ctx context.Context, domainID string) (domains.Quotas, error) {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveQuotas")
+	}
+
+	var r0 domains.Quotas
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domains.Quotas, error)); ok {
+		return returnFunc(ctx, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domains.Quotas); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Get(0).(domains.Quotas)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveQuotas'
+type Service_RetrieveQuotas_Call struct {
+	*mock.Call
+}
+
+// RetrieveQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Service_Expecter) RetrieveQuotas(ctx interface{}, domainID interface{}) *Service_RetrieveQuotas_Call {
+	return &Service_RetrieveQuotas_Call{Call: _e.mock.On("RetrieveQuotas", ctx, domainID)}
+}
+
+func (_c *Service_RetrieveQuotas_Call) Run(run func(ctx context.Context, domainID string)) *Service_RetrieveQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveQuotas_Call) Return(quotas domains.Quotas, err error) *Service_RetrieveQuotas_Call {
+	_c.Call.Return(quotas, err)
+	return _c
+}
+
+func (_c *Service_RetrieveQuotas_Call) RunAndReturn(run func(ctx context.Context, domainID string) (domains.Quotas, error)) *Service_RetrieveQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveStatus provides a mock function for the type Service
 func (_mock *Service) RetrieveStatus(ctx context.Context, id string) (domains.Status, error) {
 	ret := _mock.Called(ctx, id)
@@ -230,3 +376,141 @@ func (_c *Service_RetrieveStatus_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetUsage provides a mock function for the type Service
+func (_mock *Service) SetUsage(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	ret := _mock.Called(ctx, domainID, resource, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, uint64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_SetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUsage'
+type Service_SetUsage_Call struct {
+	*mock.Call
+}
+
+// SetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - n uint64
+func (_e *Service_Expecter) SetUsage(ctx interface{}, domainID interface{}, resource interface{}, n interface{}) *Service_SetUsage_Call {
+	return &Service_SetUsage_Call{Call: _e.mock.On("SetUsage", ctx, domainID, resource, n)}
+}
+
+func (_c *Service_SetUsage_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64)) *Service_SetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_SetUsage_Call) Return(err error) *Service_SetUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_SetUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64) error) *Service_SetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUsage provides a mock function for the type Service
+func (_mock *Service) UpdateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64) error {
+	ret := _mock.Called(ctx, domainID, resource, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, int64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_UpdateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsage'
+type Service_UpdateUsage_Call struct {
+	*mock.Call
+}
+
+// UpdateUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - delta int64
+func (_e *Service_Expecter) UpdateUsage(ctx interface{}, domainID interface{}, resource interface{}, delta interface{}) *Service_UpdateUsage_Call {
+	return &Service_UpdateUsage_Call{Call: _e.mock.On("UpdateUsage", ctx, domainID, resource, delta)}
+}
+
+func (_c *Service_UpdateUsage_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, delta int64)) *Service_UpdateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateUsage_Call) Return(err error) *Service_UpdateUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_UpdateUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, delta int64) error) *Service_UpdateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,32 +5,55 @@ package private
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
 )
 
 const defLimit = 100
 
+var errRollbackPolicies = errors.New("failed to rollback policies")
+
 type Service interface {
 	RetrieveStatus(ctx context.Context, id string) (domains.Status, error)
 	DeleteUserFromDomains(ctx context.Context, id string) error
 	RetrieveIDByRoute(ctx context.Context, route string) (string, error)
+	// UpdateUsage reserves the number of the domain resources given by the positive
+	// delta and releases them for the negative one. The reservation fails with
+	// ErrQuotaExceeded if it would exceed the domain quota.
+	UpdateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64) error
+	// SetUsage sets the usage of the domain resource to the number of the
+	// resources in use, which reconciles the usage with the resources.
+	SetUsage(ctx context.Context, domainID string, resource domains.Resource, n uint64) error
+	// RetrieveQuotas returns the quotas of the domain.
+	RetrieveQuotas(ctx context.Context, domainID string) (domains.Quotas, error)
+	// ClaimInvitations binds the pending invitations sent to the email to the
+	// user and accepts them. The token received in the invitation email proves
+	// that the user owns the email; the empty token is used only if the email
+	// is already verified by the caller.
+	ClaimInvitations(ctx context.Context, userID, email, token string) ([]domains.Invitation, error)
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo domains.Repository, cache domains.Cache) Service {
+func New(repo domains.Repository, cache domains.Cache, policy policies.Service, quotas domains.Quotas) Service {
 	return service{
-		repo:  repo,
-		cache: cache,
+		repo:   repo,
+		cache:  cache,
+		policy: policy,
+		quotas: quotas,
 	}
 }
 
 type service struct {
-	repo  domains.Repository
-	cache domains.Cache
+	repo   domains.Repository
+	cache  domains.Cache
+	policy policies.Service
+	quotas domains.Quotas
 }
 
 func (svc service) RetrieveStatus(ctx context.Context, id string) (domains.Status, error) {
@@ -85,3 +108,105 @@ func (svc service) RetrieveIDByRoute(ctx context.Context, route string) (string,
 
 	return dom.ID, nil
 }
+
+func (svc service) UpdateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64) error {
+	var limit uint64
+	// Releasing the resources is never limited.
+	if delta > 0 {
+		q, _, err := domains.DomainQuotas(ctx, svc.repo, domainID, svc.quotas)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		limit = q.Limit(resource)
+	}
+	ok, err := svc.repo.UpdateUsage(ctx, domainID, resource, delta, limit)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	if !ok {
+		return svcerr.ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+func (svc service) SetUsage(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	if err := svc.repo.SetUsage(ctx, domainID, resource, n); err != nil {
+		return errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (svc service) RetrieveQuotas(ctx context.Context, domainID string) (domains.Quotas, error) {
+	q, _, err := domains.DomainQuotas(ctx, svc.repo, domainID, svc.quotas)
+	if err != nil {
+		return domains.Quotas{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return q, nil
+}
+
+func (svc service) ClaimInvitations(ctx context.Context, userID, email, token string) ([]domains.Invitation, error) {
+	invs, err := svc.repo.RetrievePendingEmailInvitations(ctx, email)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if token != "" {
+		hash := domains.HashInvitationToken(token)
+		if !slices.ContainsFunc(invs, func(inv domains.Invitation) bool { return inv.TokenHash == hash }) {
+			return nil, svcerr.ErrAuthentication
+		}
+	}
+
+	claimed := []domains.Invitation{}
+	for _, inv := range invs {
+		if err := svc.addRoleMember(ctx, inv, userID); err != nil {
+			return claimed, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+		pendingID := inv.InviteeUserID
+		inv.InviteeUserID = userID
+		inv.ConfirmedAt = time.Now().UTC()
+		inv.UpdatedAt = inv.ConfirmedAt
+		inv.TokenHash = ""
+		if err := svc.repo.ClaimInvitation(ctx, pendingID, inv); err != nil {
+			return claimed, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+		claimed = append(claimed, inv)
+	}
+
+	return claimed, nil
+}
+
+func (svc service) addRoleMember(ctx context.Context, inv domains.Invitation, userID string) (err error) {
+	ro, err := svc.repo.RetrieveEntityRole(ctx, inv.DomainID, inv.RoleID)
+	if err != nil {
+		return err
+	}
+
+	prs := []policies.Policy{{
+		SubjectType: policies.UserType,
+		Subject:     policies.EncodeDomainUserID(inv.DomainID, userID),
+		Relation:    policies.MemberRelation,
+		Object:      ro.ID,
+		ObjectType:  policies.RoleType,
+	}}
+	if err := svc.policy.AddPolicies(ctx, prs); err != nil {
+		return errors.Wrap(svcerr.ErrAddPolicies, err)
+	}
+	defer func() {
+		if err != nil {
+			if errRollback := svc.policy.DeletePolicies(ctx, prs); errRollback != nil {
+				err = errors.Wrap(err, errors.Wrap(errRollbackPolicies, errRollback))
+			}
+		}
+	}()
+
+	ro.UpdatedAt = time.Now().UTC()
+	ro.UpdatedBy = inv.InvitedBy
+	if _, err := svc.repo.RoleAddMembers(ctx, ro, []string{userID}); err != nil {
+		return err
+	}
+
+	return nil
+}
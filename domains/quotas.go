@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+)
+
+// Resource represents the domain resource limited by the quotas.
+type Resource string
+
+// Possible Resource values.
+const (
+	ClientsResource     Resource = "clients"
+	ChannelsResource    Resource = "channels"
+	GroupsResource      Resource = "groups"
+	InvitationsResource Resource = "invitations"
+)
+
+// Counted returns true if the usage of the resource is counted
+// by the services which create the resource.
+func (r Resource) Counted() bool {
+	switch r {
+	case ClientsResource, ChannelsResource, GroupsResource:
+		return true
+	default:
+		return false
+	}
+}
+
+// Quotas are the limits of the domain resources. The zero limit means
+// the resource is not limited.
+type Quotas struct {
+	Clients     uint64 `json:"clients"`
+	Channels    uint64 `json:"channels"`
+	Groups      uint64 `json:"groups"`
+	Invitations uint64 `json:"invitations"`
+	// MessageRate is the number of messages per second
+	// the domain clients are allowed to publish.
+	MessageRate uint64 `json:"message_rate"`
+}
+
+// Limit returns the limit of the resource.
+func (q Quotas) Limit(r Resource) uint64 {
+	switch r {
+	case ClientsResource:
+		return q.Clients
+	case ChannelsResource:
+		return q.Channels
+	case GroupsResource:
+		return q.Groups
+	case InvitationsResource:
+		return q.Invitations
+	default:
+		return 0
+	}
+}
+
+// Usage is the number of the domain resources in use.
+// Pending invitations are counted as the invitations in use.
+type Usage struct {
+	Clients     uint64 `json:"clients"`
+	Channels    uint64 `json:"channels"`
+	Groups      uint64 `json:"groups"`
+	Invitations uint64 `json:"invitations"`
+}
+
+// QuotasUsage is the domain quotas together with the domain usage.
+type QuotasUsage struct {
+	DomainID string `json:"domain_id"`
+	// Default is set if the domain uses the platform default quotas.
+	Default bool   `json:"default"`
+	Quotas  Quotas `json:"quotas"`
+	Usage   Usage  `json:"usage"`
+}
+
+// DomainQuotas returns the quotas of the domain. The default quotas are
+// returned if the domain has no quotas of its own, which is reported by
+// the returned flag.
+func DomainQuotas(ctx context.Context, repo Repository, domainID string, defaults Quotas) (Quotas, bool, error) {
+	q, err := repo.RetrieveQuotas(ctx, domainID)
+	switch {
+	case err == nil:
+		return q, false, nil
+	case errors.Contains(err, repoerr.ErrNotFound):
+		return defaults, true, nil
+	default:
+		return Quotas{}, false, err
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/absmach/supermq"
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	smqauth "github.com/absmach/supermq/auth"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
@@ -19,6 +21,11 @@ import (
 var (
 	errCreateDomainPolicy = errors.New("failed to create domain policy")
 	errRollbackRepo       = errors.New("failed to rollback repo")
+	errArchiveVersion     = errors.New("unsupported archive version")
+	errRollbackRoles      = errors.New("failed to rollback roles")
+	errIssueInvitation    = errors.New("failed to issue invitation token")
+	errSendInvitation     = errors.New("failed to send invitation email")
+	errRollbackPolicies   = errors.New("failed to rollback policies")
 )
 
 type service struct {
@@ -26,27 +33,57 @@ type service struct {
 	cache      Cache
 	policy     policies.Service
 	idProvider supermq.IDProvider
+	token      grpcTokenV1.TokenServiceClient
+	email      Emailer
+	// invitationDuration is the validity of the invitations sent to the
+	// emails, and maxResends is the number of times they can be resent.
+	invitationDuration time.Duration
+	maxResends         uint64
+	// transferDuration is the validity of the ownership transfers.
+	transferDuration time.Duration
+	// quotas are the platform default quotas of the domains
+	// which have no quotas of their own.
+	quotas Quotas
+	// builtInRoles are the names of the built-in roles,
+	// which must retain at least one member.
+	builtInRoles []string
 	roles.ProvisionManageService
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo Repository, cache Cache, policy policies.Service, idProvider supermq.IDProvider, sidProvider supermq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func New(repo Repository, cache Cache, policy policies.Service, idProvider supermq.IDProvider, sidProvider supermq.IDProvider, token grpcTokenV1.TokenServiceClient, emailer Emailer, invitationDuration time.Duration, maxResends uint64, transferDuration time.Duration, quotas Quotas, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(policies.DomainType, repo, policy, sidProvider, availableActions, builtInRoles)
 	if err != nil {
 		return nil, err
 	}
 
+	names := make([]string, 0, len(builtInRoles))
+	for name := range builtInRoles {
+		names = append(names, name.String())
+	}
+
 	return &service{
 		repo:                   repo,
 		cache:                  cache,
 		policy:                 policy,
 		idProvider:             idProvider,
+		token:                  token,
+		email:                  emailer,
+		invitationDuration:     invitationDuration,
+		maxResends:             maxResends,
+		transferDuration:       transferDuration,
+		quotas:                 quotas,
+		builtInRoles:           names,
 		ProvisionManageService: rpms,
 	}, nil
 }
 
-func (svc service) CreateDomain(ctx context.Context, session authn.Session, d Domain) (retDo Domain, retRps []roles.RoleProvision, retErr error) {
+func (svc service) CreateDomain(ctx context.Context, session authn.Session, d Domain) (Domain, []roles.RoleProvision, error) {
+	return svc.createDomain(ctx, session, d, []roles.Member{roles.Member(session.UserID)})
+}
+
+func (svc service) createDomain(ctx context.Context, session authn.Session, d Domain, admins []roles.Member) (retDo Domain, retRps []roles.RoleProvision, retErr error) {
 	d.CreatedBy = session.UserID
 
 	if d.ID == "" {
@@ -80,7 +117,7 @@ func (svc service) CreateDomain(ctx context.Context, session authn.Session, d Do
 	}()
 
 	newBuiltInRoleMembers := map[roles.BuiltInRoleName][]roles.Member{
-		BuiltInRoleAdmin: {roles.Member(session.UserID)},
+		BuiltInRoleAdmin: admins,
 	}
 
 	optionalPolicies := []policies.Policy{
@@ -175,6 +212,214 @@ func (svc service) FreezeDomain(ctx context.Context, session authn.Session, id s
 	return dom, nil
 }
 
+func (svc service) DeleteDomain(ctx context.Context, session authn.Session, id string) (Domain, error) {
+	// The domain is removed once the grace period since its deletion passes,
+	// so deleting it again must not restart the grace period.
+	dom, err := svc.repo.MarkDomainDeleted(ctx, id, session.UserID, time.Now().UTC())
+	if err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			if _, err := svc.repo.RetrieveDomainByID(ctx, id); err != nil {
+				return Domain{}, errors.Wrap(svcerr.ErrViewEntity, err)
+			}
+			return Domain{}, svcerr.ErrInvalidStatus
+		}
+		return Domain{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+		return dom, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return dom, nil
+}
+
+func (svc service) RestoreDomain(ctx context.Context, session authn.Session, id string) (Domain, error) {
+	dom, err := svc.repo.RetrieveDomainByID(ctx, id)
+	if err != nil {
+		return Domain{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if dom.Status != DeletedStatus {
+		return Domain{}, svcerr.ErrInvalidStatus
+	}
+
+	status := dom.PreviousStatus
+	updatedAt := time.Now().UTC()
+	dom, err = svc.repo.UpdateDomain(ctx, id, DomainReq{Status: &status, UpdatedBy: &session.UserID, UpdatedAt: &updatedAt})
+	if err != nil {
+		return Domain{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+		return dom, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return dom, nil
+}
+
+func (svc service) ExportDomain(ctx context.Context, session authn.Session, id string) (Archive, error) {
+	dom, err := svc.repo.RetrieveDomainByID(ctx, id)
+	if err != nil {
+		return Archive{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	archive := Archive{
+		Version:    ArchiveVersion,
+		ExportedAt: time.Now().UTC(),
+		Domain:     dom,
+	}
+	for offset := uint64(0); ; {
+		rp, err := svc.repo.RetrieveAllRoles(ctx, id, defLimit, offset)
+		if err != nil {
+			return Archive{}, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		for _, r := range rp.Roles {
+			ar, err := svc.archiveRole(ctx, r)
+			if err != nil {
+				return Archive{}, errors.Wrap(svcerr.ErrViewEntity, err)
+			}
+			archive.Roles = append(archive.Roles, ar)
+		}
+		offset += uint64(len(rp.Roles))
+		if len(rp.Roles) == 0 || offset >= rp.Total {
+			break
+		}
+	}
+
+	return archive, nil
+}
+
+func (svc service) archiveRole(ctx context.Context, r roles.Role) (ArchiveRole, error) {
+	actions, err := svc.repo.RoleListActions(ctx, r.ID)
+	if err != nil {
+		return ArchiveRole{}, err
+	}
+	ar := ArchiveRole{
+		ID:      r.ID,
+		Name:    r.Name,
+		Actions: actions,
+	}
+	for offset := uint64(0); ; {
+		mp, err := svc.repo.RoleListMembers(ctx, r.ID, defLimit, offset)
+		if err != nil {
+			return ArchiveRole{}, err
+		}
+		ar.Members = append(ar.Members, mp.Members...)
+		offset += uint64(len(mp.Members))
+		if len(mp.Members) == 0 || offset >= mp.Total {
+			break
+		}
+	}
+
+	return ar, nil
+}
+
+func (svc service) ImportDomain(ctx context.Context, session authn.Session, archive Archive, preserveID bool) (retDo Domain, retRps []roles.RoleProvision, retErr error) {
+	if archive.Version != ArchiveVersion {
+		return Domain{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrMalformedEntity, errArchiveVersion)
+	}
+
+	d := Domain{
+		Name:     archive.Domain.Name,
+		Route:    archive.Domain.Route,
+		Tags:     archive.Domain.Tags,
+		Metadata: archive.Domain.Metadata,
+		Status:   EnabledStatus,
+	}
+	if archive.Domain.Status == DisabledStatus {
+		d.Status = DisabledStatus
+	}
+	if preserveID {
+		d.ID = archive.Domain.ID
+	}
+
+	// The archive can't prove the consent of the archived members, so only the
+	// importer joins the domain, while the other members are invited to their
+	// roles. The built-in role is created with the domain, the other roles are recreated.
+	var adminMembers []string
+	var archiveRoles []ArchiveRole
+	for _, r := range archive.Roles {
+		if r.Name != BuiltInRoleAdmin.String() {
+			archiveRoles = append(archiveRoles, r)
+			continue
+		}
+		adminMembers = append(adminMembers, r.Members...)
+	}
+
+	dom, rps, err := svc.createDomain(ctx, session, d, []roles.Member{roles.Member(session.UserID)})
+	if err != nil {
+		return Domain{}, []roles.RoleProvision{}, err
+	}
+	defer func() {
+		if retErr != nil {
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.DomainType,
+					Subject:     dom.ID,
+				},
+				{
+					ObjectType: policies.DomainType,
+					Object:     dom.ID,
+				},
+			}
+			if errRollback := svc.RemoveEntitiesRoles(ctx, dom.ID, session.UserID, []string{dom.ID}, filterDeletePolicies, nil); errRollback != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errRollbackRoles, errRollback))
+			}
+			if errRollback := svc.repo.DeleteDomain(ctx, dom.ID); errRollback != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errRollbackRepo, errRollback))
+			}
+		}
+	}()
+
+	// The user holds a single role in the domain, so the user listed in
+	// several roles is invited to the first one, starting with the built-in role.
+	invited := map[string]bool{session.UserID: true}
+	for _, rp := range rps {
+		if rp.Name == BuiltInRoleAdmin.String() {
+			if err := svc.inviteArchivedMembers(ctx, session, dom, rp.Role, adminMembers, invited); err != nil {
+				return Domain{}, []roles.RoleProvision{}, err
+			}
+		}
+	}
+
+	session.DomainID = dom.ID
+	for _, r := range archiveRoles {
+		rp, err := svc.AddRole(ctx, session, dom.ID, r.Name, r.Actions, nil)
+		if err != nil {
+			return Domain{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+		if err := svc.inviteArchivedMembers(ctx, session, dom, rp.Role, r.Members, invited); err != nil {
+			return Domain{}, []roles.RoleProvision{}, err
+		}
+		rps = append(rps, rp)
+	}
+
+	return dom, rps, nil
+}
+
+func (svc service) inviteArchivedMembers(ctx context.Context, session authn.Session, dom Domain, role roles.Role, members []string, invited map[string]bool) error {
+	for _, m := range members {
+		if invited[m] {
+			continue
+		}
+		invited[m] = true
+		if err := svc.checkInvitationsQuota(ctx, dom.ID); err != nil {
+			return err
+		}
+		inv := Invitation{
+			InvitedBy:     session.UserID,
+			InviteeUserID: m,
+			DomainID:      dom.ID,
+			DomainName:    dom.Name,
+			RoleID:        role.ID,
+			RoleName:      role.Name,
+			CreatedAt:     time.Now().UTC(),
+		}
+		if err := svc.repo.SaveInvitation(ctx, inv); err != nil {
+			return errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+	}
+
+	return nil
+}
+
 func (svc service) ListDomains(ctx context.Context, session authn.Session, p Page) (DomainsPage, error) {
 	p.UserID = session.UserID
 	if session.SuperAdmin {
@@ -188,6 +433,178 @@ func (svc service) ListDomains(ctx context.Context, session authn.Session, p Pag
 	return dp, nil
 }
 
+func (svc service) RetrieveUsage(ctx context.Context, session authn.Session, id string) (QuotasUsage, error) {
+	q, def, err := DomainQuotas(ctx, svc.repo, id, svc.quotas)
+	if err != nil {
+		return QuotasUsage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	usage, err := svc.repo.RetrieveUsage(ctx, id)
+	if err != nil {
+		return QuotasUsage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return QuotasUsage{
+		DomainID: id,
+		Default:  def,
+		Quotas:   q,
+		Usage:    usage,
+	}, nil
+}
+
+func (svc service) UpdateQuotas(ctx context.Context, session authn.Session, id string, q Quotas) (Quotas, error) {
+	if _, err := svc.repo.RetrieveDomainByID(ctx, id); err != nil {
+		return Quotas{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if err := svc.repo.SaveQuotas(ctx, id, q); err != nil {
+		return Quotas{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return q, nil
+}
+
+func (svc service) TransferOwnership(ctx context.Context, session authn.Session, id, userID string) (Transfer, error) {
+	if userID == session.UserID {
+		return Transfer{}, svcerr.ErrInvalidOwnershipTransfer
+	}
+	if _, _, err := svc.transferRoles(ctx, id, session.UserID, userID); err != nil {
+		return Transfer{}, err
+	}
+
+	now := time.Now().UTC()
+	t := Transfer{
+		DomainID:   id,
+		FromUserID: session.UserID,
+		ToUserID:   userID,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(svc.transferDuration),
+	}
+	if err := svc.repo.SaveTransfer(ctx, t); err != nil {
+		if errors.Contains(err, repoerr.ErrConflict) {
+			return Transfer{}, svcerr.ErrPendingOwnershipTransfer
+		}
+		return Transfer{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return t, nil
+}
+
+func (svc service) RetrieveTransfer(ctx context.Context, session authn.Session, id string) (Transfer, error) {
+	t, err := svc.repo.RetrieveTransfer(ctx, id)
+	if err != nil {
+		return Transfer{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if t.FromUserID != session.UserID && t.ToUserID != session.UserID {
+		return Transfer{}, svcerr.ErrAuthorization
+	}
+
+	return t, nil
+}
+
+func (svc service) AcceptTransfer(ctx context.Context, session authn.Session, id string) (retT Transfer, retErr error) {
+	t, err := svc.repo.RetrieveTransfer(ctx, id)
+	if err != nil {
+		return Transfer{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if t.ToUserID != session.UserID {
+		return Transfer{}, svcerr.ErrAuthorization
+	}
+	if !t.ExpiresAt.After(time.Now()) {
+		return Transfer{}, svcerr.ErrOwnershipTransferExpired
+	}
+
+	// Roles of the users could have changed since the transfer was started.
+	adminRole, memberRole, err := svc.transferRoles(ctx, id, t.FromUserID, t.ToUserID)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	prevPolicies := []policies.Policy{
+		transferPolicy(id, t.FromUserID, adminRole.ID),
+		transferPolicy(id, t.ToUserID, memberRole.ID),
+	}
+	newPolicies := []policies.Policy{
+		transferPolicy(id, t.ToUserID, adminRole.ID),
+		transferPolicy(id, t.FromUserID, memberRole.ID),
+	}
+
+	if err := svc.policy.DeletePolicies(ctx, prevPolicies); err != nil {
+		return Transfer{}, errors.Wrap(svcerr.ErrDeletePolicies, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRollback := svc.policy.AddPolicies(ctx, prevPolicies); errRollback != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errRollbackPolicies, errRollback))
+			}
+		}
+	}()
+
+	if err := svc.policy.AddPolicies(ctx, newPolicies); err != nil {
+		return Transfer{}, errors.Wrap(svcerr.ErrAddPolicies, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRollback := svc.policy.DeletePolicies(ctx, newPolicies); errRollback != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errRollbackPolicies, errRollback))
+			}
+		}
+	}()
+
+	if err := svc.repo.CompleteTransfer(ctx, t, adminRole, memberRole); err != nil {
+		return Transfer{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return t, nil
+}
+
+func (svc service) RejectTransfer(ctx context.Context, session authn.Session, id string) error {
+	t, err := svc.repo.RetrieveTransfer(ctx, id)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if t.FromUserID != session.UserID && t.ToUserID != session.UserID {
+		return svcerr.ErrAuthorization
+	}
+	if err := svc.repo.DeleteTransfer(ctx, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+// transferRoles returns the roles of the users taking part in the ownership
+// transfer, checking that only the sender holds the built-in admin role.
+func (svc service) transferRoles(ctx context.Context, domainID, fromUserID, toUserID string) (roles.Role, roles.Role, error) {
+	adminRole, err := svc.repo.RetrieveMemberRole(ctx, domainID, fromUserID)
+	if err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return roles.Role{}, roles.Role{}, svcerr.ErrInvalidOwnershipTransfer
+		}
+		return roles.Role{}, roles.Role{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	memberRole, err := svc.repo.RetrieveMemberRole(ctx, domainID, toUserID)
+	if err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return roles.Role{}, roles.Role{}, svcerr.ErrInvalidOwnershipTransfer
+		}
+		return roles.Role{}, roles.Role{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if adminRole.Name != BuiltInRoleAdmin.String() || memberRole.Name == BuiltInRoleAdmin.String() {
+		return roles.Role{}, roles.Role{}, svcerr.ErrInvalidOwnershipTransfer
+	}
+
+	return adminRole, memberRole, nil
+}
+
+func transferPolicy(domainID, userID, roleID string) policies.Policy {
+	return policies.Policy{
+		SubjectType: policies.UserType,
+		Subject:     policies.EncodeDomainUserID(domainID, userID),
+		Relation:    policies.MemberRelation,
+		Object:      roleID,
+		ObjectType:  policies.RoleType,
+	}
+}
+
 func (svc *service) SendInvitation(ctx context.Context, session authn.Session, invitation Invitation) (Invitation, error) {
 	role, err := svc.repo.RetrieveRole(ctx, invitation.RoleID)
 	if err != nil {
@@ -205,6 +622,10 @@ func (svc *service) SendInvitation(ctx context.Context, session authn.Session, i
 	invitation.InvitedBy = session.UserID
 	invitation.CreatedAt = time.Now().UTC()
 
+	if invitation.InviteeEmail != "" {
+		return svc.sendEmailInvitation(ctx, invitation)
+	}
+
 	if invitation.Resend {
 		if err := svc.resendInvitation(ctx, invitation); err != nil {
 			return Invitation{}, err
@@ -212,12 +633,111 @@ func (svc *service) SendInvitation(ctx context.Context, session authn.Session, i
 		return invitation, nil
 	}
 
+	if err := svc.checkInvitationsQuota(ctx, invitation.DomainID); err != nil {
+		return Invitation{}, err
+	}
+
 	if err := svc.repo.SaveInvitation(ctx, invitation); err != nil {
 		return Invitation{}, errors.Wrap(svcerr.ErrCreateEntity, err)
 	}
 	return invitation, nil
 }
 
+func (svc *service) sendEmailInvitation(ctx context.Context, invitation Invitation) (Invitation, error) {
+	if invitation.Resend {
+		inv, err := svc.repo.RetrieveEmailInvitation(ctx, invitation.DomainID, invitation.InviteeEmail)
+		if err != nil {
+			return Invitation{}, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		if !inv.ConfirmedAt.IsZero() {
+			return Invitation{}, svcerr.ErrInvitationAlreadyAccepted
+		}
+		if inv.ResendCount >= svc.maxResends {
+			return Invitation{}, svcerr.ErrInvitationResendLimit
+		}
+		invitation.InviteeUserID = inv.InviteeUserID
+		invitation.CreatedAt = inv.CreatedAt
+		invitation.UpdatedAt = time.Now().UTC()
+		invitation.ResendCount = inv.ResendCount + 1
+
+		token, err := svc.issueInvitationToken(ctx, &invitation)
+		if err != nil {
+			return Invitation{}, err
+		}
+		if err := svc.repo.UpdateEmailInvitation(ctx, invitation); err != nil {
+			return Invitation{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+		if err := svc.email.SendInvitation([]string{invitation.InviteeEmail}, invitation.DomainName, invitation.RoleName, token); err != nil {
+			return Invitation{}, errors.Wrap(errSendInvitation, err)
+		}
+
+		return invitation, nil
+	}
+
+	if err := svc.checkInvitationsQuota(ctx, invitation.DomainID); err != nil {
+		return Invitation{}, err
+	}
+
+	// The invitee has no account yet, so the invitation is saved with the
+	// generated ID which is replaced by the user ID once the user registers.
+	inviteeID, err := svc.idProvider.ID()
+	if err != nil {
+		return Invitation{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	invitation.InviteeUserID = inviteeID
+
+	token, err := svc.issueInvitationToken(ctx, &invitation)
+	if err != nil {
+		return Invitation{}, err
+	}
+	if err := svc.repo.SaveInvitation(ctx, invitation); err != nil {
+		return Invitation{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	if err := svc.email.SendInvitation([]string{invitation.InviteeEmail}, invitation.DomainName, invitation.RoleName, token); err != nil {
+		if errRollback := svc.repo.DeleteUsersInvitations(ctx, invitation.DomainID, inviteeID); errRollback != nil {
+			err = errors.Wrap(err, errors.Wrap(errRollbackRepo, errRollback))
+		}
+		return Invitation{}, errors.Wrap(errSendInvitation, err)
+	}
+
+	return invitation, nil
+}
+
+// issueInvitationToken issues the invitation token for the invitee and sets
+// the token hash and the expiration time of the invitation.
+func (svc *service) issueInvitationToken(ctx context.Context, invitation *Invitation) (string, error) {
+	token, err := svc.token.Issue(ctx, &grpcTokenV1.IssueReq{
+		UserId: invitation.InviteeUserID,
+		Type:   uint32(smqauth.InvitationKey),
+	})
+	if err != nil {
+		return "", errors.Wrap(errIssueInvitation, err)
+	}
+	invitation.TokenHash = HashInvitationToken(token.GetAccessToken())
+	invitation.ExpiresAt = time.Now().UTC().Add(svc.invitationDuration)
+
+	return token.GetAccessToken(), nil
+}
+
+func (svc *service) checkInvitationsQuota(ctx context.Context, domainID string) error {
+	q, _, err := DomainQuotas(ctx, svc.repo, domainID, svc.quotas)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if q.Invitations == 0 {
+		return nil
+	}
+	usage, err := svc.repo.RetrieveUsage(ctx, domainID)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if usage.Invitations >= q.Invitations {
+		return svcerr.ErrQuotaExceeded
+	}
+
+	return nil
+}
+
 func (svc *service) resendInvitation(ctx context.Context, invitation Invitation) error {
 	inv, err := svc.repo.RetrieveInvitation(ctx, invitation.InviteeUserID, invitation.DomainID)
 	if err != nil {
@@ -380,34 +900,35 @@ func (svc *service) populateDetails(ctx context.Context, inv Invitation, domainI
 
 // Add addition removal of user from invitations.
 func (svc *service) RemoveEntityMembers(ctx context.Context, session authn.Session, entityID string, members []string) error {
+	if err := svc.removeMembers(ctx, session, entityID, "", members); err != nil {
+		return err
+	}
+
 	if err := svc.repo.DeleteUsersInvitations(ctx, entityID, members...); err != nil && err != repoerr.ErrNotFound {
 		return err
 	}
 
-	return svc.ProvisionManageService.RemoveEntityMembers(ctx, session, entityID, members)
+	return nil
 }
 
 func (svc *service) RoleRemoveMembers(ctx context.Context, session authn.Session, entityID, roleID string, members []string) error {
-	ro, err := svc.repo.RetrieveEntityRole(ctx, entityID, roleID)
-	if err != nil {
+	if _, err := svc.repo.RetrieveEntityRole(ctx, entityID, roleID); err != nil {
 		return errors.Wrap(svcerr.ErrViewEntity, err)
 	}
 
-	if _, err := svc.ProvisionManageService.BuiltInRoleActions(roles.BuiltInRoleName(ro.Name)); err == nil {
-		membersPage, err := svc.repo.RoleListMembers(ctx, ro.ID, 0, 0)
-		if err != nil {
-			return errors.Wrap(svcerr.ErrViewEntity, err)
-		}
-		if membersPage.Total <= uint64(len(members)) {
-			return svcerr.ErrRetainOneMember
-		}
+	if len(members) == 0 {
+		return svcerr.ErrMalformedEntity
+	}
+
+	if err := svc.removeMembers(ctx, session, entityID, roleID, members); err != nil {
+		return err
 	}
 
 	if err := svc.repo.DeleteUsersInvitations(ctx, entityID, members...); err != nil && err != repoerr.ErrNotFound {
 		return err
 	}
 
-	return svc.ProvisionManageService.RoleRemoveMembers(ctx, session, entityID, roleID, members)
+	return nil
 }
 
 func (svc *service) RoleRemoveAllMembers(ctx context.Context, session authn.Session, entityID, roleID string) error {
@@ -422,3 +943,61 @@ func (svc *service) RoleRemoveAllMembers(ctx context.Context, session authn.Sess
 
 	return svc.ProvisionManageService.RoleRemoveAllMembers(ctx, session, entityID, roleID)
 }
+
+// removeMembers removes the members from their domain roles, or only from the
+// role if roleID is set, and deletes the policies of the removed memberships.
+// The repository checks and removes the members in a single transaction, so
+// the built-in roles, such as the domain administrator, retain a member.
+func (svc *service) removeMembers(ctx context.Context, session authn.Session, domainID, roleID string, members []string) error {
+	removed, err := svc.repo.RemoveRoleMembers(ctx, domainID, roleID, members, svc.builtInRoles, session.UserID)
+	switch {
+	case errors.Contains(err, ErrRetainBuiltInRoleMember):
+		return svcerr.ErrRetainOneMember
+	case err != nil:
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	prs := []policies.Policy{}
+	for _, mr := range removed {
+		prs = append(prs, policies.Policy{
+			SubjectType: policies.UserType,
+			Subject:     policies.EncodeDomainUserID(domainID, mr.MemberID),
+			Relation:    policies.MemberRelation,
+			Object:      mr.RoleID,
+			ObjectType:  policies.RoleType,
+		})
+	}
+	if len(prs) == 0 {
+		return nil
+	}
+
+	if err := svc.policy.DeletePolicies(ctx, prs); err != nil {
+		if errRollback := svc.restoreMembers(ctx, session, domainID, removed); errRollback != nil {
+			err = errors.Wrap(err, errors.Wrap(errRollbackRoles, errRollback))
+		}
+		return errors.Wrap(svcerr.ErrDeletePolicies, err)
+	}
+
+	return nil
+}
+
+// restoreMembers adds back the removed role memberships.
+func (svc *service) restoreMembers(ctx context.Context, session authn.Session, domainID string, removed []roles.EntityMemberRole) error {
+	members := map[string][]string{}
+	for _, mr := range removed {
+		members[mr.RoleID] = append(members[mr.RoleID], mr.MemberID)
+	}
+	for roleID, mems := range members {
+		ro := roles.Role{
+			ID:        roleID,
+			EntityID:  domainID,
+			UpdatedAt: time.Now().UTC(),
+			UpdatedBy: session.UserID,
+		}
+		if _, err := svc.repo.RoleAddMembers(ctx, ro, mems); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
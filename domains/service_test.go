@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	authmocks "github.com/absmach/supermq/auth/mocks"
 	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/domains/mocks"
 	"github.com/absmach/supermq/groups"
@@ -17,6 +19,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
 	policiesMocks "github.com/absmach/supermq/pkg/policies/mocks"
 	"github.com/absmach/supermq/pkg/roles"
 	"github.com/absmach/supermq/pkg/sid"
@@ -26,8 +29,11 @@ import (
 )
 
 const (
-	groupName = "smqx"
-	validID   = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
+	groupName          = "smqx"
+	validID            = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
+	invitationDuration = time.Hour
+	maxResends         = 2
+	transferDuration   = time.Hour
 )
 
 var (
@@ -69,12 +75,21 @@ var (
 		DomainID:      testsutil.GenerateUUID(&testing.T{}),
 		RoleID:        testsutil.GenerateUUID(&testing.T{}),
 	}
+	defaultQuotas = domains.Quotas{
+		Clients:     100,
+		Channels:    100,
+		Groups:      100,
+		Invitations: 10,
+		MessageRate: 50,
+	}
 )
 
 var (
-	drepo  *mocks.Repository
-	dcache *mocks.Cache
-	policy *policiesMocks.Service
+	drepo   *mocks.Repository
+	dcache  *mocks.Cache
+	policy  *policiesMocks.Service
+	token   *authmocks.TokenServiceClient
+	emailer *mocks.Emailer
 )
 
 func newService() domains.Service {
@@ -83,11 +98,13 @@ func newService() domains.Service {
 	idProvider := uuid.NewMock()
 	sidProvider := sid.NewMock()
 	policy = new(policiesMocks.Service)
+	token = new(authmocks.TokenServiceClient)
+	emailer = new(mocks.Emailer)
 	availableActions := []roles.Action{}
 	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
 		groups.BuiltInRoleAdmin: availableActions,
 	}
-	ds, _ := domains.New(drepo, dcache, policy, idProvider, sidProvider, availableActions, builtInRoles)
+	ds, _ := domains.New(drepo, dcache, policy, idProvider, sidProvider, token, emailer, invitationDuration, maxResends, transferDuration, defaultQuotas, availableActions, builtInRoles)
 	return ds
 }
 
@@ -539,6 +556,491 @@ func TestFreezeDomain(t *testing.T) {
 	}
 }
 
+func TestDeleteDomain(t *testing.T) {
+	svc := newService()
+
+	deletedDomain := domain
+	deletedDomain.Status = domains.DeletedStatus
+	deletedDomain.PreviousStatus = domains.EnabledStatus
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		domainID    string
+		deleteRes   domains.Domain
+		deleteErr   error
+		retrieveRes domains.Domain
+		retrieveErr error
+		cacheErr    error
+		err         error
+	}{
+		{
+			desc:      "delete domain successfully",
+			session:   validSession,
+			domainID:  domain.ID,
+			deleteRes: deletedDomain,
+			err:       nil,
+		},
+		{
+			desc:        "delete domain with empty domainID",
+			session:     validSession,
+			domainID:    "",
+			deleteErr:   repoerr.ErrNotFound,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "delete already deleted domain",
+			session:     validSession,
+			domainID:    domain.ID,
+			deleteErr:   repoerr.ErrNotFound,
+			retrieveRes: deletedDomain,
+			err:         svcerr.ErrInvalidStatus,
+		},
+		{
+			desc:      "delete domain with failed to delete",
+			session:   validSession,
+			domainID:  domain.ID,
+			deleteErr: errors.ErrMalformedEntity,
+			err:       svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:      "delete domain with failed to remove cache",
+			session:   validSession,
+			domainID:  domain.ID,
+			deleteRes: deletedDomain,
+			cacheErr:  errors.ErrMalformedEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("MarkDomainDeleted", context.Background(), tc.domainID, tc.session.UserID, mock.Anything).Return(tc.deleteRes, tc.deleteErr)
+			retrieveCall := drepo.On("RetrieveDomainByID", context.Background(), tc.domainID).Return(tc.retrieveRes, tc.retrieveErr)
+			cacheCall := dcache.On("RemoveStatus", context.Background(), tc.domainID).Return(tc.cacheErr)
+			domain, err := svc.DeleteDomain(context.Background(), tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.deleteRes, domain)
+			repoCall.Unset()
+			retrieveCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRestoreDomain(t *testing.T) {
+	svc := newService()
+
+	deletedDomain := domain
+	deletedDomain.Status = domains.DeletedStatus
+	enabledDomain := domain
+	enabledDomain.Status = domains.EnabledStatus
+	deletedDisabledDomain := deletedDomain
+	deletedDisabledDomain.PreviousStatus = domains.DisabledStatus
+	disabledDomain := domain
+	disabledDomain.Status = domains.DisabledStatus
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		domainID    string
+		retrieveRes domains.Domain
+		retrieveErr error
+		status      domains.Status
+		restoreRes  domains.Domain
+		restoreErr  error
+		cacheErr    error
+		resp        domains.Domain
+		err         error
+	}{
+		{
+			desc:        "restore domain successfully",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: deletedDomain,
+			status:      domains.EnabledStatus,
+			restoreRes:  enabledDomain,
+			resp:        enabledDomain,
+			err:         nil,
+		},
+		{
+			desc:        "restore domain disabled before deletion",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: deletedDisabledDomain,
+			status:      domains.DisabledStatus,
+			restoreRes:  disabledDomain,
+			resp:        disabledDomain,
+			err:         nil,
+		},
+		{
+			desc:        "restore non-existing domain",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "restore domain which is not deleted",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: enabledDomain,
+			err:         svcerr.ErrInvalidStatus,
+		},
+		{
+			desc:        "restore domain with failed to restore",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: deletedDomain,
+			restoreErr:  errors.ErrMalformedEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:        "restore domain with failed to remove cache",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: deletedDomain,
+			restoreRes:  enabledDomain,
+			cacheErr:    errors.ErrMalformedEntity,
+			resp:        enabledDomain,
+			err:         svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var req domains.DomainReq
+			retrieveCall := drepo.On("RetrieveDomainByID", context.Background(), tc.domainID).Return(tc.retrieveRes, tc.retrieveErr)
+			repoCall := drepo.On("UpdateDomain", context.Background(), tc.domainID, mock.Anything).Run(func(args mock.Arguments) {
+				req = args.Get(2).(domains.DomainReq)
+			}).Return(tc.restoreRes, tc.restoreErr)
+			cacheCall := dcache.On("RemoveStatus", context.Background(), tc.domainID).Return(tc.cacheErr)
+			domain, err := svc.RestoreDomain(context.Background(), tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, domain)
+			if tc.err == nil {
+				assert.Equal(t, tc.status, *req.Status, fmt.Sprintf("%s: expected status %s got %s\n", tc.desc, tc.status, *req.Status))
+			}
+			retrieveCall.Unset()
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestExportDomain(t *testing.T) {
+	svc := newService()
+
+	adminRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: domains.BuiltInRoleAdmin.String(), EntityID: domain.ID}
+	rolesPage := roles.RolePage{Total: 1, Limit: 100, Roles: []roles.Role{adminRole}}
+	membersPage := roles.MembersPage{Total: 1, Limit: 100, Members: []string{userID}}
+	actions := []string{"read", "update"}
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		domainID    string
+		retrieveRes domains.Domain
+		retrieveErr error
+		rolesRes    roles.RolePage
+		rolesErr    error
+		actionsRes  []string
+		actionsErr  error
+		membersRes  roles.MembersPage
+		membersErr  error
+		resp        domains.Archive
+		err         error
+	}{
+		{
+			desc:        "export domain successfully",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: domain,
+			rolesRes:    rolesPage,
+			actionsRes:  actions,
+			membersRes:  membersPage,
+			resp: domains.Archive{
+				Version: domains.ArchiveVersion,
+				Domain:  domain,
+				Roles: []domains.ArchiveRole{
+					{
+						ID:      adminRole.ID,
+						Name:    adminRole.Name,
+						Actions: actions,
+						Members: []string{userID},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc:        "export domain without roles",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: domain,
+			rolesRes:    roles.RolePage{},
+			resp: domains.Archive{
+				Version: domains.ArchiveVersion,
+				Domain:  domain,
+			},
+			err: nil,
+		},
+		{
+			desc:        "export non-existing domain",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "export domain with failed to retrieve roles",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: domain,
+			rolesErr:    repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "export domain with failed to list role actions",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: domain,
+			rolesRes:    rolesPage,
+			actionsErr:  repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:        "export domain with failed to list role members",
+			session:     validSession,
+			domainID:    domain.ID,
+			retrieveRes: domain,
+			rolesRes:    rolesPage,
+			actionsRes:  actions,
+			membersErr:  repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			retrieveCall := drepo.On("RetrieveDomainByID", context.Background(), tc.domainID).Return(tc.retrieveRes, tc.retrieveErr)
+			rolesCall := drepo.On("RetrieveAllRoles", context.Background(), tc.domainID, uint64(100), uint64(0)).Return(tc.rolesRes, tc.rolesErr)
+			actionsCall := drepo.On("RoleListActions", context.Background(), adminRole.ID).Return(tc.actionsRes, tc.actionsErr)
+			membersCall := drepo.On("RoleListMembers", context.Background(), adminRole.ID, uint64(100), uint64(0)).Return(tc.membersRes, tc.membersErr)
+			archive, err := svc.ExportDomain(context.Background(), tc.session, tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.WithinDuration(t, time.Now(), archive.ExportedAt, 2*time.Second)
+				archive.ExportedAt = time.Time{}
+			}
+			assert.Equal(t, tc.resp, archive)
+			retrieveCall.Unset()
+			rolesCall.Unset()
+			actionsCall.Unset()
+			membersCall.Unset()
+		})
+	}
+}
+
+func TestImportDomain(t *testing.T) {
+	svc := newService()
+
+	adminID := testsutil.GenerateUUID(t)
+	viewerID := testsutil.GenerateUUID(t)
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain: domains.Domain{
+			ID:     validID,
+			Name:   groupName,
+			Route:  groupName,
+			Status: domains.EnabledStatus,
+		},
+		Roles: []domains.ArchiveRole{
+			{
+				ID:      testsutil.GenerateUUID(t),
+				Name:    domains.BuiltInRoleAdmin.String(),
+				Members: []string{userID, adminID},
+			},
+			{
+				ID:      testsutil.GenerateUUID(t),
+				Name:    "viewer",
+				Members: []string{viewerID, adminID},
+			},
+		},
+	}
+	invalidVersion := archive
+	invalidVersion.Version = domains.ArchiveVersion + 1
+	invalidActions := archive
+	invalidActions.Roles = []domains.ArchiveRole{
+		{
+			ID:      testsutil.GenerateUUID(t),
+			Name:    "viewer",
+			Actions: []string{inValid},
+		},
+	}
+	cases := []struct {
+		desc              string
+		session           authn.Session
+		archive           domains.Archive
+		preserveID        bool
+		saveDomainErr     error
+		addRolesErr       error
+		addPoliciesErr    error
+		usage             domains.Usage
+		saveInvitationErr error
+		deleteDomainErr   error
+		invitations       map[string]string
+		err               error
+	}{
+		{
+			desc:        "import domain successfully",
+			session:     validSession,
+			archive:     archive,
+			invitations: map[string]string{adminID: domains.BuiltInRoleAdmin.String(), viewerID: "viewer"},
+			err:         nil,
+		},
+		{
+			desc:        "import domain with preserved id",
+			session:     validSession,
+			archive:     archive,
+			preserveID:  true,
+			invitations: map[string]string{adminID: domains.BuiltInRoleAdmin.String(), viewerID: "viewer"},
+			err:         nil,
+		},
+		{
+			desc:    "import domain with forged members",
+			session: validSession,
+			archive: domains.Archive{
+				Version: domains.ArchiveVersion,
+				Domain:  domains.Domain{Name: groupName, Route: groupName},
+				Roles: []domains.ArchiveRole{
+					{
+						Name:    domains.BuiltInRoleAdmin.String(),
+						Members: []string{adminID},
+					},
+					{
+						Name:    "viewer",
+						Members: []string{viewerID},
+					},
+				},
+			},
+			invitations: map[string]string{adminID: domains.BuiltInRoleAdmin.String(), viewerID: "viewer"},
+			err:         nil,
+		},
+		{
+			desc:              "import domain with failed to save invitation",
+			session:           validSession,
+			archive:           archive,
+			saveInvitationErr: repoerr.ErrCreateEntity,
+			err:               svcerr.ErrCreateEntity,
+		},
+		{
+			desc:    "import domain with exceeded invitations quota",
+			session: validSession,
+			archive: archive,
+			usage:   domains.Usage{Invitations: defaultQuotas.Invitations},
+			err:     svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:    "import domain with unsupported version",
+			session: validSession,
+			archive: invalidVersion,
+			err:     svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:          "import domain with failed to save domain",
+			session:       validSession,
+			archive:       archive,
+			saveDomainErr: svcerr.ErrCreateEntity,
+			err:           svcerr.ErrCreateEntity,
+		},
+		{
+			desc:          "import domain with unavailable route",
+			session:       validSession,
+			archive:       archive,
+			saveDomainErr: errors.ErrRouteNotAvailable,
+			err:           errors.ErrRouteNotAvailable,
+		},
+		{
+			desc:           "import domain with failed to add policies",
+			session:        validSession,
+			archive:        archive,
+			addPoliciesErr: errAddPolicies,
+			err:            errAddPolicies,
+		},
+		{
+			desc:        "import domain with failed to add roles",
+			session:     validSession,
+			archive:     archive,
+			addRolesErr: errors.ErrMalformedEntity,
+			err:         errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "import domain with invalid role actions",
+			session: validSession,
+			archive: invalidActions,
+			err:     svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:            "import domain with invalid role actions and failed rollback",
+			session:         validSession,
+			archive:         invalidActions,
+			deleteDomainErr: svcerr.ErrRemoveEntity,
+			err:             svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("SaveDomain", context.Background(), mock.Anything).Return(tc.archive.Domain, tc.saveDomainErr)
+			repoCall1 := drepo.On("DeleteDomain", context.Background(), mock.Anything).Return(tc.deleteDomainErr)
+			var members []string
+			repoCall2 := drepo.On("AddRoles", context.Background(), mock.Anything).Return(func(_ context.Context, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
+				for i := range rps {
+					members = append(members, rps[i].OptionalMembers...)
+					rps[i].ID = rps[i].Name + "-id"
+				}
+				return rps, tc.addRolesErr
+			})
+			repoCall3 := drepo.On("RetrieveEntitiesRolesActionsMembers", context.Background(), mock.Anything).Return([]roles.EntityActionRole{}, []roles.EntityMemberRole{}, nil)
+			repoCall4 := drepo.On("RetrieveQuotas", context.Background(), mock.Anything).Return(domains.Quotas{}, repoerr.ErrNotFound)
+			repoCall5 := drepo.On("RetrieveUsage", context.Background(), mock.Anything).Return(tc.usage, nil)
+			invitations := map[string]string{}
+			repoCall6 := drepo.On("SaveInvitation", context.Background(), mock.Anything).Return(tc.saveInvitationErr).Run(func(args mock.Arguments) {
+				inv := args.Get(1).(domains.Invitation)
+				invitations[inv.InviteeUserID] = inv.RoleName
+				assert.Equal(t, inv.RoleName+"-id", inv.RoleID, fmt.Sprintf("%s: expected invitation to role %s got %s\n", tc.desc, inv.RoleName+"-id", inv.RoleID))
+			})
+			policyCall := policy.On("AddPolicies", context.Background(), mock.Anything).Return(tc.addPoliciesErr)
+			policyCall1 := policy.On("DeletePolicies", context.Background(), mock.Anything).Return(nil)
+			policyCall2 := policy.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(nil)
+			d, rps, err := svc.ImportDomain(context.Background(), tc.session, tc.archive, tc.preserveID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.archive.Domain.Name, d.Name)
+				assert.Len(t, rps, len(tc.archive.Roles))
+				ok := repoCall.Parent.AssertCalled(t, "SaveDomain", context.Background(), mock.MatchedBy(func(d domains.Domain) bool {
+					return (d.ID == tc.archive.Domain.ID) == tc.preserveID
+				}))
+				assert.True(t, ok, fmt.Sprintf("SaveDomain was not called with expected id on %s", tc.desc))
+				assert.Equal(t, []string{tc.session.UserID}, members, fmt.Sprintf("%s: expected only importer to join the domain got %v\n", tc.desc, members))
+				assert.Equal(t, tc.invitations, invitations, fmt.Sprintf("%s: expected invitations %v got %v\n", tc.desc, tc.invitations, invitations))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+			repoCall4.Unset()
+			repoCall5.Unset()
+			repoCall6.Unset()
+			policyCall.Unset()
+			policyCall1.Unset()
+			policyCall2.Unset()
+		})
+	}
+}
+
 func TestListDomains(t *testing.T) {
 	svc := newService()
 
@@ -632,6 +1134,10 @@ func TestSendInvitation(t *testing.T) {
 		retrieveInvRes      domains.Invitation
 		retrieveInvErr      error
 		updateRejectionErr  error
+		retrieveQuotasRes   domains.Quotas
+		retrieveQuotasErr   error
+		retrieveUsageRes    domains.Usage
+		retrieveUsageErr    error
 		err                 error
 	}{
 		{
@@ -698,6 +1204,45 @@ func TestSendInvitation(t *testing.T) {
 			updateRejectionErr: repoerr.ErrUpdateEntity,
 			err:                svcerr.ErrUpdateEntity,
 		},
+		{
+			desc:              "send invitation with domain quotas successfully",
+			session:           validSession,
+			req:               validInvitation,
+			retrieveQuotasRes: domains.Quotas{Invitations: 2},
+			retrieveUsageRes:  domains.Usage{Invitations: 1},
+			err:               nil,
+		},
+		{
+			desc:              "send invitation with exceeded domain quota",
+			session:           validSession,
+			req:               validInvitation,
+			retrieveQuotasRes: domains.Quotas{Invitations: 2},
+			retrieveUsageRes:  domains.Usage{Invitations: 2},
+			err:               svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:              "send invitation with exceeded default quota",
+			session:           validSession,
+			req:               validInvitation,
+			retrieveQuotasErr: repoerr.ErrNotFound,
+			retrieveUsageRes:  domains.Usage{Invitations: defaultQuotas.Invitations},
+			err:               svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:              "send invitation with failed to retrieve quotas",
+			session:           validSession,
+			req:               validInvitation,
+			retrieveQuotasErr: repoerr.ErrViewEntity,
+			err:               svcerr.ErrViewEntity,
+		},
+		{
+			desc:              "send invitation with failed to retrieve usage",
+			session:           validSession,
+			req:               validInvitation,
+			retrieveQuotasRes: domains.Quotas{Invitations: 2},
+			retrieveUsageErr:  repoerr.ErrViewEntity,
+			err:               svcerr.ErrViewEntity,
+		},
 	}
 
 	for _, tc := range cases {
@@ -707,54 +1252,327 @@ func TestSendInvitation(t *testing.T) {
 			repoCall2 := drepo.On("SaveInvitation", context.Background(), mock.Anything).Return(tc.createInvitationErr)
 			repoCall3 := drepo.On("RetrieveInvitation", context.Background(), tc.req.InviteeUserID, tc.req.DomainID).Return(tc.retrieveInvRes, tc.retrieveInvErr)
 			repoCall4 := drepo.On("UpdateRejection", context.Background(), mock.Anything).Return(tc.updateRejectionErr)
+			repoCall5 := drepo.On("RetrieveQuotas", context.Background(), tc.req.DomainID).Return(tc.retrieveQuotasRes, tc.retrieveQuotasErr)
+			repoCall6 := drepo.On("RetrieveUsage", context.Background(), tc.req.DomainID).Return(tc.retrieveUsageRes, tc.retrieveUsageErr)
 			_, err := svc.SendInvitation(context.Background(), tc.session, tc.req)
-			assert.True(t, errors.Contains(err, tc.err))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			repoCall.Unset()
 			repoCall1.Unset()
 			repoCall2.Unset()
 			repoCall3.Unset()
 			repoCall4.Unset()
+			repoCall5.Unset()
+			repoCall6.Unset()
 		})
 	}
 }
 
-func TestListInvitations(t *testing.T) {
+func TestSendEmailInvitation(t *testing.T) {
 	svc := newService()
 
-	validPageMeta := domains.InvitationPageMeta{
-		Offset: 0,
-		Limit:  10,
-	}
-	validResp := domains.InvitationPage{
-		Total:  1,
-		Offset: 0,
-		Limit:  10,
-		Invitations: []domains.Invitation{
-			{
-				InvitedBy:     testsutil.GenerateUUID(t),
-				InviteeUserID: testsutil.GenerateUUID(t),
-				DomainID:      testsutil.GenerateUUID(t),
-				RoleID:        testsutil.GenerateUUID(t),
-				RoleName:      "admin",
-				CreatedAt:     time.Now().Add(-time.Hour),
-				UpdatedAt:     time.Now().Add(-time.Hour),
-				ConfirmedAt:   time.Now().Add(-time.Hour),
-			},
-		},
+	invitation := domains.Invitation{
+		InviteeEmail: "invitee@example.com",
+		DomainID:     testsutil.GenerateUUID(t),
+		RoleID:       testsutil.GenerateUUID(t),
 	}
+	resentInvitation := invitation
+	resentInvitation.Resend = true
+	pendingInvitation := invitation
+	pendingInvitation.InviteeUserID = testsutil.GenerateUUID(t)
+	pendingInvitation.ResendCount = 1
+	acceptedInvitation := pendingInvitation
+	acceptedInvitation.ConfirmedAt = time.Now()
+	resentMaxInvitation := pendingInvitation
+	resentMaxInvitation.ResendCount = maxResends
+	invitationToken := &grpcTokenV1.Token{AccessToken: "invitation_token"}
 
 	cases := []struct {
-		desc    string
-		session authn.Session
-		page    domains.InvitationPageMeta
-		resp    domains.InvitationPage
-		err     error
-		repoErr error
+		desc              string
+		req               domains.Invitation
+		retrieveQuotasErr error
+		issueErr          error
+		saveErr           error
+		retrieveInvRes    domains.Invitation
+		retrieveInvErr    error
+		updateErr         error
+		sendErr           error
+		deleteErr         error
+		err               error
 	}{
 		{
-			desc:    "list invitations successful",
-			session: validSession,
-			page:    validPageMeta,
+			desc: "send invitation to email successfully",
+			req:  invitation,
+			err:  nil,
+		},
+		{
+			desc:              "send invitation to email with failed to retrieve quotas",
+			req:               invitation,
+			retrieveQuotasErr: repoerr.ErrViewEntity,
+			err:               svcerr.ErrViewEntity,
+		},
+		{
+			desc:     "send invitation to email with failed to issue token",
+			req:      invitation,
+			issueErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:    "send invitation to email with failed to save invitation",
+			req:     invitation,
+			saveErr: repoerr.ErrConflict,
+			err:     svcerr.ErrCreateEntity,
+		},
+		{
+			desc:    "send invitation to email with failed to send email",
+			req:     invitation,
+			sendErr: errors.New("failed to send email"),
+			err:     errors.New("failed to send email"),
+		},
+		{
+			desc:      "send invitation to email with failed to send email and rollback",
+			req:       invitation,
+			sendErr:   errors.New("failed to send email"),
+			deleteErr: repoerr.ErrRemoveEntity,
+			err:       repoerr.ErrRemoveEntity,
+		},
+		{
+			desc:           "resend invitation to email successfully",
+			req:            resentInvitation,
+			retrieveInvRes: pendingInvitation,
+			err:            nil,
+		},
+		{
+			desc:           "resend invitation to email with failed to retrieve invitation",
+			req:            resentInvitation,
+			retrieveInvErr: repoerr.ErrNotFound,
+			err:            svcerr.ErrViewEntity,
+		},
+		{
+			desc:           "resend invitation to email that is already accepted",
+			req:            resentInvitation,
+			retrieveInvRes: acceptedInvitation,
+			err:            svcerr.ErrInvitationAlreadyAccepted,
+		},
+		{
+			desc:           "resend invitation to email over the resend limit",
+			req:            resentInvitation,
+			retrieveInvRes: resentMaxInvitation,
+			err:            svcerr.ErrInvitationResendLimit,
+		},
+		{
+			desc:           "resend invitation to email with failed to update invitation",
+			req:            resentInvitation,
+			retrieveInvRes: pendingInvitation,
+			updateErr:      repoerr.ErrNotFound,
+			err:            svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:           "resend invitation to email with failed to send email",
+			req:            resentInvitation,
+			retrieveInvRes: pendingInvitation,
+			sendErr:        errors.New("failed to send email"),
+			err:            errors.New("failed to send email"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveRole", context.Background(), tc.req.RoleID).Return(roles.Role{Name: "admin"}, nil)
+			repoCall1 := drepo.On("RetrieveDomainByID", context.Background(), tc.req.DomainID).Return(domains.Domain{Name: "test_domain"}, nil)
+			repoCall2 := drepo.On("RetrieveQuotas", context.Background(), tc.req.DomainID).Return(domains.Quotas{}, tc.retrieveQuotasErr)
+			repoCall3 := drepo.On("RetrieveEmailInvitation", context.Background(), tc.req.DomainID, tc.req.InviteeEmail).Return(tc.retrieveInvRes, tc.retrieveInvErr)
+			tokenCall := token.On("Issue", context.Background(), mock.Anything).Return(invitationToken, tc.issueErr)
+			repoCall4 := drepo.On("SaveInvitation", context.Background(), mock.Anything).Return(tc.saveErr)
+			repoCall5 := drepo.On("UpdateEmailInvitation", context.Background(), mock.Anything).Return(tc.updateErr)
+			emailCall := emailer.On("SendInvitation", []string{tc.req.InviteeEmail}, "test_domain", "admin", invitationToken.AccessToken).Return(tc.sendErr)
+			repoCall6 := drepo.On("DeleteUsersInvitations", context.Background(), tc.req.DomainID, mock.Anything).Return(tc.deleteErr)
+			inv, err := svc.SendInvitation(context.Background(), validSession, tc.req)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.NotEmpty(t, inv.InviteeUserID, fmt.Sprintf("%s: expected generated invitee user ID", tc.desc))
+				assert.Equal(t, domains.HashInvitationToken(invitationToken.AccessToken), inv.TokenHash, fmt.Sprintf("%s: expected token hash", tc.desc))
+				assert.False(t, inv.ExpiresAt.IsZero(), fmt.Sprintf("%s: expected expiration time", tc.desc))
+			}
+			if tc.req.Resend && err == nil {
+				assert.Equal(t, tc.retrieveInvRes.InviteeUserID, inv.InviteeUserID, fmt.Sprintf("%s: expected the same invitee user ID", tc.desc))
+				assert.Equal(t, tc.retrieveInvRes.ResendCount+1, inv.ResendCount, fmt.Sprintf("%s: expected incremented resend count", tc.desc))
+			}
+			if tc.sendErr != nil && !tc.req.Resend {
+				ok := repoCall6.Parent.AssertCalled(t, "DeleteUsersInvitations", context.Background(), tc.req.DomainID, mock.Anything)
+				assert.True(t, ok, fmt.Sprintf("%s: expected the invitation to be removed", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+			tokenCall.Unset()
+			repoCall4.Unset()
+			repoCall5.Unset()
+			emailCall.Unset()
+			repoCall6.Unset()
+		})
+	}
+}
+
+func TestRetrieveUsage(t *testing.T) {
+	svc := newService()
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+	usage := domains.Usage{Clients: 1, Channels: 2, Groups: 3, Invitations: 1}
+
+	cases := []struct {
+		desc              string
+		id                string
+		retrieveQuotasRes domains.Quotas
+		retrieveQuotasErr error
+		retrieveUsageRes  domains.Usage
+		retrieveUsageErr  error
+		resp              domains.QuotasUsage
+		err               error
+	}{
+		{
+			desc:              "retrieve usage of domain with quotas successfully",
+			id:                validID,
+			retrieveQuotasRes: quotas,
+			retrieveUsageRes:  usage,
+			resp: domains.QuotasUsage{
+				DomainID: validID,
+				Quotas:   quotas,
+				Usage:    usage,
+			},
+		},
+		{
+			desc:              "retrieve usage of domain with default quotas successfully",
+			id:                validID,
+			retrieveQuotasErr: repoerr.ErrNotFound,
+			retrieveUsageRes:  usage,
+			resp: domains.QuotasUsage{
+				DomainID: validID,
+				Default:  true,
+				Quotas:   defaultQuotas,
+				Usage:    usage,
+			},
+		},
+		{
+			desc:              "retrieve usage with failed to retrieve quotas",
+			id:                validID,
+			retrieveQuotasErr: repoerr.ErrViewEntity,
+			err:               svcerr.ErrViewEntity,
+		},
+		{
+			desc:              "retrieve usage of non-existing domain",
+			id:                inValid,
+			retrieveQuotasErr: repoerr.ErrNotFound,
+			retrieveUsageErr:  repoerr.ErrNotFound,
+			err:               svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveQuotas", context.Background(), tc.id).Return(tc.retrieveQuotasRes, tc.retrieveQuotasErr)
+			repoCall1 := drepo.On("RetrieveUsage", context.Background(), tc.id).Return(tc.retrieveUsageRes, tc.retrieveUsageErr)
+			resp, err := svc.RetrieveUsage(context.Background(), validSession, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			repoCall.Unset()
+			repoCall1.Unset()
+		})
+	}
+}
+
+func TestUpdateQuotas(t *testing.T) {
+	svc := newService()
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+
+	cases := []struct {
+		desc              string
+		id                string
+		quotas            domains.Quotas
+		retrieveDomainErr error
+		saveQuotasErr     error
+		resp              domains.Quotas
+		err               error
+	}{
+		{
+			desc:   "update quotas successfully",
+			id:     validID,
+			quotas: quotas,
+			resp:   quotas,
+		},
+		{
+			desc:   "update quotas to unlimited successfully",
+			id:     validID,
+			quotas: domains.Quotas{},
+			resp:   domains.Quotas{},
+		},
+		{
+			desc:              "update quotas of non-existing domain",
+			id:                inValid,
+			quotas:            quotas,
+			retrieveDomainErr: repoerr.ErrNotFound,
+			err:               svcerr.ErrNotFound,
+		},
+		{
+			desc:          "update quotas with failed to save quotas",
+			id:            validID,
+			quotas:        quotas,
+			saveQuotasErr: repoerr.ErrUpdateEntity,
+			err:           svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveDomainByID", context.Background(), tc.id).Return(domains.Domain{ID: tc.id}, tc.retrieveDomainErr)
+			repoCall1 := drepo.On("SaveQuotas", context.Background(), tc.id, tc.quotas).Return(tc.saveQuotasErr)
+			resp, err := svc.UpdateQuotas(context.Background(), validSession, tc.id, tc.quotas)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			repoCall.Unset()
+			repoCall1.Unset()
+		})
+	}
+}
+
+func TestListInvitations(t *testing.T) {
+	svc := newService()
+
+	validPageMeta := domains.InvitationPageMeta{
+		Offset: 0,
+		Limit:  10,
+	}
+	validResp := domains.InvitationPage{
+		Total:  1,
+		Offset: 0,
+		Limit:  10,
+		Invitations: []domains.Invitation{
+			{
+				InvitedBy:     testsutil.GenerateUUID(t),
+				InviteeUserID: testsutil.GenerateUUID(t),
+				DomainID:      testsutil.GenerateUUID(t),
+				RoleID:        testsutil.GenerateUUID(t),
+				RoleName:      "admin",
+				CreatedAt:     time.Now().Add(-time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+				ConfirmedAt:   time.Now().Add(-time.Hour),
+			},
+		},
+	}
+
+	cases := []struct {
+		desc    string
+		session authn.Session
+		page    domains.InvitationPageMeta
+		resp    domains.InvitationPage
+		err     error
+		repoErr error
+	}{
+		{
+			desc:    "list invitations successful",
+			session: validSession,
+			page:    validPageMeta,
 			resp:    validResp,
 			err:     nil,
 			repoErr: nil,
@@ -1141,3 +1959,477 @@ func TestDeleteInvitation(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferOwnership(t *testing.T) {
+	svc := newService()
+
+	memberID := testsutil.GenerateUUID(t)
+	adminRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin", EntityID: validID}
+	memberRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "member", EntityID: validID}
+
+	cases := []struct {
+		desc        string
+		userID      string
+		fromRole    roles.Role
+		fromRoleErr error
+		toRole      roles.Role
+		toRoleErr   error
+		saveErr     error
+		err         error
+	}{
+		{
+			desc:     "transfer ownership successfully",
+			userID:   memberID,
+			fromRole: adminRole,
+			toRole:   memberRole,
+		},
+		{
+			desc:   "transfer ownership to self",
+			userID: userID,
+			err:    svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:     "transfer ownership by non-admin user",
+			userID:   memberID,
+			fromRole: memberRole,
+			toRole:   memberRole,
+			err:      svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:     "transfer ownership to another admin",
+			userID:   memberID,
+			fromRole: adminRole,
+			toRole:   adminRole,
+			err:      svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:      "transfer ownership to non-member user",
+			userID:    memberID,
+			fromRole:  adminRole,
+			toRoleErr: repoerr.ErrNotFound,
+			err:       svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:        "transfer ownership with failed to retrieve role",
+			userID:      memberID,
+			fromRoleErr: repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:     "transfer ownership with failed to save transfer",
+			userID:   memberID,
+			fromRole: adminRole,
+			toRole:   memberRole,
+			saveErr:  repoerr.ErrCreateEntity,
+			err:      svcerr.ErrCreateEntity,
+		},
+		{
+			desc:     "transfer ownership with pending transfer",
+			userID:   memberID,
+			fromRole: adminRole,
+			toRole:   memberRole,
+			saveErr:  repoerr.ErrConflict,
+			err:      svcerr.ErrPendingOwnershipTransfer,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveMemberRole", context.Background(), validID, userID).Return(tc.fromRole, tc.fromRoleErr)
+			repoCall1 := drepo.On("RetrieveMemberRole", context.Background(), validID, memberID).Return(tc.toRole, tc.toRoleErr)
+			repoCall2 := drepo.On("SaveTransfer", context.Background(), mock.Anything).Return(tc.saveErr)
+			resp, err := svc.TransferOwnership(context.Background(), validSession, validID, tc.userID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, validID, resp.DomainID)
+				assert.Equal(t, userID, resp.FromUserID)
+				assert.Equal(t, tc.userID, resp.ToUserID)
+				assert.Equal(t, resp.CreatedAt.Add(transferDuration), resp.ExpiresAt)
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+		})
+	}
+}
+
+func TestRetrieveTransfer(t *testing.T) {
+	svc := newService()
+
+	transfer := domains.Transfer{
+		DomainID:   validID,
+		FromUserID: testsutil.GenerateUUID(t),
+		ToUserID:   userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc        string
+		transfer    domains.Transfer
+		retrieveErr error
+		err         error
+	}{
+		{
+			desc:     "retrieve transfer successfully",
+			transfer: transfer,
+		},
+		{
+			desc:        "retrieve non-existing transfer",
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrNotFound,
+		},
+		{
+			desc: "retrieve transfer of other users",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: testsutil.GenerateUUID(t),
+				ToUserID:   testsutil.GenerateUUID(t),
+			},
+			err: svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveTransfer", context.Background(), validID).Return(tc.transfer, tc.retrieveErr)
+			resp, err := svc.RetrieveTransfer(context.Background(), validSession, validID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.transfer, resp)
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestAcceptTransfer(t *testing.T) {
+	svc := newService()
+
+	fromUserID := testsutil.GenerateUUID(t)
+	adminRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin", EntityID: validID}
+	memberRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "member", EntityID: validID}
+	transfer := domains.Transfer{
+		DomainID:   validID,
+		FromUserID: fromUserID,
+		ToUserID:   userID,
+		CreatedAt:  time.Now().UTC(),
+		ExpiresAt:  time.Now().UTC().Add(transferDuration),
+	}
+
+	cases := []struct {
+		desc              string
+		transfer          domains.Transfer
+		retrieveErr       error
+		fromRole          roles.Role
+		toRole            roles.Role
+		deletePoliciesErr error
+		addPoliciesErr    error
+		completeErr       error
+		err               error
+	}{
+		{
+			desc:     "accept transfer successfully",
+			transfer: transfer,
+			fromRole: adminRole,
+			toRole:   memberRole,
+		},
+		{
+			desc:        "accept non-existing transfer",
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrNotFound,
+		},
+		{
+			desc: "accept transfer of other user",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: userID,
+				ToUserID:   fromUserID,
+			},
+			err: svcerr.ErrAuthorization,
+		},
+		{
+			desc: "accept expired transfer",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: fromUserID,
+				ToUserID:   userID,
+				CreatedAt:  time.Now().UTC().Add(-2 * transferDuration),
+				ExpiresAt:  time.Now().UTC().Add(-transferDuration),
+			},
+			fromRole: adminRole,
+			toRole:   memberRole,
+			err:      svcerr.ErrOwnershipTransferExpired,
+		},
+		{
+			desc:     "accept transfer from user who isn't admin anymore",
+			transfer: transfer,
+			fromRole: memberRole,
+			toRole:   memberRole,
+			err:      svcerr.ErrInvalidOwnershipTransfer,
+		},
+		{
+			desc:              "accept transfer with failed to delete policies",
+			transfer:          transfer,
+			fromRole:          adminRole,
+			toRole:            memberRole,
+			deletePoliciesErr: svcerr.ErrAuthorization,
+			err:               svcerr.ErrDeletePolicies,
+		},
+		{
+			desc:           "accept transfer with failed to add policies",
+			transfer:       transfer,
+			fromRole:       adminRole,
+			toRole:         memberRole,
+			addPoliciesErr: errAddPolicies,
+			err:            svcerr.ErrAddPolicies,
+		},
+		{
+			desc:        "accept transfer with failed to complete transfer",
+			transfer:    transfer,
+			fromRole:    adminRole,
+			toRole:      memberRole,
+			completeErr: repoerr.ErrUpdateEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveTransfer", context.Background(), validID).Return(tc.transfer, tc.retrieveErr)
+			repoCall1 := drepo.On("RetrieveMemberRole", context.Background(), validID, fromUserID).Return(tc.fromRole, nil)
+			repoCall2 := drepo.On("RetrieveMemberRole", context.Background(), validID, userID).Return(tc.toRole, nil)
+			policyCall := policy.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
+			policyCall1 := policy.On("AddPolicies", context.Background(), mock.Anything).Return(tc.addPoliciesErr)
+			repoCall3 := drepo.On("CompleteTransfer", context.Background(), tc.transfer, tc.fromRole, tc.toRole).Return(tc.completeErr)
+			resp, err := svc.AcceptTransfer(context.Background(), validSession, validID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.transfer, resp)
+				ok := repoCall3.Parent.AssertCalled(t, "CompleteTransfer", context.Background(), tc.transfer, tc.fromRole, tc.toRole)
+				assert.True(t, ok, fmt.Sprintf("CompleteTransfer was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+			policyCall.Unset()
+			policyCall1.Unset()
+		})
+	}
+}
+
+func TestRejectTransfer(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc        string
+		transfer    domains.Transfer
+		retrieveErr error
+		deleteErr   error
+		err         error
+	}{
+		{
+			desc: "reject transfer successfully",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: testsutil.GenerateUUID(t),
+				ToUserID:   userID,
+			},
+		},
+		{
+			desc: "cancel transfer successfully",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: userID,
+				ToUserID:   testsutil.GenerateUUID(t),
+			},
+		},
+		{
+			desc:        "reject non-existing transfer",
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrNotFound,
+		},
+		{
+			desc: "reject transfer of other users",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: testsutil.GenerateUUID(t),
+				ToUserID:   testsutil.GenerateUUID(t),
+			},
+			err: svcerr.ErrAuthorization,
+		},
+		{
+			desc: "reject transfer with failed to delete transfer",
+			transfer: domains.Transfer{
+				DomainID:   validID,
+				FromUserID: testsutil.GenerateUUID(t),
+				ToUserID:   userID,
+			},
+			deleteErr: repoerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := drepo.On("RetrieveTransfer", context.Background(), validID).Return(tc.transfer, tc.retrieveErr)
+			repoCall1 := drepo.On("DeleteTransfer", context.Background(), validID).Return(tc.deleteErr)
+			err := svc.RejectTransfer(context.Background(), validSession, validID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+			repoCall1.Unset()
+		})
+	}
+}
+
+func TestRoleRemoveMembers(t *testing.T) {
+	svc := newService()
+
+	adminID := testsutil.GenerateUUID(t)
+	otherAdminID := testsutil.GenerateUUID(t)
+	adminRole := roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin", EntityID: validID}
+
+	cases := []struct {
+		desc        string
+		members     []string
+		retrieveErr error
+		removed     []roles.EntityMemberRole
+		removeErr   error
+		policyErr   error
+		restoreErr  error
+		restored    int
+		err         error
+	}{
+		{
+			desc:    "remove admin while another admin remains",
+			members: []string{adminID},
+			removed: []roles.EntityMemberRole{{EntityID: validID, MemberID: adminID, RoleID: adminRole.ID}},
+		},
+		{
+			desc:    "remove members which don't hold the role",
+			members: []string{adminID},
+		},
+		{
+			desc:      "remove last admin",
+			members:   []string{adminID, otherAdminID},
+			removeErr: domains.ErrRetainBuiltInRoleMember,
+			err:       svcerr.ErrRetainOneMember,
+		},
+		{
+			desc:    "remove without members",
+			members: []string{},
+			err:     svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:        "remove members of non-existing role",
+			members:     []string{adminID},
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:      "remove members with failed to remove members",
+			members:   []string{adminID},
+			removeErr: repoerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+		{
+			desc:      "remove members with failed to delete policies",
+			members:   []string{adminID},
+			removed:   []roles.EntityMemberRole{{EntityID: validID, MemberID: adminID, RoleID: adminRole.ID}},
+			policyErr: svcerr.ErrAuthorization,
+			restored:  1,
+			err:       svcerr.ErrDeletePolicies,
+		},
+		{
+			desc:       "remove members with failed to delete policies and restore members",
+			members:    []string{adminID},
+			removed:    []roles.EntityMemberRole{{EntityID: validID, MemberID: adminID, RoleID: adminRole.ID}},
+			policyErr:  svcerr.ErrAuthorization,
+			restoreErr: repoerr.ErrCreateEntity,
+			restored:   1,
+			err:        repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			restored := 0
+			repoCall := drepo.On("RetrieveEntityRole", context.Background(), validID, adminRole.ID).Return(adminRole, tc.retrieveErr)
+			repoCall1 := drepo.On("RemoveRoleMembers", context.Background(), validID, adminRole.ID, tc.members, mock.Anything, validSession.UserID).Return(tc.removed, tc.removeErr)
+			policyCall := policy.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.policyErr)
+			repoCall2 := drepo.On("RoleAddMembers", context.Background(), mock.Anything, []string{adminID}).Run(func(args mock.Arguments) {
+				restored++
+			}).Return([]string{adminID}, tc.restoreErr)
+			repoCall3 := drepo.On("DeleteUsersInvitations", context.Background(), validID, mock.Anything).Return(nil)
+			err := svc.RoleRemoveMembers(context.Background(), validSession, validID, adminRole.ID, tc.members)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.restored, restored, fmt.Sprintf("%s: expected %d restored roles got %d\n", tc.desc, tc.restored, restored))
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+			policyCall.Unset()
+		})
+	}
+}
+
+func TestRemoveEntityMembers(t *testing.T) {
+	svc := newService()
+
+	adminID := testsutil.GenerateUUID(t)
+	memberID := testsutil.GenerateUUID(t)
+	adminRoleID := testsutil.GenerateUUID(t)
+	memberRoleID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc      string
+		members   []string
+		removed   []roles.EntityMemberRole
+		removeErr error
+		policies  int
+		err       error
+	}{
+		{
+			desc:    "remove members while another admin remains",
+			members: []string{adminID, memberID},
+			removed: []roles.EntityMemberRole{
+				{EntityID: validID, MemberID: adminID, RoleID: adminRoleID},
+				{EntityID: validID, MemberID: memberID, RoleID: memberRoleID},
+			},
+			policies: 2,
+		},
+		{
+			desc:      "remove last admin",
+			members:   []string{adminID, memberID},
+			removeErr: domains.ErrRetainBuiltInRoleMember,
+			err:       svcerr.ErrRetainOneMember,
+		},
+		{
+			desc:    "remove members without roles",
+			members: []string{adminID, memberID},
+		},
+		{
+			desc:      "remove members with failed to remove members",
+			members:   []string{memberID},
+			removeErr: repoerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var prs []policies.Policy
+			repoCall := drepo.On("RemoveRoleMembers", context.Background(), validID, "", tc.members, mock.Anything, validSession.UserID).Return(tc.removed, tc.removeErr)
+			policyCall := policy.On("DeletePolicies", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				prs = args.Get(1).([]policies.Policy)
+			}).Return(nil)
+			repoCall1 := drepo.On("DeleteUsersInvitations", context.Background(), validID, mock.Anything).Return(nil)
+			err := svc.RemoveEntityMembers(context.Background(), validSession, validID, tc.members)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.policies, len(prs), fmt.Sprintf("%s: expected %d deleted policies got %d\n", tc.desc, tc.policies, len(prs)))
+			repoCall.Unset()
+			repoCall1.Unset()
+			policyCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+import "time"
+
+// Transfer is the pending transfer of the domain ownership. The domain
+// administrator hands the built-in admin role over to the domain member,
+// and the role is moved once the member accepts the transfer.
+type Transfer struct {
+	DomainID   string    `json:"domain_id"`
+	FromUserID string    `json:"from_user_id"`
+	ToUserID   string    `json:"to_user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
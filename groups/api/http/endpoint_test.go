@@ -172,6 +172,21 @@ func TestCreateGroupEndpoint(t *testing.T) {
 			status:      http.StatusBadRequest,
 			err:         apiutil.ErrNameSize,
 		},
+		{
+			desc:     "create group with invalid id",
+			token:    validToken,
+			domainID: validID,
+			req: createGroupReq{
+				Group: groups.Group{
+					ID:          "invalid",
+					Name:        valid,
+					Description: desc,
+				},
+			},
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidIDFormat,
+		},
 		{
 			desc:     "create group with invalid content type",
 			token:    validToken,
@@ -18,6 +18,11 @@ func (req createGroupReq) validate() error {
 	if len(req.Name) > api.MaxNameSize || req.Name == "" {
 		return apiutil.ErrNameSize
 	}
+	if req.ID != "" {
+		if err := api.ValidateUUID(req.ID); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
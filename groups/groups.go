@@ -135,6 +135,10 @@ type Repository interface {
 	// Delete a group
 	Delete(ctx context.Context, groupID string) error
 
+	// CountByDomain returns the number of the groups in each of the given
+	// domains, or in all the domains if none is given.
+	CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error)
+
 	roles.Repository
 }
 
@@ -249,6 +249,83 @@ func (_c *Repository_ChangeStatus_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// CountByDomain provides a mock function for the type Repository
+func (_mock *Repository) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	var tmpRet mock.Arguments
+	if len(domainIDs) > 0 {
+		tmpRet = _mock.Called(ctx, domainIDs)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByDomain")
+	}
+
+	var r0 map[string]uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) (map[string]uint64, error)); ok {
+		return returnFunc(ctx, domainIDs...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) map[string]uint64); ok {
+		r0 = returnFunc(ctx, domainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]uint64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = returnFunc(ctx, domainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CountByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByDomain'
+type Repository_CountByDomain_Call struct {
+	*mock.Call
+}
+
+// CountByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainIDs ...string
+func (_e *Repository_Expecter) CountByDomain(ctx interface{}, domainIDs ...interface{}) *Repository_CountByDomain_Call {
+	return &Repository_CountByDomain_Call{Call: _e.mock.On("CountByDomain",
+		append([]interface{}{ctx}, domainIDs...)...)}
+}
+
+func (_c *Repository_CountByDomain_Call) Run(run func(ctx context.Context, domainIDs ...string)) *Repository_CountByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		var variadicArgs []string
+		if len(args) > 1 {
+			variadicArgs = args[1].([]string)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) Return(stringToUint64 map[string]uint64, err error) *Repository_CountByDomain_Call {
+	_c.Call.Return(stringToUint64, err)
+	return _c
+}
+
+func (_c *Repository_CountByDomain_Call) RunAndReturn(run func(ctx context.Context, domainIDs ...string) (map[string]uint64, error)) *Repository_CountByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type Repository
 func (_mock *Repository) Delete(ctx context.Context, groupID string) error {
 	ret := _mock.Called(ctx, groupID)
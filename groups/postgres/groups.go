@@ -790,6 +790,35 @@ func (repo groupRepository) Delete(ctx context.Context, groupID string) error {
 	return nil
 }
 
+func (repo groupRepository) CountByDomain(ctx context.Context, domainIDs ...string) (map[string]uint64, error) {
+	q := "SELECT domain_id, COUNT(*) AS total FROM groups GROUP BY domain_id"
+	if len(domainIDs) > 0 {
+		q = "SELECT domain_id, COUNT(*) AS total FROM groups WHERE domain_id = ANY(:domain_ids) GROUP BY domain_id"
+	}
+	params := map[string]any{
+		"domain_ids": domainIDs,
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+	for rows.Next() {
+		var c struct {
+			DomainID string `db:"domain_id"`
+			Total    uint64 `db:"total"`
+		}
+		if err := rows.StructScan(&c); err != nil {
+			return nil, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+		}
+		counts[c.DomainID] = c.Total
+	}
+
+	return counts, nil
+}
+
 func (repo groupRepository) RetrieveAllParentGroups(ctx context.Context, domainID, userID, groupID string, pm groups.PageMeta) (groups.Page, error) {
 	cGroup, err := repo.RetrieveByID(ctx, groupID)
 	if err != nil {
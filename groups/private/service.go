@@ -7,22 +7,73 @@ import (
 	"context"
 
 	"github.com/absmach/supermq/groups"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/roles"
 )
 
+const defLimit = uint64(100)
+
 type Service interface {
 	RetrieveById(ctx context.Context, id string) (groups.Group, error)
+	// DeleteDomainGroups removes all the groups of the domain together with their roles and policies.
+	DeleteDomainGroups(ctx context.Context, domainID string) error
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo groups.Repository) Service {
-	return service{repo}
+func New(repo groups.Repository, policy policies.Service) Service {
+	return service{repo, policy}
 }
 
 type service struct {
-	repo groups.Repository
+	repo   groups.Repository
+	policy policies.Service
 }
 
 func (svc service) RetrieveById(ctx context.Context, ids string) (groups.Group, error) {
 	return svc.repo.RetrieveByID(ctx, ids)
 }
+
+func (svc service) DeleteDomainGroups(ctx context.Context, domainID string) error {
+	pm := groups.PageMeta{DomainID: domainID, Status: groups.AllStatus, Limit: defLimit}
+	for {
+		gp, err := svc.repo.RetrieveAll(ctx, pm)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		if len(gp.Groups) == 0 {
+			return nil
+		}
+
+		ids := []string{}
+		for _, g := range gp.Groups {
+			ids = append(ids, g.ID)
+		}
+		if err := roles.RemoveEntitiesRolesPolicies(ctx, svc.repo, svc.policy, policies.GroupType, domainID, ids); err != nil {
+			return errors.Wrap(svcerr.ErrDeletePolicies, err)
+		}
+		for _, id := range ids {
+			filterDeletePolicies := []policies.Policy{
+				{
+					SubjectType: policies.GroupType,
+					Subject:     id,
+				},
+				{
+					ObjectType: policies.GroupType,
+					Object:     id,
+				},
+			}
+			for _, fp := range filterDeletePolicies {
+				if err := svc.policy.DeletePolicyFilter(ctx, fp); err != nil {
+					return errors.Wrap(svcerr.ErrDeletePolicies, err)
+				}
+			}
+			// Group roles are removed by the database cascade.
+			if err := svc.repo.Delete(ctx, id); err != nil {
+				return errors.Wrap(svcerr.ErrRemoveEntity, err)
+			}
+		}
+	}
+}
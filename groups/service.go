@@ -6,13 +6,16 @@ package groups
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/absmach/supermq"
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/domains"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/policies"
@@ -24,6 +27,7 @@ var (
 	errChangeGroupStatus = errors.NewServiceError("failed to change group status")
 	errGroupHaveParent   = errors.NewRequestError("group already have parent")
 	errDifferentParent   = errors.NewRequestError("groups have different parent")
+	errReleaseQuota      = errors.New("failed to release domain quota")
 )
 
 type service struct {
@@ -32,12 +36,14 @@ type service struct {
 	idProvider supermq.IDProvider
 	channels   grpcChannelsV1.ChannelsServiceClient
 	clients    grpcClientsV1.ClientsServiceClient
+	quotas     pkgDomains.Quotas
+	logger     *slog.Logger
 
 	roles.ProvisionManageService
 }
 
 // NewService returns a new groups service implementation.
-func NewService(repo Repository, policy policies.Service, idp supermq.IDProvider, channels grpcChannelsV1.ChannelsServiceClient, clients grpcClientsV1.ClientsServiceClient, sidProvider supermq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func NewService(repo Repository, policy policies.Service, idp supermq.IDProvider, channels grpcChannelsV1.ChannelsServiceClient, clients grpcClientsV1.ClientsServiceClient, quotas pkgDomains.Quotas, sidProvider supermq.IDProvider, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action, logger *slog.Logger) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(policies.GroupType, repo, policy, sidProvider, availableActions, builtInRoles)
 	if err != nil {
 		return service{}, err
@@ -48,23 +54,41 @@ func NewService(repo Repository, policy policies.Service, idp supermq.IDProvider
 		idProvider:             idp,
 		channels:               channels,
 		clients:                clients,
+		quotas:                 quotas,
+		logger:                 logger,
 		ProvisionManageService: rpms,
 	}, nil
 }
 
 func (svc service) CreateGroup(ctx context.Context, session smqauthn.Session, g Group) (retGr Group, retRps []roles.RoleProvision, retErr error) {
-	groupID, err := svc.idProvider.ID()
-	if err != nil {
-		return Group{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	if g.ID == "" {
+		groupID, err := svc.idProvider.ID()
+		if err != nil {
+			return Group{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+		}
+		g.ID = groupID
 	}
 	if g.Status != EnabledStatus && g.Status != DisabledStatus {
 		return Group{}, []roles.RoleProvision{}, svcerr.ErrInvalidStatus
 	}
 
-	g.ID = groupID
 	g.CreatedAt = time.Now().UTC()
 	g.Domain = session.DomainID
 
+	if err := svc.quotas.Reserve(ctx, session.DomainID, domains.GroupsResource, 1); err != nil {
+		if errors.Contains(err, svcerr.ErrQuotaExceeded) {
+			return Group{}, []roles.RoleProvision{}, err
+		}
+		return Group{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRelease := svc.quotas.Release(ctx, session.DomainID, domains.GroupsResource, 1); errRelease != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errReleaseQuota, errRelease))
+			}
+		}
+	}()
+
 	saved, err := svc.repo.Save(ctx, g)
 	if err != nil {
 		return Group{}, []roles.RoleProvision{}, errors.Wrap(svcerr.ErrCreateEntity, err)
@@ -448,9 +472,25 @@ func (svc service) DeleteGroup(ctx context.Context, session smqauthn.Session, id
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
+	svc.releaseQuota(ctx, session.DomainID)
+
 	return nil
 }
 
+// releaseQuota releases the domain quota of the removed group. Since the
+// group is already removed, the failure doesn't fail the request, and the
+// domain usage is reconciled with the domain groups instead.
+func (svc service) releaseQuota(ctx context.Context, domainID string) {
+	err := svc.quotas.Release(ctx, domainID, domains.GroupsResource, 1)
+	if err == nil {
+		return
+	}
+	svc.logger.Warn("failed to release domain quota", slog.String("domain_id", domainID), slog.Any("error", err))
+	if err := pkgDomains.ReconcileUsage(ctx, svc.quotas, domains.GroupsResource, svc.repo.CountByDomain, domainID); err != nil {
+		svc.logger.Error("failed to reconcile domain usage", slog.String("domain_id", domainID), slog.Any("error", err))
+	}
+}
+
 func (svc service) changeGroupStatus(ctx context.Context, session smqauthn.Session, group Group) (Group, error) {
 	dbGroup, err := svc.repo.RetrieveByID(ctx, group.ID)
 	if err != nil {
@@ -15,12 +15,15 @@ import (
 	apiutil "github.com/absmach/supermq/api/http/util"
 	chmocks "github.com/absmach/supermq/channels/mocks"
 	climocks "github.com/absmach/supermq/clients/mocks"
+	"github.com/absmach/supermq/domains"
 	"github.com/absmach/supermq/groups"
 	"github.com/absmach/supermq/groups/mocks"
 	"github.com/absmach/supermq/internal/nullable"
 	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
 	"github.com/absmach/supermq/pkg/authn"
 	smqauthn "github.com/absmach/supermq/pkg/authn"
+	dmocks "github.com/absmach/supermq/pkg/domains/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
@@ -94,6 +97,7 @@ var (
 	policies *policymocks.Service
 	channels *chmocks.ChannelsServiceClient
 	clients  *climocks.ClientsServiceClient
+	quotas   *dmocks.Quotas
 )
 
 func newService(t *testing.T) groups.Service {
@@ -101,11 +105,12 @@ func newService(t *testing.T) groups.Service {
 	policies = new(policymocks.Service)
 	channels = new(chmocks.ChannelsServiceClient)
 	clients = new(climocks.ClientsServiceClient)
+	quotas = new(dmocks.Quotas)
 	availableActions := []roles.Action{}
 	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
 		groups.BuiltInRoleAdmin: availableActions,
 	}
-	svc, err := groups.NewService(repo, policies, idProvider, channels, clients, idProvider, availableActions, builtInRoles)
+	svc, err := groups.NewService(repo, policies, idProvider, channels, clients, quotas, idProvider, availableActions, builtInRoles, smqlog.NewMock())
 	assert.Nil(t, err, fmt.Sprintf(" Unexpected error  while creating service %v", err))
 	return svc
 }
@@ -122,6 +127,7 @@ func TestCreateGroup(t *testing.T) {
 		addPoliciesErr    error
 		deletePoliciesErr error
 		addRoleErr        error
+		reserveErr        error
 		err               error
 	}{
 		{
@@ -134,6 +140,21 @@ func TestCreateGroup(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc: "create group successfully with id",
+			group: groups.Group{
+				ID:          validID,
+				Name:        namegen.Generate(),
+				Description: desc,
+				Status:      groups.EnabledStatus,
+			},
+			saveResp: groups.Group{
+				ID:        validID,
+				CreatedAt: time.Now(),
+				Domain:    validID,
+			},
+			err: nil,
+		},
 		{
 			desc: "create group with invalid status",
 			group: groups.Group{
@@ -212,6 +233,18 @@ func TestCreateGroup(t *testing.T) {
 			deletePoliciesErr: svcerr.ErrRemoveEntity,
 			err:               svcerr.ErrAddPolicies,
 		},
+		{
+			desc:       "create group with exceeded quota",
+			group:      validGroup,
+			reserveErr: svcerr.ErrQuotaExceeded,
+			err:        svcerr.ErrQuotaExceeded,
+		},
+		{
+			desc:       "create group with failed to reserve quota",
+			group:      validGroup,
+			reserveErr: errors.ErrMalformedEntity,
+			err:        svcerr.ErrCreateEntity,
+		},
 	}
 
 	for _, tc := range cases {
@@ -221,6 +254,8 @@ func TestCreateGroup(t *testing.T) {
 			policyCall1 := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			repoCall1 := repo.On("AddRoles", context.Background(), mock.Anything).Return([]roles.RoleProvision{}, tc.addRoleErr)
 			repoCall2 := repo.On("Delete", context.Background(), mock.Anything).Return(tc.deleteErr)
+			quotasCall := quotas.On("Reserve", context.Background(), validSession.DomainID, domains.GroupsResource, uint64(1)).Return(tc.reserveErr)
+			quotasCall1 := quotas.On("Release", context.Background(), validSession.DomainID, domains.GroupsResource, uint64(1)).Return(nil)
 			got, _, err := svc.CreateGroup(context.Background(), validSession, tc.group)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v but got %v", tc.err, err))
 			if err == nil {
@@ -236,6 +271,8 @@ func TestCreateGroup(t *testing.T) {
 			policyCall1.Unset()
 			repoCall1.Unset()
 			repoCall2.Unset()
+			quotasCall.Unset()
+			quotasCall1.Unset()
 		})
 	}
 }
@@ -1252,6 +1289,10 @@ func TestDeleteGroup(t *testing.T) {
 		deleteErr         error
 		unsetFromChannels error
 		unsetFromClients  error
+		releaseErr        error
+		countErr          error
+		reconcileErr      error
+		reconciled        bool
 		err               error
 	}{
 		{
@@ -1299,6 +1340,31 @@ func TestDeleteGroup(t *testing.T) {
 			deletePoliciesErr: svcerr.ErrAuthorization,
 			err:               svcerr.ErrDeletePolicies,
 		},
+		{
+			desc:            "delete group with failed to release quota",
+			id:              validGroup.ID,
+			changeStatusRes: validGroup,
+			releaseErr:      errors.ErrMalformedEntity,
+			reconciled:      true,
+			err:             nil,
+		},
+		{
+			desc:            "delete group with failed to release quota and count groups",
+			id:              validGroup.ID,
+			changeStatusRes: validGroup,
+			releaseErr:      errors.ErrMalformedEntity,
+			countErr:        repoerr.ErrViewEntity,
+			err:             nil,
+		},
+		{
+			desc:            "delete group with failed to release quota and reconcile usage",
+			id:              validGroup.ID,
+			changeStatusRes: validGroup,
+			releaseErr:      errors.ErrMalformedEntity,
+			reconcileErr:    svcerr.ErrUpdateEntity,
+			reconciled:      true,
+			err:             nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1310,7 +1376,14 @@ func TestDeleteGroup(t *testing.T) {
 			repoCall2 := repo.On("RetrieveEntitiesRolesActionsMembers", context.Background(), []string{tc.id}).Return([]roles.EntityActionRole{}, []roles.EntityMemberRole{}, nil)
 			policyCall := policies.On("DeletePolicyFilter", context.Background(), mock.Anything).Return(tc.deletePoliciesErr)
 			policyCall1 := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(nil)
+			quotasCall := quotas.On("Release", context.Background(), validSession.DomainID, domains.GroupsResource, uint64(1)).Return(tc.releaseErr)
+			countCall := repo.On("CountByDomain", context.Background(), []string{validSession.DomainID}).Return(map[string]uint64{}, tc.countErr)
+			reconciled := false
+			reconcileCall := quotas.On("Reconcile", context.Background(), validSession.DomainID, domains.GroupsResource, uint64(0)).Run(func(mock.Arguments) {
+				reconciled = true
+			}).Return(tc.reconcileErr)
 			err := svc.DeleteGroup(context.Background(), validSession, tc.id)
+			assert.Equal(t, tc.reconciled, reconciled, fmt.Sprintf("%s: expected reconciled %t got %t", tc.desc, tc.reconciled, reconciled))
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
 			policyCall.Unset()
 			repoCall.Unset()
@@ -1319,6 +1392,9 @@ func TestDeleteGroup(t *testing.T) {
 			svcCall1.Unset()
 			repoCall2.Unset()
 			policyCall1.Unset()
+			quotasCall.Unset()
+			countCall.Unset()
+			reconcileCall.Unset()
 		})
 	}
 }
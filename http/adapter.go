@@ -6,6 +6,7 @@ package http
 import (
 	"context"
 	"strings"
+	"time"
 
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
@@ -15,7 +16,10 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 var (
@@ -23,6 +27,10 @@ var (
 	ErrFailedSubscription = errors.New("failed to subscribe to a channel")
 	// ErrFailedPublish indicates that client couldn't publish to specified channel.
 	ErrFailedSubscribe = errors.New("failed to unsubscribe from topic")
+	// ErrFailedPublish indicates that client couldn't publish to specified channel.
+	ErrFailedPublish = errors.New("failed to publish to a channel")
+	// ErrFailedLastValues indicates that the last values of the channel couldn't be retrieved.
+	ErrFailedLastValues = errors.New("failed to retrieve last values of a channel")
 	// ErrEmptyTopic indicate absence of clientKey in the request.
 	ErrEmptyTopic = errors.New("empty topic")
 )
@@ -31,33 +39,49 @@ var (
 type Service interface {
 	// Subscribe subscribes message from the broker using the clientKey for authorization,
 	// the channelID for subscription and domainID specifies the domain for authorization.
-	// Subtopic is optional.
+	// Subtopic is optional. Replay optionally defines the point in the channel history
+	// from which the messages are delivered.
 	// If the subscription is successful, nil is returned otherwise error is returned.
-	Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, client *Client) error
+	Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, replay messaging.Replay, client *Client) error
 
 	Unsubscribe(ctx context.Context, sessionID, domainID, chanID, subtopic string, topicType messaging.TopicType) error
+
+	// Publish publishes the payload to the channel using the username and password
+	// for authorization. Subtopic, content type and headers are optional.
+	// If the publishing is successful, nil is returned otherwise error is returned.
+	Publish(ctx context.Context, username, password, domainID, chanID, subtopic string, payload []byte, contentType string, headers map[string]string) error
+
+	// LastValues returns the last messages published to the channel. Subtopic is optional.
+	// Access is authorized by the proxy handler prior forwarding the request.
+	LastValues(ctx context.Context, domainID, chanID, subtopic string) ([]*messaging.Message, error)
 }
 
 var _ Service = (*adapterService)(nil)
 
 type adapterService struct {
-	clients  grpcClientsV1.ClientsServiceClient
-	channels grpcChannelsV1.ChannelsServiceClient
-	authn    smqauthn.Authentication
-	pubsub   messaging.PubSub
+	clients    grpcClientsV1.ClientsServiceClient
+	channels   grpcChannelsV1.ChannelsServiceClient
+	authn      smqauthn.Authentication
+	pubsub     messaging.PubSub
+	validator  schema.Validator
+	limiter    ratelimit.Limiter
+	lastValues lastvalue.Store
 }
 
 // NewService instantiates the HTTP adapter implementation.
-func NewService(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, pubsub messaging.PubSub) Service {
+func NewService(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, pubsub messaging.PubSub, validator schema.Validator, limiter ratelimit.Limiter, lastValues lastvalue.Store) Service {
 	return &adapterService{
-		clients:  clients,
-		channels: channels,
-		authn:    authn,
-		pubsub:   pubsub,
+		clients:    clients,
+		channels:   channels,
+		authn:      authn,
+		pubsub:     pubsub,
+		validator:  validator,
+		limiter:    limiter,
+		lastValues: lastValues,
 	}
 }
 
-func (svc *adapterService) Subscribe(ctx context.Context, sessionID, username, password, domainID, channelID, subtopic string, topicType messaging.TopicType, c *Client) error {
+func (svc *adapterService) Subscribe(ctx context.Context, sessionID, username, password, domainID, channelID, subtopic string, topicType messaging.TopicType, replay messaging.Replay, c *Client) error {
 	if (channelID == "" && topicType != messaging.HealthType) || password == "" || domainID == "" {
 		return svcerr.ErrAuthentication
 	}
@@ -81,6 +105,7 @@ func (svc *adapterService) Subscribe(ctx context.Context, sessionID, username, p
 		Topic:    subject,
 		Handler:  c,
 	}
+	replay.Apply(&subCfg)
 	if err := svc.pubsub.Subscribe(ctx, subCfg); err != nil {
 		return errors.Wrap(ErrFailedSubscription, err)
 	}
@@ -100,6 +125,72 @@ func (svc *adapterService) Unsubscribe(ctx context.Context, sessionID, domainID,
 	return nil
 }
 
+func (svc *adapterService) Publish(ctx context.Context, username, password, domainID, channelID, subtopic string, payload []byte, contentType string, headers map[string]string) error {
+	if password == "" || domainID == "" {
+		return svcerr.ErrAuthentication
+	}
+	if channelID == "" {
+		return ErrEmptyTopic
+	}
+
+	clientID, err := svc.authorize(ctx, username, password, domainID, channelID, connections.Publish, messaging.MessageType)
+	if err != nil {
+		return svcerr.ErrAuthorization
+	}
+
+	contentType, err = messaging.ParseContentType(contentType)
+	if err != nil {
+		return errors.Wrap(ErrFailedPublish, err)
+	}
+	if err := messaging.ValidateHeaders(headers); err != nil {
+		return errors.Wrap(ErrFailedPublish, err)
+	}
+
+	if err := svc.limiter.Allow(ctx, domainID); err != nil {
+		if errors.Contains(err, ratelimit.ErrRateExceeded) {
+			return err
+		}
+		return errors.Wrap(ErrFailedPublish, err)
+	}
+
+	if err := svc.validator.Validate(ctx, domainID, channelID, payload); err != nil {
+		if schema.IsViolation(err) {
+			return err
+		}
+		return errors.Wrap(ErrFailedPublish, err)
+	}
+
+	msg := messaging.Message{
+		Protocol:    protocol,
+		Domain:      domainID,
+		Channel:     channelID,
+		Subtopic:    subtopic,
+		Payload:     payload,
+		Publisher:   clientID,
+		Created:     time.Now().UnixNano(),
+		ContentType: contentType,
+		Headers:     headers,
+	}
+	if err := svc.pubsub.Publish(ctx, messaging.EncodeMessageTopic(&msg), &msg); err != nil {
+		return errors.Wrap(ErrFailedPublish, err)
+	}
+
+	return nil
+}
+
+func (svc *adapterService) LastValues(ctx context.Context, domainID, channelID, subtopic string) ([]*messaging.Message, error) {
+	if channelID == "" {
+		return nil, ErrEmptyTopic
+	}
+
+	msgs, err := svc.lastValues.Retrieve(ctx, domainID, channelID, subtopic)
+	if err != nil {
+		return nil, errors.Wrap(ErrFailedLastValues, err)
+	}
+
+	return msgs, nil
+}
+
 // authorize checks if the authKey is authorized to access the channel
 // and returns the clientID or userID if it is.
 func (svc *adapterService) authorize(ctx context.Context, username, password, domainID, chanID string, msgType connections.ConnType, topicType messaging.TopicType) (string, error) {
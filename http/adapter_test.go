@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"strings"
 	"testing"
+	"time"
 
 	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
@@ -22,10 +23,16 @@ import (
 	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/connections"
 	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	lvmocks "github.com/absmach/supermq/pkg/messaging/lastvalue/mocks"
 	"github.com/absmach/supermq/pkg/messaging/mocks"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	rlmocks "github.com/absmach/supermq/pkg/ratelimit/mocks"
+	"github.com/absmach/supermq/pkg/schema"
+	schemamocks "github.com/absmach/supermq/pkg/schema/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -59,17 +66,20 @@ var (
 	invalidEncodedCreds = base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", invalidID, invalidKey)))
 )
 
-func newService() (smqhttp.Service, *mocks.PubSub, *climocks.ClientsServiceClient, *chmocks.ChannelsServiceClient, *authnmocks.Authentication) {
+func newService() (smqhttp.Service, *mocks.PubSub, *climocks.ClientsServiceClient, *chmocks.ChannelsServiceClient, *authnmocks.Authentication, *schemamocks.Validator, *rlmocks.Limiter, *lvmocks.Store) {
 	pubsub := new(mocks.PubSub)
 	clients := new(climocks.ClientsServiceClient)
 	channels := new(chmocks.ChannelsServiceClient)
 	authn := new(authnmocks.Authentication)
+	validator := new(schemamocks.Validator)
+	limiter := new(rlmocks.Limiter)
+	lastValues := new(lvmocks.Store)
 
-	return smqhttp.NewService(clients, channels, authn, pubsub), pubsub, clients, channels, authn
+	return smqhttp.NewService(clients, channels, authn, pubsub, validator, limiter, lastValues), pubsub, clients, channels, authn, validator, limiter, lastValues
 }
 
 func TestSubscribe(t *testing.T) {
-	svc, pubsub, clients, channels, auth := newService()
+	svc, pubsub, clients, channels, auth, _, _, _ := newService()
 
 	c := smqhttp.NewClient(slog.Default(), nil, sessionID)
 
@@ -83,6 +93,7 @@ func TestSubscribe(t *testing.T) {
 		clientType string
 		clientID   string
 		topicType  messaging.TopicType
+		replay     messaging.Replay
 		authNToken string
 		authNRes   *grpcClientsV1.AuthnRes
 		authNErr   error
@@ -105,6 +116,34 @@ func TestSubscribe(t *testing.T) {
 			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
 			err:        nil,
 		},
+		{
+			desc:       "subscribe to channel with replay of the last messages",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			topicType:  messaging.MessageType,
+			replay:     messaging.Replay{LastN: 10},
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        nil,
+		},
+		{
+			desc:       "subscribe to channel with replay from start time",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			topicType:  messaging.MessageType,
+			replay:     messaging.Replay{StartTime: time.Now().Add(-time.Hour)},
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        nil,
+		},
 		{
 			desc:      "subscribe to channel with valid token, chanID, subtopic",
 			password:  token,
@@ -345,6 +384,7 @@ func TestSubscribe(t *testing.T) {
 				ClientID: tc.clientID,
 				Handler:  c,
 			}
+			tc.replay.Apply(&subConfig)
 			tc.clientType = policies.ClientType
 			if strings.HasPrefix(tc.password, apiutil.BearerPrefix) {
 				tc.clientType = policies.UserType
@@ -359,7 +399,7 @@ func TestSubscribe(t *testing.T) {
 				DomainId:   tc.domainID,
 			}).Return(tc.authZRes, tc.authZErr)
 			repoCall := pubsub.On("Subscribe", mock.Anything, subConfig).Return(tc.subErr)
-			err := svc.Subscribe(context.Background(), sessionID, tc.username, tc.password, tc.domainID, tc.chanID, tc.subtopic, tc.topicType, c)
+			err := svc.Subscribe(context.Background(), sessionID, tc.username, tc.password, tc.domainID, tc.chanID, tc.subtopic, tc.topicType, tc.replay, c)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			repoCall.Unset()
 			clientsCall.Unset()
@@ -368,3 +408,318 @@ func TestSubscribe(t *testing.T) {
 		})
 	}
 }
+
+func TestServicePublish(t *testing.T) {
+	svc, pubsub, clients, channels, auth, validator, limiter, _ := newService()
+
+	cases := []struct {
+		desc        string
+		username    string
+		password    string
+		chanID      string
+		domainID    string
+		subtopic    string
+		clientType  string
+		clientID    string
+		payload     []byte
+		contentType string
+		headers     map[string]string
+		authNToken  string
+		authNRes    *grpcClientsV1.AuthnRes
+		authNErr    error
+		authNRes1   smqauthn.Session
+		authZRes    *grpcChannelsV1.AuthzRes
+		authZErr    error
+		limitErr    error
+		validateErr error
+		pubErr      error
+		err         error
+	}{
+		{
+			desc:       "publish to channel with valid clientKey, chanID, subtopic",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        nil,
+		},
+		{
+			desc:      "publish to channel with valid token, chanID, subtopic",
+			password:  token,
+			chanID:    chanID,
+			domainID:  domainID,
+			clientID:  userID,
+			subtopic:  subTopic,
+			payload:   msg.Payload,
+			authNRes1: smqauthn.Session{UserID: userID},
+			authZRes:  &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:       nil,
+		},
+		{
+			desc:        "publish to channel with content type and headers",
+			password:    clientKey,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			subtopic:    subTopic,
+			payload:     msg.Payload,
+			contentType: "application/senml+json; charset=utf-8",
+			headers:     map[string]string{"correlation-id": "42"},
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:         nil,
+		},
+		{
+			desc:        "publish to channel with malformed content type",
+			password:    clientKey,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			payload:     msg.Payload,
+			contentType: "application/json;;",
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:         messaging.ErrMalformedContentType,
+		},
+		{
+			desc:       "publish to channel with malformed headers",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			payload:    msg.Payload,
+			headers:    map[string]string{"Correlation ID": "42"},
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:       "publish to channel with basic auth",
+			username:   clientID,
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.BasicAuth, clientID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        nil,
+		},
+		{
+			desc:      "publish to channel with invalid token",
+			password:  invalidToken,
+			chanID:    chanID,
+			domainID:  domainID,
+			subtopic:  subTopic,
+			payload:   msg.Payload,
+			authNRes1: smqauthn.Session{},
+			authNErr:  svcerr.ErrAuthentication,
+			err:       svcerr.ErrAuthorization,
+		},
+		{
+			desc:       "publish to channel with failed authorization",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: false},
+			err:        svcerr.ErrAuthorization,
+		},
+		{
+			desc:       "publish to channel with publish set to fail",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			pubErr:     errors.New("failed to publish"),
+			err:        smqhttp.ErrFailedPublish,
+		},
+		{
+			desc:        "publish to channel with payload violating channel schema",
+			password:    clientKey,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			subtopic:    subTopic,
+			payload:     msg.Payload,
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: schema.ErrSchemaViolation,
+			err:         schema.ErrSchemaViolation,
+		},
+		{
+			desc:        "publish to channel with failed payload validation",
+			password:    clientKey,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			subtopic:    subTopic,
+			payload:     msg.Payload,
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: svcerr.ErrViewEntity,
+			err:         smqhttp.ErrFailedPublish,
+		},
+		{
+			desc:       "publish to channel with exceeded message rate",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr:   ratelimit.ErrRateExceeded,
+			err:        ratelimit.ErrRateExceeded,
+		},
+		{
+			desc:       "publish to channel with failed message rate check",
+			password:   clientKey,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			subtopic:   subTopic,
+			payload:    msg.Payload,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr:   svcerr.ErrViewEntity,
+			err:        smqhttp.ErrFailedPublish,
+		},
+		{
+			desc:     "publish to channel with empty clientKey",
+			password: "",
+			chanID:   chanID,
+			domainID: domainID,
+			payload:  msg.Payload,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "publish to channel with empty domain",
+			password: clientKey,
+			chanID:   chanID,
+			domainID: "",
+			payload:  msg.Payload,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "publish to empty channel",
+			password: clientKey,
+			chanID:   "",
+			domainID: domainID,
+			payload:  msg.Payload,
+			err:      smqhttp.ErrEmptyTopic,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.clientType = policies.ClientType
+			if strings.HasPrefix(tc.password, apiutil.BearerPrefix) {
+				tc.clientType = policies.UserType
+			}
+			topic := tc.domainID + ".c." + tc.chanID
+			if tc.subtopic != "" {
+				topic += "." + tc.subtopic
+			}
+			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{Token: tc.authNToken}).Return(tc.authNRes, tc.authNErr)
+			authCall := auth.On("Authenticate", mock.Anything, strings.TrimPrefix(tc.password, apiutil.BearerPrefix)).Return(tc.authNRes1, tc.authNErr)
+			channelsCall := channels.On("Authorize", mock.Anything, &grpcChannelsV1.AuthzReq{
+				ClientType: tc.clientType,
+				ClientId:   tc.clientID,
+				Type:       uint32(connections.Publish),
+				ChannelId:  tc.chanID,
+				DomainId:   tc.domainID,
+			}).Return(tc.authZRes, tc.authZErr)
+			limitCall := limiter.On("Allow", mock.Anything, tc.domainID).Return(tc.limitErr)
+			validateCall := validator.On("Validate", mock.Anything, tc.domainID, tc.chanID, tc.payload).Return(tc.validateErr)
+			contentType, _ := messaging.ParseContentType(tc.contentType)
+			repoCall := pubsub.On("Publish", mock.Anything, topic, mock.Anything).Run(func(args mock.Arguments) {
+				m := args.Get(2).(*messaging.Message)
+				assert.Equal(t, contentType, m.GetContentType(), fmt.Sprintf("%s: expected content type %s got %s\n", tc.desc, contentType, m.GetContentType()))
+				assert.Equal(t, tc.headers, m.GetHeaders(), fmt.Sprintf("%s: expected headers %v got %v\n", tc.desc, tc.headers, m.GetHeaders()))
+			}).Return(tc.pubErr)
+			err := svc.Publish(context.Background(), tc.username, tc.password, tc.domainID, tc.chanID, tc.subtopic, tc.payload, tc.contentType, tc.headers)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			limitCall.Unset()
+			validateCall.Unset()
+			repoCall.Unset()
+			clientsCall.Unset()
+			authCall.Unset()
+			channelsCall.Unset()
+		})
+	}
+}
+
+func TestLastValues(t *testing.T) {
+	svc, _, _, _, _, _, _, lastValues := newService()
+
+	cases := []struct {
+		desc     string
+		domainID string
+		chanID   string
+		subtopic string
+		msgs     []*messaging.Message
+		retErr   error
+		err      error
+	}{
+		{
+			desc:     "retrieve last values of channel",
+			domainID: domainID,
+			chanID:   chanID,
+			msgs:     []*messaging.Message{&msg},
+		},
+		{
+			desc:     "retrieve last values of subtopic",
+			domainID: domainID,
+			chanID:   chanID,
+			subtopic: subTopic,
+			msgs:     []*messaging.Message{},
+		},
+		{
+			desc:     "retrieve last values with empty channel",
+			domainID: domainID,
+			chanID:   "",
+			err:      smqhttp.ErrEmptyTopic,
+		},
+		{
+			desc:     "retrieve last values with failed store retrieve",
+			domainID: domainID,
+			chanID:   chanID,
+			retErr:   repoerr.ErrViewEntity,
+			err:      smqhttp.ErrFailedLastValues,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := lastValues.On("Retrieve", mock.Anything, tc.domainID, tc.chanID, tc.subtopic).Return(tc.msgs, tc.retErr)
+			msgs, err := svc.LastValues(context.Background(), tc.domainID, tc.chanID, tc.subtopic)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.msgs, msgs)
+			repoCall.Unset()
+		})
+	}
+}
@@ -59,6 +59,35 @@ func sendMessageEndpoint() endpoint.Endpoint {
 	}
 }
 
+func lastValuesEndpoint(svc smqhttp.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(lastValuesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		msgs, err := svc.LastValues(ctx, req.domainID, req.channelID, req.subtopic)
+		if err != nil {
+			return nil, err
+		}
+
+		res := lastValuesRes{Messages: []lastValue{}}
+		for _, msg := range msgs {
+			res.Messages = append(res.Messages, lastValue{
+				Subtopic:    msg.GetSubtopic(),
+				Publisher:   msg.GetPublisher(),
+				Protocol:    msg.GetProtocol(),
+				ContentType: msg.GetContentType(),
+				Headers:     msg.GetHeaders(),
+				Payload:     msg.GetPayload(),
+				Created:     msg.GetCreated(),
+			})
+		}
+
+		return res, nil
+	}
+}
+
 func healthCheckEndpoint() endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(healthCheckReq)
@@ -98,7 +127,7 @@ func handleWebSocket(ctx context.Context, svc smqhttp.Service, resolver messagin
 
 	go client.Start(ctx)
 
-	if err := svc.Subscribe(ctx, sessionID, req.username, req.password, req.domainID, req.channelID, req.subtopic, messaging.MessageType, client); err != nil {
+	if err := svc.Subscribe(ctx, sessionID, req.username, req.password, req.domainID, req.channelID, req.subtopic, messaging.MessageType, req.replay, client); err != nil {
 		conn.Close()
 		return
 	}
@@ -5,6 +5,7 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -32,10 +33,15 @@ import (
 	smqauthn "github.com/absmach/supermq/pkg/authn"
 	authnMocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/connections"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	lvmocks "github.com/absmach/supermq/pkg/messaging/lastvalue/mocks"
 	pubsub "github.com/absmach/supermq/pkg/messaging/mocks"
 	"github.com/absmach/supermq/pkg/policies"
+	rlmocks "github.com/absmach/supermq/pkg/ratelimit/mocks"
+	schemamocks "github.com/absmach/supermq/pkg/schema/mocks"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -65,8 +71,8 @@ var (
 	userID   = testsutil.GenerateUUID(&testing.T{})
 )
 
-func newService(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, pubsub *pubsub.PubSub) server.Service {
-	return server.NewService(clients, channels, authn, pubsub)
+func newService(clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, authn smqauthn.Authentication, pubsub *pubsub.PubSub, lastValues lastvalue.Store) server.Service {
+	return server.NewService(clients, channels, authn, pubsub, newValidator(), newLimiter(), lastValues)
 }
 
 func newHandler(authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, domains grpcDomainsV1.DomainsServiceClient) (session.Handler, *pubsub.PubSub, error) {
@@ -76,7 +82,21 @@ func newHandler(authn smqauthn.Authentication, clients grpcClientsV1.ClientsServ
 		return nil, nil, err
 	}
 
-	return server.NewHandler(pub, smqlog.NewMock(), authn, clients, channels, parser), pub, nil
+	return server.NewHandler(pub, smqlog.NewMock(), authn, clients, channels, parser, newValidator(), newLimiter()), pub, nil
+}
+
+func newValidator() *schemamocks.Validator {
+	validator := new(schemamocks.Validator)
+	validator.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return validator
+}
+
+func newLimiter() *rlmocks.Limiter {
+	limiter := new(rlmocks.Limiter)
+	limiter.On("Allow", mock.Anything, mock.Anything).Return(nil)
+
+	return limiter
 }
 
 func newTargetHTTPServer(resolver messaging.TopicResolver, svc server.Service) *httptest.Server {
@@ -144,7 +164,7 @@ func TestPublish(t *testing.T) {
 	resolver := messaging.NewTopicResolver(channels, domains)
 	handler, pubsub, err := newHandler(authn, clients, channels, domains)
 	assert.Nil(t, err, fmt.Sprintf("failed to create handler with err: %v", err))
-	svc := newService(clients, channels, authn, pubsub)
+	svc := newService(clients, channels, authn, pubsub, new(lvmocks.Store))
 	target := newTargetHTTPServer(resolver, svc)
 	defer target.Close()
 	ts, err := newProxyHTPPServer(handler, target)
@@ -357,6 +377,139 @@ func TestPublish(t *testing.T) {
 	}
 }
 
+func TestLastValues(t *testing.T) {
+	clients := new(climocks.ClientsServiceClient)
+	authn := new(authnMocks.Authentication)
+	channels := new(chmocks.ChannelsServiceClient)
+	domains := new(dmocks.DomainsServiceClient)
+	lastValues := new(lvmocks.Store)
+	resolver := messaging.NewTopicResolver(channels, domains)
+	handler, pubsub, err := newHandler(authn, clients, channels, domains)
+	assert.Nil(t, err, fmt.Sprintf("failed to create handler with err: %v", err))
+	svc := newService(clients, channels, authn, pubsub, lastValues)
+	target := newTargetHTTPServer(resolver, svc)
+	defer target.Close()
+	ts, err := newProxyHTPPServer(handler, target)
+	require.Nil(t, err)
+	defer ts.Close()
+
+	lastValue := &messaging.Message{
+		Domain:    domainID,
+		Channel:   chanID,
+		Publisher: clientID,
+		Protocol:  "http",
+		Payload:   []byte(msg),
+	}
+
+	cases := []struct {
+		desc     string
+		method   string
+		query    string
+		subtopic string
+		key      string
+		authnRes *grpcClientsV1.AuthnRes
+		authzRes *grpcChannelsV1.AuthzRes
+		msgs     []*messaging.Message
+		retErr   error
+		status   int
+	}{
+		{
+			desc:     "retrieve last values successfully",
+			method:   http.MethodGet,
+			key:      clientKey,
+			authnRes: &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			msgs:     []*messaging.Message{lastValue},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "retrieve last values of subtopic successfully",
+			method:   http.MethodGet,
+			query:    "?subtopic=temperature",
+			subtopic: "temperature",
+			key:      clientKey,
+			authnRes: &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			msgs:     []*messaging.Message{},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "retrieve last values with invalid key",
+			method:   http.MethodGet,
+			key:      invalidKey,
+			authnRes: &grpcClientsV1.AuthnRes{Authenticated: false},
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:     "retrieve last values without subscribe permission",
+			method:   http.MethodGet,
+			key:      clientKey,
+			authnRes: &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: false},
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:     "retrieve last values with failed store retrieve",
+			method:   http.MethodGet,
+			key:      clientKey,
+			authnRes: &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			retErr:   repoerr.ErrViewEntity,
+			status:   http.StatusInternalServerError,
+		},
+		{
+			desc:     "publish to last values path",
+			method:   http.MethodPost,
+			key:      clientKey,
+			authnRes: &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{Token: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, tc.key)}).Return(tc.authnRes, nil)
+			channelsCall := channels.On("Authorize", mock.Anything, &grpcChannelsV1.AuthzReq{
+				DomainId:   domainID,
+				ChannelId:  chanID,
+				ClientId:   clientID,
+				ClientType: policies.ClientType,
+				Type:       uint32(connections.Subscribe),
+			}).Return(tc.authzRes, nil)
+			repoCall := lastValues.On("Retrieve", mock.Anything, domainID, chanID, tc.subtopic).Return(tc.msgs, tc.retErr)
+			req := testRequest{
+				client:      ts.Client(),
+				method:      tc.method,
+				url:         fmt.Sprintf("%s/m/%s/c/%s/latest%s", ts.URL, domainID, chanID, tc.query),
+				contentType: ctSenmlJSON,
+				token:       tc.key,
+				body:        strings.NewReader(msg),
+			}
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusOK {
+				var body struct {
+					Messages []struct {
+						Publisher string `json:"publisher"`
+						Payload   []byte `json:"payload"`
+					} `json:"messages"`
+				}
+				err := json.NewDecoder(res.Body).Decode(&body)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error decoding body %s", tc.desc, err))
+				assert.Equal(t, len(tc.msgs), len(body.Messages))
+			}
+			if tc.method == http.MethodPost {
+				pubsub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+			}
+			clientsCall.Unset()
+			channelsCall.Unset()
+			repoCall.Unset()
+		})
+	}
+}
+
 func TestHandshake(t *testing.T) {
 	clients := new(climocks.ClientsServiceClient)
 	channels := new(chmocks.ChannelsServiceClient)
@@ -365,7 +518,7 @@ func TestHandshake(t *testing.T) {
 	resolver := messaging.NewTopicResolver(channels, domains)
 	handler, pubsub, err := newHandler(authn, clients, channels, domains)
 	assert.Nil(t, err, fmt.Sprintf("failed to create handler with err: %v", err))
-	svc := newService(clients, channels, authn, pubsub)
+	svc := newService(clients, channels, authn, pubsub, new(lvmocks.Store))
 	target := newTargetHTTPServer(resolver, svc)
 	defer target.Close()
 	ts, err := newProxyHTPPServer(handler, target)
@@ -529,3 +682,121 @@ func handshake(tsURL, domainID, chanID, subtopic, clientKey string, addHeader bo
 
 	return conn, res, errRet
 }
+
+func TestMuxWebSocket(t *testing.T) {
+	clients := new(climocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+	authn := new(authnMocks.Authentication)
+	domains := new(dmocks.DomainsServiceClient)
+	resolver := messaging.NewTopicResolver(channels, domains)
+	pubsub := new(pubsub.PubSub)
+	svc := newService(clients, channels, authn, pubsub, new(lvmocks.Store))
+	target := newTargetHTTPServer(resolver, svc)
+	defer target.Close()
+
+	pubsub.On("Subscribe", mock.Anything, mock.Anything).Return(nil)
+	pubsub.On("Unsubscribe", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	pubsub.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	clients.On("Authenticate", mock.Anything, mock.Anything).Return(&grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true}, nil)
+	channels.On("Authorize", mock.Anything, mock.Anything).Return(&grpcChannelsV1.AuthzRes{Authorized: true}, nil)
+
+	u, _ := url.Parse(target.URL)
+	u.Scheme = wsProtocol
+	_, res, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s/m/%s/ws", u, domainID), nil)
+	assert.NotNil(t, err, "expected error connecting without credentials")
+	assert.Equal(t, http.StatusForbidden, res.StatusCode, fmt.Sprintf("expected status code '%d' got '%d'", http.StatusForbidden, res.StatusCode))
+
+	header := http.Header{}
+	header.Add("Authorization", clientKey)
+	conn, res, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s/m/%s/ws", u, domainID), header)
+	require.Nil(t, err, fmt.Sprintf("unexpected error connecting: %s", err))
+	defer conn.Close()
+	assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode, fmt.Sprintf("expected status code '%d' got '%d'", http.StatusSwitchingProtocols, res.StatusCode))
+
+	cases := []struct {
+		desc string
+		req  server.Frame
+		res  server.Frame
+	}{
+		{
+			desc: "subscribe to channel",
+			req:  server.Frame{ID: "1", Type: server.SubscribeFrame, Channel: chanID},
+			res:  server.NewAckFrame("1"),
+		},
+		{
+			desc: "subscribe to channel subtopic",
+			req:  server.Frame{ID: "2", Type: server.SubscribeFrame, Channel: chanID, Subtopic: "sub/>"},
+			res:  server.NewAckFrame("2"),
+		},
+		{
+			desc: "subscribe to channel with invalid subtopic",
+			req:  server.Frame{ID: "3", Type: server.SubscribeFrame, Channel: chanID, Subtopic: "sub/a*b"},
+			res:  server.Frame{ID: "3", Type: server.ErrorFrame},
+		},
+		{
+			desc: "subscribe without channel",
+			req:  server.Frame{ID: "4", Type: server.SubscribeFrame},
+			res:  server.NewErrorFrame("4", apiutil.ErrMissingChannelID),
+		},
+		{
+			desc: "publish to channel",
+			req:  server.Frame{ID: "5", Type: server.PublishFrame, Channel: chanID, Subtopic: "sub", Payload: []byte(msg)},
+			res:  server.NewAckFrame("5"),
+		},
+		{
+			desc: "publish empty payload",
+			req:  server.Frame{ID: "6", Type: server.PublishFrame, Channel: chanID},
+			res:  server.NewErrorFrame("6", apiutil.ErrEmptyMessage),
+		},
+		{
+			desc: "unsubscribe from channel subtopic",
+			req:  server.Frame{ID: "7", Type: server.UnsubscribeFrame, Channel: chanID, Subtopic: "sub/>"},
+			res:  server.NewAckFrame("7"),
+		},
+		{
+			desc: "unsubscribe from channel subtopic without subscription",
+			req:  server.Frame{ID: "8", Type: server.UnsubscribeFrame, Channel: chanID, Subtopic: "sub/>"},
+			res:  server.Frame{ID: "8", Type: server.ErrorFrame},
+		},
+		{
+			desc: "send unsupported frame",
+			req:  server.Frame{ID: "9", Type: server.AckFrame, Channel: chanID},
+			res:  server.Frame{ID: "9", Type: server.ErrorFrame},
+		},
+		{
+			desc: "subscribe to channel with replay of the last messages",
+			req:  server.Frame{ID: "10", Type: server.SubscribeFrame, Channel: chanID, Subtopic: "replay", Last: 10},
+			res:  server.NewAckFrame("10"),
+		},
+		{
+			desc: "subscribe to channel with conflicting replay options",
+			req:  server.Frame{ID: "11", Type: server.SubscribeFrame, Channel: chanID, StartSequence: 1, Last: 10},
+			res:  server.NewErrorFrame("11", messaging.ErrMalformedReplay),
+		},
+		{
+			desc: "publish to channel with content type and headers",
+			req:  server.Frame{ID: "12", Type: server.PublishFrame, Channel: chanID, ContentType: "application/senml+json", Headers: map[string]string{"correlation-id": "42"}, Payload: []byte(msg)},
+			res:  server.NewAckFrame("12"),
+		},
+		{
+			desc: "publish to channel with malformed headers",
+			req:  server.Frame{ID: "13", Type: server.PublishFrame, Channel: chanID, Headers: map[string]string{"Correlation ID": "42"}, Payload: []byte(msg)},
+			res:  server.Frame{ID: "13", Type: server.ErrorFrame},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := conn.WriteJSON(tc.req)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error writing frame: %s", tc.desc, err))
+			var res server.Frame
+			err = conn.ReadJSON(&res)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error reading frame: %s", tc.desc, err))
+			assert.Equal(t, tc.res.ID, res.ID, fmt.Sprintf("%s: expected id %s got %s", tc.desc, tc.res.ID, res.ID))
+			assert.Equal(t, tc.res.Type, res.Type, fmt.Sprintf("%s: expected type %s got %s", tc.desc, tc.res.Type, res.Type))
+			if tc.res.Error != "" {
+				assert.Equal(t, tc.res.Error, res.Error, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.res.Error, res.Error))
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	smqhttp "github.com/absmach/supermq/http"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// muxSession keeps the state of a multiplexed WebSocket connection.
+// Credentials provided during the handshake are used to authorize
+// every subscribe and publish frame.
+type muxSession struct {
+	id       string
+	req      connReq
+	svc      smqhttp.Service
+	resolver messaging.TopicResolver
+	client   *smqhttp.Client
+	mu       sync.Mutex
+	subs     map[string]subscription
+}
+
+type subscription struct {
+	channelID string
+	subtopic  string
+}
+
+func muxHandler(ctx context.Context, svc smqhttp.Service, resolver messaging.TopicResolver, logger *slog.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !isWebSocketRequest(r) {
+			encodeError(ctx, w, errMethodNotAllowed)
+			return
+		}
+		handleMuxWebSocket(ctx, svc, resolver, logger, w, r)
+	}
+}
+
+func handleMuxWebSocket(ctx context.Context, svc smqhttp.Service, resolver messaging.TopicResolver, logger *slog.Logger, w http.ResponseWriter, r *http.Request) {
+	req, err := decodeMuxWSReq(r, resolver, logger)
+	if err != nil {
+		encodeError(ctx, w, err)
+		return
+	}
+
+	sessionID, err := generateSessionID()
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to generate session id: %s", err.Error()))
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to upgrade connection to websocket: %s", err.Error()))
+		return
+	}
+
+	s := &muxSession{
+		id:       sessionID,
+		req:      req,
+		svc:      svc,
+		resolver: resolver,
+		subs:     make(map[string]subscription),
+	}
+	s.client = smqhttp.NewMuxClient(logger, conn, sessionID, s.handle)
+	s.client.SetCloseHandler(func(code int, text string) error {
+		return s.close(ctx)
+	})
+
+	go s.client.Start(ctx)
+
+	logger.Debug(fmt.Sprintf("Successfully upgraded communication to multiplexed WS in domain %s", req.domainID))
+}
+
+func (s *muxSession) handle(ctx context.Context, f smqhttp.Frame) smqhttp.Frame {
+	if err := validateFrame(f); err != nil {
+		return smqhttp.NewErrorFrame(f.ID, err)
+	}
+
+	var err error
+	switch f.Type {
+	case smqhttp.SubscribeFrame:
+		err = s.subscribe(ctx, f)
+	case smqhttp.UnsubscribeFrame:
+		err = s.unsubscribe(ctx, f)
+	case smqhttp.PublishFrame:
+		err = s.publish(ctx, f)
+	}
+	if err != nil {
+		return smqhttp.NewErrorFrame(f.ID, err)
+	}
+
+	return smqhttp.NewAckFrame(f.ID)
+}
+
+func (s *muxSession) subscribe(ctx context.Context, f smqhttp.Frame) error {
+	channelID, err := s.resolveChannel(ctx, f.Channel)
+	if err != nil {
+		return err
+	}
+	subtopic, err := messaging.ParseSubscribeSubtopic(f.Subtopic)
+	if err != nil {
+		return errors.Wrap(errMalformedSubtopic, err)
+	}
+	replay := messaging.Replay{
+		StartTime:     f.StartTime,
+		StartSequence: f.StartSequence,
+		LastN:         f.Last,
+	}
+	if err := replay.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.svc.Subscribe(ctx, s.id, s.req.username, s.req.password, s.req.domainID, channelID, subtopic, messaging.MessageType, replay, s.client); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs[messaging.EncodeTopic(s.req.domainID, channelID, subtopic)] = subscription{
+		channelID: channelID,
+		subtopic:  subtopic,
+	}
+
+	return nil
+}
+
+func (s *muxSession) unsubscribe(ctx context.Context, f smqhttp.Frame) error {
+	channelID, err := s.resolveChannel(ctx, f.Channel)
+	if err != nil {
+		return err
+	}
+	subtopic, err := messaging.ParseSubscribeSubtopic(f.Subtopic)
+	if err != nil {
+		return errors.Wrap(errMalformedSubtopic, err)
+	}
+
+	topic := messaging.EncodeTopic(s.req.domainID, channelID, subtopic)
+	s.mu.Lock()
+	_, ok := s.subs[topic]
+	delete(s.subs, topic)
+	s.mu.Unlock()
+	if !ok {
+		return errNotSubscribed
+	}
+
+	return s.svc.Unsubscribe(ctx, s.id, s.req.domainID, channelID, subtopic, messaging.MessageType)
+}
+
+func (s *muxSession) publish(ctx context.Context, f smqhttp.Frame) error {
+	channelID, err := s.resolveChannel(ctx, f.Channel)
+	if err != nil {
+		return err
+	}
+	subtopic, err := messaging.ParsePublishSubtopic(f.Subtopic)
+	if err != nil {
+		return errors.Wrap(errMalformedSubtopic, err)
+	}
+
+	return s.svc.Publish(ctx, s.req.username, s.req.password, s.req.domainID, channelID, subtopic, f.Payload, f.ContentType, f.Headers)
+}
+
+// close cancels all the subscriptions of the session.
+func (s *muxSession) close(ctx context.Context) error {
+	s.mu.Lock()
+	subs := s.subs
+	s.subs = make(map[string]subscription)
+	s.mu.Unlock()
+
+	var errs error
+	for _, sub := range subs {
+		if err := s.svc.Unsubscribe(ctx, s.id, s.req.domainID, sub.channelID, sub.subtopic, messaging.MessageType); err != nil {
+			errs = errors.Wrap(err, errs)
+		}
+	}
+
+	return errs
+}
+
+func (s *muxSession) resolveChannel(ctx context.Context, channel string) (string, error) {
+	_, channelID, _, err := s.resolver.Resolve(ctx, s.req.domainID, channel)
+	if err != nil {
+		return "", err
+	}
+
+	return channelID, nil
+}
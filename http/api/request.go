@@ -5,6 +5,7 @@ package api
 
 import (
 	apiutil "github.com/absmach/supermq/api/http/util"
+	smqhttp "github.com/absmach/supermq/http"
 	"github.com/absmach/supermq/pkg/messaging"
 )
 
@@ -30,6 +31,41 @@ type connReq struct {
 	channelID string
 	domainID  string
 	subtopic  string
+	replay    messaging.Replay
+}
+
+func validateFrame(f smqhttp.Frame) error {
+	switch f.Type {
+	case smqhttp.SubscribeFrame, smqhttp.UnsubscribeFrame:
+	case smqhttp.PublishFrame:
+		if len(f.Payload) == 0 {
+			return apiutil.ErrEmptyMessage
+		}
+	default:
+		return errUnsupportedFrame
+	}
+	if f.Channel == "" {
+		return apiutil.ErrMissingChannelID
+	}
+
+	return nil
+}
+
+type lastValuesReq struct {
+	domainID  string
+	channelID string
+	subtopic  string
+}
+
+func (req lastValuesReq) validate() error {
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if req.channelID == "" {
+		return apiutil.ErrMissingChannelID
+	}
+
+	return nil
 }
 
 type healthCheckReq struct {
@@ -12,6 +12,7 @@ import (
 var (
 	_ supermq.Response = (*publishMessageRes)(nil)
 	_ supermq.Response = (*healthCheckRes)(nil)
+	_ supermq.Response = (*lastValuesRes)(nil)
 )
 
 type publishMessageRes struct{}
@@ -41,3 +42,29 @@ func (res healthCheckRes) Headers() map[string]string {
 func (res healthCheckRes) Empty() bool {
 	return true
 }
+
+type lastValue struct {
+	Subtopic    string            `json:"subtopic,omitempty"`
+	Publisher   string            `json:"publisher"`
+	Protocol    string            `json:"protocol"`
+	ContentType string            `json:"content_type,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Payload     []byte            `json:"payload"`
+	Created     int64             `json:"created"`
+}
+
+type lastValuesRes struct {
+	Messages []lastValue `json:"messages"`
+}
+
+func (res lastValuesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res lastValuesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res lastValuesRes) Empty() bool {
+	return false
+}
@@ -16,6 +16,7 @@ import (
 	smqhttp "github.com/absmach/supermq/http"
 	"github.com/absmach/supermq/pkg/errors"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
 	"github.com/go-chi/chi/v5"
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/websocket"
@@ -29,6 +30,7 @@ const (
 	contentType         = "application/json"
 	authzHeaderKey      = "Authorization"
 	authzQueryKey       = "authorization"
+	subtopicQueryKey    = "subtopic"
 	connHeaderKey       = "Connection"
 	connHeaderVal       = "upgrade"
 	upgradeHeaderKey    = "Upgrade"
@@ -47,6 +49,8 @@ var (
 	errMalformedSubtopic  = errors.New("malformed subtopic")
 	errGenSessionID       = errors.New("failed to generate session id")
 	errMethodNotAllowed   = errors.New("method not allowed")
+	errUnsupportedFrame   = errors.New("unsupported frame type")
+	errNotSubscribed      = errors.New("not subscribed to the channel")
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
@@ -58,8 +62,20 @@ func MakeHandler(ctx context.Context, svc smqhttp.Service, resolver messaging.To
 
 	r.Handle("/m/{domain}/c/{channel}", messageHandler(ctx, svc, resolver, logger))
 
+	// Last values subtopic is reserved, so the path does not fall back to the message handler.
+	r.Route("/m/{domain}/c/{channel}/"+lastvalue.Subtopic, func(r chi.Router) {
+		r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+			lastValuesEndpoint(svc),
+			decodeLastValuesReq(resolver),
+			api.EncodeResponse,
+			opts...,
+		), "last_values").ServeHTTP)
+	})
+
 	r.Handle("/m/{domain}/c/{channel}/*", messageHandler(ctx, svc, resolver, logger))
 
+	r.Get("/m/{domain}/ws", muxHandler(ctx, svc, resolver, logger))
+
 	r.Post("/hc/{domain}", otelhttp.NewHandler(kithttp.NewServer(
 		healthCheckEndpoint(),
 		decodeHealthCheckRequest,
@@ -99,18 +115,32 @@ func decodePublishReq(_ context.Context, r *http.Request) (any, error) {
 	return req, nil
 }
 
-func decodeWSReq(r *http.Request, resolver messaging.TopicResolver, logger *slog.Logger) (connReq, error) {
-	username, password, ok := r.BasicAuth()
-	if !ok {
-		switch {
-		case r.URL.Query().Get(authzQueryKey) != "":
-			password = r.URL.Query().Get(authzQueryKey)
-		case r.Header.Get(authzHeaderKey) != "":
-			password = r.Header.Get(authzHeaderKey)
-		default:
-			logger.Debug("Missing authorization key.")
-			return connReq{}, errUnauthorizedAccess
+func decodeLastValuesReq(resolver messaging.TopicResolver) kithttp.DecodeRequestFunc {
+	return func(ctx context.Context, r *http.Request) (any, error) {
+		domainID, channelID, _, err := resolver.Resolve(ctx, chi.URLParam(r, "domain"), chi.URLParam(r, "channel"))
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
 		}
+
+		subtopic, err := messaging.ParsePublishSubtopic(r.URL.Query().Get(subtopicQueryKey))
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		req := lastValuesReq{
+			domainID:  domainID,
+			channelID: channelID,
+			subtopic:  subtopic,
+		}
+
+		return req, nil
+	}
+}
+
+func decodeWSReq(r *http.Request, resolver messaging.TopicResolver, logger *slog.Logger) (connReq, error) {
+	username, password, err := decodeWSCredentials(r, logger)
+	if err != nil {
+		return connReq{}, err
 	}
 
 	domain := chi.URLParam(r, "domain")
@@ -121,11 +151,17 @@ func decodeWSReq(r *http.Request, resolver messaging.TopicResolver, logger *slog
 		return connReq{}, err
 	}
 
+	replay, err := messaging.ParseReplay(r.URL.Query())
+	if err != nil {
+		return connReq{}, err
+	}
+
 	req := connReq{
 		username:  username,
 		password:  password,
 		channelID: channelID,
 		domainID:  domainID,
+		replay:    replay,
 	}
 
 	subTopic := chi.URLParam(r, "*")
@@ -141,6 +177,43 @@ func decodeWSReq(r *http.Request, resolver messaging.TopicResolver, logger *slog
 	return req, nil
 }
 
+func decodeMuxWSReq(r *http.Request, resolver messaging.TopicResolver, logger *slog.Logger) (connReq, error) {
+	username, password, err := decodeWSCredentials(r, logger)
+	if err != nil {
+		return connReq{}, err
+	}
+
+	domainID, _, _, err := resolver.Resolve(r.Context(), chi.URLParam(r, "domain"), "")
+	if err != nil {
+		return connReq{}, err
+	}
+
+	req := connReq{
+		username: username,
+		password: password,
+		domainID: domainID,
+	}
+
+	return req, nil
+}
+
+func decodeWSCredentials(r *http.Request, logger *slog.Logger) (string, string, error) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		switch {
+		case r.URL.Query().Get(authzQueryKey) != "":
+			password = r.URL.Query().Get(authzQueryKey)
+		case r.Header.Get(authzHeaderKey) != "":
+			password = r.Header.Get(authzHeaderKey)
+		default:
+			logger.Debug("Missing authorization key.")
+			return "", "", errUnauthorizedAccess
+		}
+	}
+
+	return username, password, nil
+}
+
 func decodeHealthCheckRequest(_ context.Context, r *http.Request) (any, error) {
 	var req healthCheckReq
 	req.domain = chi.URLParam(r, "domain")
@@ -165,7 +238,7 @@ func encodeError(ctx context.Context, w http.ResponseWriter, err error) {
 		w.WriteHeader(http.StatusBadRequest)
 	case errUnauthorizedAccess:
 		w.WriteHeader(http.StatusForbidden)
-	case errMalformedSubtopic, errors.ErrMalformedEntity:
+	case errMalformedSubtopic, errors.ErrMalformedEntity, messaging.ErrMalformedReplay:
 		w.WriteHeader(http.StatusBadRequest)
 	default:
 		api.EncodeError(ctx, err, w)
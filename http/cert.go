@@ -0,0 +1,21 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+)
+
+// WithClientCert wraps the proxy handler so the client certificate verified
+// in the TLS handshake is available to the session handler.
+func WithClientCert(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.PeerCertificates) > 0 {
+			r = r.WithContext(smqauthn.WithClientCert(r.Context(), r.TLS.PeerCertificates[0]))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -5,7 +5,10 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/absmach/supermq/pkg/errors"
@@ -20,6 +23,9 @@ var (
 	errFailedToWriteMsg      = errors.New("failed to write message to connection")
 	errFailedToWritePing     = errors.New("failed to write ping to connection")
 	errReadMsg               = errors.New("failed to read messages ")
+	errFailedToWriteFrame    = errors.New("failed to write frame to connection")
+	errMalformedFrame        = errors.New("malformed frame")
+	errSlowConsumer          = errors.New("messages dropped due to slow consumer")
 )
 
 const (
@@ -31,6 +37,12 @@ const (
 
 	// Time allowed to read the next pong message from the peer.
 	pongWait = 60 * time.Second
+
+	// Maximum number of messages waiting to be written to the peer.
+	msgBufferSize = 1024
+
+	// Maximum number of ack and error frames waiting to be written to the peer.
+	replyBufferSize = 64
 )
 
 // Client handles messaging and websocket connection.
@@ -39,7 +51,13 @@ type Client struct {
 	conn         *websocket.Conn
 	id           string
 	msg          chan *messaging.Message
+	replies      chan Frame
+	frames       FrameHandler
+	dropped      atomic.Uint64
 	handledClose bool
+	// reader tracks the goroutine reading the connection, which
+	// handles the frames and may be subscribing while closing.
+	reader sync.WaitGroup
 }
 
 // NewClient returns a new websocket client.
@@ -48,11 +66,28 @@ func NewClient(logger *slog.Logger, conn *websocket.Conn, sessionID string) *Cli
 		logger: logger,
 		conn:   conn,
 		id:     sessionID,
-		msg:    make(chan *messaging.Message, 1024),
+		msg:    make(chan *messaging.Message, msgBufferSize),
 	}
 	return c
 }
 
+// NewMuxClient returns a new websocket client which uses the multiplexed frame
+// protocol. Request frames read from the connection are passed to the handler
+// and the returned frames are written back. Messages are written to the
+// connection as message frames.
+//
+// When the peer reads slower than the messages arrive, messages which do not
+// fit in the buffer are dropped and the peer is notified with an error frame
+// containing the number of dropped messages. Ack and error frames are never
+// dropped; instead, reading of new request frames is paused until they are
+// written to the peer.
+func NewMuxClient(logger *slog.Logger, conn *websocket.Conn, sessionID string, handler FrameHandler) *Client {
+	c := NewClient(logger, conn, sessionID)
+	c.replies = make(chan Frame, replyBufferSize)
+	c.frames = handler
+	return c
+}
+
 // Cancel handles the websocket connection after unsubscribing.
 func (c *Client) Cancel() error {
 	if c.conn == nil {
@@ -61,12 +96,14 @@ func (c *Client) Cancel() error {
 	return c.conn.Close()
 }
 
-// Close handles the websocket connection after unsubscribing.
+// Close closes the websocket connection and calls the close handler once the
+// reading of the connection stops, so no frame is handled after the handler.
 func (c *Client) Close() error {
 	err := c.conn.Close()
 	if err != nil {
 		c.logger.Debug("failed to close websocket client", slog.String("session_id", c.id), slog.String("error", err.Error()))
 	}
+	c.reader.Wait()
 	ch := c.conn.CloseHandler()
 	err = ch(0, "")
 	if err != nil {
@@ -81,6 +118,7 @@ func (c *Client) Handle(msg *messaging.Message) error {
 	case c.msg <- msg:
 		return nil
 	default:
+		c.dropped.Add(1)
 		return errHandlerBlockedMsgChan
 	}
 }
@@ -96,8 +134,10 @@ func (c *Client) readPump(ctx context.Context, cancel context.CancelFunc) error
 	})
 
 	errCh := make(chan error, 1)
+	c.reader.Add(1)
 	go func() {
-		errCh <- c.readMessage()
+		defer c.reader.Done()
+		errCh <- c.readMessage(ctx)
 	}()
 
 	for {
@@ -111,7 +151,7 @@ func (c *Client) readPump(ctx context.Context, cancel context.CancelFunc) error
 	}
 }
 
-func (c *Client) readMessage() error {
+func (c *Client) readMessage(ctx context.Context) error {
 	for {
 		msgType, msg, err := c.conn.ReadMessage()
 		if err != nil {
@@ -121,7 +161,31 @@ func (c *Client) readMessage() error {
 			}
 			return errors.Wrap(errReadMsg, err)
 		}
-		c.logger.Debug("read_pump: received message ", slog.Int("message_type", msgType), slog.String("message", string(msg)))
+		if c.frames == nil {
+			c.logger.Debug("read_pump: received message ", slog.Int("message_type", msgType), slog.String("message", string(msg)))
+			continue
+		}
+		if !c.handleFrame(ctx, msg) {
+			return nil
+		}
+	}
+}
+
+// handleFrame handles a single request frame and queues the reply.
+// It blocks until the reply is queued and returns false if the
+// context is done before that.
+func (c *Client) handleFrame(ctx context.Context, data []byte) bool {
+	var req Frame
+	res := NewErrorFrame("", errMalformedFrame)
+	if err := json.Unmarshal(data, &req); err == nil {
+		res = c.frames(ctx, req)
+	}
+
+	select {
+	case c.replies <- res:
+		return true
+	case <-ctx.Done():
+		return false
 	}
 }
 
@@ -142,8 +206,12 @@ func (c *Client) writePump(ctx context.Context, cancel context.CancelFunc) error
 				}
 				return errHandlerClosedMsgChan
 			}
-			if err := c.conn.WriteMessage(websocket.BinaryMessage, msg.GetPayload()); err != nil {
-				return errors.Wrap(errFailedToWriteMsg, err)
+			if err := c.writeMessage(msg); err != nil {
+				return err
+			}
+		case res := <-c.replies:
+			if err := c.writeFrame(res); err != nil {
+				return err
 			}
 		case <-ticker.C:
 			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
@@ -153,6 +221,39 @@ func (c *Client) writePump(ctx context.Context, cancel context.CancelFunc) error
 	}
 }
 
+func (c *Client) writeMessage(msg *messaging.Message) error {
+	if c.frames == nil {
+		if err := c.conn.WriteMessage(websocket.BinaryMessage, msg.GetPayload()); err != nil {
+			return errors.Wrap(errFailedToWriteMsg, err)
+		}
+		return nil
+	}
+
+	// Notify the peer about messages dropped since the last write
+	// before delivering the next message.
+	if dropped := c.dropped.Swap(0); dropped > 0 {
+		res := NewErrorFrame("", errSlowConsumer)
+		res.Dropped = dropped
+		if err := c.writeFrame(res); err != nil {
+			return err
+		}
+	}
+	if err := c.writeFrame(newMessageFrame(msg)); err != nil {
+		return errors.Wrap(errFailedToWriteMsg, err)
+	}
+
+	return nil
+}
+
+func (c *Client) writeFrame(f Frame) error {
+	_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	if err := c.conn.WriteJSON(f); err != nil {
+		return errors.Wrap(errFailedToWriteFrame, err)
+	}
+
+	return nil
+}
+
 // SetCloseHandler sets a close handler for the WebSocket connection.
 func (c *Client) SetCloseHandler(handler func(code int, text string) error) {
 	c.conn.SetCloseHandler(func(code int, text string) error {
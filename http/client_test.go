@@ -15,6 +15,7 @@ import (
 	"time"
 
 	smqhttp "github.com/absmach/supermq/http"
+	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,3 +104,139 @@ func TestHandle(t *testing.T) {
 	c := atomic.LoadUint64(&count)
 	assert.Equal(t, expectedCount, c, fmt.Sprintf("expected message count %d, got %d", expectedCount, c))
 }
+
+func TestMuxClient(t *testing.T) {
+	clients := make(chan *smqhttp.Client, 1)
+	frameHandler := func(_ context.Context, req smqhttp.Frame) smqhttp.Frame {
+		if req.Type == smqhttp.SubscribeFrame {
+			return smqhttp.NewAckFrame(req.ID)
+		}
+		return smqhttp.NewErrorFrame(req.ID, smqhttp.ErrFailedPublish)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		mc := smqhttp.NewMuxClient(slog.Default(), conn, "sessionID", frameHandler)
+		clients <- mc
+		mc.Start(context.Background())
+	}))
+	defer s.Close()
+
+	u := strings.Replace(s.URL, "http", "ws", 1)
+	wsConn, _, err := websocket.DefaultDialer.Dial(u, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer wsConn.Close()
+	mc := <-clients
+
+	cases := []struct {
+		desc string
+		req  []byte
+		res  smqhttp.Frame
+	}{
+		{
+			desc: "handle subscribe frame",
+			req:  []byte(`{"id":"1","type":"subscribe","channel":"c1"}`),
+			res:  smqhttp.Frame{ID: "1", Type: smqhttp.AckFrame},
+		},
+		{
+			desc: "handle publish frame with handler error",
+			req:  []byte(`{"id":"2","type":"publish","channel":"c1","payload":"dGVzdA=="}`),
+			res:  smqhttp.Frame{ID: "2", Type: smqhttp.ErrorFrame, Error: smqhttp.ErrFailedPublish.Error()},
+		},
+		{
+			desc: "handle malformed frame",
+			req:  []byte(`{"id":`),
+			res:  smqhttp.Frame{Type: smqhttp.ErrorFrame, Error: "malformed frame"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := wsConn.WriteMessage(websocket.TextMessage, tc.req)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error writing frame: %s", tc.desc, err))
+			var res smqhttp.Frame
+			err = wsConn.ReadJSON(&res)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error reading frame: %s", tc.desc, err))
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %+v got %+v", tc.desc, tc.res, res))
+		})
+	}
+
+	m := &messaging.Message{
+		Channel:   chanID,
+		Domain:    domainID,
+		Publisher: id,
+		Subtopic:  subTopic,
+		Protocol:  protocol,
+		Payload:   msg.Payload,
+	}
+	err = mc.Handle(m)
+	assert.Nil(t, err, fmt.Sprintf("expected nil error from handle, got: %s", err))
+	var res smqhttp.Frame
+	err = wsConn.ReadJSON(&res)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error reading message frame: %s", err))
+	expected := smqhttp.Frame{
+		Type:      smqhttp.MessageFrame,
+		Channel:   m.Channel,
+		Subtopic:  m.Subtopic,
+		Publisher: m.Publisher,
+		Protocol:  m.Protocol,
+		Payload:   m.Payload,
+	}
+	assert.Equal(t, expected, res, fmt.Sprintf("expected message frame %+v got %+v", expected, res))
+}
+
+func TestMuxClientClose(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var subscribed atomic.Bool
+	frameHandler := func(_ context.Context, req smqhttp.Frame) smqhttp.Frame {
+		close(started)
+		<-release
+		subscribed.Store(true)
+		return smqhttp.NewAckFrame(req.ID)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	closed := make(chan bool, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		mc := smqhttp.NewMuxClient(slog.Default(), conn, "sessionID", frameHandler)
+		mc.SetCloseHandler(func(code int, text string) error {
+			closed <- subscribed.Load()
+			return nil
+		})
+		mc.Start(ctx)
+	}))
+	defer s.Close()
+
+	u := strings.Replace(s.URL, "http", "ws", 1)
+	wsConn, _, err := websocket.DefaultDialer.Dial(u, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer wsConn.Close()
+
+	err = wsConn.WriteMessage(websocket.TextMessage, []byte(`{"id":"1","type":"subscribe","channel":"c1"}`))
+	assert.Nil(t, err, fmt.Sprintf("unexpected error writing frame: %s", err))
+	<-started
+
+	// The client is stopped while the subscribe frame is being handled,
+	// so the close handler must wait for the subscription to complete.
+	cancel()
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	select {
+	case ok := <-closed:
+		assert.True(t, ok, "expected close handler to be called after the frame is handled")
+	case <-time.After(time.Second):
+		t.Fatal("expected close handler to be called")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// FrameType identifies the purpose of a frame exchanged over
+// a multiplexed WebSocket connection.
+type FrameType string
+
+const (
+	// SubscribeFrame requests subscription to a channel and optional subtopic.
+	SubscribeFrame FrameType = "subscribe"
+	// UnsubscribeFrame cancels a subscription created by SubscribeFrame.
+	UnsubscribeFrame FrameType = "unsubscribe"
+	// PublishFrame publishes the payload to a channel and optional subtopic.
+	PublishFrame FrameType = "publish"
+	// AckFrame acknowledges successfully handled request frame.
+	AckFrame FrameType = "ack"
+	// ErrorFrame reports failure to handle request frame or to deliver messages.
+	ErrorFrame FrameType = "error"
+	// MessageFrame carries a message received from one of the subscriptions.
+	MessageFrame FrameType = "message"
+)
+
+// Frame is a JSON encoded unit of the multiplexed WebSocket protocol.
+//
+// Request frames (subscribe, unsubscribe and publish) are sent by the client
+// and carry an optional ID which is echoed back in the corresponding ack or
+// error frame. Message frames are sent by the server for every message
+// received from any of the active subscriptions. Payload is encoded as
+// base64 string in the JSON representation. Publish and message frames may
+// carry the payload content type and the message headers. Subscribe frames
+// may set one of the start_time, start_seq and last fields to replay the
+// channel history.
+type Frame struct {
+	ID            string            `json:"id,omitempty"`
+	Type          FrameType         `json:"type"`
+	Channel       string            `json:"channel,omitempty"`
+	Subtopic      string            `json:"subtopic,omitempty"`
+	Publisher     string            `json:"publisher,omitempty"`
+	Protocol      string            `json:"protocol,omitempty"`
+	Created       int64             `json:"created,omitempty"`
+	ContentType   string            `json:"content_type,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Payload       []byte            `json:"payload,omitempty"`
+	Error         string            `json:"error,omitempty"`
+	Dropped       uint64            `json:"dropped,omitempty"`
+	StartTime     time.Time         `json:"start_time,omitzero"`
+	StartSequence uint64            `json:"start_seq,omitempty"`
+	Last          uint64            `json:"last,omitempty"`
+}
+
+// FrameHandler handles request frame received over a multiplexed WebSocket
+// connection and returns ack or error frame which is sent back to the client.
+type FrameHandler func(ctx context.Context, req Frame) Frame
+
+// NewAckFrame returns ack frame for the request with the given ID.
+func NewAckFrame(id string) Frame {
+	return Frame{
+		ID:   id,
+		Type: AckFrame,
+	}
+}
+
+// NewErrorFrame returns error frame for the request with the given ID.
+func NewErrorFrame(id string, err error) Frame {
+	return Frame{
+		ID:    id,
+		Type:  ErrorFrame,
+		Error: err.Error(),
+	}
+}
+
+func newMessageFrame(msg *messaging.Message) Frame {
+	return Frame{
+		Type:        MessageFrame,
+		Channel:     msg.GetChannel(),
+		Subtopic:    msg.GetSubtopic(),
+		Publisher:   msg.GetPublisher(),
+		Protocol:    msg.GetProtocol(),
+		Created:     msg.GetCreated(),
+		ContentType: msg.GetContentType(),
+		Headers:     msg.GetHeaders(),
+		Payload:     msg.GetPayload(),
+	}
+}
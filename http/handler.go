@@ -22,7 +22,10 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 var _ session.Handler = (*handler)(nil)
@@ -46,27 +49,33 @@ var (
 	errFailedPublishToMsgBroker = errors.New("failed to publish to supermq message broker")
 	errInvalidAuthFormat        = errors.New("invalid basic auth format")
 	errInvalidClientType        = errors.New("invalid client type")
+	errValidatePayload          = errors.New("failed to validate message payload")
+	errLimitRate                = errors.New("failed to check domain message rate")
 )
 
 // Event implements events.Event interface.
 type handler struct {
-	pubsub   messaging.PubSub
-	clients  grpcClientsV1.ClientsServiceClient
-	channels grpcChannelsV1.ChannelsServiceClient
-	authn    smqauthn.Authentication
-	logger   *slog.Logger
-	parser   messaging.TopicParser
+	pubsub    messaging.PubSub
+	clients   grpcClientsV1.ClientsServiceClient
+	channels  grpcChannelsV1.ChannelsServiceClient
+	authn     smqauthn.Authentication
+	logger    *slog.Logger
+	parser    messaging.TopicParser
+	validator schema.Validator
+	limiter   ratelimit.Limiter
 }
 
 // NewHandler creates new Handler entity.
-func NewHandler(pubsub messaging.PubSub, logger *slog.Logger, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser) session.Handler {
+func NewHandler(pubsub messaging.PubSub, logger *slog.Logger, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser, validator schema.Validator, limiter ratelimit.Limiter) session.Handler {
 	return &handler{
-		logger:   logger,
-		pubsub:   pubsub,
-		authn:    authn,
-		clients:  clients,
-		channels: channels,
-		parser:   parser,
+		logger:    logger,
+		pubsub:    pubsub,
+		authn:     authn,
+		clients:   clients,
+		channels:  channels,
+		parser:    parser,
+		validator: validator,
+		limiter:   limiter,
 	}
 }
 
@@ -81,6 +90,11 @@ func (h *handler) AuthConnect(ctx context.Context) error {
 	var tok string
 	switch {
 	case string(s.Password) == "":
+		// The client authenticated with the certificate needs no key.
+		if cert, ok := smqauthn.ClientCertFromContext(ctx); ok {
+			h.logger.Info(fmt.Sprintf(LogInfoConnected, smqauthn.CertFingerprint(cert)))
+			return nil
+		}
 		return mgate.NewHTTPProxyError(http.StatusBadRequest, errors.Wrap(apiutil.ErrValidation, apiutil.ErrBearerKey))
 	case strings.HasPrefix(string(s.Password), apiutil.ClientPrefix):
 		tok = strings.TrimPrefix(string(s.Password), apiutil.ClientPrefix)
@@ -103,6 +117,16 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 		return errClientNotInitialized
 	}
 
+	// Reading the last values of the channel requires subscribe access.
+	if path, ok := lastValuesPath(*topic); ok {
+		domainID, channelID, _, _, err := h.parser.ParsePublishTopic(ctx, path, true)
+		if err != nil {
+			return mgate.NewHTTPProxyError(http.StatusBadRequest, errors.Wrap(errFailedPublish, err))
+		}
+		_, err = h.authAccess(ctx, s.Username, string(s.Password), domainID, channelID, connections.Subscribe, messaging.MessageType)
+		return err
+	}
+
 	domainID, channelID, _, topicType, err := h.parser.ParsePublishTopic(ctx, *topic, true)
 	if err != nil {
 		return mgate.NewHTTPProxyError(http.StatusBadRequest, errors.Wrap(errFailedPublish, err))
@@ -117,6 +141,27 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 		s.Username = clientID
 	}
 
+	if topicType == messaging.MessageType {
+		if err := validateMetadata(metadataFromContext(ctx)); err != nil {
+			return mgate.NewHTTPProxyError(http.StatusBadRequest, errors.Wrap(errFailedPublish, err))
+		}
+		if err := h.limiter.Allow(ctx, domainID); err != nil {
+			if errors.Contains(err, ratelimit.ErrRateExceeded) {
+				return mgate.NewHTTPProxyError(http.StatusTooManyRequests, err)
+			}
+			return mgate.NewHTTPProxyError(http.StatusInternalServerError, errors.Wrap(errLimitRate, err))
+		}
+	}
+
+	if topicType == messaging.MessageType && payload != nil {
+		if err := h.validator.Validate(ctx, domainID, channelID, *payload); err != nil {
+			if schema.IsViolation(err) {
+				return mgate.NewHTTPProxyError(http.StatusBadRequest, err)
+			}
+			return mgate.NewHTTPProxyError(http.StatusInternalServerError, errors.Wrap(errValidatePayload, err))
+		}
+	}
+
 	return nil
 }
 
@@ -155,6 +200,11 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 		return errClientNotInitialized
 	}
 
+	// Last values requests are served by the http server.
+	if _, ok := lastValuesPath(*topic); ok {
+		return nil
+	}
+
 	if len(*payload) == 0 {
 		h.logger.Warn("Empty payload, not publishing to broker", slog.String("client_id", s.Username))
 		return nil
@@ -165,14 +215,22 @@ func (h *handler) Publish(ctx context.Context, topic *string, payload *[]byte) e
 		return errors.Wrap(errFailedPublish, err)
 	}
 
+	md := metadataFromContext(ctx)
+	contentType, err := messaging.ParseContentType(md.contentType)
+	if err != nil {
+		return mgate.NewHTTPProxyError(http.StatusBadRequest, errors.Wrap(errFailedPublish, err))
+	}
+
 	msg := messaging.Message{
-		Protocol:  protocol,
-		Domain:    domainID,
-		Channel:   channelID,
-		Subtopic:  subtopic,
-		Payload:   *payload,
-		Publisher: s.Username,
-		Created:   time.Now().UnixNano(),
+		Protocol:    protocol,
+		Domain:      domainID,
+		Channel:     channelID,
+		Subtopic:    subtopic,
+		Payload:     *payload,
+		Publisher:   s.Username,
+		Created:     time.Now().UnixNano(),
+		ContentType: contentType,
+		Headers:     md.headers,
 	}
 
 	// Health check topic messages do not get published to message broker.
@@ -210,7 +268,11 @@ func (h *handler) Disconnect(ctx context.Context) error {
 func (h *handler) authAccess(ctx context.Context, username, password, domainID, chanID string, msgType connections.ConnType, topicType messaging.TopicType) (string, error) {
 	var token, clientType string
 	var err error
+	cert, hasCert := smqauthn.ClientCertFromContext(ctx)
 	switch {
+	case password == "" && hasCert:
+		token = smqauthn.CertAuthPack(cert)
+		clientType = policies.ClientType
 	case strings.HasPrefix(password, apiutil.BearerPrefix):
 		token = strings.TrimPrefix(password, apiutil.BearerPrefix)
 		clientType = policies.UserType
@@ -283,6 +345,26 @@ func (h *handler) authenticate(ctx context.Context, authType, token, domainID st
 	}
 }
 
+func validateMetadata(md metadata) error {
+	if _, err := messaging.ParseContentType(md.contentType); err != nil {
+		return err
+	}
+
+	return messaging.ValidateHeaders(md.headers)
+}
+
+// lastValuesPath returns the request path without the query and
+// reports whether it requests the last values of the channel.
+func lastValuesPath(topic string) (string, bool) {
+	path, _, _ := strings.Cut(topic, "?")
+	_, _, subtopic, topicType, err := messaging.ParseTopic(path)
+	if err != nil || topicType != messaging.MessageType {
+		return "", false
+	}
+
+	return path, subtopic == lastvalue.Subtopic
+}
+
 // decodeAuth decodes the base64 encoded string in the format "clientID:secret".
 func decodeAuth(s string) (string, string, error) {
 	db, err := base64.URLEncoding.DecodeString(s)
@@ -5,9 +5,13 @@ package http_test
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
 	"fmt"
+	"maps"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -29,6 +33,10 @@ import (
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/mocks"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	rlmocks "github.com/absmach/supermq/pkg/ratelimit/mocks"
+	"github.com/absmach/supermq/pkg/schema"
+	schemamocks "github.com/absmach/supermq/pkg/schema/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -39,6 +47,8 @@ var (
 	subtopicMsg    = "/m/%s/c/%s/subtopic"
 	topic          = fmt.Sprintf(topicMsg, domainID, chanID)
 	subtopic       = fmt.Sprintf(subtopicMsg, domainID, chanID)
+	latestTopic    = fmt.Sprintf(topicMsg+"/latest", domainID, chanID)
+	latestSubTopic = latestTopic + "?subtopic=temperature"
 	hcTopicFmt     = "/hc/%s"
 	hcTopic        = fmt.Sprintf(hcTopicFmt, domainID)
 	invalidHCTopic = "/hc"
@@ -62,6 +72,8 @@ var (
 	authn     = new(authnmocks.Authentication)
 	publisher = new(mocks.PubSub)
 	domains   = new(dmocks.DomainsServiceClient)
+	validator = new(schemamocks.Validator)
+	limiter   = new(rlmocks.Limiter)
 )
 
 func newHandler(t *testing.T) session.Handler {
@@ -70,10 +82,12 @@ func newHandler(t *testing.T) session.Handler {
 	clients = new(clmocks.ClientsServiceClient)
 	channels = new(chmocks.ChannelsServiceClient)
 	publisher = new(mocks.PubSub)
+	validator = new(schemamocks.Validator)
+	limiter = new(rlmocks.Limiter)
 	parser, err := messaging.NewTopicParser(messaging.DefaultCacheConfig, channels, domains)
 	assert.Nil(t, err, fmt.Sprintf("unexpected error while creating topic parser: %v", err))
 
-	return smqhttp.NewHandler(publisher, logger, authn, clients, channels, parser)
+	return smqhttp.NewHandler(publisher, logger, authn, clients, channels, parser, validator, limiter)
 }
 
 func TestAuthPublish(t *testing.T) {
@@ -113,26 +127,56 @@ func TestAuthPublish(t *testing.T) {
 	hcClientKeySession := session.Session{
 		Password: []byte("Client " + clientKey),
 	}
+	violationSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	failedValidationSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	rateExceededSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	failedLimitSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	latestSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	latestSubtopicSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	unauthorizedLatestSession := session.Session{
+		Password: []byte("Client " + clientKey),
+	}
+	certSession := session.Session{}
+	cert := &x509.Certificate{
+		Raw:     []byte("client certificate"),
+		Subject: pkix.Name{CommonName: clientID},
+	}
 
 	tests := []struct {
-		desc       string
-		session    *session.Session
-		topic      *string
-		payload    *[]byte
-		authKey    string
-		status     int
-		clientType string
-		chanID     string
-		domainID   string
-		clientID   string
-		authNToken string
-		superAdmin bool
-		authNRes   *grpcClientsV1.AuthnRes
-		authNRes1  smqauthn.Session
-		authNErr   error
-		authZRes   *grpcChannelsV1.AuthzRes
-		authZErr   error
-		err        error
+		desc        string
+		session     *session.Session
+		cert        *x509.Certificate
+		topic       *string
+		payload     *[]byte
+		authKey     string
+		status      int
+		clientType  string
+		chanID      string
+		domainID    string
+		clientID    string
+		authNToken  string
+		superAdmin  bool
+		authNRes    *grpcClientsV1.AuthnRes
+		authNRes1   smqauthn.Session
+		authNErr    error
+		authZRes    *grpcChannelsV1.AuthzRes
+		authZErr    error
+		limitErr    error
+		validateErr error
+		connType    connections.ConnType
+		err         error
 	}{
 		{
 			desc:       "publish with client key successfully",
@@ -151,6 +195,37 @@ func TestAuthPublish(t *testing.T) {
 			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
 			err:        nil,
 		},
+		{
+			desc:       "publish with client certificate successfully",
+			session:    &certSession,
+			cert:       cert,
+			topic:      &topic,
+			payload:    &payload,
+			status:     http.StatusOK,
+			clientType: policies.ClientType,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.CertAuthPack(cert),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			err:        nil,
+		},
+		{
+			desc:       "publish with unbound client certificate",
+			session:    &certSession,
+			cert:       cert,
+			topic:      &topic,
+			payload:    &payload,
+			clientType: policies.ClientType,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.CertAuthPack(cert),
+			authNRes:   &grpcClientsV1.AuthnRes{Authenticated: false},
+			status:     http.StatusUnauthorized,
+			err:        svcerr.ErrAuthentication,
+		},
 		{
 			desc:       "publish with invalid client key",
 			session:    &invalidClientKeySession,
@@ -325,6 +400,74 @@ func TestAuthPublish(t *testing.T) {
 			status:     http.StatusUnauthorized,
 			err:        svcerr.ErrAuthentication,
 		},
+		{
+			desc:        "publish with payload violating channel schema",
+			session:     &violationSession,
+			topic:       &topic,
+			authKey:     clientKey,
+			payload:     &payload,
+			status:      http.StatusBadRequest,
+			clientType:  policies.ClientType,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: schema.ErrSchemaViolation,
+			err:         schema.ErrSchemaViolation,
+		},
+		{
+			desc:        "publish with failed payload validation",
+			session:     &failedValidationSession,
+			topic:       &topic,
+			authKey:     clientKey,
+			payload:     &payload,
+			status:      http.StatusInternalServerError,
+			clientType:  policies.ClientType,
+			chanID:      chanID,
+			domainID:    domainID,
+			clientID:    clientID,
+			authNToken:  smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:    &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: svcerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:       "publish with exceeded message rate",
+			session:    &rateExceededSession,
+			topic:      &topic,
+			authKey:    clientKey,
+			payload:    &payload,
+			status:     http.StatusTooManyRequests,
+			clientType: policies.ClientType,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr:   ratelimit.ErrRateExceeded,
+			err:        ratelimit.ErrRateExceeded,
+		},
+		{
+			desc:       "publish with failed message rate check",
+			session:    &failedLimitSession,
+			topic:      &topic,
+			authKey:    clientKey,
+			payload:    &payload,
+			status:     http.StatusInternalServerError,
+			clientType: policies.ClientType,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr:   svcerr.ErrViewEntity,
+			err:        svcerr.ErrViewEntity,
+		},
 		{
 			desc:       "publish with health check topic successfully",
 			session:    &hcClientKeySession,
@@ -350,6 +493,52 @@ func TestAuthPublish(t *testing.T) {
 			err:        messaging.ErrMalformedTopic,
 			clientType: policies.ClientType,
 		},
+		{
+			desc:       "read last values with client key successfully",
+			session:    &latestSession,
+			topic:      &latestTopic,
+			authKey:    clientKey,
+			payload:    &[]byte{},
+			status:     http.StatusOK,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			connType:   connections.Subscribe,
+		},
+		{
+			desc:       "read last values of subtopic with client key successfully",
+			session:    &latestSubtopicSession,
+			topic:      &latestSubTopic,
+			authKey:    clientKey,
+			payload:    &[]byte{},
+			status:     http.StatusOK,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: true},
+			connType:   connections.Subscribe,
+		},
+		{
+			desc:       "read last values without subscribe permission",
+			session:    &unauthorizedLatestSession,
+			topic:      &latestTopic,
+			authKey:    clientKey,
+			payload:    &[]byte{},
+			status:     http.StatusUnauthorized,
+			chanID:     chanID,
+			domainID:   domainID,
+			clientID:   clientID,
+			authNToken: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, clientKey),
+			authNRes:   &grpcClientsV1.AuthnRes{Id: clientID, Authenticated: true},
+			authZRes:   &grpcChannelsV1.AuthzRes{Authorized: false},
+			connType:   connections.Subscribe,
+			err:        svcerr.ErrAuthentication,
+		},
 	}
 
 	for _, tc := range tests {
@@ -358,6 +547,9 @@ func TestAuthPublish(t *testing.T) {
 			if tc.session != nil {
 				ctx = session.NewContext(ctx, tc.session)
 			}
+			if tc.cert != nil {
+				ctx = smqauthn.WithClientCert(ctx, tc.cert)
+			}
 			tc.clientType = policies.ClientType
 			clientID := tc.clientID
 			if tc.session != nil && strings.HasPrefix(string(tc.session.Password), apiutil.BearerPrefix) {
@@ -367,15 +559,20 @@ func TestAuthPublish(t *testing.T) {
 					clientID = tc.clientID
 				}
 			}
+			if tc.connType == connections.Invalid {
+				tc.connType = connections.Publish
+			}
 			clientsCall := clients.On("Authenticate", ctx, &grpcClientsV1.AuthnReq{Token: tc.authNToken}).Return(tc.authNRes, tc.authNErr)
 			authCall := authn.On("Authenticate", ctx, mock.Anything).Return(tc.authNRes1, tc.authNErr)
 			channelsCall := channels.On("Authorize", mock.Anything, &grpcChannelsV1.AuthzReq{
 				ClientType: tc.clientType,
 				ClientId:   clientID,
-				Type:       uint32(connections.Publish),
+				Type:       uint32(tc.connType),
 				ChannelId:  tc.chanID,
 				DomainId:   tc.domainID,
 			}).Return(tc.authZRes, tc.authZErr)
+			limitCall := limiter.On("Allow", mock.Anything, tc.domainID).Return(tc.limitErr)
+			validateCall := validator.On("Validate", mock.Anything, tc.domainID, tc.chanID, mock.Anything).Return(tc.validateErr)
 			err := handler.AuthPublish(ctx, tc.topic, tc.payload)
 			hpe, ok := err.(mgate.HTTPProxyError)
 			if ok {
@@ -387,6 +584,8 @@ func TestAuthPublish(t *testing.T) {
 			authCall.Unset()
 			clientsCall.Unset()
 			channelsCall.Unset()
+			limitCall.Unset()
+			validateCall.Unset()
 		})
 	}
 }
@@ -700,11 +899,14 @@ func TestPublish(t *testing.T) {
 	validSubtopic := topic + "/" + subtopic
 
 	cases := []struct {
-		desc    string
-		session *session.Session
-		topic   string
-		payload []byte
-		err     error
+		desc        string
+		session     *session.Session
+		topic       string
+		payload     []byte
+		header      http.Header
+		contentType string
+		headers     map[string]string
+		err         error
 	}{
 		{
 			desc:    "publish without active session",
@@ -753,12 +955,35 @@ func TestPublish(t *testing.T) {
 			topic:   topic,
 			payload: payload,
 		},
+		{
+			desc:        "publish with content type and headers",
+			session:     &sessionClient,
+			topic:       topic,
+			payload:     payload,
+			header:      http.Header{"Content-Type": {"application/senml+json; charset=utf-8"}, "X-Smq-Header-Correlation-Id": {"42"}, "X-Request-Id": {"1"}},
+			contentType: "application/senml+json",
+			headers:     map[string]string{"correlation-id": "42"},
+		},
+		{
+			desc:    "publish with malformed content type",
+			session: &sessionClient,
+			topic:   topic,
+			payload: payload,
+			header:  http.Header{"Content-Type": {"application/json;;"}},
+			err:     messaging.ErrMalformedContentType,
+		},
 		{
 			desc:    "publish with health check topic",
 			session: &sessionClient,
 			topic:   hcTopic,
 			payload: payload,
 		},
+		{
+			desc:    "publish with last values topic",
+			session: &sessionClient,
+			topic:   latestTopic,
+			payload: payload,
+		},
 		{
 			desc:    "puvlish with invalid health check topic",
 			session: &sessionClient,
@@ -769,11 +994,15 @@ func TestPublish(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		ctx := context.TODO()
+		ctx := metadataContext(tc.header)
 		if tc.session != nil {
 			ctx = session.NewContext(ctx, tc.session)
 		}
-		repoCall := publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		repoCall := publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			m := args.Get(2).(*messaging.Message)
+			assert.Equal(t, tc.contentType, m.GetContentType(), fmt.Sprintf("%s: expected content type %s got %s", tc.desc, tc.contentType, m.GetContentType()))
+			assert.Equal(t, tc.headers, m.GetHeaders(), fmt.Sprintf("%s: expected headers %v got %v", tc.desc, tc.headers, m.GetHeaders()))
+		}).Return(nil)
 		err := handler.Publish(ctx, &tc.topic, &tc.payload)
 		if tc.err != nil {
 			assert.Contains(t, err.Error(), tc.err.Error(), fmt.Sprintf("expected error message to contain: %v, got: %v", tc.err, err))
@@ -781,3 +1010,16 @@ func TestPublish(t *testing.T) {
 		repoCall.Unset()
 	}
 }
+
+// metadataContext returns the context of the request with the given headers
+// as seen by the proxy wrapped in the metadata handler.
+func metadataContext(header http.Header) context.Context {
+	ctx := context.TODO()
+	r := httptest.NewRequest(http.MethodPost, topic, nil)
+	maps.Copy(r.Header, header)
+	smqhttp.WithMetadata(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), r)
+
+	return ctx
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// HeaderPrefix is the prefix of the HTTP request headers which are forwarded
+// as message headers. The prefix is stripped and the key is lowercased, so the
+// "X-Smq-Header-Unit-System" request header becomes "unit-system" message header.
+const HeaderPrefix = "X-Smq-Header-"
+
+type metadataKey struct{}
+
+type metadata struct {
+	contentType string
+	headers     map[string]string
+}
+
+// WithMetadata wraps the proxy handler so the content type and the message
+// headers of the published request are available to the session handler.
+func WithMetadata(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := metadata{
+			contentType: r.Header.Get("Content-Type"),
+			headers:     MessageHeaders(r.Header),
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), metadataKey{}, md)))
+	})
+}
+
+// MessageHeaders returns the message headers from the prefixed HTTP headers.
+func MessageHeaders(h http.Header) map[string]string {
+	var headers map[string]string
+	for k, v := range h {
+		if len(k) <= len(HeaderPrefix) || !strings.EqualFold(k[:len(HeaderPrefix)], HeaderPrefix) || len(v) == 0 {
+			continue
+		}
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers[strings.ToLower(k[len(HeaderPrefix):])] = v[0]
+	}
+
+	return headers
+}
+
+func metadataFromContext(ctx context.Context) metadata {
+	md, _ := ctx.Value(metadataKey{}).(metadata)
+	return md
+}
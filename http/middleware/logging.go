@@ -26,7 +26,7 @@ func NewLogging(svc smqhttp.Service, logger *slog.Logger) smqhttp.Service {
 
 // Subscribe logs the subscribe request. It logs the channel and subtopic(if present) and the time it took to complete the request.
 // If the request fails, it logs the error.
-func (lm *loggingMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, c *smqhttp.Client) (err error) {
+func (lm *loggingMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, replay messaging.Replay, c *smqhttp.Client) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -37,6 +37,14 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, sessionID, username,
 		if subtopic != "" {
 			args = append(args, "subtopic", subtopic)
 		}
+		switch {
+		case !replay.StartTime.IsZero():
+			args = append(args, slog.Time("replay_start_time", replay.StartTime))
+		case replay.StartSequence > 0:
+			args = append(args, slog.Uint64("replay_start_seq", replay.StartSequence))
+		case replay.LastN > 0:
+			args = append(args, slog.Uint64("replay_last", replay.LastN))
+		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
 			lm.logger.Warn("Subscribe failed", args...)
@@ -45,7 +53,7 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, sessionID, username,
 		lm.logger.Info("Subscribe completed successfully", args...)
 	}(time.Now())
 
-	return lm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, c)
+	return lm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, c)
 }
 
 func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, sessionID, domainID, chanID, subtopic string, topicType messaging.TopicType) (err error) {
@@ -69,3 +77,53 @@ func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, sessionID, domainI
 
 	return lm.svc.Unsubscribe(ctx, sessionID, domainID, chanID, subtopic, topicType)
 }
+
+// Publish logs the publish request. It logs the channel and subtopic(if present) and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) Publish(ctx context.Context, username, password, domainID, chanID, subtopic string, payload []byte, contentType string, headers map[string]string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", chanID),
+			slog.String("domain_id", domainID),
+			slog.Int("payload_size", len(payload)),
+		}
+		if subtopic != "" {
+			args = append(args, "subtopic", subtopic)
+		}
+		if contentType != "" {
+			args = append(args, slog.String("content_type", contentType))
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Publish failed", args...)
+			return
+		}
+		lm.logger.Info("Publish completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.Publish(ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)
+}
+
+// LastValues logs the last values request. It logs the channel and subtopic(if present) and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) LastValues(ctx context.Context, domainID, chanID, subtopic string) (msgs []*messaging.Message, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", chanID),
+			slog.String("domain_id", domainID),
+		}
+		if subtopic != "" {
+			args = append(args, "subtopic", subtopic)
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Retrieve last values failed", args...)
+			return
+		}
+		lm.logger.Info("Retrieve last values completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.LastValues(ctx, domainID, chanID, subtopic)
+}
@@ -11,6 +11,7 @@ import (
 
 	smqhttp "github.com/absmach/supermq/http"
 	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -32,13 +33,13 @@ func NewMetrics(svc smqhttp.Service, counter metrics.Counter, latency metrics.Hi
 }
 
 // Subscribe instruments Subscribe method with metrics.
-func (mm *metricsMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, c *smqhttp.Client) error {
+func (mm *metricsMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, replay messaging.Replay, c *smqhttp.Client) error {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "subscribe").Add(1)
 		mm.latency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return mm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, c)
+	return mm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, c)
 }
 
 func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, sessionID, domainID, chanID, subtopic string, topicType messaging.TopicType) error {
@@ -49,3 +50,26 @@ func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, sessionID, domainI
 
 	return mm.svc.Unsubscribe(ctx, sessionID, domainID, chanID, subtopic, topicType)
 }
+
+// Publish instruments Publish method with metrics.
+func (mm *metricsMiddleware) Publish(ctx context.Context, username, password, domainID, chanID, subtopic string, payload []byte, contentType string, headers map[string]string) (err error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "publish").Add(1)
+		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
+		if schema.IsViolation(err) {
+			mm.counter.With("method", "schema_violation").Add(1)
+		}
+	}(time.Now())
+
+	return mm.svc.Publish(ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)
+}
+
+// LastValues instruments LastValues method with metrics.
+func (mm *metricsMiddleware) LastValues(ctx context.Context, domainID, chanID, subtopic string) ([]*messaging.Message, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "last_values").Add(1)
+		mm.latency.With("method", "last_values").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.LastValues(ctx, domainID, chanID, subtopic)
+}
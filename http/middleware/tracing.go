@@ -16,6 +16,8 @@ var _ smqhttp.Service = (*tracingMiddleware)(nil)
 const (
 	subscribeOP   = "subscribe_op"
 	unsubscribeOP = "unsubscribe_op"
+	publishOP     = "publish_op"
+	lastValuesOP  = "last_values_op"
 )
 
 type tracingMiddleware struct {
@@ -32,11 +34,11 @@ func NewTracing(tracer trace.Tracer, svc smqhttp.Service) smqhttp.Service {
 }
 
 // Subscribe traces the "Subscribe" operation of the wrapped smqhttp.Service.
-func (tm *tracingMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, client *smqhttp.Client) error {
+func (tm *tracingMiddleware) Subscribe(ctx context.Context, sessionID, username, password, domainID, chanID, subtopic string, topicType messaging.TopicType, replay messaging.Replay, client *smqhttp.Client) error {
 	ctx, span := tm.tracer.Start(ctx, subscribeOP)
 	defer span.End()
 
-	return tm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, client)
+	return tm.svc.Subscribe(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, client)
 }
 
 func (tm *tracingMiddleware) Unsubscribe(ctx context.Context, sessionID, domainID, chanID, subtopic string, topicType messaging.TopicType) error {
@@ -45,3 +47,19 @@ func (tm *tracingMiddleware) Unsubscribe(ctx context.Context, sessionID, domainI
 
 	return tm.svc.Unsubscribe(ctx, sessionID, domainID, chanID, subtopic, topicType)
 }
+
+// Publish traces the "Publish" operation of the wrapped smqhttp.Service.
+func (tm *tracingMiddleware) Publish(ctx context.Context, username, password, domainID, chanID, subtopic string, payload []byte, contentType string, headers map[string]string) error {
+	ctx, span := tm.tracer.Start(ctx, publishOP)
+	defer span.End()
+
+	return tm.svc.Publish(ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)
+}
+
+// LastValues traces the "LastValues" operation of the wrapped smqhttp.Service.
+func (tm *tracingMiddleware) LastValues(ctx context.Context, domainID, chanID, subtopic string) ([]*messaging.Message, error) {
+	ctx, span := tm.tracer.Start(ctx, lastValuesOP)
+	defer span.End()
+
+	return tm.svc.LastValues(ctx, domainID, chanID, subtopic)
+}
@@ -43,17 +43,196 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// LastValues provides a mock function for the type Service
+func (_mock *Service) LastValues(ctx context.Context, domainID string, chanID string, subtopic string) ([]*messaging.Message, error) {
+	ret := _mock.Called(ctx, domainID, chanID, subtopic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastValues")
+	}
+
+	var r0 []*messaging.Message
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*messaging.Message, error)); ok {
+		return returnFunc(ctx, domainID, chanID, subtopic)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []*messaging.Message); ok {
+		r0 = returnFunc(ctx, domainID, chanID, subtopic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*messaging.Message)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, chanID, subtopic)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_LastValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastValues'
+type Service_LastValues_Call struct {
+	*mock.Call
+}
+
+// LastValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - chanID string
+//   - subtopic string
+func (_e *Service_Expecter) LastValues(ctx interface{}, domainID interface{}, chanID interface{}, subtopic interface{}) *Service_LastValues_Call {
+	return &Service_LastValues_Call{Call: _e.mock.On("LastValues", ctx, domainID, chanID, subtopic)}
+}
+
+func (_c *Service_LastValues_Call) Run(run func(ctx context.Context, domainID string, chanID string, subtopic string)) *Service_LastValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_LastValues_Call) Return(messages []*messaging.Message, err error) *Service_LastValues_Call {
+	_c.Call.Return(messages, err)
+	return _c
+}
+
+func (_c *Service_LastValues_Call) RunAndReturn(run func(ctx context.Context, domainID string, chanID string, subtopic string) ([]*messaging.Message, error)) *Service_LastValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type Service
+func (_mock *Service) Publish(ctx context.Context, username string, password string, domainID string, chanID string, subtopic string, payload []byte, contentType string, headers map[string]string) error {
+	ret := _mock.Called(ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, []byte, string, map[string]string) error); ok {
+		r0 = returnFunc(ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Service_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+//   - domainID string
+//   - chanID string
+//   - subtopic string
+//   - payload []byte
+//   - contentType string
+//   - headers map[string]string
+func (_e *Service_Expecter) Publish(ctx interface{}, username interface{}, password interface{}, domainID interface{}, chanID interface{}, subtopic interface{}, payload interface{}, contentType interface{}, headers interface{}) *Service_Publish_Call {
+	return &Service_Publish_Call{Call: _e.mock.On("Publish", ctx, username, password, domainID, chanID, subtopic, payload, contentType, headers)}
+}
+
+func (_c *Service_Publish_Call) Run(run func(ctx context.Context, username string, password string, domainID string, chanID string, subtopic string, payload []byte, contentType string, headers map[string]string)) *Service_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 []byte
+		if args[6] != nil {
+			arg6 = args[6].([]byte)
+		}
+		var arg7 string
+		if args[7] != nil {
+			arg7 = args[7].(string)
+		}
+		var arg8 map[string]string
+		if args[8] != nil {
+			arg8 = args[8].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+			arg7,
+			arg8,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_Publish_Call) Return(err error) *Service_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_Publish_Call) RunAndReturn(run func(ctx context.Context, username string, password string, domainID string, chanID string, subtopic string, payload []byte, contentType string, headers map[string]string) error) *Service_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Subscribe provides a mock function for the type Service
-func (_mock *Service) Subscribe(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, client *http.Client) error {
-	ret := _mock.Called(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, client)
+func (_mock *Service) Subscribe(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, replay messaging.Replay, client *http.Client) error {
+	ret := _mock.Called(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Subscribe")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, messaging.TopicType, *http.Client) error); ok {
-		r0 = returnFunc(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, client)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, messaging.TopicType, messaging.Replay, *http.Client) error); ok {
+		r0 = returnFunc(ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, client)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -74,12 +253,13 @@ type Service_Subscribe_Call struct {
 //   - chanID string
 //   - subtopic string
 //   - topicType messaging.TopicType
+//   - replay messaging.Replay
 //   - client *http.Client
-func (_e *Service_Expecter) Subscribe(ctx interface{}, sessionID interface{}, username interface{}, password interface{}, domainID interface{}, chanID interface{}, subtopic interface{}, topicType interface{}, client interface{}) *Service_Subscribe_Call {
-	return &Service_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, client)}
+func (_e *Service_Expecter) Subscribe(ctx interface{}, sessionID interface{}, username interface{}, password interface{}, domainID interface{}, chanID interface{}, subtopic interface{}, topicType interface{}, replay interface{}, client interface{}) *Service_Subscribe_Call {
+	return &Service_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, sessionID, username, password, domainID, chanID, subtopic, topicType, replay, client)}
 }
 
-func (_c *Service_Subscribe_Call) Run(run func(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, client *http.Client)) *Service_Subscribe_Call {
+func (_c *Service_Subscribe_Call) Run(run func(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, replay messaging.Replay, client *http.Client)) *Service_Subscribe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -113,9 +293,13 @@ func (_c *Service_Subscribe_Call) Run(run func(ctx context.Context, sessionID st
 		if args[7] != nil {
 			arg7 = args[7].(messaging.TopicType)
 		}
-		var arg8 *http.Client
+		var arg8 messaging.Replay
 		if args[8] != nil {
-			arg8 = args[8].(*http.Client)
+			arg8 = args[8].(messaging.Replay)
+		}
+		var arg9 *http.Client
+		if args[9] != nil {
+			arg9 = args[9].(*http.Client)
 		}
 		run(
 			arg0,
@@ -127,6 +311,7 @@ func (_c *Service_Subscribe_Call) Run(run func(ctx context.Context, sessionID st
 			arg6,
 			arg7,
 			arg8,
+			arg9,
 		)
 	})
 	return _c
@@ -137,7 +322,7 @@ func (_c *Service_Subscribe_Call) Return(err error) *Service_Subscribe_Call {
 	return _c
 }
 
-func (_c *Service_Subscribe_Call) RunAndReturn(run func(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, client *http.Client) error) *Service_Subscribe_Call {
+func (_c *Service_Subscribe_Call) RunAndReturn(run func(ctx context.Context, sessionID string, username string, password string, domainID string, chanID string, subtopic string, topicType messaging.TopicType, replay messaging.Replay, client *http.Client) error) *Service_Subscribe_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -185,4 +185,7 @@ type Repository interface {
 
 	// IncrementOutboundMessages increments the outbound messages count for a client.
 	IncrementOutboundMessages(ctx context.Context, channelID, subtopic string) error
+
+	// DeleteDomainJournals removes the journals and the clients telemetry of the domain from the database.
+	DeleteDomainJournals(ctx context.Context, domainID string) error
 }
@@ -228,6 +228,63 @@ func (_c *Repository_DeleteClientTelemetry_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// DeleteDomainJournals provides a mock function for the type Repository
+func (_mock *Repository) DeleteDomainJournals(ctx context.Context, domainID string) error {
+	ret := _mock.Called(ctx, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDomainJournals")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_DeleteDomainJournals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomainJournals'
+type Repository_DeleteDomainJournals_Call struct {
+	*mock.Call
+}
+
+// DeleteDomainJournals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+func (_e *Repository_Expecter) DeleteDomainJournals(ctx interface{}, domainID interface{}) *Repository_DeleteDomainJournals_Call {
+	return &Repository_DeleteDomainJournals_Call{Call: _e.mock.On("DeleteDomainJournals", ctx, domainID)}
+}
+
+func (_c *Repository_DeleteDomainJournals_Call) Run(run func(ctx context.Context, domainID string)) *Repository_DeleteDomainJournals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteDomainJournals_Call) Return(err error) *Repository_DeleteDomainJournals_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_DeleteDomainJournals_Call) RunAndReturn(run func(ctx context.Context, domainID string) error) *Repository_DeleteDomainJournals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IncrementInboundMessages provides a mock function for the type Repository
 func (_mock *Repository) IncrementInboundMessages(ctx context.Context, ct journal.ClientTelemetry) error {
 	ret := _mock.Called(ctx, ct)
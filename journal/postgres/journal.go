@@ -109,6 +109,21 @@ func (repo *repository) RetrieveAll(ctx context.Context, page journal.Page) (jou
 	return journalsPage, nil
 }
 
+func (repo *repository) DeleteDomainJournals(ctx context.Context, domainID string) error {
+	// Client subscriptions are removed by the database cascade.
+	queries := []string{
+		`DELETE FROM clients_telemetry WHERE domain_id = $1;`,
+		`DELETE FROM journal WHERE domain = $1;`,
+	}
+	for _, q := range queries {
+		if _, err := repo.db.ExecContext(ctx, q, domainID); err != nil {
+			return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
 func pageQuery(pm journal.Page) string {
 	var query []string
 	var emq string
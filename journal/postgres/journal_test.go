@@ -935,6 +935,73 @@ func TestDeleteClientTelemetry(t *testing.T) {
 	}
 }
 
+func TestDeleteDomainJournals(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM journal")
+		require.Nil(t, err, fmt.Sprintf("clean journal unexpected error: %s", err))
+		_, err = db.Exec("DELETE FROM clients_telemetry")
+		require.Nil(t, err, fmt.Sprintf("clean clients_telemetry unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	otherDomainID := testsutil.GenerateUUID(t)
+
+	for _, d := range []string{domainID, otherDomainID} {
+		err := repo.Save(context.Background(), journal.Journal{
+			ID:         testsutil.GenerateUUID(t),
+			Operation:  "client.create",
+			OccurredAt: time.Now().UTC(),
+			Attributes: map[string]any{"id": testsutil.GenerateUUID(t), "domain": d},
+		})
+		require.Nil(t, err, fmt.Sprintf("save journal unexpected error: %s", err))
+
+		err = repo.SaveClientTelemetry(context.Background(), journal.ClientTelemetry{
+			ClientID:  testsutil.GenerateUUID(t),
+			DomainID:  d,
+			FirstSeen: time.Now().UTC(),
+			LastSeen:  time.Now().UTC(),
+		})
+		require.Nil(t, err, fmt.Sprintf("save client telemetry unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc     string
+		domainID string
+		total    uint64
+		err      error
+	}{
+		{
+			desc:     "delete journals of existing domain",
+			domainID: domainID,
+			total:    1,
+			err:      nil,
+		},
+		{
+			desc:     "delete journals of non-existing domain",
+			domainID: testsutil.GenerateUUID(t),
+			total:    1,
+			err:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.DeleteDomainJournals(context.Background(), tc.domainID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+
+			var total uint64
+			err = db.QueryRow("SELECT COUNT(*) FROM journal").Scan(&total)
+			require.Nil(t, err, fmt.Sprintf("count journal unexpected error: %s", err))
+			assert.Equal(t, tc.total, total, fmt.Sprintf("%s: expected %d journals got %d", tc.desc, tc.total, total))
+
+			err = db.QueryRow("SELECT COUNT(*) FROM clients_telemetry").Scan(&total)
+			require.Nil(t, err, fmt.Sprintf("count clients telemetry unexpected error: %s", err))
+			assert.Equal(t, tc.total, total, fmt.Sprintf("%s: expected %d clients telemetry got %d", tc.desc, tc.total, total))
+		})
+	}
+}
+
 func TestRetrieveClientTelemetry(t *testing.T) {
 	t.Cleanup(func() {
 		_, err := db.Exec("DELETE FROM clients_telemetry")
@@ -18,6 +18,7 @@ import (
 const (
 	clientCreate         = "client.create"
 	clientRemove         = "client.remove"
+	domainRemove         = "domain.remove"
 	mqttSubscribe        = "mqtt.client_subscribe"
 	mqttDisconnect       = "mqtt.client_disconnect"
 	messagingPublish     = "messaging.client_publish"
@@ -107,6 +108,9 @@ func (svc *service) handleTelemetry(ctx context.Context, journal Journal) error
 	case mqttDisconnect:
 		return svc.removeMqttSubscription(ctx, journal)
 
+	case domainRemove:
+		return svc.removeDomainJournals(ctx, journal)
+
 	default:
 		return nil
 	}
@@ -222,6 +226,15 @@ func (svc *service) updateMessageCount(ctx context.Context, journal Journal) err
 	return nil
 }
 
+func (svc *service) removeDomainJournals(ctx context.Context, journal Journal) error {
+	domainID, err := getStringAttribute(journal, "id")
+	if err != nil {
+		return err
+	}
+
+	return svc.repository.DeleteDomainJournals(ctx, domainID)
+}
+
 type clientEvent struct {
 	id        string
 	domain    string
@@ -40,11 +40,20 @@ func TestSave(t *testing.T) {
 	repo := new(mocks.Repository)
 	svc := journal.NewService(idProvider, repo)
 
+	domainRemoveJournal := journal.Journal{
+		Operation:  "domain.remove",
+		OccurredAt: time.Now(),
+		Attributes: map[string]any{
+			"id": testsutil.GenerateUUID(t),
+		},
+	}
+
 	cases := []struct {
-		desc    string
-		journal journal.Journal
-		repoErr error
-		err     error
+		desc      string
+		journal   journal.Journal
+		repoErr   error
+		deleteErr error
+		err       error
 	}{
 		{
 			desc:    "successful with ID and EntityType",
@@ -57,14 +66,27 @@ func TestSave(t *testing.T) {
 			repoErr: repoerr.ErrCreateEntity,
 			err:     repoerr.ErrCreateEntity,
 		},
+		{
+			desc:    "successful with domain remove",
+			journal: domainRemoveJournal,
+			err:     nil,
+		},
+		{
+			desc:      "with domain remove and failed to delete domain journals",
+			journal:   domainRemoveJournal,
+			deleteErr: repoerr.ErrRemoveEntity,
+			err:       repoerr.ErrRemoveEntity,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("Save", context.Background(), mock.Anything).Return(tc.repoErr)
+			repoCall1 := repo.On("DeleteDomainJournals", context.Background(), mock.Anything).Return(tc.deleteErr)
 			err := svc.Save(context.Background(), tc.journal)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			repoCall.Unset()
+			repoCall1.Unset()
 		})
 	}
 }
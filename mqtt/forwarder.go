@@ -20,22 +20,26 @@ type Forwarder interface {
 
 type forwarder struct {
 	topic  string
+	retry  messaging.RetryPolicy
 	logger *slog.Logger
 }
 
-// NewForwarder returns new Forwarder implementation.
-func NewForwarder(topic string, logger *slog.Logger) Forwarder {
+// NewForwarder returns new Forwarder implementation. Messages which could not
+// be forwarded are redelivered and dead-lettered according to the retry policy.
+func NewForwarder(topic string, retry messaging.RetryPolicy, logger *slog.Logger) Forwarder {
 	return forwarder{
 		topic:  topic,
+		retry:  retry,
 		logger: logger,
 	}
 }
 
 func (f forwarder) Forward(ctx context.Context, id string, sub messaging.Subscriber, pub messaging.Publisher) error {
 	subCfg := messaging.SubscriberConfig{
-		ID:      id,
-		Topic:   f.topic,
-		Handler: handle(ctx, pub, f.logger),
+		ID:          id,
+		Topic:       f.topic,
+		Handler:     handle(ctx, pub, f.logger),
+		RetryPolicy: f.retry,
 	}
 
 	return sub.Subscribe(ctx, subCfg)
@@ -49,11 +53,10 @@ func handle(ctx context.Context, pub messaging.Publisher, logger *slog.Logger) h
 
 		topic := messaging.EncodeMessageMQTTTopic(msg)
 
-		go func() {
-			if err := pub.Publish(ctx, topic, msg); err != nil {
-				logger.Warn(fmt.Sprintf("Failed to forward message: %s", err))
-			}
-		}()
+		if err := pub.Publish(ctx, topic, msg); err != nil {
+			logger.Warn(fmt.Sprintf("Failed to forward message: %s", err))
+			return err
+		}
 
 		return nil
 	}
@@ -19,6 +19,8 @@ import (
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/absmach/supermq/pkg/schema"
 )
 
 var _ session.Handler = (*handler)(nil)
@@ -49,6 +51,8 @@ var (
 	ErrFailedPublishConnectEvent    = errors.New("failed to publish connect event")
 	ErrFailedSubscribeEvent         = errors.New("failed to publish subscribe event")
 	ErrFailedPublishToMsgBroker     = errors.New("failed to publish to supermq message broker")
+	ErrFailedValidatePayload        = errors.New("failed to validate message payload")
+	ErrFailedLimitRate              = errors.New("failed to check domain message rate")
 
 	errInvalidUserId = errors.New("invalid user id")
 )
@@ -59,17 +63,21 @@ type handler struct {
 	clients   grpcClientsV1.ClientsServiceClient
 	channels  grpcChannelsV1.ChannelsServiceClient
 	parser    messaging.TopicParser
+	validator schema.Validator
+	limiter   ratelimit.Limiter
 	logger    *slog.Logger
 }
 
 // NewHandler creates new Handler entity.
-func NewHandler(publisher messaging.Publisher, logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser) session.Handler {
+func NewHandler(publisher messaging.Publisher, logger *slog.Logger, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, parser messaging.TopicParser, validator schema.Validator, limiter ratelimit.Limiter) session.Handler {
 	return &handler{
 		logger:    logger,
 		publisher: publisher,
 		clients:   clients,
 		channels:  channels,
 		parser:    parser,
+		validator: validator,
+		limiter:   limiter,
 	}
 }
 
@@ -87,7 +95,14 @@ func (h *handler) AuthConnect(ctx context.Context) error {
 
 	pwd := string(s.Password)
 
-	res, err := h.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{Token: authn.AuthPack(authn.BasicAuth, s.Username, pwd)})
+	// The client which connected with the certificate verified in the
+	// TLS handshake is authenticated without the secret.
+	token := authn.AuthPack(authn.BasicAuth, s.Username, pwd)
+	if pwd == "" && len(s.Cert.Raw) > 0 {
+		token = authn.CertAuthPack(&s.Cert)
+	}
+
+	res, err := h.clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{Token: token})
 	if err != nil {
 		return errors.Wrap(svcerr.ErrAuthentication, err)
 	}
@@ -98,6 +113,7 @@ func (h *handler) AuthConnect(ctx context.Context) error {
 	if s.Username != "" && res.GetId() != s.Username {
 		return errInvalidUserId
 	}
+	s.Username = res.GetId()
 
 	return nil
 }
@@ -118,7 +134,29 @@ func (h *handler) AuthPublish(ctx context.Context, topic *string, payload *[]byt
 		return err
 	}
 
-	return h.authAccess(ctx, string(s.Username), domainID, chanID, connections.Publish, topicType)
+	if err := h.authAccess(ctx, string(s.Username), domainID, chanID, connections.Publish, topicType); err != nil {
+		return err
+	}
+
+	if topicType == messaging.MessageType {
+		if err := h.limiter.Allow(ctx, domainID); err != nil {
+			if errors.Contains(err, ratelimit.ErrRateExceeded) {
+				return err
+			}
+			return errors.Wrap(ErrFailedLimitRate, err)
+		}
+	}
+
+	if topicType == messaging.MessageType && payload != nil {
+		if err := h.validator.Validate(ctx, domainID, chanID, *payload); err != nil {
+			if schema.IsViolation(err) {
+				return err
+			}
+			return errors.Wrap(ErrFailedValidatePayload, err)
+		}
+	}
+
+	return nil
 }
 
 // AuthSubscribe is called on device subscribe,
@@ -6,6 +6,8 @@ package mqtt_test
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"log"
 	"testing"
@@ -26,6 +28,10 @@ import (
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/mocks"
 	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	rlmocks "github.com/absmach/supermq/pkg/ratelimit/mocks"
+	"github.com/absmach/supermq/pkg/schema"
+	schemamocks "github.com/absmach/supermq/pkg/schema/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -71,6 +77,10 @@ var (
 		Username: invalidID,
 		Password: []byte(password),
 	}
+	clientCert = x509.Certificate{
+		Raw:     []byte("client certificate"),
+		Subject: pkix.Name{CommonName: clientID},
+	}
 	errInvalidUserId = errors.New("invalid user id")
 )
 
@@ -78,6 +88,8 @@ var (
 	clients   *climocks.ClientsServiceClient
 	channels  *chmocks.ChannelsServiceClient
 	publisher *mocks.PubSub
+	validator *schemamocks.Validator
+	limiter   *rlmocks.Limiter
 )
 
 func TestAuthConnect(t *testing.T) {
@@ -144,6 +156,27 @@ func TestAuthConnect(t *testing.T) {
 				Id:            clientID,
 			},
 		},
+		{
+			desc: "connect with bound certificate",
+			session: &session.Session{
+				ID:   clientID,
+				Cert: clientCert,
+			},
+			authNRes: &grpcClientsV1.AuthnRes{
+				Authenticated: true,
+				Id:            clientID,
+			},
+			err: nil,
+		},
+		{
+			desc: "connect with unbound certificate",
+			session: &session.Session{
+				ID:   clientID,
+				Cert: clientCert,
+			},
+			authNErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -155,7 +188,11 @@ func TestAuthConnect(t *testing.T) {
 				password = string(tc.session.Password)
 				username = tc.session.Username
 			}
-			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{Token: authn.AuthPack(authn.BasicAuth, username, password)}).Return(tc.authNRes, tc.authNErr)
+			token := authn.AuthPack(authn.BasicAuth, username, password)
+			if tc.session != nil && password == "" && len(tc.session.Cert.Raw) > 0 {
+				token = authn.CertAuthPack(&tc.session.Cert)
+			}
+			clientsCall := clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{Token: token}).Return(tc.authNRes, tc.authNErr)
 			err := handler.AuthConnect(ctx)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			clientsCall.Unset()
@@ -167,13 +204,15 @@ func TestAuthPublish(t *testing.T) {
 	handler := newHandler()
 
 	cases := []struct {
-		desc     string
-		session  *session.Session
-		err      error
-		topic    *string
-		payload  []byte
-		authZRes *grpcChannelsV1.AuthzRes
-		authZErr error
+		desc        string
+		session     *session.Session
+		err         error
+		topic       *string
+		payload     []byte
+		authZRes    *grpcChannelsV1.AuthzRes
+		authZErr    error
+		limitErr    error
+		validateErr error
 	}{
 		{
 			desc:     "publish successfully",
@@ -213,6 +252,42 @@ func TestAuthPublish(t *testing.T) {
 			authZRes: &grpcChannelsV1.AuthzRes{Authorized: false},
 			authZErr: svcerr.ErrAuthorization,
 		},
+		{
+			desc:        "publish with payload violating channel schema",
+			session:     &sessionClient,
+			err:         schema.ErrSchemaViolation,
+			topic:       &topic,
+			payload:     payload,
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: schema.ErrSchemaViolation,
+		},
+		{
+			desc:        "publish with failed payload validation",
+			session:     &sessionClient,
+			err:         mqtt.ErrFailedValidatePayload,
+			topic:       &topic,
+			payload:     payload,
+			authZRes:    &grpcChannelsV1.AuthzRes{Authorized: true},
+			validateErr: svcerr.ErrViewEntity,
+		},
+		{
+			desc:     "publish with exceeded message rate",
+			session:  &sessionClient,
+			err:      ratelimit.ErrRateExceeded,
+			topic:    &topic,
+			payload:  payload,
+			authZRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr: ratelimit.ErrRateExceeded,
+		},
+		{
+			desc:     "publish with failed message rate check",
+			session:  &sessionClient,
+			err:      mqtt.ErrFailedLimitRate,
+			topic:    &topic,
+			payload:  payload,
+			authZRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			limitErr: svcerr.ErrViewEntity,
+		},
 		{
 			desc:     "publish to health check topic",
 			session:  &sessionClient,
@@ -243,9 +318,13 @@ func TestAuthPublish(t *testing.T) {
 				ClientType: policies.ClientType,
 				Type:       uint32(connections.Publish),
 			}).Return(tc.authZRes, tc.authZErr)
+			limitCall := limiter.On("Allow", mock.Anything, domainID).Return(tc.limitErr)
+			validateCall := validator.On("Validate", mock.Anything, domainID, chanID, tc.payload).Return(tc.validateErr)
 			err := handler.AuthPublish(ctx, tc.topic, &tc.payload)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			channelsCall.Unset()
+			limitCall.Unset()
+			validateCall.Unset()
 		})
 	}
 }
@@ -612,5 +691,7 @@ func newHandler() session.Handler {
 		log.Fatalf("failed to create topic parser: %s", err)
 	}
 	publisher = new(mocks.PubSub)
-	return mqtt.NewHandler(publisher, logger, clients, channels, parser)
+	validator = new(schemamocks.Validator)
+	limiter = new(rlmocks.Limiter)
+	return mqtt.NewHandler(publisher, logger, clients, channels, parser, validator, limiter)
 }
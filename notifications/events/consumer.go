@@ -21,6 +21,7 @@ const (
 	// Event data field keys.
 	invitedByKey     = "invited_by"
 	inviteeUserIDKey = "invitee_user_id"
+	inviteeEmailKey  = "invitee_email"
 	domainIDKey      = "domain_id"
 	domainNameKey    = "domain_name"
 	roleIDKey        = "role_id"
@@ -59,6 +60,10 @@ func handleInvitationEvent(notifier notifications.Notifier, notifType notificati
 		if err != nil {
 			return nil
 		}
+		// The invitee without an account has no user to notify.
+		if n.InviteeEmail != "" {
+			return nil
+		}
 
 		n.Type = notifType
 
@@ -99,14 +104,16 @@ func parseNotificationFromEvent(event events.Event, errorContext string) (notifi
 	roleID := optionalString(data, roleIDKey, errorContext)
 	domainName := optionalString(data, domainNameKey, errorContext)
 	roleName := optionalString(data, roleNameKey, errorContext)
+	inviteeEmail := optionalString(data, inviteeEmailKey, errorContext)
 
 	return notifications.Notification{
-		InviterID:  invitedBy,
-		InviteeID:  inviteeUserID,
-		DomainID:   domainID,
-		DomainName: domainName,
-		RoleID:     roleID,
-		RoleName:   roleName,
+		InviterID:    invitedBy,
+		InviteeID:    inviteeUserID,
+		InviteeEmail: inviteeEmail,
+		DomainID:     domainID,
+		DomainName:   domainName,
+		RoleID:       roleID,
+		RoleName:     roleName,
 	}, nil
 }
 
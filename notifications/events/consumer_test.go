@@ -121,6 +121,21 @@ func TestHandleInvitationSent(t *testing.T) {
 			},
 			mockCall: true, // Should still process with empty role_id and role_name
 		},
+		{
+			desc: "invitation sent to email",
+			event: testEvent{
+				data: map[string]any{
+					"invited_by":      inviterID,
+					"invitee_user_id": inviteeID,
+					"invitee_email":   "invitee@example.com",
+					"domain_id":       domainID,
+					"domain_name":     domainName,
+					"role_id":         roleID,
+					"role_name":       roleName,
+				},
+			},
+			mockCall: false, // The domains service emails unregistered invitees itself
+		},
 	}
 
 	for _, tc := range cases {
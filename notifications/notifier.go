@@ -21,13 +21,16 @@ const (
 
 // Notification contains the data needed to send a notification.
 type Notification struct {
-	Type       NotificationType
-	InviterID  string
-	InviteeID  string
-	DomainID   string
-	DomainName string
-	RoleID     string
-	RoleName   string
+	Type      NotificationType
+	InviterID string
+	InviteeID string
+	// InviteeEmail is set for the invitations sent to the emails of the
+	// unregistered users, which are emailed by the domains service.
+	InviteeEmail string
+	DomainID     string
+	DomainName   string
+	RoleID       string
+	RoleName     string
 }
 
 // Notifier represents a service for sending notifications.
@@ -47,6 +47,7 @@ type Session struct {
 	DomainUserID string
 	SuperAdmin   bool
 	Verified     bool
+	MFA          bool
 	Role         Role
 }
 
@@ -63,12 +64,16 @@ const (
 	Unknown AuthPrefix = iota
 	BasicAuth
 	DomainAuth
+	// CertAuth authenticates the client by the fingerprint and the subject
+	// of the X.509 certificate verified in the TLS handshake.
+	CertAuth
 )
 
-var authPrefixStrings = [3]string{
+var authPrefixStrings = [4]string{
 	"Unknown",
 	"Basic",
 	"Domain",
+	"Cert",
 }
 
 // String returns the string representation (e.g., "Basic") of the AuthPrefix.
@@ -0,0 +1,36 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+)
+
+type clientCertKey struct{}
+
+// CertFingerprint returns the hex encoded SHA-256 fingerprint of the certificate.
+func CertFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// CertAuthPack packs the identity of the client certificate verified in the
+// TLS handshake into the client authentication token.
+func CertAuthPack(cert *x509.Certificate) string {
+	return AuthPack(CertAuth, CertFingerprint(cert), cert.Subject.String())
+}
+
+// WithClientCert returns the context which carries the verified client certificate.
+func WithClientCert(ctx context.Context, cert *x509.Certificate) context.Context {
+	return context.WithValue(ctx, clientCertKey{}, cert)
+}
+
+// ClientCertFromContext returns the verified client certificate stored in the context.
+func ClientCertFromContext(ctx context.Context) (*x509.Certificate, bool) {
+	cert, ok := ctx.Value(clientCertKey{}).(*x509.Certificate)
+	return cert, ok && cert != nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// trustedProxiesEnv is the comma separated list of the IP addresses and CIDRs
+// of the reverse proxies whose forwarding headers are honored.
+const trustedProxiesEnv = "SMQ_TRUSTED_PROXIES"
+
+type clientInfoKey struct{}
+
+// ClientInfo describes the client which sent the authenticated request.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// WithClientInfo returns the context which carries the client info.
+func WithClientInfo(ctx context.Context, ci ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, ci)
+}
+
+// ClientInfoFromContext returns the client info stored in the context.
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	ci, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return ci
+}
+
+// ClientInfoFromRequest returns the client info of the HTTP request.
+// The forwarding headers are honored only if the request is sent by one of
+// the trusted proxies set by SMQ_TRUSTED_PROXIES, otherwise the client IP is
+// the remote address. The client IP is then read from the X-Real-IP header
+// set by the proxy, or from the last X-Forwarded-For address which is not a
+// trusted proxy. The leading X-Forwarded-For addresses are sent by the client
+// and can't be trusted.
+func ClientInfoFromRequest(r *http.Request) ClientInfo {
+	return ClientInfo{
+		IP:        clientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+}
+
+func clientIP(r *http.Request) string {
+	remote, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remote = r.RemoteAddr
+	}
+	proxies := trustedProxies()
+	if !containsIP(proxies, remote) {
+		return remote
+	}
+
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	var xff []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		xff = append(xff, strings.Split(h, ",")...)
+	}
+	for i := len(xff) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(xff[i])
+		if ip == "" {
+			continue
+		}
+		if !containsIP(proxies, ip) {
+			return ip
+		}
+	}
+
+	return remote
+}
+
+// trustedProxies returns the addresses set by SMQ_TRUSTED_PROXIES, which are
+// parsed again only when the variable changes.
+func trustedProxies() []*net.IPNet {
+	val := os.Getenv(trustedProxiesEnv)
+	if p := proxies.Load(); p != nil && p.val == val {
+		return p.nets
+	}
+	p := &parsedProxies{val: val, nets: parseTrustedProxies(val)}
+	proxies.Store(p)
+
+	return p.nets
+}
+
+type parsedProxies struct {
+	val  string
+	nets []*net.IPNet
+}
+
+var proxies atomic.Pointer[parsedProxies]
+
+// parseTrustedProxies parses the comma separated IP addresses and CIDRs.
+// The invalid entries are ignored.
+func parseTrustedProxies(val string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, e := range strings.Split(val, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if !strings.Contains(e, "/") {
+			ip := net.ParseIP(e)
+			if ip == nil {
+				continue
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, n, err := net.ParseCIDR(e); err == nil {
+			nets = append(nets, n)
+		}
+	}
+
+	return nets
+}
+
+func containsIP(nets []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
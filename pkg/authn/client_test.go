@@ -0,0 +1,127 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package authn_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientInfoFromRequest(t *testing.T) {
+	pat := auth.PAT{AllowedCIDRs: []string{"10.0.0.0/8"}}
+
+	cases := []struct {
+		desc       string
+		proxies    string
+		remoteAddr string
+		realIP     string
+		xff        []string
+		ip         string
+		allowed    bool
+	}{
+		{
+			desc:       "real ip set by proxy",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "172.18.0.2:41234",
+			realIP:     "10.1.2.3",
+			xff:        []string{"10.1.2.3"},
+			ip:         "10.1.2.3",
+			allowed:    true,
+		},
+		{
+			desc:       "forged forwarded for with real ip set by proxy",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "172.18.0.2:41234",
+			realIP:     "203.0.113.9",
+			xff:        []string{"10.1.2.3, 203.0.113.9"},
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "forged forwarded for without real ip",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "172.18.0.2:41234",
+			xff:        []string{"10.1.2.3, 203.0.113.9"},
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "forged forwarded for in separate header",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "172.18.0.2:41234",
+			xff:        []string{"10.1.2.3", "203.0.113.9"},
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "forwarded for through trusted proxies",
+			proxies:    "172.18.0.0/16, 192.0.2.7",
+			remoteAddr: "172.18.0.2:41234",
+			xff:        []string{"203.0.113.9, 10.1.2.3, 192.0.2.7"},
+			ip:         "10.1.2.3",
+			allowed:    true,
+		},
+		{
+			desc:       "spoofed real ip from untrusted client",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "203.0.113.9:41234",
+			realIP:     "10.1.2.3",
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "spoofed forwarded for from untrusted client",
+			proxies:    "172.18.0.0/16",
+			remoteAddr: "203.0.113.9:41234",
+			xff:        []string{"10.1.2.3"},
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "spoofed real ip without trusted proxies",
+			remoteAddr: "203.0.113.9:41234",
+			realIP:     "10.1.2.3",
+			xff:        []string{"10.1.2.3"},
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "spoofed real ip with invalid trusted proxies",
+			proxies:    "invalid, 172.18.0.0/33",
+			remoteAddr: "203.0.113.9:41234",
+			realIP:     "10.1.2.3",
+			ip:         "203.0.113.9",
+			allowed:    false,
+		},
+		{
+			desc:       "remote address without proxy headers",
+			remoteAddr: "10.4.5.6:41234",
+			ip:         "10.4.5.6",
+			allowed:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Setenv("SMQ_TRUSTED_PROXIES", tc.proxies)
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tc.remoteAddr
+			if tc.realIP != "" {
+				r.Header.Set("X-Real-IP", tc.realIP)
+			}
+			for _, xff := range tc.xff {
+				r.Header.Add("X-Forwarded-For", xff)
+			}
+			ci := authn.ClientInfoFromRequest(r)
+			assert.Equal(t, tc.ip, ci.IP, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.ip, ci.IP))
+			assert.Equal(t, tc.allowed, pat.AllowsIP(ci.IP), fmt.Sprintf("%s: unexpected allowlist result\n", tc.desc))
+		})
+	}
+}
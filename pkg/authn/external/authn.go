@@ -0,0 +1,412 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	grpcUsersV1 "github.com/absmach/supermq/api/grpc/users/v1"
+	smqauth "github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/authn/jwks"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	mgoauth2 "github.com/absmach/supermq/pkg/oauth2"
+	"github.com/absmach/supermq/users"
+	"github.com/gofrs/uuid/v5"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	amrClaim      = "amr"
+	mfaMethod     = "mfa"
+
+	// subjectKey is the user metadata key holding the subject of the token.
+	subjectKey = "external_subject"
+
+	fetchTimeout   = 10 * time.Second
+	keysCacheTTL   = 5 * time.Minute
+	provisionedTTL = 5 * time.Minute
+
+	// keysRefreshInterval is the minimal interval between the refreshes of
+	// the keys forced by the tokens signed with the unknown key.
+	keysRefreshInterval = 1 * time.Minute
+	// maxProvisioned is the maximal number of the recently provisioned users
+	// kept in memory.
+	maxProvisioned = 10_000
+
+	errorBodyBytes = 1024
+)
+
+var (
+	errMissingIssuer    = errors.New("missing external issuer URL")
+	errMissingAudiences = errors.New("missing external issuer audiences")
+	errDuplicateIssuer  = errors.New("duplicate external issuer")
+	errInvalidAudience  = errors.New("invalid token audience")
+	errFetchKeys        = errors.New("failed to fetch external issuer keys")
+	errDiscovery        = errors.New("failed to discover external issuer")
+	errProvisionUser    = errors.New("failed to provision external user")
+)
+
+// Config is the configuration of the trusted external token issuer.
+type Config struct {
+	IssuerURL string `env:"ISSUER_URL" envDefault:""`
+	// JWKSURL is the URL of the issuer keys. If empty, it is discovered
+	// from the issuer OpenID Connect metadata.
+	JWKSURL string `env:"JWKS_URL"   envDefault:""`
+	// Audiences are the accepted token audiences. The token has to be
+	// issued for at least one of them.
+	Audiences []string              `env:"AUDIENCES"  envDefault:""`
+	Claims    mgoauth2.ClaimMapping `envPrefix:"CLAIM_"`
+}
+
+var _ authn.Authentication = (*authentication)(nil)
+
+type authentication struct {
+	authn       authn.Authentication
+	users       grpcUsersV1.UsersServiceClient
+	issuers     map[string]*issuer
+	httpClient  *http.Client
+	revocations *jwks.Revocations
+
+	mu          sync.Mutex
+	provisioned map[string]provisionedUser
+}
+
+type provisionedUser struct {
+	provisionedAt time.Time
+	verified      bool
+}
+
+type issuer struct {
+	name   string
+	url    string
+	config Config
+
+	mu          sync.Mutex
+	jwksURL     string
+	keys        jwk.Set
+	cachedAt    time.Time
+	refreshedAt time.Time
+}
+
+// NewAuthentication returns the authentication which accepts the access
+// tokens of the given issuers, keyed by the issuer name. The users of the
+// external tokens are provisioned using the users client, while the rest of
+// the tokens are authenticated by the wrapped authentication. The tokens of
+// the users in the revocation list are rejected, unless the list is nil.
+func NewAuthentication(an authn.Authentication, usersClient grpcUsersV1.UsersServiceClient, issuers map[string]Config, revocations *jwks.Revocations) (authn.Authentication, error) {
+	a := &authentication{
+		authn:       an,
+		users:       usersClient,
+		issuers:     make(map[string]*issuer, len(issuers)),
+		httpClient:  &http.Client{Timeout: fetchTimeout},
+		revocations: revocations,
+		provisioned: make(map[string]provisionedUser),
+	}
+	for name, cfg := range issuers {
+		url := strings.TrimSuffix(cfg.IssuerURL, "/")
+		if url == "" {
+			return nil, errors.Wrap(errMissingIssuer, fmt.Errorf("issuer %s", name))
+		}
+		if len(cfg.Audiences) == 0 {
+			return nil, errors.Wrap(errMissingAudiences, fmt.Errorf("issuer %s", name))
+		}
+		if _, ok := a.issuers[url]; ok {
+			return nil, errors.Wrap(errDuplicateIssuer, fmt.Errorf("issuer %s", url))
+		}
+		a.issuers[url] = &issuer{
+			name:    name,
+			url:     url,
+			config:  cfg,
+			jwksURL: cfg.JWKSURL,
+		}
+	}
+
+	return a, nil
+}
+
+func (a *authentication) Authenticate(ctx context.Context, token string) (authn.Session, error) {
+	if strings.HasPrefix(token, authn.PatPrefix) {
+		return a.authn.Authenticate(ctx, token)
+	}
+	// The issuer is only looked up here, the token is verified below
+	// against the keys of the issuer.
+	unverified, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		return a.authn.Authenticate(ctx, token)
+	}
+	iss, ok := a.issuers[strings.TrimSuffix(unverified.Issuer(), "/")]
+	if !ok {
+		return a.authn.Authenticate(ctx, token)
+	}
+
+	tkn, err := a.validate(ctx, iss, token)
+	if err != nil {
+		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	claims, err := tkn.AsMap(ctx)
+	if err != nil {
+		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	user, err := mgoauth2.MapUser(claims, iss.config.Claims, iss.name)
+	if err != nil {
+		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	// The subject is unique only within the issuer, so the user ID is derived
+	// from both of them.
+	user.Metadata[subjectKey] = user.ID
+	user.ID = uuid.NewV5(uuid.NamespaceURL, iss.url+"#"+user.ID).String()
+	user.AuthProvider = iss.name
+
+	if a.revoked(user.ID, tkn.IssuedAt()) {
+		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, smqauth.ErrRevokedToken)
+	}
+	verified, err := a.provision(ctx, user)
+	if err != nil {
+		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+
+	return authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   user.ID,
+		Role:     authn.UserRole,
+		Verified: verified,
+		MFA:      hasMFA(claims),
+	}, nil
+}
+
+func (a *authentication) validate(ctx context.Context, iss *issuer, token string) (jwt.Token, error) {
+	keys, err := a.fetchKeys(ctx, iss, false)
+	if err != nil {
+		return nil, err
+	}
+	tkn, err := iss.parse(token, keys)
+	if err != nil {
+		// The issuer may have rotated its keys since they were cached, but
+		// the token signed with the known key just has the invalid signature.
+		if !unknownKey(token, keys) {
+			return nil, err
+		}
+		if keys, ferr := a.fetchKeys(ctx, iss, true); ferr == nil {
+			tkn, err = iss.parse(token, keys)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tkn, nil
+}
+
+// unknownKey returns true if the token is signed with the key which is not in
+// the given keys.
+func unknownKey(token string, keys jwk.Set) bool {
+	msg, err := jws.Parse([]byte(token))
+	if err != nil {
+		return false
+	}
+	for _, sig := range msg.Signatures() {
+		kid := sig.ProtectedHeaders().KeyID()
+		if kid == "" {
+			return true
+		}
+		if _, ok := keys.LookupKeyID(kid); !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// revocationsComplete returns false if the revocation list may miss the
+// revocations which didn't fit in it.
+func (a *authentication) revocationsComplete() bool {
+	return a.revocations == nil || a.revocations.Complete()
+}
+
+// revoked returns true if the tokens of the user issued at the given time
+// are revoked, such as when the user is disabled.
+func (a *authentication) revoked(userID string, issuedAt time.Time) bool {
+	return a.revocations != nil && a.revocations.Revoked(smqauth.Key{Subject: userID, IssuedAt: issuedAt})
+}
+
+func (iss *issuer) parse(token string, keys jwk.Set) (jwt.Token, error) {
+	audience := jwt.ValidatorFunc(func(_ context.Context, t jwt.Token) jwt.ValidationError {
+		for _, aud := range t.Audience() {
+			if slices.Contains(iss.config.Audiences, aud) {
+				return nil
+			}
+		}
+		return jwt.NewValidationError(errInvalidAudience)
+	})
+
+	return jwt.Parse(
+		[]byte(token),
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(iss.url),
+		jwt.WithValidator(audience),
+	)
+}
+
+// provision provisions the user unless it was provisioned recently, so the
+// users service is not called on each request. The user whose tokens were
+// revoked since the provisioning, such as the disabled user, is provisioned
+// again, and so is each user while the revocation list is incomplete. It
+// returns whether the user email is verified.
+func (a *authentication) provision(ctx context.Context, user users.User) (bool, error) {
+	a.mu.Lock()
+	p, ok := a.provisioned[user.ID]
+	a.mu.Unlock()
+	if ok && time.Since(p.provisionedAt) < provisionedTTL && !a.revoked(user.ID, p.provisionedAt) && a.revocationsComplete() {
+		return p.verified, nil
+	}
+
+	metadata, err := structpb.NewStruct(user.Metadata)
+	if err != nil {
+		return false, errors.Wrap(errProvisionUser, err)
+	}
+	pu := &grpcUsersV1.User{
+		Id:             user.ID,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		Username:       user.Credentials.Username,
+		Email:          user.Email,
+		ProfilePicture: user.ProfilePicture,
+		Metadata:       metadata,
+		AuthProvider:   user.AuthProvider,
+	}
+	// The email is verified only if the issuer asserts it.
+	if !user.VerifiedAt.IsZero() {
+		pu.VerifiedAt = timestamppb.New(user.VerifiedAt)
+	}
+	now := time.Now()
+	res, err := a.users.ProvisionUser(ctx, &grpcUsersV1.ProvisionUserReq{User: pu})
+	if err != nil {
+		return false, errors.Wrap(errProvisionUser, err)
+	}
+	p = provisionedUser{
+		provisionedAt: now,
+		verified:      res.GetUser().GetVerifiedAt() != nil,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.provisioned) >= maxProvisioned {
+		for id, e := range a.provisioned {
+			if time.Since(e.provisionedAt) >= provisionedTTL {
+				delete(a.provisioned, id)
+			}
+		}
+	}
+	// The users which don't fit are provisioned on each request.
+	if _, ok := a.provisioned[user.ID]; ok || len(a.provisioned) < maxProvisioned {
+		a.provisioned[user.ID] = p
+	}
+
+	return p.verified, nil
+}
+
+func (a *authentication) fetchKeys(ctx context.Context, iss *issuer, forceRefresh bool) (jwk.Set, error) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+
+	if iss.keys != nil {
+		switch {
+		case !forceRefresh && time.Since(iss.cachedAt) < keysCacheTTL:
+			return iss.keys, nil
+		// Anyone can send the token with the unknown key, so the forced
+		// refreshes are limited to protect the issuer.
+		case forceRefresh && time.Since(iss.refreshedAt) < keysRefreshInterval:
+			return iss.keys, nil
+		}
+	}
+	if forceRefresh {
+		iss.refreshedAt = time.Now()
+	}
+
+	if iss.jwksURL == "" {
+		var d struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := a.getJSON(ctx, iss.url+discoveryPath, &d); err != nil {
+			return nil, errors.Wrap(errDiscovery, err)
+		}
+		if strings.TrimSuffix(d.Issuer, "/") != iss.url || d.JWKSURI == "" {
+			return nil, errors.Wrap(errDiscovery, errors.New("invalid issuer metadata"))
+		}
+		iss.jwksURL = d.JWKSURI
+	}
+
+	data, err := a.get(ctx, iss.jwksURL)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	keys, err := jwk.Parse(data)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	iss.keys = keys
+	iss.cachedAt = time.Now()
+
+	return keys, nil
+}
+
+func (a *authentication) getJSON(ctx context.Context, url string, v any) error {
+	data, err := a.get(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func (a *authentication) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyBytes))
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// hasMFA returns true if the authentication methods reference claim (RFC 8176)
+// states that the user authenticated with multiple factors.
+func hasMFA(claims map[string]any) bool {
+	methods, _ := claims[amrClaim].([]any)
+	for _, m := range methods {
+		if m == mfaMethod {
+			return true
+		}
+	}
+
+	return false
+}
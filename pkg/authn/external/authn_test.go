@@ -0,0 +1,414 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package external_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	grpcUsersV1 "github.com/absmach/supermq/api/grpc/users/v1"
+	smqauth "github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/authn/external"
+	"github.com/absmach/supermq/pkg/authn/jwks"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	mgoauth2 "github.com/absmach/supermq/pkg/oauth2"
+	usersmocks "github.com/absmach/supermq/users/mocks"
+	"github.com/gofrs/uuid/v5"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	issuerName = "keycloak"
+	audience   = "supermq"
+)
+
+var claims = mgoauth2.ClaimMapping{
+	ID:            "sub",
+	Username:      "preferred_username",
+	FirstName:     "given_name",
+	LastName:      "family_name",
+	Email:         "email",
+	EmailVerified: "email_verified",
+}
+
+func newKey(t *testing.T, kid string) (jwk.Key, jwk.Set) {
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err, fmt.Sprintf("generating RSA key expected to succeed: %s", err))
+	key, err := jwk.FromRaw(raw)
+	require.Nil(t, err, fmt.Sprintf("creating JWK expected to succeed: %s", err))
+	require.Nil(t, key.Set(jwk.KeyIDKey, kid))
+	require.Nil(t, key.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	set := jwk.NewSet()
+	require.Nil(t, set.AddKey(key))
+	public, err := jwk.PublicSetOf(set)
+	require.Nil(t, err, fmt.Sprintf("creating public JWKS expected to succeed: %s", err))
+
+	return key, public
+}
+
+// newIssuer starts the fake OpenID provider which publishes the given keys.
+// It returns the number of the requests for the keys as well.
+func newIssuer(t *testing.T, keys jwk.Set) (*httptest.Server, *atomic.Int32) {
+	var srv *httptest.Server
+	var fetches atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/certs",
+		})
+	})
+	mux.HandleFunc("/certs", func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(keys)
+	})
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv, &fetches
+}
+
+func sign(t *testing.T, key jwk.Key, claims map[string]any) string {
+	tkn := jwt.New()
+	for k, v := range claims {
+		require.Nil(t, tkn.Set(k, v))
+	}
+	signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.RS256, key))
+	require.Nil(t, err, fmt.Sprintf("signing token expected to succeed: %s", err))
+
+	return string(signed)
+}
+
+func TestNewAuthentication(t *testing.T) {
+	cases := []struct {
+		desc    string
+		issuers map[string]external.Config
+		err     error
+	}{
+		{
+			desc: "create with valid issuers",
+			issuers: map[string]external.Config{
+				"keycloak": {IssuerURL: "http://keycloak", Audiences: []string{audience}},
+				"entra":    {IssuerURL: "http://entra", Audiences: []string{audience}},
+			},
+			err: nil,
+		},
+		{
+			desc: "create with missing issuer URL",
+			issuers: map[string]external.Config{
+				"keycloak": {Audiences: []string{audience}},
+			},
+			err: errors.New("missing external issuer URL"),
+		},
+		{
+			desc: "create with missing audiences",
+			issuers: map[string]external.Config{
+				"keycloak": {IssuerURL: "http://keycloak"},
+			},
+			err: errors.New("missing external issuer audiences"),
+		},
+		{
+			desc: "create with duplicate issuer URL",
+			issuers: map[string]external.Config{
+				"keycloak": {IssuerURL: "http://keycloak", Audiences: []string{audience}},
+				"other":    {IssuerURL: "http://keycloak/", Audiences: []string{audience}},
+			},
+			err: errors.New("duplicate external issuer"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := external.NewAuthentication(new(authnmocks.Authentication), new(usersmocks.UsersServiceClient), tc.issuers, nil)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	key, keys := newKey(t, "idp-key")
+	forgedKey, _ := newKey(t, "idp-key")
+	unknownKey, _ := newKey(t, "unknown-key")
+	idp, _ := newIssuer(t, keys)
+
+	inner := new(authnmocks.Authentication)
+	usersClient := new(usersmocks.UsersServiceClient)
+	revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+	revocations.SetLoaded()
+	an, err := external.NewAuthentication(inner, usersClient, map[string]external.Config{
+		issuerName: {IssuerURL: idp.URL, Audiences: []string{audience}, Claims: claims},
+	}, revocations)
+	require.Nil(t, err, fmt.Sprintf("creating authentication expected to succeed: %s", err))
+
+	tokenClaims := func(sub string) map[string]any {
+		return map[string]any{
+			jwt.IssuerKey:        idp.URL,
+			jwt.SubjectKey:       sub,
+			jwt.AudienceKey:      []string{"account", audience},
+			jwt.ExpirationKey:    time.Now().Add(time.Hour),
+			jwt.IssuedAtKey:      time.Now(),
+			"email":              sub + "@example.com",
+			"email_verified":     true,
+			"preferred_username": sub,
+			"given_name":         "John",
+			"family_name":        "Doe",
+		}
+	}
+	userID := func(sub string) string {
+		return uuid.NewV5(uuid.NamespaceURL, idp.URL+"#"+sub).String()
+	}
+	with := func(c map[string]any, key string, val any) map[string]any {
+		if val == nil {
+			delete(c, key)
+			return c
+		}
+		c[key] = val
+		return c
+	}
+
+	innerSession := authn.Session{Type: authn.AccessToken, UserID: "local-user"}
+	otherIssuer := "http://other-issuer"
+
+	cases := []struct {
+		desc         string
+		token        string
+		delegated    bool
+		provisioned  string
+		provisionErr error
+		revoke       *smqauth.Revocation
+		session      authn.Session
+		err          error
+	}{
+		{
+			desc:      "authenticate personal access token",
+			token:     authn.PatPrefix + "token",
+			delegated: true,
+			session:   innerSession,
+		},
+		{
+			desc:      "authenticate token which is not JWT",
+			token:     "token",
+			delegated: true,
+			session:   innerSession,
+		},
+		{
+			desc:      "authenticate token of untrusted issuer",
+			token:     sign(t, key, with(tokenClaims("user"), jwt.IssuerKey, otherIssuer)),
+			delegated: true,
+			session:   innerSession,
+		},
+		{
+			desc:        "authenticate token of new user",
+			token:       sign(t, key, tokenClaims("new-user")),
+			provisioned: userID("new-user"),
+			session:     authn.Session{Type: authn.AccessToken, UserID: userID("new-user"), Role: authn.UserRole, Verified: true},
+		},
+		{
+			desc:    "authenticate token of provisioned user",
+			token:   sign(t, key, tokenClaims("new-user")),
+			session: authn.Session{Type: authn.AccessToken, UserID: userID("new-user"), Role: authn.UserRole, Verified: true},
+		},
+		{
+			desc:        "authenticate token of user authenticated with multiple factors",
+			token:       sign(t, key, with(tokenClaims("mfa-user"), "amr", []string{"pwd", "mfa"})),
+			provisioned: userID("mfa-user"),
+			session:     authn.Session{Type: authn.AccessToken, UserID: userID("mfa-user"), Role: authn.UserRole, Verified: true, MFA: true},
+		},
+		{
+			desc:  "authenticate token with invalid audience",
+			token: sign(t, key, with(tokenClaims("user"), jwt.AudienceKey, []string{"account"})),
+			err:   svcerr.ErrAuthentication,
+		},
+		{
+			desc:  "authenticate expired token",
+			token: sign(t, key, with(tokenClaims("user"), jwt.ExpirationKey, time.Now().Add(-time.Hour))),
+			err:   svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "authenticate token of user with unverified email",
+			token:       sign(t, key, with(tokenClaims("unverified-user"), "email_verified", false)),
+			provisioned: userID("unverified-user"),
+			session:     authn.Session{Type: authn.AccessToken, UserID: userID("unverified-user"), Role: authn.UserRole},
+		},
+		{
+			desc:  "authenticate token with invalid signature",
+			token: sign(t, forgedKey, tokenClaims("user")),
+			err:   svcerr.ErrAuthentication,
+		},
+		{
+			desc:  "authenticate token signed with unknown key",
+			token: sign(t, unknownKey, tokenClaims("user")),
+			err:   svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "authenticate revoked token",
+			token:  sign(t, key, tokenClaims("revoked-user")),
+			revoke: &smqauth.Revocation{Subject: userID("revoked-user"), NotBefore: time.Now().Add(time.Second), ExpiresAt: time.Now().Add(time.Hour)},
+			err:    smqauth.ErrRevokedToken,
+		},
+		{
+			desc:        "authenticate token issued after revocation",
+			token:       sign(t, key, tokenClaims("reenabled-user")),
+			revoke:      &smqauth.Revocation{Subject: userID("reenabled-user"), NotBefore: time.Now().Add(-time.Hour), ExpiresAt: time.Now().Add(time.Hour)},
+			provisioned: userID("reenabled-user"),
+			session:     authn.Session{Type: authn.AccessToken, UserID: userID("reenabled-user"), Role: authn.UserRole, Verified: true},
+		},
+		{
+			desc:  "authenticate token without email claim",
+			token: sign(t, key, with(tokenClaims("user"), "email", nil)),
+			err:   svcerr.ErrAuthentication,
+		},
+		{
+			desc:         "authenticate token of disabled user",
+			token:        sign(t, key, tokenClaims("disabled-user")),
+			provisioned:  userID("disabled-user"),
+			provisionErr: svcerr.ErrAuthentication,
+			err:          svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			innerCall := inner.On("Authenticate", mock.Anything, tc.token).Return(innerSession, nil)
+			if tc.revoke != nil {
+				revocations.Add(*tc.revoke)
+			}
+			var provisioned *grpcUsersV1.User
+			usersCall := usersClient.On("ProvisionUser", mock.Anything, mock.Anything).Return(func(_ context.Context, req *grpcUsersV1.ProvisionUserReq, _ ...grpc.CallOption) (*grpcUsersV1.ProvisionUserRes, error) {
+				provisioned = req.GetUser()
+				return &grpcUsersV1.ProvisionUserRes{User: req.GetUser()}, tc.provisionErr
+			})
+
+			session, err := an.Authenticate(context.Background(), tc.token)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.session, session, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.session, session))
+			if tc.delegated {
+				inner.AssertCalled(t, "Authenticate", mock.Anything, tc.token)
+			}
+			switch tc.provisioned {
+			case "":
+				assert.Nil(t, provisioned, fmt.Sprintf("%s: expected user not to be provisioned", tc.desc))
+			default:
+				require.NotNil(t, provisioned, fmt.Sprintf("%s: expected user to be provisioned", tc.desc))
+				assert.Equal(t, tc.provisioned, provisioned.GetId())
+				assert.Equal(t, issuerName, provisioned.GetAuthProvider())
+				assert.NotEmpty(t, provisioned.GetEmail())
+			}
+			innerCall.Unset()
+			usersCall.Unset()
+		})
+	}
+}
+
+func TestKeysRefresh(t *testing.T) {
+	key, keys := newKey(t, "idp-key")
+	forgedKey, _ := newKey(t, "idp-key")
+	unknownKey, _ := newKey(t, "unknown-key")
+	otherKey, _ := newKey(t, "other-key")
+	idp, fetches := newIssuer(t, keys)
+
+	usersClient := new(usersmocks.UsersServiceClient)
+	usersClient.On("ProvisionUser", mock.Anything, mock.Anything).Return(&grpcUsersV1.ProvisionUserRes{}, nil)
+	an, err := external.NewAuthentication(new(authnmocks.Authentication), usersClient, map[string]external.Config{
+		issuerName: {IssuerURL: idp.URL, Audiences: []string{audience}, Claims: claims},
+	}, nil)
+	require.Nil(t, err, fmt.Sprintf("creating authentication expected to succeed: %s", err))
+
+	tokenClaims := map[string]any{
+		jwt.IssuerKey:     idp.URL,
+		jwt.SubjectKey:    "user",
+		jwt.AudienceKey:   []string{audience},
+		jwt.ExpirationKey: time.Now().Add(time.Hour),
+		"email":           "user@example.com",
+	}
+
+	cases := []struct {
+		desc    string
+		token   string
+		fetches int32
+		err     error
+	}{
+		{
+			desc:    "authenticate valid token",
+			token:   sign(t, key, tokenClaims),
+			fetches: 1,
+		},
+		{
+			desc:    "authenticate token with invalid signature",
+			token:   sign(t, forgedKey, tokenClaims),
+			fetches: 1,
+			err:     svcerr.ErrAuthentication,
+		},
+		{
+			desc:    "authenticate token signed with unknown key",
+			token:   sign(t, unknownKey, tokenClaims),
+			fetches: 2,
+			err:     svcerr.ErrAuthentication,
+		},
+		{
+			desc:    "authenticate token signed with other unknown key",
+			token:   sign(t, otherKey, tokenClaims),
+			fetches: 2,
+			err:     svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := an.Authenticate(context.Background(), tc.token)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.fetches, fetches.Load(), fmt.Sprintf("%s: expected %d keys fetches got %d", tc.desc, tc.fetches, fetches.Load()))
+		})
+	}
+}
+
+func TestAuthenticateWithIncompleteRevocations(t *testing.T) {
+	key, keys := newKey(t, "idp-key")
+	idp, _ := newIssuer(t, keys)
+
+	revocations := jwks.NewRevocations(1)
+	revocations.SetLoaded()
+	revocations.Add(smqauth.Revocation{Subject: "kept", NotBefore: time.Now(), ExpiresAt: time.Now().Add(time.Hour)})
+	revocations.Add(smqauth.Revocation{Subject: "dropped", NotBefore: time.Now(), ExpiresAt: time.Now().Add(time.Hour)})
+
+	usersClient := new(usersmocks.UsersServiceClient)
+	usersClient.On("ProvisionUser", mock.Anything, mock.Anything).Return(&grpcUsersV1.ProvisionUserRes{}, nil)
+	an, err := external.NewAuthentication(new(authnmocks.Authentication), usersClient, map[string]external.Config{
+		issuerName: {IssuerURL: idp.URL, Audiences: []string{audience}, Claims: claims},
+	}, revocations)
+	require.Nil(t, err, fmt.Sprintf("creating authentication expected to succeed: %s", err))
+
+	token := sign(t, key, map[string]any{
+		jwt.IssuerKey:     idp.URL,
+		jwt.SubjectKey:    "user",
+		jwt.AudienceKey:   []string{audience},
+		jwt.ExpirationKey: time.Now().Add(time.Hour),
+		"email":           "user@example.com",
+	})
+	for range 2 {
+		_, err := an.Authenticate(context.Background(), token)
+		assert.Nil(t, err, fmt.Sprintf("authenticate token expected to succeed: %s", err))
+	}
+	// The user is provisioned on each request, since the user may be disabled
+	// by the revocation which didn't fit in the list.
+	usersClient.AssertNumberOfCalls(t, "ProvisionUser", 2)
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package external contains the authentication of the JWT access tokens
+// issued by the external OpenID Connect identity providers. The users of the
+// trusted issuers are provisioned just in time on the first use of the token
+// through the users gRPC service.
+package external
@@ -54,6 +54,7 @@ type authentication struct {
 	authSvcClient grpcAuthV1.AuthServiceClient
 	httpClient    *http.Client
 	cache         *jwksCache
+	revocations   *Revocations
 }
 
 type jwksCache struct {
@@ -62,7 +63,11 @@ type jwksCache struct {
 	cachedAt time.Time
 }
 
-func NewAuthentication(ctx context.Context, jwksURL string, cfg grpcclient.Config) (authn.Authentication, grpcclient.Handler, error) {
+// NewAuthentication returns the authentication which verifies the tokens
+// against the auth service JWKS, and rejects the ones in the revocation list.
+// While the revocation list is incomplete, the tokens are checked by the auth
+// service as well. The revocation check is skipped if the list is nil.
+func NewAuthentication(ctx context.Context, jwksURL string, cfg grpcclient.Config, revocations *Revocations) (authn.Authentication, grpcclient.Handler, error) {
 	client, err := grpcclient.NewHandler(cfg)
 	if err != nil {
 		return nil, nil, err
@@ -84,6 +89,7 @@ func NewAuthentication(ctx context.Context, jwksURL string, cfg grpcclient.Confi
 		authSvcClient: authSvcClient,
 		httpClient:    httpClient,
 		cache:         &jwksCache{},
+		revocations:   revocations,
 	}, client, nil
 }
 
@@ -120,12 +126,25 @@ func (a authentication) Authenticate(ctx context.Context, token string) (authn.S
 	if err != nil {
 		return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
 	}
+	if a.revocations != nil {
+		if a.revocations.Revoked(key) {
+			return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, smqauth.ErrRevokedToken)
+		}
+		// The token may be revoked by the revocation which didn't fit
+		// in the list, so it's checked by the auth service.
+		if !a.revocations.Complete() {
+			if _, err := a.authSvcClient.Authenticate(ctx, &grpcAuthV1.AuthNReq{Token: token}); err != nil {
+				return authn.Session{}, errors.Wrap(svcerr.ErrAuthentication, err)
+			}
+		}
+	}
 
 	return authn.Session{
 		Type:     authn.AccessToken,
 		UserID:   key.Subject,
 		Role:     authn.Role(key.Role),
 		Verified: key.Verified,
+		MFA:      key.MFA,
 	}, nil
 }
 
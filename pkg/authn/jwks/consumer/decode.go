@@ -0,0 +1,71 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"time"
+
+	smqauth "github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var (
+	errTokenID   = errors.New("missing or invalid 'token_id'")
+	errSessionID = errors.New("missing or invalid 'session_id'")
+	errSubject   = errors.New("missing or invalid 'subject'")
+	errNotBefore = errors.New("failed to parse 'not_before' time")
+	errExpiresAt = errors.New("failed to parse 'expires_at' time")
+)
+
+func decodeRevokeTokenEvent(data map[string]any) (smqauth.Revocation, error) {
+	tokenID, ok := data["token_id"].(string)
+	if !ok || tokenID == "" {
+		return smqauth.Revocation{}, errTokenID
+	}
+	expiresAt, err := decodeTime(data, "expires_at")
+	if err != nil {
+		return smqauth.Revocation{}, errors.Wrap(errExpiresAt, err)
+	}
+
+	return smqauth.Revocation{TokenID: tokenID, ExpiresAt: expiresAt}, nil
+}
+
+func decodeRevokeSessionEvent(data map[string]any) (smqauth.Revocation, error) {
+	sessionID, ok := data["session_id"].(string)
+	if !ok || sessionID == "" {
+		return smqauth.Revocation{}, errSessionID
+	}
+	expiresAt, err := decodeTime(data, "expires_at")
+	if err != nil {
+		return smqauth.Revocation{}, errors.Wrap(errExpiresAt, err)
+	}
+
+	return smqauth.Revocation{SessionID: sessionID, ExpiresAt: expiresAt}, nil
+}
+
+func decodeRevokeUserTokensEvent(data map[string]any) (smqauth.Revocation, error) {
+	subject, ok := data["subject"].(string)
+	if !ok || subject == "" {
+		return smqauth.Revocation{}, errSubject
+	}
+	notBefore, err := decodeTime(data, "not_before")
+	if err != nil {
+		return smqauth.Revocation{}, errors.Wrap(errNotBefore, err)
+	}
+	expiresAt, err := decodeTime(data, "expires_at")
+	if err != nil {
+		return smqauth.Revocation{}, errors.Wrap(errExpiresAt, err)
+	}
+
+	return smqauth.Revocation{Subject: subject, NotBefore: notBefore, ExpiresAt: expiresAt}, nil
+}
+
+func decodeTime(data map[string]any, key string) (time.Time, error) {
+	value, ok := data[key].(string)
+	if !ok {
+		return time.Time{}, errors.New("missing time")
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package consumer contains the auth service token revocation events
+// consumer, which fills the revocation list of the jwks authentication.
+package consumer
@@ -0,0 +1,176 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	smqauth "github.com/absmach/supermq/auth"
+	tokengrpc "github.com/absmach/supermq/auth/api/grpc/token"
+	"github.com/absmach/supermq/pkg/authn/jwks"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/store"
+	"github.com/absmach/supermq/pkg/grpcclient"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/uuid"
+)
+
+const (
+	stream = "events.supermq.token.*"
+
+	loadLimit         = 1000
+	loadRetryInterval = 5 * time.Second
+
+	tokenRevoke      = "token.revoke"
+	sessionRevoke    = "token.revoke_session"
+	userTokensRevoke = "token.revoke_user"
+)
+
+var (
+	errNoOperationKey         = errors.New("operation key is not found in event message")
+	errRevokeTokenEvent       = errors.New("failed to consume token revoke event")
+	errRevokeSessionEvent     = errors.New("failed to consume session revoke event")
+	errRevokeUserTokensEvent  = errors.New("failed to consume user tokens revoke event")
+	errRevocationConsumerName = errors.New("failed to generate revocation consumer name")
+	errLoadRevocations        = errors.New("failed to load revocations")
+)
+
+type eventHandler struct {
+	revocations *jwks.Revocations
+}
+
+// RevocationsSubscribe returns the revocation list filled with the token
+// revocations published by the auth service. Since the list is kept in
+// memory, each service instance subscribes with its own ephemeral consumer,
+// which is removed once the instance is gone. The event store may no longer
+// hold the older revocations, so once subscribed, the revocations stored by
+// the auth service are loaded in the background and the list is incomplete
+// until they are.
+func RevocationsSubscribe(ctx context.Context, esURL, esConsumerName string, authCfg grpcclient.Config, logger *slog.Logger) (*jwks.Revocations, error) {
+	handler, err := grpcclient.NewHandler(authCfg)
+	if err != nil {
+		return nil, err
+	}
+	subscriber, err := store.NewSubscriber(ctx, esURL, logger)
+	if err != nil {
+		return nil, err
+	}
+	suffix, err := uuid.New().ID()
+	if err != nil {
+		return nil, errors.Wrap(errRevocationConsumerName, err)
+	}
+
+	revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+	subConfig := events.SubscriberConfig{
+		Stream:         stream,
+		Consumer:       esConsumerName + "-revocations-" + suffix,
+		Handler:        NewEventHandler(revocations),
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+		Ephemeral:      true,
+	}
+	if err := subscriber.Subscribe(ctx, subConfig); err != nil {
+		handler.Close()
+		return nil, err
+	}
+
+	go func() {
+		defer handler.Close()
+		loadRevocations(ctx, tokengrpc.NewTokenClient(handler.Connection(), authCfg.Timeout), revocations, logger)
+	}()
+
+	return revocations, nil
+}
+
+// LoadRevocations adds the revocations stored by the auth service to the list
+// and marks the list as loaded.
+func LoadRevocations(ctx context.Context, client grpcTokenV1.TokenServiceClient, revocations *jwks.Revocations) error {
+	for offset := uint64(0); ; offset += loadLimit {
+		res, err := client.ListRevocations(ctx, &grpcTokenV1.ListRevocationsReq{Offset: offset, Limit: loadLimit})
+		if err != nil {
+			return errors.Wrap(errLoadRevocations, err)
+		}
+		for _, r := range res.GetRevocations() {
+			revocation := smqauth.Revocation{
+				TokenID:   r.GetTokenId(),
+				SessionID: r.GetSessionId(),
+				Subject:   r.GetSubject(),
+				ExpiresAt: r.GetExpiresAt().AsTime(),
+			}
+			if r.GetNotBefore() != nil {
+				revocation.NotBefore = r.GetNotBefore().AsTime()
+			}
+			revocations.Add(revocation)
+		}
+		if len(res.GetRevocations()) < loadLimit {
+			break
+		}
+	}
+	revocations.SetLoaded()
+
+	return nil
+}
+
+func loadRevocations(ctx context.Context, client grpcTokenV1.TokenServiceClient, revocations *jwks.Revocations, logger *slog.Logger) {
+	ticker := time.NewTicker(loadRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		err := LoadRevocations(ctx, client, revocations)
+		if err == nil {
+			return
+		}
+		logger.Warn(fmt.Sprintf("failed to load token revocations, retrying in %s: %s", loadRetryInterval, err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// NewEventHandler returns new event store handler.
+func NewEventHandler(revocations *jwks.Revocations) events.EventHandler {
+	return &eventHandler{
+		revocations: revocations,
+	}
+}
+
+func (es *eventHandler) Handle(_ context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	op, ok := msg["operation"]
+	if !ok {
+		return errNoOperationKey
+	}
+	switch op {
+	case tokenRevoke:
+		r, err := decodeRevokeTokenEvent(msg)
+		if err != nil {
+			return errors.Wrap(errRevokeTokenEvent, err)
+		}
+		es.revocations.Add(r)
+	case sessionRevoke:
+		r, err := decodeRevokeSessionEvent(msg)
+		if err != nil {
+			return errors.Wrap(errRevokeSessionEvent, err)
+		}
+		es.revocations.Add(r)
+	case userTokensRevoke:
+		r, err := decodeRevokeUserTokensEvent(msg)
+		if err != nil {
+			return errors.Wrap(errRevokeUserTokensEvent, err)
+		}
+		es.revocations.Add(r)
+	}
+
+	return nil
+}
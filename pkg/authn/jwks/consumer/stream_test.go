@@ -0,0 +1,181 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
+	"github.com/absmach/supermq/auth"
+	authmocks "github.com/absmach/supermq/auth/mocks"
+	"github.com/absmach/supermq/pkg/authn/jwks"
+	"github.com/absmach/supermq/pkg/authn/jwks/consumer"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testEvent map[string]any
+
+func (e testEvent) Encode() (map[string]any, error) {
+	return e, nil
+}
+
+func TestHandle(t *testing.T) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour).Format(time.RFC3339Nano)
+
+	cases := []struct {
+		desc    string
+		event   testEvent
+		key     auth.Key
+		revoked bool
+		err     error
+	}{
+		{
+			desc: "handle token revoke event",
+			event: testEvent{
+				"operation":  "token.revoke",
+				"token_id":   "token-id",
+				"expires_at": expiresAt,
+			},
+			key:     auth.Key{ID: "token-id", Subject: "user", IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc: "handle session revoke event",
+			event: testEvent{
+				"operation":  "token.revoke_session",
+				"session_id": "session-id",
+				"expires_at": expiresAt,
+			},
+			key:     auth.Key{SessionID: "session-id", Subject: "user", IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc: "handle user tokens revoke event",
+			event: testEvent{
+				"operation":  "token.revoke_user",
+				"subject":    "revoked-user",
+				"not_before": now.Format(time.RFC3339Nano),
+				"expires_at": expiresAt,
+			},
+			key:     auth.Key{ID: "other-token-id", Subject: "revoked-user", IssuedAt: now.Add(-time.Minute)},
+			revoked: true,
+		},
+		{
+			desc: "handle token revoke event without token ID",
+			event: testEvent{
+				"operation":  "token.revoke",
+				"expires_at": expiresAt,
+			},
+			err: errors.New("missing or invalid 'token_id'"),
+		},
+		{
+			desc: "handle session revoke event without session ID",
+			event: testEvent{
+				"operation":  "token.revoke_session",
+				"expires_at": expiresAt,
+			},
+			err: errors.New("missing or invalid 'session_id'"),
+		},
+		{
+			desc: "handle user tokens revoke event with invalid expiration",
+			event: testEvent{
+				"operation":  "token.revoke_user",
+				"subject":    "user",
+				"not_before": now.Format(time.RFC3339Nano),
+				"expires_at": "invalid",
+			},
+			err: errors.New("failed to parse 'expires_at' time"),
+		},
+		{
+			desc:  "handle event without operation",
+			event: testEvent{"token_id": "token-id"},
+			err:   errors.New("operation key is not found in event message"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+			err := consumer.NewEventHandler(revocations).Handle(context.Background(), tc.event)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.revoked, revocations.Revoked(tc.key), fmt.Sprintf("%s: expected revoked %t", tc.desc, tc.revoked))
+		})
+	}
+}
+
+func TestLoadRevocations(t *testing.T) {
+	now := time.Now().UTC()
+	expiresAt := timestamppb.New(now.Add(time.Hour))
+
+	fullPage := make([]*grpcTokenV1.Revocation, 1000)
+	for i := range fullPage {
+		fullPage[i] = &grpcTokenV1.Revocation{TokenId: fmt.Sprintf("token-%d", i), ExpiresAt: expiresAt}
+	}
+	lastPage := []*grpcTokenV1.Revocation{
+		{SessionId: "session-id", ExpiresAt: expiresAt},
+		{Subject: "revoked-user", NotBefore: timestamppb.New(now), ExpiresAt: expiresAt},
+	}
+
+	cases := []struct {
+		desc    string
+		pages   [][]*grpcTokenV1.Revocation
+		listErr error
+		keys    []auth.Key
+		loaded  bool
+		err     error
+	}{
+		{
+			desc:  "load revocations in pages",
+			pages: [][]*grpcTokenV1.Revocation{fullPage, lastPage},
+			keys: []auth.Key{
+				{ID: "token-0", Subject: "user", IssuedAt: now},
+				{ID: "token-999", Subject: "user", IssuedAt: now},
+				{SessionID: "session-id", Subject: "user", IssuedAt: now},
+				{Subject: "revoked-user", IssuedAt: now.Add(-time.Minute)},
+			},
+			loaded: true,
+		},
+		{
+			desc:   "load empty revocations",
+			pages:  [][]*grpcTokenV1.Revocation{{}},
+			loaded: true,
+		},
+		{
+			desc:    "load revocations with failed to list",
+			listErr: svcerr.ErrViewEntity,
+			loaded:  false,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := new(authmocks.TokenServiceClient)
+			if tc.listErr != nil {
+				client.On("ListRevocations", mock.Anything, mock.Anything).Return(&grpcTokenV1.ListRevocationsRes{}, tc.listErr)
+			}
+			for i, page := range tc.pages {
+				req := &grpcTokenV1.ListRevocationsReq{Offset: uint64(i * 1000), Limit: 1000}
+				client.On("ListRevocations", mock.Anything, req).Return(&grpcTokenV1.ListRevocationsRes{Revocations: page}, nil)
+			}
+
+			revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+			err := consumer.LoadRevocations(context.Background(), client, revocations)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.loaded, revocations.Complete(), fmt.Sprintf("%s: expected complete %t", tc.desc, tc.loaded))
+			for _, key := range tc.keys {
+				assert.True(t, revocations.Revoked(key), fmt.Sprintf("%s: expected key %v to be revoked", tc.desc, key))
+			}
+			client.AssertNumberOfCalls(t, "ListRevocations", max(len(tc.pages), 1))
+		})
+	}
+}
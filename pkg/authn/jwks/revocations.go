@@ -0,0 +1,179 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package jwks
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	smqauth "github.com/absmach/supermq/auth"
+)
+
+// DefaultRevocationsSize is the default maximum number of the revocations
+// kept in memory.
+const DefaultRevocationsSize = 100_000
+
+const (
+	tokenKeyPrefix   = "token:"
+	sessionKeyPrefix = "session:"
+	subjectKeyPrefix = "subject:"
+)
+
+// Revocations is the bounded in-memory list of the token revocations, so the
+// revoked tokens are rejected without calling the auth service. Once the list
+// is full, the new revocations are dropped and the list is incomplete until
+// the dropped revocations expire, so the tokens have to be checked by the
+// auth service instead. The list is incomplete as well until the revocations
+// stored by the auth service are loaded.
+type Revocations struct {
+	mu      sync.RWMutex
+	size    int
+	entries map[string]*revocationEntry
+	queue   revocationQueue
+	loaded  bool
+	// droppedUntil is the time the last of the dropped revocations expires at.
+	droppedUntil time.Time
+	now          func() time.Time
+}
+
+type revocationEntry struct {
+	key        string
+	revocation smqauth.Revocation
+	index      int
+}
+
+// NewRevocations returns the revocation list which keeps at most the given
+// number of revocations.
+func NewRevocations(size int) *Revocations {
+	return &Revocations{
+		size:    max(size, 1),
+		entries: make(map[string]*revocationEntry),
+		now:     time.Now,
+	}
+}
+
+// Add adds the revocation to the list. Revoking the tokens of the same
+// subject again moves the revocation forward.
+func (r *Revocations) Add(revocation smqauth.Revocation) {
+	key := revocationKey(revocation)
+	if key == "" {
+		return
+	}
+	now := r.now()
+	if !revocation.ExpiresAt.After(now) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.entries[key]; ok {
+		if revocation.NotBefore.After(e.revocation.NotBefore) {
+			e.revocation.NotBefore = revocation.NotBefore
+		}
+		if revocation.ExpiresAt.After(e.revocation.ExpiresAt) {
+			e.revocation.ExpiresAt = revocation.ExpiresAt
+			heap.Fix(&r.queue, e.index)
+		}
+		return
+	}
+
+	for len(r.queue) > 0 && !r.queue[0].revocation.ExpiresAt.After(now) {
+		e := heap.Pop(&r.queue).(*revocationEntry)
+		delete(r.entries, e.key)
+	}
+	// Evicting the revocation which is still valid would accept the revoked
+	// token, so the new revocation is dropped instead.
+	if len(r.queue) >= r.size {
+		if revocation.ExpiresAt.After(r.droppedUntil) {
+			r.droppedUntil = revocation.ExpiresAt
+		}
+		return
+	}
+	e := &revocationEntry{key: key, revocation: revocation}
+	heap.Push(&r.queue, e)
+	r.entries[key] = e
+}
+
+// Revoked returns true if the token of the key is revoked.
+func (r *Revocations) Revoked(key smqauth.Key) bool {
+	now := r.now()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, k := range []string{tokenKeyPrefix + key.ID, sessionKeyPrefix + key.SessionID, subjectKeyPrefix + key.Subject} {
+		e, ok := r.entries[k]
+		if ok && e.revocation.ExpiresAt.After(now) && e.revocation.Revokes(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetLoaded marks the revocations stored by the auth service as loaded.
+func (r *Revocations) SetLoaded() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.loaded = true
+}
+
+// Complete returns false if the stored revocations aren't loaded yet or any
+// of the dropped revocations is still valid, in which case the token that
+// isn't in the list may be revoked anyway.
+func (r *Revocations) Complete() bool {
+	now := r.now()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.loaded && !r.droppedUntil.After(now)
+}
+
+func revocationKey(revocation smqauth.Revocation) string {
+	switch {
+	case revocation.TokenID != "":
+		return tokenKeyPrefix + revocation.TokenID
+	case revocation.SessionID != "":
+		return sessionKeyPrefix + revocation.SessionID
+	case revocation.Subject != "":
+		return subjectKeyPrefix + revocation.Subject
+	default:
+		return ""
+	}
+}
+
+// revocationQueue is the min-heap of the revocations ordered by expiration.
+type revocationQueue []*revocationEntry
+
+func (q revocationQueue) Len() int { return len(q) }
+
+func (q revocationQueue) Less(i, j int) bool {
+	return q[i].revocation.ExpiresAt.Before(q[j].revocation.ExpiresAt)
+}
+
+func (q revocationQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *revocationQueue) Push(x any) {
+	e := x.(*revocationEntry)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *revocationQueue) Pop() any {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return e
+}
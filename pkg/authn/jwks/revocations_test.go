@@ -0,0 +1,105 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package jwks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/auth"
+	"github.com/absmach/supermq/pkg/authn/jwks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevocations(t *testing.T) {
+	now := time.Now().UTC()
+	revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+	revocations.Add(auth.Revocation{TokenID: "revoked", ExpiresAt: now.Add(time.Hour)})
+	revocations.Add(auth.Revocation{TokenID: "expired", ExpiresAt: now.Add(-time.Minute)})
+	revocations.Add(auth.Revocation{SessionID: "revoked-session", ExpiresAt: now.Add(time.Hour)})
+	revocations.Add(auth.Revocation{Subject: userID, NotBefore: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)})
+	revocations.Add(auth.Revocation{Subject: userID, NotBefore: now, ExpiresAt: now.Add(time.Hour)})
+	// The older revocation doesn't move the revocation back.
+	revocations.Add(auth.Revocation{Subject: userID, NotBefore: now.Add(-2 * time.Hour), ExpiresAt: now.Add(time.Hour)})
+
+	cases := []struct {
+		desc    string
+		key     auth.Key
+		revoked bool
+	}{
+		{
+			desc:    "check revoked token",
+			key:     auth.Key{ID: "revoked", Subject: "other", IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc:    "check token with expired revocation",
+			key:     auth.Key{ID: "expired", Subject: "other", IssuedAt: now},
+			revoked: false,
+		},
+		{
+			desc:    "check token of revoked session",
+			key:     auth.Key{SessionID: "revoked-session", Subject: "other", IssuedAt: now},
+			revoked: true,
+		},
+		{
+			desc:    "check token of other session",
+			key:     auth.Key{SessionID: "session", Subject: "other", IssuedAt: now},
+			revoked: false,
+		},
+		{
+			desc:    "check token of revoked subject issued before revocation",
+			key:     auth.Key{ID: "token", Subject: userID, IssuedAt: now.Add(-time.Minute)},
+			revoked: true,
+		},
+		{
+			desc:    "check token of revoked subject issued in the second of revocation",
+			key:     auth.Key{ID: "token", Subject: userID, IssuedAt: now.Truncate(time.Second)},
+			revoked: true,
+		},
+		{
+			desc:    "check token of revoked subject issued after revocation",
+			key:     auth.Key{ID: "token", Subject: userID, IssuedAt: now.Add(time.Second)},
+			revoked: false,
+		},
+		{
+			desc:    "check token without revocation",
+			key:     auth.Key{ID: "token", Subject: "other", IssuedAt: now},
+			revoked: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			revoked := revocations.Revoked(tc.key)
+			assert.Equal(t, tc.revoked, revoked, fmt.Sprintf("%s: expected %t got %t", tc.desc, tc.revoked, revoked))
+		})
+	}
+}
+
+func TestRevocationsOverflow(t *testing.T) {
+	now := time.Now().UTC()
+	revocations := jwks.NewRevocations(2)
+	revocations.SetLoaded()
+	revocations.Add(auth.Revocation{TokenID: "expired", ExpiresAt: now.Add(-time.Minute)})
+	revocations.Add(auth.Revocation{TokenID: "long", ExpiresAt: now.Add(3 * time.Hour)})
+	revocations.Add(auth.Revocation{TokenID: "short", ExpiresAt: now.Add(time.Hour)})
+	assert.True(t, revocations.Complete(), "expected the list to be complete before it is full")
+
+	revocations.Add(auth.Revocation{TokenID: "dropped", ExpiresAt: now.Add(2 * time.Hour)})
+
+	assert.True(t, revocations.Revoked(auth.Key{ID: "long"}), "expected the revocation to be kept")
+	assert.True(t, revocations.Revoked(auth.Key{ID: "short"}), "expected the revocation which expires first to be kept")
+	assert.False(t, revocations.Revoked(auth.Key{ID: "dropped"}), "expected the new revocation to be dropped")
+	assert.False(t, revocations.Complete(), "expected the list to be incomplete after the revocation is dropped")
+}
+
+func TestRevocationsLoaded(t *testing.T) {
+	revocations := jwks.NewRevocations(jwks.DefaultRevocationsSize)
+	assert.False(t, revocations.Complete(), "expected the list to be incomplete before the stored revocations are loaded")
+
+	revocations.SetLoaded()
+	assert.True(t, revocations.Complete(), "expected the list to be complete after the stored revocations are loaded")
+}
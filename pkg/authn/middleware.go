@@ -132,7 +132,8 @@ func (a *authnMiddleware) Middleware() func(http.Handler) http.Handler {
 				encodeError(w, apiutil.ErrBearerToken, http.StatusUnauthorized)
 				return
 			}
-			resp, err := a.Authenticate(r.Context(), token)
+			ctx := WithClientInfo(r.Context(), ClientInfoFromRequest(r))
+			resp, err := a.Authenticate(ctx, token)
 			if err != nil {
 				encodeError(w, err, http.StatusUnauthorized)
 				return
@@ -158,7 +159,7 @@ func (a *authnMiddleware) Middleware() func(http.Handler) http.Handler {
 				}
 			}
 
-			ctx := context.WithValue(r.Context(), SessionKey, resp)
+			ctx = context.WithValue(ctx, SessionKey, resp)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -9,6 +9,7 @@ import (
 	grpcAuthV1 "github.com/absmach/supermq/api/grpc/auth/v1"
 	"github.com/absmach/supermq/auth/api/grpc/auth"
 	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/authz"
 	pkgDomians "github.com/absmach/supermq/pkg/domains"
 	"github.com/absmach/supermq/pkg/errors"
@@ -18,6 +19,8 @@ import (
 	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+const deleteDomainPermission = "delete_permission"
+
 type authorization struct {
 	authSvcClient grpcAuthV1.AuthServiceClient
 	domains       pkgDomians.Authorization
@@ -55,7 +58,7 @@ func (a authorization) Authorize(ctx context.Context, pr authz.PolicyReq) error
 			}
 			domainID = pr.Object
 		}
-		if err := a.checkDomain(ctx, pr.SubjectType, pr.Subject, domainID); err != nil {
+		if err := a.checkDomain(ctx, pr, domainID); err != nil {
 			return errors.Wrap(svcerr.ErrDomainAuthorization, err)
 		}
 	}
@@ -75,6 +78,12 @@ func (a authorization) Authorize(ctx context.Context, pr authz.PolicyReq) error
 		UserId:          pr.UserID,
 		EntityId:        pr.EntityID,
 		EntityType:      pr.EntityType,
+		ClientIp:        authn.ClientInfoFromContext(ctx).IP,
+	}
+	// The MFA of the access token session is checked against the domain MFA policy.
+	// The PAT requests are checked by the auth service with the MFA of the PAT.
+	if session, ok := ctx.Value(authn.SessionKey).(authn.Session); ok && session.Type == authn.AccessToken {
+		req.Mfa = &session.MFA
 	}
 
 	res, err := a.authSvcClient.Authorize(ctx, &req)
@@ -87,12 +96,13 @@ func (a authorization) Authorize(ctx context.Context, pr authz.PolicyReq) error
 	return nil
 }
 
-func (a authorization) checkDomain(ctx context.Context, subjectType, subject, domainID string) error {
+func (a authorization) checkDomain(ctx context.Context, pr authz.PolicyReq, domainID string) error {
 	status, err := a.domains.RetrieveStatus(ctx, domainID)
 	if err != nil {
 		return errors.Wrap(svcerr.ErrViewEntity, err)
 	}
 
+	subjectType, subject := pr.SubjectType, pr.Subject
 	switch status {
 	case domains.FreezeStatus:
 		_, err := a.authSvcClient.Authorize(ctx, &grpcAuthV1.PolicyReq{
@@ -103,6 +113,22 @@ func (a authorization) checkDomain(ctx context.Context, subjectType, subject, do
 			ObjectType:  policies.PlatformType,
 		})
 
+		return err
+	case domains.DeletedStatus:
+		// Only the domain deletion can be managed in the deleted domain, so
+		// the domain administrators are able to restore it.
+		object, objectType := policies.SuperMQObject, policies.PlatformType
+		if pr.ObjectType == policies.DomainType && pr.Object == domainID && pr.Permission == deleteDomainPermission {
+			object, objectType = domainID, policies.DomainType
+		}
+		_, err := a.authSvcClient.Authorize(ctx, &grpcAuthV1.PolicyReq{
+			Subject:     subject,
+			SubjectType: subjectType,
+			Permission:  policies.AdminPermission,
+			Object:      object,
+			ObjectType:  objectType,
+		})
+
 		return err
 	case domains.DisabledStatus:
 		_, err := a.authSvcClient.Authorize(ctx, &grpcAuthV1.PolicyReq{
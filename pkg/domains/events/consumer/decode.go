@@ -23,6 +23,8 @@ var (
 	errDecodeEnableDomainEvent  = errors.New("failed to decode domain enable event")
 	errDecodeDisableDomainEvent = errors.New("failed to decode domain disable event")
 	errDecodeFreezeDomainEvent  = errors.New("failed to decode domain freeze event")
+	errDecodeDeleteDomainEvent  = errors.New("failed to decode domain delete event")
+	errDecodeRestoreDomainEvent = errors.New("failed to decode domain restore event")
 	errDecodeRemoveDomainsEvent = errors.New("failed to decode domain remove  event")
 
 	errID            = errors.New("missing or invalid 'id'")
@@ -261,6 +263,65 @@ func decodeUserDeleteDomainEvent(_ map[string]any) (domains.Domain, error) {
 }
 
 func decodeDeleteDomainEvent(data map[string]any) (domains.Domain, error) {
+	var d domains.Domain
+	id, ok := data["id"].(string)
+	if !ok {
+		return domains.Domain{}, errors.Wrap(errDecodeDeleteDomainEvent, errID)
+	}
+	d.ID = id
+
+	uby, ok := data["updated_by"].(string)
+	if ok {
+		d.UpdatedBy = uby
+	}
+
+	uat, ok := data["updated_at"].(string)
+	if ok {
+		ut, err := time.Parse(layout, uat)
+		if err != nil {
+			return domains.Domain{}, errors.Wrap(errDecodeDeleteDomainEvent, errors.Wrap(errUpdatedAt, err))
+		}
+		d.UpdatedAt = ut
+	}
+
+	return d, nil
+}
+
+func decodeRestoreDomainEvent(data map[string]any) (domains.Domain, error) {
+	var d domains.Domain
+	id, ok := data["id"].(string)
+	if !ok {
+		return domains.Domain{}, errors.Wrap(errDecodeRestoreDomainEvent, errID)
+	}
+	d.ID = id
+
+	// Events published before the restored status was included carry no
+	// status, in which case the domain is restored as enabled.
+	stat, _ := data["status"].(string)
+	st, err := domains.ToStatus(stat)
+	if err != nil {
+		return domains.Domain{}, errors.Wrap(errDecodeRestoreDomainEvent, errors.Wrap(errConvertStatus, err))
+	}
+	d.Status = st
+
+	uby, ok := data["updated_by"].(string)
+	if ok {
+		d.UpdatedBy = uby
+	}
+
+	uat, ok := data["updated_at"].(string)
+	if ok {
+		ut, err := time.Parse(layout, uat)
+		if err != nil {
+			return domains.Domain{}, errors.Wrap(errDecodeRestoreDomainEvent, errors.Wrap(errUpdatedAt, err))
+		}
+		d.UpdatedAt = ut
+	}
+
+	return d, nil
+}
+
+func decodeRemoveDomainEvent(data map[string]any) (domains.Domain, error) {
 	var d domains.Domain
 	id, ok := data["id"].(string)
 	if !ok {
@@ -20,11 +20,14 @@ const (
 	stream = "events.supermq.domain.*"
 
 	create     = "domain.create"
+	importOp   = "domain.import"
 	update     = "domain.update"
 	enable     = "domain.enable"
 	disable    = "domain.disable"
 	freeze     = "domain.freeze"
 	delete     = "domain.delete"
+	restore    = "domain.restore"
+	remove     = "domain.remove"
 	userDelete = "domain.user_delete"
 )
 
@@ -37,14 +40,20 @@ var (
 	errFreezeDomainGroupEvent  = errors.New("failed to consume domain freeze event")
 	errUserDeleteDomainEvent   = errors.New("failed to consume domain user delete event")
 	errDeleteDomainEvent       = errors.New("failed to consume domain delete event")
+	errRestoreDomainEvent      = errors.New("failed to consume domain restore event")
+	errRemoveDomainEvent       = errors.New("failed to consume domain remove event")
 )
 
+// RemoveEntitiesFunc removes the service entities which belong to the removed domain.
+type RemoveEntitiesFunc func(ctx context.Context, domainID string) error
+
 type eventHandler struct {
 	repo              domains.Repository
+	removeEntities    RemoveEntitiesFunc
 	rolesEventHandler rconsumer.EventHandler
 }
 
-func DomainsEventsSubscribe(ctx context.Context, repo domains.Repository, esURL, esConsumerName string, logger *slog.Logger) error {
+func DomainsEventsSubscribe(ctx context.Context, repo domains.Repository, removeEntities RemoveEntitiesFunc, esURL, esConsumerName string, logger *slog.Logger) error {
 	subscriber, err := store.NewSubscriber(ctx, esURL, logger)
 	if err != nil {
 		return err
@@ -53,7 +62,7 @@ func DomainsEventsSubscribe(ctx context.Context, repo domains.Repository, esURL,
 	subConfig := events.SubscriberConfig{
 		Stream:         stream,
 		Consumer:       esConsumerName,
-		Handler:        NewEventHandler(repo),
+		Handler:        NewEventHandler(repo, removeEntities),
 		DeliveryPolicy: messaging.DeliverNewPolicy,
 		Ordered:        true,
 	}
@@ -61,10 +70,12 @@ func DomainsEventsSubscribe(ctx context.Context, repo domains.Repository, esURL,
 }
 
 // NewEventHandler returns new event store handler.
-func NewEventHandler(repo domains.Repository) events.EventHandler {
+// The removeEntities function is optional and it is called when the domain is removed.
+func NewEventHandler(repo domains.Repository, removeEntities RemoveEntitiesFunc) events.EventHandler {
 	reh := rconsumer.NewEventHandler("domain", repo)
 	return &eventHandler{
 		repo:              repo,
+		removeEntities:    removeEntities,
 		rolesEventHandler: reh,
 	}
 }
@@ -81,7 +92,7 @@ func (es *eventHandler) Handle(ctx context.Context, event events.Event) error {
 		return errNoOperationKey
 	}
 	switch op {
-	case create:
+	case create, importOp:
 		return es.createDomainHandler(ctx, msg)
 	case update:
 		return es.updateDomainHandler(ctx, msg)
@@ -95,6 +106,10 @@ func (es *eventHandler) Handle(ctx context.Context, event events.Event) error {
 		return es.userDeleteDomainHandler(ctx, msg)
 	case delete:
 		return es.deleteDomainHandler(ctx, msg)
+	case restore:
+		return es.restoreDomainHandler(ctx, msg)
+	case remove:
+		return es.removeDomainHandler(ctx, msg)
 	}
 
 	return es.rolesEventHandler.Handle(ctx, op, msg)
@@ -196,9 +211,42 @@ func (es *eventHandler) deleteDomainHandler(ctx context.Context, data map[string
 		return errors.Wrap(errDeleteDomainEvent, err)
 	}
 
-	if err := es.repo.DeleteDomain(ctx, d.ID); err != nil {
+	deleted := domains.DeletedStatus
+	if _, err := es.repo.UpdateDomain(ctx, d.ID, domains.DomainReq{Status: &deleted, UpdatedBy: &d.UpdatedBy, UpdatedAt: &d.UpdatedAt}); err != nil {
 		return errors.Wrap(errDeleteDomainEvent, err)
 	}
 
 	return nil
 }
+
+func (es *eventHandler) restoreDomainHandler(ctx context.Context, data map[string]any) error {
+	d, err := decodeRestoreDomainEvent(data)
+	if err != nil {
+		return errors.Wrap(errRestoreDomainEvent, err)
+	}
+
+	if _, err := es.repo.UpdateDomain(ctx, d.ID, domains.DomainReq{Status: &d.Status, UpdatedBy: &d.UpdatedBy, UpdatedAt: &d.UpdatedAt}); err != nil {
+		return errors.Wrap(errRestoreDomainEvent, err)
+	}
+
+	return nil
+}
+
+func (es *eventHandler) removeDomainHandler(ctx context.Context, data map[string]any) error {
+	d, err := decodeRemoveDomainEvent(data)
+	if err != nil {
+		return errors.Wrap(errRemoveDomainEvent, err)
+	}
+
+	if es.removeEntities != nil {
+		if err := es.removeEntities(ctx, d.ID); err != nil {
+			return errors.Wrap(errRemoveDomainEvent, err)
+		}
+	}
+
+	if err := es.repo.DeleteDomain(ctx, d.ID); err != nil {
+		return errors.Wrap(errRemoveDomainEvent, err)
+	}
+
+	return nil
+}
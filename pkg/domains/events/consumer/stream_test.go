@@ -0,0 +1,124 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/domains/mocks"
+	"github.com/absmach/supermq/pkg/domains/events/consumer"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testEvent map[string]any
+
+func (e testEvent) Encode() (map[string]any, error) {
+	return e, nil
+}
+
+func TestRestoreDomain(t *testing.T) {
+	repo := new(mocks.Repository)
+	handler := consumer.NewEventHandler(repo, nil)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc      string
+		event     testEvent
+		status    domains.Status
+		updateErr error
+		err       error
+	}{
+		{
+			desc: "restore enabled domain",
+			event: testEvent{
+				"operation":  "domain.restore",
+				"id":         "domain-id",
+				"status":     domains.Enabled,
+				"updated_by": "user-id",
+				"updated_at": now.Format(time.RFC3339Nano),
+			},
+			status: domains.EnabledStatus,
+		},
+		{
+			desc: "restore disabled domain",
+			event: testEvent{
+				"operation":  "domain.restore",
+				"id":         "domain-id",
+				"status":     domains.Disabled,
+				"updated_by": "user-id",
+				"updated_at": now.Format(time.RFC3339Nano),
+			},
+			status: domains.DisabledStatus,
+		},
+		{
+			desc: "restore frozen domain",
+			event: testEvent{
+				"operation":  "domain.restore",
+				"id":         "domain-id",
+				"status":     domains.Freezed,
+				"updated_by": "user-id",
+				"updated_at": now.Format(time.RFC3339Nano),
+			},
+			status: domains.FreezeStatus,
+		},
+		{
+			desc: "restore domain from event without status",
+			event: testEvent{
+				"operation":  "domain.restore",
+				"id":         "domain-id",
+				"updated_by": "user-id",
+				"updated_at": now.Format(time.RFC3339Nano),
+			},
+			status: domains.EnabledStatus,
+		},
+		{
+			desc: "restore domain with invalid status",
+			event: testEvent{
+				"operation": "domain.restore",
+				"id":        "domain-id",
+				"status":    "invalid",
+			},
+			err: errors.New("failed to consume domain restore event"),
+		},
+		{
+			desc: "restore domain with failed update",
+			event: testEvent{
+				"operation": "domain.restore",
+				"id":        "domain-id",
+				"status":    domains.Disabled,
+			},
+			status:    domains.DisabledStatus,
+			updateErr: repoerr.ErrNotFound,
+			err:       repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var req domains.DomainReq
+			repoCall := repo.On("UpdateDomain", context.Background(), "domain-id", mock.Anything).
+				Run(func(args mock.Arguments) {
+					req = args.Get(2).(domains.DomainReq)
+				}).
+				Return(domains.Domain{}, tc.updateErr)
+			err := handler.Handle(context.Background(), tc.event)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected error %s, got %s", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.NotNil(t, req.Status, fmt.Sprintf("%s: expected status to be set", tc.desc))
+				assert.Equal(t, tc.status, *req.Status, fmt.Sprintf("%s: expected status %s, got %s", tc.desc, tc.status, *req.Status))
+				assert.Equal(t, "user-id", *req.UpdatedBy, fmt.Sprintf("%s: expected updated by %s, got %s", tc.desc, "user-id", *req.UpdatedBy))
+				assert.True(t, now.Equal(*req.UpdatedAt), fmt.Sprintf("%s: expected updated at %s, got %s", tc.desc, now, *req.UpdatedAt))
+			}
+			repoCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcclient
+
+import (
+	"context"
+
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	"github.com/absmach/supermq/domains"
+	pkgDomains "github.com/absmach/supermq/pkg/domains"
+)
+
+type quotas struct {
+	domainsSvcClient grpcDomainsV1.DomainsServiceClient
+}
+
+var _ pkgDomains.Quotas = (*quotas)(nil)
+
+// NewQuotas returns the domain quotas which are kept by the domains service.
+func NewQuotas(domainsClient grpcDomainsV1.DomainsServiceClient) pkgDomains.Quotas {
+	return quotas{domainsSvcClient: domainsClient}
+}
+
+func (q quotas) Reserve(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	return q.updateUsage(ctx, domainID, resource, int64(n))
+}
+
+func (q quotas) Release(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	return q.updateUsage(ctx, domainID, resource, -int64(n))
+}
+
+func (q quotas) Reconcile(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	req := grpcDomainsV1.SetUsageReq{
+		DomainId: domainID,
+		Resource: string(resource),
+		Count:    n,
+	}
+	if _, err := q.domainsSvcClient.SetUsage(ctx, &req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (q quotas) updateUsage(ctx context.Context, domainID string, resource domains.Resource, delta int64) error {
+	req := grpcDomainsV1.UpdateUsageReq{
+		DomainId: domainID,
+		Resource: string(resource),
+		Delta:    delta,
+	}
+	if _, err := q.domainsSvcClient.UpdateUsage(ctx, &req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/domains"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewQuotas creates a new instance of Quotas. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuotas(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Quotas {
+	mock := &Quotas{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Quotas is an autogenerated mock type for the Quotas type
+type Quotas struct {
+	mock.Mock
+}
+
+type Quotas_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Quotas) EXPECT() *Quotas_Expecter {
+	return &Quotas_Expecter{mock: &_m.Mock}
+}
+
+// Reconcile provides a mock function for the type Quotas
+func (_mock *Quotas) Reconcile(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	ret := _mock.Called(ctx, domainID, resource, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reconcile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, uint64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Quotas_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type Quotas_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - n uint64
+func (_e *Quotas_Expecter) Reconcile(ctx interface{}, domainID interface{}, resource interface{}, n interface{}) *Quotas_Reconcile_Call {
+	return &Quotas_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, domainID, resource, n)}
+}
+
+func (_c *Quotas_Reconcile_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64)) *Quotas_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Quotas_Reconcile_Call) Return(err error) *Quotas_Reconcile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Quotas_Reconcile_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64) error) *Quotas_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type Quotas
+func (_mock *Quotas) Release(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	ret := _mock.Called(ctx, domainID, resource, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, uint64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Quotas_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type Quotas_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - n uint64
+func (_e *Quotas_Expecter) Release(ctx interface{}, domainID interface{}, resource interface{}, n interface{}) *Quotas_Release_Call {
+	return &Quotas_Release_Call{Call: _e.mock.On("Release", ctx, domainID, resource, n)}
+}
+
+func (_c *Quotas_Release_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64)) *Quotas_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Quotas_Release_Call) Return(err error) *Quotas_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Quotas_Release_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64) error) *Quotas_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type Quotas
+func (_mock *Quotas) Reserve(ctx context.Context, domainID string, resource domains.Resource, n uint64) error {
+	ret := _mock.Called(ctx, domainID, resource, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domains.Resource, uint64) error); ok {
+		r0 = returnFunc(ctx, domainID, resource, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Quotas_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type Quotas_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - resource domains.Resource
+//   - n uint64
+func (_e *Quotas_Expecter) Reserve(ctx interface{}, domainID interface{}, resource interface{}, n interface{}) *Quotas_Reserve_Call {
+	return &Quotas_Reserve_Call{Call: _e.mock.On("Reserve", ctx, domainID, resource, n)}
+}
+
+func (_c *Quotas_Reserve_Call) Run(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64)) *Quotas_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domains.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domains.Resource)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Quotas_Reserve_Call) Return(err error) *Quotas_Reserve_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Quotas_Reserve_Call) RunAndReturn(run func(ctx context.Context, domainID string, resource domains.Resource, n uint64) error) *Quotas_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package domains
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+var errReconcileUsage = errors.New("failed to reconcile domain usage")
+
+// Quotas keeps track of the domain resources usage limited by the domain quotas.
+type Quotas interface {
+	// Reserve reserves the number of the domain resources.
+	// It returns ErrQuotaExceeded if the reservation exceeds the domain quota.
+	Reserve(ctx context.Context, domainID string, resource domains.Resource, n uint64) error
+
+	// Release releases the number of the reserved domain resources.
+	Release(ctx context.Context, domainID string, resource domains.Resource, n uint64) error
+
+	// Reconcile sets the usage of the domain resource to the number
+	// of the domain resources in use.
+	Reconcile(ctx context.Context, domainID string, resource domains.Resource, n uint64) error
+}
+
+// CountFunc returns the number of the resources in use in each of the given
+// domains, or in all the domains if none is given.
+type CountFunc func(ctx context.Context, domainIDs ...string) (map[string]uint64, error)
+
+// ReconcileUsage sets the usage of the resource of the given domains to the
+// number of the resources in use, so the usage which drifted from the
+// resources is corrected. All the domains with the resources in use are
+// reconciled if none is given.
+func ReconcileUsage(ctx context.Context, quotas Quotas, resource domains.Resource, count CountFunc, domainIDs ...string) error {
+	counts, err := count(ctx, domainIDs...)
+	if err != nil {
+		return errors.Wrap(errReconcileUsage, err)
+	}
+	if counts == nil {
+		counts = make(map[string]uint64, len(domainIDs))
+	}
+	// The domain without the resources isn't counted.
+	for _, id := range domainIDs {
+		if _, ok := counts[id]; !ok {
+			counts[id] = 0
+		}
+	}
+
+	// The failure to reconcile one domain doesn't stop the others.
+	var failed error
+	for id, n := range counts {
+		if err := quotas.Reconcile(ctx, id, resource, n); err != nil {
+			failed = err
+		}
+	}
+	if failed != nil {
+		return errors.Wrap(errReconcileUsage, failed)
+	}
+
+	return nil
+}
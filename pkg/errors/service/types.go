@@ -22,6 +22,9 @@ var (
 	// ErrUnauthorizedPAT indicates failure occurred while authorizing PAT.
 	ErrUnauthorizedPAT = errors.NewAuthZError("failed to authorize PAT")
 
+	// ErrQuotaExceeded indicates that the domain quota does not allow the operation.
+	ErrQuotaExceeded = errors.NewAuthZError("domain quota exceeded")
+
 	// ErrSuperAdminAction indicates that the user is not a super admin.
 	ErrSuperAdminAction = errors.NewAuthZError("not authorized to perform admin action")
 
@@ -94,6 +97,9 @@ var (
 	// ErrInvitationAlreadyAccepted indicates that the invitation is already accepted.
 	ErrInvitationAlreadyAccepted = errors.NewRequestError("invitation already accepted")
 
+	// ErrInvitationResendLimit indicates that the invitation was resent the maximum number of times.
+	ErrInvitationResendLimit = errors.NewRequestError("invitation resend limit reached")
+
 	// ErrParentGroupAuthorization indicates failure occurred while authorizing the parent group.
 	ErrParentGroupAuthorization = errors.New("failed to authorize parent group")
 
@@ -107,7 +113,17 @@ var (
 	ErrRollbackRepo = errors.New("failed to rollback repo")
 
 	// ErrRetainOneMember indicates that at least one owner must be retained in the entity.
-	ErrRetainOneMember = errors.New("must retain at least one member")
+	ErrRetainOneMember = errors.NewRequestError("must retain at least one member")
+
+	// ErrInvalidOwnershipTransfer indicates that the ownership is not transferred
+	// by the domain administrator to the domain member who isn't the administrator.
+	ErrInvalidOwnershipTransfer = errors.NewRequestError("ownership can be transferred only by the administrator to the domain member who isn't the administrator")
+
+	// ErrPendingOwnershipTransfer indicates that the domain already has a pending ownership transfer.
+	ErrPendingOwnershipTransfer = errors.NewRequestError("domain has a pending ownership transfer")
+
+	// ErrOwnershipTransferExpired indicates that the ownership transfer is expired.
+	ErrOwnershipTransferExpired = errors.NewRequestError("ownership transfer expired")
 
 	// ErrUserVerificationExpired indicates user verification is expired.
 	ErrUserVerificationExpired = errors.New("verification expired, please generate new verification")
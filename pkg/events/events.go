@@ -45,6 +45,9 @@ type SubscriberConfig struct {
 	Handler        EventHandler
 	Ordered        bool
 	DeliveryPolicy messaging.DeliveryPolicy
+	// Ephemeral consumer is removed once the subscriber is gone, so its
+	// state isn't kept across restarts.
+	Ephemeral bool
 }
 
 // Subscriber specifies event subscription API.
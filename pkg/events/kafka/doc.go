@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kafka contains the domain concept definitions needed to support
+// SuperMQ Kafka events source service functionality.
+//
+// It provides the abstraction of the Kafka events topic and its operations.
+package kafka
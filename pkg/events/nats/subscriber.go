@@ -73,6 +73,7 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 		},
 		DeliveryPolicy: cfg.DeliveryPolicy,
 		Ordered:        cfg.Ordered,
+		Ephemeral:      cfg.Ephemeral,
 	}
 
 	return es.pubsub.Subscribe(ctx, subCfg)
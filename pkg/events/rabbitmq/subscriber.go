@@ -59,6 +59,7 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 			ctx:     ctx,
 		},
 		DeliveryPolicy: messaging.DeliverNewPolicy,
+		Ephemeral:      cfg.Ephemeral,
 	}
 
 	return es.pubsub.Subscribe(ctx, subCfg)
@@ -11,6 +11,7 @@ import (
 	"log/slog"
 
 	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -56,7 +57,16 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 		return ErrEmptyConsumer
 	}
 
-	err := es.client.XGroupCreateMkStream(ctx, cfg.Stream, group, "$").Err()
+	group, start := group, "$"
+	// The ephemeral consumer reads the stream in its own group,
+	// which is destroyed once the subscription is done.
+	if cfg.Ephemeral {
+		group = cfg.Consumer
+		if cfg.DeliveryPolicy == messaging.DeliverAllPolicy {
+			start = "0"
+		}
+	}
+	err := es.client.XGroupCreateMkStream(ctx, cfg.Stream, group, start).Err()
 	if err != nil && err.Error() != exists {
 		return err
 	}
@@ -69,6 +79,14 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 				Streams:  []string{cfg.Stream, ">"},
 				Count:    eventCount,
 			}).Result()
+			if ctx.Err() != nil {
+				if cfg.Ephemeral {
+					if err := es.client.XGroupDestroy(context.Background(), cfg.Stream, group).Err(); err != nil {
+						es.logger.Warn(fmt.Sprintf("failed to destroy redis consumer group: %s", err))
+					}
+				}
+				return
+			}
 			if err != nil {
 				es.logger.Warn(fmt.Sprintf("failed to read from redis stream: %s", err))
 
@@ -78,7 +96,7 @@ func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberCon
 				continue
 			}
 
-			es.handle(ctx, cfg.Stream, msgs[0].Messages, cfg.Handler)
+			es.handle(ctx, cfg.Stream, group, msgs[0].Messages, cfg.Handler)
 		}
 	}()
 
@@ -97,7 +115,7 @@ func (re redisEvent) Encode() (map[string]any, error) {
 	return re.Data, nil
 }
 
-func (es *subEventStore) handle(ctx context.Context, stream string, msgs []redis.XMessage, h events.EventHandler) {
+func (es *subEventStore) handle(ctx context.Context, stream, group string, msgs []redis.XMessage, h events.EventHandler) {
 	for _, msg := range msgs {
 		var data map[string]any
 		if err := json.Unmarshal([]byte(msg.Values["data"].(string)), &data); err != nil {
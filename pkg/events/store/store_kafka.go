@@ -0,0 +1,41 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build es_kafka
+// +build es_kafka
+
+package store
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/absmach/supermq/pkg/events"
+	"github.com/absmach/supermq/pkg/events/kafka"
+)
+
+// StreamAllEvents represents subject to subscribe for all the events.
+const StreamAllEvents = "events.>"
+
+func init() {
+	log.Println("The binary was build using Kafka as the events store")
+}
+
+func NewPublisher(ctx context.Context, url string) (events.Publisher, error) {
+	pb, err := kafka.NewPublisher(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func NewSubscriber(ctx context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+	pb, err := kafka.NewSubscriber(ctx, url, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
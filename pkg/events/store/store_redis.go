@@ -1,8 +1,8 @@
 // Copyright (c) Abstract Machines
 // SPDX-License-Identifier: Apache-2.0
 
-//go:build !es_nats && !es_rabbitmq
-// +build !es_nats,!es_rabbitmq
+//go:build !es_nats && !es_rabbitmq && !es_kafka
+// +build !es_nats,!es_rabbitmq,!es_kafka
 
 package store
 
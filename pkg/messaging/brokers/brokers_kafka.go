@@ -0,0 +1,50 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build msg_kafka
+// +build msg_kafka
+
+package brokers
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/kafka"
+)
+
+// SubjectAllMessages represents subject to subscribe for all the messages.
+const SubjectAllMessages = string(messaging.MsgTopicPrefix) + ".>"
+
+func init() {
+	log.Println("The binary was build using Kafka as the message broker")
+}
+
+func NewPublisher(ctx context.Context, url string, opts ...messaging.Option) (messaging.Publisher, error) {
+	pb, err := kafka.NewPublisher(ctx, url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func NewPubSub(ctx context.Context, url string, logger *slog.Logger, opts ...messaging.Option) (messaging.PubSub, error) {
+	pb, err := kafka.NewPubSub(ctx, url, logger, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func NewDeadLetters(ctx context.Context, url string) (messaging.DeadLetters, error) {
+	dl, err := kafka.NewDeadLetters(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return dl, nil
+}
@@ -1,8 +1,8 @@
 // Copyright (c) Abstract Machines
 // SPDX-License-Identifier: Apache-2.0
 
-//go:build !msg_rabbitmq
-// +build !msg_rabbitmq
+//go:build !msg_rabbitmq && !msg_kafka
+// +build !msg_rabbitmq,!msg_kafka
 
 package brokers
 
@@ -39,3 +39,12 @@ func NewPubSub(ctx context.Context, url string, logger *slog.Logger, opts ...mes
 
 	return pb, nil
 }
+
+func NewDeadLetters(ctx context.Context, url string) (messaging.DeadLetters, error) {
+	dl, err := nats.NewDeadLetters(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return dl, nil
+}
@@ -39,3 +39,12 @@ func NewPubSub(_ context.Context, url string, logger *slog.Logger, opts ...messa
 
 	return pb, nil
 }
+
+func NewDeadLetters(_ context.Context, url string) (messaging.DeadLetters, error) {
+	dl, err := rabbitmq.NewDeadLetters(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return dl, nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build msg_kafka
+// +build msg_kafka
+
+package brokers
+
+import (
+	"log"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/kafka/tracing"
+	"github.com/absmach/supermq/pkg/server"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	log.Println("The binary was build using Kafka as the message broker")
+}
+
+func NewPublisher(cfg server.Config, tracer trace.Tracer, pub messaging.Publisher) messaging.Publisher {
+	return tracing.NewPublisher(cfg, tracer, pub)
+}
+
+func NewPubSub(cfg server.Config, tracer trace.Tracer, pubsub messaging.PubSub) messaging.PubSub {
+	return tracing.NewPubSub(cfg, tracer, pubsub)
+}
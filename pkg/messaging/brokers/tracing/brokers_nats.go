@@ -1,8 +1,8 @@
 // Copyright (c) Abstract Machines
 // SPDX-License-Identifier: Apache-2.0
 
-//go:build !msg_rabbitmq
-// +build !msg_rabbitmq
+//go:build !msg_rabbitmq && !msg_kafka
+// +build !msg_rabbitmq,!msg_kafka
 
 package brokers
 
@@ -0,0 +1,86 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDeadLetterNotFound indicates that the dead letter does not exist.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// RetryPolicy configures the redelivery of the messages whose handler returned an error.
+// A message is redelivered until it is handled or MaxRetries is reached, after which
+// it is moved to the dead-letter stream. Messages are also moved to the dead-letter
+// stream when the handler returns an error with the Term acknowledgement.
+// Zero value disables retries and the dead-letter stream.
+type RetryPolicy struct {
+	MaxRetries uint64        `env:"MAX_RETRIES" envDefault:"3"`
+	Backoff    time.Duration `env:"BACKOFF"     envDefault:"1s"`
+	MaxBackoff time.Duration `env:"MAX_BACKOFF" envDefault:"1m"`
+}
+
+// Enabled returns true if the failed messages are retried and dead-lettered.
+func (rp RetryPolicy) Enabled() bool {
+	return rp.MaxRetries > 0
+}
+
+// Delay returns the exponential backoff before the given redelivery attempt.
+// The first attempt is delayed by Backoff and the delay doubles for every
+// following attempt, up to MaxBackoff.
+func (rp RetryPolicy) Delay(attempt uint64) time.Duration {
+	delay := rp.Backoff
+	for i := uint64(1); i < attempt; i++ {
+		if rp.MaxBackoff > 0 && delay >= rp.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if rp.MaxBackoff > 0 && delay > rp.MaxBackoff {
+		return rp.MaxBackoff
+	}
+
+	return delay
+}
+
+// DeadLetter represents the message which could not be handled by the subscriber.
+type DeadLetter struct {
+	ID         string    `json:"id"`
+	Subscriber string    `json:"subscriber"`
+	Subject    string    `json:"subject"`
+	Error      string    `json:"error"`
+	Attempts   uint64    `json:"attempts"`
+	FailedAt   time.Time `json:"failed_at"`
+	Message    *Message  `json:"message"`
+}
+
+// DeadLetterPage contains a page of the domain dead letters.
+type DeadLetterPage struct {
+	Total       uint64       `json:"total"`
+	Offset      uint64       `json:"offset"`
+	Limit       uint64       `json:"limit"`
+	DeadLetters []DeadLetter `json:"dead_letters"`
+}
+
+// DeadLetters specifies the API for the inspection of the dead-letter stream.
+// Dead letters are grouped by the domain of their messages.
+type DeadLetters interface {
+	// List retrieves the page of the domain dead letters, oldest first.
+	List(ctx context.Context, domainID string, offset, limit uint64) (DeadLetterPage, error)
+
+	// View retrieves the domain dead letter.
+	View(ctx context.Context, domainID, id string) (DeadLetter, error)
+
+	// Replay publishes the dead-lettered message to its original subject
+	// and removes it from the dead-letter stream.
+	Replay(ctx context.Context, domainID, id string) error
+
+	// Remove removes the dead letter without replaying it.
+	Remove(ctx context.Context, domainID, id string) error
+
+	// Close gracefully closes the dead-letter stream connection.
+	Close() error
+}
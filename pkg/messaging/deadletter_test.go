@@ -0,0 +1,65 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	cases := []struct {
+		desc    string
+		policy  messaging.RetryPolicy
+		attempt uint64
+		delay   time.Duration
+	}{
+		{
+			desc:    "delay first attempt",
+			policy:  messaging.RetryPolicy{MaxRetries: 3, Backoff: time.Second, MaxBackoff: time.Minute},
+			attempt: 1,
+			delay:   time.Second,
+		},
+		{
+			desc:    "delay third attempt",
+			policy:  messaging.RetryPolicy{MaxRetries: 3, Backoff: time.Second, MaxBackoff: time.Minute},
+			attempt: 3,
+			delay:   4 * time.Second,
+		},
+		{
+			desc:    "delay attempt exceeding max backoff",
+			policy:  messaging.RetryPolicy{MaxRetries: 10, Backoff: time.Second, MaxBackoff: 5 * time.Second},
+			attempt: 10,
+			delay:   5 * time.Second,
+		},
+		{
+			desc:    "delay attempt without max backoff",
+			policy:  messaging.RetryPolicy{MaxRetries: 10, Backoff: time.Second},
+			attempt: 5,
+			delay:   16 * time.Second,
+		},
+		{
+			desc:    "delay attempt without backoff",
+			policy:  messaging.RetryPolicy{MaxRetries: 3},
+			attempt: 2,
+			delay:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			delay := tc.policy.Delay(tc.attempt)
+			assert.Equal(t, tc.delay, delay, fmt.Sprintf("expected delay %s got %s", tc.delay, delay))
+		})
+	}
+}
+
+func TestRetryPolicyEnabled(t *testing.T) {
+	assert.False(t, messaging.RetryPolicy{}.Enabled())
+	assert.True(t, messaging.RetryPolicy{MaxRetries: 1}.Enabled())
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/absmach/mgate/pkg/session"
+	"github.com/absmach/supermq/pkg/schema"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -41,28 +42,32 @@ func (mm *metricsMiddleware) AuthConnect(ctx context.Context) error {
 }
 
 // AuthPublish implements session.Handler.
-func (mm *metricsMiddleware) AuthPublish(ctx context.Context, topic *string, payload *[]byte) error {
+// Payloads rejected by the channel schema are counted separately.
+func (mm *metricsMiddleware) AuthPublish(ctx context.Context, topic *string, payload *[]byte) (err error) {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "publish").Add(1)
 		mm.latency.With("method", "publish").Observe(time.Since(begin).Seconds())
+		if schema.IsViolation(err) {
+			mm.counter.With("method", "schema_violation").Add(1)
+		}
 	}(time.Now())
 
 	return mm.svc.AuthPublish(ctx, topic, payload)
 }
 
 // AuthSubscribe implements session.Handler.
-func (*metricsMiddleware) AuthSubscribe(ctx context.Context, topics *[]string) error {
-	return nil
+func (mm *metricsMiddleware) AuthSubscribe(ctx context.Context, topics *[]string) error {
+	return mm.svc.AuthSubscribe(ctx, topics)
 }
 
 // Connect implements session.Handler.
-func (*metricsMiddleware) Connect(ctx context.Context) error {
-	return nil
+func (mm *metricsMiddleware) Connect(ctx context.Context) error {
+	return mm.svc.Connect(ctx)
 }
 
 // Disconnect implements session.Handler.
-func (*metricsMiddleware) Disconnect(ctx context.Context) error {
-	return nil
+func (mm *metricsMiddleware) Disconnect(ctx context.Context) error {
+	return mm.svc.Disconnect(ctx)
 }
 
 // Publish instruments Publish method with metrics.
@@ -76,11 +81,11 @@ func (mm *metricsMiddleware) Publish(ctx context.Context, topic *string, payload
 }
 
 // Subscribe implements session.Handler.
-func (*metricsMiddleware) Subscribe(ctx context.Context, topics *[]string) error {
-	return nil
+func (mm *metricsMiddleware) Subscribe(ctx context.Context, topics *[]string) error {
+	return mm.svc.Subscribe(ctx, topics)
 }
 
 // Unsubscribe implements session.Handler.
-func (*metricsMiddleware) Unsubscribe(ctx context.Context, topics *[]string) error {
-	return nil
+func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, topics *[]string) error {
+	return mm.svc.Unsubscribe(ctx, topics)
 }
@@ -0,0 +1,73 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// Limits of the message headers set by the protocol adapters.
+const (
+	MaxHeaders         = 32
+	MaxHeaderKeyLen    = 64
+	MaxHeaderValueLen  = 1024
+	MaxContentTypeSize = 255
+)
+
+var (
+	// ErrMalformedHeaders indicates too many, too long or invalid message headers.
+	ErrMalformedHeaders = errors.New("malformed message headers")
+
+	// ErrMalformedContentType indicates content type which is not a valid media type.
+	ErrMalformedContentType = errors.New("malformed message content type")
+)
+
+// ParseContentType returns the media type of the content type without
+// parameters, lowercased. Empty content type is returned unchanged.
+func ParseContentType(ct string) (string, error) {
+	if ct == "" {
+		return "", nil
+	}
+	if len(ct) > MaxContentTypeSize {
+		return "", ErrMalformedContentType
+	}
+	mt, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return "", ErrMalformedContentType
+	}
+
+	return mt, nil
+}
+
+// ValidateHeaders checks the message headers limits. Header keys are
+// lowercase tokens made of letters, digits, '-', '_' and '.'.
+func ValidateHeaders(headers map[string]string) error {
+	if len(headers) > MaxHeaders {
+		return ErrMalformedHeaders
+	}
+	for k, v := range headers {
+		if k == "" || len(k) > MaxHeaderKeyLen || len(v) > MaxHeaderValueLen {
+			return ErrMalformedHeaders
+		}
+		if strings.IndexFunc(k, invalidHeaderKeyRune) >= 0 {
+			return ErrMalformedHeaders
+		}
+	}
+
+	return nil
+}
+
+func invalidHeaderKeyRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		return false
+	case r == '-', r == '_', r == '.':
+		return false
+	default:
+		return true
+	}
+}
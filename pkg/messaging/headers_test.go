@@ -0,0 +1,113 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseContentType(t *testing.T) {
+	cases := []struct {
+		desc        string
+		contentType string
+		mediaType   string
+		err         error
+	}{
+		{
+			desc: "parse empty content type",
+		},
+		{
+			desc:        "parse media type",
+			contentType: "application/senml+json",
+			mediaType:   "application/senml+json",
+		},
+		{
+			desc:        "parse media type with parameters",
+			contentType: "Application/JSON; charset=utf-8",
+			mediaType:   "application/json",
+		},
+		{
+			desc:        "parse malformed media type",
+			contentType: "application/json;;",
+			err:         messaging.ErrMalformedContentType,
+		},
+		{
+			desc:        "parse too long media type",
+			contentType: "application/" + strings.Repeat("a", messaging.MaxContentTypeSize),
+			err:         messaging.ErrMalformedContentType,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mt, err := messaging.ParseContentType(tc.contentType)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+			assert.Equal(t, tc.mediaType, mt)
+		})
+	}
+}
+
+func TestValidateHeaders(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := range messaging.MaxHeaders + 1 {
+		tooMany[fmt.Sprintf("key-%d", i)] = "value"
+	}
+
+	cases := []struct {
+		desc    string
+		headers map[string]string
+		err     error
+	}{
+		{
+			desc: "validate empty headers",
+		},
+		{
+			desc:    "validate valid headers",
+			headers: map[string]string{"correlation-id": "42", "unit_system": "si", "schema.version": "2"},
+		},
+		{
+			desc:    "validate too many headers",
+			headers: tooMany,
+			err:     messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:    "validate empty key",
+			headers: map[string]string{"": "value"},
+			err:     messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:    "validate uppercase key",
+			headers: map[string]string{"Correlation-Id": "42"},
+			err:     messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:    "validate key with spaces",
+			headers: map[string]string{"correlation id": "42"},
+			err:     messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:    "validate too long key",
+			headers: map[string]string{strings.Repeat("k", messaging.MaxHeaderKeyLen+1): "value"},
+			err:     messaging.ErrMalformedHeaders,
+		},
+		{
+			desc:    "validate too long value",
+			headers: map[string]string{"key": strings.Repeat("v", messaging.MaxHeaderValueLen+1)},
+			err:     messaging.ErrMalformedHeaders,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := messaging.ValidateHeaders(tc.headers)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+		})
+	}
+}
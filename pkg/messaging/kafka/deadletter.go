@@ -0,0 +1,287 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	headerDomain     = "Smq-Dead-Letter-Domain"
+	headerTopic      = "Smq-Dead-Letter-Topic"
+	headerSubscriber = "Smq-Dead-Letter-Subscriber"
+	headerSubject    = "Smq-Dead-Letter-Subject"
+	headerError      = "Smq-Dead-Letter-Error"
+	headerAttempts   = "Smq-Dead-Letter-Attempts"
+	headerFailedAt   = "Smq-Dead-Letter-Failed-At"
+
+	fetchTimeout = 5 * time.Second
+)
+
+// ErrEmptyDomain indicates that the message can not be dead-lettered
+// because it does not belong to any domain.
+var ErrEmptyDomain = errors.New("empty message domain")
+
+var _ messaging.DeadLetters = (*deadLetters)(nil)
+
+type deadLetters struct {
+	brokers []string
+	client  *kafka.Client
+	writer  *kafka.Writer
+}
+
+// NewDeadLetters returns Kafka dead-letter topic API.
+// Dead letters of all the domains are stored in the compacted topic keyed
+// by the dead letter ID and are removed by writing the tombstone for the ID.
+// Since Kafka topics can not be queried, the topic is read from the start
+// for every request.
+func NewDeadLetters(ctx context.Context, url string) (messaging.DeadLetters, error) {
+	brokers, err := parseBrokers(url)
+	if err != nil {
+		return nil, err
+	}
+	client := &kafka.Client{
+		Addr:    kafka.TCP(brokers...),
+		Timeout: requestTimeout,
+	}
+	if err := createTopic(ctx, client, dlqTopicConfig); err != nil {
+		return nil, err
+	}
+
+	return &deadLetters{
+		brokers: brokers,
+		client:  client,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: batchTimeout,
+		},
+	}, nil
+}
+
+func (dl *deadLetters) List(ctx context.Context, domainID string, offset, limit uint64) (messaging.DeadLetterPage, error) {
+	page := messaging.DeadLetterPage{
+		Offset:      offset,
+		Limit:       limit,
+		DeadLetters: []messaging.DeadLetter{},
+	}
+	stored, err := dl.load(ctx, domainID)
+	if err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+	page.Total = uint64(len(stored))
+	if offset >= page.Total {
+		return page, nil
+	}
+
+	for _, m := range stored[offset:min(page.Total, offset+limit)] {
+		d, err := toDeadLetter(m)
+		if err != nil {
+			return messaging.DeadLetterPage{}, err
+		}
+		page.DeadLetters = append(page.DeadLetters, d)
+	}
+
+	return page, nil
+}
+
+func (dl *deadLetters) View(ctx context.Context, domainID, id string) (messaging.DeadLetter, error) {
+	m, err := dl.retrieve(ctx, domainID, id)
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return toDeadLetter(m)
+}
+
+func (dl *deadLetters) Replay(ctx context.Context, domainID, id string) error {
+	m, err := dl.retrieve(ctx, domainID, id)
+	if err != nil {
+		return err
+	}
+	msg := kafka.Message{
+		Topic: header(m, headerTopic),
+		Key:   []byte(header(m, headerSubject)),
+		Value: m.Value,
+		Time:  time.Now(),
+	}
+	if err := dl.writer.WriteMessages(ctx, msg); err != nil {
+		return err
+	}
+
+	return dl.remove(ctx, id)
+}
+
+func (dl *deadLetters) Remove(ctx context.Context, domainID, id string) error {
+	if _, err := dl.retrieve(ctx, domainID, id); err != nil {
+		return err
+	}
+
+	return dl.remove(ctx, id)
+}
+
+func (dl *deadLetters) Close() error {
+	return dl.writer.Close()
+}
+
+// remove writes the tombstone of the dead letter.
+func (dl *deadLetters) remove(ctx context.Context, id string) error {
+	return dl.writer.WriteMessages(ctx, kafka.Message{
+		Topic: dlqTopic,
+		Key:   []byte(id),
+		Time:  time.Now(),
+	})
+}
+
+// retrieve returns the dead letter topic message if it belongs to the domain.
+func (dl *deadLetters) retrieve(ctx context.Context, domainID, id string) (kafka.Message, error) {
+	stored, err := dl.load(ctx, domainID)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+	for _, m := range stored {
+		if string(m.Key) == id {
+			return m, nil
+		}
+	}
+
+	return kafka.Message{}, messaging.ErrDeadLetterNotFound
+}
+
+// load reads the dead letter topic up to its current end and returns the
+// domain dead letters which are not removed, ordered by the failure time.
+func (dl *deadLetters) load(ctx context.Context, domainID string) ([]kafka.Message, error) {
+	partitions, err := topicPartitions(ctx, dl.client, dlqTopic)
+	if err != nil {
+		return nil, err
+	}
+	offsets, err := partitionOffsets(ctx, dl.client, dlqTopic, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	var stored []kafka.Message
+	for _, o := range offsets {
+		if o.LastOffset <= o.FirstOffset {
+			continue
+		}
+		ms, err := dl.read(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		// Dead letter and its tombstone have the same key, so they are
+		// written to the same partition and the tombstone follows the dead letter.
+		removed := make(map[string]bool)
+		for _, m := range slices.Backward(ms) {
+			switch {
+			case len(m.Value) == 0:
+				removed[string(m.Key)] = true
+			case !removed[string(m.Key)] && header(m, headerDomain) == domainID:
+				stored = append(stored, m)
+			}
+		}
+	}
+	slices.SortStableFunc(stored, func(a, b kafka.Message) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return stored, nil
+}
+
+// read returns the partition messages up to the partition end offset.
+func (dl *deadLetters) read(ctx context.Context, o kafka.PartitionOffsets) ([]kafka.Message, error) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   dl.brokers,
+		Topic:     dlqTopic,
+		Partition: o.Partition,
+		MaxWait:   maxWait,
+	})
+	defer r.Close()
+	if err := r.SetOffset(o.FirstOffset); err != nil {
+		return nil, err
+	}
+
+	var ms []kafka.Message
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+		if m.Offset >= o.LastOffset-1 {
+			return ms, nil
+		}
+	}
+}
+
+// deadLetter publishes the failed message to the dead-letter topic.
+func (ps *pubsub) deadLetter(ctx context.Context, subscriber string, m kafka.Message, msg *messaging.Message, attempts uint64, herr error) error {
+	if msg.GetDomain() == "" {
+		return ErrEmptyDomain
+	}
+	dm := kafka.Message{
+		Key:   []byte(uuid.NewString()),
+		Value: m.Value,
+		Time:  time.Now(),
+		Headers: []kafka.Header{
+			{Key: headerDomain, Value: []byte(msg.GetDomain())},
+			{Key: headerTopic, Value: []byte(m.Topic)},
+			{Key: headerSubscriber, Value: []byte(subscriber)},
+			{Key: headerSubject, Value: m.Key},
+			{Key: headerError, Value: []byte(herr.Error())},
+			{Key: headerAttempts, Value: []byte(strconv.FormatUint(attempts, 10))},
+			{Key: headerFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+		},
+	}
+
+	return ps.dlq.WriteMessages(ctx, dm)
+}
+
+func toDeadLetter(m kafka.Message) (messaging.DeadLetter, error) {
+	var msg messaging.Message
+	if err := proto.Unmarshal(m.Value, &msg); err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	attempts, err := strconv.ParseUint(header(m, headerAttempts), 10, 64)
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	failedAt, err := time.Parse(time.RFC3339Nano, header(m, headerFailedAt))
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return messaging.DeadLetter{
+		ID:         string(m.Key),
+		Subscriber: header(m, headerSubscriber),
+		Subject:    header(m, headerSubject),
+		Error:      header(m, headerError),
+		Attempts:   attempts,
+		FailedAt:   failedAt,
+		Message:    &msg,
+	}, nil
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
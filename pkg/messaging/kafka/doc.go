@@ -0,0 +1,13 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kafka holds the implementation of the Publisher and PubSub
+// interfaces for the Apache Kafka messaging system. All the messages
+// published with the same prefix are stored in the single Kafka topic
+// named after the prefix and the full SuperMQ subject is used as the
+// Kafka message key, so the messages of the same subject are always
+// written to the same partition and keep their order. Subscribers are
+// consumer groups of the topic which filter the message keys using the
+// NATS subject wildcards. Due to the practical requirements
+// implementation Publisher is created alongside PubSub.
+package kafka
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/segmentio/kafka-go"
+)
+
+var (
+	// ErrInvalidType is returned when the provided value is not of the expected type.
+	ErrInvalidType = errors.New("invalid type")
+
+	// ErrInvalidURL is returned when the broker URL is not a list of Kafka brokers.
+	ErrInvalidURL = errors.New("invalid kafka URL")
+
+	// Topic name is set to the publisher or subscriber prefix.
+	topicConfig = kafka.TopicConfig{
+		NumPartitions:     1,
+		ReplicationFactor: 1,
+		ConfigEntries: []kafka.ConfigEntry{
+			{ConfigName: "retention.ms", ConfigValue: strconv.FormatInt((time.Hour * 24).Milliseconds(), 10)},
+			{ConfigName: "max.message.bytes", ConfigValue: strconv.Itoa(1024 * 1024)},
+		},
+	}
+
+	dlqTopicConfig = kafka.TopicConfig{
+		Topic:             dlqTopic,
+		NumPartitions:     1,
+		ReplicationFactor: 1,
+		ConfigEntries: []kafka.ConfigEntry{
+			// Removed dead letters are compacted away.
+			{ConfigName: "cleanup.policy", ConfigValue: "compact,delete"},
+			{ConfigName: "retention.ms", ConfigValue: strconv.FormatInt((time.Hour * 24 * 7).Milliseconds(), 10)},
+		},
+	}
+)
+
+const (
+	msgPrefix = "m"
+	dlqTopic  = "dlq"
+)
+
+type options struct {
+	prefix      string
+	topicConfig kafka.TopicConfig
+}
+
+func defaultOptions() options {
+	return options{
+		prefix:      msgPrefix,
+		topicConfig: topicConfig,
+	}
+}
+
+// Prefix sets the prefix for the publisher or subscriber.
+// The prefix is also the name of the Kafka topic.
+func Prefix(prefix string) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.prefix = prefix
+		case *pubsub:
+			v.prefix = prefix
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// TopicConfig sets the Kafka topic configuration for the publisher or subscriber.
+// The topic name is always set to the prefix.
+func TopicConfig(topicConfig kafka.TopicConfig) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.topicConfig = topicConfig
+		case *pubsub:
+			v.topicConfig = topicConfig
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
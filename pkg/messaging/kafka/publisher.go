@@ -0,0 +1,143 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	scheme = "kafka"
+
+	// requestTimeout limits the duration of the Kafka admin requests.
+	requestTimeout = 10 * time.Second
+
+	// batchTimeout limits the time the publisher waits for more messages
+	// before writing the batch, since Publish blocks until the batch is written.
+	batchTimeout = 5 * time.Millisecond
+)
+
+var _ messaging.Publisher = (*publisher)(nil)
+
+type publisher struct {
+	brokers []string
+	client  *kafka.Client
+	writer  *kafka.Writer
+	options
+}
+
+// NewPublisher returns Kafka message Publisher.
+// The URL is a comma separated list of the brokers, optionally prefixed
+// with the kafka:// scheme, e.g. kafka://kafka-1:9092,kafka-2:9092.
+func NewPublisher(ctx context.Context, url string, opts ...messaging.Option) (messaging.Publisher, error) {
+	pub := &publisher{
+		options: defaultOptions(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(pub); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pub.connect(ctx, url); err != nil {
+		return nil, err
+	}
+
+	return pub, nil
+}
+
+func (pub *publisher) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	m := kafka.Message{
+		Key:   []byte(fmt.Sprintf("%s.%s", pub.prefix, topic)),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	return pub.writer.WriteMessages(ctx, m)
+}
+
+func (pub *publisher) Close() error {
+	return pub.writer.Close()
+}
+
+// connect creates the prefix topic if it does not exist and the topic writer.
+func (pub *publisher) connect(ctx context.Context, url string) error {
+	brokers, err := parseBrokers(url)
+	if err != nil {
+		return err
+	}
+	pub.brokers = brokers
+	pub.client = &kafka.Client{
+		Addr:    kafka.TCP(brokers...),
+		Timeout: requestTimeout,
+	}
+
+	cfg := pub.topicConfig
+	cfg.Topic = pub.prefix
+	if err := createTopic(ctx, pub.client, cfg); err != nil {
+		return err
+	}
+
+	pub.writer = &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        pub.prefix,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: batchTimeout,
+	}
+
+	return nil
+}
+
+// parseBrokers returns the broker addresses from the broker URL.
+func parseBrokers(url string) ([]string, error) {
+	hosts := url
+	if s, rest, ok := strings.Cut(url, "://"); ok {
+		if s != scheme {
+			return nil, ErrInvalidURL
+		}
+		hosts = rest
+	}
+
+	brokers := strings.Split(hosts, ",")
+	for _, broker := range brokers {
+		if broker == "" {
+			return nil, ErrInvalidURL
+		}
+	}
+
+	return brokers, nil
+}
+
+func createTopic(ctx context.Context, client *kafka.Client, cfg kafka.TopicConfig) error {
+	res, err := client.CreateTopics(ctx, &kafka.CreateTopicsRequest{
+		Topics: []kafka.TopicConfig{cfg},
+	})
+	if err != nil {
+		return err
+	}
+	if err := res.Errors[cfg.Topic]; err != nil && !errors.Is(err, kafka.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create topic %s: %w", cfg.Topic, err)
+	}
+
+	return nil
+}
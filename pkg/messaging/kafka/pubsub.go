@@ -0,0 +1,435 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Publisher and Subscriber errors.
+var (
+	// ErrNotSubscribed indicates that the topic is not subscribed to.
+	ErrNotSubscribed = errors.New("not subscribed")
+
+	// ErrEmptyTopic indicates the absence of topic.
+	ErrEmptyTopic = errors.New("empty topic")
+
+	// ErrEmptyID indicates the absence of ID.
+	ErrEmptyID = errors.New("empty id")
+)
+
+// maxWait is the maximum amount of time the readers wait for new messages.
+const maxWait = 500 * time.Millisecond
+
+var _ messaging.PubSub = (*pubsub)(nil)
+
+type subscription struct {
+	cancel func() error
+	// group is the consumer group of the subscription, replay subscriptions
+	// read the topic partitions directly and are not members of any group.
+	group string
+}
+
+type pubsub struct {
+	publisher
+	// dlq writes the failed messages to the dead-letter topic.
+	dlq           *kafka.Writer
+	logger        *slog.Logger
+	subscriptions map[string]map[string]subscription
+	mu            sync.Mutex
+}
+
+// NewPubSub returns Kafka message publisher/subscriber.
+// Every subscription is a Kafka consumer group named after the subscription
+// topic and ID, so the subscribers which share the ID share the messages and
+// the subscription offsets are preserved across the subscriber restarts.
+func NewPubSub(ctx context.Context, url string, logger *slog.Logger, opts ...messaging.Option) (messaging.PubSub, error) {
+	ps := &pubsub{
+		publisher: publisher{
+			options: defaultOptions(),
+		},
+		logger:        logger,
+		subscriptions: make(map[string]map[string]subscription),
+	}
+
+	for _, opt := range opts {
+		if err := opt(ps); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ps.connect(ctx, url); err != nil {
+		return nil, err
+	}
+	ps.dlq = &kafka.Writer{
+		Addr:         kafka.TCP(ps.brokers...),
+		Topic:        dlqTopic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: batchTimeout,
+	}
+
+	return ps, nil
+}
+
+func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig) error {
+	if cfg.ID == "" {
+		return ErrEmptyID
+	}
+	if cfg.Topic == "" {
+		return ErrEmptyTopic
+	}
+	if err := cfg.ValidateDelivery(); err != nil {
+		return err
+	}
+
+	if cfg.RetryPolicy.Enabled() {
+		if err := createTopic(ctx, ps.client, dlqTopicConfig); err != nil {
+			return fmt.Errorf("failed to create dead-letter topic: %w", err)
+		}
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s, ok := ps.subscriptions[cfg.Topic]
+	if !ok {
+		s = make(map[string]subscription)
+		ps.subscriptions[cfg.Topic] = s
+	}
+	// Subscription is replaced and its consumer group is kept, as it is
+	// done for the JetStream durable consumers.
+	if current, ok := s[cfg.ID]; ok {
+		if err := current.cancel(); err != nil {
+			return err
+		}
+		delete(s, cfg.ID)
+	}
+
+	var (
+		sub subscription
+		err error
+	)
+	// The ephemeral subscription doesn't join the consumer group,
+	// so no offsets are kept for it.
+	if isReplay(cfg.DeliveryPolicy) || cfg.Ephemeral {
+		sub.cancel, err = ps.replay(ctx, cfg)
+	} else {
+		sub.group = formatConsumerName(cfg.Topic, cfg.ID)
+		sub.cancel, err = ps.consume(ctx, sub.group, cfg)
+	}
+	if err != nil {
+		if len(s) == 0 {
+			delete(ps.subscriptions, cfg.Topic)
+		}
+		return err
+	}
+	s[cfg.ID] = sub
+
+	return nil
+}
+
+func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
+	if id == "" {
+		return ErrEmptyID
+	}
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s, ok := ps.subscriptions[topic]
+	if !ok {
+		return ErrNotSubscribed
+	}
+	current, ok := s[id]
+	if !ok {
+		return ErrNotSubscribed
+	}
+	if err := current.cancel(); err != nil {
+		return err
+	}
+	delete(s, id)
+	if len(s) == 0 {
+		delete(ps.subscriptions, topic)
+	}
+	if current.group == "" {
+		return nil
+	}
+
+	return ps.deleteGroup(ctx, current.group)
+}
+
+func (ps *pubsub) Close() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	for topic, s := range ps.subscriptions {
+		for id, sub := range s {
+			if err := sub.cancel(); err != nil {
+				ps.logger.Warn(fmt.Sprintf("failed to close subscription %s of topic %s: %s", id, topic, err))
+			}
+		}
+	}
+	ps.subscriptions = make(map[string]map[string]subscription)
+
+	return errors.Join(ps.dlq.Close(), ps.publisher.Close())
+}
+
+// consume reads the prefix topic as the member of the consumer group and
+// returns the subscription cancel function.
+func (ps *pubsub) consume(ctx context.Context, group string, cfg messaging.SubscriberConfig) (func() error, error) {
+	startOffset := kafka.LastOffset
+	switch cfg.DeliveryPolicy {
+	case messaging.DeliverAllPolicy:
+		startOffset = kafka.FirstOffset
+	case messaging.DeliverNewPolicy:
+		// The start offset of the group is resolved only once the reader joins the
+		// group, so the group offsets are committed upfront to deliver all the
+		// messages published after the subscription.
+		if err := ps.initGroup(ctx, group); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     ps.brokers,
+		GroupID:     group,
+		Topic:       ps.prefix,
+		StartOffset: startOffset,
+		MaxWait:     maxWait,
+	})
+
+	rctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for {
+			m, err := reader.FetchMessage(rctx)
+			if err != nil {
+				if rctx.Err() == nil {
+					ps.logger.Warn(fmt.Sprintf("failed to fetch message: %s", err))
+				}
+				return
+			}
+			ps.handle(rctx, cfg, m)
+			if err := reader.CommitMessages(rctx, m); err != nil && rctx.Err() == nil {
+				ps.logger.Warn(fmt.Sprintf("failed to commit message: %s", err))
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+		if err := reader.Close(); err != nil {
+			return err
+		}
+		return cfg.Handler.Cancel()
+	}, nil
+}
+
+// initGroup commits the current end offsets of the topic partitions
+// for the consumer group which has not committed any offset yet.
+func (ps *pubsub) initGroup(ctx context.Context, group string) error {
+	partitions, err := topicPartitions(ctx, ps.client, ps.prefix)
+	if err != nil {
+		return err
+	}
+	fetched, err := ps.client.OffsetFetch(ctx, &kafka.OffsetFetchRequest{
+		GroupID: group,
+		Topics:  map[string][]int{ps.prefix: partitions},
+	})
+	if err != nil {
+		return err
+	}
+	if fetched.Error != nil {
+		return fetched.Error
+	}
+	for _, p := range fetched.Topics[ps.prefix] {
+		if p.CommittedOffset >= 0 {
+			return nil
+		}
+	}
+
+	offsets, err := partitionOffsets(ctx, ps.client, ps.prefix, partitions)
+	if err != nil {
+		return err
+	}
+	commits := make([]kafka.OffsetCommit, 0, len(offsets))
+	for _, o := range offsets {
+		commits = append(commits, kafka.OffsetCommit{Partition: o.Partition, Offset: o.LastOffset})
+	}
+	res, err := ps.client.OffsetCommit(ctx, &kafka.OffsetCommitRequest{
+		GroupID:      group,
+		GenerationID: -1,
+		Topics:       map[string][]kafka.OffsetCommit{ps.prefix: commits},
+	})
+	if err != nil {
+		return err
+	}
+	for _, p := range res.Topics[ps.prefix] {
+		if p.Error != nil {
+			return fmt.Errorf("failed to commit offset of partition %d: %w", p.Partition, p.Error)
+		}
+	}
+
+	return nil
+}
+
+func (ps *pubsub) deleteGroup(ctx context.Context, group string) error {
+	res, err := ps.client.DeleteGroups(ctx, &kafka.DeleteGroupsRequest{
+		GroupIDs: []string{group},
+	})
+	if err != nil {
+		return err
+	}
+	if err := res.Errors[group]; err != nil && !errors.Is(err, kafka.GroupIdNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// topicPartitions returns the IDs of the topic partitions.
+func topicPartitions(ctx context.Context, client *kafka.Client, topic string) ([]int, error) {
+	md, err := client.Metadata(ctx, &kafka.MetadataRequest{
+		Topics: []string{topic},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range md.Topics {
+		if t.Name != topic {
+			continue
+		}
+		if t.Error != nil {
+			return nil, t.Error
+		}
+		ids := make([]int, 0, len(t.Partitions))
+		for _, p := range t.Partitions {
+			ids = append(ids, p.ID)
+		}
+		return ids, nil
+	}
+
+	return nil, kafka.UnknownTopicOrPartition
+}
+
+// partitionOffsets returns the first and the end offsets of the topic partitions.
+func partitionOffsets(ctx context.Context, client *kafka.Client, topic string, partitions []int) ([]kafka.PartitionOffsets, error) {
+	reqs := make([]kafka.OffsetRequest, 0, 2*len(partitions))
+	for _, p := range partitions {
+		reqs = append(reqs, kafka.FirstOffsetOf(p), kafka.LastOffsetOf(p))
+	}
+	res, err := client.ListOffsets(ctx, &kafka.ListOffsetsRequest{
+		Topics: map[string][]kafka.OffsetRequest{topic: reqs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	offsets := res.Topics[topic]
+	for _, o := range offsets {
+		if o.Error != nil {
+			return nil, o.Error
+		}
+	}
+
+	return offsets, nil
+}
+
+// handle handles the message if its key matches the subscription topic.
+// Failed messages are retried with backoff in place, since Kafka consumers
+// can not negatively acknowledge a single message, and are dead-lettered
+// once the retries are exhausted.
+func (ps *pubsub) handle(ctx context.Context, cfg messaging.SubscriberConfig, m kafka.Message) {
+	subject := string(m.Key)
+	if !matchSubject(cfg.Topic, subject) {
+		return
+	}
+	args := []any{
+		slog.String("subject", subject),
+		slog.String("topic", m.Topic),
+		slog.Int("partition", m.Partition),
+		slog.Int64("offset", m.Offset),
+	}
+
+	var msg messaging.Message
+	if err := proto.Unmarshal(m.Value, &msg); err != nil {
+		args = append(args, slog.String("error", err.Error()))
+		ps.logger.Warn("failed to unmarshal message", args...)
+		return
+	}
+
+	for attempts := uint64(1); ; attempts++ {
+		err := cfg.Handler.Handle(&msg)
+		if err == nil {
+			return
+		}
+		ackType := errAckType(err)
+		ps.logger.Warn("failed to handle message", append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))...)
+		if !cfg.RetryPolicy.Enabled() || !retriable(ackType) {
+			return
+		}
+		if ackType == messaging.Term || attempts > cfg.RetryPolicy.MaxRetries {
+			err := ps.deadLetter(ctx, cfg.ID, m, &msg, attempts, err)
+			if err == nil {
+				return
+			}
+			ps.logger.Warn(fmt.Sprintf("failed to dead-letter message: %s", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.RetryPolicy.Delay(attempts)):
+		}
+	}
+}
+
+func errAckType(err error) messaging.AckType {
+	if e, ok := err.(messaging.Error); ok && e != nil {
+		return e.Ack()
+	}
+	return messaging.NoAck
+}
+
+func retriable(at messaging.AckType) bool {
+	switch at {
+	case messaging.Nack, messaging.NoAck, messaging.Term:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchSubject reports whether the subject matches the NATS subject
+// pattern with the * and > wildcards.
+func matchSubject(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
+
+func formatConsumerName(topic, id string) string {
+	return fmt.Sprintf("%s-%s", topic, id)
+}
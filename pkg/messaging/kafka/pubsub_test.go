@@ -0,0 +1,406 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	topic     = "topic"
+	msgPrefix = "m"
+	channel   = "9b7b1b3f-b1b0-46a8-a717-b8213f9eda3b"
+	subtopic  = "engine"
+	clientID  = "9b7b1b3f-b1b0-46a8-a717-b8213f9eda3b"
+)
+
+var (
+	msgChan = make(chan *messaging.Message)
+	message = &messaging.Message{
+		Channel:   channel,
+		Subtopic:  subtopic,
+		Publisher: "9b7b1b3f-b1b0-46a8-a717-b8213f9eda3b",
+		Protocol:  "mqtt",
+		Payload:   []byte("payload"),
+		Created:   time.Now().UnixNano(),
+	}
+)
+
+func TestPublisher(t *testing.T) {
+	subCfg := messaging.SubscriberConfig{
+		ID:      clientID,
+		Topic:   fmt.Sprintf("%s.>", msgPrefix),
+		Handler: handler{},
+	}
+	err := pubsub.Subscribe(context.TODO(), subCfg)
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	cases := []struct {
+		desc     string
+		topic    string
+		subtopic string
+		message  *messaging.Message
+		error    error
+	}{
+		{
+			desc:     "publish message with empty message",
+			topic:    channel,
+			subtopic: subtopic,
+			message:  &messaging.Message{},
+			error:    nil,
+		},
+		{
+			desc:     "publish message with message",
+			topic:    channel,
+			subtopic: subtopic,
+			message:  message,
+			error:    nil,
+		},
+		{
+			desc:     "publish message with topic and empty subtopic",
+			topic:    channel,
+			subtopic: "",
+			message:  message,
+			error:    nil,
+		},
+		{
+			desc:     "publish message with subtopic and empty topic",
+			topic:    "",
+			subtopic: subtopic,
+			message:  message,
+			error:    kafka.ErrEmptyTopic,
+		},
+		{
+			desc:     "publish message with topic and subtopic",
+			topic:    channel,
+			subtopic: subtopic,
+			message:  message,
+			error:    nil,
+		},
+		{
+			desc:     "publish message with content type and headers",
+			topic:    channel,
+			subtopic: subtopic,
+			message: &messaging.Message{
+				Channel:     channel,
+				Publisher:   clientID,
+				Protocol:    "http",
+				Payload:     []byte(`{"temperature": 21.5}`),
+				Created:     time.Now().UnixNano(),
+				ContentType: "application/json",
+				Headers:     map[string]string{"correlation-id": "42"},
+			},
+			error: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		tc.message.Subtopic = tc.subtopic
+		err := pubsub.Publish(context.TODO(), tc.topic, tc.message)
+		assert.Equal(t, tc.error, err, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, tc.error, err))
+
+		if err == nil {
+			receivedMsg := <-msgChan
+			assert.Equal(t, tc.message.Payload, receivedMsg.Payload, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, tc.message.Payload, receivedMsg))
+			assert.Equal(t, tc.message.Channel, receivedMsg.Channel, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Created, receivedMsg.Created, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Protocol, receivedMsg.Protocol, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Publisher, receivedMsg.Publisher, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Subtopic, receivedMsg.Subtopic, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.ContentType, receivedMsg.ContentType, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Headers, receivedMsg.Headers, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+			assert.Equal(t, tc.message.Payload, receivedMsg.Payload, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &tc.message, receivedMsg))
+		}
+	}
+}
+
+func TestPubsub(t *testing.T) {
+	// Test Subscribe and Unsubscribe.
+	cases := []struct {
+		desc         string
+		topic        string
+		clientID     string
+		errorMessage error
+		pubsub       bool // true for subscribe and false for unsubscribe.
+		handler      messaging.MessageHandler
+	}{
+		{
+			desc:         "Subscribe to a topic with an ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientid1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe using malformed topic and ID",
+			topic:        fmt.Sprintf("%s.>", msgPrefix),
+			clientID:     "clientid1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe using malformed topic and ID",
+			topic:        fmt.Sprintf("%s.*", msgPrefix),
+			clientID:     "clientid1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to the same topic with a different ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientid2",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to an already subscribed topic with an ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientid1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from a topic with an ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientid1",
+			errorMessage: nil,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from a non-existent topic with an ID",
+			topic:        "h",
+			clientID:     "clientid1",
+			errorMessage: kafka.ErrNotSubscribed,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from the same topic with a different ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientidd2",
+			errorMessage: kafka.ErrNotSubscribed,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from the same topic with a different ID not subscribed",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientidd3",
+			errorMessage: kafka.ErrNotSubscribed,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from an already unsubscribed topic with an ID",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "clientid1",
+			errorMessage: kafka.ErrNotSubscribed,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to a topic with a subtopic with an ID",
+			topic:        fmt.Sprintf("%s.%s.%s", msgPrefix, topic, subtopic),
+			clientID:     "clientidd1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to an already subscribed topic with a subtopic with an ID",
+			topic:        fmt.Sprintf("%s.%s.%s", msgPrefix, topic, subtopic),
+			clientID:     "clientidd1",
+			errorMessage: nil,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from a topic with a subtopic with an ID",
+			topic:        fmt.Sprintf("%s.%s.%s", msgPrefix, topic, subtopic),
+			clientID:     "clientidd1",
+			errorMessage: nil,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from an already unsubscribed topic with a subtopic with an ID",
+			topic:        fmt.Sprintf("%s.%s.%s", msgPrefix, topic, subtopic),
+			clientID:     "clientid1",
+			errorMessage: kafka.ErrNotSubscribed,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to an empty topic with an ID",
+			topic:        "",
+			clientID:     "clientid1",
+			errorMessage: kafka.ErrEmptyTopic,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from an empty topic with an ID",
+			topic:        "",
+			clientID:     "clientid1",
+			errorMessage: kafka.ErrEmptyTopic,
+			pubsub:       false,
+			handler:      handler{},
+		},
+		{
+			desc:         "Subscribe to a topic with empty id",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "",
+			errorMessage: kafka.ErrEmptyID,
+			pubsub:       true,
+			handler:      handler{},
+		},
+		{
+			desc:         "Unsubscribe from a topic with empty id",
+			topic:        fmt.Sprintf("%s.%s", msgPrefix, topic),
+			clientID:     "",
+			errorMessage: kafka.ErrEmptyID,
+			pubsub:       false,
+			handler:      handler{},
+		},
+	}
+
+	for _, pc := range cases {
+		subCfg := messaging.SubscriberConfig{
+			ID:      pc.clientID,
+			Topic:   pc.topic,
+			Handler: pc.handler,
+		}
+		if pc.pubsub == true {
+			err := pubsub.Subscribe(context.TODO(), subCfg)
+			if pc.errorMessage == nil {
+				assert.Nil(t, err, fmt.Sprintf("%s expected %+v got %+v\n", pc.desc, pc.errorMessage, err))
+			} else {
+				assert.Equal(t, err, pc.errorMessage, fmt.Sprintf("%s expected %+v got %+v\n", pc.desc, pc.errorMessage, err))
+			}
+		} else {
+			err := pubsub.Unsubscribe(context.TODO(), pc.clientID, pc.topic)
+			if pc.errorMessage == nil {
+				assert.Nil(t, err, fmt.Sprintf("%s expected %+v got %+v\n", pc.desc, pc.errorMessage, err))
+			} else {
+				assert.Equal(t, err, pc.errorMessage, fmt.Sprintf("%s expected %+v got %+v\n", pc.desc, pc.errorMessage, err))
+			}
+		}
+	}
+}
+
+type handler struct{}
+
+func (h handler) Handle(msg *messaging.Message) error {
+	msgChan <- msg
+
+	return nil
+}
+
+func (h handler) Cancel() error {
+	return nil
+}
+
+func TestReplay(t *testing.T) {
+	replayTopic := fmt.Sprintf("%s.%s", topic, "replay")
+	var sent []*messaging.Message
+	var startTime time.Time
+	for i := range 3 {
+		if i == 1 {
+			startTime = time.Now()
+		}
+		msg := &messaging.Message{
+			Channel: channel,
+			Payload: fmt.Appendf(nil, "payload %d", i),
+			Created: time.Now().UnixNano(),
+		}
+		err := publisher.Publish(context.TODO(), replayTopic, msg)
+		assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+		sent = append(sent, msg)
+	}
+
+	cases := []struct {
+		desc     string
+		cfg      messaging.SubscriberConfig
+		expected []*messaging.Message
+		err      error
+	}{
+		{
+			desc:     "replay messages by start time",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy, StartTime: startTime},
+			expected: sent[1:],
+		},
+		{
+			desc:     "replay messages from sequence",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverFromSequencePolicy, StartSequence: 1},
+			expected: sent,
+		},
+		{
+			desc:     "replay last N messages",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy, LastN: 2},
+			expected: sent[1:],
+		},
+		{
+			desc:     "replay more messages than stored",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy, LastN: 10},
+			expected: sent,
+		},
+		{
+			desc: "replay messages without start point",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msgs := make(chan *messaging.Message, len(sent))
+			tc.cfg.ID = fmt.Sprintf("replay-%d", i)
+			tc.cfg.Topic = fmt.Sprintf("%s.%s", msgPrefix, replayTopic)
+			tc.cfg.Handler = replayHandler{msgs: msgs}
+			err := pubsub.Subscribe(context.TODO(), tc.cfg)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %v got %v", tc.desc, tc.err, err))
+			if err != nil {
+				return
+			}
+			defer func() {
+				err := pubsub.Unsubscribe(context.TODO(), tc.cfg.ID, tc.cfg.Topic)
+				assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+			}()
+			for _, expected := range tc.expected {
+				select {
+				case msg := <-msgs:
+					assert.Equal(t, expected.Payload, msg.Payload, fmt.Sprintf("%s: expected %s got %s", tc.desc, expected.Payload, msg.Payload))
+				case <-time.After(5 * time.Second):
+					t.Fatalf("%s: timed out waiting for replayed message", tc.desc)
+				}
+			}
+		})
+	}
+}
+
+type replayHandler struct {
+	msgs chan *messaging.Message
+}
+
+func (h replayHandler) Handle(msg *messaging.Message) error {
+	h.msgs <- msg
+
+	return nil
+}
+
+func (h replayHandler) Cancel() error {
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/segmentio/kafka-go"
+)
+
+// Replay of the last N messages is considered caught up once no
+// older message is received for this long.
+const replayIdleTimeout = time.Second
+
+func isReplay(dp messaging.DeliveryPolicy) bool {
+	switch dp {
+	case messaging.DeliverByStartTimePolicy, messaging.DeliverFromSequencePolicy, messaging.DeliverLastNPolicy:
+		return true
+	default:
+		return false
+	}
+}
+
+// replay reads every topic partition from the offset defined by the delivery
+// policy without joining any consumer group and returns the subscription
+// cancel function. Since the offsets are partition scoped, the start sequence
+// is applied to every partition.
+func (ps *pubsub) replay(ctx context.Context, cfg messaging.SubscriberConfig) (func() error, error) {
+	partitions, err := topicPartitions(ctx, ps.client, ps.prefix)
+	if err != nil {
+		return nil, err
+	}
+	offsets, err := partitionOffsets(ctx, ps.client, ps.prefix, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	var readers []*kafka.Reader
+	closeReaders := func() error {
+		var errs error
+		for _, r := range readers {
+			errs = errors.Join(errs, r.Close())
+		}
+		return errs
+	}
+	// Offsets of the messages stored before the subscription, per partition.
+	ends := make(map[int]int64)
+	for _, o := range offsets {
+		r := kafka.NewReader(kafka.ReaderConfig{
+			Brokers:   ps.brokers,
+			Topic:     ps.prefix,
+			Partition: o.Partition,
+			MaxWait:   maxWait,
+		})
+		readers = append(readers, r)
+
+		var err error
+		switch cfg.DeliveryPolicy {
+		case messaging.DeliverAllPolicy:
+			err = r.SetOffset(o.FirstOffset)
+		case messaging.DeliverNewPolicy:
+			err = r.SetOffset(o.LastOffset)
+		case messaging.DeliverByStartTimePolicy:
+			err = r.SetOffsetAt(ctx, cfg.StartTime)
+		case messaging.DeliverFromSequencePolicy:
+			// Kafka offsets start from 0, while the message sequences start from 1.
+			err = r.SetOffset(min(max(int64(cfg.StartSequence)-1, o.FirstOffset), o.LastOffset))
+		case messaging.DeliverLastNPolicy:
+			// Offsets are shared by all the topic subjects, so the stored
+			// messages are read from the start and filtered by the subject.
+			err = r.SetOffset(o.FirstOffset)
+			if o.LastOffset > o.FirstOffset {
+				ends[o.Partition] = o.LastOffset
+			}
+		}
+		if err != nil {
+			return nil, errors.Join(err, closeReaders())
+		}
+	}
+
+	rctx, cancel := context.WithCancel(context.Background())
+	msgs := make(chan kafka.Message)
+	for _, r := range readers {
+		go func(r *kafka.Reader) {
+			for {
+				m, err := r.FetchMessage(rctx)
+				if err != nil {
+					return
+				}
+				select {
+				case msgs <- m:
+				case <-rctx.Done():
+					return
+				}
+			}
+		}(r)
+	}
+	go ps.handleReplay(rctx, cfg, msgs, ends)
+
+	return func() error {
+		cancel()
+		if err := closeReaders(); err != nil {
+			return err
+		}
+		return cfg.Handler.Cancel()
+	}, nil
+}
+
+// handleReplay handles the messages read from the topic partitions. For the last
+// N messages policy, the messages stored before the subscription are buffered
+// until all the partitions are caught up, and only the last N are handled.
+func (ps *pubsub) handleReplay(ctx context.Context, cfg messaging.SubscriberConfig, msgs <-chan kafka.Message, ends map[int]int64) {
+	var (
+		history = make(map[int][]kafka.Message)
+		pending []kafka.Message
+		idle    <-chan time.Time
+	)
+	catchingUp := cfg.DeliveryPolicy == messaging.DeliverLastNPolicy
+	timer := time.NewTimer(replayIdleTimeout)
+	defer timer.Stop()
+	if catchingUp {
+		idle = timer.C
+	}
+	flush := func() {
+		var stored []kafka.Message
+		for _, ms := range history {
+			stored = append(stored, ms...)
+		}
+		slices.SortStableFunc(stored, func(a, b kafka.Message) int {
+			return a.Time.Compare(b.Time)
+		})
+		if uint64(len(stored)) > cfg.LastN {
+			stored = stored[uint64(len(stored))-cfg.LastN:]
+		}
+		for _, m := range append(stored, pending...) {
+			ps.handle(ctx, cfg, m)
+		}
+		history, pending = nil, nil
+		catchingUp = false
+		idle = nil
+	}
+	if catchingUp && len(ends) == 0 {
+		flush()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-msgs:
+			if !catchingUp {
+				ps.handle(ctx, cfg, m)
+				continue
+			}
+			end, ok := ends[m.Partition]
+			switch {
+			case !ok || m.Offset >= end:
+				pending = append(pending, m)
+			case matchSubject(cfg.Topic, string(m.Key)):
+				stored := append(history[m.Partition], m)
+				if uint64(len(stored)) > cfg.LastN {
+					stored = stored[1:]
+				}
+				history[m.Partition] = stored
+			}
+			if ok && m.Offset >= end-1 {
+				delete(ends, m.Partition)
+			}
+			if len(ends) == 0 {
+				flush()
+				continue
+			}
+			timer.Reset(replayIdleTimeout)
+		case <-idle:
+			flush()
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing provides tracing instrumentation for SuperMQ clients policies service.
+//
+// This package provides tracing middleware for SuperMQ clients policies service.
+// It can be used to trace incoming requests and add tracing capabilities to
+// SuperMQ clients policies service.
+//
+// For more details about tracing instrumentation for SuperMQ messaging refer
+// to the documentation at https://docs.supermq.absmach.eu/tracing/.
+package tracing
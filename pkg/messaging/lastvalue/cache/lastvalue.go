@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrEmptyDomainID  = errors.New("domain ID is empty")
+	ErrEmptyChannelID = errors.New("channel ID is empty")
+)
+
+const keyPrefix = "lastvalue"
+
+type lastValueCache struct {
+	client   *redis.Client
+	duration time.Duration
+}
+
+// NewStore returns Redis backed last value store. Channels that do not
+// receive messages for the given duration are removed from the store.
+func NewStore(client *redis.Client, duration time.Duration) lastvalue.Store {
+	return &lastValueCache{
+		client:   client,
+		duration: duration,
+	}
+}
+
+func (lc *lastValueCache) Save(ctx context.Context, msg *messaging.Message) error {
+	key, err := encodeKey(msg.GetDomain(), msg.GetChannel())
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	// Each channel is stored as a hash with one field per subtopic and publisher.
+	field := msg.GetSubtopic() + "|" + msg.GetPublisher()
+	if _, err := lc.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, field, data)
+		pipe.Expire(ctx, key, lc.duration)
+		return nil
+	}); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (lc *lastValueCache) Retrieve(ctx context.Context, domainID, channelID, subtopic string) ([]*messaging.Message, error) {
+	key, err := encodeKey(domainID, channelID)
+	if err != nil {
+		return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	vals, err := lc.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	msgs := []*messaging.Message{}
+	for _, val := range vals {
+		var msg messaging.Message
+		if err := proto.Unmarshal([]byte(val), &msg); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		if subtopic != "" && msg.GetSubtopic() != subtopic {
+			continue
+		}
+		msgs = append(msgs, &msg)
+	}
+
+	return msgs, nil
+}
+
+func encodeKey(domainID, channelID string) (string, error) {
+	if domainID == "" {
+		return "", ErrEmptyDomainID
+	}
+	if channelID == "" {
+		return "", ErrEmptyChannelID
+	}
+
+	return fmt.Sprintf("%s:%s:%s", keyPrefix, domainID, channelID), nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue/cache"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func setupStore(t *testing.T) lastvalue.Store {
+	opts, err := redis.ParseURL(redisURL)
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error on parsing redis URL: %s", err))
+	redisClient := redis.NewClient(opts)
+
+	return cache.NewStore(redisClient, 10*time.Minute)
+}
+
+func newMessage(domainID, channelID, subtopic, publisher string, payload []byte) *messaging.Message {
+	return &messaging.Message{
+		Domain:    domainID,
+		Channel:   channelID,
+		Subtopic:  subtopic,
+		Publisher: publisher,
+		Protocol:  "http",
+		Payload:   payload,
+		Created:   time.Now().UnixNano(),
+	}
+}
+
+func TestSave(t *testing.T) {
+	store := setupStore(t)
+
+	domainID := testsutil.GenerateUUID(t)
+	channelID := testsutil.GenerateUUID(t)
+	publisher := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc string
+		msg  *messaging.Message
+		err  error
+	}{
+		{
+			desc: "save message successfully",
+			msg:  newMessage(domainID, channelID, "", publisher, []byte("payload")),
+			err:  nil,
+		},
+		{
+			desc: "save message with subtopic successfully",
+			msg:  newMessage(domainID, channelID, "temperature", publisher, []byte("payload")),
+			err:  nil,
+		},
+		{
+			desc: "save message with empty domain ID",
+			msg:  newMessage("", channelID, "", publisher, []byte("payload")),
+			err:  cache.ErrEmptyDomainID,
+		},
+		{
+			desc: "save message with empty channel ID",
+			msg:  newMessage(domainID, "", "", publisher, []byte("payload")),
+			err:  cache.ErrEmptyChannelID,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := store.Save(context.Background(), tc.msg)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+		})
+	}
+}
+
+func TestRetrieve(t *testing.T) {
+	store := setupStore(t)
+
+	domainID := testsutil.GenerateUUID(t)
+	channelID := testsutil.GenerateUUID(t)
+	publisher1 := testsutil.GenerateUUID(t)
+	publisher2 := testsutil.GenerateUUID(t)
+
+	old := newMessage(domainID, channelID, "", publisher1, []byte("old"))
+	latest := newMessage(domainID, channelID, "", publisher1, []byte("latest"))
+	other := newMessage(domainID, channelID, "", publisher2, []byte("other"))
+	sub := newMessage(domainID, channelID, "temperature", publisher1, []byte("subtopic"))
+	for _, msg := range []*messaging.Message{old, latest, other, sub} {
+		err := store.Save(context.Background(), msg)
+		assert.Nil(t, err, fmt.Sprintf("unexpected error while saving message: %v", err))
+	}
+
+	cases := []struct {
+		desc      string
+		domainID  string
+		channelID string
+		subtopic  string
+		msgs      []*messaging.Message
+		err       error
+	}{
+		{
+			desc:      "retrieve last values of channel",
+			domainID:  domainID,
+			channelID: channelID,
+			msgs:      []*messaging.Message{latest, other, sub},
+		},
+		{
+			desc:      "retrieve last values of subtopic",
+			domainID:  domainID,
+			channelID: channelID,
+			subtopic:  "temperature",
+			msgs:      []*messaging.Message{sub},
+		},
+		{
+			desc:      "retrieve last values of channel without messages",
+			domainID:  domainID,
+			channelID: testsutil.GenerateUUID(t),
+			msgs:      []*messaging.Message{},
+		},
+		{
+			desc:      "retrieve last values with empty domain ID",
+			channelID: channelID,
+			err:       cache.ErrEmptyDomainID,
+		},
+		{
+			desc:     "retrieve last values with empty channel ID",
+			domainID: domainID,
+			err:      cache.ErrEmptyChannelID,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msgs, err := store.Retrieve(context.Background(), tc.domainID, tc.channelID, tc.subtopic)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			assert.Equal(t, len(tc.msgs), len(msgs))
+			for _, expected := range tc.msgs {
+				found := false
+				for _, msg := range msgs {
+					if proto.Equal(expected, msg) {
+						found = true
+					}
+				}
+				assert.True(t, found, fmt.Sprintf("expected message %v to be retrieved", expected))
+			}
+		})
+	}
+}
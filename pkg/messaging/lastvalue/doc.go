@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lastvalue contains the last value store which keeps the latest
+// message published to the channel by each publisher on each subtopic.
+// The store is fed from the message broker, so clients that connect late
+// can retrieve the current state of the channel.
+package lastvalue
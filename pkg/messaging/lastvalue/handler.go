@@ -0,0 +1,43 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+)
+
+var _ messaging.MessageHandler = (*handler)(nil)
+
+type handler struct {
+	ctx   context.Context
+	store Store
+}
+
+// NewHandler returns the message handler which saves received messages to the store.
+func NewHandler(ctx context.Context, store Store) messaging.MessageHandler {
+	return &handler{
+		ctx:   ctx,
+		store: store,
+	}
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	return h.store.Save(h.ctx, msg)
+}
+
+func (h *handler) Cancel() error {
+	return nil
+}
+
+// Subscribe feeds the store with all messages received from the broker.
+func Subscribe(ctx context.Context, sub messaging.Subscriber, store Store) error {
+	return sub.Subscribe(ctx, messaging.SubscriberConfig{
+		ID:      SubscriberID,
+		Topic:   brokers.SubjectAllMessages,
+		Handler: NewHandler(ctx, store),
+	})
+}
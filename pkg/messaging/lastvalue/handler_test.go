@@ -0,0 +1,70 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/messaging/brokers"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue"
+	"github.com/absmach/supermq/pkg/messaging/lastvalue/mocks"
+	pubsubmocks "github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandle(t *testing.T) {
+	store := new(mocks.Store)
+	handler := lastvalue.NewHandler(context.Background(), store)
+
+	msg := &messaging.Message{
+		Domain:   "domain",
+		Channel:  "channel",
+		Subtopic: "subtopic",
+		Payload:  []byte("payload"),
+	}
+
+	cases := []struct {
+		desc    string
+		saveErr error
+		err     error
+	}{
+		{
+			desc: "handle message successfully",
+		},
+		{
+			desc:    "handle message with failed save",
+			saveErr: repoerr.ErrCreateEntity,
+			err:     repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := store.On("Save", mock.Anything, msg).Return(tc.saveErr)
+			err := handler.Handle(msg)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v got %v", tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	store := new(mocks.Store)
+	pubsub := new(pubsubmocks.PubSub)
+
+	isStoreSubscription := func(cfg messaging.SubscriberConfig) bool {
+		return cfg.ID == lastvalue.SubscriberID && cfg.Topic == brokers.SubjectAllMessages && cfg.Handler != nil
+	}
+	pubsub.On("Subscribe", mock.Anything, mock.MatchedBy(isStoreSubscription)).Return(nil)
+
+	err := lastvalue.Subscribe(context.Background(), pubsub, store)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %v", err))
+	pubsub.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package lastvalue
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// Subtopic is the reserved subtopic used by the adapters
+// to request the last values of the channel.
+const Subtopic = "latest"
+
+// SubscriberID is the ID of the broker subscription that feeds the store.
+// All adapter instances share the same subscription, so every message is
+// stored only once.
+const SubscriberID = "last-value"
+
+// Store keeps the last message published to the channel.
+// Messages are keyed by domain, channel, subtopic and publisher.
+type Store interface {
+	// Save replaces the last message with the same domain, channel,
+	// subtopic and publisher.
+	Save(ctx context.Context, msg *messaging.Message) error
+
+	// Retrieve returns the last messages published to the channel.
+	// If subtopic is not empty, only messages published to the subtopic are returned.
+	Retrieve(ctx context.Context, domainID, channelID, subtopic string) ([]*messaging.Message, error)
+}
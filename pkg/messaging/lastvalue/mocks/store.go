@@ -0,0 +1,180 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewStore creates a new instance of Store. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Store {
+	mock := &Store{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Store is an autogenerated mock type for the Store type
+type Store struct {
+	mock.Mock
+}
+
+type Store_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Store) EXPECT() *Store_Expecter {
+	return &Store_Expecter{mock: &_m.Mock}
+}
+
+// Retrieve provides a mock function for the type Store
+func (_mock *Store) Retrieve(ctx context.Context, domainID string, channelID string, subtopic string) ([]*messaging.Message, error) {
+	ret := _mock.Called(ctx, domainID, channelID, subtopic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retrieve")
+	}
+
+	var r0 []*messaging.Message
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*messaging.Message, error)); ok {
+		return returnFunc(ctx, domainID, channelID, subtopic)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []*messaging.Message); ok {
+		r0 = returnFunc(ctx, domainID, channelID, subtopic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*messaging.Message)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, channelID, subtopic)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Store_Retrieve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retrieve'
+type Store_Retrieve_Call struct {
+	*mock.Call
+}
+
+// Retrieve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+//   - subtopic string
+func (_e *Store_Expecter) Retrieve(ctx interface{}, domainID interface{}, channelID interface{}, subtopic interface{}) *Store_Retrieve_Call {
+	return &Store_Retrieve_Call{Call: _e.mock.On("Retrieve", ctx, domainID, channelID, subtopic)}
+}
+
+func (_c *Store_Retrieve_Call) Run(run func(ctx context.Context, domainID string, channelID string, subtopic string)) *Store_Retrieve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_Retrieve_Call) Return(messages []*messaging.Message, err error) *Store_Retrieve_Call {
+	_c.Call.Return(messages, err)
+	return _c
+}
+
+func (_c *Store_Retrieve_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string, subtopic string) ([]*messaging.Message, error)) *Store_Retrieve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type Store
+func (_mock *Store) Save(ctx context.Context, msg *messaging.Message) error {
+	ret := _mock.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *messaging.Message) error); ok {
+		r0 = returnFunc(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Store_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type Store_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *messaging.Message
+func (_e *Store_Expecter) Save(ctx interface{}, msg interface{}) *Store_Save_Call {
+	return &Store_Save_Call{Call: _e.mock.On("Save", ctx, msg)}
+}
+
+func (_c *Store_Save_Call) Run(run func(ctx context.Context, msg *messaging.Message)) *Store_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *messaging.Message
+		if args[1] != nil {
+			arg1 = args[1].(*messaging.Message)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Store_Save_Call) Return(err error) *Store_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Store_Save_Call) RunAndReturn(run func(ctx context.Context, msg *messaging.Message) error) *Store_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,363 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewDeadLetters creates a new instance of DeadLetters. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeadLetters(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeadLetters {
+	mock := &DeadLetters{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DeadLetters is an autogenerated mock type for the DeadLetters type
+type DeadLetters struct {
+	mock.Mock
+}
+
+type DeadLetters_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeadLetters) EXPECT() *DeadLetters_Expecter {
+	return &DeadLetters_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type DeadLetters
+func (_mock *DeadLetters) Close() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeadLetters_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type DeadLetters_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *DeadLetters_Expecter) Close() *DeadLetters_Close_Call {
+	return &DeadLetters_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *DeadLetters_Close_Call) Run(run func()) *DeadLetters_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadLetters_Close_Call) Return(err error) *DeadLetters_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeadLetters_Close_Call) RunAndReturn(run func() error) *DeadLetters_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type DeadLetters
+func (_mock *DeadLetters) List(ctx context.Context, domainID string, offset uint64, limit uint64) (messaging.DeadLetterPage, error) {
+	ret := _mock.Called(ctx, domainID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 messaging.DeadLetterPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (messaging.DeadLetterPage, error)); ok {
+		return returnFunc(ctx, domainID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) messaging.DeadLetterPage); ok {
+		r0 = returnFunc(ctx, domainID, offset, limit)
+	} else {
+		r0 = ret.Get(0).(messaging.DeadLetterPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = returnFunc(ctx, domainID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeadLetters_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type DeadLetters_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - offset uint64
+//   - limit uint64
+func (_e *DeadLetters_Expecter) List(ctx interface{}, domainID interface{}, offset interface{}, limit interface{}) *DeadLetters_List_Call {
+	return &DeadLetters_List_Call{Call: _e.mock.On("List", ctx, domainID, offset, limit)}
+}
+
+func (_c *DeadLetters_List_Call) Run(run func(ctx context.Context, domainID string, offset uint64, limit uint64)) *DeadLetters_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *DeadLetters_List_Call) Return(deadLetterPage messaging.DeadLetterPage, err error) *DeadLetters_List_Call {
+	_c.Call.Return(deadLetterPage, err)
+	return _c
+}
+
+func (_c *DeadLetters_List_Call) RunAndReturn(run func(ctx context.Context, domainID string, offset uint64, limit uint64) (messaging.DeadLetterPage, error)) *DeadLetters_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function for the type DeadLetters
+func (_mock *DeadLetters) Remove(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeadLetters_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type DeadLetters_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *DeadLetters_Expecter) Remove(ctx interface{}, domainID interface{}, id interface{}) *DeadLetters_Remove_Call {
+	return &DeadLetters_Remove_Call{Call: _e.mock.On("Remove", ctx, domainID, id)}
+}
+
+func (_c *DeadLetters_Remove_Call) Run(run func(ctx context.Context, domainID string, id string)) *DeadLetters_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DeadLetters_Remove_Call) Return(err error) *DeadLetters_Remove_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeadLetters_Remove_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *DeadLetters_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Replay provides a mock function for the type DeadLetters
+func (_mock *DeadLetters) Replay(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replay")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DeadLetters_Replay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replay'
+type DeadLetters_Replay_Call struct {
+	*mock.Call
+}
+
+// Replay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *DeadLetters_Expecter) Replay(ctx interface{}, domainID interface{}, id interface{}) *DeadLetters_Replay_Call {
+	return &DeadLetters_Replay_Call{Call: _e.mock.On("Replay", ctx, domainID, id)}
+}
+
+func (_c *DeadLetters_Replay_Call) Run(run func(ctx context.Context, domainID string, id string)) *DeadLetters_Replay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DeadLetters_Replay_Call) Return(err error) *DeadLetters_Replay_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DeadLetters_Replay_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *DeadLetters_Replay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// View provides a mock function for the type DeadLetters
+func (_mock *DeadLetters) View(ctx context.Context, domainID string, id string) (messaging.DeadLetter, error) {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for View")
+	}
+
+	var r0 messaging.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (messaging.DeadLetter, error)); ok {
+		return returnFunc(ctx, domainID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) messaging.DeadLetter); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Get(0).(messaging.DeadLetter)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeadLetters_View_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'View'
+type DeadLetters_View_Call struct {
+	*mock.Call
+}
+
+// View is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *DeadLetters_Expecter) View(ctx interface{}, domainID interface{}, id interface{}) *DeadLetters_View_Call {
+	return &DeadLetters_View_Call{Call: _e.mock.On("View", ctx, domainID, id)}
+}
+
+func (_c *DeadLetters_View_Call) Run(run func(ctx context.Context, domainID string, id string)) *DeadLetters_View_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DeadLetters_View_Call) Return(deadLetter messaging.DeadLetter, err error) *DeadLetters_View_Call {
+	_c.Call.Return(deadLetter, err)
+	return _c
+}
+
+func (_c *DeadLetters_View_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (messaging.DeadLetter, error)) *DeadLetters_View_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,219 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	broker "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	headerSubscriber = "Smq-Dead-Letter-Subscriber"
+	headerSubject    = "Smq-Dead-Letter-Subject"
+	headerError      = "Smq-Dead-Letter-Error"
+	headerAttempts   = "Smq-Dead-Letter-Attempts"
+	headerFailedAt   = "Smq-Dead-Letter-Failed-At"
+
+	fetchTimeout = 5 * time.Second
+)
+
+// ErrEmptyDomain indicates that the message can not be dead-lettered
+// because it does not belong to any domain.
+var ErrEmptyDomain = errors.New("empty message domain")
+
+var _ messaging.DeadLetters = (*deadLetters)(nil)
+
+type deadLetters struct {
+	conn   *broker.Conn
+	js     jetstream.JetStream
+	stream jetstream.Stream
+}
+
+// NewDeadLetters returns NATS JetStream dead-letter stream API.
+func NewDeadLetters(ctx context.Context, url string) (messaging.DeadLetters, error) {
+	conn, err := broker.Connect(url, broker.MaxReconnects(maxReconnects))
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := js.CreateOrUpdateStream(ctx, dlqStreamConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadLetters{
+		conn:   conn,
+		js:     js,
+		stream: stream,
+	}, nil
+}
+
+func (dl *deadLetters) List(ctx context.Context, domainID string, offset, limit uint64) (messaging.DeadLetterPage, error) {
+	page := messaging.DeadLetterPage{
+		Offset:      offset,
+		Limit:       limit,
+		DeadLetters: []messaging.DeadLetter{},
+	}
+	subject := deadLetterSubject(domainID)
+	info, err := dl.stream.Info(ctx, jetstream.WithSubjectFilter(subject))
+	if err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+	page.Total = info.State.Subjects[subject]
+	if limit == 0 || offset >= page.Total {
+		return page, nil
+	}
+
+	cons, err := dl.stream.OrderedConsumer(ctx, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+	})
+	if err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+	batch, err := cons.Fetch(int(min(page.Total, offset+limit)), jetstream.FetchMaxWait(fetchTimeout))
+	if err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+
+	var i uint64
+	for m := range batch.Messages() {
+		i++
+		if i <= offset {
+			continue
+		}
+		meta, err := m.Metadata()
+		if err != nil {
+			return messaging.DeadLetterPage{}, err
+		}
+		d, err := toDeadLetter(meta.Sequence.Stream, m.Headers(), m.Data())
+		if err != nil {
+			return messaging.DeadLetterPage{}, err
+		}
+		page.DeadLetters = append(page.DeadLetters, d)
+	}
+	if err := batch.Error(); err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+
+	return page, nil
+}
+
+func (dl *deadLetters) View(ctx context.Context, domainID, id string) (messaging.DeadLetter, error) {
+	raw, err := dl.retrieve(ctx, domainID, id)
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return toDeadLetter(raw.Sequence, raw.Header, raw.Data)
+}
+
+func (dl *deadLetters) Replay(ctx context.Context, domainID, id string) error {
+	raw, err := dl.retrieve(ctx, domainID, id)
+	if err != nil {
+		return err
+	}
+	msg := &broker.Msg{
+		Subject: raw.Header.Get(headerSubject),
+		Data:    raw.Data,
+	}
+	if _, err := dl.js.PublishMsg(ctx, msg); err != nil {
+		return err
+	}
+
+	return dl.stream.DeleteMsg(ctx, raw.Sequence)
+}
+
+func (dl *deadLetters) Remove(ctx context.Context, domainID, id string) error {
+	raw, err := dl.retrieve(ctx, domainID, id)
+	if err != nil {
+		return err
+	}
+
+	return dl.stream.DeleteMsg(ctx, raw.Sequence)
+}
+
+func (dl *deadLetters) Close() error {
+	dl.conn.Close()
+	return nil
+}
+
+// retrieve returns the dead letter stream message if it belongs to the domain.
+func (dl *deadLetters) retrieve(ctx context.Context, domainID, id string) (*jetstream.RawStreamMsg, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, messaging.ErrDeadLetterNotFound
+	}
+	raw, err := dl.stream.GetMsg(ctx, seq)
+	switch {
+	case errors.Is(err, jetstream.ErrMsgNotFound):
+		return nil, messaging.ErrDeadLetterNotFound
+	case err != nil:
+		return nil, err
+	}
+	if raw.Subject != deadLetterSubject(domainID) {
+		return nil, messaging.ErrDeadLetterNotFound
+	}
+
+	return raw, nil
+}
+
+// deadLetter publishes the failed message to the dead-letter stream.
+func (ps *pubsub) deadLetter(subscriber string, m jetstream.Msg, msg *messaging.Message, attempts uint64, herr error) error {
+	if msg.GetDomain() == "" {
+		return ErrEmptyDomain
+	}
+	dm := broker.NewMsg(deadLetterSubject(msg.GetDomain()))
+	dm.Data = m.Data()
+	dm.Header.Set(headerSubscriber, subscriber)
+	dm.Header.Set(headerSubject, m.Subject())
+	dm.Header.Set(headerError, herr.Error())
+	dm.Header.Set(headerAttempts, strconv.FormatUint(attempts, 10))
+	dm.Header.Set(headerFailedAt, time.Now().UTC().Format(time.RFC3339Nano))
+	if _, err := ps.js.PublishMsg(context.Background(), dm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deadLetterSubject(domainID string) string {
+	return fmt.Sprintf("%s.%s", dlqPrefix, domainID)
+}
+
+func toDeadLetter(seq uint64, header broker.Header, data []byte) (messaging.DeadLetter, error) {
+	var msg messaging.Message
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	attempts, err := strconv.ParseUint(header.Get(headerAttempts), 10, 64)
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	failedAt, err := time.Parse(time.RFC3339Nano, header.Get(headerFailedAt))
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return messaging.DeadLetter{
+		ID:         strconv.FormatUint(seq, 10),
+		Subscriber: header.Get(headerSubscriber),
+		Subject:    header.Get(headerSubject),
+		Error:      header.Get(headerError),
+		Attempts:   attempts,
+		FailedAt:   failedAt,
+		Message:    &msg,
+	}, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package nats_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	domainID      = "2c8b4ca8-6a57-4b4f-9a6e-5e0e3cfd5a1d"
+	otherDomainID = "a2f8d6e4-4f7c-4d8a-9b1e-3c5d7e9f1a2b"
+)
+
+var errHandle = errors.New("failed to handle message")
+
+func TestDeadLetters(t *testing.T) {
+	failing := &failingHandler{}
+	subCfg := messaging.SubscriberConfig{
+		ID:             "dead-letters",
+		Topic:          fmt.Sprintf("%s.%s.>", msgPrefix, domainID),
+		Handler:        failing,
+		DeliveryPolicy: messaging.DeliverNewPolicy,
+		RetryPolicy: messaging.RetryPolicy{
+			MaxRetries: 2,
+			Backoff:    10 * time.Millisecond,
+			MaxBackoff: 50 * time.Millisecond,
+		},
+	}
+	err := pubsub.Subscribe(context.Background(), subCfg)
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	msg := &messaging.Message{
+		Domain:    domainID,
+		Channel:   channel,
+		Publisher: clientID,
+		Protocol:  "http",
+		Payload:   []byte("payload"),
+	}
+	err = publisher.Publish(context.Background(), fmt.Sprintf("%s.c.%s", domainID, channel), msg)
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	var page messaging.DeadLetterPage
+	assert.Eventually(t, func() bool {
+		page, err = deadLtrs.List(context.Background(), domainID, 0, 10)
+		return err == nil && page.Total == 1
+	}, 5*time.Second, 50*time.Millisecond, "expected message to be dead-lettered")
+	assert.Equal(t, 1, len(page.DeadLetters))
+	dead := page.DeadLetters[0]
+	assert.Equal(t, subCfg.ID, dead.Subscriber)
+	assert.Equal(t, errHandle.Error(), dead.Error)
+	assert.Equal(t, uint64(3), dead.Attempts)
+	assert.Equal(t, msg.Payload, dead.Message.GetPayload())
+
+	listCases := []struct {
+		desc     string
+		domainID string
+		offset   uint64
+		limit    uint64
+		count    int
+		total    uint64
+	}{
+		{
+			desc:     "list dead letters",
+			domainID: domainID,
+			limit:    10,
+			count:    1,
+			total:    1,
+		},
+		{
+			desc:     "list dead letters with offset",
+			domainID: domainID,
+			offset:   1,
+			limit:    10,
+			total:    1,
+		},
+		{
+			desc:     "list dead letters of other domain",
+			domainID: otherDomainID,
+			limit:    10,
+		},
+	}
+	for _, tc := range listCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := deadLtrs.List(context.Background(), tc.domainID, tc.offset, tc.limit)
+			assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.Equal(t, tc.total, page.Total)
+			assert.Equal(t, tc.count, len(page.DeadLetters))
+		})
+	}
+
+	viewCases := []struct {
+		desc     string
+		domainID string
+		id       string
+		err      error
+	}{
+		{
+			desc:     "view dead letter",
+			domainID: domainID,
+			id:       dead.ID,
+		},
+		{
+			desc:     "view dead letter of other domain",
+			domainID: otherDomainID,
+			id:       dead.ID,
+			err:      messaging.ErrDeadLetterNotFound,
+		},
+		{
+			desc:     "view dead letter with invalid id",
+			domainID: domainID,
+			id:       "invalid",
+			err:      messaging.ErrDeadLetterNotFound,
+		},
+	}
+	for _, tc := range viewCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			d, err := deadLtrs.View(context.Background(), tc.domainID, tc.id)
+			assert.Equal(t, tc.err, err)
+			if err == nil {
+				assert.Equal(t, dead.ID, d.ID)
+				assert.Equal(t, dead.Subject, d.Subject)
+			}
+		})
+	}
+
+	failing.recover()
+	err = deadLtrs.Replay(context.Background(), domainID, dead.ID)
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	assert.Eventually(t, failing.handled, 5*time.Second, 50*time.Millisecond, "expected replayed message to be handled")
+
+	_, err = deadLtrs.View(context.Background(), domainID, dead.ID)
+	assert.Equal(t, messaging.ErrDeadLetterNotFound, err)
+	err = deadLtrs.Remove(context.Background(), domainID, dead.ID)
+	assert.Equal(t, messaging.ErrDeadLetterNotFound, err)
+}
+
+type failingHandler struct {
+	ok   atomic.Bool
+	done atomic.Bool
+}
+
+func (h *failingHandler) Handle(msg *messaging.Message) error {
+	if !h.ok.Load() {
+		return errHandle
+	}
+	h.done.Store(true)
+
+	return nil
+}
+
+func (h *failingHandler) Cancel() error {
+	return nil
+}
+
+func (h *failingHandler) recover() {
+	h.ok.Store(true)
+}
+
+func (h *failingHandler) handled() bool {
+	return h.done.Load()
+}
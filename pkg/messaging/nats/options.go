@@ -26,9 +26,22 @@ var (
 		Discard:           jetstream.DiscardOld,
 		Storage:           jetstream.FileStorage,
 	}
+
+	dlqStreamConfig = jetstream.StreamConfig{
+		Name:        "dlq",
+		Description: "SuperMQ stream for the messages which could not be handled by the subscribers",
+		Subjects:    []string{dlqPrefix + ".>"},
+		Retention:   jetstream.LimitsPolicy,
+		MaxAge:      time.Hour * 24 * 7,
+		Discard:     jetstream.DiscardOld,
+		Storage:     jetstream.FileStorage,
+	}
 )
 
-const msgPrefix = "m"
+const (
+	msgPrefix = "m"
+	dlqPrefix = "dlq"
+)
 
 type options struct {
 	prefix         string
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/absmach/supermq/pkg/messaging"
 	broker "github.com/nats-io/nats.go"
@@ -23,6 +25,10 @@ var (
 	ErrEmptyID       = errors.New("empty id")
 )
 
+// ephemeralInactiveThreshold is the time after which the ephemeral consumer
+// without the subscriber is removed.
+const ephemeralInactiveThreshold = time.Minute
+
 var _ messaging.PubSub = (*pubsub)(nil)
 
 type pubsub struct {
@@ -79,9 +85,18 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 	if cfg.Topic == "" {
 		return ErrEmptyTopic
 	}
+	if err := cfg.ValidateDelivery(); err != nil {
+		return err
+	}
+
+	if cfg.RetryPolicy.Enabled() {
+		if _, err := ps.js.CreateOrUpdateStream(ctx, dlqStreamConfig); err != nil {
+			return fmt.Errorf("failed to create dead-letter stream: %w", err)
+		}
+	}
 
 	// nolint:contextcheck
-	nh := ps.natsHandler(cfg.Handler)
+	nh := ps.natsHandler(cfg)
 
 	consumerConfig := jetstream.ConsumerConfig{
 		Name:          formatConsumerName(cfg.Topic, cfg.ID),
@@ -94,12 +109,31 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 	if cfg.Ordered {
 		consumerConfig.MaxAckPending = 1
 	}
+	if cfg.Ephemeral {
+		consumerConfig.Durable = ""
+		consumerConfig.InactiveThreshold = ephemeralInactiveThreshold
+	}
 
 	switch cfg.DeliveryPolicy {
 	case messaging.DeliverNewPolicy:
 		consumerConfig.DeliverPolicy = jetstream.DeliverNewPolicy
 	case messaging.DeliverAllPolicy:
 		consumerConfig.DeliverPolicy = jetstream.DeliverAllPolicy
+	case messaging.DeliverByStartTimePolicy:
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartTimePolicy
+		consumerConfig.OptStartTime = &cfg.StartTime
+	case messaging.DeliverFromSequencePolicy:
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = cfg.StartSequence
+	case messaging.DeliverLastNPolicy:
+		// JetStream has no "last N" policy, so all the stored messages are
+		// delivered and the ones preceding the last N are skipped.
+		consumerConfig.DeliverPolicy = jetstream.DeliverAllPolicy
+		skip, err := ps.skipCount(ctx, cfg.Topic, cfg.LastN)
+		if err != nil {
+			return err
+		}
+		nh = skipHandler(skip, nh)
 	}
 
 	consumer, err := ps.stream.CreateOrUpdateConsumer(ctx, consumerConfig)
@@ -131,7 +165,40 @@ func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
 	}
 }
 
-func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg) {
+// skipCount returns the number of the stored topic messages which precede the last n.
+func (ps *pubsub) skipCount(ctx context.Context, topic string, n uint64) (uint64, error) {
+	info, err := ps.stream.Info(ctx, jetstream.WithSubjectFilter(topic))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count stored messages: %w", err)
+	}
+	var total uint64
+	for _, count := range info.State.Subjects {
+		total += count
+	}
+	if total <= n {
+		return 0, nil
+	}
+
+	return total - n, nil
+}
+
+// skipHandler acknowledges the first skip messages without handling them.
+func skipHandler(skip uint64, h jetstream.MessageHandler) jetstream.MessageHandler {
+	if skip == 0 {
+		return h
+	}
+	var delivered atomic.Uint64
+
+	return func(m jetstream.Msg) {
+		if delivered.Add(1) <= skip {
+			_ = m.Ack()
+			return
+		}
+		h(m)
+	}
+}
+
+func (ps *pubsub) natsHandler(cfg messaging.SubscriberConfig) func(m jetstream.Msg) {
 	return func(m jetstream.Msg) {
 		args := []any{
 			slog.String("subject", m.Subject()),
@@ -160,16 +227,48 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg)
 			return
 		}
 
-		err = h.Handle(&msg)
+		err = cfg.Handler.Handle(&msg)
 		ackType := ps.errAckType(err)
 		if err != nil {
 			args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
 			ps.logger.Warn("failed to handle message", args...)
+			if cfg.RetryPolicy.Enabled() && retriable(ackType) {
+				ps.retry(cfg, m, meta, &msg, err)
+				return
+			}
 		}
 		ps.handleAck(ackType, m)
 	}
 }
 
+// retry redelivers the failed message with backoff until the retries are
+// exhausted or the message is terminated, and then moves it to the dead-letter stream.
+func (ps *pubsub) retry(cfg messaging.SubscriberConfig, m jetstream.Msg, meta *jetstream.MsgMetadata, msg *messaging.Message, herr error) {
+	attempts := uint64(1)
+	if meta != nil {
+		attempts = meta.NumDelivered
+	}
+	terminated := false
+	if e, ok := herr.(messaging.Error); ok && e.Ack() == messaging.Term {
+		terminated = true
+	}
+	if !terminated && attempts <= cfg.RetryPolicy.MaxRetries {
+		if err := m.NakWithDelay(cfg.RetryPolicy.Delay(attempts)); err != nil {
+			ps.logger.Warn(fmt.Sprintf("failed to negatively ack message: %s", err))
+		}
+		return
+	}
+
+	if err := ps.deadLetter(cfg.ID, m, msg, attempts, herr); err != nil {
+		ps.logger.Warn(fmt.Sprintf("failed to dead-letter message: %s", err))
+		if err := m.NakWithDelay(cfg.RetryPolicy.Delay(attempts)); err != nil {
+			ps.logger.Warn(fmt.Sprintf("failed to negatively ack message: %s", err))
+		}
+		return
+	}
+	ps.handleAck(messaging.Term, m)
+}
+
 func (ps *pubsub) errAckType(err error) messaging.AckType {
 	if err == nil {
 		return messaging.Ack
@@ -180,6 +279,15 @@ func (ps *pubsub) errAckType(err error) messaging.AckType {
 	return messaging.NoAck
 }
 
+func retriable(at messaging.AckType) bool {
+	switch at {
+	case messaging.Nack, messaging.NoAck, messaging.Term:
+		return true
+	default:
+		return false
+	}
+}
+
 func (ps *pubsub) handleAck(at messaging.AckType, m jetstream.Msg) {
 	switch at {
 	case messaging.Ack:
@@ -21,6 +21,7 @@ import (
 var (
 	publisher messaging.Publisher
 	pubsub    messaging.PubSub
+	deadLtrs  messaging.DeadLetters
 )
 
 func TestMain(m *testing.M) {
@@ -57,6 +58,12 @@ func TestMain(m *testing.M) {
 	}); err != nil {
 		log.Fatalf("Could not connect to docker: %s", err)
 	}
+	if err := pool.Retry(func() error {
+		deadLtrs, err = nats.NewDeadLetters(context.Background(), address)
+		return err
+	}); err != nil {
+		log.Fatalf("Could not connect to docker: %s", err)
+	}
 
 	code := m.Run()
 	if err := pool.Purge(container); err != nil {
@@ -6,8 +6,14 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
 )
 
+// ErrInvalidDeliveryPolicy indicates unknown delivery policy or missing delivery start point.
+var ErrInvalidDeliveryPolicy = errors.New("invalid delivery policy")
+
 type DeliveryPolicy uint8
 
 const (
@@ -17,6 +23,17 @@ const (
 
 	// DeliverAllPolicy starts delivering messages from the very beginning of a stream.
 	DeliverAllPolicy
+
+	// DeliverByStartTimePolicy starts delivering messages sent at or after the subscriber StartTime.
+	DeliverByStartTimePolicy
+
+	// DeliverFromSequencePolicy starts delivering messages from the subscriber StartSequence
+	// of the underlying stream.
+	DeliverFromSequencePolicy
+
+	// DeliverLastNPolicy delivers the last LastN messages sent to the topic
+	// before the subscription, followed by the new messages.
+	DeliverLastNPolicy
 )
 
 // AckType is used for message acknowledgement.
@@ -77,6 +94,36 @@ type SubscriberConfig struct {
 	Handler        MessageHandler // Function that handles incoming messages.
 	DeliveryPolicy DeliveryPolicy // DeliverPolicy defines from which point to start delivering messages.
 	Ordered        bool           // Whether message delivery must preserve order.
+	Ephemeral      bool           // Whether the subscription is removed once the subscriber is gone, instead of being kept across restarts.
+	RetryPolicy    RetryPolicy    // RetryPolicy defines redelivery and dead-lettering of failed messages.
+	StartTime      time.Time      // StartTime is the delivery start point of DeliverByStartTimePolicy.
+	StartSequence  uint64         // StartSequence is the delivery start point of DeliverFromSequencePolicy.
+	LastN          uint64         // LastN is the number of past messages delivered by DeliverLastNPolicy.
+}
+
+// ValidateDelivery checks that the delivery policy is known and
+// that its delivery start point is set.
+func (cfg SubscriberConfig) ValidateDelivery() error {
+	switch cfg.DeliveryPolicy {
+	case DeliverNewPolicy, DeliverAllPolicy:
+		return nil
+	case DeliverByStartTimePolicy:
+		if cfg.StartTime.IsZero() {
+			return ErrInvalidDeliveryPolicy
+		}
+	case DeliverFromSequencePolicy:
+		if cfg.StartSequence == 0 {
+			return ErrInvalidDeliveryPolicy
+		}
+	case DeliverLastNPolicy:
+		if cfg.LastN == 0 {
+			return ErrInvalidDeliveryPolicy
+		}
+	default:
+		return ErrInvalidDeliveryPolicy
+	}
+
+	return nil
 }
 
 // Subscriber specifies message subscription API.
@@ -0,0 +1,258 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	headerExchange   = "Smq-Dead-Letter-Exchange"
+	headerSubscriber = "Smq-Dead-Letter-Subscriber"
+	headerSubject    = "Smq-Dead-Letter-Subject"
+	headerError      = "Smq-Dead-Letter-Error"
+	headerAttempts   = "Smq-Dead-Letter-Attempts"
+	headerFailedAt   = "Smq-Dead-Letter-Failed-At"
+
+	dlqPrefix = "dlq"
+)
+
+// ErrEmptyDomain indicates that the message can not be dead-lettered
+// because it does not belong to any domain.
+var ErrEmptyDomain = errors.New("empty message domain")
+
+var _ messaging.DeadLetters = (*deadLetters)(nil)
+
+type deadLetters struct {
+	conn *amqp.Connection
+}
+
+// NewDeadLetters returns RabbitMQ dead-letter queues API.
+// Dead letters are stored in the durable queue per domain and are browsed by
+// fetching the messages without acknowledgement, so they are returned to the
+// queue once the browsing channel is closed.
+func NewDeadLetters(url string) (messaging.DeadLetters, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadLetters{conn: conn}, nil
+}
+
+func (dl *deadLetters) List(_ context.Context, domainID string, offset, limit uint64) (messaging.DeadLetterPage, error) {
+	page := messaging.DeadLetterPage{
+		Offset:      offset,
+		Limit:       limit,
+		DeadLetters: []messaging.DeadLetter{},
+	}
+	ch, err := dl.conn.Channel()
+	if err != nil {
+		return messaging.DeadLetterPage{}, err
+	}
+	defer ch.Close()
+
+	queue := deadLetterQueue(domainID)
+	q, err := ch.QueueDeclarePassive(queue, true, false, false, false, nil)
+	switch {
+	case isNotFound(err):
+		return page, nil
+	case err != nil:
+		return messaging.DeadLetterPage{}, err
+	}
+	page.Total = uint64(q.Messages)
+
+	for i := uint64(0); i < min(page.Total, offset+limit); i++ {
+		d, ok, err := ch.Get(queue, false)
+		if err != nil {
+			return messaging.DeadLetterPage{}, err
+		}
+		if !ok {
+			break
+		}
+		if i < offset {
+			continue
+		}
+		dead, err := toDeadLetter(d)
+		if err != nil {
+			return messaging.DeadLetterPage{}, err
+		}
+		page.DeadLetters = append(page.DeadLetters, dead)
+	}
+
+	return page, nil
+}
+
+func (dl *deadLetters) View(_ context.Context, domainID, id string) (messaging.DeadLetter, error) {
+	ch, err := dl.conn.Channel()
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	defer ch.Close()
+
+	d, err := find(ch, domainID, id)
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+
+	return toDeadLetter(d)
+}
+
+func (dl *deadLetters) Replay(ctx context.Context, domainID, id string) error {
+	ch, err := dl.conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	d, err := find(ch, domainID, id)
+	if err != nil {
+		return err
+	}
+	exchange, subject := deliveryOrigin(d)
+	pub := amqp.Publishing{
+		Headers:     amqp.Table{},
+		ContentType: d.ContentType,
+		AppId:       d.AppId,
+		Body:        d.Body,
+	}
+	if err := ch.PublishWithContext(ctx, exchange, subject, false, false, pub); err != nil {
+		return err
+	}
+
+	return d.Ack(false)
+}
+
+func (dl *deadLetters) Remove(_ context.Context, domainID, id string) error {
+	ch, err := dl.conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	d, err := find(ch, domainID, id)
+	if err != nil {
+		return err
+	}
+
+	return d.Ack(false)
+}
+
+func (dl *deadLetters) Close() error {
+	return dl.conn.Close()
+}
+
+// find fetches the domain dead-letter queue messages until the one with the given ID.
+// Fetched messages are not acknowledged, so closing the channel returns them to the queue.
+func find(ch *amqp.Channel, domainID, id string) (amqp.Delivery, error) {
+	queue := deadLetterQueue(domainID)
+	for {
+		d, ok, err := ch.Get(queue, false)
+		switch {
+		case isNotFound(err):
+			return amqp.Delivery{}, messaging.ErrDeadLetterNotFound
+		case err != nil:
+			return amqp.Delivery{}, err
+		case !ok:
+			return amqp.Delivery{}, messaging.ErrDeadLetterNotFound
+		case d.MessageId == id:
+			return d, nil
+		}
+	}
+}
+
+// deadLetter publishes the failed message to the dead-letter queue of its domain.
+func (ps *pubsub) deadLetter(subscriber string, d amqp.Delivery, msg *messaging.Message, attempts uint64, herr error) error {
+	if msg.GetDomain() == "" {
+		return ErrEmptyDomain
+	}
+	queue := deadLetterQueue(msg.GetDomain())
+	if _, err := ps.channel.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+		return err
+	}
+	exchange, subject := deliveryOrigin(d)
+	pub := amqp.Publishing{
+		Headers: amqp.Table{
+			headerExchange:   exchange,
+			headerSubscriber: subscriber,
+			headerSubject:    subject,
+			headerError:      herr.Error(),
+			headerAttempts:   int64(attempts),
+			headerFailedAt:   time.Now().UTC().Format(time.RFC3339Nano),
+		},
+		ContentType:  d.ContentType,
+		AppId:        d.AppId,
+		MessageId:    uuid.NewString(),
+		DeliveryMode: amqp.Persistent,
+		Body:         d.Body,
+	}
+
+	return ps.channel.PublishWithContext(context.Background(), "", queue, false, false, pub)
+}
+
+// deliveryOrigin returns the exchange and the routing key the message was originally published to.
+func deliveryOrigin(d amqp.Delivery) (string, string) {
+	exchange, subject := d.Exchange, d.RoutingKey
+	if e, ok := d.Headers[headerExchange].(string); ok {
+		exchange = e
+	}
+	if s, ok := d.Headers[headerSubject].(string); ok {
+		subject = s
+	}
+
+	return exchange, subject
+}
+
+// deliveryAttempts returns the number of the failed delivery attempts.
+func deliveryAttempts(d amqp.Delivery) uint64 {
+	if a, ok := d.Headers[headerAttempts].(int64); ok && a > 0 {
+		return uint64(a)
+	}
+
+	return 0
+}
+
+func deadLetterQueue(domainID string) string {
+	return fmt.Sprintf("%s.%s", dlqPrefix, domainID)
+}
+
+func isNotFound(err error) bool {
+	var amqpErr *amqp.Error
+	return errors.As(err, &amqpErr) && amqpErr.Code == amqp.NotFound
+}
+
+func toDeadLetter(d amqp.Delivery) (messaging.DeadLetter, error) {
+	var msg messaging.Message
+	if err := proto.Unmarshal(d.Body, &msg); err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	failedAt, err := time.Parse(time.RFC3339Nano, header(d, headerFailedAt))
+	if err != nil {
+		return messaging.DeadLetter{}, err
+	}
+	_, subject := deliveryOrigin(d)
+
+	return messaging.DeadLetter{
+		ID:         d.MessageId,
+		Subscriber: header(d, headerSubscriber),
+		Subject:    subject,
+		Error:      header(d, headerError),
+		Attempts:   deliveryAttempts(d),
+		FailedAt:   failedAt,
+		Message:    &msg,
+	}, nil
+}
+
+func header(d amqp.Delivery, key string) string {
+	v, _ := d.Headers[key].(string)
+	return v
+}
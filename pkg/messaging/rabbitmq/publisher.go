@@ -45,6 +45,9 @@ func NewPublisher(url string, opts ...messaging.Option) (messaging.Publisher, er
 	if err := ch.ExchangeDeclare(pub.exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
 		return nil, err
 	}
+	if err := declareHistory(ch, pub.exchange, pub.prefix); err != nil {
+		return nil, err
+	}
 	pub.channel = ch
 
 	return pub, nil
@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/absmach/supermq/pkg/messaging"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -30,6 +31,7 @@ var _ messaging.PubSub = (*pubsub)(nil)
 
 type subscription struct {
 	cancel func() error
+	replay bool
 }
 type pubsub struct {
 	publisher
@@ -66,6 +68,9 @@ func NewPubSub(url string, logger *slog.Logger, opts ...messaging.Option) (messa
 	if err := ch.ExchangeDeclare(exchangeName, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
 		return nil, err
 	}
+	if err := declareHistory(ch, ps.exchange, ps.prefix); err != nil {
+		return nil, err
+	}
 	ps.channel = ch
 
 	return ps, nil
@@ -78,6 +83,9 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 	if cfg.Topic == "" {
 		return ErrEmptyTopic
 	}
+	if err := cfg.ValidateDelivery(); err != nil {
+		return err
+	}
 	ps.mu.Lock()
 
 	cfg.Topic = formatTopic(cfg.Topic)
@@ -105,7 +113,21 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 
 	clientID := fmt.Sprintf("%s-%s", cfg.Topic, cfg.ID)
 
-	queue, err := ps.channel.QueueDeclare(clientID, true, false, false, false, nil)
+	// Replayed messages are consumed from the history stream instead of the subscriber queue.
+	if isReplay(cfg.DeliveryPolicy) {
+		cancel, err := ps.replay(cfg, clientID)
+		if err != nil {
+			return err
+		}
+		s[cfg.ID] = subscription{
+			cancel: cancel,
+			replay: true,
+		}
+		return nil
+	}
+
+	// The queue of the ephemeral subscription is deleted once it has no consumers.
+	queue, err := ps.channel.QueueDeclare(clientID, !cfg.Ephemeral, cfg.Ephemeral, false, false, nil)
 	if err != nil {
 		return err
 	}
@@ -114,11 +136,13 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 		return err
 	}
 
-	msgs, err := ps.channel.Consume(queue.Name, clientID, true, false, false, false, nil)
+	// Failed messages are acknowledged manually so that they can be retried or dead-lettered.
+	autoAck := !cfg.RetryPolicy.Enabled()
+	msgs, err := ps.channel.Consume(queue.Name, clientID, autoAck, false, false, false, nil)
 	if err != nil {
 		return err
 	}
-	go ps.handle(msgs, cfg.Handler)
+	go ps.handle(msgs, cfg, queue.Name)
 	s[cfg.ID] = subscription{
 		cancel: func() error {
 			if err := ps.channel.Cancel(clientID, false); err != nil {
@@ -157,8 +181,10 @@ func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
 			return err
 		}
 	}
-	if err := ps.channel.QueueUnbind(topic, topic, exchangeName, nil); err != nil {
-		return err
+	if !current.replay {
+		if err := ps.channel.QueueUnbind(topic, topic, exchangeName, nil); err != nil {
+			return err
+		}
 	}
 
 	delete(s, id)
@@ -168,20 +194,103 @@ func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
 	return nil
 }
 
-func (ps *pubsub) handle(deliveries <-chan amqp.Delivery, h messaging.MessageHandler) {
+func (ps *pubsub) handle(deliveries <-chan amqp.Delivery, cfg messaging.SubscriberConfig, queue string) {
 	for d := range deliveries {
 		var msg messaging.Message
 		if err := proto.Unmarshal(d.Body, &msg); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to unmarshal received message: %s", err))
-			return
+			if cfg.RetryPolicy.Enabled() {
+				ps.reject(d)
+			}
+			continue
 		}
-		if err := h.Handle(&msg); err != nil {
+		err := cfg.Handler.Handle(&msg)
+		if err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to handle SuperMQ message: %s", err))
-			return
 		}
+		if !cfg.RetryPolicy.Enabled() {
+			continue
+		}
+		if err == nil || !retriable(err) {
+			ps.ack(d)
+			continue
+		}
+		ps.retry(cfg, queue, d, &msg, err)
 	}
 }
 
+// retry republishes the failed message to the subscriber queue with backoff until
+// the retries are exhausted or the message is terminated, and then moves it to the
+// dead-letter queue of the message domain.
+func (ps *pubsub) retry(cfg messaging.SubscriberConfig, queue string, d amqp.Delivery, msg *messaging.Message, herr error) {
+	attempts := deliveryAttempts(d) + 1
+	terminated := false
+	if e, ok := herr.(messaging.Error); ok && e.Ack() == messaging.Term {
+		terminated = true
+	}
+	delay := cfg.RetryPolicy.Delay(attempts)
+	if !terminated && attempts <= cfg.RetryPolicy.MaxRetries {
+		exchange, subject := deliveryOrigin(d)
+		pub := amqp.Publishing{
+			Headers: amqp.Table{
+				headerExchange: exchange,
+				headerSubject:  subject,
+				headerAttempts: int64(attempts),
+			},
+			ContentType: d.ContentType,
+			AppId:       d.AppId,
+			Body:        d.Body,
+		}
+		// Republish and acknowledge after the delay without blocking the other messages.
+		time.AfterFunc(delay, func() {
+			if err := ps.channel.PublishWithContext(context.Background(), "", queue, false, false, pub); err != nil {
+				ps.logger.Warn(fmt.Sprintf("Failed to redeliver SuperMQ message: %s", err))
+				ps.requeue(d)
+				return
+			}
+			ps.ack(d)
+		})
+		return
+	}
+
+	if err := ps.deadLetter(cfg.ID, d, msg, attempts, herr); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to dead-letter SuperMQ message: %s", err))
+		time.AfterFunc(delay, func() { ps.requeue(d) })
+		return
+	}
+	ps.ack(d)
+}
+
+func (ps *pubsub) ack(d amqp.Delivery) {
+	if err := d.Ack(false); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to ack message: %s", err))
+	}
+}
+
+func (ps *pubsub) reject(d amqp.Delivery) {
+	if err := d.Reject(false); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to reject message: %s", err))
+	}
+}
+
+func (ps *pubsub) requeue(d amqp.Delivery) {
+	if err := d.Nack(false, true); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to requeue message: %s", err))
+	}
+}
+
+// retriable returns false if the handler error explicitly acknowledges the message.
+func retriable(err error) bool {
+	if e, ok := err.(messaging.Error); ok {
+		switch e.Ack() {
+		case messaging.Ack, messaging.DoubleAck:
+			return false
+		}
+	}
+
+	return true
+}
+
 func formatTopic(topic string) string {
 	return strings.ReplaceAll(topic, ">", "#")
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/absmach/supermq/pkg/messaging"
 	"github.com/absmach/supermq/pkg/messaging/rabbitmq"
@@ -49,10 +50,12 @@ func TestPublisher(t *testing.T) {
 	})
 
 	cases := []struct {
-		desc     string
-		channel  string
-		subtopic string
-		payload  []byte
+		desc        string
+		channel     string
+		subtopic    string
+		payload     []byte
+		contentType string
+		headers     map[string]string
 	}{
 		{
 			desc:    "publish message with nil payload",
@@ -78,14 +81,23 @@ func TestPublisher(t *testing.T) {
 			channel:  channel,
 			subtopic: subtopic,
 		},
+		{
+			desc:        "publish message with content type and headers",
+			payload:     data,
+			channel:     channel,
+			contentType: "text/plain",
+			headers:     map[string]string{"correlation-id": "42"},
+		},
 	}
 
 	for _, tc := range cases {
 		expectedMsg := messaging.Message{
-			Publisher: clientID,
-			Channel:   tc.channel,
-			Subtopic:  tc.subtopic,
-			Payload:   tc.payload,
+			Publisher:   clientID,
+			Channel:     tc.channel,
+			Subtopic:    tc.subtopic,
+			Payload:     tc.payload,
+			ContentType: tc.contentType,
+			Headers:     tc.headers,
 		}
 		err = pubsub.Publish(context.TODO(), topic, &expectedMsg)
 		assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error: %s", tc.desc, err))
@@ -97,6 +109,8 @@ func TestPublisher(t *testing.T) {
 		assert.Equal(t, expectedMsg.Publisher, receivedMsg.Publisher, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &expectedMsg, receivedMsg))
 		assert.Equal(t, expectedMsg.Subtopic, receivedMsg.Subtopic, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &expectedMsg, receivedMsg))
 		assert.Equal(t, expectedMsg.Payload, receivedMsg.Payload, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &expectedMsg, receivedMsg))
+		assert.Equal(t, expectedMsg.ContentType, receivedMsg.ContentType, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &expectedMsg, receivedMsg))
+		assert.Equal(t, expectedMsg.Headers, receivedMsg.Headers, fmt.Sprintf("%s: expected %+v got %+v\n", tc.desc, &expectedMsg, receivedMsg))
 	}
 }
 
@@ -458,3 +472,95 @@ func (h handler) Cancel() error {
 	}
 	return nil
 }
+
+func TestReplay(t *testing.T) {
+	replayTopic := fmt.Sprintf("%s.%s", topic, "replay")
+	var sent []*messaging.Message
+	var startTime time.Time
+	for i := range 3 {
+		if i == 1 {
+			startTime = time.Now()
+		}
+		msg := &messaging.Message{
+			Channel: channel,
+			Payload: fmt.Appendf(nil, "payload %d", i),
+			Created: time.Now().UnixNano(),
+		}
+		err := publisher.Publish(context.TODO(), replayTopic, msg)
+		assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+		sent = append(sent, msg)
+	}
+
+	cases := []struct {
+		desc     string
+		cfg      messaging.SubscriberConfig
+		expected []*messaging.Message
+		err      error
+	}{
+		{
+			desc:     "replay messages by start time",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy, StartTime: startTime},
+			expected: sent[1:],
+		},
+		{
+			desc:     "replay messages from sequence",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverFromSequencePolicy, StartSequence: 1},
+			expected: sent,
+		},
+		{
+			desc:     "replay last N messages",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy, LastN: 2},
+			expected: sent[1:],
+		},
+		{
+			desc:     "replay more messages than stored",
+			cfg:      messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy, LastN: 10},
+			expected: sent,
+		},
+		{
+			desc: "replay messages without start point",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msgs := make(chan *messaging.Message, len(sent))
+			tc.cfg.ID = fmt.Sprintf("replay-%d", i)
+			tc.cfg.Topic = fmt.Sprintf("%s.%s", msgPrefix, replayTopic)
+			tc.cfg.Handler = replayHandler{msgs: msgs}
+			err := pubsub.Subscribe(context.TODO(), tc.cfg)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %v got %v", tc.desc, tc.err, err))
+			if err != nil {
+				return
+			}
+			defer func() {
+				err := pubsub.Unsubscribe(context.TODO(), tc.cfg.ID, tc.cfg.Topic)
+				assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+			}()
+			for _, expected := range tc.expected {
+				select {
+				case msg := <-msgs:
+					assert.Equal(t, expected.Payload, msg.Payload, fmt.Sprintf("%s: expected %s got %s", tc.desc, expected.Payload, msg.Payload))
+				case <-time.After(5 * time.Second):
+					t.Fatalf("%s: timed out waiting for replayed message", tc.desc)
+				}
+			}
+		})
+	}
+}
+
+type replayHandler struct {
+	msgs chan *messaging.Message
+}
+
+func (h replayHandler) Handle(msg *messaging.Message) error {
+	h.msgs <- msg
+
+	return nil
+}
+
+func (h replayHandler) Cancel() error {
+	return nil
+}
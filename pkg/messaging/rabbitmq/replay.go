@@ -0,0 +1,186 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rabbitmq
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	streamOffsetArg = "x-stream-offset"
+	historySuffix   = "history"
+	// Messages are retained as long as in the NATS JetStream messages stream.
+	historyMaxAge = "24h"
+	// Stream queues can be consumed only with a prefetch limit.
+	replayPrefetch = 100
+	// Replay of the last N messages is considered caught up once no
+	// older message is received for this long.
+	replayIdleTimeout = time.Second
+)
+
+func historyQueue(prefix string) string {
+	return fmt.Sprintf("%s.%s", prefix, historySuffix)
+}
+
+// declareHistory declares the stream queue which retains all the messages
+// published with the prefix so that they can be replayed by the subscribers.
+func declareHistory(ch *amqp.Channel, exchange, prefix string) error {
+	args := amqp.Table{
+		amqp.QueueTypeArg:    amqp.QueueTypeStream,
+		amqp.StreamMaxAgeArg: historyMaxAge,
+	}
+	if _, err := ch.QueueDeclare(historyQueue(prefix), true, false, false, false, args); err != nil {
+		return err
+	}
+
+	return ch.QueueBind(historyQueue(prefix), prefix+".#", exchange, false, nil)
+}
+
+func isReplay(dp messaging.DeliveryPolicy) bool {
+	switch dp {
+	case messaging.DeliverByStartTimePolicy, messaging.DeliverFromSequencePolicy, messaging.DeliverLastNPolicy:
+		return true
+	default:
+		return false
+	}
+}
+
+// replay consumes the history stream from the offset defined by the delivery
+// policy on a dedicated channel and returns the subscription cancel function.
+func (ps *pubsub) replay(cfg messaging.SubscriberConfig, consumer string) (func() error, error) {
+	ch, err := ps.conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	if err := ch.Qos(replayPrefetch, 0, false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	var offset any = "first"
+	switch cfg.DeliveryPolicy {
+	case messaging.DeliverByStartTimePolicy:
+		offset = cfg.StartTime
+	case messaging.DeliverFromSequencePolicy:
+		offset = int64(cfg.StartSequence)
+	}
+	args := amqp.Table{streamOffsetArg: offset}
+	deliveries, err := ch.Consume(historyQueue(ps.prefix), consumer, false, false, false, false, args)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+	go ps.handleReplay(deliveries, cfg)
+
+	return func() error {
+		if err := ch.Close(); err != nil {
+			return err
+		}
+		return cfg.Handler.Cancel()
+	}, nil
+}
+
+// handleReplay handles the history stream messages which match the subscription topic.
+// Stream offsets are timestamp granular to the stream chunk, so the messages created
+// before the start time are skipped. For the last N messages policy, the messages
+// created before the subscription are buffered and only the last N are handled.
+func (ps *pubsub) handleReplay(deliveries <-chan amqp.Delivery, cfg messaging.SubscriberConfig) {
+	var (
+		start   = cfg.StartTime.UnixNano()
+		now     = time.Now().UnixNano()
+		pending []*messaging.Message
+		idle    <-chan time.Time
+	)
+	catchingUp := cfg.DeliveryPolicy == messaging.DeliverLastNPolicy
+	timer := time.NewTimer(replayIdleTimeout)
+	defer timer.Stop()
+	if catchingUp {
+		idle = timer.C
+	}
+	flush := func() {
+		for _, msg := range pending {
+			ps.handleReplayed(cfg, msg)
+		}
+		pending = nil
+		catchingUp = false
+		idle = nil
+	}
+
+	for {
+		select {
+		case d, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			// Stream queue acknowledgements only release the prefetch credit.
+			ps.ack(d)
+			if !matchTopic(cfg.Topic, d.RoutingKey) {
+				continue
+			}
+			var msg messaging.Message
+			if err := proto.Unmarshal(d.Body, &msg); err != nil {
+				ps.logger.Warn(fmt.Sprintf("Failed to unmarshal replayed message: %s", err))
+				continue
+			}
+			if cfg.DeliveryPolicy == messaging.DeliverByStartTimePolicy && msg.GetCreated() < start {
+				continue
+			}
+			if catchingUp {
+				if msg.GetCreated() < now {
+					pending = append(pending, &msg)
+					if uint64(len(pending)) > cfg.LastN {
+						pending = pending[1:]
+					}
+					timer.Reset(replayIdleTimeout)
+					continue
+				}
+				flush()
+			}
+			ps.handleReplayed(cfg, &msg)
+		case <-idle:
+			flush()
+		}
+	}
+}
+
+func (ps *pubsub) handleReplayed(cfg messaging.SubscriberConfig, msg *messaging.Message) {
+	if err := cfg.Handler.Handle(msg); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to handle replayed SuperMQ message: %s", err))
+	}
+}
+
+// matchTopic reports whether the routing key matches the topic exchange binding key.
+func matchTopic(pattern, key string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(key, "."))
+}
+
+func matchWords(pattern, key []string) bool {
+	for i, word := range pattern {
+		switch word {
+		case "#":
+			for j := i; j <= len(key); j++ {
+				if matchWords(pattern[i+1:], key[j:]) {
+					return true
+				}
+			}
+			return false
+		case "*":
+			if i >= len(key) {
+				return false
+			}
+		default:
+			if i >= len(key) || key[i] != word {
+				return false
+			}
+		}
+	}
+
+	return len(pattern) == len(key)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+// Replay query parameters of the protocol adapters subscriptions.
+const (
+	StartTimeKey     = "start_time"
+	StartSequenceKey = "start_seq"
+	LastKey          = "last"
+)
+
+// ErrMalformedReplay indicates malformed or conflicting replay options.
+var ErrMalformedReplay = errors.New("malformed replay options")
+
+// Replay defines the point in the topic history from which a subscription
+// starts delivering messages. At most one of the fields may be set. The zero
+// value delivers only the messages sent after the subscription.
+type Replay struct {
+	StartTime     time.Time
+	StartSequence uint64
+	LastN         uint64
+}
+
+// ParseReplay parses replay options from the subscription query parameters:
+// start_time (RFC3339), start_seq and last.
+func ParseReplay(query url.Values) (Replay, error) {
+	var r Replay
+	if v := query.Get(StartTimeKey); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return Replay{}, ErrMalformedReplay
+		}
+		r.StartTime = t
+	}
+	if v := query.Get(StartSequenceKey); v != "" {
+		seq, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || seq == 0 {
+			return Replay{}, ErrMalformedReplay
+		}
+		r.StartSequence = seq
+	}
+	if v := query.Get(LastKey); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || n == 0 {
+			return Replay{}, ErrMalformedReplay
+		}
+		r.LastN = n
+	}
+
+	return r, r.Validate()
+}
+
+// Validate checks that at most one replay start point is set.
+func (r Replay) Validate() error {
+	set := 0
+	if !r.StartTime.IsZero() {
+		set++
+	}
+	if r.StartSequence > 0 {
+		set++
+	}
+	if r.LastN > 0 {
+		set++
+	}
+	if set > 1 {
+		return ErrMalformedReplay
+	}
+
+	return nil
+}
+
+// Apply sets the subscriber delivery policy and start point. Subscriber
+// configuration is left unchanged if no replay start point is set.
+func (r Replay) Apply(cfg *SubscriberConfig) {
+	switch {
+	case !r.StartTime.IsZero():
+		cfg.DeliveryPolicy = DeliverByStartTimePolicy
+		cfg.StartTime = r.StartTime
+	case r.StartSequence > 0:
+		cfg.DeliveryPolicy = DeliverFromSequencePolicy
+		cfg.StartSequence = r.StartSequence
+	case r.LastN > 0:
+		cfg.DeliveryPolicy = DeliverLastNPolicy
+		cfg.LastN = r.LastN
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReplay(t *testing.T) {
+	startTime := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := []struct {
+		desc   string
+		query  url.Values
+		replay messaging.Replay
+		err    error
+	}{
+		{
+			desc:  "parse empty query",
+			query: url.Values{},
+		},
+		{
+			desc:   "parse start time",
+			query:  url.Values{messaging.StartTimeKey: {startTime.Format(time.RFC3339)}},
+			replay: messaging.Replay{StartTime: startTime},
+		},
+		{
+			desc:   "parse start sequence",
+			query:  url.Values{messaging.StartSequenceKey: {"42"}},
+			replay: messaging.Replay{StartSequence: 42},
+		},
+		{
+			desc:   "parse last",
+			query:  url.Values{messaging.LastKey: {"10"}},
+			replay: messaging.Replay{LastN: 10},
+		},
+		{
+			desc:  "parse malformed start time",
+			query: url.Values{messaging.StartTimeKey: {"yesterday"}},
+			err:   messaging.ErrMalformedReplay,
+		},
+		{
+			desc:  "parse zero start sequence",
+			query: url.Values{messaging.StartSequenceKey: {"0"}},
+			err:   messaging.ErrMalformedReplay,
+		},
+		{
+			desc:  "parse malformed last",
+			query: url.Values{messaging.LastKey: {"-1"}},
+			err:   messaging.ErrMalformedReplay,
+		},
+		{
+			desc:  "parse conflicting start points",
+			query: url.Values{messaging.StartSequenceKey: {"42"}, messaging.LastKey: {"10"}},
+			err:   messaging.ErrMalformedReplay,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			replay, err := messaging.ParseReplay(tc.query)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.replay, replay)
+			}
+		})
+	}
+}
+
+func TestReplayApply(t *testing.T) {
+	startTime := time.Now().UTC()
+
+	cases := []struct {
+		desc   string
+		replay messaging.Replay
+		cfg    messaging.SubscriberConfig
+	}{
+		{
+			desc:   "apply empty replay",
+			replay: messaging.Replay{},
+			cfg:    messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverNewPolicy},
+		},
+		{
+			desc:   "apply start time",
+			replay: messaging.Replay{StartTime: startTime},
+			cfg:    messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy, StartTime: startTime},
+		},
+		{
+			desc:   "apply start sequence",
+			replay: messaging.Replay{StartSequence: 42},
+			cfg:    messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverFromSequencePolicy, StartSequence: 42},
+		},
+		{
+			desc:   "apply last",
+			replay: messaging.Replay{LastN: 10},
+			cfg:    messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy, LastN: 10},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var cfg messaging.SubscriberConfig
+			tc.replay.Apply(&cfg)
+			assert.Equal(t, tc.cfg, cfg)
+			assert.Nil(t, cfg.ValidateDelivery())
+		})
+	}
+}
+
+func TestValidateDelivery(t *testing.T) {
+	cases := []struct {
+		desc string
+		cfg  messaging.SubscriberConfig
+		err  error
+	}{
+		{
+			desc: "validate deliver new policy",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverNewPolicy},
+		},
+		{
+			desc: "validate deliver all policy",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverAllPolicy},
+		},
+		{
+			desc: "validate deliver by start time policy without start time",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverByStartTimePolicy},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+		{
+			desc: "validate deliver from sequence policy without start sequence",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverFromSequencePolicy},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+		{
+			desc: "validate deliver last N policy without N",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliverLastNPolicy},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+		{
+			desc: "validate unknown delivery policy",
+			cfg:  messaging.SubscriberConfig{DeliveryPolicy: messaging.DeliveryPolicy(100)},
+			err:  messaging.ErrInvalidDeliveryPolicy,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.cfg.ValidateDelivery()
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
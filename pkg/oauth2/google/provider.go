@@ -25,6 +25,8 @@ const (
 	tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo?access_token="
 )
 
+var errEmailVerified = errors.New("email is not verified by Google")
+
 var scopes = []string{
 	"https://www.googleapis.com/auth/userinfo.email",
 	"https://www.googleapis.com/auth/userinfo.profile",
@@ -88,8 +90,12 @@ func (cfg *config) Exchange(ctx context.Context, code string) (oauth2.Token, err
 	return *token, nil
 }
 
-func (cfg *config) UserInfo(accessToken string) (uclient.User, error) {
-	resp, err := httpClient.Get(userInfoURL + url.QueryEscape(accessToken))
+func (cfg *config) UserInfo(ctx context.Context, token oauth2.Token) (uclient.User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userInfoURL+url.QueryEscape(token.AccessToken), nil)
+	if err != nil {
+		return uclient.User{}, err
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return uclient.User{}, err
 	}
@@ -108,6 +114,9 @@ func (cfg *config) UserInfo(accessToken string) (uclient.User, error) {
 	if err != nil {
 		return uclient.User{}, errors.Wrap(err, svcerr.ErrAuthentication)
 	}
+	if user.VerifiedAt.IsZero() {
+		return uclient.User{}, errors.Wrap(svcerr.ErrAuthentication, errEmailVerified)
+	}
 
 	return user, nil
 }
@@ -0,0 +1,92 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/absmach/supermq/pkg/oauth2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewAuthorizer creates a new instance of Authorizer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthorizer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Authorizer {
+	mock := &Authorizer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Authorizer is an autogenerated mock type for the Authorizer type
+type Authorizer struct {
+	mock.Mock
+}
+
+type Authorizer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Authorizer) EXPECT() *Authorizer_Expecter {
+	return &Authorizer_Expecter{mock: &_m.Mock}
+}
+
+// AuthCodeURL provides a mock function for the type Authorizer
+func (_mock *Authorizer) AuthCodeURL(flow oauth2.Flow) string {
+	ret := _mock.Called(flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthCodeURL")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(oauth2.Flow) string); ok {
+		r0 = returnFunc(flow)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// Authorizer_AuthCodeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthCodeURL'
+type Authorizer_AuthCodeURL_Call struct {
+	*mock.Call
+}
+
+// AuthCodeURL is a helper method to define mock.On call
+//   - flow oauth2.Flow
+func (_e *Authorizer_Expecter) AuthCodeURL(flow interface{}) *Authorizer_AuthCodeURL_Call {
+	return &Authorizer_AuthCodeURL_Call{Call: _e.mock.On("AuthCodeURL", flow)}
+}
+
+func (_c *Authorizer_AuthCodeURL_Call) Run(run func(flow oauth2.Flow)) *Authorizer_AuthCodeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 oauth2.Flow
+		if args[0] != nil {
+			arg0 = args[0].(oauth2.Flow)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Authorizer_AuthCodeURL_Call) Return(s string) *Authorizer_AuthCodeURL_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *Authorizer_AuthCodeURL_Call) RunAndReturn(run func(flow oauth2.Flow) string) *Authorizer_AuthCodeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
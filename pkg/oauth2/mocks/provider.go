@@ -330,8 +330,8 @@ func (_c *Provider_State_Call) RunAndReturn(run func() string) *Provider_State_C
 }
 
 // UserInfo provides a mock function for the type Provider
-func (_mock *Provider) UserInfo(accessToken string) (users.User, error) {
-	ret := _mock.Called(accessToken)
+func (_mock *Provider) UserInfo(ctx context.Context, token oauth2.Token) (users.User, error) {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UserInfo")
@@ -339,16 +339,16 @@ func (_mock *Provider) UserInfo(accessToken string) (users.User, error) {
 
 	var r0 users.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (users.User, error)); ok {
-		return returnFunc(accessToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, oauth2.Token) (users.User, error)); ok {
+		return returnFunc(ctx, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) users.User); ok {
-		r0 = returnFunc(accessToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, oauth2.Token) users.User); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
 		r0 = ret.Get(0).(users.User)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(accessToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, oauth2.Token) error); ok {
+		r1 = returnFunc(ctx, token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -361,19 +361,25 @@ type Provider_UserInfo_Call struct {
 }
 
 // UserInfo is a helper method to define mock.On call
-//   - accessToken string
-func (_e *Provider_Expecter) UserInfo(accessToken interface{}) *Provider_UserInfo_Call {
-	return &Provider_UserInfo_Call{Call: _e.mock.On("UserInfo", accessToken)}
+//   - ctx context.Context
+//   - token oauth2.Token
+func (_e *Provider_Expecter) UserInfo(ctx interface{}, token interface{}) *Provider_UserInfo_Call {
+	return &Provider_UserInfo_Call{Call: _e.mock.On("UserInfo", ctx, token)}
 }
 
-func (_c *Provider_UserInfo_Call) Run(run func(accessToken string)) *Provider_UserInfo_Call {
+func (_c *Provider_UserInfo_Call) Run(run func(ctx context.Context, token oauth2.Token)) *Provider_UserInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 oauth2.Token
+		if args[1] != nil {
+			arg1 = args[1].(oauth2.Token)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -384,7 +390,7 @@ func (_c *Provider_UserInfo_Call) Return(user users.User, err error) *Provider_U
 	return _c
 }
 
-func (_c *Provider_UserInfo_Call) RunAndReturn(run func(accessToken string) (users.User, error)) *Provider_UserInfo_Call {
+func (_c *Provider_UserInfo_Call) RunAndReturn(run func(ctx context.Context, token oauth2.Token) (users.User, error)) *Provider_UserInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/absmach/supermq/users"
 )
@@ -18,6 +19,7 @@ type normalizedUser struct {
 	Username  string `json:"username"`
 	Email     string `json:"email"`
 	Picture   string `json:"picture"`
+	Verified  bool   `json:"verified_email"`
 }
 
 func NormalizeUser(data []byte, provider string) (users.User, error) {
@@ -42,14 +44,19 @@ func NormalizeUser(data []byte, provider string) (users.User, error) {
 		return users.User{}, err
 	}
 
-	return users.User{
+	u := users.User{
 		ID:             user.ID,
 		FirstName:      user.FirstName,
 		LastName:       user.LastName,
 		Email:          user.Email,
 		ProfilePicture: user.Picture,
 		Metadata:       users.Metadata{"oauth_provider": provider},
-	}, nil
+	}
+	if user.Verified {
+		u.VerifiedAt = time.Now().UTC()
+	}
+
+	return u, nil
 }
 
 func normalizeProfile(raw map[string]any) map[string]any {
@@ -63,6 +70,7 @@ func normalizeProfile(raw map[string]any) map[string]any {
 		"email":      {"email", "email_address", "emailAddress"},
 		"picture":    {"picture", "profile_picture", "profilePicture", "avatar"},
 	}
+	verifiedKeys := []string{"verified_email", "email_verified"}
 
 	for stdKey, variants := range keyMap {
 		for _, variant := range variants {
@@ -72,6 +80,12 @@ func normalizeProfile(raw map[string]any) map[string]any {
 			}
 		}
 	}
+	for _, key := range verifiedKeys {
+		if val, ok := raw[key]; ok {
+			normalized["verified_email"] = isTrue(val)
+			break
+		}
+	}
 
 	return normalized
 }
@@ -95,3 +109,66 @@ func validateUser(user normalizedUser) error {
 	}
 	return nil
 }
+
+// ClaimMapping maps the identity provider claims onto the user fields.
+type ClaimMapping struct {
+	ID            string `env:"ID"             envDefault:"sub"`
+	Username      string `env:"USERNAME"       envDefault:"preferred_username"`
+	FirstName     string `env:"FIRST_NAME"     envDefault:"given_name"`
+	LastName      string `env:"LAST_NAME"      envDefault:"family_name"`
+	Email         string `env:"EMAIL"          envDefault:"email"`
+	Picture       string `env:"PICTURE"        envDefault:"picture"`
+	EmailVerified string `env:"EMAIL_VERIFIED" envDefault:"email_verified"`
+}
+
+// MapUser maps the claims onto the user. The ID and the email claims are
+// required, while the missing username is generated on the user registration.
+// The user is verified only if the provider asserts the email is verified.
+func MapUser(claims map[string]any, mapping ClaimMapping, provider string) (users.User, error) {
+	claim := func(name string) string {
+		if name == "" {
+			return ""
+		}
+		val, _ := claims[name].(string)
+		return val
+	}
+
+	user := users.User{
+		ID:             claim(mapping.ID),
+		FirstName:      claim(mapping.FirstName),
+		LastName:       claim(mapping.LastName),
+		Email:          claim(mapping.Email),
+		ProfilePicture: claim(mapping.Picture),
+		Credentials:    users.Credentials{Username: claim(mapping.Username)},
+		Metadata:       users.Metadata{"oauth_provider": provider},
+	}
+	if mapping.EmailVerified != "" && isTrue(claims[mapping.EmailVerified]) {
+		user.VerifiedAt = time.Now().UTC()
+	}
+
+	var missing []string
+	if user.ID == "" {
+		missing = append(missing, mapping.ID)
+	}
+	if user.Email == "" {
+		missing = append(missing, mapping.Email)
+	}
+	if len(missing) > 0 {
+		return users.User{}, fmt.Errorf("missing required claims: %s", strings.Join(missing, ", "))
+	}
+
+	return user, nil
+}
+
+// isTrue reports whether the claim is true. Some providers send the boolean
+// claims as strings.
+func isTrue(val any) bool {
+	switch v := val.(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
@@ -5,6 +5,7 @@ package oauth2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/absmach/supermq/users"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ func TestNormalizeUser(t *testing.T) {
 		inputJSON  string
 		provider   string
 		wantUser   users.User
+		verified   bool
 		wantErrStr string
 	}{
 		{
@@ -38,6 +40,25 @@ func TestNormalizeUser(t *testing.T) {
 			},
 			wantErrStr: "",
 		},
+		{
+			desc: "valid user with verified email",
+			inputJSON: `{
+				"id": "123",
+				"given_name": "Jane",
+				"family_name": "Doe",
+				"email": "jane@example.com",
+				"verified_email": true
+			}`,
+			provider: "google",
+			wantUser: users.User{
+				ID:        "123",
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Email:     "jane@example.com",
+				Metadata:  users.Metadata{"oauth_provider": "google"},
+			},
+			verified: true,
+		},
 		{
 			desc: "missing required fields",
 			inputJSON: `{
@@ -59,6 +80,8 @@ func TestNormalizeUser(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			user, err := NormalizeUser([]byte(tc.inputJSON), tc.provider)
+			assert.Equal(t, tc.verified, !user.VerifiedAt.IsZero())
+			user.VerifiedAt = time.Time{}
 			if tc.wantErrStr != "" {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tc.wantErrStr)
@@ -96,6 +119,17 @@ func TestNormalizeProfile(t *testing.T) {
 				"picture":    "pic.png",
 			},
 		},
+		{
+			desc: "maps email verification",
+			raw: map[string]any{
+				"id":             "id123",
+				"email_verified": "true",
+			},
+			expected: map[string]any{
+				"id":             "id123",
+				"verified_email": true,
+			},
+		},
 		{
 			desc:     "missing keys returns empty map",
 			raw:      map[string]any{"foo": "bar"},
@@ -155,3 +189,147 @@ func TestValidateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestMapUser(t *testing.T) {
+	mapping := ClaimMapping{
+		ID:            "oid",
+		Username:      "preferred_username",
+		FirstName:     "given_name",
+		LastName:      "family_name",
+		Email:         "upn",
+		Picture:       "picture",
+		EmailVerified: "email_verified",
+	}
+
+	cases := []struct {
+		desc       string
+		claims     map[string]any
+		mapping    ClaimMapping
+		wantUser   users.User
+		verified   bool
+		wantErrStr string
+	}{
+		{
+			desc: "map all claims",
+			claims: map[string]any{
+				"oid":                "id123",
+				"preferred_username": "jdoe",
+				"given_name":         "Jane",
+				"family_name":        "Doe",
+				"upn":                "jane@example.com",
+				"picture":            "pic.jpg",
+			},
+			mapping: mapping,
+			wantUser: users.User{
+				ID:             "id123",
+				FirstName:      "Jane",
+				LastName:       "Doe",
+				Email:          "jane@example.com",
+				ProfilePicture: "pic.jpg",
+				Credentials:    users.Credentials{Username: "jdoe"},
+				Metadata:       users.Metadata{"oauth_provider": "azure"},
+			},
+		},
+		{
+			desc: "map only required claims",
+			claims: map[string]any{
+				"oid": "id123",
+				"upn": "jane@example.com",
+			},
+			mapping: mapping,
+			wantUser: users.User{
+				ID:       "id123",
+				Email:    "jane@example.com",
+				Metadata: users.Metadata{"oauth_provider": "azure"},
+			},
+		},
+		{
+			desc: "map claims with verified email",
+			claims: map[string]any{
+				"oid":            "id123",
+				"upn":            "jane@example.com",
+				"email_verified": true,
+			},
+			mapping: mapping,
+			wantUser: users.User{
+				ID:       "id123",
+				Email:    "jane@example.com",
+				Metadata: users.Metadata{"oauth_provider": "azure"},
+			},
+			verified: true,
+		},
+		{
+			desc: "map claims with verified email as string",
+			claims: map[string]any{
+				"oid":            "id123",
+				"upn":            "jane@example.com",
+				"email_verified": "true",
+			},
+			mapping: mapping,
+			wantUser: users.User{
+				ID:       "id123",
+				Email:    "jane@example.com",
+				Metadata: users.Metadata{"oauth_provider": "azure"},
+			},
+			verified: true,
+		},
+		{
+			desc: "map claims with unverified email",
+			claims: map[string]any{
+				"oid":            "id123",
+				"upn":            "jane@example.com",
+				"email_verified": false,
+			},
+			mapping: mapping,
+			wantUser: users.User{
+				ID:       "id123",
+				Email:    "jane@example.com",
+				Metadata: users.Metadata{"oauth_provider": "azure"},
+			},
+		},
+		{
+			desc: "map claims with unmapped field",
+			claims: map[string]any{
+				"oid":                "id123",
+				"upn":                "jane@example.com",
+				"preferred_username": "jdoe",
+			},
+			mapping: ClaimMapping{ID: "oid", Email: "upn"},
+			wantUser: users.User{
+				ID:       "id123",
+				Email:    "jane@example.com",
+				Metadata: users.Metadata{"oauth_provider": "azure"},
+			},
+		},
+		{
+			desc: "map claims with non-string value",
+			claims: map[string]any{
+				"oid": 123,
+				"upn": "jane@example.com",
+			},
+			mapping:    mapping,
+			wantErrStr: "missing required claims: oid",
+		},
+		{
+			desc:       "map claims without required claims",
+			claims:     map[string]any{"given_name": "Jane"},
+			mapping:    mapping,
+			wantErrStr: "missing required claims: oid, upn",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			user, err := MapUser(tc.claims, tc.mapping, "azure")
+			assert.Equal(t, tc.verified, !user.VerifiedAt.IsZero())
+			user.VerifiedAt = time.Time{}
+			if tc.wantErrStr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErrStr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantUser, user)
+		})
+	}
+}
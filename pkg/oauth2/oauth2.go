@@ -5,11 +5,22 @@ package oauth2
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 
+	"github.com/absmach/supermq/pkg/errors"
 	"github.com/absmach/supermq/users"
 	"golang.org/x/oauth2"
 )
 
+const flowSecretSize = 32
+
+// ErrMissingFlow indicates that the authorization code is exchanged without
+// the flow it was issued for.
+var ErrMissingFlow = errors.New("missing OAuth2 flow")
+
+type flowKey struct{}
+
 // Config is the configuration for the OAuth2 provider.
 type Config struct {
 	ClientID     string `env:"CLIENT_ID"       envDefault:""`
@@ -39,6 +50,62 @@ type Provider interface {
 	// Exchange converts an authorization code into a token.
 	Exchange(ctx context.Context, code string) (oauth2.Token, error)
 
-	// UserInfo retrieves the user's information using the access token.
-	UserInfo(accessToken string) (users.User, error)
+	// UserInfo retrieves the user's information using the exchanged token.
+	// The user is returned only if the provider verified the user's email.
+	UserInfo(ctx context.Context, token oauth2.Token) (users.User, error)
+}
+
+// Authorizer is implemented by the providers which start the authorization
+// code flow themselves, so each flow is bound to its own state, nonce and
+// PKCE verifier instead of the static state.
+type Authorizer interface {
+	// AuthCodeURL returns the URL of the provider consent page for the flow.
+	AuthCodeURL(flow Flow) string
+}
+
+// Flow contains the per-login secrets of the authorization code flow. They
+// are kept by the user agent between the authorization request and the callback.
+type Flow struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// NewFlow generates the random secrets of the new authorization code flow.
+func NewFlow() (Flow, error) {
+	state, err := randomString()
+	if err != nil {
+		return Flow{}, err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return Flow{}, err
+	}
+
+	return Flow{
+		State:    state,
+		Nonce:    nonce,
+		Verifier: oauth2.GenerateVerifier(),
+	}, nil
+}
+
+// WithFlow returns the context carrying the authorization code flow the
+// code is exchanged for.
+func WithFlow(ctx context.Context, flow Flow) context.Context {
+	return context.WithValue(ctx, flowKey{}, flow)
+}
+
+// FlowFromContext returns the authorization code flow carried by the context.
+func FlowFromContext(ctx context.Context) (Flow, bool) {
+	flow, ok := ctx.Value(flowKey{}).(Flow)
+	return flow, ok
+}
+
+func randomString() (string, error) {
+	b := make([]byte, flowSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package oidc contains the generic OpenID Connect provider, configured by
+// the issuer discovery, which supports SuperMQ OAuth2 functionality for
+// identity providers such as Keycloak or Azure AD.
+package oidc
@@ -0,0 +1,338 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	mgoauth2 "github.com/absmach/supermq/pkg/oauth2"
+	"github.com/absmach/supermq/users"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/oauth2"
+)
+
+const (
+	discoveryPath  = "/.well-known/openid-configuration"
+	defTimeout     = 1 * time.Minute
+	keysCacheTTL   = 5 * time.Minute
+	idTokenKey     = "id_token"
+	nonceClaim     = "nonce"
+	subjectClaim   = "sub"
+	errorBodyBytes = 1024
+)
+
+var (
+	errDiscovery      = errors.New("failed to discover OpenID provider configuration")
+	errIssuerMismatch = errors.New("discovered issuer doesn't match the configured one")
+	errFetchKeys      = errors.New("failed to fetch OpenID provider keys")
+	errMissingIDToken = errors.New("token response doesn't contain ID token")
+	errInvalidIDToken = errors.New("invalid ID token")
+	errInvalidNonce   = errors.New("invalid ID token nonce")
+	errUserInfo       = errors.New("failed to retrieve user info")
+	errSubjectClaim   = errors.New("user info subject doesn't match the ID token subject")
+	errEmailVerified  = errors.New("email is not verified by the OpenID provider")
+)
+
+var _ mgoauth2.Provider = (*provider)(nil)
+
+var _ mgoauth2.Authorizer = (*provider)(nil)
+
+// Config is the configuration of the OpenID Connect provider.
+type Config struct {
+	ClientID     string                `env:"CLIENT_ID"     envDefault:""`
+	ClientSecret string                `env:"CLIENT_SECRET" envDefault:""`
+	RedirectURL  string                `env:"REDIRECT_URL"  envDefault:""`
+	IssuerURL    string                `env:"ISSUER_URL"    envDefault:""`
+	Scopes       []string              `env:"SCOPES"        envDefault:"openid,profile,email"`
+	Claims       mgoauth2.ClaimMapping `envPrefix:"CLAIM_"`
+}
+
+// discovery is the subset of the OpenID provider metadata used by the provider.
+type discovery struct {
+	Issuer           string `json:"issuer"`
+	AuthEndpoint     string `json:"authorization_endpoint"`
+	TokenEndpoint    string `json:"token_endpoint"`
+	UserInfoEndpoint string `json:"userinfo_endpoint"`
+	JWKSURI          string `json:"jwks_uri"`
+}
+
+type provider struct {
+	name          string
+	config        *oauth2.Config
+	issuer        string
+	userInfoURL   string
+	jwksURL       string
+	claims        mgoauth2.ClaimMapping
+	uiRedirectURL string
+	errorURL      string
+	httpClient    *http.Client
+
+	mu       sync.Mutex
+	keys     jwk.Set
+	cachedAt time.Time
+}
+
+// NewProvider returns a new OpenID Connect provider with the given name. The
+// provider endpoints are discovered from the issuer, so the issuer has to be
+// reachable. The disabled provider is returned without the discovery.
+func NewProvider(ctx context.Context, name string, cfg Config, uiRedirectURL, errorURL string) (mgoauth2.Provider, error) {
+	p := &provider{
+		name: name,
+		config: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+		},
+		issuer:        strings.TrimSuffix(cfg.IssuerURL, "/"),
+		claims:        cfg.Claims,
+		uiRedirectURL: uiRedirectURL,
+		errorURL:      errorURL,
+		httpClient:    &http.Client{Timeout: defTimeout},
+	}
+	if !p.IsEnabled() {
+		return p, nil
+	}
+	if !slices.Contains(p.config.Scopes, "openid") {
+		p.config.Scopes = append([]string{"openid"}, p.config.Scopes...)
+	}
+
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.config.Endpoint = oauth2.Endpoint{
+		AuthURL:  d.AuthEndpoint,
+		TokenURL: d.TokenEndpoint,
+	}
+	p.userInfoURL = d.UserInfoEndpoint
+	p.jwksURL = d.JWKSURI
+
+	return p, nil
+}
+
+func (p *provider) Name() string {
+	return p.name
+}
+
+// State returns the empty state, since the state is generated for each flow.
+func (p *provider) State() string {
+	return ""
+}
+
+func (p *provider) RedirectURL() string {
+	return p.uiRedirectURL
+}
+
+func (p *provider) ErrorURL() string {
+	return p.errorURL
+}
+
+func (p *provider) IsEnabled() bool {
+	return p.config.ClientID != "" && p.issuer != ""
+}
+
+func (p *provider) AuthCodeURL(flow mgoauth2.Flow) string {
+	return p.config.AuthCodeURL(flow.State,
+		oauth2.S256ChallengeOption(flow.Verifier),
+		oauth2.SetAuthURLParam(nonceClaim, flow.Nonce),
+	)
+}
+
+// Exchange exchanges the code with the PKCE verifier of the flow carried by
+// the context, and validates the returned ID token against the flow nonce.
+func (p *provider) Exchange(ctx context.Context, code string) (oauth2.Token, error) {
+	flow, ok := mgoauth2.FlowFromContext(ctx)
+	if !ok {
+		return oauth2.Token{}, mgoauth2.ErrMissingFlow
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(flow.Verifier))
+	if err != nil {
+		return oauth2.Token{}, err
+	}
+
+	idToken, ok := token.Extra(idTokenKey).(string)
+	if !ok || idToken == "" {
+		return oauth2.Token{}, errMissingIDToken
+	}
+	if err := p.validateIDToken(ctx, idToken, flow.Nonce); err != nil {
+		return oauth2.Token{}, err
+	}
+
+	return *token, nil
+}
+
+// UserInfo retrieves the user info with the access token. The user info has to
+// belong to the subject of the ID token, and the email has to be verified by
+// the provider.
+func (p *provider) UserInfo(ctx context.Context, token oauth2.Token) (users.User, error) {
+	idToken, ok := token.Extra(idTokenKey).(string)
+	if !ok || idToken == "" {
+		return users.User{}, errors.Wrap(svcerr.ErrAuthentication, errMissingIDToken)
+	}
+	keys, err := p.fetchKeys(ctx, false)
+	if err != nil {
+		return users.User{}, err
+	}
+	tkn, err := p.parseIDToken(idToken, keys)
+	if err != nil {
+		return users.User{}, errors.Wrap(svcerr.ErrAuthentication, errors.Wrap(errInvalidIDToken, err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userInfoURL, nil)
+	if err != nil {
+		return users.User{}, errors.Wrap(errUserInfo, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return users.User{}, errors.Wrap(errUserInfo, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return users.User{}, svcerr.ErrAuthentication
+	}
+
+	var claims map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return users.User{}, errors.Wrap(errUserInfo, err)
+	}
+	if sub, _ := claims[subjectClaim].(string); sub == "" || sub != tkn.Subject() {
+		return users.User{}, errors.Wrap(svcerr.ErrAuthentication, errSubjectClaim)
+	}
+
+	user, err := mgoauth2.MapUser(claims, p.claims, p.name)
+	if err != nil {
+		return users.User{}, errors.Wrap(err, svcerr.ErrAuthentication)
+	}
+	if user.VerifiedAt.IsZero() {
+		return users.User{}, errors.Wrap(svcerr.ErrAuthentication, errEmailVerified)
+	}
+
+	return user, nil
+}
+
+func (p *provider) discover(ctx context.Context) (discovery, error) {
+	var d discovery
+	if err := p.getJSON(ctx, p.issuer+discoveryPath, &d); err != nil {
+		return discovery{}, errors.Wrap(errDiscovery, err)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != p.issuer {
+		return discovery{}, errors.Wrap(errDiscovery, errIssuerMismatch)
+	}
+	if d.AuthEndpoint == "" || d.TokenEndpoint == "" || d.UserInfoEndpoint == "" || d.JWKSURI == "" {
+		return discovery{}, errors.Wrap(errDiscovery, errors.New("missing provider endpoints"))
+	}
+	p.issuer = d.Issuer
+
+	return d, nil
+}
+
+func (p *provider) validateIDToken(ctx context.Context, idToken, nonce string) error {
+	keys, err := p.fetchKeys(ctx, false)
+	if err != nil {
+		return err
+	}
+	tkn, err := p.parseIDToken(idToken, keys)
+	if err != nil {
+		// The provider may have rotated its keys since they were cached.
+		if keys, ferr := p.fetchKeys(ctx, true); ferr == nil {
+			tkn, err = p.parseIDToken(idToken, keys)
+		}
+		if err != nil {
+			return errors.Wrap(errInvalidIDToken, err)
+		}
+	}
+
+	claim, _ := tkn.Get(nonceClaim)
+	if tokenNonce, _ := claim.(string); tokenNonce == "" || tokenNonce != nonce {
+		return errInvalidNonce
+	}
+
+	return nil
+}
+
+func (p *provider) parseIDToken(idToken string, keys jwk.Set) (jwt.Token, error) {
+	return jwt.Parse(
+		[]byte(idToken),
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.config.ClientID),
+	)
+}
+
+func (p *provider) fetchKeys(ctx context.Context, forceRefresh bool) (jwk.Set, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !forceRefresh && p.keys != nil && time.Since(p.cachedAt) < keysCacheTTL {
+		return p.keys, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.jwksURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyBytes))
+		return nil, errors.Wrap(errFetchKeys, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body)))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	keys, err := jwk.Parse(data)
+	if err != nil {
+		return nil, errors.Wrap(errFetchKeys, err)
+	}
+	p.keys = keys
+	p.cachedAt = time.Now()
+
+	return keys, nil
+}
+
+func (p *provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyBytes))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
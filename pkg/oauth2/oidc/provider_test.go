@@ -0,0 +1,501 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	mgoauth2 "github.com/absmach/supermq/pkg/oauth2"
+	"github.com/absmach/supermq/pkg/oauth2/oidc"
+	"github.com/absmach/supermq/users"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+const (
+	clientID    = "supermq"
+	code        = "valid-code"
+	accessToken = "valid-access-token"
+	name        = "keycloak"
+)
+
+var (
+	errInvalidIDToken = errors.New("invalid ID token")
+	errInvalidNonce   = errors.New("invalid ID token nonce")
+	errMissingIDToken = errors.New("token response doesn't contain ID token")
+	errDiscovery      = errors.New("failed to discover OpenID provider configuration")
+	errSubjectClaim   = errors.New("user info subject doesn't match the ID token subject")
+	errEmailVerified  = errors.New("email is not verified by the OpenID provider")
+)
+
+// identityProvider is the fake OpenID provider which issues the ID token for
+// the code, once the code verifier matches the challenge of the authorization.
+type identityProvider struct {
+	*httptest.Server
+	key  jwk.Key
+	keys jwk.Set
+
+	mu        sync.Mutex
+	challenge string
+	nonce     string
+	// claims overrides the claims of the issued ID token.
+	claims map[string]any
+	// signKey signs the ID token instead of the published key.
+	signKey    jwk.Key
+	noIDToken  bool
+	issuer     string
+	userClaims map[string]any
+}
+
+func newIdentityProvider(t *testing.T) *identityProvider {
+	key, keys := newKey(t, "idp-key")
+	idp := &identityProvider{key: key, keys: keys}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		issuer := idp.URL
+		if idp.issuer != "" {
+			issuer = idp.issuer
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": idp.URL + "/auth",
+			"token_endpoint":         idp.URL + "/token",
+			"userinfo_endpoint":      idp.URL + "/userinfo",
+			"jwks_uri":               idp.URL + "/certs",
+		})
+	})
+	mux.HandleFunc("/certs", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(idp.keys)
+	})
+	mux.HandleFunc("/token", idp.token)
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+accessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(idp.userClaims)
+	})
+	idp.Server = httptest.NewServer(mux)
+
+	return idp
+}
+
+// authorize records the PKCE challenge and the nonce of the authorization URL,
+// as the user consent would.
+func (idp *identityProvider) authorize(t *testing.T, authURL string) {
+	u, err := url.Parse(authURL)
+	require.Nil(t, err, fmt.Sprintf("parsing authorization URL expected to succeed: %s", err))
+	q := u.Query()
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.challenge = q.Get("code_challenge")
+	idp.nonce = q.Get("nonce")
+}
+
+func (idp *identityProvider) token(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if r.PostForm.Get("code") != code || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	res := map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+	}
+	if !idp.noIDToken {
+		tkn := jwt.New()
+		claims := map[string]any{
+			jwt.IssuerKey:     idp.URL,
+			jwt.SubjectKey:    "user-id",
+			jwt.AudienceKey:   []string{clientID},
+			jwt.IssuedAtKey:   time.Now(),
+			jwt.ExpirationKey: time.Now().Add(time.Minute),
+			"nonce":           idp.nonce,
+		}
+		for k, v := range idp.claims {
+			claims[k] = v
+		}
+		for k, v := range claims {
+			_ = tkn.Set(k, v)
+		}
+		key := idp.key
+		if idp.signKey != nil {
+			key = idp.signKey
+		}
+		signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.RS256, key))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		res["id_token"] = string(signed)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func newKey(t *testing.T, kid string) (jwk.Key, jwk.Set) {
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err, fmt.Sprintf("generating RSA key expected to succeed: %s", err))
+	key, err := jwk.FromRaw(raw)
+	require.Nil(t, err, fmt.Sprintf("creating JWK expected to succeed: %s", err))
+	require.Nil(t, key.Set(jwk.KeyIDKey, kid))
+	require.Nil(t, key.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	set := jwk.NewSet()
+	require.Nil(t, set.AddKey(key))
+	public, err := jwk.PublicSetOf(set)
+	require.Nil(t, err, fmt.Sprintf("creating public JWKS expected to succeed: %s", err))
+
+	return key, public
+}
+
+func newConfig(issuer string) oidc.Config {
+	return oidc.Config{
+		ClientID:     clientID,
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/oauth/callback/" + name,
+		IssuerURL:    issuer,
+		Scopes:       []string{"profile", "email"},
+		Claims: mgoauth2.ClaimMapping{
+			ID:            "sub",
+			Username:      "preferred_username",
+			FirstName:     "given_name",
+			LastName:      "family_name",
+			Email:         "email",
+			Picture:       "picture",
+			EmailVerified: "email_verified",
+		},
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	idp := newIdentityProvider(t)
+	defer idp.Close()
+
+	cases := []struct {
+		desc    string
+		cfg     oidc.Config
+		issuer  string
+		enabled bool
+		err     error
+	}{
+		{
+			desc:    "create provider with discovery",
+			cfg:     newConfig(idp.URL),
+			enabled: true,
+		},
+		{
+			desc:    "create provider with issuer with trailing slash",
+			cfg:     newConfig(idp.URL + "/"),
+			enabled: true,
+		},
+		{
+			desc:    "create disabled provider",
+			cfg:     oidc.Config{},
+			enabled: false,
+		},
+		{
+			desc:   "create provider with mismatched issuer",
+			cfg:    newConfig(idp.URL),
+			issuer: "http://other-issuer",
+			err:    errDiscovery,
+		},
+		{
+			desc: "create provider with unreachable issuer",
+			cfg:  newConfig(idp.URL + "/unknown"),
+			err:  errDiscovery,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			idp.issuer = tc.issuer
+			p, err := oidc.NewProvider(context.Background(), name, tc.cfg, "", "")
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, name, p.Name())
+				assert.Equal(t, tc.enabled, p.IsEnabled(), fmt.Sprintf("%s: expected enabled %t got %t", tc.desc, tc.enabled, p.IsEnabled()))
+			}
+		})
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	idp := newIdentityProvider(t)
+	defer idp.Close()
+
+	p, err := oidc.NewProvider(context.Background(), name, newConfig(idp.URL), "", "")
+	require.Nil(t, err, fmt.Sprintf("creating provider expected to succeed: %s", err))
+	flow, err := mgoauth2.NewFlow()
+	require.Nil(t, err, fmt.Sprintf("creating flow expected to succeed: %s", err))
+
+	authURL, err := url.Parse(p.(mgoauth2.Authorizer).AuthCodeURL(flow))
+	require.Nil(t, err, fmt.Sprintf("parsing authorization URL expected to succeed: %s", err))
+	q := authURL.Query()
+	sum := sha256.Sum256([]byte(flow.Verifier))
+
+	assert.Equal(t, idp.URL+"/auth", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	assert.Equal(t, flow.State, q.Get("state"))
+	assert.Equal(t, flow.Nonce, q.Get("nonce"))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:]), q.Get("code_challenge"))
+	assert.Equal(t, "openid profile email", q.Get("scope"))
+	assert.Equal(t, clientID, q.Get("client_id"))
+}
+
+func TestExchange(t *testing.T) {
+	idp := newIdentityProvider(t)
+	defer idp.Close()
+
+	p, err := oidc.NewProvider(context.Background(), name, newConfig(idp.URL), "", "")
+	require.Nil(t, err, fmt.Sprintf("creating provider expected to succeed: %s", err))
+	authorizer := p.(mgoauth2.Authorizer)
+	otherKey, _ := newKey(t, "other-key")
+
+	cases := []struct {
+		desc      string
+		code      string
+		noFlow    bool
+		verifier  string
+		claims    map[string]any
+		signKey   jwk.Key
+		noIDToken bool
+		err       error
+	}{
+		{
+			desc: "exchange code successfully",
+			code: code,
+		},
+		{
+			desc:   "exchange code without flow",
+			code:   code,
+			noFlow: true,
+			err:    mgoauth2.ErrMissingFlow,
+		},
+		{
+			desc: "exchange invalid code",
+			code: "invalid",
+			err:  errors.New(`oauth2: "invalid_grant"`),
+		},
+		{
+			desc:     "exchange code with wrong verifier",
+			code:     code,
+			verifier: "wrong-verifier-wrong-verifier-wrong-verifier",
+			err:      errors.New(`oauth2: "invalid_grant"`),
+		},
+		{
+			desc:   "exchange code with ID token with wrong nonce",
+			code:   code,
+			claims: map[string]any{"nonce": "other"},
+			err:    errInvalidNonce,
+		},
+		{
+			desc:   "exchange code with ID token without nonce",
+			code:   code,
+			claims: map[string]any{"nonce": ""},
+			err:    errInvalidNonce,
+		},
+		{
+			desc:   "exchange code with ID token for other audience",
+			code:   code,
+			claims: map[string]any{jwt.AudienceKey: []string{"other"}},
+			err:    errInvalidIDToken,
+		},
+		{
+			desc:   "exchange code with ID token of other issuer",
+			code:   code,
+			claims: map[string]any{jwt.IssuerKey: "http://other-issuer"},
+			err:    errInvalidIDToken,
+		},
+		{
+			desc:   "exchange code with expired ID token",
+			code:   code,
+			claims: map[string]any{jwt.ExpirationKey: time.Now().Add(-time.Minute)},
+			err:    errInvalidIDToken,
+		},
+		{
+			desc:    "exchange code with ID token signed by unknown key",
+			code:    code,
+			signKey: otherKey,
+			err:     errInvalidIDToken,
+		},
+		{
+			desc:      "exchange code without ID token",
+			code:      code,
+			noIDToken: true,
+			err:       errMissingIDToken,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			flow, err := mgoauth2.NewFlow()
+			require.Nil(t, err, fmt.Sprintf("creating flow expected to succeed: %s", err))
+			idp.authorize(t, authorizer.AuthCodeURL(flow))
+			idp.claims = tc.claims
+			idp.signKey = tc.signKey
+			idp.noIDToken = tc.noIDToken
+
+			if tc.verifier != "" {
+				flow.Verifier = tc.verifier
+			}
+			ctx := context.Background()
+			if !tc.noFlow {
+				ctx = mgoauth2.WithFlow(ctx, flow)
+			}
+			token, err := p.Exchange(ctx, tc.code)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, accessToken, token.AccessToken, fmt.Sprintf("%s: expected access token %s got %s", tc.desc, accessToken, token.AccessToken))
+			}
+		})
+	}
+}
+
+func TestUserInfo(t *testing.T) {
+	idp := newIdentityProvider(t)
+	defer idp.Close()
+
+	cfg := newConfig(idp.URL)
+	cfg.Claims.Email = "upn"
+	p, err := oidc.NewProvider(context.Background(), name, cfg, "", "")
+	require.Nil(t, err, fmt.Sprintf("creating provider expected to succeed: %s", err))
+
+	flow, err := mgoauth2.NewFlow()
+	require.Nil(t, err, fmt.Sprintf("creating flow expected to succeed: %s", err))
+	idp.authorize(t, p.(mgoauth2.Authorizer).AuthCodeURL(flow))
+	token, err := p.Exchange(mgoauth2.WithFlow(context.Background(), flow), code)
+	require.Nil(t, err, fmt.Sprintf("exchanging code expected to succeed: %s", err))
+	invalidToken := token
+	invalidToken.AccessToken = "invalid"
+
+	cases := []struct {
+		desc     string
+		token    oauth2.Token
+		claims   map[string]any
+		user     users.User
+		verified bool
+		err      error
+	}{
+		{
+			desc:  "retrieve user info with mapped claims",
+			token: token,
+			claims: map[string]any{
+				"sub":                "user-id",
+				"preferred_username": "jdoe",
+				"given_name":         "Jane",
+				"family_name":        "Doe",
+				"upn":                "jane@example.com",
+				"picture":            "https://example.com/jane.png",
+				"email_verified":     true,
+			},
+			user: users.User{
+				ID:             "user-id",
+				FirstName:      "Jane",
+				LastName:       "Doe",
+				Email:          "jane@example.com",
+				ProfilePicture: "https://example.com/jane.png",
+				Credentials:    users.Credentials{Username: "jdoe"},
+				Metadata:       users.Metadata{"oauth_provider": name},
+			},
+			verified: true,
+		},
+		{
+			desc:  "retrieve user info without mapped email claim",
+			token: token,
+			claims: map[string]any{
+				"sub":            "user-id",
+				"email":          "jane@example.com",
+				"email_verified": true,
+			},
+			err: svcerr.ErrAuthentication,
+		},
+		{
+			desc:  "retrieve user info with unverified email",
+			token: token,
+			claims: map[string]any{
+				"sub":            "user-id",
+				"upn":            "jane@example.com",
+				"email_verified": false,
+			},
+			err: errEmailVerified,
+		},
+		{
+			desc:  "retrieve user info without email verification claim",
+			token: token,
+			claims: map[string]any{
+				"sub": "user-id",
+				"upn": "jane@example.com",
+			},
+			err: errEmailVerified,
+		},
+		{
+			desc:  "retrieve user info of other subject than ID token",
+			token: token,
+			claims: map[string]any{
+				"sub":            "other-id",
+				"upn":            "jane@example.com",
+				"email_verified": true,
+			},
+			err: errSubjectClaim,
+		},
+		{
+			desc:  "retrieve user info without ID token",
+			token: oauth2.Token{AccessToken: accessToken},
+			claims: map[string]any{
+				"sub":            "user-id",
+				"upn":            "jane@example.com",
+				"email_verified": true,
+			},
+			err: errMissingIDToken,
+		},
+		{
+			desc:  "retrieve user info with invalid access token",
+			token: invalidToken,
+			err:   svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			idp.userClaims = tc.claims
+			user, err := p.UserInfo(context.Background(), tc.token)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.verified, !user.VerifiedAt.IsZero(), fmt.Sprintf("%s: expected verified %t", tc.desc, tc.verified))
+			user.VerifiedAt = time.Time{}
+			assert.Equal(t, tc.user, user, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.user, user))
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit contains the domain message rate limiter used by the
+// protocol adapters to enforce the message rate quotas of the domains.
+package ratelimit
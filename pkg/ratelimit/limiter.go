@@ -0,0 +1,157 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/dgraph-io/ristretto/v2"
+)
+
+// ErrRateExceeded indicates that the domain exceeded its message rate quota.
+var ErrRateExceeded = errors.New("domain message rate quota exceeded")
+
+const (
+	// idleBucket is the time in which the bucket is refilled, so the bucket
+	// which is idle for longer doesn't differ from the new one.
+	idleBucket = time.Second
+	// sweepInterval is the interval of the idle buckets eviction.
+	sweepInterval = time.Minute
+)
+
+var (
+	errCreateCache    = errors.New("failed to create quotas cache")
+	errRetrieveQuotas = errors.New("failed to retrieve domain quotas")
+)
+
+// Limiter limits the rate of the messages published to the domains.
+type Limiter interface {
+	// Allow returns ErrRateExceeded if the message published to the domain
+	// exceeds the domain message rate. Domains without the message rate
+	// quota accept any number of messages.
+	Allow(ctx context.Context, domainID string) error
+}
+
+// bucket is the token bucket which holds up to one second worth of messages.
+type bucket struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) take(rate uint64, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	limit := float64(rate)
+	if b.last.IsZero() {
+		b.tokens = limit
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * limit
+	}
+	b.last = now
+	if b.tokens > limit {
+		b.tokens = limit
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
+
+func (b *bucket) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return now.Sub(b.last) >= idleBucket
+}
+
+type limiter struct {
+	domains grpcDomainsV1.DomainsServiceClient
+	rates   *ristretto.Cache[string, uint64]
+	ttl     time.Duration
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+// NewLimiter returns the Limiter that retrieves the domain message rates from
+// the domains service and caches them for the given TTL. The rate is enforced
+// by every limiter instance on its own, so the domain can publish up to the
+// rate to each of the adapter instances. The buckets of the domains which
+// stopped publishing are evicted, so they don't pile up in the limiter.
+func NewLimiter(cfg messaging.CacheConfig, ttl time.Duration, domains grpcDomainsV1.DomainsServiceClient) (Limiter, error) {
+	rates, err := ristretto.NewCache(&ristretto.Config[string, uint64]{
+		NumCounters: cfg.NumCounters,
+		MaxCost:     cfg.MaxCost,
+		BufferItems: cfg.BufferItems,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errCreateCache, err)
+	}
+
+	return &limiter{
+		domains: domains,
+		rates:   rates,
+		ttl:     ttl,
+		buckets: make(map[string]*bucket),
+	}, nil
+}
+
+func (l *limiter) Allow(ctx context.Context, domainID string) error {
+	rate, ok := l.rates.Get(domainID)
+	if !ok {
+		res, err := l.domains.RetrieveQuotas(ctx, &grpcCommonV1.RetrieveEntityReq{Id: domainID})
+		if err != nil {
+			return errors.Wrap(errRetrieveQuotas, err)
+		}
+		rate = res.GetQuotas().GetMessageRate()
+		l.rates.SetWithTTL(domainID, rate, 1, l.ttl)
+	}
+	if rate == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if !l.bucket(domainID, now).take(rate, now) {
+		return ErrRateExceeded
+	}
+
+	return nil
+}
+
+func (l *limiter) bucket(domainID string, now time.Time) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.swept) >= sweepInterval {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[domainID]
+	if !ok {
+		b = &bucket{}
+		l.buckets[domainID] = b
+	}
+
+	return b
+}
+
+// sweep evicts the idle buckets. These buckets are full,
+// so evicting them doesn't change the domains rates.
+func (l *limiter) sweep(now time.Time) {
+	for id, b := range l.buckets {
+		if b.idle(now) {
+			delete(l.buckets, id)
+		}
+	}
+	l.swept = now
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	grpcCommonV1 "github.com/absmach/supermq/api/grpc/common/v1"
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
+	"github.com/absmach/supermq/domains/mocks"
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllow(t *testing.T) {
+	domains := new(mocks.DomainsServiceClient)
+	limiter, err := ratelimit.NewLimiter(messaging.DefaultCacheConfig, time.Minute, domains)
+	require.Nil(t, err, fmt.Sprintf("unexpected error while creating limiter: %s", err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		rate     uint64
+		retErr   error
+		messages int
+		errs     []error
+	}{
+		{
+			desc:     "publish to domain without message rate quota",
+			domainID: testsutil.GenerateUUID(t),
+			rate:     0,
+			messages: 5,
+			errs:     []error{nil, nil, nil, nil, nil},
+		},
+		{
+			desc:     "publish to domain within message rate quota",
+			domainID: testsutil.GenerateUUID(t),
+			rate:     3,
+			messages: 3,
+			errs:     []error{nil, nil, nil},
+		},
+		{
+			desc:     "publish to domain exceeding message rate quota",
+			domainID: testsutil.GenerateUUID(t),
+			rate:     2,
+			messages: 4,
+			errs:     []error{nil, nil, ratelimit.ErrRateExceeded, ratelimit.ErrRateExceeded},
+		},
+		{
+			desc:     "publish with failed to retrieve domain quotas",
+			domainID: testsutil.GenerateUUID(t),
+			retErr:   svcerr.ErrNotFound,
+			messages: 1,
+			errs:     []error{svcerr.ErrNotFound},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res := &grpcDomainsV1.RetrieveQuotasRes{Quotas: &grpcDomainsV1.Quotas{MessageRate: tc.rate}}
+			domainsCall := domains.On("RetrieveQuotas", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: tc.domainID}).Return(res, tc.retErr)
+			for i := 0; i < tc.messages; i++ {
+				err := limiter.Allow(context.Background(), tc.domainID)
+				assert.True(t, errors.Contains(err, tc.errs[i]), fmt.Sprintf("%s: message %d: expected %s got %s\n", tc.desc, i, tc.errs[i], err))
+				if tc.errs[i] == nil {
+					assert.Nil(t, err, fmt.Sprintf("%s: message %d: unexpected error %s\n", tc.desc, i, err))
+				}
+			}
+			domainsCall.Unset()
+		})
+	}
+}
+
+func TestAllowRefill(t *testing.T) {
+	domains := new(mocks.DomainsServiceClient)
+	limiter, err := ratelimit.NewLimiter(messaging.DefaultCacheConfig, time.Minute, domains)
+	require.Nil(t, err, fmt.Sprintf("unexpected error while creating limiter: %s", err))
+
+	domainID := testsutil.GenerateUUID(t)
+	res := &grpcDomainsV1.RetrieveQuotasRes{Quotas: &grpcDomainsV1.Quotas{MessageRate: 10}}
+	domains.On("RetrieveQuotas", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: domainID}).Return(res, nil)
+
+	for i := 0; i < 10; i++ {
+		err := limiter.Allow(context.Background(), domainID)
+		assert.Nil(t, err, fmt.Sprintf("message %d: unexpected error %s", i, err))
+	}
+	err = limiter.Allow(context.Background(), domainID)
+	assert.True(t, errors.Contains(err, ratelimit.ErrRateExceeded), fmt.Sprintf("expected %s got %s", ratelimit.ErrRateExceeded, err))
+
+	// The bucket refills one message every 100 milliseconds.
+	time.Sleep(200 * time.Millisecond)
+	err = limiter.Allow(context.Background(), domainID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error after refill %s", err))
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweep(t *testing.T) {
+	now := time.Now()
+	l := &limiter{
+		buckets: map[string]*bucket{},
+		swept:   now,
+	}
+
+	idle := l.bucket("idle", now)
+	idle.take(10, now)
+	active := l.bucket("active", now)
+	active.take(10, now)
+
+	// The buckets aren't swept before the sweep interval.
+	later := now.Add(sweepInterval / 2)
+	active.take(10, later)
+	l.bucket("new", later)
+	assert.Len(t, l.buckets, 3, fmt.Sprintf("expected 3 buckets got %d", len(l.buckets)))
+
+	sweep := now.Add(sweepInterval)
+	active.take(10, sweep.Add(-idleBucket/2))
+	l.bucket("active", sweep)
+	assert.Contains(t, l.buckets, "active", "expected active bucket to be retained")
+	assert.NotContains(t, l.buckets, "idle", "expected idle bucket to be evicted")
+	assert.NotContains(t, l.buckets, "new", "expected unused bucket to be evicted")
+	assert.Equal(t, sweep, l.swept, fmt.Sprintf("expected sweep at %s got %s", sweep, l.swept))
+}
@@ -101,36 +101,10 @@ func (r ProvisionManageService) validateActions(actions []Action) error {
 }
 
 func (r ProvisionManageService) RemoveEntitiesRoles(ctx context.Context, domainID, userID string, entityIDs []string, optionalFilterDeletePolicies []policies.Policy, optionalDeletePolicies []policies.Policy) error {
-	ears, emrs, err := r.repo.RetrieveEntitiesRolesActionsMembers(ctx, entityIDs)
-	if err != nil {
+	if err := RemoveEntitiesRolesPolicies(ctx, r.repo, r.policy, r.entityType, domainID, entityIDs); err != nil {
 		return err
 	}
 
-	deletePolicies := []policies.Policy{}
-	for _, ear := range ears {
-		deletePolicies = append(deletePolicies, policies.Policy{
-			Subject:         ear.RoleID,
-			SubjectRelation: policies.MemberRelation,
-			SubjectType:     policies.RoleType,
-			Relation:        ear.Action,
-			ObjectType:      r.entityType,
-			Object:          ear.EntityID,
-		})
-	}
-	for _, emr := range emrs {
-		deletePolicies = append(deletePolicies, policies.Policy{
-			Subject:     policies.EncodeDomainUserID(domainID, emr.MemberID),
-			SubjectType: policies.UserType,
-			Relation:    policies.MemberRelation,
-			ObjectType:  policies.RoleType,
-			Object:      emr.RoleID,
-		})
-	}
-
-	if err := r.policy.DeletePolicies(ctx, deletePolicies); err != nil {
-		return errors.Wrap(svcerr.ErrDeletePolicies, err)
-	}
-
 	if len(optionalDeletePolicies) > 1 {
 		if err := r.policy.DeletePolicies(ctx, optionalDeletePolicies); err != nil {
 			return errors.Wrap(errRemoveOptionalDeletePolicies, err)
@@ -651,3 +625,39 @@ func (r ProvisionManageService) RemoveMemberFromAllRoles(ctx context.Context, se
 
 	return fmt.Errorf("not implemented")
 }
+
+// RemoveEntitiesRolesPolicies removes the policies of the entities roles actions
+// and the policies of the roles members.
+func RemoveEntitiesRolesPolicies(ctx context.Context, repo Repository, policy policies.Service, entityType, domainID string, entityIDs []string) error {
+	ears, emrs, err := repo.RetrieveEntitiesRolesActionsMembers(ctx, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	deletePolicies := []policies.Policy{}
+	for _, ear := range ears {
+		deletePolicies = append(deletePolicies, policies.Policy{
+			Subject:         ear.RoleID,
+			SubjectRelation: policies.MemberRelation,
+			SubjectType:     policies.RoleType,
+			Relation:        ear.Action,
+			ObjectType:      entityType,
+			Object:          ear.EntityID,
+		})
+	}
+	for _, emr := range emrs {
+		deletePolicies = append(deletePolicies, policies.Policy{
+			Subject:     policies.EncodeDomainUserID(domainID, emr.MemberID),
+			SubjectType: policies.UserType,
+			Relation:    policies.MemberRelation,
+			ObjectType:  policies.RoleType,
+			Object:      emr.RoleID,
+		})
+	}
+
+	if err := policy.DeletePolicies(ctx, deletePolicies); err != nil {
+		return errors.Wrap(svcerr.ErrDeletePolicies, err)
+	}
+
+	return nil
+}
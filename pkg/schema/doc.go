@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema contains the channel message schema model and the
+// payload validation used by the protocol adapters.
+package schema
@@ -0,0 +1,111 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewValidator creates a new instance of Validator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewValidator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Validator {
+	mock := &Validator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Validator is an autogenerated mock type for the Validator type
+type Validator struct {
+	mock.Mock
+}
+
+type Validator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Validator) EXPECT() *Validator_Expecter {
+	return &Validator_Expecter{mock: &_m.Mock}
+}
+
+// Validate provides a mock function for the type Validator
+func (_mock *Validator) Validate(ctx context.Context, domainID string, channelID string, payload []byte) error {
+	ret := _mock.Called(ctx, domainID, channelID, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = returnFunc(ctx, domainID, channelID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Validator_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type Validator_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - channelID string
+//   - payload []byte
+func (_e *Validator_Expecter) Validate(ctx interface{}, domainID interface{}, channelID interface{}, payload interface{}) *Validator_Validate_Call {
+	return &Validator_Validate_Call{Call: _e.mock.On("Validate", ctx, domainID, channelID, payload)}
+}
+
+func (_c *Validator_Validate_Call) Run(run func(ctx context.Context, domainID string, channelID string, payload []byte)) *Validator_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Validator_Validate_Call) Return(err error) *Validator_Validate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Validator_Validate_Call) RunAndReturn(run func(ctx context.Context, domainID string, channelID string, payload []byte) error) *Validator_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
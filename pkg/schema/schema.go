@@ -0,0 +1,151 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/absmach/senml"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Type represents the type of the channel schema.
+type Type string
+
+const (
+	// JSONType is the type of the schema that validates JSON payloads using JSON Schema.
+	JSONType Type = "json"
+	// SenMLType is the type of the schema that constrains SenML record names and units.
+	SenMLType Type = "senml"
+)
+
+var (
+	// ErrInvalidSchema indicates that the schema definition is not valid.
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	// ErrUnsupportedType indicates that the schema type is not supported.
+	ErrUnsupportedType = errors.New("unsupported schema type")
+
+	// ErrSchemaViolation indicates that the message payload does not conform to the channel schema.
+	ErrSchemaViolation = errors.New("message payload does not conform to the channel schema")
+
+	errEmptyDefinition  = errors.New("JSON schema definition is empty")
+	errEmptyConstraints = errors.New("SenML schema must contain allowed names or units")
+	errDecodeSenML      = errors.New("payload is not a valid SenML JSON pack")
+)
+
+// Schema represents the constraints that messages published to the channel must satisfy.
+type Schema struct {
+	Type       Type            `json:"type"`
+	Definition json.RawMessage `json:"definition,omitempty"`
+	Names      []string        `json:"names,omitempty"`
+	Units      []string        `json:"units,omitempty"`
+}
+
+// Empty returns true if the schema does not constrain the payload.
+func (s Schema) Empty() bool {
+	return s.Type == ""
+}
+
+// Validate checks if the schema itself is valid.
+func (s Schema) Validate() error {
+	_, err := Compile(s)
+	return err
+}
+
+// Checker checks message payloads against the compiled schema.
+type Checker interface {
+	// Check returns ErrSchemaViolation if the payload does not conform to the schema.
+	Check(payload []byte) error
+}
+
+// Compile validates the schema and returns the Checker for it.
+// Empty schema compiles to the Checker that accepts any payload.
+func Compile(s Schema) (Checker, error) {
+	switch s.Type {
+	case "":
+		return anyChecker{}, nil
+	case JSONType:
+		if len(s.Definition) == 0 {
+			return nil, errors.Wrap(ErrInvalidSchema, errEmptyDefinition)
+		}
+		js, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(s.Definition))
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidSchema, err)
+		}
+		return jsonChecker{schema: js}, nil
+	case SenMLType:
+		if len(s.Names) == 0 && len(s.Units) == 0 {
+			return nil, errors.Wrap(ErrInvalidSchema, errEmptyConstraints)
+		}
+		return senmlChecker{names: s.Names, units: s.Units}, nil
+	default:
+		return nil, ErrUnsupportedType
+	}
+}
+
+// IsViolation returns true if the error is caused by the schema violation.
+// Protocol proxy errors do not support unwrapping, so the message is checked as well.
+func IsViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Contains(err, ErrSchemaViolation) || strings.HasPrefix(err.Error(), ErrSchemaViolation.Error())
+}
+
+type anyChecker struct{}
+
+func (anyChecker) Check([]byte) error {
+	return nil
+}
+
+type jsonChecker struct {
+	schema *gojsonschema.Schema
+}
+
+func (jc jsonChecker) Check(payload []byte) error {
+	res, err := jc.schema.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil {
+		return errors.Wrap(ErrSchemaViolation, err)
+	}
+	if res.Valid() {
+		return nil
+	}
+	details := make([]string, len(res.Errors()))
+	for i, e := range res.Errors() {
+		details[i] = e.String()
+	}
+
+	return errors.Wrap(ErrSchemaViolation, errors.New(strings.Join(details, "; ")))
+}
+
+type senmlChecker struct {
+	names []string
+	units []string
+}
+
+func (sc senmlChecker) Check(payload []byte) error {
+	pack, err := senml.Decode(payload, senml.JSON)
+	if err != nil {
+		return errors.Wrap(ErrSchemaViolation, errDecodeSenML)
+	}
+	pack, err = senml.Normalize(pack)
+	if err != nil {
+		return errors.Wrap(ErrSchemaViolation, err)
+	}
+	for _, r := range pack.Records {
+		if len(sc.names) > 0 && !slices.Contains(sc.names, r.Name) {
+			return errors.Wrap(ErrSchemaViolation, fmt.Errorf("record name %q is not allowed", r.Name))
+		}
+		if len(sc.units) > 0 && r.Unit != "" && !slices.Contains(sc.units, r.Unit) {
+			return errors.Wrap(ErrSchemaViolation, fmt.Errorf("record unit %q is not allowed", r.Unit))
+		}
+	}
+
+	return nil
+}
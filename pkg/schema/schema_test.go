@@ -0,0 +1,155 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		desc   string
+		schema schema.Schema
+		err    error
+	}{
+		{
+			desc:   "compile empty schema",
+			schema: schema.Schema{},
+		},
+		{
+			desc: "compile JSON schema",
+			schema: schema.Schema{
+				Type:       schema.JSONType,
+				Definition: []byte(`{"type":"object"}`),
+			},
+		},
+		{
+			desc:   "compile JSON schema without definition",
+			schema: schema.Schema{Type: schema.JSONType},
+			err:    schema.ErrInvalidSchema,
+		},
+		{
+			desc: "compile JSON schema with invalid definition",
+			schema: schema.Schema{
+				Type:       schema.JSONType,
+				Definition: []byte(`{"type":"invalid"}`),
+			},
+			err: schema.ErrInvalidSchema,
+		},
+		{
+			desc: "compile SenML schema",
+			schema: schema.Schema{
+				Type:  schema.SenMLType,
+				Names: []string{"temperature"},
+			},
+		},
+		{
+			desc:   "compile SenML schema without constraints",
+			schema: schema.Schema{Type: schema.SenMLType},
+			err:    schema.ErrInvalidSchema,
+		},
+		{
+			desc:   "compile schema with unsupported type",
+			schema: schema.Schema{Type: "xml"},
+			err:    schema.ErrUnsupportedType,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := schema.Compile(tc.schema)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	jsonSchema := schema.Schema{
+		Type: schema.JSONType,
+		Definition: []byte(`{
+			"type": "object",
+			"properties": {"temperature": {"type": "number"}},
+			"required": ["temperature"]
+		}`),
+	}
+	senmlSchema := schema.Schema{
+		Type:  schema.SenMLType,
+		Names: []string{"sensor:temperature", "sensor:humidity"},
+		Units: []string{"Cel", "%RH"},
+	}
+
+	cases := []struct {
+		desc    string
+		schema  schema.Schema
+		payload []byte
+		err     error
+	}{
+		{
+			desc:    "check any payload against empty schema",
+			schema:  schema.Schema{},
+			payload: []byte("not JSON"),
+		},
+		{
+			desc:    "check valid JSON payload",
+			schema:  jsonSchema,
+			payload: []byte(`{"temperature": 21.5}`),
+		},
+		{
+			desc:    "check JSON payload with missing required property",
+			schema:  jsonSchema,
+			payload: []byte(`{"humidity": 40}`),
+			err:     schema.ErrSchemaViolation,
+		},
+		{
+			desc:    "check JSON payload with invalid property type",
+			schema:  jsonSchema,
+			payload: []byte(`{"temperature": "hot"}`),
+			err:     schema.ErrSchemaViolation,
+		},
+		{
+			desc:    "check malformed JSON payload",
+			schema:  jsonSchema,
+			payload: []byte(`{"temperature":`),
+			err:     schema.ErrSchemaViolation,
+		},
+		{
+			desc:    "check valid SenML payload",
+			schema:  senmlSchema,
+			payload: []byte(`[{"bn":"sensor:","n":"temperature","u":"Cel","v":21.5},{"n":"humidity","u":"%RH","v":40}]`),
+		},
+		{
+			desc:    "check SenML payload with not allowed name",
+			schema:  senmlSchema,
+			payload: []byte(`[{"bn":"sensor:","n":"pressure","u":"Cel","v":1000}]`),
+			err:     schema.ErrSchemaViolation,
+		},
+		{
+			desc:    "check SenML payload with not allowed unit",
+			schema:  senmlSchema,
+			payload: []byte(`[{"bn":"sensor:","n":"temperature","u":"K","v":294.65}]`),
+			err:     schema.ErrSchemaViolation,
+		},
+		{
+			desc:    "check invalid SenML payload",
+			schema:  senmlSchema,
+			payload: []byte(`{"n":"temperature"}`),
+			err:     schema.ErrSchemaViolation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			checker, err := schema.Compile(tc.schema)
+			assert.Nil(t, err, fmt.Sprintf("unexpected error while compiling schema: %v", err))
+			err = checker.Check(tc.payload)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", err, tc.err))
+			assert.Equal(t, tc.err != nil, schema.IsViolation(err))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/dgraph-io/ristretto/v2"
+)
+
+var (
+	errCreateCache    = errors.New("failed to create schema cache")
+	errRetrieveSchema = errors.New("failed to retrieve channel schema")
+)
+
+// Validator validates message payloads against the schema of the channel.
+type Validator interface {
+	// Validate returns ErrSchemaViolation if the payload does not conform
+	// to the schema of the channel. Channels without schema accept any payload.
+	Validate(ctx context.Context, domainID, channelID string, payload []byte) error
+}
+
+type cachedChecker struct {
+	checker Checker
+	cost    int64
+}
+
+type validator struct {
+	channels grpcChannelsV1.ChannelsServiceClient
+	cache    *ristretto.Cache[string, cachedChecker]
+	ttl      time.Duration
+}
+
+// NewValidator returns the Validator that retrieves channel schemas from the
+// channels service and caches the compiled schemas for the given TTL.
+func NewValidator(cfg messaging.CacheConfig, ttl time.Duration, channels grpcChannelsV1.ChannelsServiceClient) (Validator, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config[string, cachedChecker]{
+		NumCounters: cfg.NumCounters,
+		MaxCost:     cfg.MaxCost,
+		BufferItems: cfg.BufferItems,
+		Cost:        func(c cachedChecker) int64 { return c.cost },
+	})
+	if err != nil {
+		return nil, errors.Wrap(errCreateCache, err)
+	}
+
+	return &validator{
+		channels: channels,
+		cache:    cache,
+		ttl:      ttl,
+	}, nil
+}
+
+func (v *validator) Validate(ctx context.Context, domainID, channelID string, payload []byte) error {
+	key := domainID + ":" + channelID
+	cc, ok := v.cache.Get(key)
+	if !ok {
+		res, err := v.channels.RetrieveSchema(ctx, &grpcChannelsV1.RetrieveSchemaReq{
+			DomainId:  domainID,
+			ChannelId: channelID,
+		})
+		if err != nil {
+			return errors.Wrap(errRetrieveSchema, err)
+		}
+		s := FromProto(res.GetSchema())
+		checker, err := Compile(s)
+		if err != nil {
+			return errors.Wrap(errRetrieveSchema, err)
+		}
+		cc = cachedChecker{checker: checker, cost: cost(s)}
+		v.cache.SetWithTTL(key, cc, cc.cost, v.ttl)
+	}
+
+	return cc.checker.Check(payload)
+}
+
+// FromProto converts the gRPC schema representation to Schema.
+func FromProto(s *grpcChannelsV1.Schema) Schema {
+	return Schema{
+		Type:       Type(s.GetType()),
+		Definition: s.GetDefinition(),
+		Names:      s.GetNames(),
+		Units:      s.GetUnits(),
+	}
+}
+
+// ToProto converts Schema to the gRPC schema representation.
+func ToProto(s Schema) *grpcChannelsV1.Schema {
+	return &grpcChannelsV1.Schema{
+		Type:       string(s.Type),
+		Definition: s.Definition,
+		Names:      s.Names,
+		Units:      s.Units,
+	}
+}
+
+func cost(s Schema) int64 {
+	c := int64(len(s.Type) + len(s.Definition) + 1)
+	for _, n := range s.Names {
+		c += int64(len(n))
+	}
+	for _, u := range s.Units {
+		c += int64(len(u))
+	}
+
+	return c
+}
@@ -0,0 +1,463 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const (
+	exportEndpoint = "export"
+	importEndpoint = "import"
+
+	archiveLimit  = uint64(100)
+	adminRoleName = "admin"
+	allStatus     = "all"
+)
+
+var (
+	errArchiveParents = errors.New("archive groups contain a cyclic parent relation")
+	errImportRollback = errors.New("failed to roll back the domain import")
+)
+
+// DomainArchive represents the versioned export of the domain together with
+// its roles, groups hierarchy, channels, clients and connections.
+type DomainArchive struct {
+	Version     uint64              `json:"version"`
+	ExportedAt  time.Time           `json:"exported_at"`
+	Domain      Domain              `json:"domain"`
+	Roles       []ArchiveRole       `json:"roles,omitempty"`
+	Groups      []ArchiveGroup      `json:"groups,omitempty"`
+	Channels    []ArchiveChannel    `json:"channels,omitempty"`
+	Clients     []ArchiveClient     `json:"clients,omitempty"`
+	Connections []ArchiveConnection `json:"connections,omitempty"`
+}
+
+// ArchiveRole represents the archived entity role with its actions and members.
+type ArchiveRole struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Actions []string `json:"actions,omitempty"`
+	Members []string `json:"members,omitempty"`
+}
+
+// ArchiveGroup represents the archived group with its roles.
+type ArchiveGroup struct {
+	Group Group         `json:"group"`
+	Roles []ArchiveRole `json:"roles,omitempty"`
+}
+
+// ArchiveChannel represents the archived channel with its roles.
+type ArchiveChannel struct {
+	Channel Channel       `json:"channel"`
+	Roles   []ArchiveRole `json:"roles,omitempty"`
+}
+
+// ArchiveClient represents the archived client with its roles.
+type ArchiveClient struct {
+	Client Client        `json:"client"`
+	Roles  []ArchiveRole `json:"roles,omitempty"`
+}
+
+// ArchiveConnection represents the archived connection of the client to the channel.
+type ArchiveConnection struct {
+	ClientID  string   `json:"client_id"`
+	ChannelID string   `json:"channel_id"`
+	Types     []string `json:"types"`
+}
+
+type importDomainReq struct {
+	Archive     DomainArchive `json:"archive"`
+	PreserveIDs bool          `json:"preserve_ids,omitempty"`
+}
+
+func (sdk mgSDK) ExportDomain(ctx context.Context, domainID string, withSecrets bool, token string) (DomainArchive, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, exportEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return DomainArchive{}, sdkErr
+	}
+
+	var archive DomainArchive
+	if err := json.Unmarshal(body, &archive); err != nil {
+		return DomainArchive{}, errors.NewSDKError(err)
+	}
+
+	if archive.Groups, sdkErr = sdk.exportGroups(ctx, domainID, token); sdkErr != nil {
+		return DomainArchive{}, sdkErr
+	}
+	if archive.Channels, sdkErr = sdk.exportChannels(ctx, domainID, token); sdkErr != nil {
+		return DomainArchive{}, sdkErr
+	}
+	if archive.Clients, sdkErr = sdk.exportClients(ctx, domainID, withSecrets, token); sdkErr != nil {
+		return DomainArchive{}, sdkErr
+	}
+	for _, ch := range archive.Channels {
+		conns, sdkErr := sdk.exportConnections(ctx, ch.Channel.ID, domainID, token)
+		if sdkErr != nil {
+			return DomainArchive{}, sdkErr
+		}
+		archive.Connections = append(archive.Connections, conns...)
+	}
+
+	return archive, nil
+}
+
+func (sdk mgSDK) exportGroups(ctx context.Context, domainID, token string) ([]ArchiveGroup, errors.SDKError) {
+	var ags []ArchiveGroup
+	for pm := (PageMetadata{Limit: archiveLimit, Status: allStatus}); ; {
+		gp, sdkErr := sdk.Groups(ctx, pm, domainID, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		for _, g := range gp.Groups {
+			rs, sdkErr := sdk.exportRoles(ctx, sdk.groupsURL, groupsEndpoint, g.ID, domainID, token)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			g.Children, g.Roles = nil, nil
+			ags = append(ags, ArchiveGroup{Group: g, Roles: rs})
+		}
+		pm.Offset += uint64(len(gp.Groups))
+		if len(gp.Groups) == 0 || pm.Offset >= gp.Total {
+			return ags, nil
+		}
+	}
+}
+
+func (sdk mgSDK) exportChannels(ctx context.Context, domainID, token string) ([]ArchiveChannel, errors.SDKError) {
+	var acs []ArchiveChannel
+	for pm := (PageMetadata{Limit: archiveLimit, Status: allStatus}); ; {
+		cp, sdkErr := sdk.Channels(ctx, pm, domainID, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		for _, c := range cp.Channels {
+			rs, sdkErr := sdk.exportRoles(ctx, sdk.channelsURL, channelsEndpoint, c.ID, domainID, token)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			c.Roles, c.Permissions = nil, nil
+			acs = append(acs, ArchiveChannel{Channel: c, Roles: rs})
+		}
+		pm.Offset += uint64(len(cp.Channels))
+		if len(cp.Channels) == 0 || pm.Offset >= cp.Total {
+			return acs, nil
+		}
+	}
+}
+
+func (sdk mgSDK) exportClients(ctx context.Context, domainID string, withSecrets bool, token string) ([]ArchiveClient, errors.SDKError) {
+	var acs []ArchiveClient
+	for pm := (PageMetadata{Limit: archiveLimit, Status: allStatus}); ; {
+		cp, sdkErr := sdk.Clients(ctx, pm, domainID, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		for _, c := range cp.Clients {
+			rs, sdkErr := sdk.exportRoles(ctx, sdk.clientsURL, clientsEndpoint, c.ID, domainID, token)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			if !withSecrets {
+				c.Credentials.Secret = ""
+			}
+			// The rotation in progress and the bound certificate are not moved.
+			c.Credentials.SecretRotation = ClientSecretRotation{}
+			c.Credentials.Certificate = ClientCertificate{}
+			c.Roles, c.Permissions, c.ConnectionTypes = nil, nil, nil
+			acs = append(acs, ArchiveClient{Client: c, Roles: rs})
+		}
+		pm.Offset += uint64(len(cp.Clients))
+		if len(cp.Clients) == 0 || pm.Offset >= cp.Total {
+			return acs, nil
+		}
+	}
+}
+
+func (sdk mgSDK) exportConnections(ctx context.Context, channelID, domainID, token string) ([]ArchiveConnection, errors.SDKError) {
+	var conns []ArchiveConnection
+	for pm := (PageMetadata{Limit: archiveLimit, Status: allStatus, Channel: channelID}); ; {
+		cp, sdkErr := sdk.Clients(ctx, pm, domainID, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		for _, c := range cp.Clients {
+			conns = append(conns, ArchiveConnection{
+				ClientID:  c.ID,
+				ChannelID: channelID,
+				Types:     c.ConnectionTypes,
+			})
+		}
+		pm.Offset += uint64(len(cp.Clients))
+		if len(cp.Clients) == 0 || pm.Offset >= cp.Total {
+			return conns, nil
+		}
+	}
+}
+
+func (sdk mgSDK) exportRoles(ctx context.Context, entityURL, entityEndpoint, id, domainID, token string) ([]ArchiveRole, errors.SDKError) {
+	var ars []ArchiveRole
+	for pm := (PageMetadata{Limit: archiveLimit}); ; {
+		rp, sdkErr := sdk.listRoles(ctx, entityURL, entityEndpoint, id, domainID, pm, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		for _, r := range rp.Roles {
+			actions, sdkErr := sdk.listRoleActions(ctx, entityURL, entityEndpoint, id, r.ID, domainID, token)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			members, sdkErr := sdk.roleMembers(ctx, entityURL, entityEndpoint, id, r.ID, domainID, token)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			ars = append(ars, ArchiveRole{ID: r.ID, Name: r.Name, Actions: actions, Members: members})
+		}
+		pm.Offset += uint64(len(rp.Roles))
+		if len(rp.Roles) == 0 || pm.Offset >= rp.Total {
+			return ars, nil
+		}
+	}
+}
+
+func (sdk mgSDK) roleMembers(ctx context.Context, entityURL, entityEndpoint, id, roleID, domainID, token string) ([]string, errors.SDKError) {
+	var members []string
+	for pm := (PageMetadata{Limit: archiveLimit}); ; {
+		mp, sdkErr := sdk.listRoleMembers(ctx, entityURL, entityEndpoint, id, roleID, domainID, pm, token)
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		members = append(members, mp.Members...)
+		pm.Offset += uint64(len(mp.Members))
+		if len(mp.Members) == 0 || pm.Offset >= mp.Total {
+			return members, nil
+		}
+	}
+}
+
+func (sdk mgSDK) ImportDomain(ctx context.Context, archive DomainArchive, preserveIDs bool, token string) (Domain, errors.SDKError) {
+	// Domain and its roles are imported by the domains service, while the
+	// entities are recreated in the new domain using their services.
+	req := importDomainReq{
+		Archive: DomainArchive{
+			Version:    archive.Version,
+			ExportedAt: archive.ExportedAt,
+			Domain:     archive.Domain,
+			Roles:      archive.Roles,
+		},
+		PreserveIDs: preserveIDs,
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return Domain{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", sdk.domainsURL, domainsEndpoint, importEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusCreated)
+	if sdkErr != nil {
+		return Domain{}, sdkErr
+	}
+
+	var d Domain
+	if err := json.Unmarshal(body, &d); err != nil {
+		return Domain{}, errors.NewSDKError(err)
+	}
+
+	// The import is rolled back on failure, so no partial domain is left behind.
+	var created importedEntities
+	if sdkErr := sdk.importEntities(ctx, archive, preserveIDs, d.ID, &created, token); sdkErr != nil {
+		if rbErr := sdk.rollbackImport(ctx, d.ID, created, token); rbErr != nil {
+			return Domain{}, errors.NewSDKErrorWithStatus(errors.Wrap(sdkErr, errors.Wrap(errImportRollback, rbErr)), sdkErr.StatusCode())
+		}
+		return Domain{}, sdkErr
+	}
+
+	return d, nil
+}
+
+// importedEntities records the entities created by the import in the order
+// of creation.
+type importedEntities struct {
+	groups   []string
+	channels []string
+	clients  []string
+}
+
+func (sdk mgSDK) importEntities(ctx context.Context, archive DomainArchive, preserveIDs bool, domainID string, created *importedEntities, token string) errors.SDKError {
+	// IDs maps the archived entities IDs to the IDs of the imported entities.
+	ids := map[string]string{}
+	if sdkErr := sdk.importGroups(ctx, archive.Groups, ids, preserveIDs, domainID, created, token); sdkErr != nil {
+		return sdkErr
+	}
+	for _, ac := range archive.Channels {
+		c := Channel{
+			Name:        ac.Channel.Name,
+			Tags:        ac.Channel.Tags,
+			Route:       ac.Channel.Route,
+			Metadata:    ac.Channel.Metadata,
+			ParentGroup: ids[ac.Channel.ParentGroup],
+			Status:      ac.Channel.Status,
+		}
+		if preserveIDs {
+			c.ID = ac.Channel.ID
+		}
+		c, sdkErr := sdk.CreateChannel(ctx, c, domainID, token)
+		if sdkErr != nil {
+			return sdkErr
+		}
+		ids[ac.Channel.ID] = c.ID
+		created.channels = append(created.channels, c.ID)
+		if sdkErr := sdk.importRoles(ctx, sdk.channelsURL, channelsEndpoint, c.ID, domainID, ac.Roles, token); sdkErr != nil {
+			return sdkErr
+		}
+	}
+	for _, ac := range archive.Clients {
+		c := Client{
+			Name:            ac.Client.Name,
+			Tags:            ac.Client.Tags,
+			Metadata:        ac.Client.Metadata,
+			PrivateMetadata: ac.Client.PrivateMetadata,
+			ParentGroup:     ids[ac.Client.ParentGroup],
+			Credentials: ClientCredentials{
+				Identity: ac.Client.Credentials.Identity,
+				Secret:   ac.Client.Credentials.Secret,
+			},
+			Status: ac.Client.Status,
+		}
+		if preserveIDs {
+			c.ID = ac.Client.ID
+		}
+		c, sdkErr := sdk.CreateClient(ctx, c, domainID, token)
+		if sdkErr != nil {
+			return sdkErr
+		}
+		ids[ac.Client.ID] = c.ID
+		created.clients = append(created.clients, c.ID)
+		if sdkErr := sdk.importRoles(ctx, sdk.clientsURL, clientsEndpoint, c.ID, domainID, ac.Roles, token); sdkErr != nil {
+			return sdkErr
+		}
+	}
+	for _, conn := range archive.Connections {
+		clientID, ok := ids[conn.ClientID]
+		if !ok {
+			continue
+		}
+		channelID, ok := ids[conn.ChannelID]
+		if !ok {
+			continue
+		}
+		c := Connection{
+			ClientIDs:  []string{clientID},
+			ChannelIDs: []string{channelID},
+			Types:      conn.Types,
+		}
+		if sdkErr := sdk.Connect(ctx, c, domainID, token); sdkErr != nil {
+			return sdkErr
+		}
+	}
+
+	return nil
+}
+
+// rollbackImport removes the created entities in the reverse order of
+// creation, together with their roles and connections, and deletes the
+// imported domain. The removal continues on failure and the first error
+// is returned.
+func (sdk mgSDK) rollbackImport(ctx context.Context, domainID string, created importedEntities, token string) errors.SDKError {
+	var rbErr errors.SDKError
+	keep := func(sdkErr errors.SDKError) {
+		if rbErr == nil {
+			rbErr = sdkErr
+		}
+	}
+	for _, id := range slices.Backward(created.clients) {
+		keep(sdk.DeleteClient(ctx, id, domainID, token))
+	}
+	for _, id := range slices.Backward(created.channels) {
+		keep(sdk.DeleteChannel(ctx, id, domainID, token))
+	}
+	for _, id := range slices.Backward(created.groups) {
+		keep(sdk.DeleteGroup(ctx, id, domainID, token))
+	}
+	keep(sdk.DeleteDomain(ctx, domainID, token))
+
+	return rbErr
+}
+
+// importGroups creates the groups so that each parent is created before its children.
+func (sdk mgSDK) importGroups(ctx context.Context, ags []ArchiveGroup, ids map[string]string, preserveIDs bool, domainID string, created *importedEntities, token string) errors.SDKError {
+	archived := map[string]bool{}
+	for _, ag := range ags {
+		archived[ag.Group.ID] = true
+	}
+
+	pending := ags
+	for len(pending) > 0 {
+		var next []ArchiveGroup
+		for _, ag := range pending {
+			parent := ag.Group.ParentID
+			if _, ok := ids[parent]; archived[parent] && !ok {
+				next = append(next, ag)
+				continue
+			}
+			g := Group{
+				Name:        ag.Group.Name,
+				Description: ag.Group.Description,
+				Tags:        ag.Group.Tags,
+				Metadata:    ag.Group.Metadata,
+				ParentID:    ids[parent],
+				Status:      ag.Group.Status,
+			}
+			if preserveIDs {
+				g.ID = ag.Group.ID
+			}
+			g, sdkErr := sdk.CreateGroup(ctx, g, domainID, token)
+			if sdkErr != nil {
+				return sdkErr
+			}
+			ids[ag.Group.ID] = g.ID
+			created.groups = append(created.groups, g.ID)
+			if sdkErr := sdk.importRoles(ctx, sdk.groupsURL, groupsEndpoint, g.ID, domainID, ag.Roles, token); sdkErr != nil {
+				return sdkErr
+			}
+		}
+		if len(next) == len(pending) {
+			return errors.NewSDKError(errArchiveParents)
+		}
+		pending = next
+	}
+
+	return nil
+}
+
+// importRoles creates the archived roles of the entity, except the built-in
+// admin role which is created with the entity. The archived members aren't
+// added, since they may not be the members of the imported domain yet, so
+// they are added by the domain admin once they accept the domain invitation.
+func (sdk mgSDK) importRoles(ctx context.Context, entityURL, entityEndpoint, id, domainID string, ars []ArchiveRole, token string) errors.SDKError {
+	for _, ar := range ars {
+		if ar.Name == adminRoleName {
+			continue
+		}
+		rq := RoleReq{
+			RoleName:        ar.Name,
+			OptionalActions: ar.Actions,
+		}
+		if _, sdkErr := sdk.createRole(ctx, entityURL, entityEndpoint, id, domainID, rq, token); sdkErr != nil {
+			return sdkErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/channels"
+	"github.com/absmach/supermq/clients"
+	"github.com/absmach/supermq/domains"
+	"github.com/absmach/supermq/groups"
+	"github.com/absmach/supermq/internal/testsutil"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/roles"
+	sdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportDomain(t *testing.T) {
+	ds, dsvc, dauthn := setupDomains()
+	defer ds.Close()
+	gs, gsvc, gauthn := setupGroups()
+	defer gs.Close()
+	cs, csvc, cauthn := setupChannels()
+	defer cs.Close()
+	ts, tsvc, tauthn := setupClients()
+	defer ts.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		GroupsURL:      gs.URL,
+		ChannelsURL:    cs.URL,
+		ClientsURL:     ts.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	domainID := authDomain.ID
+	archive := domains.Archive{
+		Version: domains.ArchiveVersion,
+		Domain:  authDomain,
+		Roles: []domains.ArchiveRole{
+			{
+				ID:      testsutil.GenerateUUID(t),
+				Name:    "admin",
+				Members: []string{validID},
+			},
+		},
+	}
+	group := groups.Group{ID: testsutil.GenerateUUID(t), Name: gName, Status: groups.EnabledStatus}
+	channel := channels.Channel{ID: testsutil.GenerateUUID(t), Name: "channel", Status: channels.EnabledStatus}
+	client := clients.Client{
+		ID:          testsutil.GenerateUUID(t),
+		Name:        "client",
+		Credentials: clients.Credentials{Identity: "client", Secret: secret},
+		Status:      clients.EnabledStatus,
+	}
+	connClient := client
+	connClient.ConnectionTypes = []connections.ConnType{connections.Publish}
+	role := roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}
+	rolesPage := roles.RolePage{Total: 1, Limit: 100, Roles: []roles.Role{role}}
+	membersPage := roles.MembersPage{Total: 1, Limit: 100, Members: []string{validID}}
+
+	cases := []struct {
+		desc        string
+		token       string
+		withSecrets bool
+		session     smqauthn.Session
+		svcRes      domains.Archive
+		svcErr      error
+		groupsErr   error
+		authnErr    error
+		secret      string
+		err         error
+	}{
+		{
+			desc:   "export domain successfully",
+			token:  validToken,
+			svcRes: archive,
+			err:    nil,
+		},
+		{
+			desc:        "export domain successfully with secrets",
+			token:       validToken,
+			withSecrets: true,
+			svcRes:      archive,
+			secret:      secret,
+			err:         nil,
+		},
+		{
+			desc:     "export domain with invalid token",
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:   "export domain with service error",
+			token:  validToken,
+			svcErr: svcerr.ErrAuthorization,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+		},
+		{
+			desc:      "export domain with failed to list groups",
+			token:     validToken,
+			svcRes:    archive,
+			groupsErr: svcerr.ErrViewEntity,
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrViewEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := dauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall1 := gauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall2 := cauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall3 := tauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := dsvc.On("ExportDomain", mock.Anything, tc.session, domainID).Return(tc.svcRes, tc.svcErr)
+			svcCall1 := gsvc.On("ListGroups", mock.Anything, tc.session, mock.Anything).Return(groups.Page{PageMeta: groups.PageMeta{Total: 1}, Groups: []groups.Group{group}}, tc.groupsErr)
+			svcCall2 := gsvc.On("RetrieveAllRoles", mock.Anything, tc.session, group.ID, uint64(100), uint64(0)).Return(rolesPage, nil)
+			svcCall3 := gsvc.On("RoleListActions", mock.Anything, tc.session, group.ID, role.ID).Return([]string{"read"}, nil)
+			svcCall4 := gsvc.On("RoleListMembers", mock.Anything, tc.session, group.ID, role.ID, uint64(100), uint64(0)).Return(membersPage, nil)
+			svcCall5 := csvc.On("ListChannels", mock.Anything, tc.session, mock.Anything).Return(channels.ChannelsPage{Page: channels.Page{Total: 1}, Channels: []channels.Channel{channel}}, nil)
+			svcCall6 := csvc.On("RetrieveAllRoles", mock.Anything, tc.session, channel.ID, uint64(100), uint64(0)).Return(roles.RolePage{}, nil)
+			svcCall7 := tsvc.On("ListClients", mock.Anything, tc.session, mock.Anything).Return(clients.ClientsPage{Page: clients.Page{Total: 1}, Clients: []clients.Client{connClient}}, nil)
+			svcCall8 := tsvc.On("RetrieveAllRoles", mock.Anything, tc.session, client.ID, uint64(100), uint64(0)).Return(roles.RolePage{}, nil)
+			resp, err := mgsdk.ExportDomain(context.Background(), domainID, tc.withSecrets, tc.token)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, archive.Version, resp.Version)
+				assert.Equal(t, domainID, resp.Domain.ID)
+				assert.Len(t, resp.Roles, len(archive.Roles))
+				assert.Equal(t, []sdk.ArchiveGroup{
+					{
+						Group: sdk.Group{ID: group.ID, Name: group.Name, Status: group.Status.String()},
+						Roles: []sdk.ArchiveRole{{ID: role.ID, Name: role.Name, Actions: []string{"read"}, Members: []string{validID}}},
+					},
+				}, resp.Groups)
+				assert.Len(t, resp.Channels, 1)
+				assert.Len(t, resp.Clients, 1)
+				assert.Equal(t, tc.secret, resp.Clients[0].Client.Credentials.Secret)
+				assert.Empty(t, resp.Clients[0].Client.ConnectionTypes)
+				assert.Equal(t, []sdk.ArchiveConnection{{ClientID: client.ID, ChannelID: channel.ID, Types: []string{connections.Publish.String()}}}, resp.Connections)
+			}
+			svcCall.Unset()
+			svcCall1.Unset()
+			svcCall2.Unset()
+			svcCall3.Unset()
+			svcCall4.Unset()
+			svcCall5.Unset()
+			svcCall6.Unset()
+			svcCall7.Unset()
+			svcCall8.Unset()
+			authCall.Unset()
+			authCall1.Unset()
+			authCall2.Unset()
+			authCall3.Unset()
+		})
+	}
+}
+
+func TestImportDomain(t *testing.T) {
+	ds, dsvc, dauthn := setupDomains()
+	defer ds.Close()
+	gs, gsvc, gauthn := setupGroups()
+	defer gs.Close()
+	cs, csvc, cauthn := setupChannels()
+	defer cs.Close()
+	ts, tsvc, tauthn := setupClients()
+	defer ts.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		GroupsURL:      gs.URL,
+		ChannelsURL:    cs.URL,
+		ClientsURL:     ts.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	parentID := testsutil.GenerateUUID(t)
+	childID := testsutil.GenerateUUID(t)
+	channelID := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
+	archive := sdk.DomainArchive{
+		Version: domains.ArchiveVersion,
+		Domain:  sdkDomain,
+		Groups: []sdk.ArchiveGroup{
+			{Group: sdk.Group{ID: childID, Name: "child", ParentID: parentID, Status: groups.EnabledStatus.String()}},
+			{Group: sdk.Group{ID: parentID, Name: "parent", Status: groups.EnabledStatus.String()}},
+		},
+		Channels: []sdk.ArchiveChannel{
+			{
+				Channel: sdk.Channel{ID: channelID, Name: "channel", ParentGroup: childID, Status: channels.EnabledStatus.String()},
+				Roles: []sdk.ArchiveRole{
+					{Name: "admin", Members: []string{validID}},
+					{Name: "viewer", Actions: []string{"read"}, Members: []string{validID}},
+				},
+			},
+		},
+		Clients: []sdk.ArchiveClient{
+			{Client: sdk.Client{ID: clientID, Name: "client", Status: clients.EnabledStatus.String()}},
+		},
+		Connections: []sdk.ArchiveConnection{
+			{ClientID: clientID, ChannelID: channelID, Types: []string{"publish"}},
+		},
+	}
+	emptyArchive := sdk.DomainArchive{
+		Version: domains.ArchiveVersion,
+		Domain:  sdkDomain,
+	}
+	invalidArchive := emptyArchive
+	invalidArchive.Version = domains.ArchiveVersion + 1
+
+	cases := []struct {
+		desc        string
+		token       string
+		session     smqauthn.Session
+		archive     sdk.DomainArchive
+		preserveIDs bool
+		svcRes      domains.Domain
+		svcErr      error
+		groupErr    error
+		clientErr   error
+		deleteErr   error
+		rolledBack  bool
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:    "import domain without entities successfully",
+			token:   validToken,
+			archive: emptyArchive,
+			svcRes:  authDomain,
+			err:     nil,
+		},
+		{
+			desc:        "import domain with entities successfully",
+			token:       validToken,
+			archive:     archive,
+			preserveIDs: true,
+			svcRes:      authDomain,
+			err:         nil,
+		},
+		{
+			desc:     "import domain with invalid token",
+			token:    invalidToken,
+			archive:  emptyArchive,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:    "import domain with unsupported version",
+			token:   validToken,
+			archive: invalidArchive,
+			err:     errors.NewSDKErrorWithStatus(apiutil.ErrUnsupportedArchiveVersion, http.StatusBadRequest),
+		},
+		{
+			desc:    "import domain with service error",
+			token:   validToken,
+			archive: emptyArchive,
+			svcErr:  svcerr.ErrCreateEntity,
+			err:     errors.NewSDKErrorWithStatus(svcerr.ErrCreateEntity, http.StatusUnprocessableEntity),
+		},
+		{
+			desc:       "import domain with failed to create group",
+			token:      validToken,
+			archive:    archive,
+			svcRes:     authDomain,
+			groupErr:   svcerr.ErrCreateEntity,
+			rolledBack: true,
+			err:        errors.NewSDKErrorWithStatus(svcerr.ErrCreateEntity, http.StatusUnprocessableEntity),
+		},
+		{
+			desc:       "import domain with failed to create client",
+			token:      validToken,
+			archive:    archive,
+			svcRes:     authDomain,
+			clientErr:  svcerr.ErrCreateEntity,
+			rolledBack: true,
+			err:        errors.NewSDKErrorWithStatus(svcerr.ErrCreateEntity, http.StatusUnprocessableEntity),
+		},
+		{
+			desc:       "import domain with failed to roll back",
+			token:      validToken,
+			archive:    archive,
+			svcRes:     authDomain,
+			clientErr:  svcerr.ErrCreateEntity,
+			deleteErr:  svcerr.ErrRemoveEntity,
+			rolledBack: true,
+			err:        errors.NewSDKErrorWithStatus(svcerr.ErrRemoveEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: authDomain.ID + "_" + validID, UserID: validID, DomainID: authDomain.ID}
+			}
+			authCall := dauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall1 := gauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall2 := cauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			authCall3 := tauthn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := dsvc.On("ImportDomain", mock.Anything, tc.session, mock.Anything, tc.preserveIDs).Return(tc.svcRes, []roles.RoleProvision{}, tc.svcErr)
+			svcCall1 := gsvc.On("CreateGroup", mock.Anything, tc.session, mock.Anything).Return(groups.Group{ID: parentID}, []roles.RoleProvision{}, tc.groupErr)
+			svcCall2 := csvc.On("CreateChannels", mock.Anything, tc.session, mock.Anything).Return([]channels.Channel{{ID: channelID}}, []roles.RoleProvision{}, nil)
+			svcCall3 := tsvc.On("CreateClients", mock.Anything, tc.session, mock.Anything).Return([]clients.Client{{ID: clientID}}, []roles.RoleProvision{}, tc.clientErr)
+			svcCall4 := csvc.On("Connect", mock.Anything, tc.session, []string{channelID}, []string{clientID}, []connections.ConnType{connections.Publish}).Return(nil)
+			var roleMembers [][]string
+			svcCall5 := csvc.On("AddRole", mock.Anything, tc.session, channelID, "viewer", []string{"read"}, mock.Anything).Run(func(args mock.Arguments) {
+				roleMembers = append(roleMembers, args.Get(5).([]string))
+			}).Return(roles.RoleProvision{}, nil)
+			var deletedDomains, deletedGroups, removedChannels int
+			svcCall6 := dsvc.On("DeleteDomain", mock.Anything, tc.session, authDomain.ID).Run(func(_ mock.Arguments) {
+				deletedDomains++
+			}).Return(domains.Domain{}, tc.deleteErr)
+			svcCall7 := gsvc.On("DeleteGroup", mock.Anything, tc.session, parentID).Run(func(_ mock.Arguments) {
+				deletedGroups++
+			}).Return(nil)
+			svcCall8 := csvc.On("RemoveChannel", mock.Anything, tc.session, channelID).Run(func(_ mock.Arguments) {
+				removedChannels++
+			}).Return(nil)
+			resp, err := mgsdk.ImportDomain(context.Background(), tc.archive, tc.preserveIDs, tc.token)
+			switch tc.deleteErr {
+			case nil:
+				assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+			default:
+				assert.True(t, errors.Contains(err, tc.deleteErr), fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.deleteErr, err))
+			}
+			if tc.err == nil {
+				assert.Equal(t, authDomain.ID, resp.ID)
+				if len(tc.archive.Groups) > 0 {
+					ok := svcCall1.Parent.AssertNumberOfCalls(t, "CreateGroup", len(tc.archive.Groups))
+					assert.True(t, ok, fmt.Sprintf("CreateGroup was not called on %s", tc.desc))
+					ok = svcCall4.Parent.AssertCalled(t, "Connect", mock.Anything, tc.session, []string{channelID}, []string{clientID}, []connections.ConnType{connections.Publish})
+					assert.True(t, ok, fmt.Sprintf("Connect was not called on %s", tc.desc))
+					// The archived members are not added to the entity roles.
+					assert.Equal(t, [][]string{nil}, roleMembers, fmt.Sprintf("%s: expected role without members got %v", tc.desc, roleMembers))
+					ok = svcCall5.Parent.AssertNotCalled(t, "RoleAddMembers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+					assert.True(t, ok, fmt.Sprintf("RoleAddMembers was called on %s", tc.desc))
+				}
+				assert.Zero(t, deletedDomains, fmt.Sprintf("%s: expected the domain not to be deleted", tc.desc))
+			}
+			if tc.rolledBack {
+				assert.Equal(t, 1, deletedDomains, fmt.Sprintf("%s: expected the imported domain to be deleted", tc.desc))
+				if tc.clientErr != nil {
+					assert.Equal(t, len(tc.archive.Groups), deletedGroups, fmt.Sprintf("%s: expected the imported groups to be deleted", tc.desc))
+					assert.Equal(t, len(tc.archive.Channels), removedChannels, fmt.Sprintf("%s: expected the imported channels to be removed", tc.desc))
+				}
+			}
+			svcCall.Unset()
+			svcCall1.Unset()
+			svcCall2.Unset()
+			svcCall3.Unset()
+			svcCall4.Unset()
+			svcCall5.Unset()
+			svcCall6.Unset()
+			svcCall7.Unset()
+			svcCall8.Unset()
+			authCall.Unset()
+			authCall1.Unset()
+			authCall2.Unset()
+			authCall3.Unset()
+		})
+	}
+}
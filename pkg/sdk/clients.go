@@ -23,6 +23,7 @@ const (
 	identifyEndpoint    = "identify"
 	rolesEndpoint       = "roles"
 	actionsEndpoint     = "actions"
+	certificateEndpoint = "certificate"
 )
 
 // Client represents supermq client.
@@ -41,11 +42,26 @@ type Client struct {
 	Status          string                    `json:"status,omitempty"`
 	Permissions     []string                  `json:"permissions,omitempty"`
 	Roles           []roles.MemberRoleActions `json:"roles,omitempty"`
+	ConnectionTypes []string                  `json:"connection_types,omitempty"`
 }
 
 type ClientCredentials struct {
-	Identity string `json:"identity,omitempty"`
-	Secret   string `json:"secret,omitempty"`
+	Identity       string               `json:"identity,omitempty"`
+	Secret         string               `json:"secret,omitempty"`
+	Certificate    ClientCertificate    `json:"certificate,omitzero"`
+	SecretRotation ClientSecretRotation `json:"secret_rotation,omitzero"`
+}
+
+// ClientSecretRotation represents the client secret rotation in progress.
+// The previous secret is accepted until it expires.
+type ClientSecretRotation struct {
+	ExpiresAt time.Time `json:"previous_secret_expires_at,omitzero"`
+}
+
+// ClientCertificate represents the X.509 certificate bound to the client.
+type ClientCertificate struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Subject     string `json:"subject,omitempty"`
 }
 
 func (sdk mgSDK) CreateClient(ctx context.Context, client Client, domainID, token string) (Client, errors.SDKError) {
@@ -197,6 +213,77 @@ func (sdk mgSDK) UpdateClientSecret(ctx context.Context, id, secret, domainID, t
 	return t, nil
 }
 
+func (sdk mgSDK) RotateClientSecret(ctx context.Context, id, secret string, gracePeriod time.Duration, domainID, token string) (Client, errors.SDKError) {
+	rcsr := rotateClientSecretReq{Secret: secret}
+	if gracePeriod > 0 {
+		rcsr.GracePeriod = gracePeriod.String()
+	}
+
+	data, err := json.Marshal(rcsr)
+	if err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/secret/rotate", sdk.clientsURL, domainID, clientsEndpoint, id)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Client{}, sdkErr
+	}
+
+	var t Client
+	if err = json.Unmarshal(body, &t); err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) BindClientCert(ctx context.Context, id, cert, subject, domainID, token string) (Client, errors.SDKError) {
+	return sdk.updateClientCert(ctx, http.MethodPost, id, cert, subject, domainID, token)
+}
+
+func (sdk mgSDK) RotateClientCert(ctx context.Context, id, cert, subject, domainID, token string) (Client, errors.SDKError) {
+	return sdk.updateClientCert(ctx, http.MethodPut, id, cert, subject, domainID, token)
+}
+
+func (sdk mgSDK) RevokeClientCert(ctx context.Context, id, domainID, token string) (Client, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.clientsURL, domainID, clientsEndpoint, id, certificateEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Client{}, sdkErr
+	}
+
+	var t Client
+	if err := json.Unmarshal(body, &t); err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) updateClientCert(ctx context.Context, method, id, cert, subject, domainID, token string) (Client, errors.SDKError) {
+	data, err := json.Marshal(clientCertReq{Certificate: cert, Subject: subject})
+	if err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.clientsURL, domainID, clientsEndpoint, id, certificateEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, method, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Client{}, sdkErr
+	}
+
+	var t Client
+	if err = json.Unmarshal(body, &t); err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
 func (sdk mgSDK) EnableClient(ctx context.Context, id, domainID, token string) (Client, errors.SDKError) {
 	return sdk.changeClientStatus(ctx, id, enableEndpoint, domainID, token)
 }
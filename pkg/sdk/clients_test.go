@@ -184,7 +184,7 @@ func TestCreateClient(t *testing.T) {
 			svcRes: []clients.Client{{
 				Name:        client.Name,
 				Tags:        client.Tags,
-				Credentials: clients.Credentials(client.Credentials),
+				Credentials: convertCredentials(client.Credentials),
 				PrivateMetadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -295,7 +295,7 @@ func TestCreateClients(t *testing.T) {
 			svcRes: []clients.Client{{
 				Name:        sdkClients[0].Name,
 				Tags:        sdkClients[0].Tags,
-				Credentials: clients.Credentials(sdkClients[0].Credentials),
+				Credentials: convertCredentials(sdkClients[0].Credentials),
 				PrivateMetadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -561,7 +561,7 @@ func TestListClients(t *testing.T) {
 				Clients: []clients.Client{{
 					Name:        sdkClients[0].Name,
 					Tags:        sdkClients[0].Tags,
-					Credentials: clients.Credentials(sdkClients[0].Credentials),
+					Credentials: convertCredentials(sdkClients[0].Credentials),
 					PrivateMetadata: clients.Metadata{
 						"test": make(chan int),
 					},
@@ -697,7 +697,7 @@ func TestViewClient(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        sdkClient.Name,
 				Tags:        sdkClient.Tags,
-				Credentials: clients.Credentials(sdkClient.Credentials),
+				Credentials: convertCredentials(sdkClient.Credentials),
 				PrivateMetadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -870,7 +870,7 @@ func TestUpdateClient(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        updatedClient.Name,
 				Tags:        updatedClient.Tags,
-				Credentials: clients.Credentials(updatedClient.Credentials),
+				Credentials: convertCredentials(updatedClient.Credentials),
 				Metadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -1022,7 +1022,7 @@ func TestUpdateClientTags(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        updatedClient.Name,
 				Tags:        updatedClient.Tags,
-				Credentials: clients.Credentials(updatedClient.Credentials),
+				Credentials: convertCredentials(updatedClient.Credentials),
 				Metadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -1154,7 +1154,7 @@ func TestUpdateClientSecret(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        updatedClient.Name,
 				Tags:        updatedClient.Tags,
-				Credentials: clients.Credentials(updatedClient.Credentials),
+				Credentials: convertCredentials(updatedClient.Credentials),
 				Metadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -1184,6 +1184,295 @@ func TestUpdateClientSecret(t *testing.T) {
 	}
 }
 
+func TestRotateClientSecret(t *testing.T) {
+	ts, tsvc, auth := setupClients()
+	defer ts.Close()
+
+	sdkClient := generateTestClient(t, false)
+	newSecret := generateUUID(t)
+	rotatedClient := sdkClient
+	rotatedClient.Credentials.Secret = newSecret
+	rotatedClient.Credentials.SecretRotation = sdk.ClientSecretRotation{
+		ExpiresAt: time.Now().UTC().Add(time.Hour).Truncate(time.Second),
+	}
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		clientID        string
+		newSecret       string
+		gracePeriod     time.Duration
+		svcRes          clients.Client
+		svcErr          error
+		authenticateErr error
+		response        sdk.Client
+		err             errors.SDKError
+	}{
+		{
+			desc:        "rotate client secret successfully",
+			domainID:    domainID,
+			token:       validToken,
+			clientID:    sdkClient.ID,
+			newSecret:   newSecret,
+			gracePeriod: time.Hour,
+			svcRes:      convertClient(rotatedClient),
+			svcErr:      nil,
+			response:    rotatedClient,
+			err:         nil,
+		},
+		{
+			desc:     "rotate client secret with default values",
+			domainID: domainID,
+			token:    validToken,
+			clientID: sdkClient.ID,
+			svcRes:   convertClient(rotatedClient),
+			svcErr:   nil,
+			response: rotatedClient,
+			err:      nil,
+		},
+		{
+			desc:            "rotate client secret with an invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			clientID:        sdkClient.ID,
+			newSecret:       newSecret,
+			svcRes:          clients.Client{},
+			authenticateErr: svcerr.ErrAuthentication,
+			response:        sdk.Client{},
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:      "rotate client secret with empty token",
+			domainID:  domainID,
+			token:     "",
+			clientID:  sdkClient.ID,
+			newSecret: newSecret,
+			svcRes:    clients.Client{},
+			response:  sdk.Client{},
+			err:       errors.NewSDKErrorWithStatus(apiutil.ErrBearerToken, http.StatusUnauthorized),
+		},
+		{
+			desc:        "rotate client secret with negative grace period",
+			domainID:    domainID,
+			token:       validToken,
+			clientID:    sdkClient.ID,
+			newSecret:   newSecret,
+			gracePeriod: -time.Hour,
+			svcRes:      convertClient(rotatedClient),
+			response:    rotatedClient,
+			err:         nil,
+		},
+		{
+			desc:      "rotate client secret with an invalid client id",
+			domainID:  domainID,
+			token:     validToken,
+			clientID:  wrongID,
+			newSecret: newSecret,
+			svcRes:    clients.Client{},
+			svcErr:    svcerr.ErrUpdateEntity,
+			response:  sdk.Client{},
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			gracePeriod := max(tc.gracePeriod, 0)
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("RotateSecret", mock.Anything, tc.session, tc.clientID, tc.newSecret, gracePeriod).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.RotateClientSecret(context.Background(), tc.clientID, tc.newSecret, tc.gracePeriod, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RotateSecret", mock.Anything, tc.session, tc.clientID, tc.newSecret, gracePeriod)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestBindClientCert(t *testing.T) {
+	ts, tsvc, auth := setupClients()
+	defer ts.Close()
+
+	sdkClient := generateTestClient(t, false)
+	subject := "CN=client"
+	boundClient := sdkClient
+	boundClient.Credentials.Certificate = sdk.ClientCertificate{Subject: subject}
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		clientID        string
+		cert            string
+		subject         string
+		svcReq          clients.Certificate
+		svcRes          clients.Client
+		svcErr          error
+		authenticateErr error
+		response        sdk.Client
+		err             errors.SDKError
+	}{
+		{
+			desc:     "bind client certificate subject successfully",
+			domainID: domainID,
+			token:    validToken,
+			clientID: sdkClient.ID,
+			subject:  subject,
+			svcReq:   clients.Certificate{Subject: subject},
+			svcRes:   convertClient(boundClient),
+			response: boundClient,
+			err:      nil,
+		},
+		{
+			desc:            "bind client certificate with an invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			clientID:        sdkClient.ID,
+			subject:         subject,
+			svcReq:          clients.Certificate{Subject: subject},
+			authenticateErr: svcerr.ErrAuthentication,
+			response:        sdk.Client{},
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "bind client certificate without certificate and subject",
+			domainID: domainID,
+			token:    validToken,
+			clientID: sdkClient.ID,
+			response: sdk.Client{},
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrMissingCert, http.StatusBadRequest),
+		},
+		{
+			desc:     "bind client certificate with invalid certificate",
+			domainID: domainID,
+			token:    validToken,
+			clientID: sdkClient.ID,
+			cert:     "invalid",
+			response: sdk.Client{},
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrInvalidCert, http.StatusBadRequest),
+		},
+		{
+			desc:     "bind client certificate with an invalid client id",
+			domainID: domainID,
+			token:    validToken,
+			clientID: wrongID,
+			subject:  subject,
+			svcReq:   clients.Certificate{Subject: subject},
+			svcErr:   svcerr.ErrUpdateEntity,
+			response: sdk.Client{},
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("BindCert", mock.Anything, tc.session, tc.clientID, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.BindClientCert(context.Background(), tc.clientID, tc.cert, tc.subject, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "BindCert", mock.Anything, tc.session, tc.clientID, tc.svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRevokeClientCert(t *testing.T) {
+	ts, tsvc, auth := setupClients()
+	defer ts.Close()
+
+	sdkClient := generateTestClient(t, false)
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		clientID        string
+		svcRes          clients.Client
+		svcErr          error
+		authenticateErr error
+		response        sdk.Client
+		err             errors.SDKError
+	}{
+		{
+			desc:     "revoke client certificate successfully",
+			domainID: domainID,
+			token:    validToken,
+			clientID: sdkClient.ID,
+			svcRes:   convertClient(sdkClient),
+			response: sdkClient,
+			err:      nil,
+		},
+		{
+			desc:            "revoke client certificate with an invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			clientID:        sdkClient.ID,
+			authenticateErr: svcerr.ErrAuthentication,
+			response:        sdk.Client{},
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "revoke client certificate with an invalid client id",
+			domainID: domainID,
+			token:    validToken,
+			clientID: wrongID,
+			svcErr:   svcerr.ErrUpdateEntity,
+			response: sdk.Client{},
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("RevokeCert", mock.Anything, tc.session, tc.clientID).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.RevokeClientCert(context.Background(), tc.clientID, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RevokeCert", mock.Anything, tc.session, tc.clientID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestEnableClient(t *testing.T) {
 	ts, tsvc, auth := setupClients()
 	defer ts.Close()
@@ -1257,7 +1546,7 @@ func TestEnableClient(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        enabledClient.Name,
 				Tags:        enabledClient.Tags,
-				Credentials: clients.Credentials(enabledClient.Credentials),
+				Credentials: convertCredentials(enabledClient.Credentials),
 				Metadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -1360,7 +1649,7 @@ func TestDisableClient(t *testing.T) {
 			svcRes: clients.Client{
 				Name:        disabledClient.Name,
 				Tags:        disabledClient.Tags,
-				Credentials: clients.Credentials(disabledClient.Credentials),
+				Credentials: convertCredentials(disabledClient.Credentials),
 				Metadata: clients.Metadata{
 					"test": make(chan int),
 				},
@@ -16,8 +16,12 @@ import (
 )
 
 const (
-	domainsEndpoint = "domains"
-	freezeEndpoint  = "freeze"
+	domainsEndpoint  = "domains"
+	freezeEndpoint   = "freeze"
+	restoreEndpoint  = "restore"
+	usageEndpoint    = "usage"
+	quotasEndpoint   = "quotas"
+	transferEndpoint = "transfer"
 )
 
 // Domain represents supermq domain.
@@ -37,6 +41,41 @@ type Domain struct {
 	Roles       []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
+// DomainTransfer represents the pending transfer of the domain ownership.
+type DomainTransfer struct {
+	DomainID   string    `json:"domain_id"`
+	FromUserID string    `json:"from_user_id"`
+	ToUserID   string    `json:"to_user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// DomainQuotas represents the limits of the domain resources.
+// The zero limit means the resource is not limited.
+type DomainQuotas struct {
+	Clients     uint64 `json:"clients"`
+	Channels    uint64 `json:"channels"`
+	Groups      uint64 `json:"groups"`
+	Invitations uint64 `json:"invitations"`
+	MessageRate uint64 `json:"message_rate"`
+}
+
+// DomainUsage represents the number of the domain resources in use.
+type DomainUsage struct {
+	Clients     uint64 `json:"clients"`
+	Channels    uint64 `json:"channels"`
+	Groups      uint64 `json:"groups"`
+	Invitations uint64 `json:"invitations"`
+}
+
+// DomainQuotasUsage represents the domain quotas together with the domain usage.
+type DomainQuotasUsage struct {
+	DomainID string       `json:"domain_id"`
+	Default  bool         `json:"default"`
+	Quotas   DomainQuotas `json:"quotas"`
+	Usage    DomainUsage  `json:"usage"`
+}
+
 func (sdk mgSDK) CreateDomain(ctx context.Context, domain Domain, token string) (Domain, errors.SDKError) {
 	data, err := json.Marshal(domain)
 	if err != nil {
@@ -130,6 +169,135 @@ func (sdk mgSDK) FreezeDomain(ctx context.Context, domainID, token string) error
 	return sdk.changeDomainStatus(ctx, token, domainID, freezeEndpoint)
 }
 
+func (sdk mgSDK) DeleteDomain(ctx context.Context, domainID, token string) errors.SDKError {
+	url := fmt.Sprintf("%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID)
+	_, _, sdkErr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent)
+	return sdkErr
+}
+
+func (sdk mgSDK) RestoreDomain(ctx context.Context, domainID, token string) errors.SDKError {
+	return sdk.changeDomainStatus(ctx, token, domainID, restoreEndpoint)
+}
+
+func (sdk mgSDK) DomainUsage(ctx context.Context, domainID, token string) (DomainQuotasUsage, errors.SDKError) {
+	if domainID == "" {
+		return DomainQuotasUsage{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, usageEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return DomainQuotasUsage{}, sdkErr
+	}
+
+	var usage DomainQuotasUsage
+	if err := json.Unmarshal(body, &usage); err != nil {
+		return DomainQuotasUsage{}, errors.NewSDKError(err)
+	}
+
+	return usage, nil
+}
+
+func (sdk mgSDK) UpdateDomainQuotas(ctx context.Context, domainID string, quotas DomainQuotas, token string) (DomainQuotas, errors.SDKError) {
+	if domainID == "" {
+		return DomainQuotas{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, quotasEndpoint)
+
+	data, err := json.Marshal(quotas)
+	if err != nil {
+		return DomainQuotas{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPut, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return DomainQuotas{}, sdkErr
+	}
+
+	var q DomainQuotas
+	if err := json.Unmarshal(body, &q); err != nil {
+		return DomainQuotas{}, errors.NewSDKError(err)
+	}
+
+	return q, nil
+}
+
+func (sdk mgSDK) TransferDomainOwnership(ctx context.Context, domainID, userID, token string) (DomainTransfer, errors.SDKError) {
+	if domainID == "" {
+		return DomainTransfer{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	if userID == "" {
+		return DomainTransfer{}, errors.NewSDKError(apiutil.ErrMissingUserID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, transferEndpoint)
+
+	data, err := json.Marshal(map[string]string{"user_id": userID})
+	if err != nil {
+		return DomainTransfer{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusCreated)
+	if sdkErr != nil {
+		return DomainTransfer{}, sdkErr
+	}
+
+	var t DomainTransfer
+	if err := json.Unmarshal(body, &t); err != nil {
+		return DomainTransfer{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) DomainTransfer(ctx context.Context, domainID, token string) (DomainTransfer, errors.SDKError) {
+	if domainID == "" {
+		return DomainTransfer{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, transferEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return DomainTransfer{}, sdkErr
+	}
+
+	var t DomainTransfer
+	if err := json.Unmarshal(body, &t); err != nil {
+		return DomainTransfer{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) AcceptDomainTransfer(ctx context.Context, domainID, token string) (DomainTransfer, errors.SDKError) {
+	if domainID == "" {
+		return DomainTransfer{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, transferEndpoint, acceptEndpoint)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return DomainTransfer{}, sdkErr
+	}
+
+	var t DomainTransfer
+	if err := json.Unmarshal(body, &t); err != nil {
+		return DomainTransfer{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) RejectDomainTransfer(ctx context.Context, domainID, token string) errors.SDKError {
+	if domainID == "" {
+		return errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, domainID, transferEndpoint, rejectEndpoint)
+
+	_, _, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, nil, nil, http.StatusNoContent)
+
+	return sdkErr
+}
+
 func (sdk mgSDK) changeDomainStatus(ctx context.Context, token, id, status string) errors.SDKError {
 	url := fmt.Sprintf("%s/%s/%s/%s", sdk.domainsURL, domainsEndpoint, id, status)
 	_, _, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, nil, nil, http.StatusOK)
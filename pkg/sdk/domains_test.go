@@ -834,6 +834,506 @@ func TestFreezeDomain(t *testing.T) {
 	}
 }
 
+func TestDeleteDomain(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		svcRes   domains.Domain
+		svcErr   error
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "delete domain successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   authDomain,
+			svcErr:   nil,
+			err:      nil,
+		},
+		{
+			desc:     "delete domain with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "delete domain with empty token",
+			token:    "",
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   nil,
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrBearerToken, http.StatusUnauthorized),
+		},
+		{
+			desc:     "delete domain with service error",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   svcerr.ErrUpdateEntity,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("DeleteDomain", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			err := mgsdk.DeleteDomain(context.Background(), tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "DeleteDomain", mock.Anything, tc.session, tc.domainID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRestoreDomain(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		svcRes   domains.Domain
+		svcErr   error
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "restore domain successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   authDomain,
+			svcErr:   nil,
+			err:      nil,
+		},
+		{
+			desc:     "restore domain with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "restore domain with empty token",
+			token:    "",
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   nil,
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrBearerToken, http.StatusUnauthorized),
+		},
+		{
+			desc:     "restore domain with empty domain id",
+			token:    validToken,
+			domainID: "",
+			svcRes:   domains.Domain{},
+			svcErr:   nil,
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrMissingDomainID, http.StatusBadRequest),
+		},
+		{
+			desc:     "restore domain which is not deleted",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   domains.Domain{},
+			svcErr:   svcerr.ErrInvalidStatus,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrInvalidStatus, http.StatusBadRequest),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RestoreDomain", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			err := mgsdk.RestoreDomain(context.Background(), tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RestoreDomain", mock.Anything, tc.session, tc.domainID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDomainUsage(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	usage := domains.QuotasUsage{
+		DomainID: sdkDomain.ID,
+		Quotas:   domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100},
+		Usage:    domains.Usage{Clients: 1, Channels: 2, Groups: 3, Invitations: 1},
+	}
+	sdkUsage := sdk.DomainQuotasUsage{
+		DomainID: sdkDomain.ID,
+		Quotas:   sdk.DomainQuotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100},
+		Usage:    sdk.DomainUsage{Clients: 1, Channels: 2, Groups: 3, Invitations: 1},
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		svcRes   domains.QuotasUsage
+		svcErr   error
+		authnErr error
+		response sdk.DomainQuotasUsage
+		err      error
+	}{
+		{
+			desc:     "retrieve domain usage successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   usage,
+			response: sdkUsage,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve domain usage with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "retrieve domain usage with empty token",
+			token:    "",
+			domainID: sdkDomain.ID,
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrBearerToken, http.StatusUnauthorized),
+		},
+		{
+			desc:     "retrieve domain usage with empty domain id",
+			token:    validToken,
+			domainID: "",
+			err:      errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:     "retrieve domain usage with service error",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcErr:   svcerr.ErrViewEntity,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrViewEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RetrieveUsage", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.DomainUsage(context.Background(), tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RetrieveUsage", mock.Anything, tc.session, tc.domainID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestUpdateDomainQuotas(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	quotas := domains.Quotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+	sdkQuotas := sdk.DomainQuotas{Clients: 10, Channels: 20, Groups: 5, Invitations: 3, MessageRate: 100}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		quotas   sdk.DomainQuotas
+		svcReq   domains.Quotas
+		svcRes   domains.Quotas
+		svcErr   error
+		authnErr error
+		response sdk.DomainQuotas
+		err      error
+	}{
+		{
+			desc:     "update domain quotas successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			quotas:   sdkQuotas,
+			svcReq:   quotas,
+			svcRes:   quotas,
+			response: sdkQuotas,
+			err:      nil,
+		},
+		{
+			desc:     "update domain quotas with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			quotas:   sdkQuotas,
+			svcReq:   quotas,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "update domain quotas with empty token",
+			token:    "",
+			domainID: sdkDomain.ID,
+			quotas:   sdkQuotas,
+			svcReq:   quotas,
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrBearerToken, http.StatusUnauthorized),
+		},
+		{
+			desc:     "update domain quotas with empty domain id",
+			token:    validToken,
+			domainID: "",
+			quotas:   sdkQuotas,
+			svcReq:   quotas,
+			err:      errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:     "update domain quotas with non super admin user",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			quotas:   sdkQuotas,
+			svcReq:   quotas,
+			svcErr:   svcerr.ErrSuperAdminAction,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrSuperAdminAction, http.StatusForbidden),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("UpdateQuotas", mock.Anything, tc.session, tc.domainID, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.UpdateDomainQuotas(context.Background(), tc.domainID, tc.quotas, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "UpdateQuotas", mock.Anything, tc.session, tc.domainID, tc.svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestTransferDomainOwnership(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	memberID := testsutil.GenerateUUID(t)
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	expiresAt := createdAt.Add(time.Hour)
+	transfer := domains.Transfer{DomainID: sdkDomain.ID, FromUserID: validID, ToUserID: memberID, CreatedAt: createdAt, ExpiresAt: expiresAt}
+	sdkTransfer := sdk.DomainTransfer{DomainID: sdkDomain.ID, FromUserID: validID, ToUserID: memberID, CreatedAt: createdAt, ExpiresAt: expiresAt}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		userID   string
+		svcRes   domains.Transfer
+		svcErr   error
+		authnErr error
+		response sdk.DomainTransfer
+		err      error
+	}{
+		{
+			desc:     "transfer domain ownership successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			userID:   memberID,
+			svcRes:   transfer,
+			response: sdkTransfer,
+			err:      nil,
+		},
+		{
+			desc:     "transfer domain ownership with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			userID:   memberID,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "transfer domain ownership with empty domain id",
+			token:    validToken,
+			domainID: "",
+			userID:   memberID,
+			err:      errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:     "transfer domain ownership with empty user id",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			userID:   "",
+			err:      errors.NewSDKError(apiutil.ErrMissingUserID),
+		},
+		{
+			desc:     "transfer domain ownership with unauthorized user",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			userID:   memberID,
+			svcErr:   svcerr.ErrAuthorization,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("TransferOwnership", mock.Anything, tc.session, tc.domainID, tc.userID).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.TransferDomainOwnership(context.Background(), tc.domainID, tc.userID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "TransferOwnership", mock.Anything, tc.session, tc.domainID, tc.userID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestAcceptDomainTransfer(t *testing.T) {
+	ds, svc, authn := setupDomains()
+	defer ds.Close()
+
+	sdkConf := sdk.Config{
+		DomainsURL:     ds.URL,
+		MsgContentType: contentType,
+	}
+
+	mgsdk := sdk.NewSDK(sdkConf)
+
+	fromUserID := testsutil.GenerateUUID(t)
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	expiresAt := createdAt.Add(time.Hour)
+	transfer := domains.Transfer{DomainID: sdkDomain.ID, FromUserID: fromUserID, ToUserID: validID, CreatedAt: createdAt, ExpiresAt: expiresAt}
+	sdkTransfer := sdk.DomainTransfer{DomainID: sdkDomain.ID, FromUserID: fromUserID, ToUserID: validID, CreatedAt: createdAt, ExpiresAt: expiresAt}
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		domainID string
+		svcRes   domains.Transfer
+		svcErr   error
+		authnErr error
+		response sdk.DomainTransfer
+		err      error
+	}{
+		{
+			desc:     "accept domain transfer successfully",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcRes:   transfer,
+			response: sdkTransfer,
+			err:      nil,
+		},
+		{
+			desc:     "accept domain transfer with invalid token",
+			token:    invalidToken,
+			domainID: sdkDomain.ID,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "accept domain transfer with empty domain id",
+			token:    validToken,
+			domainID: "",
+			err:      errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:     "accept domain transfer of other user",
+			token:    validToken,
+			domainID: sdkDomain.ID,
+			svcErr:   svcerr.ErrAuthorization,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: tc.domainID + "_" + validID, UserID: validID, DomainID: tc.domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("AcceptTransfer", mock.Anything, tc.session, tc.domainID).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.AcceptDomainTransfer(context.Background(), tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "AcceptTransfer", mock.Anything, tc.session, tc.domainID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestCreateDomainRole(t *testing.T) {
 	ts, csvc, auth := setupDomains()
 	defer ts.Close()
@@ -22,6 +22,7 @@ const (
 type Invitation struct {
 	InvitedBy     string    `json:"invited_by"`
 	InviteeUserID string    `json:"invitee_user_id"`
+	InviteeEmail  string    `json:"invitee_email,omitempty"`
 	DomainID      string    `json:"domain_id"`
 	DomainName    string    `json:"domain_name,omitempty"`
 	RoleID        string    `json:"role_id,omitempty"`
@@ -31,6 +32,8 @@ type Invitation struct {
 	UpdatedAt     time.Time `json:"updated_at,omitempty"`
 	ConfirmedAt   time.Time `json:"confirmed_at,omitempty"`
 	RejectedAt    time.Time `json:"rejected_at,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at,omitzero"`
+	ResendCount   uint64    `json:"resend_count,omitempty"`
 	Resend        bool      `json:"resend,omitempty"`
 }
 
@@ -5,15 +5,71 @@ package sdk
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/pkg/errors"
+	"github.com/gorilla/websocket"
 )
 
-const channelParts = 2
+const (
+	channelParts = 2
+
+	replayFrameID    = "replay"
+	subscribeFrame   = "subscribe"
+	ackFrame         = "ack"
+	errorFrame       = "error"
+	messageFrame     = "message"
+	handshakeTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidReplayPolicy indicates that none or more than one replay start point is set.
+	ErrInvalidReplayPolicy = errors.New("exactly one replay start point must be set")
+
+	// ErrFailedReplay indicates that the message replay subscription failed.
+	ErrFailedReplay = errors.New("failed to replay messages")
+)
+
+// ReplayPolicy defines the point in the channel history from which
+// ReplayMessages starts delivering messages. Exactly one field must be set.
+type ReplayPolicy struct {
+	StartTime     time.Time
+	StartSequence uint64
+	Last          uint64
+}
+
+// ReplayedMessage represents the message delivered by ReplayMessages.
+type ReplayedMessage struct {
+	Channel   string `json:"channel"`
+	Subtopic  string `json:"subtopic,omitempty"`
+	Publisher string `json:"publisher"`
+	Protocol  string `json:"protocol"`
+	Created   int64  `json:"created"`
+	Payload   []byte `json:"payload"`
+}
+
+// replayFrame is the frame of the HTTP adapter multiplexed WebSocket protocol.
+type replayFrame struct {
+	ID            string    `json:"id,omitempty"`
+	Type          string    `json:"type"`
+	Channel       string    `json:"channel,omitempty"`
+	Subtopic      string    `json:"subtopic,omitempty"`
+	Publisher     string    `json:"publisher,omitempty"`
+	Protocol      string    `json:"protocol,omitempty"`
+	Created       int64     `json:"created,omitempty"`
+	Payload       []byte    `json:"payload,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	Dropped       uint64    `json:"dropped,omitempty"`
+	StartTime     time.Time `json:"start_time,omitzero"`
+	StartSequence uint64    `json:"start_seq,omitempty"`
+	Last          uint64    `json:"last,omitempty"`
+}
 
 func (sdk mgSDK) SendMessage(ctx context.Context, domainID, topic, msg, secret string) errors.SDKError {
 	chanNameParts := strings.SplitN(topic, ".", channelParts)
@@ -30,6 +86,108 @@ func (sdk mgSDK) SendMessage(ctx context.Context, domainID, topic, msg, secret s
 	return err
 }
 
+func (sdk mgSDK) ReplayMessages(ctx context.Context, domainID, topic string, rp ReplayPolicy, secret string, handler func(ReplayedMessage) error) errors.SDKError {
+	if err := rp.validate(); err != nil {
+		return errors.NewSDKError(err)
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/m/%s/ws", sdk.httpAdapterURL, domainID))
+	if err != nil {
+		return errors.NewSDKError(err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: handshakeTimeout,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: !sdk.tlsVerification,
+		},
+	}
+	header := http.Header{}
+	header.Set("Authorization", ClientPrefix+secret)
+	conn, resp, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil {
+			return errors.NewSDKErrorWithStatus(errors.Wrap(ErrFailedReplay, err), resp.StatusCode)
+		}
+		return errors.NewSDKError(errors.Wrap(ErrFailedReplay, err))
+	}
+	defer conn.Close()
+
+	// Unblock reading from the connection once the context is done.
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	chanNameParts := strings.SplitN(topic, ".", channelParts)
+	req := replayFrame{
+		ID:            replayFrameID,
+		Type:          subscribeFrame,
+		Channel:       chanNameParts[0],
+		StartTime:     rp.StartTime,
+		StartSequence: rp.StartSequence,
+		Last:          rp.Last,
+	}
+	if len(chanNameParts) == channelParts {
+		req.Subtopic = strings.ReplaceAll(chanNameParts[1], ".", "/")
+	}
+	if err := conn.WriteJSON(req); err != nil {
+		return errors.NewSDKError(errors.Wrap(ErrFailedReplay, err))
+	}
+
+	for {
+		var f replayFrame
+		if err := conn.ReadJSON(&f); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.NewSDKError(errors.Wrap(ErrFailedReplay, err))
+		}
+		switch f.Type {
+		case ackFrame:
+		case errorFrame:
+			return errors.NewSDKError(errors.Wrap(ErrFailedReplay, errors.New(f.Error)))
+		case messageFrame:
+			msg := ReplayedMessage{
+				Channel:   f.Channel,
+				Subtopic:  f.Subtopic,
+				Publisher: f.Publisher,
+				Protocol:  f.Protocol,
+				Created:   f.Created,
+				Payload:   f.Payload,
+			}
+			if err := handler(msg); err != nil {
+				return errors.NewSDKError(err)
+			}
+		}
+	}
+}
+
+func (rp ReplayPolicy) validate() error {
+	set := 0
+	if !rp.StartTime.IsZero() {
+		set++
+	}
+	if rp.StartSequence > 0 {
+		set++
+	}
+	if rp.Last > 0 {
+		set++
+	}
+	if set != 1 {
+		return ErrInvalidReplayPolicy
+	}
+
+	return nil
+}
+
 func (sdk *mgSDK) SetContentType(ct ContentType) errors.SDKError {
 	if ct != CTJSON && ct != CTJSONSenML && ct != CTBinary {
 		return errors.NewSDKError(apiutil.ErrUnsupportedContentType)
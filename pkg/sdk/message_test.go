@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/absmach/mgate"
 	proxy "github.com/absmach/mgate/pkg/http"
@@ -25,12 +26,15 @@ import (
 	adapter "github.com/absmach/supermq/http"
 	"github.com/absmach/supermq/http/api"
 	httpmocks "github.com/absmach/supermq/http/mocks"
+	"github.com/absmach/supermq/internal/testsutil"
 	smqlog "github.com/absmach/supermq/logger"
 	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	"github.com/absmach/supermq/pkg/messaging"
 	pubsub "github.com/absmach/supermq/pkg/messaging/mocks"
+	rlmocks "github.com/absmach/supermq/pkg/ratelimit/mocks"
+	schemamocks "github.com/absmach/supermq/pkg/schema/mocks"
 	sdk "github.com/absmach/supermq/pkg/sdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -52,7 +56,11 @@ func setupMessages(t *testing.T) (*httptest.Server, *pubsub.PubSub) {
 
 	parser, err := messaging.NewTopicParser(messaging.DefaultCacheConfig, channelsGRPCClient, domainsGRPCClient)
 	assert.Nil(t, err, fmt.Sprintf("unexpected error while setting up parser: %v", err))
-	handler := adapter.NewHandler(pub, smqlog.NewMock(), authn, clientsGRPCClient, channelsGRPCClient, parser)
+	validator := new(schemamocks.Validator)
+	validator.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	limiter := new(rlmocks.Limiter)
+	limiter.On("Allow", mock.Anything, mock.Anything).Return(nil)
+	handler := adapter.NewHandler(pub, smqlog.NewMock(), authn, clientsGRPCClient, channelsGRPCClient, parser, validator, limiter)
 	resolver := messaging.NewTopicResolver(channelsGRPCClient, domainsGRPCClient)
 
 	mux := api.MakeHandler(context.Background(), svc, resolver, smqlog.NewMock(), "")
@@ -237,3 +245,102 @@ func TestSetContentType(t *testing.T) {
 		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s, got %s", tc.desc, tc.err, err))
 	}
 }
+
+func TestReplayMessages(t *testing.T) {
+	svc := new(httpmocks.Service)
+	resolver := messaging.NewTopicResolver(new(chmocks.ChannelsServiceClient), new(dmocks.DomainsServiceClient))
+	ts := httptest.NewServer(api.MakeHandler(context.Background(), svc, resolver, smqlog.NewMock(), ""))
+	defer ts.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{HTTPAdapterURL: ts.URL})
+	// Subscriptions are closed asynchronously once the connection is closed.
+	svc.On("Unsubscribe", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	domainID := testsutil.GenerateUUID(t)
+	channelID := testsutil.GenerateUUID(t)
+	msg := &messaging.Message{
+		Domain:    domainID,
+		Channel:   channelID,
+		Subtopic:  "sensors.temp",
+		Publisher: testsutil.GenerateUUID(t),
+		Protocol:  "http",
+		Created:   time.Now().UnixNano(),
+		Payload:   []byte(`{"temp":22.5}`),
+	}
+
+	cases := []struct {
+		desc     string
+		topic    string
+		rp       sdk.ReplayPolicy
+		subtopic string
+		replay   messaging.Replay
+		svcErr   error
+		err      error
+	}{
+		{
+			desc:   "replay last messages successfully",
+			topic:  channelID,
+			rp:     sdk.ReplayPolicy{Last: 10},
+			replay: messaging.Replay{LastN: 10},
+		},
+		{
+			desc:     "replay messages of subtopic from start time successfully",
+			topic:    channelID + ".sensors.temp",
+			rp:       sdk.ReplayPolicy{StartTime: time.Unix(1700000000, 0).UTC()},
+			subtopic: "sensors.temp",
+			replay:   messaging.Replay{StartTime: time.Unix(1700000000, 0).UTC()},
+		},
+		{
+			desc:  "replay messages without start point",
+			topic: channelID,
+			rp:    sdk.ReplayPolicy{},
+			err:   sdk.ErrInvalidReplayPolicy,
+		},
+		{
+			desc:  "replay messages with conflicting start points",
+			topic: channelID,
+			rp:    sdk.ReplayPolicy{StartSequence: 1, Last: 10},
+			err:   sdk.ErrInvalidReplayPolicy,
+		},
+		{
+			desc:   "replay messages with failed subscription",
+			topic:  channelID,
+			rp:     sdk.ReplayPolicy{StartSequence: 1},
+			replay: messaging.Replay{StartSequence: 1},
+			svcErr: svcerr.ErrAuthorization,
+			err:    sdk.ErrFailedReplay,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("Subscribe", mock.Anything, mock.Anything, "", sdk.ClientPrefix+"secret", domainID, channelID, tc.subtopic, messaging.MessageType, tc.replay, mock.Anything).
+				Run(func(args mock.Arguments) {
+					if tc.svcErr == nil {
+						_ = args.Get(9).(*adapter.Client).Handle(msg)
+					}
+				}).Return(tc.svcErr)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			var received []sdk.ReplayedMessage
+			err := mgsdk.ReplayMessages(ctx, domainID, tc.topic, tc.rp, "secret", func(m sdk.ReplayedMessage) error {
+				received = append(received, m)
+				cancel()
+				return nil
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected error %v got %v", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, []sdk.ReplayedMessage{{
+					Channel:   msg.Channel,
+					Subtopic:  msg.Subtopic,
+					Publisher: msg.Publisher,
+					Protocol:  msg.Protocol,
+					Created:   msg.Created,
+					Payload:   msg.Payload,
+				}}, received)
+			}
+			svcCall.Unset()
+		})
+	}
+}
@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/pkg/errors"
 	"github.com/absmach/supermq/pkg/sdk"
@@ -43,6 +44,80 @@ func (_m *SDK) EXPECT() *SDK_Expecter {
 	return &SDK_Expecter{mock: &_m.Mock}
 }
 
+// AcceptDomainTransfer provides a mock function for the type SDK
+func (_mock *SDK) AcceptDomainTransfer(ctx context.Context, domainID string, token string) (sdk.DomainTransfer, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptDomainTransfer")
+	}
+
+	var r0 sdk.DomainTransfer
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (sdk.DomainTransfer, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) sdk.DomainTransfer); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_AcceptDomainTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptDomainTransfer'
+type SDK_AcceptDomainTransfer_Call struct {
+	*mock.Call
+}
+
+// AcceptDomainTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) AcceptDomainTransfer(ctx interface{}, domainID interface{}, token interface{}) *SDK_AcceptDomainTransfer_Call {
+	return &SDK_AcceptDomainTransfer_Call{Call: _e.mock.On("AcceptDomainTransfer", ctx, domainID, token)}
+}
+
+func (_c *SDK_AcceptDomainTransfer_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_AcceptDomainTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_AcceptDomainTransfer_Call) Return(domainTransfer sdk.DomainTransfer, sDKError errors.SDKError) *SDK_AcceptDomainTransfer_Call {
+	_c.Call.Return(domainTransfer, sDKError)
+	return _c
+}
+
+func (_c *SDK_AcceptDomainTransfer_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) (sdk.DomainTransfer, errors.SDKError)) *SDK_AcceptDomainTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AcceptInvitation provides a mock function for the type SDK
 func (_mock *SDK) AcceptInvitation(ctx context.Context, domainID string, token string) error {
 	ret := _mock.Called(ctx, domainID, token)
@@ -957,6 +1032,98 @@ func (_c *SDK_AvailableGroupRoleActions_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// BindClientCert provides a mock function for the type SDK
+func (_mock *SDK) BindClientCert(ctx context.Context, id string, cert string, subject string, domainID string, token string) (sdk.Client, errors.SDKError) {
+	ret := _mock.Called(ctx, id, cert, subject, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindClientCert")
+	}
+
+	var r0 sdk.Client
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (sdk.Client, errors.SDKError)); ok {
+		return returnFunc(ctx, id, cert, subject, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) sdk.Client); ok {
+		r0 = returnFunc(ctx, id, cert, subject, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, cert, subject, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_BindClientCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindClientCert'
+type SDK_BindClientCert_Call struct {
+	*mock.Call
+}
+
+// BindClientCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - cert string
+//   - subject string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) BindClientCert(ctx interface{}, id interface{}, cert interface{}, subject interface{}, domainID interface{}, token interface{}) *SDK_BindClientCert_Call {
+	return &SDK_BindClientCert_Call{Call: _e.mock.On("BindClientCert", ctx, id, cert, subject, domainID, token)}
+}
+
+func (_c *SDK_BindClientCert_Call) Run(run func(ctx context.Context, id string, cert string, subject string, domainID string, token string)) *SDK_BindClientCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_BindClientCert_Call) Return(client sdk.Client, sDKError errors.SDKError) *SDK_BindClientCert_Call {
+	_c.Call.Return(client, sDKError)
+	return _c
+}
+
+func (_c *SDK_BindClientCert_Call) RunAndReturn(run func(ctx context.Context, id string, cert string, subject string, domainID string, token string) (sdk.Client, errors.SDKError)) *SDK_BindClientCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Channel provides a mock function for the type SDK
 func (_mock *SDK) Channel(ctx context.Context, id string, domainID string, token string) (sdk.Channel, errors.SDKError) {
 	ret := _mock.Called(ctx, id, domainID, token)
@@ -2599,6 +2766,86 @@ func (_c *SDK_CreateGroupRole_Call) RunAndReturn(run func(ctx context.Context, i
 	return _c
 }
 
+// CreateRule provides a mock function for the type SDK
+func (_mock *SDK) CreateRule(ctx context.Context, rule sdk.Rule, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, rule, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 sdk.Rule
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Rule, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, rule, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Rule, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, rule, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.Rule, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, rule, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type SDK_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule sdk.Rule
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) CreateRule(ctx interface{}, rule interface{}, domainID interface{}, token interface{}) *SDK_CreateRule_Call {
+	return &SDK_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, rule, domainID, token)}
+}
+
+func (_c *SDK_CreateRule_Call) Run(run func(ctx context.Context, rule sdk.Rule, domainID string, token string)) *SDK_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.Rule
+		if args[1] != nil {
+			arg1 = args[1].(sdk.Rule)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_CreateRule_Call) Return(rule1 sdk.Rule, sDKError errors.SDKError) *SDK_CreateRule_Call {
+	_c.Call.Return(rule1, sDKError)
+	return _c
+}
+
+func (_c *SDK_CreateRule_Call) RunAndReturn(run func(ctx context.Context, rule sdk.Rule, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateToken provides a mock function for the type SDK
 func (_mock *SDK) CreateToken(ctx context.Context, lt sdk.Login) (sdk.Token, errors.SDKError) {
 	ret := _mock.Called(ctx, lt)
@@ -2960,6 +3207,71 @@ func (_c *SDK_DeleteClientRole_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// DeleteDomain provides a mock function for the type SDK
+func (_mock *SDK) DeleteDomain(ctx context.Context, domainID string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDomain")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_DeleteDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomain'
+type SDK_DeleteDomain_Call struct {
+	*mock.Call
+}
+
+// DeleteDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) DeleteDomain(ctx interface{}, domainID interface{}, token interface{}) *SDK_DeleteDomain_Call {
+	return &SDK_DeleteDomain_Call{Call: _e.mock.On("DeleteDomain", ctx, domainID, token)}
+}
+
+func (_c *SDK_DeleteDomain_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_DeleteDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DeleteDomain_Call) Return(sDKError errors.SDKError) *SDK_DeleteDomain_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_DeleteDomain_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) errors.SDKError) *SDK_DeleteDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteDomainRole provides a mock function for the type SDK
 func (_mock *SDK) DeleteDomainRole(ctx context.Context, id string, roleID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, id, roleID, token)
@@ -3248,17 +3560,17 @@ func (_c *SDK_DeleteInvitation_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
-// DeleteUser provides a mock function for the type SDK
-func (_mock *SDK) DeleteUser(ctx context.Context, id string, token string) errors.SDKError {
-	ret := _mock.Called(ctx, id, token)
+// DeleteRule provides a mock function for the type SDK
+func (_mock *SDK) DeleteRule(ctx context.Context, id string, domainID string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, id, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for DeleteRule")
 	}
 
 	var r0 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
-		r0 = returnFunc(ctx, id, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.SDKError)
@@ -3267,17 +3579,88 @@ func (_mock *SDK) DeleteUser(ctx context.Context, id string, token string) error
 	return r0
 }
 
-// SDK_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type SDK_DeleteUser_Call struct {
+// SDK_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type SDK_DeleteRule_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// DeleteRule is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
+//   - domainID string
 //   - token string
-func (_e *SDK_Expecter) DeleteUser(ctx interface{}, id interface{}, token interface{}) *SDK_DeleteUser_Call {
-	return &SDK_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id, token)}
+func (_e *SDK_Expecter) DeleteRule(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_DeleteRule_Call {
+	return &SDK_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_DeleteRule_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DeleteRule_Call) Return(sDKError errors.SDKError) *SDK_DeleteRule_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_DeleteRule_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) errors.SDKError) *SDK_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type SDK
+func (_mock *SDK) DeleteUser(ctx context.Context, id string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, id, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, id, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type SDK_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - token string
+func (_e *SDK_Expecter) DeleteUser(ctx interface{}, id interface{}, token interface{}) *SDK_DeleteUser_Call {
+	return &SDK_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id, token)}
 }
 
 func (_c *SDK_DeleteUser_Call) Run(run func(ctx context.Context, id string, token string)) *SDK_DeleteUser_Call {
@@ -3618,6 +4001,86 @@ func (_c *SDK_DisableGroup_Call) RunAndReturn(run func(ctx context.Context, id s
 	return _c
 }
 
+// DisableRule provides a mock function for the type SDK
+func (_mock *SDK) DisableRule(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableRule")
+	}
+
+	var r0 sdk.Rule
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_DisableRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableRule'
+type SDK_DisableRule_Call struct {
+	*mock.Call
+}
+
+// DisableRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) DisableRule(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_DisableRule_Call {
+	return &SDK_DisableRule_Call{Call: _e.mock.On("DisableRule", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_DisableRule_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_DisableRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DisableRule_Call) Return(rule sdk.Rule, sDKError errors.SDKError) *SDK_DisableRule_Call {
+	_c.Call.Return(rule, sDKError)
+	return _c
+}
+
+func (_c *SDK_DisableRule_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_DisableRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisableUser provides a mock function for the type SDK
 func (_mock *SDK) DisableUser(ctx context.Context, id string, token string) (sdk.User, errors.SDKError) {
 	ret := _mock.Called(ctx, id, token)
@@ -4326,6 +4789,154 @@ func (_c *SDK_DomainRoles_Call) RunAndReturn(run func(ctx context.Context, id st
 	return _c
 }
 
+// DomainTransfer provides a mock function for the type SDK
+func (_mock *SDK) DomainTransfer(ctx context.Context, domainID string, token string) (sdk.DomainTransfer, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DomainTransfer")
+	}
+
+	var r0 sdk.DomainTransfer
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (sdk.DomainTransfer, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) sdk.DomainTransfer); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_DomainTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DomainTransfer'
+type SDK_DomainTransfer_Call struct {
+	*mock.Call
+}
+
+// DomainTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) DomainTransfer(ctx interface{}, domainID interface{}, token interface{}) *SDK_DomainTransfer_Call {
+	return &SDK_DomainTransfer_Call{Call: _e.mock.On("DomainTransfer", ctx, domainID, token)}
+}
+
+func (_c *SDK_DomainTransfer_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_DomainTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DomainTransfer_Call) Return(domainTransfer sdk.DomainTransfer, sDKError errors.SDKError) *SDK_DomainTransfer_Call {
+	_c.Call.Return(domainTransfer, sDKError)
+	return _c
+}
+
+func (_c *SDK_DomainTransfer_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) (sdk.DomainTransfer, errors.SDKError)) *SDK_DomainTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DomainUsage provides a mock function for the type SDK
+func (_mock *SDK) DomainUsage(ctx context.Context, domainID string, token string) (sdk.DomainQuotasUsage, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DomainUsage")
+	}
+
+	var r0 sdk.DomainQuotasUsage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (sdk.DomainQuotasUsage, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) sdk.DomainQuotasUsage); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainQuotasUsage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_DomainUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DomainUsage'
+type SDK_DomainUsage_Call struct {
+	*mock.Call
+}
+
+// DomainUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) DomainUsage(ctx interface{}, domainID interface{}, token interface{}) *SDK_DomainUsage_Call {
+	return &SDK_DomainUsage_Call{Call: _e.mock.On("DomainUsage", ctx, domainID, token)}
+}
+
+func (_c *SDK_DomainUsage_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_DomainUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DomainUsage_Call) Return(domainQuotasUsage sdk.DomainQuotasUsage, sDKError errors.SDKError) *SDK_DomainUsage_Call {
+	_c.Call.Return(domainQuotasUsage, sDKError)
+	return _c
+}
+
+func (_c *SDK_DomainUsage_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) (sdk.DomainQuotasUsage, errors.SDKError)) *SDK_DomainUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Domains provides a mock function for the type SDK
 func (_mock *SDK) Domains(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.DomainsPage, errors.SDKError) {
 	ret := _mock.Called(ctx, pm, token)
@@ -4705,18 +5316,98 @@ func (_c *SDK_EnableGroup_Call) RunAndReturn(run func(ctx context.Context, id st
 	return _c
 }
 
-// EnableUser provides a mock function for the type SDK
-func (_mock *SDK) EnableUser(ctx context.Context, id string, token string) (sdk.User, errors.SDKError) {
-	ret := _mock.Called(ctx, id, token)
+// EnableRule provides a mock function for the type SDK
+func (_mock *SDK) EnableRule(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnableUser")
+		panic("no return value specified for EnableRule")
 	}
 
-	var r0 sdk.User
+	var r0 sdk.Rule
 	var r1 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (sdk.User, errors.SDKError)); ok {
-		return returnFunc(ctx, id, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_EnableRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableRule'
+type SDK_EnableRule_Call struct {
+	*mock.Call
+}
+
+// EnableRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) EnableRule(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_EnableRule_Call {
+	return &SDK_EnableRule_Call{Call: _e.mock.On("EnableRule", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_EnableRule_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_EnableRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_EnableRule_Call) Return(rule sdk.Rule, sDKError errors.SDKError) *SDK_EnableRule_Call {
+	_c.Call.Return(rule, sDKError)
+	return _c
+}
+
+func (_c *SDK_EnableRule_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_EnableRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableUser provides a mock function for the type SDK
+func (_mock *SDK) EnableUser(ctx context.Context, id string, token string) (sdk.User, errors.SDKError) {
+	ret := _mock.Called(ctx, id, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableUser")
+	}
+
+	var r0 sdk.User
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (sdk.User, errors.SDKError)); ok {
+		return returnFunc(ctx, id, token)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) sdk.User); ok {
 		r0 = returnFunc(ctx, id, token)
@@ -4779,6 +5470,86 @@ func (_c *SDK_EnableUser_Call) RunAndReturn(run func(ctx context.Context, id str
 	return _c
 }
 
+// ExportDomain provides a mock function for the type SDK
+func (_mock *SDK) ExportDomain(ctx context.Context, domainID string, withSecrets bool, token string) (sdk.DomainArchive, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, withSecrets, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportDomain")
+	}
+
+	var r0 sdk.DomainArchive
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, string) (sdk.DomainArchive, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, withSecrets, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, string) sdk.DomainArchive); ok {
+		r0 = returnFunc(ctx, domainID, withSecrets, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainArchive)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, withSecrets, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ExportDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportDomain'
+type SDK_ExportDomain_Call struct {
+	*mock.Call
+}
+
+// ExportDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - withSecrets bool
+//   - token string
+func (_e *SDK_Expecter) ExportDomain(ctx interface{}, domainID interface{}, withSecrets interface{}, token interface{}) *SDK_ExportDomain_Call {
+	return &SDK_ExportDomain_Call{Call: _e.mock.On("ExportDomain", ctx, domainID, withSecrets, token)}
+}
+
+func (_c *SDK_ExportDomain_Call) Run(run func(ctx context.Context, domainID string, withSecrets bool, token string)) *SDK_ExportDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ExportDomain_Call) Return(domainArchive sdk.DomainArchive, sDKError errors.SDKError) *SDK_ExportDomain_Call {
+	_c.Call.Return(domainArchive, sDKError)
+	return _c
+}
+
+func (_c *SDK_ExportDomain_Call) RunAndReturn(run func(ctx context.Context, domainID string, withSecrets bool, token string) (sdk.DomainArchive, errors.SDKError)) *SDK_ExportDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FreezeDomain provides a mock function for the type SDK
 func (_mock *SDK) FreezeDomain(ctx context.Context, domainID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, domainID, token)
@@ -5504,6 +6275,86 @@ func (_c *SDK_Hierarchy_Call) RunAndReturn(run func(ctx context.Context, id stri
 	return _c
 }
 
+// ImportDomain provides a mock function for the type SDK
+func (_mock *SDK) ImportDomain(ctx context.Context, archive sdk.DomainArchive, preserveIDs bool, token string) (sdk.Domain, errors.SDKError) {
+	ret := _mock.Called(ctx, archive, preserveIDs, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportDomain")
+	}
+
+	var r0 sdk.Domain
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.DomainArchive, bool, string) (sdk.Domain, errors.SDKError)); ok {
+		return returnFunc(ctx, archive, preserveIDs, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.DomainArchive, bool, string) sdk.Domain); ok {
+		r0 = returnFunc(ctx, archive, preserveIDs, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Domain)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.DomainArchive, bool, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, archive, preserveIDs, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ImportDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportDomain'
+type SDK_ImportDomain_Call struct {
+	*mock.Call
+}
+
+// ImportDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - archive sdk.DomainArchive
+//   - preserveIDs bool
+//   - token string
+func (_e *SDK_Expecter) ImportDomain(ctx interface{}, archive interface{}, preserveIDs interface{}, token interface{}) *SDK_ImportDomain_Call {
+	return &SDK_ImportDomain_Call{Call: _e.mock.On("ImportDomain", ctx, archive, preserveIDs, token)}
+}
+
+func (_c *SDK_ImportDomain_Call) Run(run func(ctx context.Context, archive sdk.DomainArchive, preserveIDs bool, token string)) *SDK_ImportDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.DomainArchive
+		if args[1] != nil {
+			arg1 = args[1].(sdk.DomainArchive)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ImportDomain_Call) Return(domain sdk.Domain, sDKError errors.SDKError) *SDK_ImportDomain_Call {
+	_c.Call.Return(domain, sDKError)
+	return _c
+}
+
+func (_c *SDK_ImportDomain_Call) RunAndReturn(run func(ctx context.Context, archive sdk.DomainArchive, preserveIDs bool, token string) (sdk.Domain, errors.SDKError)) *SDK_ImportDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Invitations provides a mock function for the type SDK
 func (_mock *SDK) Invitations(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.InvitationPage, error) {
 	ret := _mock.Called(ctx, pm, token)
@@ -6004,6 +6855,80 @@ func (_c *SDK_ListGroupMembers_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ListSessions provides a mock function for the type SDK
+func (_mock *SDK) ListSessions(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.SessionsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, pm, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 sdk.SessionsPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string) (sdk.SessionsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, pm, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string) sdk.SessionsPage); ok {
+		r0 = returnFunc(ctx, pm, token)
+	} else {
+		r0 = ret.Get(0).(sdk.SessionsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type SDK_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm sdk.PageMetadata
+//   - token string
+func (_e *SDK_Expecter) ListSessions(ctx interface{}, pm interface{}, token interface{}) *SDK_ListSessions_Call {
+	return &SDK_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, pm, token)}
+}
+
+func (_c *SDK_ListSessions_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, token string)) *SDK_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.PageMetadata
+		if args[1] != nil {
+			arg1 = args[1].(sdk.PageMetadata)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ListSessions_Call) Return(sessionsPage sdk.SessionsPage, sDKError errors.SDKError) *SDK_ListSessions_Call {
+	_c.Call.Return(sessionsPage, sDKError)
+	return _c
+}
+
+func (_c *SDK_ListSessions_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.SessionsPage, errors.SDKError)) *SDK_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshToken provides a mock function for the type SDK
 func (_mock *SDK) RefreshToken(ctx context.Context, token string) (sdk.Token, errors.SDKError) {
 	ret := _mock.Called(ctx, token)
@@ -6072,37 +6997,102 @@ func (_c *SDK_RefreshToken_Call) RunAndReturn(run func(ctx context.Context, toke
 	return _c
 }
 
-// RejectInvitation provides a mock function for the type SDK
-func (_mock *SDK) RejectInvitation(ctx context.Context, domainID string, token string) error {
+// RejectDomainTransfer provides a mock function for the type SDK
+func (_mock *SDK) RejectDomainTransfer(ctx context.Context, domainID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RejectInvitation")
+		panic("no return value specified for RejectDomainTransfer")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
 		r0 = returnFunc(ctx, domainID, token)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
 	}
 	return r0
 }
 
-// SDK_RejectInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectInvitation'
-type SDK_RejectInvitation_Call struct {
+// SDK_RejectDomainTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectDomainTransfer'
+type SDK_RejectDomainTransfer_Call struct {
 	*mock.Call
 }
 
-// RejectInvitation is a helper method to define mock.On call
+// RejectDomainTransfer is a helper method to define mock.On call
 //   - ctx context.Context
 //   - domainID string
 //   - token string
-func (_e *SDK_Expecter) RejectInvitation(ctx interface{}, domainID interface{}, token interface{}) *SDK_RejectInvitation_Call {
-	return &SDK_RejectInvitation_Call{Call: _e.mock.On("RejectInvitation", ctx, domainID, token)}
+func (_e *SDK_Expecter) RejectDomainTransfer(ctx interface{}, domainID interface{}, token interface{}) *SDK_RejectDomainTransfer_Call {
+	return &SDK_RejectDomainTransfer_Call{Call: _e.mock.On("RejectDomainTransfer", ctx, domainID, token)}
 }
 
-func (_c *SDK_RejectInvitation_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_RejectInvitation_Call {
+func (_c *SDK_RejectDomainTransfer_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_RejectDomainTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RejectDomainTransfer_Call) Return(sDKError errors.SDKError) *SDK_RejectDomainTransfer_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_RejectDomainTransfer_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) errors.SDKError) *SDK_RejectDomainTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectInvitation provides a mock function for the type SDK
+func (_mock *SDK) RejectInvitation(ctx context.Context, domainID string, token string) error {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectInvitation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// SDK_RejectInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectInvitation'
+type SDK_RejectInvitation_Call struct {
+	*mock.Call
+}
+
+// RejectInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RejectInvitation(ctx interface{}, domainID interface{}, token interface{}) *SDK_RejectInvitation_Call {
+	return &SDK_RejectInvitation_Call{Call: _e.mock.On("RejectInvitation", ctx, domainID, token)}
+}
+
+func (_c *SDK_RejectInvitation_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_RejectInvitation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -7450,40 +8440,656 @@ func (_c *SDK_RemoveGroupRoleMembers_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
-// ResetPassword provides a mock function for the type SDK
-func (_mock *SDK) ResetPassword(ctx context.Context, password string, confPass string, token string) errors.SDKError {
-	ret := _mock.Called(ctx, password, confPass, token)
+// ReplayMessages provides a mock function for the type SDK
+func (_mock *SDK) ReplayMessages(ctx context.Context, domainID string, topic string, rp sdk.ReplayPolicy, secret string, handler func(sdk.ReplayedMessage) error) errors.SDKError {
+	ret := _mock.Called(ctx, domainID, topic, rp, secret, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayMessages")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, sdk.ReplayPolicy, string, func(sdk.ReplayedMessage) error) errors.SDKError); ok {
+		r0 = returnFunc(ctx, domainID, topic, rp, secret, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_ReplayMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayMessages'
+type SDK_ReplayMessages_Call struct {
+	*mock.Call
+}
+
+// ReplayMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - topic string
+//   - rp sdk.ReplayPolicy
+//   - secret string
+//   - handler func(sdk.ReplayedMessage) error
+func (_e *SDK_Expecter) ReplayMessages(ctx interface{}, domainID interface{}, topic interface{}, rp interface{}, secret interface{}, handler interface{}) *SDK_ReplayMessages_Call {
+	return &SDK_ReplayMessages_Call{Call: _e.mock.On("ReplayMessages", ctx, domainID, topic, rp, secret, handler)}
+}
+
+func (_c *SDK_ReplayMessages_Call) Run(run func(ctx context.Context, domainID string, topic string, rp sdk.ReplayPolicy, secret string, handler func(sdk.ReplayedMessage) error)) *SDK_ReplayMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 sdk.ReplayPolicy
+		if args[3] != nil {
+			arg3 = args[3].(sdk.ReplayPolicy)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 func(sdk.ReplayedMessage) error
+		if args[5] != nil {
+			arg5 = args[5].(func(sdk.ReplayedMessage) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ReplayMessages_Call) Return(sDKError errors.SDKError) *SDK_ReplayMessages_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_ReplayMessages_Call) RunAndReturn(run func(ctx context.Context, domainID string, topic string, rp sdk.ReplayPolicy, secret string, handler func(sdk.ReplayedMessage) error) errors.SDKError) *SDK_ReplayMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type SDK
+func (_mock *SDK) ResetPassword(ctx context.Context, password string, confPass string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, password, confPass, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, password, confPass, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type SDK_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - password string
+//   - confPass string
+//   - token string
+func (_e *SDK_Expecter) ResetPassword(ctx interface{}, password interface{}, confPass interface{}, token interface{}) *SDK_ResetPassword_Call {
+	return &SDK_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, password, confPass, token)}
+}
+
+func (_c *SDK_ResetPassword_Call) Run(run func(ctx context.Context, password string, confPass string, token string)) *SDK_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ResetPassword_Call) Return(sDKError errors.SDKError) *SDK_ResetPassword_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, password string, confPass string, token string) errors.SDKError) *SDK_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPasswordRequest provides a mock function for the type SDK
+func (_mock *SDK) ResetPasswordRequest(ctx context.Context, email string) errors.SDKError {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPasswordRequest")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_ResetPasswordRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPasswordRequest'
+type SDK_ResetPasswordRequest_Call struct {
+	*mock.Call
+}
+
+// ResetPasswordRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *SDK_Expecter) ResetPasswordRequest(ctx interface{}, email interface{}) *SDK_ResetPasswordRequest_Call {
+	return &SDK_ResetPasswordRequest_Call{Call: _e.mock.On("ResetPasswordRequest", ctx, email)}
+}
+
+func (_c *SDK_ResetPasswordRequest_Call) Run(run func(ctx context.Context, email string)) *SDK_ResetPasswordRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ResetPasswordRequest_Call) Return(sDKError errors.SDKError) *SDK_ResetPasswordRequest_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_ResetPasswordRequest_Call) RunAndReturn(run func(ctx context.Context, email string) errors.SDKError) *SDK_ResetPasswordRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreDomain provides a mock function for the type SDK
+func (_mock *SDK) RestoreDomain(ctx context.Context, domainID string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreDomain")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, domainID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_RestoreDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDomain'
+type SDK_RestoreDomain_Call struct {
+	*mock.Call
+}
+
+// RestoreDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RestoreDomain(ctx interface{}, domainID interface{}, token interface{}) *SDK_RestoreDomain_Call {
+	return &SDK_RestoreDomain_Call{Call: _e.mock.On("RestoreDomain", ctx, domainID, token)}
+}
+
+func (_c *SDK_RestoreDomain_Call) Run(run func(ctx context.Context, domainID string, token string)) *SDK_RestoreDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RestoreDomain_Call) Return(sDKError errors.SDKError) *SDK_RestoreDomain_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_RestoreDomain_Call) RunAndReturn(run func(ctx context.Context, domainID string, token string) errors.SDKError) *SDK_RestoreDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeClientCert provides a mock function for the type SDK
+func (_mock *SDK) RevokeClientCert(ctx context.Context, id string, domainID string, token string) (sdk.Client, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeClientCert")
+	}
+
+	var r0 sdk.Client
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Client, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Client); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RevokeClientCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeClientCert'
+type SDK_RevokeClientCert_Call struct {
+	*mock.Call
+}
+
+// RevokeClientCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RevokeClientCert(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_RevokeClientCert_Call {
+	return &SDK_RevokeClientCert_Call{Call: _e.mock.On("RevokeClientCert", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_RevokeClientCert_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_RevokeClientCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RevokeClientCert_Call) Return(client sdk.Client, sDKError errors.SDKError) *SDK_RevokeClientCert_Call {
+	_c.Call.Return(client, sDKError)
+	return _c
+}
+
+func (_c *SDK_RevokeClientCert_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Client, errors.SDKError)) *SDK_RevokeClientCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type SDK
+func (_mock *SDK) RevokeSession(ctx context.Context, id string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, id, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, id, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type SDK_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - token string
+func (_e *SDK_Expecter) RevokeSession(ctx interface{}, id interface{}, token interface{}) *SDK_RevokeSession_Call {
+	return &SDK_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, id, token)}
+}
+
+func (_c *SDK_RevokeSession_Call) Run(run func(ctx context.Context, id string, token string)) *SDK_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RevokeSession_Call) Return(sDKError errors.SDKError) *SDK_RevokeSession_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, id string, token string) errors.SDKError) *SDK_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateClientCert provides a mock function for the type SDK
+func (_mock *SDK) RotateClientCert(ctx context.Context, id string, cert string, subject string, domainID string, token string) (sdk.Client, errors.SDKError) {
+	ret := _mock.Called(ctx, id, cert, subject, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateClientCert")
+	}
+
+	var r0 sdk.Client
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (sdk.Client, errors.SDKError)); ok {
+		return returnFunc(ctx, id, cert, subject, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) sdk.Client); ok {
+		r0 = returnFunc(ctx, id, cert, subject, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, cert, subject, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RotateClientCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateClientCert'
+type SDK_RotateClientCert_Call struct {
+	*mock.Call
+}
+
+// RotateClientCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - cert string
+//   - subject string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RotateClientCert(ctx interface{}, id interface{}, cert interface{}, subject interface{}, domainID interface{}, token interface{}) *SDK_RotateClientCert_Call {
+	return &SDK_RotateClientCert_Call{Call: _e.mock.On("RotateClientCert", ctx, id, cert, subject, domainID, token)}
+}
+
+func (_c *SDK_RotateClientCert_Call) Run(run func(ctx context.Context, id string, cert string, subject string, domainID string, token string)) *SDK_RotateClientCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RotateClientCert_Call) Return(client sdk.Client, sDKError errors.SDKError) *SDK_RotateClientCert_Call {
+	_c.Call.Return(client, sDKError)
+	return _c
+}
+
+func (_c *SDK_RotateClientCert_Call) RunAndReturn(run func(ctx context.Context, id string, cert string, subject string, domainID string, token string) (sdk.Client, errors.SDKError)) *SDK_RotateClientCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateClientSecret provides a mock function for the type SDK
+func (_mock *SDK) RotateClientSecret(ctx context.Context, id string, secret string, gracePeriod time.Duration, domainID string, token string) (sdk.Client, errors.SDKError) {
+	ret := _mock.Called(ctx, id, secret, gracePeriod, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateClientSecret")
+	}
+
+	var r0 sdk.Client
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, string, string) (sdk.Client, errors.SDKError)); ok {
+		return returnFunc(ctx, id, secret, gracePeriod, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, string, string) sdk.Client); ok {
+		r0 = returnFunc(ctx, id, secret, gracePeriod, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, secret, gracePeriod, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RotateClientSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateClientSecret'
+type SDK_RotateClientSecret_Call struct {
+	*mock.Call
+}
+
+// RotateClientSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - secret string
+//   - gracePeriod time.Duration
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RotateClientSecret(ctx interface{}, id interface{}, secret interface{}, gracePeriod interface{}, domainID interface{}, token interface{}) *SDK_RotateClientSecret_Call {
+	return &SDK_RotateClientSecret_Call{Call: _e.mock.On("RotateClientSecret", ctx, id, secret, gracePeriod, domainID, token)}
+}
+
+func (_c *SDK_RotateClientSecret_Call) Run(run func(ctx context.Context, id string, secret string, gracePeriod time.Duration, domainID string, token string)) *SDK_RotateClientSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RotateClientSecret_Call) Return(client sdk.Client, sDKError errors.SDKError) *SDK_RotateClientSecret_Call {
+	_c.Call.Return(client, sDKError)
+	return _c
+}
+
+func (_c *SDK_RotateClientSecret_Call) RunAndReturn(run func(ctx context.Context, id string, secret string, gracePeriod time.Duration, domainID string, token string) (sdk.Client, errors.SDKError)) *SDK_RotateClientSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rule provides a mock function for the type SDK
+func (_mock *SDK) Rule(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetPassword")
+		panic("no return value specified for Rule")
 	}
 
-	var r0 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) errors.SDKError); ok {
-		r0 = returnFunc(ctx, password, confPass, token)
+	var r0 sdk.Rule
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.SDKError)
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
 		}
 	}
-	return r0
+	return r0, r1
 }
 
-// SDK_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
-type SDK_ResetPassword_Call struct {
+// SDK_Rule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rule'
+type SDK_Rule_Call struct {
 	*mock.Call
 }
 
-// ResetPassword is a helper method to define mock.On call
+// Rule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - password string
-//   - confPass string
+//   - id string
+//   - domainID string
 //   - token string
-func (_e *SDK_Expecter) ResetPassword(ctx interface{}, password interface{}, confPass interface{}, token interface{}) *SDK_ResetPassword_Call {
-	return &SDK_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, password, confPass, token)}
+func (_e *SDK_Expecter) Rule(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_Rule_Call {
+	return &SDK_Rule_Call{Call: _e.mock.On("Rule", ctx, id, domainID, token)}
 }
 
-func (_c *SDK_ResetPassword_Call) Run(run func(ctx context.Context, password string, confPass string, token string)) *SDK_ResetPassword_Call {
+func (_c *SDK_Rule_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_Rule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -7511,71 +9117,92 @@ func (_c *SDK_ResetPassword_Call) Run(run func(ctx context.Context, password str
 	return _c
 }
 
-func (_c *SDK_ResetPassword_Call) Return(sDKError errors.SDKError) *SDK_ResetPassword_Call {
-	_c.Call.Return(sDKError)
+func (_c *SDK_Rule_Call) Return(rule sdk.Rule, sDKError errors.SDKError) *SDK_Rule_Call {
+	_c.Call.Return(rule, sDKError)
 	return _c
 }
 
-func (_c *SDK_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, password string, confPass string, token string) errors.SDKError) *SDK_ResetPassword_Call {
+func (_c *SDK_Rule_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_Rule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResetPasswordRequest provides a mock function for the type SDK
-func (_mock *SDK) ResetPasswordRequest(ctx context.Context, email string) errors.SDKError {
-	ret := _mock.Called(ctx, email)
+// Rules provides a mock function for the type SDK
+func (_mock *SDK) Rules(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.RulesPage, errors.SDKError) {
+	ret := _mock.Called(ctx, pm, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetPasswordRequest")
+		panic("no return value specified for Rules")
 	}
 
-	var r0 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) errors.SDKError); ok {
-		r0 = returnFunc(ctx, email)
+	var r0 sdk.RulesPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.RulesPage, errors.SDKError)); ok {
+		return returnFunc(ctx, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.RulesPage); ok {
+		r0 = returnFunc(ctx, pm, domainID, token)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.SDKError)
+		r0 = ret.Get(0).(sdk.RulesPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
 		}
 	}
-	return r0
+	return r0, r1
 }
 
-// SDK_ResetPasswordRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPasswordRequest'
-type SDK_ResetPasswordRequest_Call struct {
+// SDK_Rules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rules'
+type SDK_Rules_Call struct {
 	*mock.Call
 }
 
-// ResetPasswordRequest is a helper method to define mock.On call
+// Rules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *SDK_Expecter) ResetPasswordRequest(ctx interface{}, email interface{}) *SDK_ResetPasswordRequest_Call {
-	return &SDK_ResetPasswordRequest_Call{Call: _e.mock.On("ResetPasswordRequest", ctx, email)}
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) Rules(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_Rules_Call {
+	return &SDK_Rules_Call{Call: _e.mock.On("Rules", ctx, pm, domainID, token)}
 }
 
-func (_c *SDK_ResetPasswordRequest_Call) Run(run func(ctx context.Context, email string)) *SDK_ResetPasswordRequest_Call {
+func (_c *SDK_Rules_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_Rules_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 sdk.PageMetadata
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(sdk.PageMetadata)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *SDK_ResetPasswordRequest_Call) Return(sDKError errors.SDKError) *SDK_ResetPasswordRequest_Call {
-	_c.Call.Return(sDKError)
+func (_c *SDK_Rules_Call) Return(rulesPage sdk.RulesPage, sDKError errors.SDKError) *SDK_Rules_Call {
+	_c.Call.Return(rulesPage, sDKError)
 	return _c
 }
 
-func (_c *SDK_ResetPasswordRequest_Call) RunAndReturn(run func(ctx context.Context, email string) errors.SDKError) *SDK_ResetPasswordRequest_Call {
+func (_c *SDK_Rules_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.RulesPage, errors.SDKError)) *SDK_Rules_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8137,6 +9764,86 @@ func (_c *SDK_SetGroupParent_Call) RunAndReturn(run func(ctx context.Context, id
 	return _c
 }
 
+// TransferDomainOwnership provides a mock function for the type SDK
+func (_mock *SDK) TransferDomainOwnership(ctx context.Context, domainID string, userID string, token string) (sdk.DomainTransfer, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, userID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferDomainOwnership")
+	}
+
+	var r0 sdk.DomainTransfer
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.DomainTransfer, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, userID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.DomainTransfer); ok {
+		r0 = returnFunc(ctx, domainID, userID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainTransfer)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, userID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_TransferDomainOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferDomainOwnership'
+type SDK_TransferDomainOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferDomainOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - userID string
+//   - token string
+func (_e *SDK_Expecter) TransferDomainOwnership(ctx interface{}, domainID interface{}, userID interface{}, token interface{}) *SDK_TransferDomainOwnership_Call {
+	return &SDK_TransferDomainOwnership_Call{Call: _e.mock.On("TransferDomainOwnership", ctx, domainID, userID, token)}
+}
+
+func (_c *SDK_TransferDomainOwnership_Call) Run(run func(ctx context.Context, domainID string, userID string, token string)) *SDK_TransferDomainOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_TransferDomainOwnership_Call) Return(domainTransfer sdk.DomainTransfer, sDKError errors.SDKError) *SDK_TransferDomainOwnership_Call {
+	_c.Call.Return(domainTransfer, sDKError)
+	return _c
+}
+
+func (_c *SDK_TransferDomainOwnership_Call) RunAndReturn(run func(ctx context.Context, domainID string, userID string, token string) (sdk.DomainTransfer, errors.SDKError)) *SDK_TransferDomainOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannel provides a mock function for the type SDK
 func (_mock *SDK) UpdateChannel(ctx context.Context, channel sdk.Channel, domainID string, token string) (sdk.Channel, errors.SDKError) {
 	ret := _mock.Called(ctx, channel, domainID, token)
@@ -8709,6 +10416,86 @@ func (_c *SDK_UpdateDomain_Call) RunAndReturn(run func(ctx context.Context, d sd
 	return _c
 }
 
+// UpdateDomainQuotas provides a mock function for the type SDK
+func (_mock *SDK) UpdateDomainQuotas(ctx context.Context, domainID string, quotas sdk.DomainQuotas, token string) (sdk.DomainQuotas, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, quotas, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDomainQuotas")
+	}
+
+	var r0 sdk.DomainQuotas
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.DomainQuotas, string) (sdk.DomainQuotas, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, quotas, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.DomainQuotas, string) sdk.DomainQuotas); ok {
+		r0 = returnFunc(ctx, domainID, quotas, token)
+	} else {
+		r0 = ret.Get(0).(sdk.DomainQuotas)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.DomainQuotas, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, quotas, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_UpdateDomainQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDomainQuotas'
+type SDK_UpdateDomainQuotas_Call struct {
+	*mock.Call
+}
+
+// UpdateDomainQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - quotas sdk.DomainQuotas
+//   - token string
+func (_e *SDK_Expecter) UpdateDomainQuotas(ctx interface{}, domainID interface{}, quotas interface{}, token interface{}) *SDK_UpdateDomainQuotas_Call {
+	return &SDK_UpdateDomainQuotas_Call{Call: _e.mock.On("UpdateDomainQuotas", ctx, domainID, quotas, token)}
+}
+
+func (_c *SDK_UpdateDomainQuotas_Call) Run(run func(ctx context.Context, domainID string, quotas sdk.DomainQuotas, token string)) *SDK_UpdateDomainQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.DomainQuotas
+		if args[2] != nil {
+			arg2 = args[2].(sdk.DomainQuotas)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_UpdateDomainQuotas_Call) Return(domainQuotas sdk.DomainQuotas, sDKError errors.SDKError) *SDK_UpdateDomainQuotas_Call {
+	_c.Call.Return(domainQuotas, sDKError)
+	return _c
+}
+
+func (_c *SDK_UpdateDomainQuotas_Call) RunAndReturn(run func(ctx context.Context, domainID string, quotas sdk.DomainQuotas, token string) (sdk.DomainQuotas, errors.SDKError)) *SDK_UpdateDomainQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDomainRole provides a mock function for the type SDK
 func (_mock *SDK) UpdateDomainRole(ctx context.Context, id string, roleID string, newName string, token string) (sdk.Role, errors.SDKError) {
 	ret := _mock.Called(ctx, id, roleID, newName, token)
@@ -9201,6 +10988,86 @@ func (_c *SDK_UpdateProfilePicture_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// UpdateRule provides a mock function for the type SDK
+func (_mock *SDK) UpdateRule(ctx context.Context, rule sdk.Rule, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, rule, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRule")
+	}
+
+	var r0 sdk.Rule
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Rule, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, rule, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Rule, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, rule, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.Rule, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, rule, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
+type SDK_UpdateRule_Call struct {
+	*mock.Call
+}
+
+// UpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule sdk.Rule
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) UpdateRule(ctx interface{}, rule interface{}, domainID interface{}, token interface{}) *SDK_UpdateRule_Call {
+	return &SDK_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, rule, domainID, token)}
+}
+
+func (_c *SDK_UpdateRule_Call) Run(run func(ctx context.Context, rule sdk.Rule, domainID string, token string)) *SDK_UpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.Rule
+		if args[1] != nil {
+			arg1 = args[1].(sdk.Rule)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_UpdateRule_Call) Return(rule1 sdk.Rule, sDKError errors.SDKError) *SDK_UpdateRule_Call {
+	_c.Call.Return(rule1, sDKError)
+	return _c
+}
+
+func (_c *SDK_UpdateRule_Call) RunAndReturn(run func(ctx context.Context, rule sdk.Rule, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_UpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type SDK
 func (_mock *SDK) UpdateUser(ctx context.Context, user sdk.User, token string) (sdk.User, errors.SDKError) {
 	ret := _mock.Called(ctx, user, token)
@@ -24,6 +24,18 @@ type updateClientSecretReq struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// rotateClientSecretReq is used to rotate the client secret.
+type rotateClientSecretReq struct {
+	Secret      string `json:"secret,omitempty"`
+	GracePeriod string `json:"grace_period,omitempty"`
+}
+
+// clientCertReq is used to bind the certificate, or the certificate subject, to the client.
+type clientCertReq struct {
+	Certificate string `json:"certificate,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+}
+
 // updateUserEmailReq is used to update the user email.
 type updateUserEmailReq struct {
 	token string
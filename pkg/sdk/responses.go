@@ -47,6 +47,12 @@ type UsersPage struct {
 	PageRes
 }
 
+// SessionsPage contains list of login sessions in a page with proper metadata.
+type SessionsPage struct {
+	Sessions []Session `json:"sessions"`
+	PageRes
+}
+
 type MembersPage struct {
 	Members []User `json:"members"`
 	PageRes
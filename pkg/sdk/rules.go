@@ -0,0 +1,176 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const rulesEndpoint = "rules"
+
+// Condition represents a rule condition evaluated against a message field.
+type Condition struct {
+	Field      string `json:"field"`
+	Comparator string `json:"comparator"`
+	Value      any    `json:"value"`
+}
+
+// Action represents an action executed when the message satisfies the rule conditions.
+type Action struct {
+	Type     string         `json:"type"`
+	Channel  string         `json:"channel,omitempty"`
+	Subtopic string         `json:"subtopic,omitempty"`
+	Fields   map[string]any `json:"fields,omitempty"`
+}
+
+// Rule represents supermq rules engine rule.
+type Rule struct {
+	ID           string      `json:"id,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	DomainID     string      `json:"domain_id,omitempty"`
+	InputChannel string      `json:"input_channel,omitempty"`
+	InputTopic   string      `json:"input_topic,omitempty"`
+	ContentType  string      `json:"content_type,omitempty"`
+	Conditions   []Condition `json:"conditions,omitempty"`
+	Actions      []Action    `json:"actions,omitempty"`
+	Status       string      `json:"status,omitempty"`
+	CreatedAt    time.Time   `json:"created_at,omitempty"`
+	CreatedBy    string      `json:"created_by,omitempty"`
+	UpdatedAt    time.Time   `json:"updated_at,omitempty"`
+	UpdatedBy    string      `json:"updated_by,omitempty"`
+}
+
+// RulesPage contains list of rules in a page with proper metadata.
+type RulesPage struct {
+	Rules []Rule `json:"rules"`
+	PageRes
+}
+
+func (sdk mgSDK) CreateRule(ctx context.Context, r Rule, domainID, token string) (Rule, errors.SDKError) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", sdk.rulesURL, domainID, rulesEndpoint)
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusCreated)
+	if sdkErr != nil {
+		return Rule{}, sdkErr
+	}
+
+	r = Rule{}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	return r, nil
+}
+
+func (sdk mgSDK) Rules(ctx context.Context, pm PageMetadata, domainID, token string) (RulesPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s", domainID, rulesEndpoint)
+	url, err := sdk.withQueryParams(sdk.rulesURL, endpoint, pm)
+	if err != nil {
+		return RulesPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return RulesPage{}, sdkErr
+	}
+
+	var rp RulesPage
+	if err := json.Unmarshal(body, &rp); err != nil {
+		return RulesPage{}, errors.NewSDKError(err)
+	}
+
+	return rp, nil
+}
+
+func (sdk mgSDK) Rule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError) {
+	if id == "" {
+		return Rule{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.rulesURL, domainID, rulesEndpoint, id)
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Rule{}, sdkErr
+	}
+
+	var r Rule
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	return r, nil
+}
+
+func (sdk mgSDK) UpdateRule(ctx context.Context, r Rule, domainID, token string) (Rule, errors.SDKError) {
+	if r.ID == "" {
+		return Rule{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.rulesURL, domainID, rulesEndpoint, r.ID)
+	data, err := json.Marshal(r)
+	if err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPatch, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Rule{}, sdkErr
+	}
+
+	r = Rule{}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	return r, nil
+}
+
+func (sdk mgSDK) EnableRule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError) {
+	return sdk.changeRuleStatus(ctx, id, enableEndpoint, domainID, token)
+}
+
+func (sdk mgSDK) DisableRule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError) {
+	return sdk.changeRuleStatus(ctx, id, disableEndpoint, domainID, token)
+}
+
+func (sdk mgSDK) DeleteRule(ctx context.Context, id, domainID, token string) errors.SDKError {
+	if id == "" {
+		return errors.NewSDKError(apiutil.ErrMissingID)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.rulesURL, domainID, rulesEndpoint, id)
+	_, _, sdkErr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent)
+
+	return sdkErr
+}
+
+func (sdk mgSDK) changeRuleStatus(ctx context.Context, id, status, domainID, token string) (Rule, errors.SDKError) {
+	if id == "" {
+		return Rule{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.rulesURL, domainID, rulesEndpoint, id, status)
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Rule{}, sdkErr
+	}
+
+	var r Rule
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,562 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqlog "github.com/absmach/supermq/logger"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	sdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/absmach/supermq/rules"
+	"github.com/absmach/supermq/rules/api"
+	"github.com/absmach/supermq/rules/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupRules() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	svc := new(mocks.Service)
+	authn := new(authnmocks.Authentication)
+	logger := smqlog.NewMock()
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	mux := api.MakeHandler(svc, am, logger, "rules", "test")
+
+	return httptest.NewServer(mux), svc, authn
+}
+
+func generateTestRule(t *testing.T) sdk.Rule {
+	createdAt, err := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error parsing time: %v", err))
+	return sdk.Rule{
+		ID:           generateUUID(t),
+		Name:         "alarm",
+		DomainID:     domainID,
+		InputChannel: generateUUID(t),
+		InputTopic:   "temperature",
+		ContentType:  "application/senml+json",
+		Conditions:   []sdk.Condition{{Field: "temperature", Comparator: "gt", Value: float64(30)}},
+		Actions:      []sdk.Action{{Type: "publish", Channel: generateUUID(t), Subtopic: "alarms"}},
+		Status:       rules.Enabled,
+		CreatedAt:    createdAt,
+		CreatedBy:    validID,
+	}
+}
+
+func convertRule(r sdk.Rule) rules.Rule {
+	status, err := rules.ToStatus(r.Status)
+	if err != nil {
+		status = rules.EnabledStatus
+	}
+	var conditions []rules.Condition
+	for _, c := range r.Conditions {
+		conditions = append(conditions, rules.Condition{Field: c.Field, Comparator: rules.Comparator(c.Comparator), Value: c.Value})
+	}
+	var actions []rules.Action
+	for _, a := range r.Actions {
+		actions = append(actions, rules.Action{Type: rules.ActionType(a.Type), Channel: a.Channel, Subtopic: a.Subtopic, Fields: a.Fields})
+	}
+
+	return rules.Rule{
+		ID:           r.ID,
+		Name:         r.Name,
+		DomainID:     r.DomainID,
+		InputChannel: r.InputChannel,
+		InputTopic:   r.InputTopic,
+		ContentType:  r.ContentType,
+		Conditions:   conditions,
+		Actions:      actions,
+		Status:       status,
+		CreatedAt:    r.CreatedAt,
+		CreatedBy:    r.CreatedBy,
+		UpdatedAt:    r.UpdatedAt,
+		UpdatedBy:    r.UpdatedBy,
+	}
+}
+
+func TestCreateRule(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	rule := generateTestRule(t)
+	createReq := sdk.Rule{
+		Name:         rule.Name,
+		InputChannel: rule.InputChannel,
+		InputTopic:   rule.InputTopic,
+		ContentType:  rule.ContentType,
+		Conditions:   rule.Conditions,
+		Actions:      rule.Actions,
+	}
+	svcReq := convertRule(createReq)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		rule     sdk.Rule
+		svcReq   rules.Rule
+		svcRes   rules.Rule
+		svcErr   error
+		authnErr error
+		response sdk.Rule
+		err      errors.SDKError
+	}{
+		{
+			desc:     "create rule successfully",
+			token:    validToken,
+			rule:     createReq,
+			svcReq:   svcReq,
+			svcRes:   convertRule(rule),
+			response: rule,
+		},
+		{
+			desc:     "create rule with invalid token",
+			token:    invalidToken,
+			rule:     createReq,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:  "create rule without input channel",
+			token: validToken,
+			rule: sdk.Rule{
+				Name:    rule.Name,
+				Actions: rule.Actions,
+			},
+			err: errors.NewSDKErrorWithStatus(apiutil.ErrMissingChannelID, http.StatusBadRequest),
+		},
+		{
+			desc:  "create rule without actions",
+			token: validToken,
+			rule: sdk.Rule{
+				Name:         rule.Name,
+				InputChannel: rule.InputChannel,
+			},
+			err: errors.NewSDKErrorWithStatus(apiutil.ErrMissingRuleActions, http.StatusBadRequest),
+		},
+		{
+			desc:   "create rule with service error",
+			token:  validToken,
+			rule:   createReq,
+			svcReq: svcReq,
+			svcErr: svcerr.ErrCreateEntity,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrCreateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("AddRule", mock.Anything, tc.session, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.CreateRule(context.Background(), tc.rule, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "AddRule", mock.Anything, tc.session, tc.svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListRules(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	rule := generateTestRule(t)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		pageMeta sdk.PageMetadata
+		svcReq   rules.PageMeta
+		svcRes   rules.Page
+		svcErr   error
+		authnErr error
+		response sdk.RulesPage
+		err      errors.SDKError
+	}{
+		{
+			desc:  "list rules successfully",
+			token: validToken,
+			pageMeta: sdk.PageMetadata{
+				Offset: 0,
+				Limit:  10,
+			},
+			svcReq: rules.PageMeta{Offset: 0, Limit: 10, Status: rules.AllStatus},
+			svcRes: rules.Page{Total: 1, Limit: 10, Rules: []rules.Rule{convertRule(rule)}},
+			response: sdk.RulesPage{
+				PageRes: sdk.PageRes{Total: 1, Limit: 10},
+				Rules:   []sdk.Rule{rule},
+			},
+		},
+		{
+			desc:  "list rules of input channel with status",
+			token: validToken,
+			pageMeta: sdk.PageMetadata{
+				Offset:       0,
+				Limit:        10,
+				InputChannel: rule.InputChannel,
+				Status:       rules.Enabled,
+			},
+			svcReq: rules.PageMeta{Offset: 0, Limit: 10, InputChannel: rule.InputChannel, Status: rules.EnabledStatus},
+			svcRes: rules.Page{Total: 1, Limit: 10, Rules: []rules.Rule{convertRule(rule)}},
+			response: sdk.RulesPage{
+				PageRes: sdk.PageRes{Total: 1, Limit: 10},
+				Rules:   []sdk.Rule{rule},
+			},
+		},
+		{
+			desc:     "list rules with invalid token",
+			token:    invalidToken,
+			pageMeta: sdk.PageMetadata{Offset: 0, Limit: 10},
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:     "list rules with limit greater than max",
+			token:    validToken,
+			pageMeta: sdk.PageMetadata{Offset: 0, Limit: 1000},
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrLimitSize, http.StatusBadRequest),
+		},
+		{
+			desc:     "list rules with service error",
+			token:    validToken,
+			pageMeta: sdk.PageMetadata{Offset: 0, Limit: 10},
+			svcReq:   rules.PageMeta{Offset: 0, Limit: 10, Status: rules.AllStatus},
+			svcErr:   svcerr.ErrViewEntity,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrViewEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ListRules", mock.Anything, tc.session, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.Rules(context.Background(), tc.pageMeta, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "ListRules", mock.Anything, tc.session, tc.svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewRule(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	rule := generateTestRule(t)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		id       string
+		svcRes   rules.Rule
+		svcErr   error
+		authnErr error
+		response sdk.Rule
+		err      errors.SDKError
+	}{
+		{
+			desc:     "view rule successfully",
+			token:    validToken,
+			id:       rule.ID,
+			svcRes:   convertRule(rule),
+			response: rule,
+		},
+		{
+			desc:     "view rule with invalid token",
+			token:    invalidToken,
+			id:       rule.ID,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:  "view rule with empty id",
+			token: validToken,
+			id:    "",
+			err:   errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:   "view non-existing rule",
+			token:  validToken,
+			id:     wrongID,
+			svcErr: svcerr.ErrNotFound,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ViewRule", mock.Anything, tc.session, tc.id).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.Rule(context.Background(), tc.id, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "ViewRule", mock.Anything, tc.session, tc.id)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	rule := generateTestRule(t)
+	updateReq := sdk.Rule{
+		ID:           rule.ID,
+		Name:         "updated",
+		InputChannel: rule.InputChannel,
+		Actions:      []sdk.Action{{Type: "drop"}},
+	}
+	updated := rule
+	updated.Name = updateReq.Name
+	updated.Actions = updateReq.Actions
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		rule     sdk.Rule
+		svcRes   rules.Rule
+		svcErr   error
+		authnErr error
+		response sdk.Rule
+		err      errors.SDKError
+	}{
+		{
+			desc:     "update rule successfully",
+			token:    validToken,
+			rule:     updateReq,
+			svcRes:   convertRule(updated),
+			response: updated,
+		},
+		{
+			desc:     "update rule with invalid token",
+			token:    invalidToken,
+			rule:     updateReq,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:  "update rule with empty id",
+			token: validToken,
+			rule:  sdk.Rule{Name: "updated"},
+			err:   errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:   "update rule with service error",
+			token:  validToken,
+			rule:   updateReq,
+			svcErr: svcerr.ErrUpdateEntity,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			svcReq := convertRule(tc.rule)
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("UpdateRule", mock.Anything, tc.session, svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.UpdateRule(context.Background(), tc.rule, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "UpdateRule", mock.Anything, tc.session, svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestChangeRuleStatus(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	enabled := generateTestRule(t)
+	disabled := enabled
+	disabled.Status = rules.Disabled
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		id       string
+		method   string
+		change   func(context.Context, string, string, string) (sdk.Rule, errors.SDKError)
+		svcRes   rules.Rule
+		svcErr   error
+		authnErr error
+		response sdk.Rule
+		err      errors.SDKError
+	}{
+		{
+			desc:     "enable rule successfully",
+			token:    validToken,
+			id:       enabled.ID,
+			method:   "EnableRule",
+			change:   mgsdk.EnableRule,
+			svcRes:   convertRule(enabled),
+			response: enabled,
+		},
+		{
+			desc:     "disable rule successfully",
+			token:    validToken,
+			id:       enabled.ID,
+			method:   "DisableRule",
+			change:   mgsdk.DisableRule,
+			svcRes:   convertRule(disabled),
+			response: disabled,
+		},
+		{
+			desc:     "enable rule with invalid token",
+			token:    invalidToken,
+			id:       enabled.ID,
+			method:   "EnableRule",
+			change:   mgsdk.EnableRule,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:   "disable rule with empty id",
+			token:  validToken,
+			id:     "",
+			method: "DisableRule",
+			change: mgsdk.DisableRule,
+			err:    errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:   "disable non-existing rule",
+			token:  validToken,
+			id:     wrongID,
+			method: "DisableRule",
+			change: mgsdk.DisableRule,
+			svcErr: svcerr.ErrNotFound,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On(tc.method, mock.Anything, tc.session, tc.id).Return(tc.svcRes, tc.svcErr)
+			resp, err := tc.change(context.Background(), tc.id, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, tc.method, mock.Anything, tc.session, tc.id)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDeleteRule(t *testing.T) {
+	rs, svc, authn := setupRules()
+	defer rs.Close()
+
+	mgsdk := sdk.NewSDK(sdk.Config{RulesURL: rs.URL})
+
+	ruleID := generateUUID(t)
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		id       string
+		svcErr   error
+		authnErr error
+		err      errors.SDKError
+	}{
+		{
+			desc:  "delete rule successfully",
+			token: validToken,
+			id:    ruleID,
+		},
+		{
+			desc:     "delete rule with invalid token",
+			token:    invalidToken,
+			id:       ruleID,
+			authnErr: svcerr.ErrAuthentication,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:  "delete rule with empty id",
+			token: validToken,
+			id:    "",
+			err:   errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:   "delete non-existing rule",
+			token:  validToken,
+			id:     wrongID,
+			svcErr: svcerr.ErrNotFound,
+			err:    errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RemoveRule", mock.Anything, tc.session, tc.id).Return(tc.svcErr)
+			err := mgsdk.DeleteRule(context.Background(), tc.id, domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RemoveRule", mock.Anything, tc.session, tc.id)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -162,6 +162,9 @@ type PageMetadata struct {
 	Tree            bool      `json:"tree,omitempty"`
 	StartLevel      int64     `json:"start_level,omitempty"`
 	EndLevel        int64     `json:"end_level,omitempty"`
+	InputChannel    string    `json:"input_channel,omitempty"`
+	Channel         string    `json:"channel,omitempty"`
+	ConnectionType  string    `json:"connection_type,omitempty"`
 }
 
 type Role struct {
@@ -399,6 +402,27 @@ type SDK interface {
 	//  fmt.Println(token)
 	RefreshToken(ctx context.Context, token string) (Token, errors.SDKError)
 
+	// ListSessions returns the active login sessions of the user.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  pm := sdk.PageMetadata{
+	//    Offset: 0,
+	//    Limit:  10,
+	//  }
+	//  sessions, _ := sdk.ListSessions(ctx, pm, "token")
+	//  fmt.Println(sessions)
+	ListSessions(ctx context.Context, pm PageMetadata, token string) (SessionsPage, errors.SDKError)
+
+	// RevokeSession revokes the login session of the user with the given id,
+	// so its refresh token can't be used anymore.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  err := sdk.RevokeSession(ctx, "sessionID", "token")
+	//  fmt.Println(err)
+	RevokeSession(ctx context.Context, id, token string) errors.SDKError
+
 	// SeachUsers filters users and returns a page result.
 	//
 	// example:
@@ -504,6 +528,41 @@ type SDK interface {
 	//  fmt.Println(client)
 	UpdateClientSecret(ctx context.Context, id, secret, domainID, token string) (Client, errors.SDKError)
 
+	// RotateClientSecret replaces the client's secret and keeps accepting the
+	// previous secret until the grace period expires. The secret is generated
+	// if empty, and the default grace period is used if zero.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  client, err := sdk.RotateClientSecret(ctx, "clientID", "newSecret", 24*time.Hour, "domainID", "token")
+	//  fmt.Println(client.Credentials.SecretRotation.ExpiresAt)
+	RotateClientSecret(ctx context.Context, id, secret string, gracePeriod time.Duration, domainID, token string) (Client, errors.SDKError)
+
+	// BindClientCert binds the PEM encoded X.509 certificate, or only the
+	// certificate subject, to the client.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  client, err := sdk.BindClientCert(ctx, "clientID", certPEM, "", "domainID", "token")
+	//  fmt.Println(client)
+	BindClientCert(ctx context.Context, id, cert, subject, domainID, token string) (Client, errors.SDKError)
+
+	// RotateClientCert replaces the certificate bound to the client.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  client, err := sdk.RotateClientCert(ctx, "clientID", certPEM, "", "domainID", "token")
+	//  fmt.Println(client)
+	RotateClientCert(ctx context.Context, id, cert, subject, domainID, token string) (Client, errors.SDKError)
+
+	// RevokeClientCert removes the certificate bound to the client.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  client, err := sdk.RevokeClientCert(ctx, "clientID", "domainID", "token")
+	//  fmt.Println(client)
+	RevokeClientCert(ctx context.Context, id, domainID, token string) (Client, errors.SDKError)
+
 	// EnableClient changes client status to enabled.
 	//
 	// example:
@@ -1168,6 +1227,22 @@ type SDK interface {
 	//  fmt.Println(err)
 	SendMessage(ctx context.Context, domainID, topic, msg, secret string) errors.SDKError
 
+	// ReplayMessages replays the messages retained by the message broker for the
+	// channel topic from the point defined by the replay policy, and keeps delivering
+	// new messages to the handler until the context is canceled, the connection is
+	// closed or the handler returns an error.
+	//
+	// example:
+	//  ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	//  defer cancel()
+	//  rp := sdk.ReplayPolicy{StartTime: time.Now().Add(-time.Hour)}
+	//  err := sdk.ReplayMessages(ctx, "domainID", "channelID.subtopic", rp, "clientSecret", func(msg sdk.ReplayedMessage) error {
+	//  	fmt.Println(string(msg.Payload))
+	//  	return nil
+	//  })
+	//  fmt.Println(err)
+	ReplayMessages(ctx context.Context, domainID, topic string, rp ReplayPolicy, secret string, handler func(ReplayedMessage) error) errors.SDKError
+
 	// SetContentType sets message content type.
 	//
 	// example:
@@ -1257,6 +1332,101 @@ type SDK interface {
 	//  fmt.Println(err)
 	FreezeDomain(ctx context.Context, domainID, token string) errors.SDKError
 
+	// DeleteDomain marks the domain as deleted. The domain is removed
+	// together with its entities once the deletion grace period expires.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  err := sdk.DeleteDomain(ctx, "domainID", "token")
+	//  fmt.Println(err)
+	DeleteDomain(ctx context.Context, domainID, token string) errors.SDKError
+
+	// RestoreDomain restores the deleted domain within the deletion grace period.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  err := sdk.RestoreDomain(ctx, "domainID", "token")
+	//  fmt.Println(err)
+	RestoreDomain(ctx context.Context, domainID, token string) errors.SDKError
+
+	// ExportDomain returns the archive of the domain with its roles, groups
+	// hierarchy, channels, clients and connections. Client secrets are
+	// exported only if withSecrets is set.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  archive, _ := sdk.ExportDomain(ctx, "domainID", false, "token")
+	//  fmt.Println(archive)
+	ExportDomain(ctx context.Context, domainID string, withSecrets bool, token string) (DomainArchive, errors.SDKError)
+
+	// ImportDomain creates the new domain from the archive and recreates the
+	// archived entities in it. The entity roles are created without their
+	// archived members. If preserveIDs is set, the archived IDs are kept,
+	// otherwise the new IDs are generated. If the import fails, the created
+	// entities are removed and the new domain is deleted.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  domain, _ := sdk.ImportDomain(ctx, archive, false, "token")
+	//  fmt.Println(domain)
+	ImportDomain(ctx context.Context, archive DomainArchive, preserveIDs bool, token string) (Domain, errors.SDKError)
+
+	// DomainUsage returns the domain quotas together with the number of
+	// the domain resources in use.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  usage, _ := sdk.DomainUsage(ctx, "domainID", "token")
+	//  fmt.Println(usage)
+	DomainUsage(ctx context.Context, domainID, token string) (DomainQuotasUsage, errors.SDKError)
+
+	// UpdateDomainQuotas sets the domain quotas. Only the super admin
+	// is allowed to update the quotas.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  quotas := sdk.DomainQuotas{
+	//    Clients:     100,
+	//    MessageRate: 10,
+	//  }
+	//  quotas, _ := sdk.UpdateDomainQuotas(ctx, "domainID", quotas, "token")
+	//  fmt.Println(quotas)
+	UpdateDomainQuotas(ctx context.Context, domainID string, quotas DomainQuotas, token string) (DomainQuotas, errors.SDKError)
+
+	// TransferDomainOwnership starts the transfer of the domain administrator
+	// role to the domain member. The transfer is completed once the member
+	// accepts it.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  transfer, _ := sdk.TransferDomainOwnership(ctx, "domainID", "userID", "token")
+	//  fmt.Println(transfer)
+	TransferDomainOwnership(ctx context.Context, domainID, userID, token string) (DomainTransfer, errors.SDKError)
+
+	// DomainTransfer returns the pending ownership transfer of the domain.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  transfer, _ := sdk.DomainTransfer(ctx, "domainID", "token")
+	//  fmt.Println(transfer)
+	DomainTransfer(ctx context.Context, domainID, token string) (DomainTransfer, errors.SDKError)
+
+	// AcceptDomainTransfer accepts the pending ownership transfer of the domain.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  transfer, _ := sdk.AcceptDomainTransfer(ctx, "domainID", "token")
+	//  fmt.Println(transfer)
+	AcceptDomainTransfer(ctx context.Context, domainID, token string) (DomainTransfer, errors.SDKError)
+
+	// RejectDomainTransfer rejects or cancels the pending ownership transfer of the domain.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  err := sdk.RejectDomainTransfer(ctx, "domainID", "token")
+	//  fmt.Println(err)
+	RejectDomainTransfer(ctx context.Context, domainID, token string) errors.SDKError
+
 	// CreateDomainRole creates new domain role and returns its id.
 	//
 	// example:
@@ -1461,6 +1631,78 @@ type SDK interface {
 	//  invitations, _ := sdk.DomainInvitations(ctx, "domainID", pm, "token")
 	//  fmt.Println(invitations)
 	DomainInvitations(ctx context.Context, pm PageMetadata, token, domainID string) (invitations InvitationPage, err error)
+	// CreateRule creates a new rules engine rule.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  rule := sdk.Rule{
+	//    Name:         "alarm",
+	//    InputChannel: "channelID",
+	//    Conditions:   []sdk.Condition{{Field: "temperature", Comparator: "gt", Value: 30}},
+	//    Actions:      []sdk.Action{{Type: "publish", Channel: "alarmsChannelID"}},
+	//  }
+	//  rule, _ := sdk.CreateRule(ctx, rule, "domainID", "token")
+	//  fmt.Println(rule)
+	CreateRule(ctx context.Context, rule Rule, domainID, token string) (Rule, errors.SDKError)
+
+	// Rules returns page of rules.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  pm := sdk.PageMetadata{
+	//    Offset:       0,
+	//    Limit:        10,
+	//    InputChannel: "channelID",
+	//  }
+	//  rules, _ := sdk.Rules(ctx, pm, "domainID", "token")
+	//  fmt.Println(rules)
+	Rules(ctx context.Context, pm PageMetadata, domainID, token string) (RulesPage, errors.SDKError)
+
+	// Rule returns rule data by id.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  rule, _ := sdk.Rule(ctx, "ruleID", "domainID", "token")
+	//  fmt.Println(rule)
+	Rule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError)
+
+	// UpdateRule updates existing rule.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  rule := sdk.Rule{
+	//    ID:           "ruleID",
+	//    Name:         "alarm",
+	//    InputChannel: "channelID",
+	//    Actions:      []sdk.Action{{Type: "drop"}},
+	//  }
+	//  rule, _ := sdk.UpdateRule(ctx, rule, "domainID", "token")
+	//  fmt.Println(rule)
+	UpdateRule(ctx context.Context, rule Rule, domainID, token string) (Rule, errors.SDKError)
+
+	// EnableRule enables a disabled rule.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  rule, _ := sdk.EnableRule(ctx, "ruleID", "domainID", "token")
+	//  fmt.Println(rule)
+	EnableRule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError)
+
+	// DisableRule disables an enabled rule.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  rule, _ := sdk.DisableRule(ctx, "ruleID", "domainID", "token")
+	//  fmt.Println(rule)
+	DisableRule(ctx context.Context, id, domainID, token string) (Rule, errors.SDKError)
+
+	// DeleteRule deletes a rule.
+	//
+	// For example:
+	//  ctx := context.Background()
+	//  err := sdk.DeleteRule(ctx, "ruleID", "domainID", "token")
+	//  fmt.Println(err)
+	DeleteRule(ctx context.Context, id, domainID, token string) errors.SDKError
 }
 
 type mgSDK struct {
@@ -1472,12 +1714,14 @@ type mgSDK struct {
 	channelsURL    string
 	domainsURL     string
 	journalURL     string
+	rulesURL       string
 	HostURL        string
 
-	msgContentType ContentType
-	client         *http.Client
-	curlFlag       bool
-	roles          bool
+	msgContentType  ContentType
+	client          *http.Client
+	tlsVerification bool
+	curlFlag        bool
+	roles           bool
 }
 
 // Config contains sdk configuration parameters.
@@ -1490,6 +1734,7 @@ type Config struct {
 	ChannelsURL    string
 	DomainsURL     string
 	JournalURL     string
+	RulesURL       string
 	HostURL        string
 
 	MsgContentType  ContentType
@@ -1509,6 +1754,7 @@ func NewSDK(conf Config) SDK {
 		channelsURL:    conf.ChannelsURL,
 		domainsURL:     conf.DomainsURL,
 		journalURL:     conf.JournalURL,
+		rulesURL:       conf.RulesURL,
 		HostURL:        conf.HostURL,
 
 		msgContentType: conf.MsgContentType,
@@ -1517,8 +1763,9 @@ func NewSDK(conf Config) SDK {
 				InsecureSkipVerify: !conf.TLSVerification,
 			},
 		})},
-		curlFlag: conf.CurlFlag,
-		roles:    conf.Roles,
+		tlsVerification: conf.TLSVerification,
+		curlFlag:        conf.CurlFlag,
+		roles:           conf.Roles,
 	}
 }
 
@@ -1634,6 +1881,15 @@ func (pm PageMetadata) query() (string, error) {
 	if pm.Status != "" {
 		q.Add("status", pm.Status)
 	}
+	if pm.InputChannel != "" {
+		q.Add("input_channel", pm.InputChannel)
+	}
+	if pm.Channel != "" {
+		q.Add("channel", pm.Channel)
+	}
+	if pm.ConnectionType != "" {
+		q.Add("connection_type", pm.ConnectionType)
+	}
 	if pm.Metadata != nil {
 		md, err := json.Marshal(pm.Metadata)
 		if err != nil {
@@ -195,7 +195,7 @@ func convertClient(c sdk.Client) clients.Client {
 		Tags:            c.Tags,
 		Domain:          c.DomainID,
 		ParentGroup:     c.ParentGroup,
-		Credentials:     clients.Credentials(c.Credentials),
+		Credentials:     convertCredentials(c.Credentials),
 		Metadata:        clients.Metadata(c.Metadata),
 		PrivateMetadata: clients.Metadata(c.PrivateMetadata),
 		CreatedAt:       c.CreatedAt,
@@ -206,6 +206,17 @@ func convertClient(c sdk.Client) clients.Client {
 	}
 }
 
+func convertCredentials(c sdk.ClientCredentials) clients.Credentials {
+	return clients.Credentials{
+		Identity:    c.Identity,
+		Secret:      c.Secret,
+		Certificate: clients.Certificate(c.Certificate),
+		SecretRotation: clients.SecretRotation{
+			ExpiresAt: c.SecretRotation.ExpiresAt,
+		},
+	}
+}
+
 func convertChannel(g sdk.Channel) channels.Channel {
 	if g.Status == "" {
 		g.Status = channels.EnabledStatus.String()
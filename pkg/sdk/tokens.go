@@ -8,7 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
+	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/pkg/errors"
 )
 
@@ -20,6 +22,17 @@ type Token struct {
 	AccessType   string `json:"access_type,omitempty"`
 }
 
+// Session represents the user login session, started by creating the token
+// and kept alive by refreshing it.
+type Session struct {
+	ID          string    `json:"id"`
+	IP          string    `json:"ip,omitempty"`
+	UserAgent   string    `json:"user_agent,omitempty"`
+	IssuedAt    time.Time `json:"issued_at"`
+	RefreshedAt time.Time `json:"refreshed_at,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
 type Login struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -60,3 +73,33 @@ func (sdk mgSDK) RefreshToken(ctx context.Context, token string) (Token, errors.
 
 	return t, nil
 }
+
+func (sdk mgSDK) ListSessions(ctx context.Context, pm PageMetadata, token string) (SessionsPage, errors.SDKError) {
+	url, err := sdk.withQueryParams(sdk.usersURL, fmt.Sprintf("%s/%s", usersEndpoint, sessionsEndpoint), pm)
+	if err != nil {
+		return SessionsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return SessionsPage{}, sdkErr
+	}
+
+	var sp SessionsPage
+	if err := json.Unmarshal(body, &sp); err != nil {
+		return SessionsPage{}, errors.NewSDKError(err)
+	}
+
+	return sp, nil
+}
+
+func (sdk mgSDK) RevokeSession(ctx context.Context, id, token string) errors.SDKError {
+	if id == "" {
+		return errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.usersURL, usersEndpoint, sessionsEndpoint, id)
+
+	_, _, sdkErr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent)
+
+	return sdkErr
+}
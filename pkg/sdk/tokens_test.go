@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
@@ -15,6 +16,7 @@ import (
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
 	sdk "github.com/absmach/supermq/pkg/sdk"
+	"github.com/absmach/supermq/users"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -101,7 +103,7 @@ func TestIssueToken(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			svcCall := svc.On("IssueToken", mock.Anything, tc.login.Username, tc.login.Password).Return(tc.svcRes, tc.svcErr)
+			svcCall := svc.On("IssueToken", mock.Anything, tc.login.Username, tc.login.Password).Return(tc.svcRes, users.MFAChallenge{}, tc.svcErr)
 			resp, err := mgsdk.CreateToken(context.Background(), tc.login)
 			assert.Equal(t, tc.err, err)
 			assert.Equal(t, tc.response, resp)
@@ -177,6 +179,176 @@ func TestRefreshToken(t *testing.T) {
 	}
 }
 
+func TestListSessions(t *testing.T) {
+	ts, svc, auth := setupUsers()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		UsersURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	now := time.Now().UTC()
+	session := smqauthn.Session{DomainUserID: validID, UserID: validID, DomainID: validID}
+	loginSession := users.LoginSession{
+		ID:        validID,
+		IP:        "192.168.1.10",
+		UserAgent: "supermq-cli",
+		IssuedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	cases := []struct {
+		desc            string
+		token           string
+		pageMeta        sdk.PageMetadata
+		svcReq          users.Page
+		svcRes          users.LoginSessionsPage
+		svcErr          error
+		authenticateErr error
+		response        sdk.SessionsPage
+		err             errors.SDKError
+	}{
+		{
+			desc:  "list sessions successfully",
+			token: validToken,
+			pageMeta: sdk.PageMetadata{
+				Offset: 0,
+				Limit:  10,
+			},
+			svcReq: users.Page{Offset: 0, Limit: 10},
+			svcRes: users.LoginSessionsPage{
+				Total:    1,
+				Limit:    10,
+				Sessions: []users.LoginSession{loginSession},
+			},
+			response: sdk.SessionsPage{
+				PageRes: sdk.PageRes{Total: 1, Limit: 10},
+				Sessions: []sdk.Session{
+					{
+						ID:        loginSession.ID,
+						IP:        loginSession.IP,
+						UserAgent: loginSession.UserAgent,
+						IssuedAt:  loginSession.IssuedAt,
+						ExpiresAt: loginSession.ExpiresAt,
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc:  "list sessions with invalid token",
+			token: invalidToken,
+			pageMeta: sdk.PageMetadata{
+				Offset: 0,
+				Limit:  10,
+			},
+			authenticateErr: svcerr.ErrAuthentication,
+			response:        sdk.SessionsPage{},
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:  "list sessions with limit greater than max",
+			token: validToken,
+			pageMeta: sdk.PageMetadata{
+				Offset: 0,
+				Limit:  110,
+			},
+			response: sdk.SessionsPage{},
+			err:      errors.NewSDKErrorWithStatus(apiutil.ErrLimitSize, http.StatusBadRequest),
+		},
+		{
+			desc:  "list sessions with service error",
+			token: validToken,
+			pageMeta: sdk.PageMetadata{
+				Offset: 0,
+				Limit:  10,
+			},
+			svcReq:   users.Page{Offset: 0, Limit: 10},
+			svcErr:   svcerr.ErrViewEntity,
+			response: sdk.SessionsPage{},
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrViewEntity, http.StatusUnprocessableEntity),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authenticateErr)
+			svcCall := svc.On("ListSessions", mock.Anything, session, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.ListSessions(context.Background(), tc.pageMeta, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "ListSessions", mock.Anything, session, tc.svcReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	ts, svc, auth := setupUsers()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		UsersURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	session := smqauthn.Session{DomainUserID: validID, UserID: validID, DomainID: validID}
+
+	cases := []struct {
+		desc            string
+		token           string
+		sessionID       string
+		svcErr          error
+		authenticateErr error
+		err             errors.SDKError
+	}{
+		{
+			desc:      "revoke session successfully",
+			token:     validToken,
+			sessionID: validID,
+			err:       nil,
+		},
+		{
+			desc:            "revoke session with invalid token",
+			token:           invalidToken,
+			sessionID:       validID,
+			authenticateErr: svcerr.ErrAuthentication,
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:      "revoke non-existing session",
+			token:     validToken,
+			sessionID: wrongID,
+			svcErr:    svcerr.ErrNotFound,
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+		{
+			desc:      "revoke session with empty id",
+			token:     validToken,
+			sessionID: "",
+			err:       errors.NewSDKError(apiutil.ErrMissingID),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authenticateErr)
+			svcCall := svc.On("RevokeSession", mock.Anything, session, tc.sessionID).Return(tc.svcErr)
+			err := mgsdk.RevokeSession(context.Background(), tc.sessionID, tc.token)
+			assert.Equal(t, tc.err, err)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "RevokeSession", mock.Anything, session, tc.sessionID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func generateTestToken() sdk.Token {
 	return sdk.Token{
 		AccessToken:  "access_token",
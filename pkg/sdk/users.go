@@ -20,6 +20,7 @@ const (
 	disableEndpoint          = "disable"
 	issueTokenEndpoint       = "tokens/issue"
 	refreshTokenEndpoint     = "tokens/refresh"
+	sessionsEndpoint         = "sessions"
 	membersEndpoint          = "members"
 	PasswordResetEndpoint    = "password"
 	sendVerificationEndpoint = "send-verification"
@@ -43,6 +44,7 @@ type User struct {
 	Role            string      `json:"role,omitempty"`
 	ProfilePicture  string      `json:"profile_picture,omitempty"`
 	AuthProvider    string      `json:"auth_provider,omitempty"`
+	InvitationToken string      `json:"invitation_token,omitempty"`
 }
 
 func (sdk mgSDK) CreateUser(ctx context.Context, user User, token string) (User, errors.SDKError) {
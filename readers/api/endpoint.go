@@ -0,0 +1,87 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/connections"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/readers"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func listMessagesEndpoint(repo readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		if err := authorize(ctx, req, authn, clients, channels); err != nil {
+			return nil, err
+		}
+
+		page, err := repo.ReadAll(req.chanID, req.pageMeta)
+		if err != nil {
+			return nil, err
+		}
+
+		return pageRes{
+			PageMetadata: page.PageMetadata,
+			Total:        page.Total,
+			Messages:     page.Messages,
+		}, nil
+	}
+}
+
+// authorize checks if the user identified by the bearer token or the client
+// identified by the client secret is allowed to subscribe to the channel.
+func authorize(ctx context.Context, req listMessagesReq, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) error {
+	var clientID, clientType string
+	switch {
+	case req.token != "":
+		session, err := authn.Authenticate(ctx, req.token)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthentication, err)
+		}
+		clientType = policies.UserType
+		clientID = policies.EncodeDomainUserID(req.domainID, session.UserID)
+		if session.Role == smqauthn.AdminRole {
+			clientID = session.UserID
+		}
+	default:
+		res, err := clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{Token: smqauthn.AuthPack(smqauthn.DomainAuth, req.domainID, req.key)})
+		if err != nil {
+			return errors.Wrap(svcerr.ErrAuthentication, err)
+		}
+		if !res.GetAuthenticated() {
+			return svcerr.ErrAuthentication
+		}
+		clientType = policies.ClientType
+		clientID = res.GetId()
+	}
+
+	res, err := channels.Authorize(ctx, &grpcChannelsV1.AuthzReq{
+		ClientType: clientType,
+		ClientId:   clientID,
+		Type:       uint32(connections.Subscribe),
+		ChannelId:  req.chanID,
+		DomainId:   req.domainID,
+	})
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !res.GetAuthorized() {
+		return svcerr.ErrAuthorization
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/supermq/api/grpc/channels/v1"
+	grpcClientsV1 "github.com/absmach/supermq/api/grpc/clients/v1"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	chmocks "github.com/absmach/supermq/channels/mocks"
+	climocks "github.com/absmach/supermq/clients/mocks"
+	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/readers"
+	"github.com/absmach/supermq/readers/api"
+	"github.com/absmach/supermq/readers/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	validToken  = "valid"
+	validSecret = "secret"
+	domainID    = testsutil.GenerateUUID(&testing.T{})
+	chanID      = testsutil.GenerateUUID(&testing.T{})
+	clientID    = testsutil.GenerateUUID(&testing.T{})
+	userID      = testsutil.GenerateUUID(&testing.T{})
+)
+
+type testRequest struct {
+	client *http.Client
+	method string
+	url    string
+	token  string
+	key    string
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+	if tr.key != "" {
+		req.Header.Set("Authorization", apiutil.ClientPrefix+tr.key)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newServer() (*httptest.Server, *mocks.MessageRepository, *authnmocks.Authentication, *climocks.ClientsServiceClient, *chmocks.ChannelsServiceClient) {
+	repo := new(mocks.MessageRepository)
+	authn := new(authnmocks.Authentication)
+	clients := new(climocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+
+	mux := api.MakeHandler(repo, authn, clients, channels, smqlog.NewMock(), "test", "test")
+
+	return httptest.NewServer(mux), repo, authn, clients, channels
+}
+
+func TestListMessagesEndpoint(t *testing.T) {
+	ts, repo, authn, clients, channels := newServer()
+	defer ts.Close()
+
+	now := time.Now().UnixNano()
+	url := fmt.Sprintf("%s/%s/channels/%s/messages", ts.URL, domainID, chanID)
+
+	cases := []struct {
+		desc        string
+		url         string
+		token       string
+		key         string
+		authnErr    error
+		authnRes    *grpcClientsV1.AuthnRes
+		authzRes    *grpcChannelsV1.AuthzRes
+		authzErr    error
+		repoErr     error
+		status      int
+		skipAuthn   bool
+		skipRepoRun bool
+	}{
+		{
+			desc:     "list messages with valid token",
+			url:      url,
+			token:    validToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list messages with valid client secret",
+			url:      url,
+			key:      validSecret,
+			authnRes: &grpcClientsV1.AuthnRes{Authenticated: true, Id: clientID},
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusOK,
+		},
+		{
+			desc:        "list messages without credentials",
+			url:         url,
+			status:      http.StatusUnauthorized,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid token",
+			url:         url,
+			token:       "invalid",
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid client secret",
+			url:         url,
+			key:         "invalid",
+			authnRes:    &grpcClientsV1.AuthnRes{Authenticated: false},
+			status:      http.StatusUnauthorized,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages without subscribe permission",
+			url:         url,
+			token:       validToken,
+			authzRes:    &grpcChannelsV1.AuthzRes{Authorized: false},
+			status:      http.StatusForbidden,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with failed authorization",
+			url:         url,
+			token:       validToken,
+			authzRes:    &grpcChannelsV1.AuthzRes{},
+			authzErr:    svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+			skipRepoRun: true,
+		},
+		{
+			desc:     "list messages with repository error",
+			url:      url,
+			token:    validToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			repoErr:  readers.ErrReadMessages,
+			status:   http.StatusInternalServerError,
+		},
+		{
+			desc:     "list messages with filters",
+			url:      fmt.Sprintf("%s?offset=1&limit=5&subtopic=temp&publisher=%s&protocol=mqtt&name=t&v=10&comparator=ge&from=%d&to=%d", url, clientID, now-1, now),
+			token:    validToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list messages with aggregation",
+			url:      fmt.Sprintf("%s?aggregation=avg&interval=1h&from=%d&to=%d", url, now-1, now),
+			token:    validToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list messages in JSON format",
+			url:      url + "?format=json_messages",
+			token:    validToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: true},
+			status:   http.StatusOK,
+		},
+		{
+			desc:        "list messages with invalid limit",
+			url:         url + "?limit=1000",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid offset",
+			url:         url + "?offset=ten",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid comparator",
+			url:         url + "?v=1&comparator=invalid",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid value",
+			url:         url + "?v=ten",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid bool value",
+			url:         url + "?vb=yes",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid direction",
+			url:         url + "?dir=up",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid format",
+			url:         url + "?format=messages-1",
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with invalid aggregation",
+			url:         fmt.Sprintf("%s?aggregation=median&interval=1h&from=%d&to=%d", url, now-1, now),
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with aggregation without from",
+			url:         fmt.Sprintf("%s?aggregation=max&interval=1h&to=%d", url, now),
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with aggregation without to",
+			url:         fmt.Sprintf("%s?aggregation=max&interval=1h&from=%d", url, now),
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with aggregation and invalid interval",
+			url:         fmt.Sprintf("%s?aggregation=max&interval=hour&from=%d&to=%d", url, now-1, now),
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+		{
+			desc:        "list messages with aggregation in JSON format",
+			url:         fmt.Sprintf("%s?format=json&aggregation=max&interval=1h&from=%d&to=%d", url, now-1, now),
+			token:       validToken,
+			status:      http.StatusBadRequest,
+			skipAuthn:   true,
+			skipRepoRun: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var authnCall, clientsCall, channelsCall, repoCall *mock.Call
+			if !tc.skipAuthn {
+				switch {
+				case tc.token != "":
+					authnCall = authn.On("Authenticate", mock.Anything, tc.token).Return(smqauthn.Session{UserID: userID}, tc.authnErr)
+				case tc.key != "":
+					clientsCall = clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{Token: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, tc.key)}).Return(tc.authnRes, tc.authnErr)
+				}
+				channelsCall = channels.On("Authorize", mock.Anything, mock.Anything).Return(tc.authzRes, tc.authzErr)
+			}
+			if !tc.skipRepoRun {
+				repoCall = repo.On("ReadAll", chanID, mock.Anything).Return(readers.MessagesPage{Total: 1, Messages: []readers.Message{map[string]any{"name": "t"}}}, tc.repoErr)
+			}
+
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    tc.url,
+				token:  tc.token,
+				key:    tc.key,
+			}
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+			for _, call := range []*mock.Call{authnCall, clientsCall, channelsCall, repoCall} {
+				if call != nil {
+					call.Unset()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/readers"
+)
+
+var formatRegExp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+type listMessagesReq struct {
+	token    string
+	key      string
+	domainID string
+	chanID   string
+	pageMeta readers.PageMetadata
+}
+
+func (req listMessagesReq) validate() error {
+	if req.token == "" && req.key == "" {
+		return apiutil.ErrBearerToken
+	}
+	if req.chanID == "" {
+		return apiutil.ErrMissingChannelID
+	}
+	if req.pageMeta.Limit < 1 || req.pageMeta.Limit > api.MaxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+	if req.pageMeta.Comparator != "" &&
+		req.pageMeta.Comparator != readers.EqualKey &&
+		req.pageMeta.Comparator != readers.LowerThanKey &&
+		req.pageMeta.Comparator != readers.LowerThanEqualKey &&
+		req.pageMeta.Comparator != readers.GreaterThanKey &&
+		req.pageMeta.Comparator != readers.GreaterThanEqualKey {
+		return apiutil.ErrInvalidComparator
+	}
+	if req.pageMeta.Dir != "" && req.pageMeta.Dir != api.AscDir && req.pageMeta.Dir != api.DescDir {
+		return apiutil.ErrInvalidDirection
+	}
+	if !formatRegExp.MatchString(req.pageMeta.Format) {
+		return apiutil.ErrValidation
+	}
+
+	if req.pageMeta.Aggregation != "" {
+		if req.pageMeta.Format != readers.DefFormat {
+			return apiutil.ErrInvalidAggregation
+		}
+		switch strings.ToLower(req.pageMeta.Aggregation) {
+		case readers.MaxAggregation, readers.MinAggregation, readers.AvgAggregation, readers.SumAggregation, readers.CountAggregation:
+		default:
+			return apiutil.ErrInvalidAggregation
+		}
+		if req.pageMeta.From == 0 {
+			return apiutil.ErrMissingFrom
+		}
+		if req.pageMeta.To == 0 {
+			return apiutil.ErrMissingTo
+		}
+		interval, err := time.ParseDuration(req.pageMeta.Interval)
+		if err != nil || interval <= 0 {
+			return apiutil.ErrInvalidInterval
+		}
+	}
+
+	return nil
+}
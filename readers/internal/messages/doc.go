@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package messages provides the messages repository shared by the readers
+// of the messages stored by the PostgreSQL writer.
+package messages
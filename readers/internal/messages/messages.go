@@ -0,0 +1,279 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package messages
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/readers"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+// Postgres error codes:
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const errUndefinedTable = "42P01" // undefined_table
+
+var (
+	errInvalidAggregation = errors.New("invalid aggregation")
+	errInvalidInterval    = errors.New("invalid aggregation interval")
+)
+
+var aggregations = map[string]string{
+	readers.MaxAggregation:   "MAX",
+	readers.MinAggregation:   "MIN",
+	readers.AvgAggregation:   "AVG",
+	readers.SumAggregation:   "SUM",
+	readers.CountAggregation: "COUNT",
+}
+
+var (
+	senmlOrders = map[string]struct{}{"time": {}, "name": {}, "value": {}, "publisher": {}, "subtopic": {}, "protocol": {}}
+	jsonOrders  = map[string]struct{}{"created": {}, "publisher": {}, "subtopic": {}, "protocol": {}}
+)
+
+var _ readers.MessageRepository = (*repository)(nil)
+
+type repository struct {
+	db     *sqlx.DB
+	bucket string
+}
+
+// NewRepository returns new messages repository which reads the messages
+// stored by the PostgreSQL writer. The bucket is the SQL expression which
+// groups the message time, stored in nanoseconds, into the aggregation
+// interval given in nanoseconds by the :interval parameter.
+func NewRepository(db *sqlx.DB, bucket string) readers.MessageRepository {
+	return &repository{
+		db:     db,
+		bucket: bucket,
+	}
+}
+
+func (repo repository) ReadAll(chanID string, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	format := readers.DefFormat
+	if rpm.Format != "" {
+		format = rpm.Format
+	}
+	table := pgx.Identifier{format}.Sanitize()
+
+	params := map[string]any{
+		"channel":      chanID,
+		"limit":        rpm.Limit,
+		"offset":       rpm.Offset,
+		"subtopic":     rpm.Subtopic,
+		"publisher":    rpm.Publisher,
+		"name":         rpm.Name,
+		"protocol":     rpm.Protocol,
+		"value":        rpm.Value,
+		"bool_value":   rpm.BoolValue,
+		"string_value": rpm.StringValue,
+		"data_value":   rpm.DataValue,
+		"from":         rpm.From,
+		"to":           rpm.To,
+	}
+
+	condition := fmtCondition(format, rpm)
+	q := fmt.Sprintf(`SELECT * FROM %s WHERE %s ORDER BY %s LIMIT :limit OFFSET :offset;`, table, condition, fmtOrder(format, rpm))
+	tq := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, table, condition)
+
+	if format == readers.DefFormat && rpm.Aggregation != "" {
+		agg, ok := aggregations[strings.ToLower(rpm.Aggregation)]
+		if !ok {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errInvalidAggregation)
+		}
+		interval, err := time.ParseDuration(rpm.Interval)
+		if err != nil || interval <= 0 {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errInvalidInterval)
+		}
+		params["interval"] = interval.Nanoseconds()
+
+		bucket := repo.bucket
+		q = fmt.Sprintf(`SELECT %s AS time, name, unit, %s(value) AS value FROM %s WHERE %s
+			GROUP BY 1, name, unit ORDER BY time %s LIMIT :limit OFFSET :offset;`, bucket, agg, table, condition, fmtDir(rpm))
+		tq = fmt.Sprintf(`SELECT COUNT(*) FROM (SELECT %s FROM %s WHERE %s GROUP BY 1, name, unit) AS buckets;`, bucket, table, condition)
+	}
+
+	rows, err := repo.db.NamedQuery(q, params)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == errUndefinedTable {
+			return readers.MessagesPage{PageMetadata: rpm, Messages: []readers.Message{}}, nil
+		}
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer rows.Close()
+
+	page := readers.MessagesPage{
+		PageMetadata: rpm,
+		Messages:     []readers.Message{},
+	}
+	switch format {
+	case readers.DefFormat:
+		for rows.Next() {
+			msg := senmlMessage{Message: senml.Message{}}
+			if err := rows.StructScan(&msg); err != nil {
+				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+			}
+			page.Messages = append(page.Messages, msg.Message)
+		}
+	default:
+		for rows.Next() {
+			msg := jsonMessage{}
+			if err := rows.StructScan(&msg); err != nil {
+				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+			}
+			m, err := msg.toMap()
+			if err != nil {
+				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+			}
+			page.Messages = append(page.Messages, m)
+		}
+	}
+
+	total, err := repo.total(tq, params)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	page.Total = total
+
+	return page, nil
+}
+
+func (repo repository) total(query string, params map[string]any) (uint64, error) {
+	rows, err := repo.db.NamedQuery(query, params)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	total := uint64(0)
+	if rows.Next() {
+		if err := rows.Scan(&total); err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+func fmtCondition(format string, rpm readers.PageMetadata) string {
+	condition := `channel = :channel`
+
+	var query map[string]any
+	meta, err := json.Marshal(rpm)
+	if err != nil {
+		return condition
+	}
+	if err := json.Unmarshal(meta, &query); err != nil {
+		return condition
+	}
+
+	timeColumn := "time"
+	if format != readers.DefFormat {
+		timeColumn = "created"
+	}
+
+	for name := range query {
+		switch name {
+		case "subtopic", "publisher", "protocol":
+			condition = fmt.Sprintf(`%s AND %s = :%s`, condition, name, name)
+		case "from":
+			condition = fmt.Sprintf(`%s AND %s >= :from`, condition, timeColumn)
+		case "to":
+			condition = fmt.Sprintf(`%s AND %s < :to`, condition, timeColumn)
+		}
+		// Value filters are applicable only to SenML messages.
+		if format != readers.DefFormat {
+			continue
+		}
+		switch name {
+		case "name":
+			condition = fmt.Sprintf(`%s AND name = :name`, condition)
+		case "v":
+			comparator := readers.ParseValueComparator(query)
+			condition = fmt.Sprintf(`%s AND value %s :value`, condition, comparator)
+		case "vb":
+			condition = fmt.Sprintf(`%s AND bool_value = :bool_value`, condition)
+		case "vs":
+			comparator := readers.ParseValueComparator(query)
+			switch comparator {
+			case "=":
+				condition = fmt.Sprintf(`%s AND string_value = :string_value`, condition)
+			case ">":
+				condition = fmt.Sprintf(`%s AND string_value LIKE '%%' || :string_value || '%%' AND string_value <> :string_value`, condition)
+			case ">=":
+				condition = fmt.Sprintf(`%s AND string_value LIKE '%%' || :string_value || '%%'`, condition)
+			case "<=":
+				condition = fmt.Sprintf(`%s AND :string_value LIKE '%%' || string_value || '%%'`, condition)
+			case "<":
+				condition = fmt.Sprintf(`%s AND :string_value LIKE '%%' || string_value || '%%' AND string_value <> :string_value`, condition)
+			}
+		case "vd":
+			comparator := readers.ParseValueComparator(query)
+			condition = fmt.Sprintf(`%s AND data_value %s :data_value`, condition, comparator)
+		}
+	}
+
+	return condition
+}
+
+func fmtOrder(format string, rpm readers.PageMetadata) string {
+	order, orders := "time", senmlOrders
+	if format != readers.DefFormat {
+		order, orders = "created", jsonOrders
+	}
+	if _, ok := orders[rpm.Order]; ok {
+		order = rpm.Order
+	}
+
+	return fmt.Sprintf("%s %s", order, fmtDir(rpm))
+}
+
+func fmtDir(rpm readers.PageMetadata) string {
+	if strings.EqualFold(rpm.Dir, "asc") {
+		return "ASC"
+	}
+
+	return "DESC"
+}
+
+type senmlMessage struct {
+	ID string `db:"id"`
+	senml.Message
+}
+
+type jsonMessage struct {
+	ID        string `db:"id"`
+	Channel   string `db:"channel"`
+	Created   int64  `db:"created"`
+	Subtopic  string `db:"subtopic"`
+	Publisher string `db:"publisher"`
+	Protocol  string `db:"protocol"`
+	Payload   []byte `db:"payload"`
+}
+
+func (msg jsonMessage) toMap() (map[string]any, error) {
+	ret := map[string]any{
+		"id":        msg.ID,
+		"channel":   msg.Channel,
+		"created":   msg.Created,
+		"subtopic":  msg.Subtopic,
+		"publisher": msg.Publisher,
+		"protocol":  msg.Protocol,
+		"payload":   map[string]any{},
+	}
+	pld := make(map[string]any)
+	if err := json.Unmarshal(msg.Payload, &pld); err != nil {
+		return nil, err
+	}
+	ret["payload"] = pld
+
+	return ret, nil
+}
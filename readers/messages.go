@@ -18,6 +18,23 @@ const (
 	GreaterThanEqualKey = "ge"
 )
 
+const (
+	// MaxAggregation represents the maximum value aggregation.
+	MaxAggregation = "max"
+	// MinAggregation represents the minimum value aggregation.
+	MinAggregation = "min"
+	// AvgAggregation represents the average value aggregation.
+	AvgAggregation = "avg"
+	// SumAggregation represents the sum of values aggregation.
+	SumAggregation = "sum"
+	// CountAggregation represents the number of values aggregation.
+	CountAggregation = "count"
+)
+
+// DefFormat represents the format of the normalized SenML messages.
+// Any other format is considered as JSON messages format.
+const DefFormat = "messages"
+
 // ErrReadMessages indicates failure occurred while reading messages from database.
 var ErrReadMessages = errors.New("failed to read messages from database")
 
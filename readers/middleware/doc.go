@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package middleware provides logging and metrics middleware
+// for SuperMQ message readers.
+package middleware
@@ -0,0 +1,55 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/absmach/supermq/readers"
+)
+
+var _ readers.MessageRepository = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger *slog.Logger
+	repo   readers.MessageRepository
+}
+
+// NewLogging adds logging facilities to the message repository.
+func NewLogging(repo readers.MessageRepository, logger *slog.Logger) readers.MessageRepository {
+	return &loggingMiddleware{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (lm *loggingMiddleware) ReadAll(chanID string, rpm readers.PageMetadata) (page readers.MessagesPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", chanID),
+			slog.Group("page",
+				slog.String("format", rpm.Format),
+				slog.Uint64("offset", rpm.Offset),
+				slog.Uint64("limit", rpm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if rpm.Aggregation != "" {
+			args = append(args, slog.Group("aggregation",
+				slog.String("type", rpm.Aggregation),
+				slog.String("interval", rpm.Interval),
+			))
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Read all messages failed", args...)
+			return
+		}
+		lm.logger.Info("Read all messages completed successfully", args...)
+	}(time.Now())
+
+	return lm.repo.ReadAll(chanID, rpm)
+}
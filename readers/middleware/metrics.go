@@ -0,0 +1,37 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"time"
+
+	"github.com/absmach/supermq/readers"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ readers.MessageRepository = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	repo    readers.MessageRepository
+}
+
+// NewMetrics returns new message repository with ReadAll method wrapped to expose metrics.
+func NewMetrics(repo readers.MessageRepository, counter metrics.Counter, latency metrics.Histogram) readers.MessageRepository {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		repo:    repo,
+	}
+}
+
+func (mm *metricsMiddleware) ReadAll(chanID string, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "read_all").Add(1)
+		mm.latency.With("method", "read_all").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.repo.ReadAll(chanID, rpm)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"github.com/absmach/supermq/readers"
+	"github.com/absmach/supermq/readers/internal/messages"
+	"github.com/jmoiron/sqlx"
+)
+
+// Time is stored in nanoseconds, so buckets are
+// calculated by flooring time to the interval.
+const bucket = `FLOOR(time / :interval) * :interval`
+
+// New returns new PostgreSQL messages repository.
+func New(db *sqlx.DB) readers.MessageRepository {
+	return messages.NewRepository(db, bucket)
+}
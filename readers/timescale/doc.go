@@ -0,0 +1,5 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package timescale provides a TimescaleDB implementation of the messages repository.
+package timescale
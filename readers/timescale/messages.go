@@ -0,0 +1,20 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale
+
+import (
+	"github.com/absmach/supermq/readers"
+	"github.com/absmach/supermq/readers/internal/messages"
+	"github.com/jmoiron/sqlx"
+)
+
+// Time is stored in nanoseconds as FLOAT by the PostgreSQL writer, and
+// time_bucket supports only integer and timestamp columns, so time is cast
+// to BIGINT and bucketed by the interval in nanoseconds.
+const bucket = `time_bucket(:interval, CAST(time AS BIGINT))`
+
+// New returns new TimescaleDB messages repository.
+func New(db *sqlx.DB) readers.MessageRepository {
+	return messages.NewRepository(db, bucket)
+}
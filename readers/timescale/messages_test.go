@@ -0,0 +1,346 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/readers"
+	treader "github.com/absmach/supermq/readers/timescale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	subtopic    = "subtopic"
+	msgsNum     = 100
+	valueFields = 5
+	jsonFormat  = "json_messages"
+	mqttProt    = "mqtt"
+	httpProt    = "http"
+	msgName     = "temperature"
+)
+
+var (
+	v   float64 = 5
+	vs          = "stringValue"
+	vb          = true
+	vd          = "dataValue"
+	sum float64 = 42
+)
+
+const insertSenML = `INSERT INTO messages (id, channel, subtopic, publisher, protocol, name, unit,
+	value, string_value, bool_value, data_value, sum, time, update_time)
+	VALUES (:id, :channel, :subtopic, :publisher, :protocol, :name, :unit,
+	:value, :string_value, :bool_value, :data_value, :sum, :time, :update_time);`
+
+type dbSenML struct {
+	ID string `db:"id"`
+	senml.Message
+}
+
+func TestReadSenml(t *testing.T) {
+	reader := treader.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+	pubID2 := testsutil.GenerateUUID(t)
+	wrongID := testsutil.GenerateUUID(t)
+
+	m := senml.Message{
+		Channel:   chanID,
+		Publisher: pubID,
+		Protocol:  mqttProt,
+	}
+
+	messages := []senml.Message{}
+	valueMsgs := []senml.Message{}
+	boolMsgs := []senml.Message{}
+	stringMsgs := []senml.Message{}
+	dataMsgs := []senml.Message{}
+	queryMsgs := []senml.Message{}
+
+	// Messages are kept within the same day to get a single aggregation bucket.
+	now := float64(time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC).UnixNano())
+	for i := 0; i < msgsNum; i++ {
+		// Mix possible values as well as value sum.
+		msg := m
+		msg.Time = now - float64(i)*float64(time.Second)
+
+		count := i % valueFields
+		switch count {
+		case 0:
+			msg.Value = &v
+			valueMsgs = append(valueMsgs, msg)
+		case 1:
+			msg.BoolValue = &vb
+			boolMsgs = append(boolMsgs, msg)
+		case 2:
+			msg.StringValue = &vs
+			stringMsgs = append(stringMsgs, msg)
+		case 3:
+			msg.DataValue = &vd
+			dataMsgs = append(dataMsgs, msg)
+		case 4:
+			msg.Sum = &sum
+			msg.Subtopic = subtopic
+			msg.Protocol = httpProt
+			msg.Publisher = pubID2
+			msg.Name = msgName
+			queryMsgs = append(queryMsgs, msg)
+		}
+
+		messages = append(messages, msg)
+	}
+
+	for _, msg := range messages {
+		_, err := db.NamedExec(insertSenML, dbSenML{ID: testsutil.GenerateUUID(t), Message: msg})
+		require.Nil(t, err, fmt.Sprintf("failed to store message: %s", err))
+	}
+
+	cases := []struct {
+		desc     string
+		chanID   string
+		pageMeta readers.PageMetadata
+		total    uint64
+		count    int
+	}{
+		{
+			desc:     "read message page for existing channel",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum},
+			total:    msgsNum,
+			count:    msgsNum,
+		},
+		{
+			desc:     "read message page for non-existent channel",
+			chanID:   wrongID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum},
+			total:    0,
+			count:    0,
+		},
+		{
+			desc:     "read message last page",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: msgsNum - 20, Limit: msgsNum},
+			total:    msgsNum,
+			count:    20,
+		},
+		{
+			desc:     "read message with non-existent subtopic",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Subtopic: "not-present"},
+			total:    0,
+			count:    0,
+		},
+		{
+			desc:     "read message with subtopic",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Subtopic: subtopic},
+			total:    uint64(len(queryMsgs)),
+			count:    len(queryMsgs),
+		},
+		{
+			desc:     "read message with publisher",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Publisher: pubID2},
+			total:    uint64(len(queryMsgs)),
+			count:    len(queryMsgs),
+		},
+		{
+			desc:     "read message with protocol",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Protocol: httpProt},
+			total:    uint64(len(queryMsgs)),
+			count:    len(queryMsgs),
+		},
+		{
+			desc:     "read message with name",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Name: msgName},
+			total:    uint64(len(queryMsgs)),
+			count:    len(queryMsgs),
+		},
+		{
+			desc:     "read message with value",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Value: v},
+			total:    uint64(len(valueMsgs)),
+			count:    len(valueMsgs),
+		},
+		{
+			desc:     "read message with value and greater-than comparator",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Value: v - 1, Comparator: readers.GreaterThanKey},
+			total:    uint64(len(valueMsgs)),
+			count:    len(valueMsgs),
+		},
+		{
+			desc:     "read message with value and lower-than comparator",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, Value: v, Comparator: readers.LowerThanKey},
+			total:    0,
+			count:    0,
+		},
+		{
+			desc:     "read message with boolean value",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, BoolValue: vb},
+			total:    uint64(len(boolMsgs)),
+			count:    len(boolMsgs),
+		},
+		{
+			desc:     "read message with string value",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, StringValue: vs},
+			total:    uint64(len(stringMsgs)),
+			count:    len(stringMsgs),
+		},
+		{
+			desc:     "read message with string value and greater-than-equal comparator",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, StringValue: vs[1:4], Comparator: readers.GreaterThanEqualKey},
+			total:    uint64(len(stringMsgs)),
+			count:    len(stringMsgs),
+		},
+		{
+			desc:     "read message with data value",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, DataValue: vd},
+			total:    uint64(len(dataMsgs)),
+			count:    len(dataMsgs),
+		},
+		{
+			desc:     "read message with from",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, From: messages[19].Time},
+			total:    20,
+			count:    20,
+		},
+		{
+			desc:     "read message with to",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, To: messages[19].Time},
+			total:    msgsNum - 20,
+			count:    msgsNum - 20,
+		},
+		{
+			desc:     "read message with from and to",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, From: messages[39].Time, To: messages[19].Time},
+			total:    20,
+			count:    20,
+		},
+		{
+			desc:     "read message with count aggregation",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Offset: 0, Limit: msgsNum, From: messages[msgsNum-1].Time, To: now + 1, Aggregation: readers.CountAggregation, Interval: "24h", Name: msgName},
+			total:    1,
+			count:    1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := reader.ReadAll(tc.chanID, tc.pageMeta)
+			assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, page.Total))
+			assert.Len(t, page.Messages, tc.count, fmt.Sprintf("%s: expected %d messages got %d", tc.desc, tc.count, len(page.Messages)))
+		})
+	}
+}
+
+func TestReadSenmlInvalidAggregation(t *testing.T) {
+	reader := treader.New(db)
+
+	_, err := reader.ReadAll(testsutil.GenerateUUID(t), readers.PageMetadata{Limit: 10, Aggregation: "median", Interval: "1h"})
+	assert.ErrorIs(t, err, readers.ErrReadMessages)
+
+	_, err = reader.ReadAll(testsutil.GenerateUUID(t), readers.PageMetadata{Limit: 10, Aggregation: readers.MaxAggregation, Interval: "hour"})
+	assert.ErrorIs(t, err, readers.ErrReadMessages)
+}
+
+func TestReadJSON(t *testing.T) {
+	reader := treader.New(db)
+
+	_, err := db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		id        UUID,
+		created   BIGINT,
+		channel   VARCHAR(36),
+		subtopic  VARCHAR(254),
+		publisher VARCHAR(36),
+		protocol  TEXT,
+		payload   JSONB,
+		PRIMARY KEY (id)
+	)`, jsonFormat))
+	require.Nil(t, err, fmt.Sprintf("failed to create JSON table: %s", err))
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+	now := time.Now().UnixNano()
+	payload, err := json.Marshal(map[string]any{"field_1": 123.0, "field_2": "value"})
+	require.Nil(t, err, fmt.Sprintf("failed to marshal payload: %s", err))
+
+	for i := 0; i < msgsNum; i++ {
+		st := ""
+		if i%2 == 0 {
+			st = subtopic
+		}
+		_, err := db.Exec(fmt.Sprintf(`INSERT INTO %s (id, created, channel, subtopic, publisher, protocol, payload)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`, jsonFormat),
+			testsutil.GenerateUUID(t), now-int64(i), chanID, st, pubID, mqttProt, payload)
+		require.Nil(t, err, fmt.Sprintf("failed to store message: %s", err))
+	}
+
+	cases := []struct {
+		desc     string
+		chanID   string
+		pageMeta readers.PageMetadata
+		total    uint64
+		count    int
+	}{
+		{
+			desc:     "read JSON messages for existing channel",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Format: jsonFormat, Offset: 0, Limit: 10},
+			total:    msgsNum,
+			count:    10,
+		},
+		{
+			desc:     "read JSON messages with subtopic",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Format: jsonFormat, Offset: 0, Limit: msgsNum, Subtopic: subtopic},
+			total:    msgsNum / 2,
+			count:    msgsNum / 2,
+		},
+		{
+			desc:     "read JSON messages with from",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Format: jsonFormat, Offset: 0, Limit: msgsNum, From: float64(now - 9)},
+			total:    10,
+			count:    10,
+		},
+		{
+			desc:     "read JSON messages from non-existent format",
+			chanID:   chanID,
+			pageMeta: readers.PageMetadata{Format: "unknown", Offset: 0, Limit: msgsNum},
+			total:    0,
+			count:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := reader.ReadAll(tc.chanID, tc.pageMeta)
+			assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, page.Total))
+			assert.Len(t, page.Messages, tc.count, fmt.Sprintf("%s: expected %d messages got %d", tc.desc, tc.count, len(page.Messages)))
+		})
+	}
+}
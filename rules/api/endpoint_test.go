@@ -0,0 +1,603 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/rules"
+	httpapi "github.com/absmach/supermq/rules/api"
+	"github.com/absmach/supermq/rules/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const contentType = "application/json"
+
+var (
+	validToken   = "valid"
+	invalidToken = "invalid"
+	domainID     = testsutil.GenerateUUID(&testing.T{})
+	userID       = testsutil.GenerateUUID(&testing.T{})
+	validSession = smqauthn.Session{
+		UserID:       userID,
+		DomainID:     domainID,
+		DomainUserID: domainID + "_" + userID,
+	}
+	rule = rules.Rule{
+		ID:           testsutil.GenerateUUID(&testing.T{}),
+		Name:         "alarm",
+		DomainID:     domainID,
+		InputChannel: testsutil.GenerateUUID(&testing.T{}),
+		InputTopic:   "sensors.*",
+		Conditions: []rules.Condition{
+			{Field: "temperature", Comparator: rules.GreaterThanComparator, Value: float64(30)},
+		},
+		Actions: []rules.Action{
+			{Type: rules.PublishAction, Channel: testsutil.GenerateUUID(&testing.T{}), Subtopic: "alarms"},
+		},
+	}
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func toJSON(data any) string {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return ""
+	}
+	return string(jsonData)
+}
+
+func newRulesServer() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	svc := new(mocks.Service)
+
+	logger := smqlog.NewMock()
+	authn := new(authnmocks.Authentication)
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	mux := httpapi.MakeHandler(svc, am, logger, "rules", "test")
+	return httptest.NewServer(mux), svc, authn
+}
+
+func TestCreateRuleEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	withAction := func(a rules.Action) rules.Rule {
+		r := rule
+		r.Actions = []rules.Action{a}
+		return r
+	}
+	withCondition := func(c rules.Condition) rules.Rule {
+		r := rule
+		r.Conditions = []rules.Condition{c}
+		return r
+	}
+	noInput := rule
+	noInput.InputChannel = ""
+	invalidTopic := rule
+	invalidTopic.InputTopic = "sensors/temp#"
+	invalidContentType := rule
+	invalidContentType.ContentType = "text/plain"
+	noActions := rule
+	noActions.Actions = nil
+
+	cases := []struct {
+		desc        string
+		token       string
+		contentType string
+		body        string
+		authnErr    error
+		svcErr      error
+		status      int
+	}{
+		{
+			desc:        "create rule successfully",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create rule with invalid token",
+			token:       invalidToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create rule with invalid content type",
+			token:       validToken,
+			contentType: "text/plain",
+			body:        toJSON(rule),
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create rule with malformed body",
+			token:       validToken,
+			contentType: contentType,
+			body:        "{",
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule without input channel",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(noInput),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with invalid input topic",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(invalidTopic),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with unsupported message content type",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(invalidContentType),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule without actions",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(noActions),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with invalid action type",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withAction(rules.Action{Type: "invalid"})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with publish action without channel",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withAction(rules.Action{Type: rules.PublishAction})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with enrich action without fields",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withAction(rules.Action{Type: rules.EnrichAction})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with invalid comparator",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withCondition(rules.Condition{Field: "temperature", Comparator: "invalid", Value: float64(1)})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with condition without field",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withCondition(rules.Condition{Comparator: rules.EqualComparator, Value: float64(1)})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with non comparable condition value",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(withCondition(rules.Condition{Field: "temperature", Comparator: rules.LowerThanComparator, Value: true})),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create rule with service error",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			svcErr:      svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("AddRule", mock.Anything, validSession, mock.Anything).Return(rule, tc.svcErr)
+			req := testRequest{
+				client:      rs.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/rules", rs.URL, domainID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.body),
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			if tc.status == http.StatusCreated {
+				assert.Equal(t, fmt.Sprintf("/%s/rules/%s", domainID, rule.ID), resp.Header.Get("Location"))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewRuleEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "view rule successfully",
+			token:  validToken,
+			id:     rule.ID,
+			status: http.StatusOK,
+		},
+		{
+			desc:     "view rule with invalid token",
+			token:    invalidToken,
+			id:       rule.ID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "view non-existing rule",
+			token:  validToken,
+			id:     testsutil.GenerateUUID(t),
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("ViewRule", mock.Anything, validSession, tc.id).Return(rule, tc.svcErr)
+			req := testRequest{
+				client: rs.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/rules/%s", rs.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestUpdateRuleEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	noActions := rule
+	noActions.Actions = nil
+
+	cases := []struct {
+		desc        string
+		token       string
+		contentType string
+		body        string
+		authnErr    error
+		svcErr      error
+		status      int
+	}{
+		{
+			desc:        "update rule successfully",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update rule with invalid token",
+			token:       invalidToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update rule with invalid content type",
+			token:       validToken,
+			contentType: "text/plain",
+			body:        toJSON(rule),
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "update rule without actions",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(noActions),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update rule with service error",
+			token:       validToken,
+			contentType: contentType,
+			body:        toJSON(rule),
+			svcErr:      svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("UpdateRule", mock.Anything, validSession, mock.Anything).Return(rule, tc.svcErr)
+			req := testRequest{
+				client:      rs.Client(),
+				method:      http.MethodPatch,
+				url:         fmt.Sprintf("%s/%s/rules/%s", rs.URL, domainID, rule.ID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.body),
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListRulesEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		query    string
+		pm       rules.PageMeta
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "list rules successfully",
+			token:  validToken,
+			pm:     rules.PageMeta{Limit: 10, Status: rules.AllStatus},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "list rules with filters",
+			token:  validToken,
+			query:  fmt.Sprintf("?offset=1&limit=5&name=alarm&input_channel=%s&status=disabled", rule.InputChannel),
+			pm:     rules.PageMeta{Offset: 1, Limit: 5, Name: "alarm", InputChannel: rule.InputChannel, Status: rules.DisabledStatus},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list rules with invalid token",
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "list rules with invalid offset",
+			token:  validToken,
+			query:  "?offset=ten",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list rules with limit exceeding maximum",
+			token:  validToken,
+			query:  "?limit=1000",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list rules with invalid status",
+			token:  validToken,
+			query:  "?status=invalid",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list rules with duplicate name",
+			token:  validToken,
+			query:  "?name=a&name=b",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list rules with service error",
+			token:  validToken,
+			pm:     rules.PageMeta{Limit: 10, Status: rules.AllStatus},
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("ListRules", mock.Anything, validSession, tc.pm).Return(rules.Page{}, tc.svcErr)
+			req := testRequest{
+				client: rs.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/rules%s", rs.URL, domainID, tc.query),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDeleteRuleEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "delete rule successfully",
+			token:  validToken,
+			id:     rule.ID,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:     "delete rule with invalid token",
+			token:    invalidToken,
+			id:       rule.ID,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "delete non-existing rule",
+			token:  validToken,
+			id:     testsutil.GenerateUUID(t),
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On("RemoveRule", mock.Anything, validSession, tc.id).Return(tc.svcErr)
+			req := testRequest{
+				client: rs.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/rules/%s", rs.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestChangeRuleStatusEndpoint(t *testing.T) {
+	rs, svc, authn := newRulesServer()
+	defer rs.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		action   string
+		method   string
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:   "enable rule successfully",
+			token:  validToken,
+			action: "enable",
+			method: "EnableRule",
+			status: http.StatusOK,
+		},
+		{
+			desc:   "disable rule successfully",
+			token:  validToken,
+			action: "disable",
+			method: "DisableRule",
+			status: http.StatusOK,
+		},
+		{
+			desc:     "disable rule with invalid token",
+			token:    invalidToken,
+			action:   "disable",
+			method:   "DisableRule",
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:   "enable rule with service error",
+			token:  validToken,
+			action: "enable",
+			method: "EnableRule",
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			svcCall := svc.On(tc.method, mock.Anything, validSession, rule.ID).Return(rule, tc.svcErr)
+			req := testRequest{
+				client: rs.Client(),
+				method: http.MethodPost,
+				url:    fmt.Sprintf("%s/%s/rules/%s/%s", rs.URL, domainID, rule.ID, tc.action),
+				token:  tc.token,
+			}
+
+			resp, err := req.make()
+			assert.Nil(t, err, tc.desc)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode, tc.desc)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	api "github.com/absmach/supermq/api/http"
+	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/rules"
+)
+
+type ruleReq struct {
+	rules.Rule
+}
+
+func (req ruleReq) validate() error {
+	if len(req.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+	if req.InputChannel == "" {
+		return apiutil.ErrMissingChannelID
+	}
+	if _, err := messaging.ParseSubscribeSubtopic(req.InputTopic); err != nil {
+		return errors.Wrap(apiutil.ErrInvalidTopic, err)
+	}
+	if req.ContentType != "" && !rules.SupportedContentType(req.ContentType) {
+		return rules.ErrUnsupportedContentType
+	}
+	for _, c := range req.Conditions {
+		if err := validateCondition(c); err != nil {
+			return err
+		}
+	}
+	if len(req.Actions) == 0 {
+		return apiutil.ErrMissingRuleActions
+	}
+	for _, a := range req.Actions {
+		if err := validateAction(a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCondition(c rules.Condition) error {
+	if c.Field == "" {
+		return apiutil.ErrInvalidRuleCondition
+	}
+	switch c.Comparator {
+	case rules.EqualComparator, rules.NotEqualComparator:
+	case rules.LowerThanComparator, rules.LowerThanEqualComparator, rules.GreaterThanComparator, rules.GreaterThanEqComparator:
+		switch c.Value.(type) {
+		case float64, string:
+		default:
+			return apiutil.ErrInvalidRuleCondition
+		}
+	default:
+		return apiutil.ErrInvalidComparator
+	}
+
+	return nil
+}
+
+func validateAction(a rules.Action) error {
+	switch a.Type {
+	case rules.PublishAction:
+		if a.Channel == "" {
+			return apiutil.ErrMissingChannelID
+		}
+		if _, err := messaging.ParsePublishSubtopic(a.Subtopic); err != nil {
+			return errors.Wrap(apiutil.ErrInvalidTopic, err)
+		}
+	case rules.EnrichAction:
+		if len(a.Fields) == 0 {
+			return apiutil.ErrInvalidRuleAction
+		}
+	case rules.DropAction:
+	default:
+		return apiutil.ErrInvalidRuleAction
+	}
+
+	return nil
+}
+
+type createRuleReq struct {
+	ruleReq
+}
+
+func (req createRuleReq) validate() error {
+	return req.ruleReq.validate()
+}
+
+type updateRuleReq struct {
+	ruleReq
+}
+
+func (req updateRuleReq) validate() error {
+	if req.ID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return req.ruleReq.validate()
+}
+
+type ruleIDReq struct {
+	id string
+}
+
+func (req ruleIDReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listRulesReq struct {
+	rules.PageMeta
+}
+
+func (req listRulesReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+	if len(req.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	return nil
+}
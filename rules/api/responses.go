@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/absmach/supermq"
+	"github.com/absmach/supermq/rules"
+)
+
+var (
+	_ supermq.Response = (*ruleRes)(nil)
+	_ supermq.Response = (*rulesPageRes)(nil)
+	_ supermq.Response = (*deleteRuleRes)(nil)
+)
+
+type ruleRes struct {
+	rules.Rule `json:",inline"`
+	created    bool
+}
+
+func (res ruleRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/rules/%s", res.DomainID, res.ID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res ruleRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res ruleRes) Empty() bool {
+	return false
+}
+
+type rulesPageRes struct {
+	rules.Page `json:",inline"`
+}
+
+func (res rulesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res rulesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res rulesPageRes) Empty() bool {
+	return false
+}
+
+type deleteRuleRes struct{}
+
+func (res deleteRuleRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deleteRuleRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res deleteRuleRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rules contains the rules engine service.
+// This service evaluates domain rules against every message published to the
+// rule input channel and republishes, enriches or drops the matching messages.
+// It also provides a REST API to manage the rules.
+package rules
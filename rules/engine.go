@@ -0,0 +1,251 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/transformers"
+	smqjson "github.com/absmach/supermq/pkg/transformers/json"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+// JSONContentType represents JSON messages content type.
+const JSONContentType = "application/json"
+
+// defFormat is the JSON messages format used when the message has no subtopic.
+const defFormat = "messages"
+
+var contentTypes = map[string]transformers.Transformer{
+	senml.JSON:      senml.New(senml.JSON),
+	senml.CBOR:      senml.New(senml.CBOR),
+	JSONContentType: smqjson.New(nil),
+}
+
+// SupportedContentType returns true if rules can be evaluated against messages
+// of the given content type.
+func SupportedContentType(contentType string) bool {
+	_, ok := contentTypes[contentType]
+	return ok
+}
+
+// MatchTopic reports whether the message subtopic matches the rule input topic.
+// Input topic uses the message broker subtopic format where "*" matches a
+// single segment and ">" matches one or more trailing segments. Empty input
+// topic matches any subtopic.
+func MatchTopic(topic, subtopic string) bool {
+	if topic == "" {
+		return true
+	}
+	ts := strings.Split(topic, ".")
+	ss := strings.Split(subtopic, ".")
+	if subtopic == "" {
+		ss = nil
+	}
+	for i, t := range ts {
+		if t == ">" {
+			return len(ss) > i
+		}
+		if i >= len(ss) {
+			return false
+		}
+		if t != "*" && t != ss[i] {
+			return false
+		}
+	}
+
+	return len(ts) == len(ss)
+}
+
+// evaluate reports whether the message satisfies all the rule conditions.
+func evaluate(r Rule, msg *messaging.Message) (bool, error) {
+	if len(r.Conditions) == 0 {
+		return true, nil
+	}
+	contentType := r.ContentType
+	if contentType == "" {
+		contentType = senml.JSON
+	}
+	t, ok := contentTypes[contentType]
+	if !ok {
+		return false, ErrUnsupportedContentType
+	}
+
+	m := msg
+	if contentType == JSONContentType && msg.GetSubtopic() == "" {
+		// JSON transformer uses the last subtopic segment as the format.
+		m = &messaging.Message{Payload: msg.GetPayload(), Subtopic: defFormat}
+	}
+	decoded, err := t.Transform(m)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range r.Conditions {
+		if !matchCondition(c, decoded) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchCondition(c Condition, decoded any) bool {
+	switch msgs := decoded.(type) {
+	case []senml.Message:
+		for _, m := range msgs {
+			if m.Name != c.Field {
+				continue
+			}
+			if compare(c.Comparator, senmlValue(m), c.Value) {
+				return true
+			}
+		}
+	case smqjson.Messages:
+		for _, m := range msgs.Data {
+			v, ok := lookup(m.Payload, c.Field)
+			if !ok {
+				continue
+			}
+			if compare(c.Comparator, v, c.Value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lookup returns the payload value of the field where nested keys are
+// joined with "/".
+func lookup(payload map[string]any, field string) (any, bool) {
+	var cur any = map[string]any(payload)
+	for _, k := range strings.Split(field, "/") {
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = obj[k]; !ok {
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+func senmlValue(m senml.Message) any {
+	switch {
+	case m.Value != nil:
+		return *m.Value
+	case m.StringValue != nil:
+		return *m.StringValue
+	case m.BoolValue != nil:
+		return *m.BoolValue
+	case m.DataValue != nil:
+		return *m.DataValue
+	case m.Sum != nil:
+		return *m.Sum
+	default:
+		return nil
+	}
+}
+
+func compare(cmp Comparator, actual, expected any) bool {
+	if a, ok := toFloat(actual); ok {
+		e, ok := toFloat(expected)
+		if !ok {
+			return cmp == NotEqualComparator
+		}
+		switch cmp {
+		case EqualComparator:
+			return a == e
+		case NotEqualComparator:
+			return a != e
+		case LowerThanComparator:
+			return a < e
+		case LowerThanEqualComparator:
+			return a <= e
+		case GreaterThanComparator:
+			return a > e
+		case GreaterThanEqComparator:
+			return a >= e
+		}
+		return false
+	}
+
+	switch actual.(type) {
+	case string, bool, nil:
+	default:
+		// Nested objects and arrays can not be compared.
+		return cmp == NotEqualComparator
+	}
+	switch cmp {
+	case EqualComparator:
+		return actual == expected
+	case NotEqualComparator:
+		return actual != expected
+	}
+	if a, ok := actual.(string); ok {
+		e, ok := expected.(string)
+		if !ok {
+			return false
+		}
+		switch cmp {
+		case LowerThanComparator:
+			return a < e
+		case LowerThanEqualComparator:
+			return a <= e
+		case GreaterThanComparator:
+			return a > e
+		case GreaterThanEqComparator:
+			return a >= e
+		}
+	}
+
+	return false
+}
+
+func toFloat(v any) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case float32:
+		return float64(val), true
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case json.Number:
+		f, err := val.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// enrich merges the fields into the JSON payload. Payload can be either
+// a single JSON object or an array of JSON objects.
+func enrich(payload []byte, fields map[string]any) ([]byte, error) {
+	var obj map[string]any
+	if err := json.Unmarshal(payload, &obj); err == nil {
+		for k, v := range fields {
+			obj[k] = v
+		}
+		return json.Marshal(obj)
+	}
+
+	var objs []map[string]any
+	if err := json.Unmarshal(payload, &objs); err != nil {
+		return nil, ErrEnrichPayload
+	}
+	for _, o := range objs {
+		for k, v := range fields {
+			o[k] = v
+		}
+	}
+
+	return json.Marshal(objs)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+var _ messaging.MessageHandler = (*handler)(nil)
+
+type handler struct {
+	ctx    context.Context
+	svc    Service
+	logger *slog.Logger
+}
+
+// NewHandler returns a message handler which evaluates the rules
+// against every received message.
+func NewHandler(ctx context.Context, svc Service, logger *slog.Logger) messaging.MessageHandler {
+	return &handler{
+		ctx:    ctx,
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	err := h.svc.HandleMessage(h.ctx, msg)
+	if err == nil {
+		return nil
+	}
+	h.logger.Warn("Failed to handle message",
+		slog.String("domain_id", msg.GetDomain()),
+		slog.String("channel_id", msg.GetChannel()),
+		slog.String("error", err.Error()),
+	)
+	if errors.Contains(err, ErrEnrichPayload) {
+		// Message payload is never going to be enriched, so there is no point in redelivery.
+		return messaging.NewError(err, messaging.Term)
+	}
+
+	return err
+}
+
+func (h *handler) Cancel() error {
+	return nil
+}
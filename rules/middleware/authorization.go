@@ -0,0 +1,152 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	smqauthz "github.com/absmach/supermq/pkg/authz"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/policies"
+	"github.com/absmach/supermq/rules"
+)
+
+var (
+	_ rules.Service = (*authorizationMiddleware)(nil)
+
+	readPermission      = "read_permission"
+	publishPermission   = "publish_permission"
+	subscribePermission = "subscribe_permission"
+)
+
+type authorizationMiddleware struct {
+	svc   rules.Service
+	authz smqauthz.Authorization
+}
+
+// NewAuthorization adds authorization to the rules service.
+// Rules are authorized using the permissions of their channels: a rule can be
+// managed by the users who can subscribe to its input channel and publish to
+// all the channels it publishes to, and viewed by the users who can read its
+// input channel.
+func NewAuthorization(svc rules.Service, authz smqauthz.Authorization) rules.Service {
+	return &authorizationMiddleware{
+		svc:   svc,
+		authz: authz,
+	}
+}
+
+func (am *authorizationMiddleware) AddRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rules.Rule, error) {
+	if err := am.authorizeRule(ctx, session, r); err != nil {
+		return rules.Rule{}, err
+	}
+
+	return am.svc.AddRule(ctx, session, r)
+}
+
+func (am *authorizationMiddleware) ViewRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	rule, err := am.svc.ViewRule(ctx, session, id)
+	if err != nil {
+		return rules.Rule{}, err
+	}
+	if err := am.authorize(ctx, session, readPermission, policies.ChannelType, rule.InputChannel); err != nil {
+		return rules.Rule{}, err
+	}
+
+	return rule, nil
+}
+
+func (am *authorizationMiddleware) UpdateRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rules.Rule, error) {
+	if err := am.authorizeManage(ctx, session, r.ID); err != nil {
+		return rules.Rule{}, err
+	}
+	if err := am.authorizeRule(ctx, session, r); err != nil {
+		return rules.Rule{}, err
+	}
+
+	return am.svc.UpdateRule(ctx, session, r)
+}
+
+func (am *authorizationMiddleware) ListRules(ctx context.Context, session smqauthn.Session, pm rules.PageMeta) (rules.Page, error) {
+	objectType, object := policies.DomainType, session.DomainID
+	if pm.InputChannel != "" {
+		objectType, object = policies.ChannelType, pm.InputChannel
+	}
+	if err := am.authorize(ctx, session, readPermission, objectType, object); err != nil {
+		return rules.Page{}, err
+	}
+
+	return am.svc.ListRules(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemoveRule(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := am.authorizeManage(ctx, session, id); err != nil {
+		return err
+	}
+
+	return am.svc.RemoveRule(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) EnableRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	if err := am.authorizeManage(ctx, session, id); err != nil {
+		return rules.Rule{}, err
+	}
+
+	return am.svc.EnableRule(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) DisableRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	if err := am.authorizeManage(ctx, session, id); err != nil {
+		return rules.Rule{}, err
+	}
+
+	return am.svc.DisableRule(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) HandleMessage(ctx context.Context, msg *messaging.Message) error {
+	return am.svc.HandleMessage(ctx, msg)
+}
+
+// authorizeManage checks if the user can manage the existing rule.
+func (am *authorizationMiddleware) authorizeManage(ctx context.Context, session smqauthn.Session, id string) error {
+	rule, err := am.svc.ViewRule(ctx, session, id)
+	if err != nil {
+		return err
+	}
+
+	return am.authorize(ctx, session, subscribePermission, policies.ChannelType, rule.InputChannel)
+}
+
+// authorizeRule checks if the user can subscribe to the rule input channel
+// and publish to the rule output channels.
+func (am *authorizationMiddleware) authorizeRule(ctx context.Context, session smqauthn.Session, r rules.Rule) error {
+	if err := am.authorize(ctx, session, subscribePermission, policies.ChannelType, r.InputChannel); err != nil {
+		return err
+	}
+	for _, a := range r.Actions {
+		if a.Type != rules.PublishAction {
+			continue
+		}
+		if err := am.authorize(ctx, session, publishPermission, policies.ChannelType, a.Channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (am *authorizationMiddleware) authorize(ctx context.Context, session smqauthn.Session, permission, objectType, object string) error {
+	req := smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     session.DomainUserID,
+		Permission:  permission,
+		ObjectType:  objectType,
+		Object:      object,
+	}
+
+	return am.authz.Authorize(ctx, req)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/rules"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+var _ rules.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger  *slog.Logger
+	service rules.Service
+}
+
+// NewLogging adds logging facilities to the rules service.
+func NewLogging(service rules.Service, logger *slog.Logger) rules.Service {
+	return &loggingMiddleware{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (lm *loggingMiddleware) AddRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rule rules.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("rule",
+				slog.String("id", rule.ID),
+				slog.String("name", r.Name),
+				slog.String("input_channel", r.InputChannel),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Add rule failed", args...)
+			return
+		}
+		lm.logger.Info("Add rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.AddRule(ctx, session, r)
+}
+
+func (lm *loggingMiddleware) ViewRule(ctx context.Context, session smqauthn.Session, id string) (rule rules.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("View rule failed", args...)
+			return
+		}
+		lm.logger.Info("View rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewRule(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdateRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rule rules.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("rule",
+				slog.String("id", r.ID),
+				slog.String("name", r.Name),
+				slog.String("input_channel", r.InputChannel),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Update rule failed", args...)
+			return
+		}
+		lm.logger.Info("Update rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateRule(ctx, session, r)
+}
+
+func (lm *loggingMiddleware) ListRules(ctx context.Context, session smqauthn.Session, pm rules.PageMeta) (page rules.Page, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("page",
+				slog.String("input_channel", pm.InputChannel),
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List rules failed", args...)
+			return
+		}
+		lm.logger.Info("List rules completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListRules(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemoveRule(ctx context.Context, session smqauthn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Remove rule failed", args...)
+			return
+		}
+		lm.logger.Info("Remove rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveRule(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) EnableRule(ctx context.Context, session smqauthn.Session, id string) (rule rules.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Enable rule failed", args...)
+			return
+		}
+		lm.logger.Info("Enable rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.EnableRule(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) DisableRule(ctx context.Context, session smqauthn.Session, id string) (rule rules.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Disable rule failed", args...)
+			return
+		}
+		lm.logger.Info("Disable rule completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.DisableRule(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) HandleMessage(ctx context.Context, msg *messaging.Message) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("message",
+				slog.String("domain_id", msg.GetDomain()),
+				slog.String("channel_id", msg.GetChannel()),
+				slog.String("subtopic", msg.GetSubtopic()),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Handle message failed", args...)
+			return
+		}
+		lm.logger.Debug("Handle message completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.HandleMessage(ctx, msg)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/messaging"
+	smqTracing "github.com/absmach/supermq/pkg/tracing"
+	"github.com/absmach/supermq/rules"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ rules.Service = (*tracing)(nil)
+
+type tracing struct {
+	tracer trace.Tracer
+	svc    rules.Service
+}
+
+// NewTracing returns a new rules service with tracing capabilities.
+func NewTracing(svc rules.Service, tracer trace.Tracer) rules.Service {
+	return &tracing{tracer, svc}
+}
+
+func (tm *tracing) AddRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rules.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "add_rule", trace.WithAttributes(
+		attribute.String("name", r.Name),
+		attribute.String("input_channel", r.InputChannel),
+	))
+	defer span.End()
+
+	return tm.svc.AddRule(ctx, session, r)
+}
+
+func (tm *tracing) ViewRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_rule", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewRule(ctx, session, id)
+}
+
+func (tm *tracing) UpdateRule(ctx context.Context, session smqauthn.Session, r rules.Rule) (rules.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_rule", trace.WithAttributes(
+		attribute.String("id", r.ID),
+		attribute.String("name", r.Name),
+		attribute.String("input_channel", r.InputChannel),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateRule(ctx, session, r)
+}
+
+func (tm *tracing) ListRules(ctx context.Context, session smqauthn.Session, pm rules.PageMeta) (rules.Page, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_rules", trace.WithAttributes(
+		attribute.String("input_channel", pm.InputChannel),
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListRules(ctx, session, pm)
+}
+
+func (tm *tracing) RemoveRule(ctx context.Context, session smqauthn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_rule", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveRule(ctx, session, id)
+}
+
+func (tm *tracing) EnableRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "enable_rule", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.EnableRule(ctx, session, id)
+}
+
+func (tm *tracing) DisableRule(ctx context.Context, session smqauthn.Session, id string) (rules.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "disable_rule", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.DisableRule(ctx, session, id)
+}
+
+func (tm *tracing) HandleMessage(ctx context.Context, msg *messaging.Message) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "handle_message", trace.WithAttributes(
+		attribute.String("domain_id", msg.GetDomain()),
+		attribute.String("channel_id", msg.GetChannel()),
+		attribute.String("subtopic", msg.GetSubtopic()),
+	))
+	defer span.End()
+
+	return tm.svc.HandleMessage(ctx, msg)
+}
@@ -0,0 +1,442 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/rules"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+type Repository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repository) EXPECT() *Repository_Expecter {
+	return &Repository_Expecter{mock: &_m.Mock}
+}
+
+// AddRule provides a mock function for the type Repository
+func (_mock *Repository) AddRule(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRule")
+	}
+
+	var r0 rules.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) (rules.Rule, error)); ok {
+		return returnFunc(ctx, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) rules.Rule); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Get(0).(rules.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, rules.Rule) error); ok {
+		r1 = returnFunc(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRule'
+type Repository_AddRule_Call struct {
+	*mock.Call
+}
+
+// AddRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r rules.Rule
+func (_e *Repository_Expecter) AddRule(ctx interface{}, r interface{}) *Repository_AddRule_Call {
+	return &Repository_AddRule_Call{Call: _e.mock.On("AddRule", ctx, r)}
+}
+
+func (_c *Repository_AddRule_Call) Run(run func(ctx context.Context, r rules.Rule)) *Repository_AddRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 rules.Rule
+		if args[1] != nil {
+			arg1 = args[1].(rules.Rule)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddRule_Call) Return(rule rules.Rule, err error) *Repository_AddRule_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *Repository_AddRule_Call) RunAndReturn(run func(ctx context.Context, r rules.Rule) (rules.Rule, error)) *Repository_AddRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRules provides a mock function for the type Repository
+func (_mock *Repository) ListRules(ctx context.Context, pm rules.PageMeta) (rules.Page, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 rules.Page
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.PageMeta) (rules.Page, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.PageMeta) rules.Page); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(rules.Page)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, rules.PageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRules'
+type Repository_ListRules_Call struct {
+	*mock.Call
+}
+
+// ListRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm rules.PageMeta
+func (_e *Repository_Expecter) ListRules(ctx interface{}, pm interface{}) *Repository_ListRules_Call {
+	return &Repository_ListRules_Call{Call: _e.mock.On("ListRules", ctx, pm)}
+}
+
+func (_c *Repository_ListRules_Call) Run(run func(ctx context.Context, pm rules.PageMeta)) *Repository_ListRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 rules.PageMeta
+		if args[1] != nil {
+			arg1 = args[1].(rules.PageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListRules_Call) Return(page rules.Page, err error) *Repository_ListRules_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *Repository_ListRules_Call) RunAndReturn(run func(ctx context.Context, pm rules.PageMeta) (rules.Page, error)) *Repository_ListRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRule provides a mock function for the type Repository
+func (_mock *Repository) RemoveRule(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRule'
+type Repository_RemoveRule_Call struct {
+	*mock.Call
+}
+
+// RemoveRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemoveRule(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemoveRule_Call {
+	return &Repository_RemoveRule_Call{Call: _e.mock.On("RemoveRule", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemoveRule_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemoveRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveRule_Call) Return(err error) *Repository_RemoveRule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveRule_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemoveRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRule provides a mock function for the type Repository
+func (_mock *Repository) UpdateRule(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRule")
+	}
+
+	var r0 rules.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) (rules.Rule, error)); ok {
+		return returnFunc(ctx, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) rules.Rule); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Get(0).(rules.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, rules.Rule) error); ok {
+		r1 = returnFunc(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
+type Repository_UpdateRule_Call struct {
+	*mock.Call
+}
+
+// UpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r rules.Rule
+func (_e *Repository_Expecter) UpdateRule(ctx interface{}, r interface{}) *Repository_UpdateRule_Call {
+	return &Repository_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, r)}
+}
+
+func (_c *Repository_UpdateRule_Call) Run(run func(ctx context.Context, r rules.Rule)) *Repository_UpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 rules.Rule
+		if args[1] != nil {
+			arg1 = args[1].(rules.Rule)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateRule_Call) Return(rule rules.Rule, err error) *Repository_UpdateRule_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *Repository_UpdateRule_Call) RunAndReturn(run func(ctx context.Context, r rules.Rule) (rules.Rule, error)) *Repository_UpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRuleStatus provides a mock function for the type Repository
+func (_mock *Repository) UpdateRuleStatus(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRuleStatus")
+	}
+
+	var r0 rules.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) (rules.Rule, error)); ok {
+		return returnFunc(ctx, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rules.Rule) rules.Rule); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Get(0).(rules.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, rules.Rule) error); ok {
+		r1 = returnFunc(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateRuleStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRuleStatus'
+type Repository_UpdateRuleStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateRuleStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r rules.Rule
+func (_e *Repository_Expecter) UpdateRuleStatus(ctx interface{}, r interface{}) *Repository_UpdateRuleStatus_Call {
+	return &Repository_UpdateRuleStatus_Call{Call: _e.mock.On("UpdateRuleStatus", ctx, r)}
+}
+
+func (_c *Repository_UpdateRuleStatus_Call) Run(run func(ctx context.Context, r rules.Rule)) *Repository_UpdateRuleStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 rules.Rule
+		if args[1] != nil {
+			arg1 = args[1].(rules.Rule)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateRuleStatus_Call) Return(rule rules.Rule, err error) *Repository_UpdateRuleStatus_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *Repository_UpdateRuleStatus_Call) RunAndReturn(run func(ctx context.Context, r rules.Rule) (rules.Rule, error)) *Repository_UpdateRuleStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViewRule provides a mock function for the type Repository
+func (_mock *Repository) ViewRule(ctx context.Context, domainID string, id string) (rules.Rule, error) {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewRule")
+	}
+
+	var r0 rules.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (rules.Rule, error)); ok {
+		return returnFunc(ctx, domainID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) rules.Rule); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Get(0).(rules.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ViewRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewRule'
+type Repository_ViewRule_Call struct {
+	*mock.Call
+}
+
+// ViewRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) ViewRule(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewRule_Call {
+	return &Repository_ViewRule_Call{Call: _e.mock.On("ViewRule", ctx, domainID, id)}
+}
+
+func (_c *Repository_ViewRule_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ViewRule_Call) Return(rule rules.Rule, err error) *Repository_ViewRule_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *Repository_ViewRule_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (rules.Rule, error)) *Repository_ViewRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
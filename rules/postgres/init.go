@@ -0,0 +1,40 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "rules_01",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS rules (
+						id              VARCHAR(36) PRIMARY KEY,
+						name            VARCHAR(1024),
+						domain_id       VARCHAR(36) NOT NULL,
+						input_channel   VARCHAR(36) NOT NULL,
+						input_topic     TEXT,
+						content_type    VARCHAR(254) NOT NULL,
+						conditions      JSONB,
+						actions         JSONB NOT NULL,
+						status          SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						created_at      TIMESTAMPTZ NOT NULL,
+						created_by      VARCHAR(254),
+						updated_at      TIMESTAMPTZ,
+						updated_by      VARCHAR(254)
+					)`,
+					`CREATE INDEX idx_rules_domain_channel ON rules(domain_id, input_channel, status, created_at);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS rules`,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/postgres"
+	"github.com/absmach/supermq/rules"
+)
+
+const ruleColumns = `id, COALESCE(name, '') AS name, domain_id, input_channel, COALESCE(input_topic, '') AS input_topic,
+	content_type, conditions, actions, status, created_at, COALESCE(created_by, '') AS created_by, updated_at, COALESCE(updated_by, '') AS updated_by`
+
+type repository struct {
+	db postgres.Database
+	eh errors.Handler
+}
+
+// NewRepository instantiates a PostgreSQL implementation of rules repository.
+func NewRepository(db postgres.Database) rules.Repository {
+	errHandlerOptions := []errors.HandlerOption{
+		postgres.WithDuplicateErrors(NewDuplicateErrors()),
+	}
+	return &repository{
+		db: db,
+		eh: postgres.NewErrorHandler(errHandlerOptions...),
+	}
+}
+
+func (repo *repository) AddRule(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	q := fmt.Sprintf(`INSERT INTO rules (id, name, domain_id, input_channel, input_topic, content_type, conditions, actions, status, created_at, created_by)
+		VALUES (:id, :name, :domain_id, :input_channel, :input_topic, :content_type, :conditions, :actions, :status, :created_at, :created_by)
+		RETURNING %s;`, ruleColumns)
+
+	dbr, err := toDBRule(r)
+	if err != nil {
+		return rules.Rule{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return repo.returning(ctx, q, dbr, repoerr.ErrCreateEntity)
+}
+
+func (repo *repository) ViewRule(ctx context.Context, domainID, id string) (rules.Rule, error) {
+	q := fmt.Sprintf(`SELECT %s FROM rules WHERE id = :id AND domain_id = :domain_id;`, ruleColumns)
+
+	dbr := dbRule{
+		ID:       id,
+		DomainID: domainID,
+	}
+
+	return repo.returning(ctx, q, dbr, repoerr.ErrViewEntity)
+}
+
+func (repo *repository) UpdateRule(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	q := fmt.Sprintf(`UPDATE rules SET name = :name, input_channel = :input_channel, input_topic = :input_topic, content_type = :content_type,
+		conditions = :conditions, actions = :actions, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id
+		RETURNING %s;`, ruleColumns)
+
+	dbr, err := toDBRule(r)
+	if err != nil {
+		return rules.Rule{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return repo.returning(ctx, q, dbr, repoerr.ErrUpdateEntity)
+}
+
+func (repo *repository) UpdateRuleStatus(ctx context.Context, r rules.Rule) (rules.Rule, error) {
+	q := fmt.Sprintf(`UPDATE rules SET status = :status, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id
+		RETURNING %s;`, ruleColumns)
+
+	dbr, err := toDBRule(r)
+	if err != nil {
+		return rules.Rule{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return repo.returning(ctx, q, dbr, repoerr.ErrUpdateEntity)
+}
+
+func (repo *repository) RemoveRule(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM rules WHERE id = :id AND domain_id = :domain_id;`
+
+	dbr := dbRule{
+		ID:       id,
+		DomainID: domainID,
+	}
+	result, err := repo.db.NamedExecContext(ctx, q, dbr)
+	if err != nil {
+		return repo.eh.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *repository) ListRules(ctx context.Context, pm rules.PageMeta) (rules.Page, error) {
+	query := pageQuery(pm)
+
+	var lq string
+	if pm.Limit > 0 {
+		lq = "LIMIT :limit"
+	}
+	q := fmt.Sprintf(`SELECT %s FROM rules %s ORDER BY created_at, id %s OFFSET :offset;`, ruleColumns, query, lq)
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, pm)
+	if err != nil {
+		return rules.Page{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []rules.Rule
+	for rows.Next() {
+		var dbr dbRule
+		if err := rows.StructScan(&dbr); err != nil {
+			return rules.Page{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+		}
+		r, err := toRule(dbr)
+		if err != nil {
+			return rules.Page{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, r)
+	}
+
+	tq := fmt.Sprintf(`SELECT COUNT(*) FROM rules %s;`, query)
+	total, err := postgres.Total(ctx, repo.db, tq, pm)
+	if err != nil {
+		return rules.Page{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return rules.Page{
+		Total:  total,
+		Offset: pm.Offset,
+		Limit:  pm.Limit,
+		Rules:  items,
+	}, nil
+}
+
+func (repo *repository) returning(ctx context.Context, q string, dbr dbRule, wrap error) (rules.Rule, error) {
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbr)
+	if err != nil {
+		return rules.Rule{}, repo.eh.HandleError(wrap, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return rules.Rule{}, repo.eh.HandleError(wrap, err)
+		}
+		return rules.Rule{}, repoerr.ErrNotFound
+	}
+	dbr = dbRule{}
+	if err := rows.StructScan(&dbr); err != nil {
+		return rules.Rule{}, repo.eh.HandleError(wrap, err)
+	}
+
+	return toRule(dbr)
+}
+
+func pageQuery(pm rules.PageMeta) string {
+	query := []string{"domain_id = :domain_id"}
+	if pm.InputChannel != "" {
+		query = append(query, "input_channel = :input_channel")
+	}
+	if pm.Name != "" {
+		query = append(query, "name ILIKE '%' || :name || '%'")
+	}
+	if pm.Status != rules.AllStatus {
+		query = append(query, "status = :status")
+	}
+
+	return fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+}
+
+type dbRule struct {
+	ID           string       `db:"id"`
+	Name         string       `db:"name"`
+	DomainID     string       `db:"domain_id"`
+	InputChannel string       `db:"input_channel"`
+	InputTopic   string       `db:"input_topic"`
+	ContentType  string       `db:"content_type"`
+	Conditions   []byte       `db:"conditions"`
+	Actions      []byte       `db:"actions"`
+	Status       rules.Status `db:"status"`
+	CreatedAt    time.Time    `db:"created_at"`
+	CreatedBy    string       `db:"created_by"`
+	UpdatedAt    sql.NullTime `db:"updated_at"`
+	UpdatedBy    string       `db:"updated_by"`
+}
+
+func toDBRule(r rules.Rule) (dbRule, error) {
+	conditions := []byte("[]")
+	if len(r.Conditions) > 0 {
+		b, err := json.Marshal(r.Conditions)
+		if err != nil {
+			return dbRule{}, err
+		}
+		conditions = b
+	}
+	actions, err := json.Marshal(r.Actions)
+	if err != nil {
+		return dbRule{}, err
+	}
+	var updatedAt sql.NullTime
+	if !r.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: r.UpdatedAt, Valid: true}
+	}
+
+	return dbRule{
+		ID:           r.ID,
+		Name:         r.Name,
+		DomainID:     r.DomainID,
+		InputChannel: r.InputChannel,
+		InputTopic:   r.InputTopic,
+		ContentType:  r.ContentType,
+		Conditions:   conditions,
+		Actions:      actions,
+		Status:       r.Status,
+		CreatedAt:    r.CreatedAt,
+		CreatedBy:    r.CreatedBy,
+		UpdatedAt:    updatedAt,
+		UpdatedBy:    r.UpdatedBy,
+	}, nil
+}
+
+func toRule(dbr dbRule) (rules.Rule, error) {
+	var conditions []rules.Condition
+	if len(dbr.Conditions) > 0 {
+		if err := json.Unmarshal(dbr.Conditions, &conditions); err != nil {
+			return rules.Rule{}, err
+		}
+	}
+	var actions []rules.Action
+	if len(dbr.Actions) > 0 {
+		if err := json.Unmarshal(dbr.Actions, &actions); err != nil {
+			return rules.Rule{}, err
+		}
+	}
+	var updatedAt time.Time
+	if dbr.UpdatedAt.Valid {
+		updatedAt = dbr.UpdatedAt.Time.UTC()
+	}
+
+	return rules.Rule{
+		ID:           dbr.ID,
+		Name:         dbr.Name,
+		DomainID:     dbr.DomainID,
+		InputChannel: dbr.InputChannel,
+		InputTopic:   dbr.InputTopic,
+		ContentType:  dbr.ContentType,
+		Conditions:   conditions,
+		Actions:      actions,
+		Status:       dbr.Status,
+		CreatedAt:    dbr.CreatedAt.UTC(),
+		CreatedBy:    dbr.CreatedBy,
+		UpdatedAt:    updatedAt,
+		UpdatedBy:    dbr.UpdatedBy,
+	}, nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/rules"
+	"github.com/absmach/supermq/rules/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	domainID  = testsutil.GenerateUUID(&testing.T{})
+	channelID = testsutil.GenerateUUID(&testing.T{})
+)
+
+func newRule(t *testing.T, name string, createdAt time.Time) rules.Rule {
+	return rules.Rule{
+		ID:           testsutil.GenerateUUID(t),
+		Name:         name,
+		DomainID:     domainID,
+		InputChannel: channelID,
+		InputTopic:   "temperature.*",
+		ContentType:  senml.JSON,
+		Conditions: []rules.Condition{
+			{Field: "temperature", Comparator: rules.GreaterThanComparator, Value: float64(30)},
+		},
+		Actions: []rules.Action{
+			{Type: rules.PublishAction, Channel: testsutil.GenerateUUID(t), Subtopic: "alarms"},
+		},
+		Status:    rules.EnabledStatus,
+		CreatedAt: createdAt,
+		CreatedBy: testsutil.GenerateUUID(t),
+	}
+}
+
+func TestAddRule(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		require.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	rule := newRule(t, "rule", time.Now().UTC().Truncate(time.Microsecond))
+
+	cases := []struct {
+		desc string
+		rule rules.Rule
+		err  error
+	}{
+		{
+			desc: "add new rule",
+			rule: rule,
+			err:  nil,
+		},
+		{
+			desc: "add rule with duplicate ID",
+			rule: rule,
+			err:  repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r, err := repo.AddRule(context.Background(), tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.rule, r)
+			}
+		})
+	}
+}
+
+func TestViewRule(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		require.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	rule, err := repo.AddRule(context.Background(), newRule(t, "rule", time.Now().UTC().Truncate(time.Microsecond)))
+	require.Nil(t, err, fmt.Sprintf("add rule unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		rule     rules.Rule
+		err      error
+	}{
+		{
+			desc:     "view existing rule",
+			domainID: domainID,
+			id:       rule.ID,
+			rule:     rule,
+			err:      nil,
+		},
+		{
+			desc:     "view rule from another domain",
+			domainID: testsutil.GenerateUUID(t),
+			id:       rule.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "view non-existing rule",
+			domainID: domainID,
+			id:       testsutil.GenerateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r, err := repo.ViewRule(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.rule, r)
+		})
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		require.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	rule, err := repo.AddRule(context.Background(), newRule(t, "rule", time.Now().UTC().Truncate(time.Microsecond)))
+	require.Nil(t, err, fmt.Sprintf("add rule unexpected error: %s", err))
+
+	updated := rule
+	updated.Name = "updated"
+	updated.InputTopic = ""
+	updated.Actions = []rules.Action{{Type: rules.DropAction}}
+	updated.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	updated.UpdatedBy = testsutil.GenerateUUID(t)
+
+	disabled := rule
+	disabled.Status = rules.DisabledStatus
+	disabled.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	disabled.UpdatedBy = testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc   string
+		rule   rules.Rule
+		update func(context.Context, rules.Rule) (rules.Rule, error)
+		err    error
+	}{
+		{
+			desc:   "update rule",
+			rule:   updated,
+			update: repo.UpdateRule,
+			err:    nil,
+		},
+		{
+			desc:   "update non-existing rule",
+			rule:   rules.Rule{ID: testsutil.GenerateUUID(t), DomainID: domainID},
+			update: repo.UpdateRule,
+			err:    repoerr.ErrNotFound,
+		},
+		{
+			desc:   "update rule status",
+			rule:   disabled,
+			update: repo.UpdateRuleStatus,
+			err:    nil,
+		},
+		{
+			desc:   "update non-existing rule status",
+			rule:   rules.Rule{ID: testsutil.GenerateUUID(t), DomainID: domainID},
+			update: repo.UpdateRuleStatus,
+			err:    repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r, err := tc.update(context.Background(), tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.rule.Name, r.Name)
+				assert.Equal(t, tc.rule.Actions, r.Actions)
+				assert.Equal(t, tc.rule.Status, r.Status)
+				assert.Equal(t, tc.rule.UpdatedAt, r.UpdatedAt)
+				assert.Equal(t, tc.rule.UpdatedBy, r.UpdatedBy)
+			}
+		})
+	}
+}
+
+func TestRemoveRule(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		require.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	rule, err := repo.AddRule(context.Background(), newRule(t, "rule", time.Now().UTC().Truncate(time.Microsecond)))
+	require.Nil(t, err, fmt.Sprintf("add rule unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		err      error
+	}{
+		{
+			desc:     "remove rule from another domain",
+			domainID: testsutil.GenerateUUID(t),
+			id:       rule.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "remove existing rule",
+			domainID: domainID,
+			id:       rule.ID,
+			err:      nil,
+		},
+		{
+			desc:     "remove removed rule",
+			domainID: domainID,
+			id:       rule.ID,
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.RemoveRule(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestListRules(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		require.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+	repo := postgres.NewRepository(database)
+
+	num := 10
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	var items []rules.Rule
+	for i := 0; i < num; i++ {
+		r := newRule(t, fmt.Sprintf("rule-%d", i), now.Add(time.Duration(i)*time.Second))
+		if i%2 == 1 {
+			r.Status = rules.DisabledStatus
+		}
+		r, err := repo.AddRule(context.Background(), r)
+		require.Nil(t, err, fmt.Sprintf("add rule unexpected error: %s", err))
+		items = append(items, r)
+	}
+	var enabled []rules.Rule
+	for i := 0; i < num; i += 2 {
+		enabled = append(enabled, items[i])
+	}
+
+	cases := []struct {
+		desc string
+		pm   rules.PageMeta
+		page rules.Page
+	}{
+		{
+			desc: "list all rules",
+			pm:   rules.PageMeta{DomainID: domainID, Status: rules.AllStatus},
+			page: rules.Page{Total: uint64(num), Rules: items},
+		},
+		{
+			desc: "list rules with offset and limit",
+			pm:   rules.PageMeta{DomainID: domainID, Status: rules.AllStatus, Offset: 2, Limit: 3},
+			page: rules.Page{Total: uint64(num), Offset: 2, Limit: 3, Rules: items[2:5]},
+		},
+		{
+			desc: "list enabled rules of the input channel",
+			pm:   rules.PageMeta{DomainID: domainID, InputChannel: channelID, Status: rules.EnabledStatus},
+			page: rules.Page{Total: uint64(len(enabled)), Rules: enabled},
+		},
+		{
+			desc: "list rules by name",
+			pm:   rules.PageMeta{DomainID: domainID, Status: rules.AllStatus, Name: "rule-3"},
+			page: rules.Page{Total: 1, Rules: items[3:4]},
+		},
+		{
+			desc: "list rules of another input channel",
+			pm:   rules.PageMeta{DomainID: domainID, InputChannel: testsutil.GenerateUUID(t), Status: rules.AllStatus},
+			page: rules.Page{Total: 0},
+		},
+		{
+			desc: "list rules of another domain",
+			pm:   rules.PageMeta{DomainID: testsutil.GenerateUUID(t), Status: rules.AllStatus},
+			page: rules.Page{Total: 0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.ListRules(context.Background(), tc.pm)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.page, page)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+)
+
+// Status represents Rule status.
+type Status uint8
+
+// Possible Rule status values.
+const (
+	// EnabledStatus represents enabled Rule.
+	EnabledStatus Status = iota
+	// DisabledStatus represents disabled Rule.
+	DisabledStatus
+
+	// AllStatus is used for querying purposes to list rules irrespective
+	// of their status. It is never stored in the database as the actual
+	// Rule status and should always be the largest value in this enumeration.
+	AllStatus
+)
+
+// String representation of the possible status values.
+const (
+	Disabled = "disabled"
+	Enabled  = "enabled"
+	All      = "all"
+	Unknown  = "unknown"
+)
+
+// String converts Rule status to string literal.
+func (s Status) String() string {
+	switch s {
+	case DisabledStatus:
+		return Disabled
+	case EnabledStatus:
+		return Enabled
+	case AllStatus:
+		return All
+	default:
+		return Unknown
+	}
+}
+
+// ToStatus converts string value to a valid Rule status.
+func ToStatus(status string) (Status, error) {
+	switch status {
+	case Disabled:
+		return DisabledStatus, nil
+	case Enabled:
+		return EnabledStatus, nil
+	case All:
+		return AllStatus, nil
+	}
+	return Status(0), svcerr.ErrInvalidStatus
+}
+
+// MarshalJSON marshals Status as a string literal.
+func (s Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshals Status from a string literal.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	val, err := ToStatus(str)
+	if err != nil {
+		return err
+	}
+	*s = val
+	return nil
+}
+
+// Comparator is the operator used to compare a message field with the
+// condition value.
+type Comparator string
+
+// Supported comparators.
+const (
+	EqualComparator          Comparator = "eq"
+	NotEqualComparator       Comparator = "ne"
+	LowerThanComparator      Comparator = "lt"
+	LowerThanEqualComparator Comparator = "le"
+	GreaterThanComparator    Comparator = "gt"
+	GreaterThanEqComparator  Comparator = "ge"
+)
+
+// ActionType is the type of the action executed when the rule matches.
+type ActionType string
+
+// Supported action types.
+const (
+	// PublishAction republishes the message to the action channel and subtopic.
+	PublishAction ActionType = "publish"
+	// EnrichAction merges the action fields into the message payload.
+	EnrichAction ActionType = "enrich"
+	// DropAction stops processing the message with this and all subsequent rules.
+	DropAction ActionType = "drop"
+)
+
+// Condition compares a single message field with the value.
+// For SenML messages, the field is the record name and the condition is
+// satisfied if any record with that name satisfies it. For JSON messages,
+// the field is a payload key where nested keys are joined with "/".
+type Condition struct {
+	Field      string     `json:"field"`
+	Comparator Comparator `json:"comparator"`
+	Value      any        `json:"value"`
+}
+
+// Action is executed on the message when all the rule conditions are met.
+type Action struct {
+	Type     ActionType     `json:"type"`
+	Channel  string         `json:"channel,omitempty"`
+	Subtopic string         `json:"subtopic,omitempty"`
+	Fields   map[string]any `json:"fields,omitempty"`
+}
+
+// Rule represents a server-side rule evaluated against every message
+// published to the input channel and topic.
+type Rule struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name,omitempty"`
+	DomainID     string      `json:"domain_id"`
+	InputChannel string      `json:"input_channel"`
+	InputTopic   string      `json:"input_topic,omitempty"`
+	ContentType  string      `json:"content_type,omitempty"`
+	Conditions   []Condition `json:"conditions,omitempty"`
+	Actions      []Action    `json:"actions"`
+	Status       Status      `json:"status"`
+	CreatedAt    time.Time   `json:"created_at"`
+	CreatedBy    string      `json:"created_by,omitempty"`
+	UpdatedAt    time.Time   `json:"updated_at,omitempty"`
+	UpdatedBy    string      `json:"updated_by,omitempty"`
+}
+
+// PageMeta contains page metadata that helps navigation.
+type PageMeta struct {
+	Offset       uint64 `json:"offset" db:"offset"`
+	Limit        uint64 `json:"limit" db:"limit"`
+	Name         string `json:"name,omitempty" db:"name"`
+	DomainID     string `json:"domain_id,omitempty" db:"domain_id"`
+	InputChannel string `json:"input_channel,omitempty" db:"input_channel"`
+	Status       Status `json:"status,omitempty" db:"status"`
+}
+
+// Page represents a page of rules.
+type Page struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Rules  []Rule `json:"rules"`
+}
+
+func (page Page) MarshalJSON() ([]byte, error) {
+	type Alias Page
+	a := struct {
+		Alias
+	}{
+		Alias: Alias(page),
+	}
+
+	if a.Rules == nil {
+		a.Rules = make([]Rule, 0)
+	}
+
+	return json.Marshal(a)
+}
+
+// Service specifies an API that must be fulfilled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// AddRule creates a new rule in the session domain.
+	AddRule(ctx context.Context, session smqauthn.Session, r Rule) (Rule, error)
+
+	// ViewRule retrieves the rule with the given ID.
+	ViewRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error)
+
+	// UpdateRule updates the rule name, input, conditions and actions.
+	UpdateRule(ctx context.Context, session smqauthn.Session, r Rule) (Rule, error)
+
+	// ListRules retrieves the rules of the session domain.
+	ListRules(ctx context.Context, session smqauthn.Session, pm PageMeta) (Page, error)
+
+	// RemoveRule removes the rule with the given ID.
+	RemoveRule(ctx context.Context, session smqauthn.Session, id string) error
+
+	// EnableRule enables the rule with the given ID.
+	EnableRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error)
+
+	// DisableRule disables the rule with the given ID.
+	DisableRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error)
+
+	// HandleMessage evaluates the enabled rules of the message channel
+	// and executes the actions of the matching ones.
+	HandleMessage(ctx context.Context, msg *messaging.Message) error
+}
+
+// Repository specifies rules persistence API.
+type Repository interface {
+	// AddRule persists the rule.
+	AddRule(ctx context.Context, r Rule) (Rule, error)
+
+	// ViewRule retrieves the rule with the given ID from the given domain.
+	ViewRule(ctx context.Context, domainID, id string) (Rule, error)
+
+	// UpdateRule updates the rule name, input, conditions and actions.
+	UpdateRule(ctx context.Context, r Rule) (Rule, error)
+
+	// UpdateRuleStatus updates the rule status.
+	UpdateRuleStatus(ctx context.Context, r Rule) (Rule, error)
+
+	// RemoveRule removes the rule with the given ID from the given domain.
+	RemoveRule(ctx context.Context, domainID, id string) error
+
+	// ListRules retrieves the rules matching the page metadata, ordered
+	// by their creation time.
+	ListRules(ctx context.Context, pm PageMeta) (Page, error)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/absmach/supermq"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+)
+
+// Protocol is the protocol of the messages published by the rules engine.
+// Messages with this protocol are not evaluated to prevent publishing loops.
+const Protocol = "rules"
+
+var (
+	// ErrUnsupportedContentType indicates that the rule content type is not supported.
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+
+	// ErrEnrichPayload indicates that the message payload is not a JSON object
+	// or an array of JSON objects and can not be enriched.
+	ErrEnrichPayload = errors.New("failed to enrich message payload")
+
+	errPublish = errors.New("failed to publish message")
+)
+
+type service struct {
+	idProvider supermq.IDProvider
+	repo       Repository
+	publisher  messaging.Publisher
+}
+
+// NewService returns a new rules service.
+func NewService(idp supermq.IDProvider, repo Repository, publisher messaging.Publisher) Service {
+	return &service{
+		idProvider: idp,
+		repo:       repo,
+		publisher:  publisher,
+	}
+}
+
+func (svc *service) AddRule(ctx context.Context, session smqauthn.Session, r Rule) (Rule, error) {
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrIssueProviderID, err)
+	}
+	if err := normalize(&r); err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	r.ID = id
+	r.DomainID = session.DomainID
+	r.Status = EnabledStatus
+	r.CreatedAt = time.Now().UTC()
+	r.CreatedBy = session.UserID
+
+	rule, err := svc.repo.AddRule(ctx, r)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return rule, nil
+}
+
+func (svc *service) ViewRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error) {
+	rule, err := svc.repo.ViewRule(ctx, session.DomainID, id)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return rule, nil
+}
+
+func (svc *service) UpdateRule(ctx context.Context, session smqauthn.Session, r Rule) (Rule, error) {
+	if err := normalize(&r); err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	r.DomainID = session.DomainID
+	r.UpdatedAt = time.Now().UTC()
+	r.UpdatedBy = session.UserID
+
+	rule, err := svc.repo.UpdateRule(ctx, r)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return rule, nil
+}
+
+func (svc *service) ListRules(ctx context.Context, session smqauthn.Session, pm PageMeta) (Page, error) {
+	pm.DomainID = session.DomainID
+	page, err := svc.repo.ListRules(ctx, pm)
+	if err != nil {
+		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (svc *service) RemoveRule(ctx context.Context, session smqauthn.Session, id string) error {
+	if err := svc.repo.RemoveRule(ctx, session.DomainID, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (svc *service) EnableRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error) {
+	return svc.changeRuleStatus(ctx, session, id, EnabledStatus)
+}
+
+func (svc *service) DisableRule(ctx context.Context, session smqauthn.Session, id string) (Rule, error) {
+	return svc.changeRuleStatus(ctx, session, id, DisabledStatus)
+}
+
+func (svc *service) changeRuleStatus(ctx context.Context, session smqauthn.Session, id string, status Status) (Rule, error) {
+	r := Rule{
+		ID:        id,
+		DomainID:  session.DomainID,
+		Status:    status,
+		UpdatedAt: time.Now().UTC(),
+		UpdatedBy: session.UserID,
+	}
+	rule, err := svc.repo.UpdateRuleStatus(ctx, r)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return rule, nil
+}
+
+// normalize sets the default content type and converts the rule topics
+// to the message broker subtopic format.
+func normalize(r *Rule) error {
+	if r.ContentType == "" {
+		r.ContentType = senml.JSON
+	}
+	if !SupportedContentType(r.ContentType) {
+		return ErrUnsupportedContentType
+	}
+	topic, err := messaging.ParseSubscribeSubtopic(r.InputTopic)
+	if err != nil {
+		return err
+	}
+	r.InputTopic = topic
+	r.Actions = slices.Clone(r.Actions)
+	for i, a := range r.Actions {
+		if a.Type != PublishAction {
+			continue
+		}
+		subtopic, err := messaging.ParsePublishSubtopic(a.Subtopic)
+		if err != nil {
+			return err
+		}
+		r.Actions[i].Subtopic = subtopic
+	}
+
+	return nil
+}
+
+func (svc *service) HandleMessage(ctx context.Context, msg *messaging.Message) error {
+	if msg.GetProtocol() == Protocol {
+		return nil
+	}
+
+	pm := PageMeta{
+		DomainID:     msg.GetDomain(),
+		InputChannel: msg.GetChannel(),
+		Status:       EnabledStatus,
+	}
+	page, err := svc.repo.ListRules(ctx, pm)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range page.Rules {
+		if !MatchTopic(r.InputTopic, msg.GetSubtopic()) {
+			continue
+		}
+		drop, err := svc.apply(ctx, r, msg)
+		if err != nil {
+			return err
+		}
+		if drop {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// apply executes the rule actions on the copy of the message if the message
+// satisfies the rule conditions. It returns true if the message is dropped.
+func (svc *service) apply(ctx context.Context, r Rule, msg *messaging.Message) (bool, error) {
+	ok, err := evaluate(r, msg)
+	if err != nil || !ok {
+		// Messages that can not be decoded are not matched by the rule.
+		return false, nil
+	}
+
+	m := &messaging.Message{
+		Domain:      msg.GetDomain(),
+		Channel:     msg.GetChannel(),
+		Subtopic:    msg.GetSubtopic(),
+		Publisher:   msg.GetPublisher(),
+		Protocol:    Protocol,
+		Payload:     msg.GetPayload(),
+		Created:     msg.GetCreated(),
+		ContentType: msg.GetContentType(),
+		Headers:     msg.GetHeaders(),
+	}
+	for _, a := range r.Actions {
+		switch a.Type {
+		case DropAction:
+			return true, nil
+		case EnrichAction:
+			payload, err := enrich(m.GetPayload(), a.Fields)
+			if err != nil {
+				return false, err
+			}
+			m.Payload = payload
+		case PublishAction:
+			m.Channel = a.Channel
+			m.Subtopic = a.Subtopic
+			if err := svc.publisher.Publish(ctx, messaging.EncodeMessageTopic(m), m); err != nil {
+				return false, errors.Wrap(errPublish, err)
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,466 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package rules_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/supermq/internal/testsutil"
+	smqauthn "github.com/absmach/supermq/pkg/authn"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/messaging"
+	msgmocks "github.com/absmach/supermq/pkg/messaging/mocks"
+	"github.com/absmach/supermq/pkg/transformers/senml"
+	"github.com/absmach/supermq/pkg/uuid"
+	"github.com/absmach/supermq/rules"
+	"github.com/absmach/supermq/rules/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	domainID  = testsutil.GenerateUUID(&testing.T{})
+	channelID = testsutil.GenerateUUID(&testing.T{})
+	outputID  = testsutil.GenerateUUID(&testing.T{})
+	session   = smqauthn.Session{
+		UserID:   testsutil.GenerateUUID(&testing.T{}),
+		DomainID: domainID,
+	}
+	validRule = rules.Rule{
+		Name:         "alarm",
+		InputChannel: channelID,
+		InputTopic:   "sensors/+",
+		Conditions: []rules.Condition{
+			{Field: "temperature", Comparator: rules.GreaterThanComparator, Value: float64(30)},
+		},
+		Actions: []rules.Action{
+			{Type: rules.PublishAction, Channel: outputID, Subtopic: "alarms"},
+		},
+	}
+)
+
+func newService() (rules.Service, *mocks.Repository, *msgmocks.PubSub) {
+	repo := new(mocks.Repository)
+	pub := new(msgmocks.PubSub)
+
+	return rules.NewService(uuid.NewMock(), repo, pub), repo, pub
+}
+
+func TestAddRule(t *testing.T) {
+	svc, repo, _ := newService()
+
+	cases := []struct {
+		desc    string
+		rule    rules.Rule
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "add rule successfully",
+			rule: validRule,
+		},
+		{
+			desc: "add rule with unsupported content type",
+			rule: rules.Rule{
+				InputChannel: channelID,
+				ContentType:  "text/plain",
+				Actions:      validRule.Actions,
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "add rule with malformed input topic",
+			rule: rules.Rule{
+				InputChannel: channelID,
+				InputTopic:   "sensors/temp+",
+				Actions:      validRule.Actions,
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "add rule with repo error",
+			rule:    validRule,
+			repoErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("AddRule", context.Background(), mock.Anything).Return(func(_ context.Context, r rules.Rule) (rules.Rule, error) {
+				return r, tc.repoErr
+			})
+			r, err := svc.AddRule(context.Background(), session, tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.NotEmpty(t, r.ID)
+				assert.Equal(t, domainID, r.DomainID)
+				assert.Equal(t, session.UserID, r.CreatedBy)
+				assert.Equal(t, rules.EnabledStatus, r.Status)
+				assert.Equal(t, senml.JSON, r.ContentType)
+				assert.Equal(t, "sensors.*", r.InputTopic)
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestViewRule(t *testing.T) {
+	svc, repo, _ := newService()
+
+	id := testsutil.GenerateUUID(t)
+	cases := []struct {
+		desc    string
+		id      string
+		rule    rules.Rule
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "view rule successfully",
+			id:   id,
+			rule: rules.Rule{ID: id, DomainID: domainID},
+		},
+		{
+			desc:    "view non-existing rule",
+			id:      testsutil.GenerateUUID(t),
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("ViewRule", context.Background(), domainID, tc.id).Return(tc.rule, tc.repoErr)
+			r, err := svc.ViewRule(context.Background(), session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.rule, r)
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	svc, repo, _ := newService()
+
+	rule := validRule
+	rule.ID = testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc    string
+		rule    rules.Rule
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "update rule successfully",
+			rule: rule,
+		},
+		{
+			desc: "update rule with malformed action subtopic",
+			rule: rules.Rule{
+				ID:           rule.ID,
+				InputChannel: channelID,
+				Actions:      []rules.Action{{Type: rules.PublishAction, Channel: outputID, Subtopic: "alarms/#"}},
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "update non-existing rule",
+			rule:    rule,
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("UpdateRule", context.Background(), mock.Anything).Return(func(_ context.Context, r rules.Rule) (rules.Rule, error) {
+				return r, tc.repoErr
+			})
+			r, err := svc.UpdateRule(context.Background(), session, tc.rule)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, domainID, r.DomainID)
+				assert.Equal(t, session.UserID, r.UpdatedBy)
+				assert.False(t, r.UpdatedAt.IsZero())
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestListRules(t *testing.T) {
+	svc, repo, _ := newService()
+
+	cases := []struct {
+		desc    string
+		pm      rules.PageMeta
+		page    rules.Page
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "list rules successfully",
+			pm:   rules.PageMeta{Limit: 10, Status: rules.AllStatus},
+			page: rules.Page{Total: 1, Limit: 10, Rules: []rules.Rule{validRule}},
+		},
+		{
+			desc:    "list rules with repo error",
+			pm:      rules.PageMeta{Limit: 10, Status: rules.AllStatus},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			pm := tc.pm
+			pm.DomainID = domainID
+			repoCall := repo.On("ListRules", context.Background(), pm).Return(tc.page, tc.repoErr)
+			page, err := svc.ListRules(context.Background(), session, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, page)
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRemoveRule(t *testing.T) {
+	svc, repo, _ := newService()
+
+	cases := []struct {
+		desc    string
+		id      string
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "remove rule successfully",
+			id:   testsutil.GenerateUUID(t),
+		},
+		{
+			desc:    "remove non-existing rule",
+			id:      testsutil.GenerateUUID(t),
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RemoveRule", context.Background(), domainID, tc.id).Return(tc.repoErr)
+			err := svc.RemoveRule(context.Background(), session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestChangeRuleStatus(t *testing.T) {
+	cases := []struct {
+		desc    string
+		status  rules.Status
+		repoErr error
+		err     error
+	}{
+		{
+			desc:   "enable rule successfully",
+			status: rules.EnabledStatus,
+		},
+		{
+			desc:   "disable rule successfully",
+			status: rules.DisabledStatus,
+		},
+		{
+			desc:    "disable non-existing rule",
+			status:  rules.DisabledStatus,
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc, repo, _ := newService()
+			id := testsutil.GenerateUUID(t)
+			repo.On("UpdateRuleStatus", context.Background(), mock.MatchedBy(func(r rules.Rule) bool {
+				return r.ID == id && r.DomainID == domainID && r.Status == tc.status
+			})).Return(rules.Rule{ID: id, Status: tc.status}, tc.repoErr)
+			change := svc.EnableRule
+			if tc.status == rules.DisabledStatus {
+				change = svc.DisableRule
+			}
+			r, err := change(context.Background(), session, id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.status, r.Status)
+			}
+		})
+	}
+}
+
+func TestHandleMessage(t *testing.T) {
+	senmlPayload := []byte(`[{"bn":"dev1:","n":"temperature","v":35},{"n":"humidity","v":40}]`)
+	coldPayload := []byte(`[{"n":"temperature","v":20}]`)
+	jsonPayload := []byte(`{"temperature":35,"location":{"room":"lab"}}`)
+
+	publish := rules.Action{Type: rules.PublishAction, Channel: outputID, Subtopic: "alarms"}
+	hot := rules.Condition{Field: "dev1:temperature", Comparator: rules.GreaterThanComparator, Value: float64(30)}
+
+	cases := []struct {
+		desc      string
+		msg       *messaging.Message
+		rules     []rules.Rule
+		listErr   error
+		published []string
+		payload   string
+		pubErr    error
+		err       error
+	}{
+		{
+			desc:      "publish message matching SenML condition",
+			msg:       &messaging.Message{Domain: domainID, Channel: channelID, Subtopic: "sensors.lab", Payload: senmlPayload},
+			rules:     []rules.Rule{{InputTopic: "sensors.*", ContentType: senml.JSON, Conditions: []rules.Condition{hot}, Actions: []rules.Action{publish}}},
+			published: []string{fmt.Sprintf("%s.c.%s.alarms", domainID, outputID)},
+		},
+		{
+			desc:  "skip message not matching SenML condition",
+			msg:   &messaging.Message{Domain: domainID, Channel: channelID, Payload: coldPayload},
+			rules: []rules.Rule{{ContentType: senml.JSON, Conditions: []rules.Condition{{Field: "temperature", Comparator: rules.GreaterThanComparator, Value: float64(30)}}, Actions: []rules.Action{publish}}},
+		},
+		{
+			desc:  "skip message not matching input topic",
+			msg:   &messaging.Message{Domain: domainID, Channel: channelID, Subtopic: "actuators.lab", Payload: senmlPayload},
+			rules: []rules.Rule{{InputTopic: "sensors.>", ContentType: senml.JSON, Actions: []rules.Action{publish}}},
+		},
+		{
+			desc:  "skip message which can not be decoded",
+			msg:   &messaging.Message{Domain: domainID, Channel: channelID, Payload: []byte("invalid")},
+			rules: []rules.Rule{{ContentType: senml.JSON, Conditions: []rules.Condition{hot}, Actions: []rules.Action{publish}}},
+		},
+		{
+			desc: "skip message published by rules engine",
+			msg:  &messaging.Message{Domain: domainID, Channel: channelID, Protocol: rules.Protocol, Payload: senmlPayload},
+		},
+		{
+			desc: "enrich and publish message matching JSON condition",
+			msg:  &messaging.Message{Domain: domainID, Channel: channelID, Payload: jsonPayload},
+			rules: []rules.Rule{{
+				ContentType: rules.JSONContentType,
+				Conditions:  []rules.Condition{{Field: "location/room", Comparator: rules.EqualComparator, Value: "lab"}},
+				Actions: []rules.Action{
+					{Type: rules.EnrichAction, Fields: map[string]any{"alarm": true}},
+					publish,
+				},
+			}},
+			published: []string{fmt.Sprintf("%s.c.%s.alarms", domainID, outputID)},
+			payload:   `{"alarm":true,"location":{"room":"lab"},"temperature":35}`,
+		},
+		{
+			desc: "drop message and skip subsequent rules",
+			msg:  &messaging.Message{Domain: domainID, Channel: channelID, Payload: senmlPayload},
+			rules: []rules.Rule{
+				{ContentType: senml.JSON, Actions: []rules.Action{{Type: rules.DropAction}, publish}},
+				{ContentType: senml.JSON, Actions: []rules.Action{publish}},
+			},
+		},
+		{
+			desc: "execute all matching rules",
+			msg:  &messaging.Message{Domain: domainID, Channel: channelID, Payload: senmlPayload},
+			rules: []rules.Rule{
+				{ContentType: senml.JSON, Actions: []rules.Action{publish}},
+				{ContentType: senml.JSON, Actions: []rules.Action{{Type: rules.PublishAction, Channel: channelID, Subtopic: "copy"}}},
+			},
+			published: []string{
+				fmt.Sprintf("%s.c.%s.alarms", domainID, outputID),
+				fmt.Sprintf("%s.c.%s.copy", domainID, channelID),
+			},
+		},
+		{
+			desc:  "enrich message with non JSON payload",
+			msg:   &messaging.Message{Domain: domainID, Channel: channelID, Payload: []byte("invalid")},
+			rules: []rules.Rule{{ContentType: senml.JSON, Actions: []rules.Action{{Type: rules.EnrichAction, Fields: map[string]any{"alarm": true}}}}},
+			err:   rules.ErrEnrichPayload,
+		},
+		{
+			desc:      "publish message with publisher error",
+			msg:       &messaging.Message{Domain: domainID, Channel: channelID, Payload: senmlPayload},
+			rules:     []rules.Rule{{ContentType: senml.JSON, Actions: []rules.Action{publish}}},
+			published: []string{fmt.Sprintf("%s.c.%s.alarms", domainID, outputID)},
+			pubErr:    errors.New("publisher error"),
+			err:       errors.New("publisher error"),
+		},
+		{
+			desc:    "list rules with repo error",
+			msg:     &messaging.Message{Domain: domainID, Channel: channelID, Payload: senmlPayload},
+			listErr: repoerr.ErrViewEntity,
+			err:     repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc, repo, pub := newService()
+			pm := rules.PageMeta{DomainID: domainID, InputChannel: channelID, Status: rules.EnabledStatus}
+			repo.On("ListRules", context.Background(), pm).Return(rules.Page{Rules: tc.rules}, tc.listErr)
+			var published []string
+			pub.On("Publish", context.Background(), mock.Anything, mock.Anything).Return(func(_ context.Context, topic string, msg *messaging.Message) error {
+				published = append(published, topic)
+				assert.Equal(t, rules.Protocol, msg.GetProtocol())
+				if tc.payload != "" {
+					assert.JSONEq(t, tc.payload, string(msg.GetPayload()))
+				}
+				return tc.pubErr
+			})
+			err := svc.HandleMessage(context.Background(), tc.msg)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.published, published)
+		})
+	}
+}
+
+func TestMatchTopic(t *testing.T) {
+	cases := []struct {
+		topic    string
+		subtopic string
+		match    bool
+	}{
+		{topic: "", subtopic: "", match: true},
+		{topic: "", subtopic: "a.b", match: true},
+		{topic: "a.b", subtopic: "a.b", match: true},
+		{topic: "a.b", subtopic: "a.c", match: false},
+		{topic: "a.*", subtopic: "a.b", match: true},
+		{topic: "a.*", subtopic: "a.b.c", match: false},
+		{topic: "a.>", subtopic: "a.b.c", match: true},
+		{topic: "a.>", subtopic: "a", match: false},
+		{topic: "a", subtopic: "", match: false},
+		{topic: ">", subtopic: "", match: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s matches %s", tc.topic, tc.subtopic), func(t *testing.T) {
+			assert.Equal(t, tc.match, rules.MatchTopic(tc.topic, tc.subtopic))
+		})
+	}
+}
+
+func TestStatusJSON(t *testing.T) {
+	data, err := json.Marshal(rules.DisabledStatus)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, `"disabled"`, string(data))
+
+	var s rules.Status
+	err = json.Unmarshal([]byte(`"enabled"`), &s)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, rules.EnabledStatus, s)
+
+	err = json.Unmarshal([]byte(`"invalid"`), &s)
+	assert.True(t, errors.Contains(err, svcerr.ErrInvalidStatus), fmt.Sprintf("expected %s got %s", svcerr.ErrInvalidStatus, err))
+}
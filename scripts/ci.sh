@@ -73,6 +73,8 @@ setup_mg() {
     SMQ_MESSAGE_BROKER_TYPE=msg_rabbitmq make http
     echo "Compile check for redis..."
     SMQ_ES_TYPE=es_redis make http
+    echo "Compile check for kafka..."
+    SMQ_MESSAGE_BROKER_TYPE=msg_kafka SMQ_ES_TYPE=es_kafka make http
     make -j$NPROC
 }
 
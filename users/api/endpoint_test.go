@@ -4,6 +4,8 @@
 package api_test
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +15,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	api "github.com/absmach/supermq/api/http"
@@ -24,6 +27,7 @@ import (
 	authnmocks "github.com/absmach/supermq/pkg/authn/mocks"
 	"github.com/absmach/supermq/pkg/errors"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/oauth2"
 	oauth2mocks "github.com/absmach/supermq/pkg/oauth2/mocks"
 	"github.com/absmach/supermq/pkg/uuid"
 	"github.com/absmach/supermq/users"
@@ -32,6 +36,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	xoauth2 "golang.org/x/oauth2"
 )
 
 var (
@@ -132,6 +137,20 @@ func TestRegister(t *testing.T) {
 			status:      http.StatusCreated,
 			err:         nil,
 		},
+		{
+			desc: "register a new user with invitation token",
+			user: users.User{
+				FirstName:       user.FirstName,
+				LastName:        user.LastName,
+				Email:           user.Email,
+				Credentials:     user.Credentials,
+				InvitationToken: validToken,
+			},
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusCreated,
+			err:         nil,
+		},
 		{
 			desc:        "register an existing user",
 			user:        user,
@@ -2390,6 +2409,7 @@ func TestIssueToken(t *testing.T) {
 		desc        string
 		data        string
 		contentType string
+		challenge   users.MFAChallenge
 		status      int
 		err         error
 	}{
@@ -2400,6 +2420,14 @@ func TestIssueToken(t *testing.T) {
 			status:      http.StatusCreated,
 			err:         nil,
 		},
+		{
+			desc:        "issue token for user with MFA enabled",
+			data:        fmt.Sprintf(dataFormat, validUsername, secret),
+			contentType: contentType,
+			challenge:   users.MFAChallenge{ID: validID, ExpiresAt: time.Now().Add(users.MFAChallengeDuration)},
+			status:      http.StatusAccepted,
+			err:         nil,
+		},
 		{
 			desc:        "issue token with empty identity",
 			data:        fmt.Sprintf(dataFormat, "", secret),
@@ -2447,9 +2475,16 @@ func TestIssueToken(t *testing.T) {
 				body:        strings.NewReader(tc.data),
 			}
 
-			svcCall := svc.On("IssueToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&grpcTokenV1.Token{AccessToken: validToken}, tc.err)
+			svcCall := svc.On("IssueToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&grpcTokenV1.Token{AccessToken: validToken}, tc.challenge, tc.err)
 			res, err := req.make()
 			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			if tc.challenge.ID != "" {
+				var resBody map[string]any
+				err = json.NewDecoder(res.Body).Decode(&resBody)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.challenge.ID, resBody["id"], fmt.Sprintf("%s: expected challenge id %s got %v", tc.desc, tc.challenge.ID, resBody["id"]))
+				assert.Nil(t, resBody["access_token"], fmt.Sprintf("%s: expected no access token", tc.desc))
+			}
 			if tc.err != nil {
 				var resBody respBody
 				err = json.NewDecoder(res.Body).Decode(&resBody)
@@ -2565,6 +2600,510 @@ func TestRefreshToken(t *testing.T) {
 	}
 }
 
+func TestListSessions(t *testing.T) {
+	us, svc, authn := newUsersServer()
+	defer us.Close()
+
+	sessionsPage := users.LoginSessionsPage{
+		Total: 1,
+		Limit: 10,
+		Sessions: []users.LoginSession{
+			{
+				ID:        validID,
+				IP:        "192.168.1.10",
+				UserAgent: "supermq-cli",
+				IssuedAt:  time.Now().UTC(),
+				ExpiresAt: time.Now().UTC().Add(time.Hour),
+			},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		authnRes smqauthn.Session
+		authnErr error
+		page     users.Page
+		svcRes   users.LoginSessionsPage
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:     "list sessions with valid token",
+			token:    validToken,
+			authnRes: verifiedSession,
+			page:     users.Page{Offset: 0, Limit: 10},
+			svcRes:   sessionsPage,
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list sessions with offset and limit",
+			query:    "?offset=1&limit=5",
+			token:    validToken,
+			authnRes: verifiedSession,
+			page:     users.Page{Offset: 1, Limit: 5},
+			svcRes:   users.LoginSessionsPage{Total: 1, Offset: 1, Limit: 5},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list sessions with invalid token",
+			token:    inValidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "list sessions with limit greater than max",
+			query:    fmt.Sprintf("?limit=%d", api.MaxLimitSize+1),
+			token:    validToken,
+			authnRes: verifiedSession,
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrLimitSize,
+		},
+		{
+			desc:     "list sessions with invalid offset",
+			query:    "?offset=invalid",
+			token:    validToken,
+			authnRes: verifiedSession,
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrInvalidQueryParams,
+		},
+		{
+			desc:     "list sessions with service error",
+			token:    validToken,
+			authnRes: verifiedSession,
+			page:     users.Page{Offset: 0, Limit: 10},
+			svcErr:   svcerr.ErrViewEntity,
+			status:   http.StatusUnprocessableEntity,
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:   us.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/users/sessions%s", us.URL, tc.query),
+				token:  tc.token,
+			}
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("ListSessions", mock.Anything, tc.authnRes, tc.page).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, int(tc.svcRes.Total), resBody.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.svcRes.Total, resBody.Total))
+			}
+			svcCall.Unset()
+			authnCall.Unset()
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	us, svc, authn := newUsersServer()
+	defer us.Close()
+
+	cases := []struct {
+		desc     string
+		id       string
+		token    string
+		authnRes smqauthn.Session
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:     "revoke session with valid token",
+			id:       validID,
+			token:    validToken,
+			authnRes: verifiedSession,
+			status:   http.StatusNoContent,
+		},
+		{
+			desc:     "revoke session with invalid token",
+			id:       validID,
+			token:    inValidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:     "revoke non-existing session",
+			id:       validID,
+			token:    validToken,
+			authnRes: verifiedSession,
+			svcErr:   svcerr.ErrNotFound,
+			status:   http.StatusNotFound,
+		},
+		{
+			desc:     "revoke session with service error",
+			id:       validID,
+			token:    validToken,
+			authnRes: verifiedSession,
+			svcErr:   svcerr.ErrRemoveEntity,
+			status:   http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:   us.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/users/sessions/%s", us.URL, tc.id),
+				token:  tc.token,
+			}
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("RevokeSession", mock.Anything, tc.authnRes, tc.id).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authnCall.Unset()
+		})
+	}
+}
+
+func TestVerifyMFA(t *testing.T) {
+	us, svc, _ := newUsersServer()
+	defer us.Close()
+
+	dataFormat := `{"challenge_id": "%s", "code": "%s"}`
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		svcErr      error
+		status      int
+		err         error
+	}{
+		{
+			desc:        "verify MFA with valid code",
+			data:        fmt.Sprintf(dataFormat, validID, "123456"),
+			contentType: contentType,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "verify MFA with empty challenge id",
+			data:        fmt.Sprintf(dataFormat, "", "123456"),
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingMFAChallenge,
+		},
+		{
+			desc:        "verify MFA with empty code",
+			data:        fmt.Sprintf(dataFormat, validID, ""),
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingMFACode,
+		},
+		{
+			desc:        "verify MFA with invalid code",
+			data:        fmt.Sprintf(dataFormat, validID, "000000"),
+			contentType: contentType,
+			svcErr:      users.ErrInvalidMFACode,
+			status:      http.StatusUnauthorized,
+			err:         users.ErrInvalidMFACode,
+		},
+		{
+			desc:        "verify MFA with expired challenge",
+			data:        fmt.Sprintf(dataFormat, validID, "123456"),
+			contentType: contentType,
+			svcErr:      users.ErrMFAChallengeExpired,
+			status:      http.StatusUnauthorized,
+			err:         users.ErrMFAChallengeExpired,
+		},
+		{
+			desc:        "verify MFA with malformed data",
+			data:        fmt.Sprintf(`{"challenge_id": %s}`, validID),
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "verify MFA with invalid content type",
+			data:        fmt.Sprintf(dataFormat, validID, "123456"),
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:        us.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/users/tokens/mfa", us.URL),
+				contentType: tc.contentType,
+				body:        strings.NewReader(tc.data),
+			}
+
+			svcCall := svc.On("VerifyMFA", mock.Anything, mock.Anything, mock.Anything).Return(&grpcTokenV1.Token{AccessToken: validToken}, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			if tc.err != nil {
+				var resBody respBody
+				err = json.NewDecoder(res.Body).Decode(&resBody)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				if resBody.Err != "" || resBody.Message != "" {
+					err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+				}
+				assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			}
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestEnrollMFA(t *testing.T) {
+	us, svc, authn := newUsersServer()
+	defer us.Close()
+
+	enrolment := users.MFAEnrolment{Secret: "JBSWY3DPEHPK3PXP", URI: "otpauth://totp/SuperMQ:user?secret=JBSWY3DPEHPK3PXP"}
+
+	cases := []struct {
+		desc     string
+		token    string
+		authnRes smqauthn.Session
+		authnErr error
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:     "enroll MFA with valid token",
+			token:    validToken,
+			authnRes: verifiedSession,
+			status:   http.StatusCreated,
+		},
+		{
+			desc:     "enroll MFA with invalid token",
+			token:    inValidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:     "enroll MFA with MFA already enabled",
+			token:    validToken,
+			authnRes: verifiedSession,
+			svcErr:   users.ErrMFAEnabled,
+			status:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:   us.Client(),
+				method: http.MethodPost,
+				url:    fmt.Sprintf("%s/users/mfa/enroll", us.URL),
+				token:  tc.token,
+			}
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("EnrollMFA", mock.Anything, tc.authnRes).Return(enrolment, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			if tc.status == http.StatusCreated {
+				var resBody users.MFAEnrolment
+				err = json.NewDecoder(res.Body).Decode(&resBody)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, enrolment, resBody, fmt.Sprintf("%s: expected %v got %v", tc.desc, enrolment, resBody))
+			}
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authnCall.Unset()
+		})
+	}
+}
+
+func TestConfirmMFA(t *testing.T) {
+	us, svc, authn := newUsersServer()
+	defer us.Close()
+
+	codes := []string{"abcd-efgh", "ijkl-mnop"}
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		token       string
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcErr      error
+		status      int
+	}{
+		{
+			desc:        "confirm MFA with valid code",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "confirm MFA with invalid token",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       inValidToken,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "confirm MFA with empty code",
+			data:        `{"code": ""}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "confirm MFA with invalid code",
+			data:        `{"code": "000000"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			svcErr:      users.ErrInvalidMFACode,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "confirm MFA without enrolment",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			svcErr:      users.ErrMFANotEnrolled,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "confirm MFA with invalid content type",
+			data:        `{"code": "123456"}`,
+			contentType: "application/xml",
+			token:       validToken,
+			authnRes:    verifiedSession,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:        us.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/users/mfa/confirm", us.URL),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("ConfirmMFA", mock.Anything, tc.authnRes, mock.Anything).Return(codes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			if tc.status == http.StatusOK {
+				var resBody struct {
+					RecoveryCodes []string `json:"recovery_codes"`
+				}
+				err = json.NewDecoder(res.Body).Decode(&resBody)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, codes, resBody.RecoveryCodes, fmt.Sprintf("%s: expected %v got %v", tc.desc, codes, resBody.RecoveryCodes))
+			}
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authnCall.Unset()
+		})
+	}
+}
+
+func TestDisableMFA(t *testing.T) {
+	us, svc, authn := newUsersServer()
+	defer us.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		token       string
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcErr      error
+		status      int
+	}{
+		{
+			desc:        "disable MFA with valid code",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "disable MFA with invalid token",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       inValidToken,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "disable MFA with empty code",
+			data:        `{}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "disable MFA with invalid code",
+			data:        `{"code": "000000"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			svcErr:      users.ErrInvalidMFACode,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "disable MFA when not enabled",
+			data:        `{"code": "123456"}`,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    verifiedSession,
+			svcErr:      users.ErrMFANotEnabled,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				user:        us.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/users/mfa/disable", us.URL),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("DisableMFA", mock.Anything, tc.authnRes, mock.Anything).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authnCall.Unset()
+		})
+	}
+}
+
 func TestEnable(t *testing.T) {
 	us, svc, authn := newUsersServer()
 	defer us.Close()
@@ -2841,3 +3380,190 @@ type respBody struct {
 	Role    users.Role   `json:"role"`
 	Status  users.Status `json:"status"`
 }
+
+type oidcProvider struct {
+	*oauth2mocks.Provider
+	*oauth2mocks.Authorizer
+}
+
+func newOAuthServer() (*httptest.Server, *mocks.Service, *oauth2mocks.Provider, *oauth2mocks.Authorizer, *authmocks.TokenServiceClient) {
+	svc := new(mocks.Service)
+	provider := new(oauth2mocks.Provider)
+	provider.On("Name").Return("oidc")
+	provider.On("ErrorURL").Return("http://localhost/error")
+	provider.On("RedirectURL").Return("http://localhost/domains")
+	authorizer := new(oauth2mocks.Authorizer)
+	authn := new(authnmocks.Authentication)
+	token := new(authmocks.TokenServiceClient)
+	mux := chi.NewRouter()
+	usersapi.MakeHandler(svc, smqauthn.NewAuthNMiddleware(authn), token, true, mux, smqlog.NewMock(), "", passRegex, uuid.NewMock(), oidcProvider{provider, authorizer})
+
+	return httptest.NewServer(mux), svc, provider, authorizer, token
+}
+
+func noRedirectClient(us *httptest.Server) *http.Client {
+	client := us.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return client
+}
+
+func TestOAuthAuthorize(t *testing.T) {
+	us, _, provider, authorizer, _ := newOAuthServer()
+	defer us.Close()
+	client := noRedirectClient(us)
+
+	cases := []struct {
+		desc     string
+		enabled  bool
+		status   int
+		location string
+	}{
+		{
+			desc:     "start OAuth flow successfully",
+			enabled:  true,
+			status:   http.StatusFound,
+			location: "http://idp/auth",
+		},
+		{
+			desc:     "start OAuth flow with disabled provider",
+			enabled:  false,
+			status:   http.StatusSeeOther,
+			location: "http://localhost/error?error=oauth%20provider%20is%20disabled",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var flow oauth2.Flow
+			enabledCall := provider.On("IsEnabled").Return(tc.enabled)
+			authCall := authorizer.On("AuthCodeURL", mock.Anything).Return("http://idp/auth").Run(func(args mock.Arguments) {
+				flow = args.Get(0).(oauth2.Flow)
+			})
+			res, err := client.Get(us.URL + "/oauth/authorize/oidc")
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			assert.Equal(t, tc.location, res.Header.Get("Location"), fmt.Sprintf("%s: expected location %s got %s", tc.desc, tc.location, res.Header.Get("Location")))
+			if tc.enabled {
+				assert.NotEmpty(t, flow.State, fmt.Sprintf("%s: expected flow state", tc.desc))
+				assert.NotEmpty(t, flow.Nonce, fmt.Sprintf("%s: expected flow nonce", tc.desc))
+				assert.NotEmpty(t, flow.Verifier, fmt.Sprintf("%s: expected flow verifier", tc.desc))
+				cookies := res.Cookies()
+				assert.Len(t, cookies, 1, fmt.Sprintf("%s: expected flow cookie", tc.desc))
+				if len(cookies) == 1 {
+					assert.Equal(t, "/oauth/callback/oidc", cookies[0].Path, fmt.Sprintf("%s: expected flow cookie path %s got %s", tc.desc, "/oauth/callback/oidc", cookies[0].Path))
+					assert.True(t, cookies[0].HttpOnly, fmt.Sprintf("%s: expected HTTP only flow cookie", tc.desc))
+				}
+			}
+			enabledCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestOAuthCallback(t *testing.T) {
+	us, svc, provider, _, token := newOAuthServer()
+	defer us.Close()
+	client := noRedirectClient(us)
+
+	flow, err := oauth2.NewFlow()
+	assert.Nil(t, err, fmt.Sprintf("creating flow unexpected error %s", err))
+	data, err := json.Marshal(flow)
+	assert.Nil(t, err, fmt.Sprintf("marshaling flow unexpected error %s", err))
+	flowCookie := &http.Cookie{Name: "oauth_flow", Value: base64.RawURLEncoding.EncodeToString(data)}
+	oauthUser := users.User{ID: testsutil.GenerateUUID(t), Email: "jane@example.com"}
+	challenge := users.MFAChallenge{ID: testsutil.GenerateUUID(t), ExpiresAt: time.Now().UTC().Add(users.MFAChallengeDuration)}
+
+	cases := []struct {
+		desc      string
+		state     string
+		cookie    *http.Cookie
+		exchange  bool
+		challenge users.MFAChallenge
+		tokens    bool
+		location  string
+	}{
+		{
+			desc:     "OAuth callback successfully",
+			state:    flow.State,
+			cookie:   flowCookie,
+			exchange: true,
+			tokens:   true,
+			location: "http://localhost/domains",
+		},
+		{
+			desc:      "OAuth callback of user with MFA enabled",
+			state:     flow.State,
+			cookie:    flowCookie,
+			exchange:  true,
+			challenge: challenge,
+			location:  "http://localhost/domains?" + url.Values{"mfa_challenge": {challenge.ID}, "mfa_expires_at": {challenge.ExpiresAt.Format(time.RFC3339)}}.Encode(),
+		},
+		{
+			desc:     "OAuth callback without flow cookie",
+			state:    flow.State,
+			location: "http://localhost/error?error=invalid%20state",
+		},
+		{
+			desc:     "OAuth callback with invalid flow cookie",
+			state:    flow.State,
+			cookie:   &http.Cookie{Name: "oauth_flow", Value: "invalid"},
+			location: "http://localhost/error?error=invalid%20state",
+		},
+		{
+			desc:     "OAuth callback with state of other flow",
+			state:    "other-state",
+			cookie:   flowCookie,
+			location: "http://localhost/error?error=invalid%20state",
+		},
+		{
+			desc:     "OAuth callback with empty state",
+			state:    "",
+			cookie:   flowCookie,
+			location: "http://localhost/error?error=invalid%20state",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var exchangeFlow oauth2.Flow
+			enabledCall := provider.On("IsEnabled").Return(true)
+			exchangeCall := provider.On("Exchange", mock.Anything, "code").Return(xoauth2.Token{AccessToken: "access"}, nil).Run(func(args mock.Arguments) {
+				exchangeFlow, _ = oauth2.FlowFromContext(args.Get(0).(context.Context))
+			})
+			userInfoCall := provider.On("UserInfo", mock.Anything, xoauth2.Token{AccessToken: "access"}).Return(oauthUser, nil)
+			svcCall := svc.On("OAuthCallback", mock.Anything, mock.Anything).Return(oauthUser, nil)
+			svcCall1 := svc.On("OAuthAddUserPolicy", mock.Anything, oauthUser).Return(nil)
+			svcCall2 := svc.On("OAuthMFAChallenge", mock.Anything, oauthUser).Return(tc.challenge, nil)
+			tokenCall := token.On("Issue", mock.Anything, mock.Anything).Return(&grpcTokenV1.Token{AccessToken: "token"}, nil)
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/oauth/callback/oidc?code=code&state=%s", us.URL, url.QueryEscape(tc.state)), nil)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			if tc.cookie != nil {
+				req.AddCookie(tc.cookie)
+			}
+			res, err := client.Do(req)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.location, res.Header.Get("Location"), fmt.Sprintf("%s: expected location %s got %s", tc.desc, tc.location, res.Header.Get("Location")))
+			if tc.exchange {
+				assert.Equal(t, flow, exchangeFlow, fmt.Sprintf("%s: expected exchange with flow %v got %v", tc.desc, flow, exchangeFlow))
+			}
+			var tokens bool
+			for _, c := range res.Cookies() {
+				if c.Name == "access_token" || c.Name == "refresh_token" {
+					tokens = true
+				}
+			}
+			assert.Equal(t, tc.tokens, tokens, fmt.Sprintf("%s: expected tokens issued %t got %t", tc.desc, tc.tokens, tokens))
+			enabledCall.Unset()
+			exchangeCall.Unset()
+			userInfoCall.Unset()
+			svcCall.Unset()
+			svcCall1.Unset()
+			svcCall2.Unset()
+			tokenCall.Unset()
+		})
+	}
+}
@@ -416,7 +416,30 @@ func issueTokenEndpoint(svc users.Service) endpoint.Endpoint {
 			return nil, errors.Wrap(apiutil.ErrValidation, err)
 		}
 
-		token, err := svc.IssueToken(ctx, req.Username, req.Password)
+		token, challenge, err := svc.IssueToken(ctx, req.Username, req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if challenge.ID != "" {
+			return mfaChallengeRes{MFAChallenge: challenge}, nil
+		}
+
+		return tokenRes{
+			AccessToken:  token.GetAccessToken(),
+			RefreshToken: token.GetRefreshToken(),
+			AccessType:   token.GetAccessType(),
+		}, nil
+	}
+}
+
+func verifyMFAEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(verifyMFAReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		token, err := svc.VerifyMFA(ctx, req.ChallengeID, req.Code)
 		if err != nil {
 			return nil, err
 		}
@@ -429,6 +452,63 @@ func issueTokenEndpoint(svc users.Service) endpoint.Endpoint {
 	}
 }
 
+func enrollMFAEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		enrolment, err := svc.EnrollMFA(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+
+		return mfaEnrolmentRes{MFAEnrolment: enrolment}, nil
+	}
+}
+
+func confirmMFAEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(mfaCodeReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		codes, err := svc.ConfirmMFA(ctx, session, req.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		return recoveryCodesRes{RecoveryCodes: codes}, nil
+	}
+}
+
+func disableMFAEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(mfaCodeReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		if err := svc.DisableMFA(ctx, session, req.Code); err != nil {
+			return nil, err
+		}
+
+		return disableMFARes{}, nil
+	}
+}
+
 func refreshTokenEndpoint(svc users.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(tokenReq)
@@ -454,6 +534,54 @@ func refreshTokenEndpoint(svc users.Service) endpoint.Endpoint {
 	}
 }
 
+func listSessionsEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listSessionsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		page, err := svc.ListSessions(ctx, session, users.Page{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return nil, err
+		}
+
+		return sessionsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Sessions: page.Sessions,
+		}, nil
+	}
+}
+
+func revokeSessionEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(revokeSessionReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		if err := svc.RevokeSession(ctx, session, req.id); err != nil {
+			return nil, err
+		}
+
+		return revokeSessionRes{}, nil
+	}
+}
+
 func enableEndpoint(svc users.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(changeUserStatusReq)
@@ -21,6 +21,7 @@ var _ grpcUsersV1.UsersServiceClient = (*usersGrpcClient)(nil)
 
 type usersGrpcClient struct {
 	retrieveUsers endpoint.Endpoint
+	provisionUser endpoint.Endpoint
 	timeout       time.Duration
 }
 
@@ -35,6 +36,14 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcUsersV1.UsersSe
 			decodeRetrieveUsersResponse,
 			grpcUsersV1.RetrieveUsersRes{},
 		).Endpoint(),
+		provisionUser: kitgrpc.NewClient(
+			conn,
+			usersSvcName,
+			"ProvisionUser",
+			encodeProvisionUserRequest,
+			decodeProvisionUserResponse,
+			grpcUsersV1.ProvisionUserRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -92,6 +101,52 @@ func encodeRetrieveUsersRequest(_ context.Context, grpcReq any) (any, error) {
 	}, nil
 }
 
+func (client usersGrpcClient) ProvisionUser(ctx context.Context, in *grpcUsersV1.ProvisionUserReq, opts ...grpc.CallOption) (*grpcUsersV1.ProvisionUserRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	user, err := userFromProto(in.GetUser())
+	if err != nil {
+		return &grpcUsersV1.ProvisionUserRes{}, err
+	}
+
+	res, err := client.provisionUser(ctx, provisionUserReq{user: user})
+	if err != nil {
+		return &grpcUsersV1.ProvisionUserRes{}, grpcapi.DecodeError(err)
+	}
+
+	pur := res.(provisionUserRes)
+
+	userPB, err := toProtoUser(pur.user)
+	if err != nil {
+		return &grpcUsersV1.ProvisionUserRes{}, err
+	}
+
+	return &grpcUsersV1.ProvisionUserRes{User: userPB}, nil
+}
+
+func decodeProvisionUserResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcUsersV1.ProvisionUserRes)
+
+	user, err := userFromProto(res.GetUser())
+	if err != nil {
+		return nil, err
+	}
+
+	return provisionUserRes{user: user}, nil
+}
+
+func encodeProvisionUserRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(provisionUserReq)
+
+	userPB, err := toProtoUser(req.user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcUsersV1.ProvisionUserReq{User: userPB}, nil
+}
+
 func usersFromProto(us []*grpcUsersV1.User) ([]users.User, error) {
 	var res []users.User
 	for _, u := range us {
@@ -31,3 +31,20 @@ func retrieveUsersEndpoint(svc pusers.Service) endpoint.Endpoint {
 		}, nil
 	}
 }
+
+func provisionUserEndpoint(svc pusers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(provisionUserReq)
+
+		if err := req.validate(); err != nil {
+			return provisionUserRes{}, err
+		}
+
+		user, err := svc.ProvisionUser(ctx, req.user)
+		if err != nil {
+			return provisionUserRes{}, err
+		}
+
+		return provisionUserRes{user: user}, nil
+	}
+}
@@ -5,6 +5,7 @@ package grpc
 
 import (
 	apiutil "github.com/absmach/supermq/api/http/util"
+	"github.com/absmach/supermq/users"
 )
 
 type retrieveUsersReq struct {
@@ -20,3 +21,18 @@ func (req retrieveUsersReq) validate() error {
 
 	return nil
 }
+
+type provisionUserReq struct {
+	user users.User
+}
+
+func (req provisionUserReq) validate() error {
+	if req.user.ID == "" {
+		return apiutil.ErrMissingUserID
+	}
+	if req.user.Email == "" {
+		return apiutil.ErrMissingEmail
+	}
+
+	return nil
+}
@@ -11,3 +11,7 @@ type retrieveUsersRes struct {
 	limit  uint64
 	offset uint64
 }
+
+type provisionUserRes struct {
+	user users.User
+}
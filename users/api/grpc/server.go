@@ -22,6 +22,7 @@ var _ grpcUsersV1.UsersServiceServer = (*usersGrpcServer)(nil)
 type usersGrpcServer struct {
 	grpcUsersV1.UnimplementedUsersServiceServer
 	retrieveUsers kitgrpc.Handler
+	provisionUser kitgrpc.Handler
 }
 
 func NewServer(svc pusers.Service) grpcUsersV1.UsersServiceServer {
@@ -31,6 +32,11 @@ func NewServer(svc pusers.Service) grpcUsersV1.UsersServiceServer {
 			decodeRetrieveUsersRequest,
 			encodeRetrieveUsersResponse,
 		),
+		provisionUser: kitgrpc.NewServer(
+			provisionUserEndpoint(svc),
+			decodeProvisionUserRequest,
+			encodeProvisionUserResponse,
+		),
 	}
 }
 
@@ -68,6 +74,37 @@ func (s *usersGrpcServer) RetrieveUsers(ctx context.Context, req *grpcUsersV1.Re
 	return res.(*grpcUsersV1.RetrieveUsersRes), nil
 }
 
+func decodeProvisionUserRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcUsersV1.ProvisionUserReq)
+
+	user, err := userFromProto(req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+
+	return provisionUserReq{user: user}, nil
+}
+
+func encodeProvisionUserResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(provisionUserRes)
+
+	userPB, err := toProtoUser(res.user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcUsersV1.ProvisionUserRes{User: userPB}, nil
+}
+
+func (s *usersGrpcServer) ProvisionUser(ctx context.Context, req *grpcUsersV1.ProvisionUserReq) (*grpcUsersV1.ProvisionUserRes, error) {
+	_, res, err := s.provisionUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcUsersV1.ProvisionUserRes), nil
+}
+
 func toProtoUsers(us []users.User) ([]*grpcUsersV1.User, error) {
 	var res []*grpcUsersV1.User
 	for _, u := range us {
@@ -297,6 +297,59 @@ func (req tokenReq) validate() error {
 	return nil
 }
 
+type listSessionsReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req listSessionsReq) validate() error {
+	if req.limit > maxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type verifyMFAReq struct {
+	ChallengeID string `json:"challenge_id,omitempty"`
+	Code        string `json:"code,omitempty"`
+}
+
+func (req verifyMFAReq) validate() error {
+	if req.ChallengeID == "" {
+		return apiutil.ErrMissingMFAChallenge
+	}
+	if req.Code == "" {
+		return apiutil.ErrMissingMFACode
+	}
+
+	return nil
+}
+
+type mfaCodeReq struct {
+	Code string `json:"code,omitempty"`
+}
+
+func (req mfaCodeReq) validate() error {
+	if req.Code == "" {
+		return apiutil.ErrMissingMFACode
+	}
+
+	return nil
+}
+
+type revokeSessionReq struct {
+	id string
+}
+
+func (req revokeSessionReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
 type passResetReq struct {
 	Email string `json:"email"`
 }
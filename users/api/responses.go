@@ -80,6 +80,99 @@ func (res tokenRes) Empty() bool {
 	return res.AccessToken == "" || res.RefreshToken == ""
 }
 
+type mfaChallengeRes struct {
+	users.MFAChallenge
+}
+
+func (res mfaChallengeRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res mfaChallengeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res mfaChallengeRes) Empty() bool {
+	return false
+}
+
+type mfaEnrolmentRes struct {
+	users.MFAEnrolment
+}
+
+func (res mfaEnrolmentRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res mfaEnrolmentRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res mfaEnrolmentRes) Empty() bool {
+	return false
+}
+
+type recoveryCodesRes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (res recoveryCodesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res recoveryCodesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res recoveryCodesRes) Empty() bool {
+	return false
+}
+
+type disableMFARes struct{}
+
+func (res disableMFARes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res disableMFARes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res disableMFARes) Empty() bool {
+	return true
+}
+
+type sessionsPageRes struct {
+	pageRes
+	Sessions []users.LoginSession `json:"sessions"`
+}
+
+func (res sessionsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res sessionsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res sessionsPageRes) Empty() bool {
+	return false
+}
+
+type revokeSessionRes struct{}
+
+func (res revokeSessionRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res revokeSessionRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res revokeSessionRes) Empty() bool {
+	return true
+}
+
 type sendVerificationRes struct{}
 
 func (res sendVerificationRes) Code() int {
@@ -5,11 +5,15 @@ package api
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/absmach/supermq"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
@@ -25,6 +29,11 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+const (
+	oauthFlowCookie   = "oauth_flow"
+	oauthFlowDuration = 10 * time.Minute
+)
+
 var passRegex = regexp.MustCompile("^.{8,}$")
 
 // usersHandler returns a HTTP handler for API endpoints.
@@ -110,6 +119,41 @@ func usersHandler(svc users.Service, authn smqauthn.AuthNMiddleware, tokenClient
 				opts...,
 			), "search_users").ServeHTTP)
 
+			r.Get("/sessions", otelhttp.NewHandler(kithttp.NewServer(
+				listSessionsEndpoint(svc),
+				decodeListSessions,
+				api.EncodeResponse,
+				opts...,
+			), "list_sessions").ServeHTTP)
+
+			r.Delete("/sessions/{sessionID}", otelhttp.NewHandler(kithttp.NewServer(
+				revokeSessionEndpoint(svc),
+				decodeRevokeSession,
+				api.EncodeResponse,
+				opts...,
+			), "revoke_session").ServeHTTP)
+
+			r.Post("/mfa/enroll", otelhttp.NewHandler(kithttp.NewServer(
+				enrollMFAEndpoint(svc),
+				decodeEnrollMFA,
+				api.EncodeResponse,
+				opts...,
+			), "enroll_mfa").ServeHTTP)
+
+			r.Post("/mfa/confirm", otelhttp.NewHandler(kithttp.NewServer(
+				confirmMFAEndpoint(svc),
+				decodeMFACode,
+				api.EncodeResponse,
+				opts...,
+			), "confirm_mfa").ServeHTTP)
+
+			r.Post("/mfa/disable", otelhttp.NewHandler(kithttp.NewServer(
+				disableMFAEndpoint(svc),
+				decodeMFACode,
+				api.EncodeResponse,
+				opts...,
+			), "disable_mfa").ServeHTTP)
+
 			r.Patch("/secret", otelhttp.NewHandler(kithttp.NewServer(
 				updateSecretEndpoint(svc),
 				decodeUpdateUserSecret,
@@ -189,9 +233,16 @@ func usersHandler(svc users.Service, authn smqauthn.AuthNMiddleware, tokenClient
 		issueTokenEndpoint(svc),
 		decodeCredentials,
 		api.EncodeResponse,
-		opts...,
+		append(opts, kithttp.ServerBefore(clientInfo))...,
 	), "issue_token").ServeHTTP)
 
+	r.Post("/users/tokens/mfa", otelhttp.NewHandler(kithttp.NewServer(
+		verifyMFAEndpoint(svc),
+		decodeVerifyMFA,
+		api.EncodeResponse,
+		append(opts, kithttp.ServerBefore(clientInfo))...,
+	), "verify_mfa").ServeHTTP)
+
 	r.Post("/password/reset-request", otelhttp.NewHandler(kithttp.NewServer(
 		passwordResetRequestEndpoint(svc),
 		decodePasswordResetRequest,
@@ -207,6 +258,9 @@ func usersHandler(svc users.Service, authn smqauthn.AuthNMiddleware, tokenClient
 	), "verify_email").ServeHTTP)
 
 	for _, provider := range providers {
+		if authorizer, ok := provider.(oauth2.Authorizer); ok {
+			r.Get("/oauth/authorize/"+provider.Name(), oauth2AuthorizeHandler(provider, authorizer))
+		}
 		r.HandleFunc("/oauth/callback/"+provider.Name(), oauth2CallbackHandler(provider, svc, tokenClient))
 	}
 
@@ -532,6 +586,59 @@ func decodeRefreshToken(_ context.Context, r *http.Request) (any, error) {
 	return req, nil
 }
 
+func decodeVerifyMFA(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := verifyMFAReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeEnrollMFA(_ context.Context, _ *http.Request) (any, error) {
+	return nil, nil
+}
+
+func decodeMFACode(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+	}
+
+	req := mfaCodeReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeListSessions(_ context.Context, r *http.Request) (any, error) {
+	o, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	l, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listSessionsReq{offset: o, limit: l}, nil
+}
+
+func decodeRevokeSession(_ context.Context, r *http.Request) (any, error) {
+	return revokeSessionReq{id: chi.URLParam(r, "sessionID")}, nil
+}
+
+// clientInfo stores the info of the client which sent the unauthenticated
+// request in the context, so it can be recorded in the login session.
+func clientInfo(ctx context.Context, r *http.Request) context.Context {
+	return smqauthn.WithClientInfo(ctx, smqauthn.ClientInfoFromRequest(r))
+}
+
 func decodeCreateUserReq(_ context.Context, r *http.Request) (any, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -553,6 +660,39 @@ func decodeChangeUserStatus(_ context.Context, r *http.Request) (any, error) {
 	return req, nil
 }
 
+// oauth2AuthorizeHandler is a http.HandlerFunc that starts the OAuth2 flow of
+// the providers which bind each flow to its own secrets. The secrets are kept
+// in the cookie scoped to the provider callback.
+func oauth2AuthorizeHandler(oauth oauth2.Provider, authorizer oauth2.Authorizer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !oauth.IsEnabled() {
+			http.Redirect(w, r, oauth.ErrorURL()+"?error=oauth%20provider%20is%20disabled", http.StatusSeeOther)
+			return
+		}
+		flow, err := oauth2.NewFlow()
+		if err != nil {
+			http.Redirect(w, r, oauth.ErrorURL()+"?error="+url.QueryEscape(err.Error()), http.StatusSeeOther)
+			return
+		}
+		data, err := json.Marshal(flow)
+		if err != nil {
+			http.Redirect(w, r, oauth.ErrorURL()+"?error="+url.QueryEscape(err.Error()), http.StatusSeeOther)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     oauthFlowCookie,
+			Value:    base64.RawURLEncoding.EncodeToString(data),
+			Path:     "/oauth/callback/" + oauth.Name(),
+			MaxAge:   int(oauthFlowDuration.Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, authorizer.AuthCodeURL(flow), http.StatusFound)
+	}
+}
+
 // oauth2CallbackHandler is a http.HandlerFunc that handles OAuth2 callbacks.
 func oauth2CallbackHandler(oauth oauth2.Provider, svc users.Service, tokenClient grpcTokenV1.TokenServiceClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -560,20 +700,36 @@ func oauth2CallbackHandler(oauth oauth2.Provider, svc users.Service, tokenClient
 			http.Redirect(w, r, oauth.ErrorURL()+"?error=oauth%20provider%20is%20disabled", http.StatusSeeOther)
 			return
 		}
+		ctx := r.Context()
 		state := r.FormValue("state")
-		if state != oauth.State() {
+		if _, ok := oauth.(oauth2.Authorizer); ok {
+			flow, err := oauth2FlowFromCookie(r)
+			// The flow is answered only once.
+			http.SetCookie(w, &http.Cookie{
+				Name:     oauthFlowCookie,
+				Path:     "/oauth/callback/" + oauth.Name(),
+				MaxAge:   -1,
+				HttpOnly: true,
+				Secure:   true,
+			})
+			if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(flow.State)) != 1 {
+				http.Redirect(w, r, oauth.ErrorURL()+"?error=invalid%20state", http.StatusSeeOther)
+				return
+			}
+			ctx = oauth2.WithFlow(ctx, flow)
+		} else if state != oauth.State() {
 			http.Redirect(w, r, oauth.ErrorURL()+"?error=invalid%20state", http.StatusSeeOther)
 			return
 		}
 
 		if code := r.FormValue("code"); code != "" {
-			token, err := oauth.Exchange(r.Context(), code)
+			token, err := oauth.Exchange(ctx, code)
 			if err != nil {
 				http.Redirect(w, r, oauth.ErrorURL()+"?error="+err.Error(), http.StatusSeeOther)
 				return
 			}
 
-			user, err := oauth.UserInfo(token.AccessToken)
+			user, err := oauth.UserInfo(ctx, token)
 			if err != nil {
 				http.Redirect(w, r, oauth.ErrorURL()+"?error="+err.Error(), http.StatusSeeOther)
 				return
@@ -593,11 +749,26 @@ func oauth2CallbackHandler(oauth oauth2.Provider, svc users.Service, tokenClient
 				return
 			}
 
+			// Users who enabled MFA get the same challenge as on the password
+			// login, and the tokens are issued once it is answered.
+			challenge, err := svc.OAuthMFAChallenge(r.Context(), user)
+			if err != nil {
+				http.Redirect(w, r, oauth.ErrorURL()+"?error="+err.Error(), http.StatusSeeOther)
+				return
+			}
+			if challenge.ID != "" {
+				http.Redirect(w, r, mfaChallengeURL(oauth.RedirectURL(), challenge), http.StatusFound)
+				return
+			}
+
+			ci := smqauthn.ClientInfoFromRequest(r)
 			jwt, err := tokenClient.Issue(r.Context(), &grpcTokenV1.IssueReq{
-				UserId:   user.ID,
-				Type:     uint32(smqauth.AccessKey),
-				UserRole: uint32(smqauth.UserRole),
-				Verified: !user.VerifiedAt.IsZero(),
+				UserId:    user.ID,
+				Type:      uint32(smqauth.AccessKey),
+				UserRole:  uint32(smqauth.UserRole),
+				Verified:  !user.VerifiedAt.IsZero(),
+				ClientIp:  ci.IP,
+				UserAgent: ci.UserAgent,
 			})
 			if err != nil {
 				http.Redirect(w, r, oauth.ErrorURL()+"?error="+err.Error(), http.StatusSeeOther)
@@ -626,3 +797,35 @@ func oauth2CallbackHandler(oauth oauth2.Provider, svc users.Service, tokenClient
 		http.Redirect(w, r, oauth.ErrorURL()+"?error=empty%20code", http.StatusSeeOther)
 	}
 }
+
+// mfaChallengeURL adds the MFA challenge ID and expiration time to the OAuth
+// redirect URL, so the client can answer the challenge.
+func mfaChallengeURL(redirectURL string, challenge users.MFAChallenge) string {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return redirectURL
+	}
+	q := u.Query()
+	q.Set("mfa_challenge", challenge.ID)
+	q.Set("mfa_expires_at", challenge.ExpiresAt.Format(time.RFC3339))
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+func oauth2FlowFromCookie(r *http.Request) (oauth2.Flow, error) {
+	cookie, err := r.Cookie(oauthFlowCookie)
+	if err != nil {
+		return oauth2.Flow{}, err
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return oauth2.Flow{}, err
+	}
+	var flow oauth2.Flow
+	if err := json.Unmarshal(data, &flow); err != nil {
+		return oauth2.Flow{}, err
+	}
+
+	return flow, nil
+}
@@ -34,6 +34,12 @@ const (
 	generateResetToken       = userPrefix + "generate_reset_token"
 	issueToken               = userPrefix + "issue_token"
 	refreshToken             = userPrefix + "refresh_token"
+	listSessions             = userPrefix + "list_sessions"
+	revokeSession            = userPrefix + "revoke_session"
+	verifyMFA                = userPrefix + "verify_mfa"
+	enrollMFA                = userPrefix + "enroll_mfa"
+	confirmMFA               = userPrefix + "confirm_mfa"
+	disableMFA               = userPrefix + "disable_mfa"
 	resetSecret              = userPrefix + "reset_secret"
 	sendPasswordReset        = userPrefix + "send_password_reset"
 	oauthCallback            = userPrefix + "oauth_callback"
@@ -56,6 +62,12 @@ var (
 	_ events.Event = (*identifyUserEvent)(nil)
 	_ events.Event = (*issueTokenEvent)(nil)
 	_ events.Event = (*refreshTokenEvent)(nil)
+	_ events.Event = (*listSessionsEvent)(nil)
+	_ events.Event = (*revokeSessionEvent)(nil)
+	_ events.Event = (*verifyMFAEvent)(nil)
+	_ events.Event = (*enrollMFAEvent)(nil)
+	_ events.Event = (*confirmMFAEvent)(nil)
+	_ events.Event = (*disableMFAEvent)(nil)
 	_ events.Event = (*resetSecretEvent)(nil)
 	_ events.Event = (*sendPasswordResetEvent)(nil)
 	_ events.Event = (*oauthCallbackEvent)(nil)
@@ -469,15 +481,72 @@ func (ise identifyUserEvent) Encode() (map[string]any, error) {
 }
 
 type issueTokenEvent struct {
-	username  string
-	requestID string
+	username    string
+	mfaRequired bool
+	requestID   string
 }
 
 func (ite issueTokenEvent) Encode() (map[string]any, error) {
 	return map[string]any{
-		"operation":  issueToken,
-		"username":   ite.username,
-		"request_id": ite.requestID,
+		"operation":    issueToken,
+		"username":     ite.username,
+		"mfa_required": ite.mfaRequired,
+		"request_id":   ite.requestID,
+	}, nil
+}
+
+type verifyMFAEvent struct {
+	challengeID string
+	requestID   string
+}
+
+func (vme verifyMFAEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":    verifyMFA,
+		"challenge_id": vme.challengeID,
+		"request_id":   vme.requestID,
+	}, nil
+}
+
+type enrollMFAEvent struct {
+	authn.Session
+	requestID string
+}
+
+func (eme enrollMFAEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  enrollMFA,
+		"user_id":    eme.UserID,
+		"token_type": eme.Type.String(),
+		"request_id": eme.requestID,
+	}, nil
+}
+
+type confirmMFAEvent struct {
+	authn.Session
+	requestID string
+}
+
+func (cme confirmMFAEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  confirmMFA,
+		"user_id":    cme.UserID,
+		"token_type": cme.Type.String(),
+		"request_id": cme.requestID,
+	}, nil
+}
+
+type disableMFAEvent struct {
+	authn.Session
+	requestID string
+}
+
+func (dme disableMFAEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  disableMFA,
+		"user_id":    dme.UserID,
+		"token_type": dme.Type.String(),
+		"request_id": dme.requestID,
 	}, nil
 }
 
@@ -492,6 +561,42 @@ func (rte refreshTokenEvent) Encode() (map[string]any, error) {
 	}, nil
 }
 
+type listSessionsEvent struct {
+	total  uint64
+	offset uint64
+	limit  uint64
+	authn.Session
+	requestID string
+}
+
+func (lse listSessionsEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  listSessions,
+		"user_id":    lse.UserID,
+		"total":      lse.total,
+		"offset":     lse.offset,
+		"limit":      lse.limit,
+		"token_type": lse.Type.String(),
+		"request_id": lse.requestID,
+	}, nil
+}
+
+type revokeSessionEvent struct {
+	id string
+	authn.Session
+	requestID string
+}
+
+func (rse revokeSessionEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":  revokeSession,
+		"id":         rse.id,
+		"user_id":    rse.UserID,
+		"token_type": rse.Type.String(),
+		"request_id": rse.requestID,
+	}, nil
+}
+
 type resetSecretEvent struct {
 	requestID string
 }
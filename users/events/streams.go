@@ -35,6 +35,12 @@ const (
 	identifyStream          = supermqPrefix + userIdentify
 	issueTokenStream        = supermqPrefix + issueToken
 	refreshTokenStream      = supermqPrefix + refreshToken
+	listSessionsStream      = supermqPrefix + listSessions
+	revokeSessionStream     = supermqPrefix + revokeSession
+	verifyMFAStream         = supermqPrefix + verifyMFA
+	enrollMFAStream         = supermqPrefix + enrollMFA
+	confirmMFAStream        = supermqPrefix + confirmMFA
+	disableMFAStream        = supermqPrefix + disableMFA
 	resetSecretStream       = supermqPrefix + resetSecret
 	sendPasswordResetStream = supermqPrefix + sendPasswordReset
 	oauthStream             = supermqPrefix + oauthCallback
@@ -350,24 +356,92 @@ func (es *eventStore) SendPasswordReset(ctx context.Context, email string) error
 	return es.Publish(ctx, sendPasswordResetStream, event)
 }
 
-func (es *eventStore) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, error) {
-	token, err := es.svc.IssueToken(ctx, username, secret)
+func (es *eventStore) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, users.MFAChallenge, error) {
+	token, challenge, err := es.svc.IssueToken(ctx, username, secret)
 	if err != nil {
-		return token, err
+		return token, challenge, err
 	}
 
 	event := issueTokenEvent{
-		username:  username,
-		requestID: middleware.GetReqID(ctx),
+		username:    username,
+		mfaRequired: challenge.ID != "",
+		requestID:   middleware.GetReqID(ctx),
 	}
 
 	if err := es.Publish(ctx, issueTokenStream, event); err != nil {
+		return token, challenge, err
+	}
+
+	return token, challenge, nil
+}
+
+func (es *eventStore) VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error) {
+	token, err := es.svc.VerifyMFA(ctx, challengeID, code)
+	if err != nil {
+		return token, err
+	}
+
+	event := verifyMFAEvent{
+		challengeID: challengeID,
+		requestID:   middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, verifyMFAStream, event); err != nil {
 		return token, err
 	}
 
 	return token, nil
 }
 
+func (es *eventStore) EnrollMFA(ctx context.Context, session authn.Session) (users.MFAEnrolment, error) {
+	enrolment, err := es.svc.EnrollMFA(ctx, session)
+	if err != nil {
+		return enrolment, err
+	}
+
+	event := enrollMFAEvent{
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, enrollMFAStream, event); err != nil {
+		return enrolment, err
+	}
+
+	return enrolment, nil
+}
+
+func (es *eventStore) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	codes, err := es.svc.ConfirmMFA(ctx, session, code)
+	if err != nil {
+		return codes, err
+	}
+
+	event := confirmMFAEvent{
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, confirmMFAStream, event); err != nil {
+		return codes, err
+	}
+
+	return codes, nil
+}
+
+func (es *eventStore) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	if err := es.svc.DisableMFA(ctx, session, code); err != nil {
+		return err
+	}
+
+	event := disableMFAEvent{
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	return es.Publish(ctx, disableMFAStream, event)
+}
+
 func (es *eventStore) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (*grpcTokenV1.Token, error) {
 	token, err := es.svc.RefreshToken(ctx, session, refreshToken)
 	if err != nil {
@@ -385,6 +459,41 @@ func (es *eventStore) RefreshToken(ctx context.Context, session authn.Session, r
 	return token, nil
 }
 
+func (es *eventStore) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error) {
+	sp, err := es.svc.ListSessions(ctx, session, pm)
+	if err != nil {
+		return sp, err
+	}
+
+	event := listSessionsEvent{
+		total:     sp.Total,
+		offset:    sp.Offset,
+		limit:     sp.Limit,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	if err := es.Publish(ctx, listSessionsStream, event); err != nil {
+		return sp, err
+	}
+
+	return sp, nil
+}
+
+func (es *eventStore) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	if err := es.svc.RevokeSession(ctx, session, id); err != nil {
+		return err
+	}
+
+	event := revokeSessionEvent{
+		id:        id,
+		Session:   session,
+		requestID: middleware.GetReqID(ctx),
+	}
+
+	return es.Publish(ctx, revokeSessionStream, event)
+}
+
 func (es *eventStore) ResetSecret(ctx context.Context, session authn.Session, secret string) error {
 	if err := es.svc.ResetSecret(ctx, session, secret); err != nil {
 		return err
@@ -442,3 +551,7 @@ func (es *eventStore) OAuthAddUserPolicy(ctx context.Context, user users.User) e
 
 	return es.Publish(ctx, addPolicyStream, event)
 }
+
+func (es *eventStore) OAuthMFAChallenge(ctx context.Context, user users.User) (users.MFAChallenge, error) {
+	return es.svc.OAuthMFAChallenge(ctx, user)
+}
@@ -901,14 +901,21 @@ func TestIssueToken(t *testing.T) {
 		AccessToken: "validAccessToken",
 	}
 
+	challenge := users.MFAChallenge{
+		ID:        testsutil.GenerateUUID(t),
+		UserID:    validUser.ID,
+		ExpiresAt: time.Now().Add(users.MFAChallengeDuration),
+	}
+
 	cases := []struct {
-		desc     string
-		username string
-		secret   string
-		svcRes   *grpcTokenV1.Token
-		svcErr   error
-		resp     *grpcTokenV1.Token
-		err      error
+		desc      string
+		username  string
+		secret    string
+		svcRes    *grpcTokenV1.Token
+		challenge users.MFAChallenge
+		svcErr    error
+		resp      *grpcTokenV1.Token
+		err       error
 	}{
 		{
 			desc:     "publish successfully",
@@ -919,6 +926,16 @@ func TestIssueToken(t *testing.T) {
 			resp:     validToken,
 			err:      nil,
 		},
+		{
+			desc:      "publish successfully with MFA challenge",
+			username:  validUser.Credentials.Username,
+			secret:    validUser.Credentials.Secret,
+			svcRes:    &grpcTokenV1.Token{},
+			challenge: challenge,
+			svcErr:    nil,
+			resp:      &grpcTokenV1.Token{},
+			err:       nil,
+		},
 		{
 			desc:     "failed to publish with service error",
 			username: validUser.Credentials.Username,
@@ -932,8 +949,55 @@ func TestIssueToken(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			svcCall := svc.On("IssueToken", validCtx, tc.username, tc.secret).Return(tc.svcRes, tc.svcErr)
-			resp, err := nsvc.IssueToken(validCtx, tc.username, tc.secret)
+			svcCall := svc.On("IssueToken", validCtx, tc.username, tc.secret).Return(tc.svcRes, tc.challenge, tc.svcErr)
+			resp, challenge, err := nsvc.IssueToken(validCtx, tc.username, tc.secret)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			assert.Equal(t, tc.challenge, challenge, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.challenge, challenge))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestVerifyMFA(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+	validToken := &grpcTokenV1.Token{
+		AccessToken: "validAccessToken",
+	}
+	challengeID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc   string
+		code   string
+		svcRes *grpcTokenV1.Token
+		svcErr error
+		resp   *grpcTokenV1.Token
+		err    error
+	}{
+		{
+			desc:   "publish successfully",
+			code:   "123456",
+			svcRes: validToken,
+			svcErr: nil,
+			resp:   validToken,
+			err:    nil,
+		},
+		{
+			desc:   "failed to publish with service error",
+			code:   "000000",
+			svcRes: nil,
+			svcErr: users.ErrInvalidMFACode,
+			resp:   nil,
+			err:    users.ErrInvalidMFACode,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("VerifyMFA", validCtx, challengeID, tc.code).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.VerifyMFA(validCtx, challengeID, tc.code)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
 			svcCall.Unset()
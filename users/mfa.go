@@ -0,0 +1,127 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+)
+
+const (
+	// MFAChallengeDuration is the time within which the MFA challenge
+	// issued on login has to be answered.
+	MFAChallengeDuration = 5 * time.Minute
+
+	// mfaChallengeAttempts is the number of the wrong codes after which the
+	// MFA challenge is discarded and the user has to log in again.
+	mfaChallengeAttempts = 5
+
+	// mfaChallengesLimit is the number of the unexpired MFA challenges of the
+	// user after which no new challenge is issued on login.
+	mfaChallengesLimit = 5
+
+	recoveryCodesCount = 10
+	recoveryCodeSize   = 5
+)
+
+var (
+	// ErrInvalidMFACode indicates that the provided TOTP or recovery code is not valid.
+	ErrInvalidMFACode = errors.NewAuthNError("invalid MFA code")
+
+	// ErrMFANotEnabled indicates that the user has not enabled MFA.
+	ErrMFANotEnabled = errors.NewRequestError("MFA is not enabled")
+
+	// ErrMFAEnabled indicates that the user has already enabled MFA.
+	ErrMFAEnabled = errors.NewRequestError("MFA is already enabled")
+
+	// ErrMFANotEnrolled indicates that the user has not started the MFA enrolment.
+	ErrMFANotEnrolled = errors.NewRequestError("MFA enrolment is not started")
+
+	// ErrMFAChallengeExpired indicates that the MFA challenge expired or was
+	// answered with too many wrong codes.
+	ErrMFAChallengeExpired = errors.NewAuthNError("MFA challenge expired")
+
+	// ErrMFAChallengesLimit indicates that the user has too many unanswered
+	// MFA challenges, so no new one is issued until they expire.
+	ErrMFAChallengesLimit = errors.NewAuthNError("too many MFA challenges")
+
+	errRecoveryCodes = errors.NewServiceError("failed to generate MFA recovery codes")
+
+	recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// MFA represents the user's time-based one-time password (TOTP) multi-factor
+// authentication settings.
+type MFA struct {
+	UserID string
+	Secret string
+	// RecoveryCodes contains the hashes of the unused one-time recovery codes.
+	RecoveryCodes []string
+	// LastStep is the time step of the last accepted TOTP code. Codes of the
+	// same or earlier steps are rejected, so the code can't be replayed.
+	LastStep  uint64
+	CreatedAt time.Time
+	EnabledAt time.Time
+}
+
+// Enabled returns true if the MFA enrolment is confirmed.
+func (m MFA) Enabled() bool {
+	return !m.EnabledAt.IsZero()
+}
+
+// MFAEnrolment contains the secret of the started MFA enrolment and its
+// provisioning URI, which is rendered as QR code and scanned by the
+// authenticator app.
+type MFAEnrolment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// MFAChallenge is issued instead of the token on login of the user with MFA
+// enabled. The token is issued once the challenge is answered with a valid code.
+type MFAChallenge struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"-"`
+	Attempts  uint      `json:"-"`
+	CreatedAt time.Time `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// TOTP specifies an API for the time-based one-time passwords.
+type TOTP interface {
+	// GenerateSecret generates a new random secret.
+	GenerateSecret() (string, error)
+
+	// URI returns the provisioning URI of the secret for the given account.
+	URI(account, secret string) string
+
+	// Validate validates the code against the secret at the current time and
+	// returns the time step the code belongs to.
+	Validate(secret, code string) (uint64, error)
+}
+
+// newRecoveryCodes generates the random one-time recovery codes.
+func newRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodesCount)
+	for i := range codes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.Wrap(errRecoveryCodes, err)
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		codes[i] = code[:4] + "-" + code[4:]
+	}
+
+	return codes, nil
+}
+
+// normalizeRecoveryCode strips the separators and the case of the recovery
+// code, so it can be typed in either way.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
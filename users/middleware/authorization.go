@@ -161,14 +161,38 @@ func (am *authorizationMiddleware) Identify(ctx context.Context, session authn.S
 	return am.svc.Identify(ctx, session)
 }
 
-func (am *authorizationMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, error) {
+func (am *authorizationMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, users.MFAChallenge, error) {
 	return am.svc.IssueToken(ctx, username, secret)
 }
 
+func (am *authorizationMiddleware) VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error) {
+	return am.svc.VerifyMFA(ctx, challengeID, code)
+}
+
+func (am *authorizationMiddleware) EnrollMFA(ctx context.Context, session authn.Session) (users.MFAEnrolment, error) {
+	return am.svc.EnrollMFA(ctx, session)
+}
+
+func (am *authorizationMiddleware) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	return am.svc.ConfirmMFA(ctx, session, code)
+}
+
+func (am *authorizationMiddleware) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	return am.svc.DisableMFA(ctx, session, code)
+}
+
 func (am *authorizationMiddleware) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (*grpcTokenV1.Token, error) {
 	return am.svc.RefreshToken(ctx, session, refreshToken)
 }
 
+func (am *authorizationMiddleware) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error) {
+	return am.svc.ListSessions(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	return am.svc.RevokeSession(ctx, session, id)
+}
+
 func (am *authorizationMiddleware) OAuthCallback(ctx context.Context, user users.User) (users.User, error) {
 	return am.svc.OAuthCallback(ctx, user)
 }
@@ -180,6 +204,10 @@ func (am *authorizationMiddleware) OAuthAddUserPolicy(ctx context.Context, user
 	return am.svc.OAuthAddUserPolicy(ctx, user)
 }
 
+func (am *authorizationMiddleware) OAuthMFAChallenge(ctx context.Context, user users.User) (users.MFAChallenge, error) {
+	return am.svc.OAuthMFAChallenge(ctx, user)
+}
+
 func (am *authorizationMiddleware) checkSuperAdmin(ctx context.Context, session authn.Session) error {
 	if session.Role != authn.AdminRole {
 		return svcerr.ErrSuperAdminAction
@@ -89,7 +89,7 @@ func (lm *loggingMiddleware) VerifyEmail(ctx context.Context, verificationToken
 
 // IssueToken logs the issue_token request. It logs the username type and the time it took to complete the request.
 // If the request fails, it logs the error.
-func (lm *loggingMiddleware) IssueToken(ctx context.Context, username, secret string) (t *grpcTokenV1.Token, err error) {
+func (lm *loggingMiddleware) IssueToken(ctx context.Context, username, secret string) (t *grpcTokenV1.Token, c users.MFAChallenge, err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -98,6 +98,9 @@ func (lm *loggingMiddleware) IssueToken(ctx context.Context, username, secret st
 		if t.AccessType != "" {
 			args = append(args, slog.String("access_type", t.AccessType))
 		}
+		if c.ID != "" {
+			args = append(args, slog.String("mfa_challenge_id", c.ID))
+		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
 			lm.logger.Warn("Issue token failed", args...)
@@ -108,6 +111,79 @@ func (lm *loggingMiddleware) IssueToken(ctx context.Context, username, secret st
 	return lm.svc.IssueToken(ctx, username, secret)
 }
 
+// VerifyMFA logs the verify_mfa request. It logs the challenge id and the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) VerifyMFA(ctx context.Context, challengeID, code string) (t *grpcTokenV1.Token, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("mfa_challenge_id", challengeID),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Verify MFA failed", args...)
+			return
+		}
+		lm.logger.Info("Verify MFA completed successfully", args...)
+	}(time.Now())
+	return lm.svc.VerifyMFA(ctx, challengeID, code)
+}
+
+// EnrollMFA logs the enroll_mfa request. It logs the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) EnrollMFA(ctx context.Context, session authn.Session) (e users.MFAEnrolment, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Enroll MFA failed", args...)
+			return
+		}
+		lm.logger.Info("Enroll MFA completed successfully", args...)
+	}(time.Now())
+	return lm.svc.EnrollMFA(ctx, session)
+}
+
+// ConfirmMFA logs the confirm_mfa request. It logs the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) ConfirmMFA(ctx context.Context, session authn.Session, code string) (codes []string, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Confirm MFA failed", args...)
+			return
+		}
+		lm.logger.Info("Confirm MFA completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ConfirmMFA(ctx, session, code)
+}
+
+// DisableMFA logs the disable_mfa request. It logs the time it took to complete the request.
+// If the request fails, it logs the error.
+func (lm *loggingMiddleware) DisableMFA(ctx context.Context, session authn.Session, code string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Disable MFA failed", args...)
+			return
+		}
+		lm.logger.Info("Disable MFA completed successfully", args...)
+	}(time.Now())
+	return lm.svc.DisableMFA(ctx, session, code)
+}
+
 // RefreshToken logs the refresh_token request. It logs the refreshtoken, token type and the time it took to complete the request.
 // If the request fails, it logs the error.
 func (lm *loggingMiddleware) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (t *grpcTokenV1.Token, err error) {
@@ -129,6 +205,46 @@ func (lm *loggingMiddleware) RefreshToken(ctx context.Context, session authn.Ses
 	return lm.svc.RefreshToken(ctx, session, refreshToken)
 }
 
+// ListSessions logs the list_sessions request. It logs the page metadata and the time it took to complete the request.
+func (lm *loggingMiddleware) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (sp users.LoginSessionsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("page",
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("total", sp.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List sessions failed", args...)
+			return
+		}
+		lm.logger.Info("List sessions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListSessions(ctx, session, pm)
+}
+
+// RevokeSession logs the revoke_session request. It logs the session id and the time it took to complete the request.
+func (lm *loggingMiddleware) RevokeSession(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("session_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Revoke session failed", args...)
+			return
+		}
+		lm.logger.Info("Revoke session completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RevokeSession(ctx, session, id)
+}
+
 // View logs the view_user request. It logs the user id and the time it took to complete the request.
 // If the request fails, it logs the error.
 func (lm *loggingMiddleware) View(ctx context.Context, session authn.Session, id string) (c users.User, err error) {
@@ -521,3 +637,22 @@ func (lm *loggingMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.
 	}(time.Now())
 	return lm.svc.OAuthAddUserPolicy(ctx, user)
 }
+
+// OAuthMFAChallenge logs the oauth_mfa_challenge request. It logs the user id and the time it took to complete the request.
+func (lm *loggingMiddleware) OAuthMFAChallenge(ctx context.Context, user users.User) (challenge users.MFAChallenge, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("user_id", user.ID),
+			slog.Bool("mfa_required", challenge.ID != ""),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("OAuth MFA challenge failed", args...)
+			return
+		}
+		lm.logger.Info("OAuth MFA challenge completed successfully", args...)
+	}(time.Now())
+	return lm.svc.OAuthMFAChallenge(ctx, user)
+}
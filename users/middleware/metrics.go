@@ -58,7 +58,7 @@ func (ms *metricsMiddleware) VerifyEmail(ctx context.Context, verificationToken
 }
 
 // IssueToken instruments IssueToken method with metrics.
-func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, error) {
+func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, users.MFAChallenge, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "issue_token").Add(1)
 		ms.latency.With("method", "issue_token").Observe(time.Since(begin).Seconds())
@@ -66,6 +66,42 @@ func (ms *metricsMiddleware) IssueToken(ctx context.Context, username, secret st
 	return ms.svc.IssueToken(ctx, username, secret)
 }
 
+// VerifyMFA instruments VerifyMFA method with metrics.
+func (ms *metricsMiddleware) VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "verify_mfa").Add(1)
+		ms.latency.With("method", "verify_mfa").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.VerifyMFA(ctx, challengeID, code)
+}
+
+// EnrollMFA instruments EnrollMFA method with metrics.
+func (ms *metricsMiddleware) EnrollMFA(ctx context.Context, session authn.Session) (users.MFAEnrolment, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "enroll_mfa").Add(1)
+		ms.latency.With("method", "enroll_mfa").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.EnrollMFA(ctx, session)
+}
+
+// ConfirmMFA instruments ConfirmMFA method with metrics.
+func (ms *metricsMiddleware) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "confirm_mfa").Add(1)
+		ms.latency.With("method", "confirm_mfa").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ConfirmMFA(ctx, session, code)
+}
+
+// DisableMFA instruments DisableMFA method with metrics.
+func (ms *metricsMiddleware) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "disable_mfa").Add(1)
+		ms.latency.With("method", "disable_mfa").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.DisableMFA(ctx, session, code)
+}
+
 // RefreshToken instruments RefreshToken method with metrics.
 func (ms *metricsMiddleware) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (token *grpcTokenV1.Token, err error) {
 	defer func(begin time.Time) {
@@ -75,6 +111,24 @@ func (ms *metricsMiddleware) RefreshToken(ctx context.Context, session authn.Ses
 	return ms.svc.RefreshToken(ctx, session, refreshToken)
 }
 
+// ListSessions instruments ListSessions method with metrics.
+func (ms *metricsMiddleware) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_sessions").Add(1)
+		ms.latency.With("method", "list_sessions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.ListSessions(ctx, session, pm)
+}
+
+// RevokeSession instruments RevokeSession method with metrics.
+func (ms *metricsMiddleware) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_session").Add(1)
+		ms.latency.With("method", "revoke_session").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RevokeSession(ctx, session, id)
+}
+
 // View instruments View method with metrics.
 func (ms *metricsMiddleware) View(ctx context.Context, session authn.Session, id string) (users.User, error) {
 	defer func(begin time.Time) {
@@ -245,3 +299,12 @@ func (ms *metricsMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.
 	}(time.Now())
 	return ms.svc.OAuthAddUserPolicy(ctx, user)
 }
+
+// OAuthMFAChallenge instruments OAuthMFAChallenge method with metrics.
+func (ms *metricsMiddleware) OAuthMFAChallenge(ctx context.Context, user users.User) (users.MFAChallenge, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "oauth_mfa_challenge").Add(1)
+		ms.latency.With("method", "oauth_mfa_challenge").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.OAuthMFAChallenge(ctx, user)
+}
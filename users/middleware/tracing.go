@@ -50,13 +50,45 @@ func (tm *tracingMiddleware) VerifyEmail(ctx context.Context, verificationToken
 }
 
 // IssueToken traces the "IssueToken" operation of the wrapped users.Service.
-func (tm *tracingMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, error) {
+func (tm *tracingMiddleware) IssueToken(ctx context.Context, username, secret string) (*grpcTokenV1.Token, users.MFAChallenge, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_issue_token", trace.WithAttributes(attribute.String("username", username)))
 	defer span.End()
 
 	return tm.svc.IssueToken(ctx, username, secret)
 }
 
+// VerifyMFA traces the "VerifyMFA" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_verify_mfa", trace.WithAttributes(attribute.String("challenge_id", challengeID)))
+	defer span.End()
+
+	return tm.svc.VerifyMFA(ctx, challengeID, code)
+}
+
+// EnrollMFA traces the "EnrollMFA" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) EnrollMFA(ctx context.Context, session authn.Session) (users.MFAEnrolment, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_enroll_mfa")
+	defer span.End()
+
+	return tm.svc.EnrollMFA(ctx, session)
+}
+
+// ConfirmMFA traces the "ConfirmMFA" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_confirm_mfa")
+	defer span.End()
+
+	return tm.svc.ConfirmMFA(ctx, session, code)
+}
+
+// DisableMFA traces the "DisableMFA" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_disable_mfa")
+	defer span.End()
+
+	return tm.svc.DisableMFA(ctx, session, code)
+}
+
 // RefreshToken traces the "RefreshToken" operation of the wrapped users.Service.
 func (tm *tracingMiddleware) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (*grpcTokenV1.Token, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_refresh_token", trace.WithAttributes(attribute.String("refresh_token", refreshToken)))
@@ -65,6 +97,25 @@ func (tm *tracingMiddleware) RefreshToken(ctx context.Context, session authn.Ses
 	return tm.svc.RefreshToken(ctx, session, refreshToken)
 }
 
+// ListSessions traces the "ListSessions" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_list_sessions", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListSessions(ctx, session, pm)
+}
+
+// RevokeSession traces the "RevokeSession" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_revoke_session", trace.WithAttributes(attribute.String("id", id)))
+	defer span.End()
+
+	return tm.svc.RevokeSession(ctx, session, id)
+}
+
 // View traces the "View" operation of the wrapped users.Service.
 func (tm *tracingMiddleware) View(ctx context.Context, session authn.Session, id string) (users.User, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_view_user", trace.WithAttributes(attribute.String("id", id)))
@@ -248,3 +299,13 @@ func (tm *tracingMiddleware) OAuthAddUserPolicy(ctx context.Context, user users.
 
 	return tm.svc.OAuthAddUserPolicy(ctx, user)
 }
+
+// OAuthMFAChallenge traces the "OAuthMFAChallenge" operation of the wrapped users.Service.
+func (tm *tracingMiddleware) OAuthMFAChallenge(ctx context.Context, user users.User) (users.MFAChallenge, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_oauth_mfa_challenge", trace.WithAttributes(
+		attribute.String("id", user.ID),
+	))
+	defer span.End()
+
+	return tm.svc.OAuthMFAChallenge(ctx, user)
+}
@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/supermq/users"
 	mock "github.com/stretchr/testify/mock"
@@ -222,6 +223,78 @@ func (_c *Repository_CheckSuperAdmin_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// CountMFAChallenges provides a mock function for the type Repository
+func (_mock *Repository) CountMFAChallenges(ctx context.Context, userID string, expiresAfter time.Time) (uint64, error) {
+	ret := _mock.Called(ctx, userID, expiresAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountMFAChallenges")
+	}
+
+	var r0 uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (uint64, error)); ok {
+		return returnFunc(ctx, userID, expiresAfter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) uint64); ok {
+		r0 = returnFunc(ctx, userID, expiresAfter)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, expiresAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CountMFAChallenges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMFAChallenges'
+type Repository_CountMFAChallenges_Call struct {
+	*mock.Call
+}
+
+// CountMFAChallenges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - expiresAfter time.Time
+func (_e *Repository_Expecter) CountMFAChallenges(ctx interface{}, userID interface{}, expiresAfter interface{}) *Repository_CountMFAChallenges_Call {
+	return &Repository_CountMFAChallenges_Call{Call: _e.mock.On("CountMFAChallenges", ctx, userID, expiresAfter)}
+}
+
+func (_c *Repository_CountMFAChallenges_Call) Run(run func(ctx context.Context, userID string, expiresAfter time.Time)) *Repository_CountMFAChallenges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CountMFAChallenges_Call) Return(v uint64, err error) *Repository_CountMFAChallenges_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *Repository_CountMFAChallenges_Call) RunAndReturn(run func(ctx context.Context, userID string, expiresAfter time.Time) (uint64, error)) *Repository_CountMFAChallenges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type Repository
 func (_mock *Repository) Delete(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
@@ -279,6 +352,183 @@ func (_c *Repository_Delete_Call) RunAndReturn(run func(ctx context.Context, id
 	return _c
 }
 
+// IncrementMFAChallengeAttempts provides a mock function for the type Repository
+func (_mock *Repository) IncrementMFAChallengeAttempts(ctx context.Context, id string, limit uint) error {
+	ret := _mock.Called(ctx, id, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementMFAChallengeAttempts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint) error); ok {
+		r0 = returnFunc(ctx, id, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_IncrementMFAChallengeAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementMFAChallengeAttempts'
+type Repository_IncrementMFAChallengeAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementMFAChallengeAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - limit uint
+func (_e *Repository_Expecter) IncrementMFAChallengeAttempts(ctx interface{}, id interface{}, limit interface{}) *Repository_IncrementMFAChallengeAttempts_Call {
+	return &Repository_IncrementMFAChallengeAttempts_Call{Call: _e.mock.On("IncrementMFAChallengeAttempts", ctx, id, limit)}
+}
+
+func (_c *Repository_IncrementMFAChallengeAttempts_Call) Run(run func(ctx context.Context, id string, limit uint)) *Repository_IncrementMFAChallengeAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint
+		if args[2] != nil {
+			arg2 = args[2].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_IncrementMFAChallengeAttempts_Call) Return(err error) *Repository_IncrementMFAChallengeAttempts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_IncrementMFAChallengeAttempts_Call) RunAndReturn(run func(ctx context.Context, id string, limit uint) error) *Repository_IncrementMFAChallengeAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMFA provides a mock function for the type Repository
+func (_mock *Repository) RemoveMFA(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMFA'
+type Repository_RemoveMFA_Call struct {
+	*mock.Call
+}
+
+// RemoveMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Repository_Expecter) RemoveMFA(ctx interface{}, userID interface{}) *Repository_RemoveMFA_Call {
+	return &Repository_RemoveMFA_Call{Call: _e.mock.On("RemoveMFA", ctx, userID)}
+}
+
+func (_c *Repository_RemoveMFA_Call) Run(run func(ctx context.Context, userID string)) *Repository_RemoveMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveMFA_Call) Return(err error) *Repository_RemoveMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *Repository_RemoveMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMFAChallenge provides a mock function for the type Repository
+func (_mock *Repository) RemoveMFAChallenge(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMFAChallenge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMFAChallenge'
+type Repository_RemoveMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// RemoveMFAChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) RemoveMFAChallenge(ctx interface{}, id interface{}) *Repository_RemoveMFAChallenge_Call {
+	return &Repository_RemoveMFAChallenge_Call{Call: _e.mock.On("RemoveMFAChallenge", ctx, id)}
+}
+
+func (_c *Repository_RemoveMFAChallenge_Call) Run(run func(ctx context.Context, id string)) *Repository_RemoveMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveMFAChallenge_Call) Return(err error) *Repository_RemoveMFAChallenge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, id string) error) *Repository_RemoveMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAll provides a mock function for the type Repository
 func (_mock *Repository) RetrieveAll(ctx context.Context, pm users.Page) (users.UsersPage, error) {
 	ret := _mock.Called(ctx, pm)
@@ -609,6 +859,138 @@ func (_c *Repository_RetrieveByUsername_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// RetrieveMFA provides a mock function for the type Repository
+func (_mock *Repository) RetrieveMFA(ctx context.Context, userID string) (users.MFA, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveMFA")
+	}
+
+	var r0 users.MFA
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (users.MFA, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) users.MFA); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(users.MFA)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveMFA'
+type Repository_RetrieveMFA_Call struct {
+	*mock.Call
+}
+
+// RetrieveMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *Repository_Expecter) RetrieveMFA(ctx interface{}, userID interface{}) *Repository_RetrieveMFA_Call {
+	return &Repository_RetrieveMFA_Call{Call: _e.mock.On("RetrieveMFA", ctx, userID)}
+}
+
+func (_c *Repository_RetrieveMFA_Call) Run(run func(ctx context.Context, userID string)) *Repository_RetrieveMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveMFA_Call) Return(mFA users.MFA, err error) *Repository_RetrieveMFA_Call {
+	_c.Call.Return(mFA, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveMFA_Call) RunAndReturn(run func(ctx context.Context, userID string) (users.MFA, error)) *Repository_RetrieveMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveMFAChallenge provides a mock function for the type Repository
+func (_mock *Repository) RetrieveMFAChallenge(ctx context.Context, id string) (users.MFAChallenge, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveMFAChallenge")
+	}
+
+	var r0 users.MFAChallenge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (users.MFAChallenge, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) users.MFAChallenge); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(users.MFAChallenge)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveMFAChallenge'
+type Repository_RetrieveMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// RetrieveMFAChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) RetrieveMFAChallenge(ctx interface{}, id interface{}) *Repository_RetrieveMFAChallenge_Call {
+	return &Repository_RetrieveMFAChallenge_Call{Call: _e.mock.On("RetrieveMFAChallenge", ctx, id)}
+}
+
+func (_c *Repository_RetrieveMFAChallenge_Call) Run(run func(ctx context.Context, id string)) *Repository_RetrieveMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveMFAChallenge_Call) Return(mFAChallenge users.MFAChallenge, err error) *Repository_RetrieveMFAChallenge_Call {
+	_c.Call.Return(mFAChallenge, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, id string) (users.MFAChallenge, error)) *Repository_RetrieveMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveUserVerification provides a mock function for the type Repository
 func (_mock *Repository) RetrieveUserVerification(ctx context.Context, userID string, email string) (users.UserVerification, error) {
 	ret := _mock.Called(ctx, userID, email)
@@ -747,6 +1129,120 @@ func (_c *Repository_Save_Call) RunAndReturn(run func(ctx context.Context, user
 	return _c
 }
 
+// SaveMFA provides a mock function for the type Repository
+func (_mock *Repository) SaveMFA(ctx context.Context, mfa users.MFA) error {
+	ret := _mock.Called(ctx, mfa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, users.MFA) error); ok {
+		r0 = returnFunc(ctx, mfa)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SaveMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMFA'
+type Repository_SaveMFA_Call struct {
+	*mock.Call
+}
+
+// SaveMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfa users.MFA
+func (_e *Repository_Expecter) SaveMFA(ctx interface{}, mfa interface{}) *Repository_SaveMFA_Call {
+	return &Repository_SaveMFA_Call{Call: _e.mock.On("SaveMFA", ctx, mfa)}
+}
+
+func (_c *Repository_SaveMFA_Call) Run(run func(ctx context.Context, mfa users.MFA)) *Repository_SaveMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 users.MFA
+		if args[1] != nil {
+			arg1 = args[1].(users.MFA)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveMFA_Call) Return(err error) *Repository_SaveMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SaveMFA_Call) RunAndReturn(run func(ctx context.Context, mfa users.MFA) error) *Repository_SaveMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMFAChallenge provides a mock function for the type Repository
+func (_mock *Repository) SaveMFAChallenge(ctx context.Context, challenge users.MFAChallenge) error {
+	ret := _mock.Called(ctx, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMFAChallenge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, users.MFAChallenge) error); ok {
+		r0 = returnFunc(ctx, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SaveMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMFAChallenge'
+type Repository_SaveMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// SaveMFAChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge users.MFAChallenge
+func (_e *Repository_Expecter) SaveMFAChallenge(ctx interface{}, challenge interface{}) *Repository_SaveMFAChallenge_Call {
+	return &Repository_SaveMFAChallenge_Call{Call: _e.mock.On("SaveMFAChallenge", ctx, challenge)}
+}
+
+func (_c *Repository_SaveMFAChallenge_Call) Run(run func(ctx context.Context, challenge users.MFAChallenge)) *Repository_SaveMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 users.MFAChallenge
+		if args[1] != nil {
+			arg1 = args[1].(users.MFAChallenge)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveMFAChallenge_Call) Return(err error) *Repository_SaveMFAChallenge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SaveMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, challenge users.MFAChallenge) error) *Repository_SaveMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchUsers provides a mock function for the type Repository
 func (_mock *Repository) SearchUsers(ctx context.Context, pm users.Page) (users.UsersPage, error) {
 	ret := _mock.Called(ctx, pm)
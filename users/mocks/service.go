@@ -44,6 +44,80 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// ConfirmMFA provides a mock function for the type Service
+func (_mock *Service) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	ret := _mock.Called(ctx, session, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmMFA")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) ([]string, error)); ok {
+		return returnFunc(ctx, session, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) []string); ok {
+		r0 = returnFunc(ctx, session, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ConfirmMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmMFA'
+type Service_ConfirmMFA_Call struct {
+	*mock.Call
+}
+
+// ConfirmMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - code string
+func (_e *Service_Expecter) ConfirmMFA(ctx interface{}, session interface{}, code interface{}) *Service_ConfirmMFA_Call {
+	return &Service_ConfirmMFA_Call{Call: _e.mock.On("ConfirmMFA", ctx, session, code)}
+}
+
+func (_c *Service_ConfirmMFA_Call) Run(run func(ctx context.Context, session authn.Session, code string)) *Service_ConfirmMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ConfirmMFA_Call) Return(strings []string, err error) *Service_ConfirmMFA_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Service_ConfirmMFA_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, code string) ([]string, error)) *Service_ConfirmMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type Service
 func (_mock *Service) Delete(ctx context.Context, session authn.Session, id string) error {
 	ret := _mock.Called(ctx, session, id)
@@ -179,6 +253,69 @@ func (_c *Service_Disable_Call) RunAndReturn(run func(ctx context.Context, sessi
 	return _c
 }
 
+// DisableMFA provides a mock function for the type Service
+func (_mock *Service) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	ret := _mock.Called(ctx, session, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableMFA")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_DisableMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableMFA'
+type Service_DisableMFA_Call struct {
+	*mock.Call
+}
+
+// DisableMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - code string
+func (_e *Service_Expecter) DisableMFA(ctx interface{}, session interface{}, code interface{}) *Service_DisableMFA_Call {
+	return &Service_DisableMFA_Call{Call: _e.mock.On("DisableMFA", ctx, session, code)}
+}
+
+func (_c *Service_DisableMFA_Call) Run(run func(ctx context.Context, session authn.Session, code string)) *Service_DisableMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DisableMFA_Call) Return(err error) *Service_DisableMFA_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_DisableMFA_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, code string) error) *Service_DisableMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Enable provides a mock function for the type Service
 func (_mock *Service) Enable(ctx context.Context, session authn.Session, id string) (users.User, error) {
 	ret := _mock.Called(ctx, session, id)
@@ -251,6 +388,72 @@ func (_c *Service_Enable_Call) RunAndReturn(run func(ctx context.Context, sessio
 	return _c
 }
 
+// EnrollMFA provides a mock function for the type Service
+func (_mock *Service) EnrollMFA(ctx context.Context, session authn.Session) (users.MFAEnrolment, error) {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFA")
+	}
+
+	var r0 users.MFAEnrolment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session) (users.MFAEnrolment, error)); ok {
+		return returnFunc(ctx, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session) users.MFAEnrolment); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Get(0).(users.MFAEnrolment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session) error); ok {
+		r1 = returnFunc(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_EnrollMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFA'
+type Service_EnrollMFA_Call struct {
+	*mock.Call
+}
+
+// EnrollMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+func (_e *Service_Expecter) EnrollMFA(ctx interface{}, session interface{}) *Service_EnrollMFA_Call {
+	return &Service_EnrollMFA_Call{Call: _e.mock.On("EnrollMFA", ctx, session)}
+}
+
+func (_c *Service_EnrollMFA_Call) Run(run func(ctx context.Context, session authn.Session)) *Service_EnrollMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_EnrollMFA_Call) Return(mFAEnrolment users.MFAEnrolment, err error) *Service_EnrollMFA_Call {
+	_c.Call.Return(mFAEnrolment, err)
+	return _c
+}
+
+func (_c *Service_EnrollMFA_Call) RunAndReturn(run func(ctx context.Context, session authn.Session) (users.MFAEnrolment, error)) *Service_EnrollMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Identify provides a mock function for the type Service
 func (_mock *Service) Identify(ctx context.Context, session authn.Session) (string, error) {
 	ret := _mock.Called(ctx, session)
@@ -318,7 +521,7 @@ func (_c *Service_Identify_Call) RunAndReturn(run func(ctx context.Context, sess
 }
 
 // IssueToken provides a mock function for the type Service
-func (_mock *Service) IssueToken(ctx context.Context, identity string, secret string) (*v1.Token, error) {
+func (_mock *Service) IssueToken(ctx context.Context, identity string, secret string) (*v1.Token, users.MFAChallenge, error) {
 	ret := _mock.Called(ctx, identity, secret)
 
 	if len(ret) == 0 {
@@ -326,8 +529,9 @@ func (_mock *Service) IssueToken(ctx context.Context, identity string, secret st
 	}
 
 	var r0 *v1.Token
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*v1.Token, error)); ok {
+	var r1 users.MFAChallenge
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*v1.Token, users.MFAChallenge, error)); ok {
 		return returnFunc(ctx, identity, secret)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *v1.Token); ok {
@@ -337,12 +541,17 @@ func (_mock *Service) IssueToken(ctx context.Context, identity string, secret st
 			r0 = ret.Get(0).(*v1.Token)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) users.MFAChallenge); ok {
 		r1 = returnFunc(ctx, identity, secret)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(users.MFAChallenge)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, identity, secret)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // Service_IssueToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueToken'
@@ -381,12 +590,84 @@ func (_c *Service_IssueToken_Call) Run(run func(ctx context.Context, identity st
 	return _c
 }
 
-func (_c *Service_IssueToken_Call) Return(token *v1.Token, err error) *Service_IssueToken_Call {
-	_c.Call.Return(token, err)
+func (_c *Service_IssueToken_Call) Return(token *v1.Token, mFAChallenge users.MFAChallenge, err error) *Service_IssueToken_Call {
+	_c.Call.Return(token, mFAChallenge, err)
 	return _c
 }
 
-func (_c *Service_IssueToken_Call) RunAndReturn(run func(ctx context.Context, identity string, secret string) (*v1.Token, error)) *Service_IssueToken_Call {
+func (_c *Service_IssueToken_Call) RunAndReturn(run func(ctx context.Context, identity string, secret string) (*v1.Token, users.MFAChallenge, error)) *Service_IssueToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type Service
+func (_mock *Service) ListSessions(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 users.LoginSessionsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, users.Page) (users.LoginSessionsPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, users.Page) users.LoginSessionsPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(users.LoginSessionsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, users.Page) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type Service_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm users.Page
+func (_e *Service_Expecter) ListSessions(ctx interface{}, session interface{}, pm interface{}) *Service_ListSessions_Call {
+	return &Service_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, session, pm)}
+}
+
+func (_c *Service_ListSessions_Call) Run(run func(ctx context.Context, session authn.Session, pm users.Page)) *Service_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 users.Page
+		if args[2] != nil {
+			arg2 = args[2].(users.Page)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListSessions_Call) Return(loginSessionsPage users.LoginSessionsPage, err error) *Service_ListSessions_Call {
+	_c.Call.Return(loginSessionsPage, err)
+	return _c
+}
+
+func (_c *Service_ListSessions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm users.Page) (users.LoginSessionsPage, error)) *Service_ListSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -586,6 +867,72 @@ func (_c *Service_OAuthCallback_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// OAuthMFAChallenge provides a mock function for the type Service
+func (_mock *Service) OAuthMFAChallenge(ctx context.Context, user users.User) (users.MFAChallenge, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OAuthMFAChallenge")
+	}
+
+	var r0 users.MFAChallenge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, users.User) (users.MFAChallenge, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, users.User) users.MFAChallenge); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Get(0).(users.MFAChallenge)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, users.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_OAuthMFAChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OAuthMFAChallenge'
+type Service_OAuthMFAChallenge_Call struct {
+	*mock.Call
+}
+
+// OAuthMFAChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user users.User
+func (_e *Service_Expecter) OAuthMFAChallenge(ctx interface{}, user interface{}) *Service_OAuthMFAChallenge_Call {
+	return &Service_OAuthMFAChallenge_Call{Call: _e.mock.On("OAuthMFAChallenge", ctx, user)}
+}
+
+func (_c *Service_OAuthMFAChallenge_Call) Run(run func(ctx context.Context, user users.User)) *Service_OAuthMFAChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 users.User
+		if args[1] != nil {
+			arg1 = args[1].(users.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_OAuthMFAChallenge_Call) Return(mFAChallenge users.MFAChallenge, err error) *Service_OAuthMFAChallenge_Call {
+	_c.Call.Return(mFAChallenge, err)
+	return _c
+}
+
+func (_c *Service_OAuthMFAChallenge_Call) RunAndReturn(run func(ctx context.Context, user users.User) (users.MFAChallenge, error)) *Service_OAuthMFAChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshToken provides a mock function for the type Service
 func (_mock *Service) RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (*v1.Token, error) {
 	ret := _mock.Called(ctx, session, refreshToken)
@@ -801,6 +1148,69 @@ func (_c *Service_ResetSecret_Call) RunAndReturn(run func(ctx context.Context, s
 	return _c
 }
 
+// RevokeSession provides a mock function for the type Service
+func (_mock *Service) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type Service_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RevokeSession(ctx interface{}, session interface{}, id interface{}) *Service_RevokeSession_Call {
+	return &Service_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, session, id)}
+}
+
+func (_c *Service_RevokeSession_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RevokeSession_Call) Return(err error) *Service_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchUsers provides a mock function for the type Service
 func (_mock *Service) SearchUsers(ctx context.Context, pm users.Page) (users.UsersPage, error) {
 	ret := _mock.Called(ctx, pm)
@@ -1587,6 +1997,80 @@ func (_c *Service_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, v
 	return _c
 }
 
+// VerifyMFA provides a mock function for the type Service
+func (_mock *Service) VerifyMFA(ctx context.Context, challengeID string, code string) (*v1.Token, error) {
+	ret := _mock.Called(ctx, challengeID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFA")
+	}
+
+	var r0 *v1.Token
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*v1.Token, error)); ok {
+		return returnFunc(ctx, challengeID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *v1.Token); ok {
+		r0 = returnFunc(ctx, challengeID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Token)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, challengeID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_VerifyMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFA'
+type Service_VerifyMFA_Call struct {
+	*mock.Call
+}
+
+// VerifyMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeID string
+//   - code string
+func (_e *Service_Expecter) VerifyMFA(ctx interface{}, challengeID interface{}, code interface{}) *Service_VerifyMFA_Call {
+	return &Service_VerifyMFA_Call{Call: _e.mock.On("VerifyMFA", ctx, challengeID, code)}
+}
+
+func (_c *Service_VerifyMFA_Call) Run(run func(ctx context.Context, challengeID string, code string)) *Service_VerifyMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_VerifyMFA_Call) Return(token *v1.Token, err error) *Service_VerifyMFA_Call {
+	_c.Call.Return(token, err)
+	return _c
+}
+
+func (_c *Service_VerifyMFA_Call) RunAndReturn(run func(ctx context.Context, challengeID string, code string) (*v1.Token, error)) *Service_VerifyMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // View provides a mock function for the type Service
 func (_mock *Service) View(ctx context.Context, session authn.Session, id string) (users.User, error) {
 	ret := _mock.Called(ctx, session, id)
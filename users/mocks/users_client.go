@@ -0,0 +1,210 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/supermq/api/grpc/users/v1"
+	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+// NewUsersServiceClient creates a new instance of UsersServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsersServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UsersServiceClient {
+	mock := &UsersServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// UsersServiceClient is an autogenerated mock type for the UsersServiceClient type
+type UsersServiceClient struct {
+	mock.Mock
+}
+
+type UsersServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UsersServiceClient) EXPECT() *UsersServiceClient_Expecter {
+	return &UsersServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// ProvisionUser provides a mock function for the type UsersServiceClient
+func (_mock *UsersServiceClient) ProvisionUser(ctx context.Context, in *v1.ProvisionUserReq, opts ...grpc.CallOption) (*v1.ProvisionUserRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionUser")
+	}
+
+	var r0 *v1.ProvisionUserRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ProvisionUserReq, ...grpc.CallOption) (*v1.ProvisionUserRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ProvisionUserReq, ...grpc.CallOption) *v1.ProvisionUserRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ProvisionUserRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ProvisionUserReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UsersServiceClient_ProvisionUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProvisionUser'
+type UsersServiceClient_ProvisionUser_Call struct {
+	*mock.Call
+}
+
+// ProvisionUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ProvisionUserReq
+//   - opts ...grpc.CallOption
+func (_e *UsersServiceClient_Expecter) ProvisionUser(ctx interface{}, in interface{}, opts ...interface{}) *UsersServiceClient_ProvisionUser_Call {
+	return &UsersServiceClient_ProvisionUser_Call{Call: _e.mock.On("ProvisionUser",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UsersServiceClient_ProvisionUser_Call) Run(run func(ctx context.Context, in *v1.ProvisionUserReq, opts ...grpc.CallOption)) *UsersServiceClient_ProvisionUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ProvisionUserReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ProvisionUserReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *UsersServiceClient_ProvisionUser_Call) Return(provisionUserRes *v1.ProvisionUserRes, err error) *UsersServiceClient_ProvisionUser_Call {
+	_c.Call.Return(provisionUserRes, err)
+	return _c
+}
+
+func (_c *UsersServiceClient_ProvisionUser_Call) RunAndReturn(run func(ctx context.Context, in *v1.ProvisionUserReq, opts ...grpc.CallOption) (*v1.ProvisionUserRes, error)) *UsersServiceClient_ProvisionUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveUsers provides a mock function for the type UsersServiceClient
+func (_mock *UsersServiceClient) RetrieveUsers(ctx context.Context, in *v1.RetrieveUsersReq, opts ...grpc.CallOption) (*v1.RetrieveUsersRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveUsers")
+	}
+
+	var r0 *v1.RetrieveUsersRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveUsersReq, ...grpc.CallOption) (*v1.RetrieveUsersRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveUsersReq, ...grpc.CallOption) *v1.RetrieveUsersRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RetrieveUsersRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RetrieveUsersReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UsersServiceClient_RetrieveUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveUsers'
+type UsersServiceClient_RetrieveUsers_Call struct {
+	*mock.Call
+}
+
+// RetrieveUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RetrieveUsersReq
+//   - opts ...grpc.CallOption
+func (_e *UsersServiceClient_Expecter) RetrieveUsers(ctx interface{}, in interface{}, opts ...interface{}) *UsersServiceClient_RetrieveUsers_Call {
+	return &UsersServiceClient_RetrieveUsers_Call{Call: _e.mock.On("RetrieveUsers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *UsersServiceClient_RetrieveUsers_Call) Run(run func(ctx context.Context, in *v1.RetrieveUsersReq, opts ...grpc.CallOption)) *UsersServiceClient_RetrieveUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.RetrieveUsersReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.RetrieveUsersReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *UsersServiceClient_RetrieveUsers_Call) Return(retrieveUsersRes *v1.RetrieveUsersRes, err error) *UsersServiceClient_RetrieveUsers_Call {
+	_c.Call.Return(retrieveUsersRes, err)
+	return _c
+}
+
+func (_c *UsersServiceClient_RetrieveUsers_Call) RunAndReturn(run func(ctx context.Context, in *v1.RetrieveUsersReq, opts ...grpc.CallOption) (*v1.RetrieveUsersRes, error)) *UsersServiceClient_RetrieveUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -145,6 +145,33 @@ func Migration() *migrate.MemoryMigrationSource {
 					`ALTER TABLE users DROP COLUMN private_metadata;`,
 				},
 			},
+			{
+				Id: "clients_11",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS users_mfa (
+						user_id         VARCHAR(36) PRIMARY KEY,
+						secret          TEXT NOT NULL,
+						recovery_codes  TEXT[],
+						last_step       BIGINT NOT NULL DEFAULT 0,
+						created_at      TIMESTAMPTZ NOT NULL,
+						enabled_at      TIMESTAMPTZ,
+						FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+					)`,
+					`CREATE TABLE IF NOT EXISTS users_mfa_challenges (
+						id          VARCHAR(36) PRIMARY KEY,
+						user_id     VARCHAR(36) NOT NULL,
+						attempts    SMALLINT NOT NULL DEFAULT 0,
+						created_at  TIMESTAMPTZ NOT NULL,
+						expires_at  TIMESTAMPTZ NOT NULL,
+						FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+					)`,
+					`CREATE INDEX IF NOT EXISTS users_mfa_challenges_user_id_idx ON users_mfa_challenges (user_id, expires_at)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS users_mfa_challenges`,
+					`DROP TABLE IF EXISTS users_mfa`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,215 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/users"
+	"github.com/jackc/pgtype"
+)
+
+func (repo *userRepo) SaveMFA(ctx context.Context, mfa users.MFA) error {
+	q := `INSERT INTO users_mfa (user_id, secret, recovery_codes, last_step, created_at, enabled_at)
+		VALUES (:user_id, :secret, :recovery_codes, :last_step, :created_at, :enabled_at)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, recovery_codes = EXCLUDED.recovery_codes,
+		last_step = EXCLUDED.last_step, created_at = EXCLUDED.created_at, enabled_at = EXCLUDED.enabled_at`
+
+	dbm, err := toDBMFA(mfa)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if _, err := repo.Repository.DB.NamedExecContext(ctx, q, dbm); err != nil {
+		return repo.eh.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *userRepo) RetrieveMFA(ctx context.Context, userID string) (users.MFA, error) {
+	q := `SELECT user_id, secret, recovery_codes, last_step, created_at, enabled_at FROM users_mfa WHERE user_id = :user_id`
+
+	dbm := dbMFA{UserID: userID}
+	rows, err := repo.Repository.DB.NamedQueryContext(ctx, q, dbm)
+	if err != nil {
+		return users.MFA{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return users.MFA{}, repoerr.ErrNotFound
+	}
+	if err := rows.StructScan(&dbm); err != nil {
+		return users.MFA{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toMFA(dbm), nil
+}
+
+func (repo *userRepo) RemoveMFA(ctx context.Context, userID string) error {
+	q := `DELETE FROM users_mfa WHERE user_id = $1`
+
+	res, err := repo.Repository.DB.ExecContext(ctx, q, userID)
+	if err != nil {
+		return repo.eh.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *userRepo) SaveMFAChallenge(ctx context.Context, challenge users.MFAChallenge) error {
+	q := `INSERT INTO users_mfa_challenges (id, user_id, attempts, created_at, expires_at)
+		VALUES (:id, :user_id, :attempts, :created_at, :expires_at)`
+
+	if _, err := repo.Repository.DB.NamedExecContext(ctx, q, toDBMFAChallenge(challenge)); err != nil {
+		return repo.eh.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *userRepo) RetrieveMFAChallenge(ctx context.Context, id string) (users.MFAChallenge, error) {
+	q := `SELECT id, user_id, attempts, created_at, expires_at FROM users_mfa_challenges WHERE id = :id`
+
+	dbc := dbMFAChallenge{ID: id}
+	rows, err := repo.Repository.DB.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return users.MFAChallenge{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return users.MFAChallenge{}, repoerr.ErrNotFound
+	}
+	if err := rows.StructScan(&dbc); err != nil {
+		return users.MFAChallenge{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toMFAChallenge(dbc), nil
+}
+
+func (repo *userRepo) CountMFAChallenges(ctx context.Context, userID string, expiresAfter time.Time) (uint64, error) {
+	q := `SELECT COUNT(*) FROM users_mfa_challenges WHERE user_id = $1 AND expires_at > $2`
+
+	var count uint64
+	if err := repo.Repository.DB.QueryRowxContext(ctx, q, userID, expiresAfter).Scan(&count); err != nil {
+		return 0, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return count, nil
+}
+
+// IncrementMFAChallengeAttempts increments the attempts in a single conditional
+// update, so the parallel attempts can't get past the limit.
+func (repo *userRepo) IncrementMFAChallengeAttempts(ctx context.Context, id string, limit uint) error {
+	q := `UPDATE users_mfa_challenges SET attempts = attempts + 1 WHERE id = $1 AND attempts < $2`
+
+	res, err := repo.Repository.DB.ExecContext(ctx, q, id, int(limit))
+	if err != nil {
+		return repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *userRepo) RemoveMFAChallenge(ctx context.Context, id string) error {
+	q := `DELETE FROM users_mfa_challenges WHERE id = $1`
+
+	res, err := repo.Repository.DB.ExecContext(ctx, q, id)
+	if err != nil {
+		return repo.eh.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+type dbMFA struct {
+	UserID        string           `db:"user_id"`
+	Secret        string           `db:"secret"`
+	RecoveryCodes pgtype.TextArray `db:"recovery_codes"`
+	LastStep      int64            `db:"last_step"`
+	CreatedAt     time.Time        `db:"created_at"`
+	EnabledAt     sql.NullTime     `db:"enabled_at"`
+}
+
+func toDBMFA(mfa users.MFA) (dbMFA, error) {
+	var codes pgtype.TextArray
+	if err := codes.Set(mfa.RecoveryCodes); err != nil {
+		return dbMFA{}, err
+	}
+	var enabledAt sql.NullTime
+	if !mfa.EnabledAt.IsZero() {
+		enabledAt = sql.NullTime{Time: mfa.EnabledAt, Valid: true}
+	}
+
+	return dbMFA{
+		UserID:        mfa.UserID,
+		Secret:        mfa.Secret,
+		RecoveryCodes: codes,
+		LastStep:      int64(mfa.LastStep),
+		CreatedAt:     mfa.CreatedAt,
+		EnabledAt:     enabledAt,
+	}, nil
+}
+
+func toMFA(dbm dbMFA) users.MFA {
+	var codes []string
+	for _, e := range dbm.RecoveryCodes.Elements {
+		codes = append(codes, e.String)
+	}
+	var enabledAt time.Time
+	if dbm.EnabledAt.Valid {
+		enabledAt = dbm.EnabledAt.Time.UTC()
+	}
+
+	return users.MFA{
+		UserID:        dbm.UserID,
+		Secret:        dbm.Secret,
+		RecoveryCodes: codes,
+		LastStep:      uint64(dbm.LastStep),
+		CreatedAt:     dbm.CreatedAt.UTC(),
+		EnabledAt:     enabledAt,
+	}
+}
+
+type dbMFAChallenge struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func toDBMFAChallenge(c users.MFAChallenge) dbMFAChallenge {
+	return dbMFAChallenge{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Attempts:  int(c.Attempts),
+		CreatedAt: c.CreatedAt,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
+
+func toMFAChallenge(dbc dbMFAChallenge) users.MFAChallenge {
+	return users.MFAChallenge{
+		ID:        dbc.ID,
+		UserID:    dbc.UserID,
+		Attempts:  uint(dbc.Attempts),
+		CreatedAt: dbc.CreatedAt.UTC(),
+		ExpiresAt: dbc.ExpiresAt.UTC(),
+	}
+}
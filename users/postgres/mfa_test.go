@@ -0,0 +1,183 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/internal/testsutil"
+	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
+	"github.com/absmach/supermq/users"
+	"github.com/absmach/supermq/users/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveMFAUser(t *testing.T, repo users.Repository) users.User {
+	user := users.User{
+		ID:        testsutil.GenerateUUID(t),
+		Email:     namesgen.Generate() + "@example.com",
+		FirstName: namesgen.Generate(),
+		LastName:  namesgen.Generate(),
+		Credentials: users.Credentials{
+			Username: namesgen.Generate(),
+		},
+	}
+	user, err := repo.Save(context.Background(), user)
+	require.Nil(t, err, fmt.Sprintf("saving user unexpected error: %s", err))
+
+	return user
+}
+
+func TestSaveMFA(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM users")
+		require.Nil(t, err, fmt.Sprintf("clean users unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	user := saveMFAUser(t, repo)
+
+	pending := users.MFA{
+		UserID:    user.ID,
+		Secret:    "JBSWY3DPEHPK3PXP",
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	enabled := pending
+	enabled.RecoveryCodes = []string{"hash1", "hash2"}
+	enabled.LastStep = 58
+	enabled.EnabledAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc string
+		mfa  users.MFA
+		err  error
+	}{
+		{
+			desc: "save pending MFA",
+			mfa:  pending,
+			err:  nil,
+		},
+		{
+			desc: "save enabled MFA over pending one",
+			mfa:  enabled,
+			err:  nil,
+		},
+		{
+			desc: "save MFA of non-existing user",
+			mfa: users.MFA{
+				UserID:    testsutil.GenerateUUID(t),
+				Secret:    "JBSWY3DPEHPK3PXP",
+				CreatedAt: time.Now().UTC(),
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.SaveMFA(context.Background(), tc.mfa)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if err == nil {
+				mfa, err := repo.RetrieveMFA(context.Background(), tc.mfa.UserID)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+				assert.Equal(t, tc.mfa, mfa, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.mfa, mfa))
+			}
+		})
+	}
+}
+
+func TestRemoveMFA(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM users")
+		require.Nil(t, err, fmt.Sprintf("clean users unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	user := saveMFAUser(t, repo)
+
+	err := repo.SaveMFA(context.Background(), users.MFA{UserID: user.ID, Secret: "JBSWY3DPEHPK3PXP", CreatedAt: time.Now().UTC()})
+	require.Nil(t, err, fmt.Sprintf("saving MFA unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		userID string
+		err    error
+	}{
+		{
+			desc:   "remove existing MFA",
+			userID: user.ID,
+			err:    nil,
+		},
+		{
+			desc:   "remove removed MFA",
+			userID: user.ID,
+			err:    repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.RemoveMFA(context.Background(), tc.userID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestMFAChallenge(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM users")
+		require.Nil(t, err, fmt.Sprintf("clean users unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	user := saveMFAUser(t, repo)
+
+	challenge := users.MFAChallenge{
+		ID:        testsutil.GenerateUUID(t),
+		UserID:    user.ID,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		ExpiresAt: time.Now().UTC().Add(users.MFAChallengeDuration).Truncate(time.Microsecond),
+	}
+
+	err := repo.SaveMFAChallenge(context.Background(), challenge)
+	assert.Nil(t, err, fmt.Sprintf("saving MFA challenge unexpected error: %s", err))
+
+	err = repo.SaveMFAChallenge(context.Background(), challenge)
+	assert.True(t, errors.Contains(err, repoerr.ErrConflict), fmt.Sprintf("saving duplicate MFA challenge: expected %s got %s", repoerr.ErrConflict, err))
+
+	count, err := repo.CountMFAChallenges(context.Background(), user.ID, time.Now().UTC())
+	assert.Nil(t, err, fmt.Sprintf("counting MFA challenges unexpected error: %s", err))
+	assert.Equal(t, uint64(1), count, fmt.Sprintf("counting MFA challenges: expected 1 got %d", count))
+
+	count, err = repo.CountMFAChallenges(context.Background(), user.ID, challenge.ExpiresAt)
+	assert.Nil(t, err, fmt.Sprintf("counting MFA challenges unexpected error: %s", err))
+	assert.Equal(t, uint64(0), count, fmt.Sprintf("counting expired MFA challenges: expected 0 got %d", count))
+
+	for range 2 {
+		err = repo.IncrementMFAChallengeAttempts(context.Background(), challenge.ID, 2)
+		assert.Nil(t, err, fmt.Sprintf("incrementing MFA challenge attempts unexpected error: %s", err))
+	}
+	challenge.Attempts = 2
+
+	err = repo.IncrementMFAChallengeAttempts(context.Background(), challenge.ID, 2)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("incrementing MFA challenge attempts over limit: expected %s got %s", repoerr.ErrNotFound, err))
+
+	retrieved, err := repo.RetrieveMFAChallenge(context.Background(), challenge.ID)
+	assert.Nil(t, err, fmt.Sprintf("retrieving MFA challenge unexpected error: %s", err))
+	assert.Equal(t, challenge, retrieved, fmt.Sprintf("retrieving MFA challenge: expected %v got %v", challenge, retrieved))
+
+	err = repo.RemoveMFAChallenge(context.Background(), challenge.ID)
+	assert.Nil(t, err, fmt.Sprintf("removing MFA challenge unexpected error: %s", err))
+
+	_, err = repo.RetrieveMFAChallenge(context.Background(), challenge.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("retrieving removed MFA challenge: expected %s got %s", repoerr.ErrNotFound, err))
+
+	err = repo.IncrementMFAChallengeAttempts(context.Background(), challenge.ID, 2)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("incrementing removed MFA challenge attempts: expected %s got %s", repoerr.ErrNotFound, err))
+}
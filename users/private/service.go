@@ -5,26 +5,36 @@ package private
 
 import (
 	"context"
+	"time"
 
+	apiutil "github.com/absmach/supermq/api/http/util"
 	"github.com/absmach/supermq/pkg/errors"
+	repoerr "github.com/absmach/supermq/pkg/errors/repository"
 	svcerr "github.com/absmach/supermq/pkg/errors/service"
+	"github.com/absmach/supermq/pkg/policies"
 	"github.com/absmach/supermq/users"
 )
 
 type Service interface {
 	RetrieveByIDs(ctx context.Context, ids []string, offset, limit uint64) (users.UsersPage, error)
+
+	// ProvisionUser creates the user authenticated by the external identity
+	// provider if it doesn't exist yet and returns the stored user.
+	ProvisionUser(ctx context.Context, user users.User) (users.User, error)
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo users.Repository) Service {
+func New(repo users.Repository, policyService policies.Service) Service {
 	return service{
-		repo: repo,
+		repo:     repo,
+		policies: policyService,
 	}
 }
 
 type service struct {
-	repo users.Repository
+	repo     users.Repository
+	policies policies.Service
 }
 
 func (svc service) RetrieveByIDs(ctx context.Context, ids []string, offset, limit uint64) (users.UsersPage, error) {
@@ -49,3 +59,69 @@ func (svc service) RetrieveByIDs(ctx context.Context, ids []string, offset, limi
 
 	return page, nil
 }
+
+func (svc service) ProvisionUser(ctx context.Context, user users.User) (users.User, error) {
+	if user.ID == "" {
+		return users.User{}, svcerr.ErrMalformedEntity
+	}
+
+	dbUser, err := svc.retrieveProvisioned(ctx, user.ID)
+	if err == nil || !errors.Contains(err, repoerr.ErrNotFound) {
+		return dbUser, err
+	}
+
+	if user.Credentials.Username == "" {
+		user.Credentials.Username = user.ID
+	}
+	now := time.Now().UTC()
+	user.CreatedAt = now
+	// The email is verified only if the issuer of the token asserts it.
+	if !user.VerifiedAt.IsZero() {
+		user.VerifiedAt = now
+	}
+	user.Status = users.EnabledStatus
+	user.Role = users.UserRole
+
+	policy := policies.Policy{
+		SubjectType: policies.UserType,
+		Subject:     user.ID,
+		Relation:    policies.MemberRelation,
+		ObjectType:  policies.PlatformType,
+		Object:      policies.SuperMQObject,
+	}
+	if err := svc.policies.AddPolicy(ctx, policy); err != nil {
+		return users.User{}, errors.Wrap(svcerr.ErrAddPolicies, err)
+	}
+
+	saved, err := svc.repo.Save(ctx, user)
+	if err != nil {
+		// The user may have been provisioned by the concurrent request
+		// with the same token, in which case the policy is kept.
+		if errors.Contains(err, repoerr.ErrConflict) {
+			if dbUser, rerr := svc.retrieveProvisioned(ctx, user.ID); rerr == nil {
+				return dbUser, nil
+			}
+		}
+		if errRollback := svc.policies.DeletePolicyFilter(ctx, policy); errRollback != nil {
+			err = errors.Wrap(errors.Wrap(apiutil.ErrRollbackTx, errRollback), err)
+		}
+		return users.User{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return saved, nil
+}
+
+func (svc service) retrieveProvisioned(ctx context.Context, id string) (users.User, error) {
+	user, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return users.User{}, err
+		}
+		return users.User{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if user.Status != users.EnabledStatus {
+		return users.User{}, svcerr.ErrAuthentication
+	}
+
+	return user, nil
+}
@@ -8,12 +8,14 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"net/mail"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/absmach/supermq"
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	apiutil "github.com/absmach/supermq/api/http/util"
 	smqauth "github.com/absmach/supermq/auth"
@@ -33,6 +35,8 @@ var (
 	errLoginDisableUser      = errors.NewAuthNError("failed to login in disabled user")
 	errMatchUserVerification = errors.NewRequestError("user verification does not match with stored verification")
 	errSimilarUpdateEmail    = errors.NewRequestError("new email is similar to the current email")
+	errRevokeTokens          = errors.NewServiceError("failed to revoke user tokens")
+	errAccountLinking        = errors.NewAuthNError("account with the email is registered with other authentication provider")
 
 	usernameRegExp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{34}[a-z0-9]$`)
 )
@@ -44,10 +48,13 @@ type service struct {
 	policies   policies.Service
 	hasher     Hasher
 	email      Emailer
+	totp       TOTP
+	domains    grpcDomainsV1.DomainsServiceClient
+	logger     *slog.Logger
 }
 
 // NewService returns a new Users service implementation.
-func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policyService policies.Service, emailer Emailer, hasher Hasher, idp supermq.IDProvider) Service {
+func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policyService policies.Service, emailer Emailer, hasher Hasher, idp supermq.IDProvider, totp TOTP, domains grpcDomainsV1.DomainsServiceClient, logger *slog.Logger) Service {
 	return service{
 		token:      token,
 		users:      urepo,
@@ -55,6 +62,9 @@ func NewService(token grpcTokenV1.TokenServiceClient, urepo Repository, policySe
 		hasher:     hasher,
 		email:      emailer,
 		idProvider: idp,
+		totp:       totp,
+		domains:    domains,
+		logger:     logger,
 	}
 }
 
@@ -101,6 +111,10 @@ func (svc service) Register(ctx context.Context, session authn.Session, u User,
 	if err != nil {
 		return User{}, errors.Wrap(svcerr.ErrCreateEntity, err)
 	}
+	if u.InvitationToken != "" {
+		req := &grpcDomainsV1.ClaimInvitationsReq{UserId: user.ID, Email: user.Email, Token: u.InvitationToken}
+		svc.claimInvitations(ctx, req)
+	}
 	return user, nil
 }
 
@@ -183,7 +197,30 @@ func (svc service) VerifyEmail(ctx context.Context, token string) (User, error)
 	return user, nil
 }
 
-func (svc service) IssueToken(ctx context.Context, identity, secret string) (*grpcTokenV1.Token, error) {
+func (svc service) IssueToken(ctx context.Context, identity, secret string) (*grpcTokenV1.Token, MFAChallenge, error) {
+	dbUser, err := svc.authenticate(ctx, identity, secret)
+	if err != nil {
+		return &grpcTokenV1.Token{}, MFAChallenge{}, err
+	}
+
+	challenge, err := svc.loginMFAChallenge(ctx, dbUser.ID)
+	if err != nil {
+		return &grpcTokenV1.Token{}, MFAChallenge{}, err
+	}
+	if challenge.ID != "" {
+		return &grpcTokenV1.Token{}, challenge, nil
+	}
+
+	token, err := svc.issueToken(ctx, dbUser, false)
+	if err != nil {
+		return &grpcTokenV1.Token{}, MFAChallenge{}, err
+	}
+
+	return token, MFAChallenge{}, nil
+}
+
+// authenticate retrieves the user by the username or email and checks the secret.
+func (svc service) authenticate(ctx context.Context, identity, secret string) (User, error) {
 	var dbUser User
 	var err error
 
@@ -194,18 +231,252 @@ func (svc service) IssueToken(ctx context.Context, identity, secret string) (*gr
 	}
 
 	if err == repoerr.ErrNotFound {
-		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrLogin, err)
+		return User{}, errors.Wrap(svcerr.ErrLogin, err)
 	}
 
 	if err != nil {
-		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+		return User{}, errors.Wrap(svcerr.ErrAuthentication, err)
 	}
 
 	if err := svc.hasher.Compare(secret, dbUser.Credentials.Secret); err != nil {
-		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrLogin, err)
+		return User{}, errors.Wrap(svcerr.ErrLogin, err)
 	}
 
-	token, err := svc.token.Issue(ctx, &grpcTokenV1.IssueReq{UserId: dbUser.ID, UserRole: uint32(dbUser.Role + 1), Type: uint32(smqauth.AccessKey), Verified: !dbUser.VerifiedAt.IsZero()})
+	return dbUser, nil
+}
+
+func (svc service) VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error) {
+	challenge, err := svc.users.RetrieveMFAChallenge(ctx, challengeID)
+	if err != nil {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if time.Now().After(challenge.ExpiresAt) {
+		return &grpcTokenV1.Token{}, svc.discardMFAChallenge(ctx, challenge.ID)
+	}
+	// The attempt is counted before the code is checked, so the parallel
+	// attempts can't get past the attempts limit.
+	if err := svc.users.IncrementMFAChallengeAttempts(ctx, challenge.ID, mfaChallengeAttempts); err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			return &grpcTokenV1.Token{}, svc.discardMFAChallenge(ctx, challenge.ID)
+		}
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+
+	mfa, err := svc.users.RetrieveMFA(ctx, challenge.UserID)
+	if err != nil {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	mfa, err = svc.checkMFACode(mfa, code)
+	if err != nil {
+		return &grpcTokenV1.Token{}, err
+	}
+	// The challenge is removed first, so it can't be answered twice.
+	if err := svc.users.RemoveMFAChallenge(ctx, challenge.ID); err != nil {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if err := svc.users.SaveMFA(ctx, mfa); err != nil {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+
+	dbUser, err := svc.users.RetrieveByID(ctx, challenge.UserID)
+	if err != nil {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if dbUser.Status == DisabledStatus {
+		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, errLoginDisableUser)
+	}
+
+	return svc.issueToken(ctx, dbUser, true)
+}
+
+func (svc service) EnrollMFA(ctx context.Context, session authn.Session) (MFAEnrolment, error) {
+	dbUser, err := svc.users.RetrieveByID(ctx, session.UserID)
+	if err != nil {
+		return MFAEnrolment{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	mfa, err := svc.users.RetrieveMFA(ctx, session.UserID)
+	if err != nil && err != repoerr.ErrNotFound {
+		return MFAEnrolment{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if mfa.Enabled() {
+		return MFAEnrolment{}, ErrMFAEnabled
+	}
+
+	secret, err := svc.totp.GenerateSecret()
+	if err != nil {
+		return MFAEnrolment{}, err
+	}
+	mfa = MFA{
+		UserID:    session.UserID,
+		Secret:    secret,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := svc.users.SaveMFA(ctx, mfa); err != nil {
+		return MFAEnrolment{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	account := dbUser.Email
+	if account == "" {
+		account = dbUser.Credentials.Username
+	}
+
+	return MFAEnrolment{
+		Secret: secret,
+		URI:    svc.totp.URI(account, secret),
+	}, nil
+}
+
+func (svc service) ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error) {
+	mfa, err := svc.users.RetrieveMFA(ctx, session.UserID)
+	if err != nil {
+		if err == repoerr.ErrNotFound {
+			return nil, ErrMFANotEnrolled
+		}
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if mfa.Enabled() {
+		return nil, ErrMFAEnabled
+	}
+
+	step, err := svc.totp.Validate(mfa.Secret, code)
+	if err != nil {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, c := range codes {
+		hash, err := svc.hasher.Hash(normalizeRecoveryCode(c))
+		if err != nil {
+			return nil, errors.Wrap(svcerr.ErrMalformedEntity, err)
+		}
+		hashes[i] = hash
+	}
+
+	mfa.RecoveryCodes = hashes
+	mfa.LastStep = step
+	mfa.EnabledAt = time.Now().UTC()
+	if err := svc.users.SaveMFA(ctx, mfa); err != nil {
+		return nil, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return codes, nil
+}
+
+func (svc service) DisableMFA(ctx context.Context, session authn.Session, code string) error {
+	mfa, err := svc.users.RetrieveMFA(ctx, session.UserID)
+	if err != nil {
+		if err == repoerr.ErrNotFound {
+			return ErrMFANotEnabled
+		}
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if !mfa.Enabled() {
+		return ErrMFANotEnabled
+	}
+	if _, err := svc.checkMFACode(mfa, code); err != nil {
+		return err
+	}
+
+	if err := svc.users.RemoveMFA(ctx, session.UserID); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+// discardMFAChallenge removes the expired or exhausted MFA challenge, so the
+// user has to log in again.
+func (svc service) discardMFAChallenge(ctx context.Context, id string) error {
+	if err := svc.users.RemoveMFAChallenge(ctx, id); err != nil && !errors.Contains(err, repoerr.ErrNotFound) {
+		return errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+
+	return ErrMFAChallengeExpired
+}
+
+// loginMFAChallenge issues the MFA challenge if the user has enabled MFA.
+// Otherwise, it returns an empty challenge.
+func (svc service) loginMFAChallenge(ctx context.Context, userID string) (MFAChallenge, error) {
+	mfa, err := svc.users.RetrieveMFA(ctx, userID)
+	switch {
+	case err == repoerr.ErrNotFound:
+		return MFAChallenge{}, nil
+	case err != nil:
+		return MFAChallenge{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	case !mfa.Enabled():
+		return MFAChallenge{}, nil
+	}
+
+	return svc.newMFAChallenge(ctx, userID)
+}
+
+func (svc service) newMFAChallenge(ctx context.Context, userID string) (MFAChallenge, error) {
+	now := time.Now().UTC()
+	// Each login with the valid password issues the challenge, so the number
+	// of the challenges that can be guessed at the same time is limited.
+	count, err := svc.users.CountMFAChallenges(ctx, userID, now)
+	if err != nil {
+		return MFAChallenge{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+	if count >= mfaChallengesLimit {
+		return MFAChallenge{}, ErrMFAChallengesLimit
+	}
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return MFAChallenge{}, errors.Wrap(svcerr.ErrIssueProviderID, err)
+	}
+	challenge := MFAChallenge{
+		ID:        id,
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(MFAChallengeDuration),
+	}
+	if err := svc.users.SaveMFAChallenge(ctx, challenge); err != nil {
+		return MFAChallenge{}, errors.Wrap(svcerr.ErrAuthentication, err)
+	}
+
+	return challenge, nil
+}
+
+// checkMFACode checks the TOTP code, falling back to the recovery codes, and
+// returns the MFA settings updated with the last accepted time step or
+// without the used recovery code.
+func (svc service) checkMFACode(mfa MFA, code string) (MFA, error) {
+	if step, err := svc.totp.Validate(mfa.Secret, code); err == nil {
+		if step <= mfa.LastStep {
+			return mfa, ErrInvalidMFACode
+		}
+		mfa.LastStep = step
+		return mfa, nil
+	}
+
+	rc := normalizeRecoveryCode(code)
+	for i, hash := range mfa.RecoveryCodes {
+		if err := svc.hasher.Compare(rc, hash); err == nil {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i:i], mfa.RecoveryCodes[i+1:]...)
+			return mfa, nil
+		}
+	}
+
+	return mfa, ErrInvalidMFACode
+}
+
+func (svc service) issueToken(ctx context.Context, user User, mfa bool) (*grpcTokenV1.Token, error) {
+	ci := authn.ClientInfoFromContext(ctx)
+	token, err := svc.token.Issue(ctx, &grpcTokenV1.IssueReq{
+		UserId:    user.ID,
+		UserRole:  uint32(user.Role + 1),
+		Type:      uint32(smqauth.AccessKey),
+		Verified:  !user.VerifiedAt.IsZero(),
+		ClientIp:  ci.IP,
+		UserAgent: ci.UserAgent,
+		Mfa:       mfa,
+	})
 	if err != nil {
 		return &grpcTokenV1.Token{}, errors.Wrap(errIssueToken, err)
 	}
@@ -221,7 +492,13 @@ func (svc service) RefreshToken(ctx context.Context, session authn.Session, refr
 	if dbUser.Status == DisabledStatus {
 		return &grpcTokenV1.Token{}, errors.Wrap(svcerr.ErrAuthentication, errLoginDisableUser)
 	}
-	token, err := svc.token.Refresh(ctx, &grpcTokenV1.RefreshReq{RefreshToken: refreshToken, Verified: !dbUser.VerifiedAt.IsZero()})
+	ci := authn.ClientInfoFromContext(ctx)
+	token, err := svc.token.Refresh(ctx, &grpcTokenV1.RefreshReq{
+		RefreshToken: refreshToken,
+		Verified:     !dbUser.VerifiedAt.IsZero(),
+		ClientIp:     ci.IP,
+		UserAgent:    ci.UserAgent,
+	})
 	if err != nil {
 		return &grpcTokenV1.Token{}, errors.Wrap(errIssueToken, err)
 	}
@@ -229,6 +506,47 @@ func (svc service) RefreshToken(ctx context.Context, session authn.Session, refr
 	return token, nil
 }
 
+func (svc service) ListSessions(ctx context.Context, session authn.Session, pm Page) (LoginSessionsPage, error) {
+	res, err := svc.token.ListSessions(ctx, &grpcTokenV1.ListSessionsReq{
+		UserId: session.UserID,
+		Offset: pm.Offset,
+		Limit:  pm.Limit,
+	})
+	if err != nil {
+		return LoginSessionsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	page := LoginSessionsPage{
+		Total:    res.GetTotal(),
+		Offset:   res.GetOffset(),
+		Limit:    res.GetLimit(),
+		Sessions: make([]LoginSession, 0, len(res.GetSessions())),
+	}
+	for _, s := range res.GetSessions() {
+		ls := LoginSession{
+			ID:        s.GetId(),
+			IP:        s.GetIp(),
+			UserAgent: s.GetUserAgent(),
+			IssuedAt:  s.GetIssuedAt().AsTime(),
+			ExpiresAt: s.GetExpiresAt().AsTime(),
+		}
+		if s.GetRefreshedAt() != nil {
+			ls.RefreshedAt = s.GetRefreshedAt().AsTime()
+		}
+		page.Sessions = append(page.Sessions, ls)
+	}
+
+	return page, nil
+}
+
+func (svc service) RevokeSession(ctx context.Context, session authn.Session, id string) error {
+	if _, err := svc.token.RevokeSession(ctx, &grpcTokenV1.RevokeSessionReq{UserId: session.UserID, SessionId: id}); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
 func (svc service) View(ctx context.Context, session authn.Session, id string) (User, error) {
 	user, err := svc.users.RetrieveByID(ctx, id)
 	if err != nil {
@@ -453,7 +771,7 @@ func (svc service) UpdateSecret(ctx context.Context, session authn.Session, oldS
 	if err != nil {
 		return User{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	if _, err := svc.IssueToken(ctx, dbUser.Credentials.Username, oldSecret); err != nil {
+	if _, err := svc.authenticate(ctx, dbUser.Credentials.Username, oldSecret); err != nil {
 		return User{}, err
 	}
 	newSecret, err = svc.hasher.Hash(newSecret)
@@ -561,6 +879,13 @@ func (svc service) changeUserStatus(ctx context.Context, session authn.Session,
 	if dbu.Status == user.Status {
 		return User{}, svcerr.ErrStatusAlreadyAssigned
 	}
+	// The tokens are revoked first, so the disabled or deleted user can't
+	// keep using the live sessions in any of the services.
+	if user.Status != EnabledStatus {
+		if _, err := svc.token.RevokeUserTokens(ctx, &grpcTokenV1.RevokeUserTokensReq{UserId: user.ID}); err != nil {
+			return User{}, errors.Wrap(errRevokeTokens, err)
+		}
+	}
 	user.UpdatedBy = session.UserID
 
 	user, err = svc.users.ChangeStatus(ctx, user)
@@ -595,10 +920,13 @@ func (svc *service) checkSuperAdmin(ctx context.Context, session authn.Session)
 }
 
 func (svc service) OAuthCallback(ctx context.Context, user User) (User, error) {
+	emailVerified := !user.VerifiedAt.IsZero()
 	u, err := svc.users.RetrieveByEmail(ctx, user.Email)
 
 	if errors.Contains(err, repoerr.ErrNotFound) {
-		user.Credentials.Username = generateUsername(user.Email)
+		if user.Credentials.Username == "" {
+			user.Credentials.Username = generateUsername(user.Email)
+		}
 		u, err = svc.Register(ctx, authn.Session{}, user, true)
 		if err != nil {
 			if errors.Contains(err, errors.ErrUsernameNotAvailable) {
@@ -611,6 +939,11 @@ func (svc service) OAuthCallback(ctx context.Context, user User) (User, error) {
 	if err != nil && !errors.Contains(err, repoerr.ErrNotFound) {
 		return User{}, err
 	}
+	// The provider identity is never linked to the account registered with
+	// the password or the other provider, even if the email is the same.
+	if u.AuthProvider != user.AuthProvider {
+		return User{}, errAccountLinking
+	}
 
 	if u.VerifiedAt.IsZero() {
 		user.ID = u.ID
@@ -621,13 +954,32 @@ func (svc service) OAuthCallback(ctx context.Context, user User) (User, error) {
 		}
 	}
 
+	// Only the email asserted as verified by the provider proves the
+	// ownership, so the invitations are claimed without the token.
+	if emailVerified {
+		svc.claimInvitations(ctx, &grpcDomainsV1.ClaimInvitationsReq{UserId: u.ID, Email: user.Email})
+	}
+
 	return User{ID: u.ID, Role: u.Role, VerifiedAt: u.VerifiedAt}, nil
 }
 
+// claimInvitations claims the invitations sent to the user email. The user is
+// already signed up at this point, so the failure doesn't fail the request and
+// the user can still be invited by ID.
+func (svc service) claimInvitations(ctx context.Context, req *grpcDomainsV1.ClaimInvitationsReq) {
+	if _, err := svc.domains.ClaimInvitations(ctx, req); err != nil {
+		svc.logger.Warn("failed to claim domain invitations", slog.String("user_id", req.GetUserId()), slog.Any("error", err))
+	}
+}
+
 func (svc service) OAuthAddUserPolicy(ctx context.Context, user User) error {
 	return svc.addUserPolicy(ctx, user.ID, user.Role)
 }
 
+func (svc service) OAuthMFAChallenge(ctx context.Context, user User) (MFAChallenge, error) {
+	return svc.loginMFAChallenge(ctx, user.ID)
+}
+
 func (svc service) Identify(ctx context.Context, session authn.Session) (string, error) {
 	return session.UserID, nil
 }
@@ -10,10 +10,13 @@ import (
 	"testing"
 	"time"
 
+	grpcDomainsV1 "github.com/absmach/supermq/api/grpc/domains/v1"
 	grpcTokenV1 "github.com/absmach/supermq/api/grpc/token/v1"
 	smqauth "github.com/absmach/supermq/auth"
 	authmocks "github.com/absmach/supermq/auth/mocks"
+	dmocks "github.com/absmach/supermq/domains/mocks"
 	"github.com/absmach/supermq/internal/testsutil"
+	smqlog "github.com/absmach/supermq/logger"
 	"github.com/absmach/supermq/pkg/authn"
 	"github.com/absmach/supermq/pkg/errors"
 	repoerr "github.com/absmach/supermq/pkg/errors/repository"
@@ -23,13 +26,19 @@ import (
 	"github.com/absmach/supermq/users"
 	"github.com/absmach/supermq/users/hasher"
 	"github.com/absmach/supermq/users/mocks"
+	"github.com/absmach/supermq/users/totp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
 	idProvider     = uuid.New()
 	phasher        = hasher.New()
+	mfaTime        = time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	ptotp          = totp.New("SuperMQ", func() time.Time { return mfaTime })
+	mfaSecret      = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
 	secret         = "strongsecret"
 	validCMetadata = users.Metadata{"role": "user"}
 	userID         = "d8dd12ef-aa2a-43fe-8ef2-2e4fe514360f"
@@ -52,10 +61,12 @@ var (
 		FirstName: "firstname",
 		LastName:  "lastname",
 	}
-	validToken      = "token"
-	validID         = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
-	wrongID         = testsutil.GenerateUUID(&testing.T{})
-	errHashPassword = errors.New("generate hash from password failed")
+	validToken        = "token"
+	validID           = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
+	wrongID           = testsutil.GenerateUUID(&testing.T{})
+	errHashPassword   = errors.New("generate hash from password failed")
+	errAccountLinking = errors.New("account with the email is registered with other authentication provider")
+	domainsClient     *dmocks.DomainsServiceClient
 )
 
 func newService() (users.Service, *authmocks.TokenServiceClient, *mocks.Repository, *policymocks.Service, *mocks.Emailer) {
@@ -63,7 +74,8 @@ func newService() (users.Service, *authmocks.TokenServiceClient, *mocks.Reposito
 	policies := new(policymocks.Service)
 	e := new(mocks.Emailer)
 	tokenClient := new(authmocks.TokenServiceClient)
-	return users.NewService(tokenClient, cRepo, policies, e, phasher, idProvider), tokenClient, cRepo, policies, e
+	domainsClient = new(dmocks.DomainsServiceClient)
+	return users.NewService(tokenClient, cRepo, policies, e, phasher, idProvider, ptotp, domainsClient, smqlog.NewMock()), tokenClient, cRepo, policies, e
 }
 
 func newServiceMinimal() (users.Service, *mocks.Repository) {
@@ -71,7 +83,8 @@ func newServiceMinimal() (users.Service, *mocks.Repository) {
 	policies := new(policymocks.Service)
 	e := new(mocks.Emailer)
 	tokenUser := new(authmocks.TokenServiceClient)
-	return users.NewService(tokenUser, cRepo, policies, e, phasher, idProvider), cRepo
+	domainsClient = new(dmocks.DomainsServiceClient)
+	return users.NewService(tokenUser, cRepo, policies, e, phasher, idProvider, ptotp, domainsClient, smqlog.NewMock()), cRepo
 }
 
 func TestRegister(t *testing.T) {
@@ -83,6 +96,7 @@ func TestRegister(t *testing.T) {
 		addPoliciesResponseErr    error
 		deletePoliciesResponseErr error
 		saveErr                   error
+		claimErr                  error
 		err                       error
 	}{
 		{
@@ -90,6 +104,27 @@ func TestRegister(t *testing.T) {
 			user: user,
 			err:  nil,
 		},
+		{
+			desc: "register new user with invitation token",
+			user: users.User{
+				FirstName:       "invitedUser",
+				Email:           "inviteduser@example.com",
+				Credentials:     users.Credentials{Secret: secret},
+				InvitationToken: validToken,
+			},
+			err: nil,
+		},
+		{
+			desc: "register new user with invalid invitation token",
+			user: users.User{
+				FirstName:       "invitedUser",
+				Email:           "inviteduser@example.com",
+				Credentials:     users.Credentials{Secret: secret},
+				InvitationToken: "invalid",
+			},
+			claimErr: svcerr.ErrAuthentication,
+			err:      nil,
+		},
 		{
 			desc:    "register existing user",
 			user:    user,
@@ -229,6 +264,8 @@ func TestRegister(t *testing.T) {
 			policyCall := policies.On("AddPolicies", context.Background(), mock.Anything).Return(tc.addPoliciesResponseErr)
 			policyCall1 := policies.On("DeletePolicies", context.Background(), mock.Anything).Return(tc.deletePoliciesResponseErr)
 			repoCall := cRepo.On("Save", context.Background(), mock.Anything).Return(tc.user, tc.saveErr)
+			claimReq := &grpcDomainsV1.ClaimInvitationsReq{UserId: tc.user.ID, Email: tc.user.Email, Token: tc.user.InvitationToken}
+			domainsCall := domainsClient.On("ClaimInvitations", context.Background(), claimReq).Return(&grpcDomainsV1.ClaimInvitationsRes{}, tc.claimErr)
 			expected, err := svc.Register(context.Background(), authn.Session{}, tc.user, true)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			if err == nil {
@@ -241,9 +278,14 @@ func TestRegister(t *testing.T) {
 				ok := repoCall.Parent.AssertCalled(t, "Save", context.Background(), mock.Anything)
 				assert.True(t, ok, fmt.Sprintf("Save was not called on %s", tc.desc))
 			}
+			if tc.user.InvitationToken != "" {
+				ok := domainsCall.Parent.AssertCalled(t, "ClaimInvitations", context.Background(), claimReq)
+				assert.True(t, ok, fmt.Sprintf("ClaimInvitations was not called on %s", tc.desc))
+			}
 			repoCall.Unset()
 			policyCall.Unset()
 			policyCall1.Unset()
+			domainsCall.Unset()
 		})
 	}
 
@@ -1386,7 +1428,7 @@ func TestEnableUser(t *testing.T) {
 }
 
 func TestDisableUser(t *testing.T) {
-	svc, cRepo := newServiceMinimal()
+	svc, tokenClient, cRepo, _, _ := newService()
 
 	enabledUser1 := users.User{ID: testsutil.GenerateUUID(t), Credentials: users.Credentials{Username: "user1@example.com", Secret: "password"}, Status: users.EnabledStatus}
 	disabledUser1 := users.User{ID: testsutil.GenerateUUID(t), Credentials: users.Credentials{Username: "user3@example.com", Secret: "password"}, Status: users.DisabledStatus}
@@ -1403,6 +1445,7 @@ func TestDisableUser(t *testing.T) {
 		retrieveByIDErr      error
 		changeStatusErr      error
 		checkSuperAdminErr   error
+		revokeErr            error
 		err                  error
 	}{
 		{
@@ -1436,6 +1479,14 @@ func TestDisableUser(t *testing.T) {
 			retrieveByIDResponse: disabledUser1,
 			err:                  svcerr.ErrStatusAlreadyAssigned,
 		},
+		{
+			desc:                 "disable enabled user with failed to revoke tokens",
+			id:                   enabledUser1.ID,
+			user:                 enabledUser1,
+			retrieveByIDResponse: enabledUser1,
+			revokeErr:            svcerr.ErrAuthentication,
+			err:                  errors.New("failed to revoke user tokens"),
+		},
 		{
 			desc:                 "disable enabled user with failed to change status",
 			id:                   enabledUser1.ID,
@@ -1451,6 +1502,7 @@ func TestDisableUser(t *testing.T) {
 			repoCall := cRepo.On("CheckSuperAdmin", context.Background(), mock.Anything).Return(tc.checkSuperAdminErr)
 			repoCall1 := cRepo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveByIDResponse, tc.retrieveByIDErr)
 			repoCall2 := cRepo.On("ChangeStatus", context.Background(), mock.Anything).Return(tc.changeStatusResponse, tc.changeStatusErr)
+			tokenCall := tokenClient.On("RevokeUserTokens", context.Background(), &grpcTokenV1.RevokeUserTokensReq{UserId: tc.id}).Return(&grpcTokenV1.RevokeUserTokensRes{Revoked: tc.revokeErr == nil}, tc.revokeErr)
 
 			_, err := svc.Disable(context.Background(), authn.Session{}, tc.id)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
@@ -1459,16 +1511,19 @@ func TestDisableUser(t *testing.T) {
 				assert.True(t, ok, fmt.Sprintf("RetrieveByID was not called on %s", tc.desc))
 				ok = repoCall2.Parent.AssertCalled(t, "ChangeStatus", context.Background(), mock.Anything)
 				assert.True(t, ok, fmt.Sprintf("ChangeStatus was not called on %s", tc.desc))
+				ok = tokenCall.Parent.AssertCalled(t, "RevokeUserTokens", context.Background(), &grpcTokenV1.RevokeUserTokensReq{UserId: tc.id})
+				assert.True(t, ok, fmt.Sprintf("RevokeUserTokens was not called on %s", tc.desc))
 			}
 			repoCall.Unset()
 			repoCall1.Unset()
 			repoCall2.Unset()
+			tokenCall.Unset()
 		})
 	}
 }
 
 func TestDeleteUser(t *testing.T) {
-	svc, cRepo := newServiceMinimal()
+	svc, tokenClient, cRepo, _, _ := newService()
 
 	enabledUser1 := users.User{ID: testsutil.GenerateUUID(t), Credentials: users.Credentials{Username: "user1@example.com", Secret: "password"}, Status: users.EnabledStatus}
 	deletedUser1 := users.User{ID: testsutil.GenerateUUID(t), Credentials: users.Credentials{Username: "user3@example.com", Secret: "password"}, Status: users.DeletedStatus}
@@ -1486,6 +1541,7 @@ func TestDeleteUser(t *testing.T) {
 		retrieveByIDErr      error
 		changeStatusErr      error
 		checkSuperAdminErr   error
+		revokeErr            error
 		err                  error
 	}{
 		{
@@ -1515,6 +1571,15 @@ func TestDeleteUser(t *testing.T) {
 			retrieveByIDResponse: deletedUser1,
 			err:                  svcerr.ErrStatusAlreadyAssigned,
 		},
+		{
+			desc:                 "delete enabled user with failed to revoke tokens",
+			id:                   enabledUser1.ID,
+			user:                 enabledUser1,
+			session:              authn.Session{UserID: validID, SuperAdmin: true},
+			retrieveByIDResponse: enabledUser1,
+			revokeErr:            svcerr.ErrAuthentication,
+			err:                  errors.New("failed to revoke user tokens"),
+		},
 		{
 			desc:                 "delete enabled user with failed to change status",
 			id:                   enabledUser1.ID,
@@ -1532,6 +1597,7 @@ func TestDeleteUser(t *testing.T) {
 			repoCall2 := cRepo.On("CheckSuperAdmin", context.Background(), mock.Anything).Return(tc.checkSuperAdminErr)
 			repoCall3 := cRepo.On("RetrieveByID", context.Background(), tc.id).Return(tc.retrieveByIDResponse, tc.retrieveByIDErr)
 			repoCall4 := cRepo.On("ChangeStatus", context.Background(), mock.Anything).Return(tc.changeStatusResponse, tc.changeStatusErr)
+			tokenCall := tokenClient.On("RevokeUserTokens", context.Background(), &grpcTokenV1.RevokeUserTokensReq{UserId: tc.id}).Return(&grpcTokenV1.RevokeUserTokensRes{Revoked: tc.revokeErr == nil}, tc.revokeErr)
 			err := svc.Delete(context.Background(), tc.session, tc.id)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			if tc.err == nil {
@@ -1539,10 +1605,13 @@ func TestDeleteUser(t *testing.T) {
 				assert.True(t, ok, fmt.Sprintf("RetrieveByID was not called on %s", tc.desc))
 				ok = repoCall4.Parent.AssertCalled(t, "ChangeStatus", context.Background(), mock.Anything)
 				assert.True(t, ok, fmt.Sprintf("ChangeStatus was not called on %s", tc.desc))
+				ok = tokenCall.Parent.AssertCalled(t, "RevokeUserTokens", context.Background(), &grpcTokenV1.RevokeUserTokensReq{UserId: tc.id})
+				assert.True(t, ok, fmt.Sprintf("RevokeUserTokens was not called on %s", tc.desc))
 			}
 			repoCall2.Unset()
 			repoCall3.Unset()
 			repoCall4.Unset()
+			tokenCall.Unset()
 		})
 	}
 }
@@ -1560,10 +1629,17 @@ func TestIssueToken(t *testing.T) {
 	cases := []struct {
 		desc                       string
 		user                       users.User
+		clientInfo                 authn.ClientInfo
 		retrieveByUsernameResponse users.User
+		retrieveMFAResponse        users.MFA
 		issueResponse              *grpcTokenV1.Token
 		retrieveByUsernameErr      error
+		retrieveMFAErr             error
+		challengesCount            uint64
+		countChallengesErr         error
+		saveChallengeErr           error
 		issueErr                   error
+		challenge                  bool
 		err                        error
 	}{
 		{
@@ -1580,6 +1656,14 @@ func TestIssueToken(t *testing.T) {
 			issueResponse:              &grpcTokenV1.Token{AccessToken: validToken, RefreshToken: &validToken, AccessType: "3"},
 			err:                        nil,
 		},
+		{
+			desc:                       "issue token with client info",
+			user:                       user,
+			clientInfo:                 authn.ClientInfo{IP: "192.168.1.10", UserAgent: "supermq-cli"},
+			retrieveByUsernameResponse: rUser,
+			issueResponse:              &grpcTokenV1.Token{AccessToken: validToken, RefreshToken: &validToken, AccessType: "3"},
+			err:                        nil,
+		},
 		{
 			desc:                       "issue token for a non-existing user",
 			user:                       user,
@@ -1609,24 +1693,500 @@ func TestIssueToken(t *testing.T) {
 			issueErr:                   svcerr.ErrAuthentication,
 			err:                        svcerr.ErrAuthentication,
 		},
+		{
+			desc:                       "issue token for a user with MFA enabled",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAResponse:        users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			challenge:                  true,
+			err:                        nil,
+		},
+		{
+			desc:                       "issue token for a user with MFA enrolment not confirmed",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAResponse:        users.MFA{UserID: user.ID, Secret: mfaSecret},
+			issueResponse:              &grpcTokenV1.Token{AccessToken: validToken, RefreshToken: &validToken, AccessType: "3"},
+			err:                        nil,
+		},
+		{
+			desc:                       "issue token for a user with MFA enabled with failed to save challenge",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAResponse:        users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			saveChallengeErr:           repoerr.ErrCreateEntity,
+			err:                        svcerr.ErrAuthentication,
+		},
+		{
+			desc:                       "issue token for a user with MFA enabled with too many challenges",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAResponse:        users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			challengesCount:            5,
+			err:                        users.ErrMFAChallengesLimit,
+		},
+		{
+			desc:                       "issue token for a user with MFA enabled with failed to count challenges",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAResponse:        users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			countChallengesErr:         repoerr.ErrViewEntity,
+			err:                        svcerr.ErrAuthentication,
+		},
+		{
+			desc:                       "issue token with failed to retrieve MFA",
+			user:                       user,
+			retrieveByUsernameResponse: rUser,
+			retrieveMFAErr:             repoerr.ErrViewEntity,
+			err:                        svcerr.ErrAuthentication,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			repoCall := cRepo.On("RetrieveByUsername", context.Background(), tc.user.Credentials.Username).Return(tc.retrieveByUsernameResponse, tc.retrieveByUsernameErr)
-			authCall := auth.On("Issue", context.Background(), &grpcTokenV1.IssueReq{UserId: tc.user.ID, UserRole: uint32(tc.user.Role + 1), Type: uint32(smqauth.AccessKey)}).Return(tc.issueResponse, tc.issueErr)
-			token, err := svc.IssueToken(context.Background(), tc.user.Credentials.Username, tc.user.Credentials.Secret)
+			ctx := authn.WithClientInfo(context.Background(), tc.clientInfo)
+			issueReq := &grpcTokenV1.IssueReq{UserId: tc.user.ID, UserRole: uint32(tc.user.Role + 1), Type: uint32(smqauth.AccessKey), ClientIp: tc.clientInfo.IP, UserAgent: tc.clientInfo.UserAgent}
+			retrieveMFAErr := tc.retrieveMFAErr
+			if retrieveMFAErr == nil && tc.retrieveMFAResponse.UserID == "" {
+				retrieveMFAErr = repoerr.ErrNotFound
+			}
+			repoCall := cRepo.On("RetrieveByUsername", ctx, tc.user.Credentials.Username).Return(tc.retrieveByUsernameResponse, tc.retrieveByUsernameErr)
+			repoCall1 := cRepo.On("RetrieveMFA", ctx, tc.user.ID).Return(tc.retrieveMFAResponse, retrieveMFAErr)
+			repoCall2 := cRepo.On("SaveMFAChallenge", ctx, mock.Anything).Return(tc.saveChallengeErr)
+			repoCall3 := cRepo.On("CountMFAChallenges", ctx, tc.user.ID, mock.Anything).Return(tc.challengesCount, tc.countChallengesErr)
+			authCall := auth.On("Issue", ctx, issueReq).Return(tc.issueResponse, tc.issueErr)
+			token, challenge, err := svc.IssueToken(ctx, tc.user.Credentials.Username, tc.user.Credentials.Secret)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
-			if err == nil {
+			switch {
+			case err == nil && tc.challenge:
+				assert.NotEmpty(t, challenge.ID, fmt.Sprintf("%s: expected MFA challenge not to be empty\n", tc.desc))
+				assert.Equal(t, tc.user.ID, challenge.UserID, fmt.Sprintf("%s: expected user ID %s got %s\n", tc.desc, tc.user.ID, challenge.UserID))
+				assert.Empty(t, token.GetAccessToken(), fmt.Sprintf("%s: expected access token to be empty\n", tc.desc))
+				ok := repoCall2.Parent.AssertCalled(t, "SaveMFAChallenge", ctx, mock.Anything)
+				assert.True(t, ok, fmt.Sprintf("SaveMFAChallenge was not called on %s", tc.desc))
+			case err == nil:
+				assert.Empty(t, challenge.ID, fmt.Sprintf("%s: expected MFA challenge to be empty\n", tc.desc))
 				assert.NotEmpty(t, token.GetAccessToken(), fmt.Sprintf("%s: expected %s not to be empty\n", tc.desc, token.GetAccessToken()))
 				assert.NotEmpty(t, token.GetRefreshToken(), fmt.Sprintf("%s: expected %s not to be empty\n", tc.desc, token.GetRefreshToken()))
-				ok := repoCall.Parent.AssertCalled(t, "RetrieveByUsername", context.Background(), tc.user.Credentials.Username)
+				ok := repoCall.Parent.AssertCalled(t, "RetrieveByUsername", ctx, tc.user.Credentials.Username)
 				assert.True(t, ok, fmt.Sprintf("RetrieveByUsername was not called on %s", tc.desc))
-				ok = authCall.Parent.AssertCalled(t, "Issue", context.Background(), &grpcTokenV1.IssueReq{UserId: tc.user.ID, UserRole: uint32(tc.user.Role + 1), Type: uint32(smqauth.AccessKey)})
+				ok = authCall.Parent.AssertCalled(t, "Issue", ctx, issueReq)
 				assert.True(t, ok, fmt.Sprintf("Issue was not called on %s", tc.desc))
 			}
 			authCall.Unset()
 			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+		})
+	}
+}
+
+func TestVerifyMFA(t *testing.T) {
+	svc, auth, cRepo, _, _ := newService()
+
+	code, err := totp.Code(mfaSecret, mfaTime)
+	require.Nil(t, err, fmt.Sprintf("generating TOTP code expected to succeed: %s", err))
+	recoveryCode := "abcd-efgh"
+	recoveryHash, err := phasher.Hash("abcdefgh")
+	require.Nil(t, err, fmt.Sprintf("hashing recovery code expected to succeed: %s", err))
+
+	challenge := users.MFAChallenge{
+		ID:        validID,
+		UserID:    user.ID,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: time.Now().UTC().Add(users.MFAChallengeDuration),
+	}
+	expired := challenge
+	expired.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	exhausted := challenge
+	exhausted.Attempts = 5
+	mfa := users.MFA{
+		UserID:        user.ID,
+		Secret:        mfaSecret,
+		RecoveryCodes: []string{recoveryHash},
+		EnabledAt:     mfaTime,
+	}
+	usedStep := mfa
+	usedStep.LastStep = uint64(mfaTime.Unix()) / uint64(totp.Period.Seconds())
+	disabledUser := user
+	disabledUser.Status = users.DisabledStatus
+
+	cases := []struct {
+		desc                string
+		challengeID         string
+		code                string
+		challengeResponse   users.MFAChallenge
+		challengeErr        error
+		retrieveMFAResponse users.MFA
+		retrieveMFAErr      error
+		retrieveByIDResp    users.User
+		retrieveByIDErr     error
+		issueResponse       *grpcTokenV1.Token
+		issueErr            error
+		incrementErr        error
+		attempted           bool
+		err                 error
+	}{
+		{
+			desc:                "verify MFA with valid TOTP code",
+			challengeID:         challenge.ID,
+			code:                code,
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: mfa,
+			retrieveByIDResp:    user,
+			issueResponse:       &grpcTokenV1.Token{AccessToken: validToken, RefreshToken: &validToken, AccessType: "3"},
+			err:                 nil,
+		},
+		{
+			desc:                "verify MFA with valid recovery code",
+			challengeID:         challenge.ID,
+			code:                recoveryCode,
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: mfa,
+			retrieveByIDResp:    user,
+			issueResponse:       &grpcTokenV1.Token{AccessToken: validToken, RefreshToken: &validToken, AccessType: "3"},
+			err:                 nil,
+		},
+		{
+			desc:                "verify MFA with invalid code",
+			challengeID:         challenge.ID,
+			code:                "000000",
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: mfa,
+			err:                 users.ErrInvalidMFACode,
+		},
+		{
+			desc:                "verify MFA with already used TOTP code",
+			challengeID:         challenge.ID,
+			code:                code,
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: usedStep,
+			err:                 users.ErrInvalidMFACode,
+		},
+		{
+			desc:              "verify MFA with expired challenge",
+			challengeID:       expired.ID,
+			code:              code,
+			challengeResponse: expired,
+			err:               users.ErrMFAChallengeExpired,
+		},
+		{
+			desc:              "verify MFA with exhausted challenge",
+			challengeID:       exhausted.ID,
+			code:              code,
+			challengeResponse: exhausted,
+			incrementErr:      repoerr.ErrNotFound,
+			attempted:         true,
+			err:               users.ErrMFAChallengeExpired,
+		},
+		{
+			desc:              "verify MFA with failed to increment attempts",
+			challengeID:       challenge.ID,
+			code:              code,
+			challengeResponse: challenge,
+			incrementErr:      repoerr.ErrUpdateEntity,
+			attempted:         true,
+			err:               svcerr.ErrAuthentication,
+		},
+		{
+			desc:         "verify MFA with non-existing challenge",
+			challengeID:  wrongID,
+			code:         code,
+			challengeErr: repoerr.ErrNotFound,
+			err:          svcerr.ErrAuthentication,
+		},
+		{
+			desc:              "verify MFA with failed to retrieve MFA",
+			challengeID:       challenge.ID,
+			code:              code,
+			challengeResponse: challenge,
+			attempted:         true,
+			retrieveMFAErr:    repoerr.ErrNotFound,
+			err:               svcerr.ErrAuthentication,
+		},
+		{
+			desc:                "verify MFA for disabled user",
+			challengeID:         challenge.ID,
+			code:                code,
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: mfa,
+			retrieveByIDResp:    disabledUser,
+			err:                 svcerr.ErrAuthentication,
+		},
+		{
+			desc:                "verify MFA with failed to issue token",
+			challengeID:         challenge.ID,
+			code:                code,
+			challengeResponse:   challenge,
+			attempted:           true,
+			retrieveMFAResponse: mfa,
+			retrieveByIDResp:    user,
+			issueResponse:       &grpcTokenV1.Token{},
+			issueErr:            svcerr.ErrAuthentication,
+			err:                 svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			attempted := false
+			issueReq := &grpcTokenV1.IssueReq{UserId: user.ID, UserRole: uint32(user.Role + 1), Type: uint32(smqauth.AccessKey), Mfa: true}
+			repoCall := cRepo.On("RetrieveMFAChallenge", context.Background(), tc.challengeID).Return(tc.challengeResponse, tc.challengeErr)
+			repoCall1 := cRepo.On("RemoveMFAChallenge", context.Background(), tc.challengeID).Return(nil)
+			repoCall2 := cRepo.On("RetrieveMFA", context.Background(), user.ID).Return(tc.retrieveMFAResponse, tc.retrieveMFAErr)
+			repoCall3 := cRepo.On("IncrementMFAChallengeAttempts", context.Background(), tc.challengeID, uint(5)).Return(tc.incrementErr).Run(func(args mock.Arguments) {
+				attempted = true
+			})
+			repoCall4 := cRepo.On("SaveMFA", context.Background(), mock.Anything).Return(nil)
+			repoCall5 := cRepo.On("RetrieveByID", context.Background(), user.ID).Return(tc.retrieveByIDResp, tc.retrieveByIDErr)
+			authCall := auth.On("Issue", context.Background(), issueReq).Return(tc.issueResponse, tc.issueErr)
+			token, err := svc.VerifyMFA(context.Background(), tc.challengeID, tc.code)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.attempted, attempted, fmt.Sprintf("%s: expected challenge attempt to be counted %t got %t\n", tc.desc, tc.attempted, attempted))
+			if err == nil {
+				assert.Equal(t, validToken, token.GetAccessToken(), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, validToken, token.GetAccessToken()))
+				ok := repoCall1.Parent.AssertCalled(t, "RemoveMFAChallenge", context.Background(), tc.challengeID)
+				assert.True(t, ok, fmt.Sprintf("RemoveMFAChallenge was not called on %s", tc.desc))
+				ok = authCall.Parent.AssertCalled(t, "Issue", context.Background(), issueReq)
+				assert.True(t, ok, fmt.Sprintf("Issue was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repoCall3.Unset()
+			repoCall4.Unset()
+			repoCall5.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestEnrollMFA(t *testing.T) {
+	svc, cRepo := newServiceMinimal()
+
+	cases := []struct {
+		desc                string
+		session             authn.Session
+		retrieveByIDResp    users.User
+		retrieveByIDErr     error
+		retrieveMFAResponse users.MFA
+		retrieveMFAErr      error
+		saveErr             error
+		err                 error
+	}{
+		{
+			desc:             "enroll MFA successfully",
+			session:          authn.Session{UserID: user.ID},
+			retrieveByIDResp: user,
+			retrieveMFAErr:   repoerr.ErrNotFound,
+			err:              nil,
+		},
+		{
+			desc:                "enroll MFA again before confirmation",
+			session:             authn.Session{UserID: user.ID},
+			retrieveByIDResp:    user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret},
+			err:                 nil,
+		},
+		{
+			desc:                "enroll MFA with MFA already enabled",
+			session:             authn.Session{UserID: user.ID},
+			retrieveByIDResp:    user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			err:                 users.ErrMFAEnabled,
+		},
+		{
+			desc:            "enroll MFA with failed to retrieve user",
+			session:         authn.Session{UserID: user.ID},
+			retrieveByIDErr: repoerr.ErrNotFound,
+			err:             svcerr.ErrViewEntity,
+		},
+		{
+			desc:             "enroll MFA with failed to save",
+			session:          authn.Session{UserID: user.ID},
+			retrieveByIDResp: user,
+			retrieveMFAErr:   repoerr.ErrNotFound,
+			saveErr:          repoerr.ErrCreateEntity,
+			err:              svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := cRepo.On("RetrieveByID", context.Background(), tc.session.UserID).Return(tc.retrieveByIDResp, tc.retrieveByIDErr)
+			repoCall1 := cRepo.On("RetrieveMFA", context.Background(), tc.session.UserID).Return(tc.retrieveMFAResponse, tc.retrieveMFAErr)
+			repoCall2 := cRepo.On("SaveMFA", context.Background(), mock.Anything).Return(tc.saveErr)
+			enrolment, err := svc.EnrollMFA(context.Background(), tc.session)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.NotEmpty(t, enrolment.Secret, fmt.Sprintf("%s: expected secret not to be empty\n", tc.desc))
+				assert.True(t, strings.HasPrefix(enrolment.URI, "otpauth://totp/"), fmt.Sprintf("%s: expected otpauth URI got %s\n", tc.desc, enrolment.URI))
+				assert.Contains(t, enrolment.URI, "secret="+enrolment.Secret, fmt.Sprintf("%s: expected URI to contain the secret\n", tc.desc))
+				ok := repoCall2.Parent.AssertCalled(t, "SaveMFA", context.Background(), mock.Anything)
+				assert.True(t, ok, fmt.Sprintf("SaveMFA was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+		})
+	}
+}
+
+func TestConfirmMFA(t *testing.T) {
+	svc, cRepo := newServiceMinimal()
+
+	code, err := totp.Code(mfaSecret, mfaTime)
+	require.Nil(t, err, fmt.Sprintf("generating TOTP code expected to succeed: %s", err))
+
+	pending := users.MFA{UserID: user.ID, Secret: mfaSecret, CreatedAt: mfaTime}
+	enabled := pending
+	enabled.EnabledAt = mfaTime
+
+	cases := []struct {
+		desc                string
+		session             authn.Session
+		code                string
+		retrieveMFAResponse users.MFA
+		retrieveMFAErr      error
+		saveErr             error
+		err                 error
+	}{
+		{
+			desc:                "confirm MFA with valid code",
+			session:             authn.Session{UserID: user.ID},
+			code:                code,
+			retrieveMFAResponse: pending,
+			err:                 nil,
+		},
+		{
+			desc:                "confirm MFA with invalid code",
+			session:             authn.Session{UserID: user.ID},
+			code:                "000000",
+			retrieveMFAResponse: pending,
+			err:                 users.ErrInvalidMFACode,
+		},
+		{
+			desc:           "confirm MFA without enrolment",
+			session:        authn.Session{UserID: user.ID},
+			code:           code,
+			retrieveMFAErr: repoerr.ErrNotFound,
+			err:            users.ErrMFANotEnrolled,
+		},
+		{
+			desc:                "confirm MFA with MFA already enabled",
+			session:             authn.Session{UserID: user.ID},
+			code:                code,
+			retrieveMFAResponse: enabled,
+			err:                 users.ErrMFAEnabled,
+		},
+		{
+			desc:                "confirm MFA with failed to save",
+			session:             authn.Session{UserID: user.ID},
+			code:                code,
+			retrieveMFAResponse: pending,
+			saveErr:             repoerr.ErrUpdateEntity,
+			err:                 svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var saved users.MFA
+			repoCall := cRepo.On("RetrieveMFA", context.Background(), tc.session.UserID).Return(tc.retrieveMFAResponse, tc.retrieveMFAErr)
+			repoCall1 := cRepo.On("SaveMFA", context.Background(), mock.Anything).Return(tc.saveErr).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(users.MFA)
+			})
+			codes, err := svc.ConfirmMFA(context.Background(), tc.session, tc.code)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Len(t, codes, 10, fmt.Sprintf("%s: expected 10 recovery codes got %d\n", tc.desc, len(codes)))
+				assert.True(t, saved.Enabled(), fmt.Sprintf("%s: expected MFA to be enabled\n", tc.desc))
+				assert.Len(t, saved.RecoveryCodes, len(codes), fmt.Sprintf("%s: expected %d recovery code hashes got %d\n", tc.desc, len(codes), len(saved.RecoveryCodes)))
+				for i, c := range codes {
+					assert.NotEqual(t, c, saved.RecoveryCodes[i], fmt.Sprintf("%s: expected recovery code to be stored hashed\n", tc.desc))
+				}
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+		})
+	}
+}
+
+func TestDisableMFA(t *testing.T) {
+	svc, cRepo := newServiceMinimal()
+
+	code, err := totp.Code(mfaSecret, mfaTime)
+	require.Nil(t, err, fmt.Sprintf("generating TOTP code expected to succeed: %s", err))
+	recoveryHash, err := phasher.Hash("abcdefgh")
+	require.Nil(t, err, fmt.Sprintf("hashing recovery code expected to succeed: %s", err))
+
+	enabled := users.MFA{UserID: user.ID, Secret: mfaSecret, RecoveryCodes: []string{recoveryHash}, EnabledAt: mfaTime}
+
+	cases := []struct {
+		desc                string
+		session             authn.Session
+		code                string
+		retrieveMFAResponse users.MFA
+		retrieveMFAErr      error
+		removeErr           error
+		err                 error
+	}{
+		{
+			desc:                "disable MFA with valid TOTP code",
+			session:             authn.Session{UserID: user.ID},
+			code:                code,
+			retrieveMFAResponse: enabled,
+			err:                 nil,
+		},
+		{
+			desc:                "disable MFA with valid recovery code",
+			session:             authn.Session{UserID: user.ID},
+			code:                "ABCD-EFGH",
+			retrieveMFAResponse: enabled,
+			err:                 nil,
+		},
+		{
+			desc:                "disable MFA with invalid code",
+			session:             authn.Session{UserID: user.ID},
+			code:                "000000",
+			retrieveMFAResponse: enabled,
+			err:                 users.ErrInvalidMFACode,
+		},
+		{
+			desc:           "disable MFA when not enabled",
+			session:        authn.Session{UserID: user.ID},
+			code:           code,
+			retrieveMFAErr: repoerr.ErrNotFound,
+			err:            users.ErrMFANotEnabled,
+		},
+		{
+			desc:                "disable MFA with failed to remove",
+			session:             authn.Session{UserID: user.ID},
+			code:                code,
+			retrieveMFAResponse: enabled,
+			removeErr:           repoerr.ErrRemoveEntity,
+			err:                 svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := cRepo.On("RetrieveMFA", context.Background(), tc.session.UserID).Return(tc.retrieveMFAResponse, tc.retrieveMFAErr)
+			repoCall1 := cRepo.On("RemoveMFA", context.Background(), tc.session.UserID).Return(tc.removeErr)
+			err := svc.DisableMFA(context.Background(), tc.session, tc.code)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				ok := repoCall1.Parent.AssertCalled(t, "RemoveMFA", context.Background(), tc.session.UserID)
+				assert.True(t, ok, fmt.Sprintf("RemoveMFA was not called on %s", tc.desc))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
 		})
 	}
 }
@@ -1710,6 +2270,137 @@ func TestRefreshToken(t *testing.T) {
 	}
 }
 
+func TestListSessions(t *testing.T) {
+	svc, authsvc, _, _, _ := newService()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	session := authn.Session{UserID: validID}
+	pm := users.Page{Offset: 0, Limit: 10}
+	req := &grpcTokenV1.ListSessionsReq{UserId: validID, Offset: pm.Offset, Limit: pm.Limit}
+
+	cases := []struct {
+		desc     string
+		listResp *grpcTokenV1.ListSessionsRes
+		listErr  error
+		resp     users.LoginSessionsPage
+		err      error
+	}{
+		{
+			desc: "list sessions successfully",
+			listResp: &grpcTokenV1.ListSessionsRes{
+				Total: 1,
+				Limit: pm.Limit,
+				Sessions: []*grpcTokenV1.Session{
+					{
+						Id:          validID,
+						Ip:          "192.168.1.10",
+						UserAgent:   "supermq-cli",
+						IssuedAt:    timestamppb.New(now),
+						RefreshedAt: timestamppb.New(now),
+						ExpiresAt:   timestamppb.New(now.Add(time.Hour)),
+					},
+				},
+			},
+			resp: users.LoginSessionsPage{
+				Total: 1,
+				Limit: pm.Limit,
+				Sessions: []users.LoginSession{
+					{
+						ID:          validID,
+						IP:          "192.168.1.10",
+						UserAgent:   "supermq-cli",
+						IssuedAt:    now,
+						RefreshedAt: now,
+						ExpiresAt:   now.Add(time.Hour),
+					},
+				},
+			},
+		},
+		{
+			desc: "list sessions which were never refreshed",
+			listResp: &grpcTokenV1.ListSessionsRes{
+				Total: 1,
+				Limit: pm.Limit,
+				Sessions: []*grpcTokenV1.Session{
+					{
+						Id:        validID,
+						IssuedAt:  timestamppb.New(now),
+						ExpiresAt: timestamppb.New(now.Add(time.Hour)),
+					},
+				},
+			},
+			resp: users.LoginSessionsPage{
+				Total: 1,
+				Limit: pm.Limit,
+				Sessions: []users.LoginSession{
+					{
+						ID:        validID,
+						IssuedAt:  now,
+						ExpiresAt: now.Add(time.Hour),
+					},
+				},
+			},
+		},
+		{
+			desc:     "list sessions with failed to list",
+			listResp: &grpcTokenV1.ListSessionsRes{},
+			listErr:  svcerr.ErrViewEntity,
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authsvc.On("ListSessions", context.Background(), req).Return(tc.listResp, tc.listErr)
+			page, err := svc.ListSessions(context.Background(), session, pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.resp, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, page))
+			}
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	svc, authsvc, _, _, _ := newService()
+
+	session := authn.Session{UserID: validID}
+
+	cases := []struct {
+		desc      string
+		id        string
+		revokeErr error
+		err       error
+	}{
+		{
+			desc: "revoke session successfully",
+			id:   validID,
+		},
+		{
+			desc:      "revoke non-existing session",
+			id:        wrongID,
+			revokeErr: svcerr.ErrNotFound,
+			err:       svcerr.ErrNotFound,
+		},
+		{
+			desc:      "revoke session with failed to revoke",
+			id:        validID,
+			revokeErr: svcerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authCall := authsvc.On("RevokeSession", context.Background(), &grpcTokenV1.RevokeSessionReq{UserId: validID, SessionId: tc.id}).Return(&grpcTokenV1.RevokeSessionRes{Revoked: tc.revokeErr == nil}, tc.revokeErr)
+			err := svc.RevokeSession(context.Background(), session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			authCall.Unset()
+		})
+	}
+}
+
 func TestSendPasswordReset(t *testing.T) {
 	svc, auth, cRepo, _, e := newService()
 
@@ -1906,37 +2597,61 @@ func TestOAuthCallback(t *testing.T) {
 		retrieveByEmailResponse users.User
 		retrieveByEmailErr      error
 		saveResponse            users.User
+		username                string
 		addPoliciesErr          error
+		claim                   bool
+		claimErr                error
 		err                     error
 	}{
 		{
 			desc: "oauth signin callback with already existing user",
 			user: users.User{
-				Email: "test@example.com",
+				Email:        "test@example.com",
+				AuthProvider: "google",
 			},
 			retrieveByEmailResponse: users.User{
-				ID:         testsutil.GenerateUUID(t),
-				Role:       users.UserRole,
-				VerifiedAt: time.Now(),
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				VerifiedAt:   time.Now(),
+				AuthProvider: "google",
 			},
 			err: nil,
 		},
 		{
 			desc: "oauth signup callback with user not found",
 			user: users.User{
-				Email: "test@example.com",
+				Email:        "test@example.com",
+				AuthProvider: "google",
 			},
 			retrieveByEmailErr: repoerr.ErrNotFound,
 			saveResponse: users.User{
-				ID:   testsutil.GenerateUUID(t),
-				Role: users.UserRole,
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				AuthProvider: "google",
 			},
 			err: nil,
 		},
+		{
+			desc: "oauth signup callback with username mapped by provider",
+			user: users.User{
+				Email:        "test@example.com",
+				Credentials:  users.Credentials{Username: "jdoe"},
+				AuthProvider: "google",
+			},
+			retrieveByEmailErr: repoerr.ErrNotFound,
+			saveResponse: users.User{
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				AuthProvider: "google",
+			},
+			username: "jdoe",
+			err:      nil,
+		},
 		{
 			desc: "oauth signup callback with malformed entity",
 			user: users.User{
-				Email: "test@example.com",
+				Email:        "test@example.com",
+				AuthProvider: "google",
 			},
 			retrieveByEmailErr: repoerr.ErrMalformedEntity,
 			err:                repoerr.ErrMalformedEntity,
@@ -1944,7 +2659,8 @@ func TestOAuthCallback(t *testing.T) {
 		{
 			desc: "oauth signup callback with failed to register user",
 			user: users.User{
-				Email: "test@example.com",
+				Email:        "test@example.com",
+				AuthProvider: "google",
 			},
 			addPoliciesErr:     svcerr.ErrAuthorization,
 			retrieveByEmailErr: repoerr.ErrNotFound,
@@ -1953,30 +2669,104 @@ func TestOAuthCallback(t *testing.T) {
 		{
 			desc: "oauth signin callback with user not in the platform",
 			user: users.User{
-				Email: "test@example.com",
+				Email:        "test@example.com",
+				AuthProvider: "google",
 			},
 			retrieveByEmailResponse: users.User{
-				ID:   testsutil.GenerateUUID(t),
-				Role: users.UserRole,
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				AuthProvider: "google",
 			},
 			err: nil,
 		},
+		{
+			desc: "oauth signin callback with existing password account",
+			user: users.User{
+				Email:        "test@example.com",
+				AuthProvider: "google",
+			},
+			retrieveByEmailResponse: users.User{
+				ID:         testsutil.GenerateUUID(t),
+				Role:       users.UserRole,
+				VerifiedAt: time.Now(),
+			},
+			err: errAccountLinking,
+		},
+		{
+			desc: "oauth signin callback with account of other provider",
+			user: users.User{
+				Email:        "test@example.com",
+				AuthProvider: "google",
+			},
+			retrieveByEmailResponse: users.User{
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				VerifiedAt:   time.Now(),
+				AuthProvider: "keycloak",
+			},
+			err: errAccountLinking,
+		},
+		{
+			desc: "oauth signin callback with verified email",
+			user: users.User{
+				Email:        "test@example.com",
+				AuthProvider: "google",
+				VerifiedAt:   time.Now(),
+			},
+			retrieveByEmailResponse: users.User{
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				VerifiedAt:   time.Now(),
+				AuthProvider: "google",
+			},
+			claim: true,
+			err:   nil,
+		},
+		{
+			desc: "oauth signin callback with failed to claim invitations",
+			user: users.User{
+				Email:        "test@example.com",
+				AuthProvider: "google",
+				VerifiedAt:   time.Now(),
+			},
+			retrieveByEmailResponse: users.User{
+				ID:           testsutil.GenerateUUID(t),
+				Role:         users.UserRole,
+				VerifiedAt:   time.Now(),
+				AuthProvider: "google",
+			},
+			claim:    true,
+			claimErr: svcerr.ErrUpdateEntity,
+			err:      nil,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := cRepo.On("RetrieveByEmail", context.Background(), tc.user.Email).Return(tc.retrieveByEmailResponse, tc.retrieveByEmailErr)
-			repoCall1 := cRepo.On("Save", context.Background(), mock.Anything).Return(tc.saveResponse, nil)
+			var saved users.User
+			repoCall1 := cRepo.On("Save", context.Background(), mock.Anything).Return(tc.saveResponse, nil).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(users.User)
+			})
 			repoCall2 := cRepo.On("UpdateVerifiedAt", context.Background(), mock.MatchedBy(func(u users.User) bool {
 				assert.NotEmpty(t, u.ID, "UpdateVerifiedAt must be called with non-empty user ID")
 				return u.ID != ""
 			})).Maybe().Return(tc.retrieveByEmailResponse, nil)
 			policyCall := policies.On("AddPolicies", context.Background(), mock.Anything).Return(tc.addPoliciesErr)
+			claimed := false
+			domainsCall := domainsClient.On("ClaimInvitations", context.Background(), mock.Anything).Return(&grpcDomainsV1.ClaimInvitationsRes{}, tc.claimErr).Run(func(_ mock.Arguments) {
+				claimed = true
+			})
 			_, err := svc.OAuthCallback(context.Background(), tc.user)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.username != "" {
+				assert.Equal(t, tc.username, saved.Credentials.Username, fmt.Sprintf("%s: expected username %s got %s\n", tc.desc, tc.username, saved.Credentials.Username))
+			}
 			repoCall.Parent.AssertCalled(t, "RetrieveByEmail", context.Background(), tc.user.Email)
+			assert.Equal(t, tc.claim, claimed, fmt.Sprintf("%s: expected claiming invitations %t got %t\n", tc.desc, tc.claim, claimed))
 			repoCall.Unset()
 			repoCall1.Unset()
 			policyCall.Unset()
+			domainsCall.Unset()
 			_ = repoCall2
 			cRepo.ExpectedCalls = nil
 			policies.ExpectedCalls = nil
@@ -1984,6 +2774,77 @@ func TestOAuthCallback(t *testing.T) {
 	}
 }
 
+func TestOAuthMFAChallenge(t *testing.T) {
+	svc, _, cRepo, _, _ := newService()
+
+	cases := []struct {
+		desc                string
+		user                users.User
+		retrieveMFAResponse users.MFA
+		retrieveMFAErr      error
+		saveChallengeErr    error
+		challengesCount     uint64
+		challenge           bool
+		err                 error
+	}{
+		{
+			desc:           "OAuth MFA challenge for a user without MFA",
+			user:           user,
+			retrieveMFAErr: repoerr.ErrNotFound,
+			err:            nil,
+		},
+		{
+			desc:                "OAuth MFA challenge for a user with MFA enrolment not confirmed",
+			user:                user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret},
+			err:                 nil,
+		},
+		{
+			desc:                "OAuth MFA challenge for a user with MFA enabled",
+			user:                user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			challenge:           true,
+			err:                 nil,
+		},
+		{
+			desc:                "OAuth MFA challenge for a user with MFA enabled with too many challenges",
+			user:                user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			challengesCount:     5,
+			err:                 users.ErrMFAChallengesLimit,
+		},
+		{
+			desc:                "OAuth MFA challenge for a user with MFA enabled with failed to save challenge",
+			user:                user,
+			retrieveMFAResponse: users.MFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: mfaTime},
+			saveChallengeErr:    repoerr.ErrCreateEntity,
+			err:                 svcerr.ErrAuthentication,
+		},
+		{
+			desc:           "OAuth MFA challenge with failed to retrieve MFA",
+			user:           user,
+			retrieveMFAErr: repoerr.ErrViewEntity,
+			err:            svcerr.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := cRepo.On("RetrieveMFA", context.Background(), tc.user.ID).Return(tc.retrieveMFAResponse, tc.retrieveMFAErr)
+			repoCall1 := cRepo.On("CountMFAChallenges", context.Background(), tc.user.ID, mock.Anything).Return(tc.challengesCount, nil)
+			repoCall2 := cRepo.On("SaveMFAChallenge", context.Background(), mock.Anything).Return(tc.saveChallengeErr)
+			challenge, err := svc.OAuthMFAChallenge(context.Background(), tc.user)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.challenge, challenge.ID != "", fmt.Sprintf("%s: expected MFA challenge %t got %t\n", tc.desc, tc.challenge, challenge.ID != ""))
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+		})
+	}
+}
+
 func TestSendVerification(t *testing.T) {
 	svc, _, cRepo, _, e := newService()
 
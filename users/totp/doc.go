@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package totp contains the time-based one-time password (RFC 6238)
+// implementation used for SuperMQ users multi-factor authentication.
+package totp
@@ -0,0 +1,141 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/users"
+)
+
+const (
+	// Period is the validity period of the single code.
+	Period = 30 * time.Second
+	// Digits is the number of the code digits.
+	Digits = 6
+
+	secretSize = 20
+	// skew is the number of periods before and after the current one whose
+	// codes are accepted, to tolerate clock drift of the authenticator app.
+	skew = 1
+)
+
+var (
+	errGenerateSecret = errors.NewServiceError("failed to generate TOTP secret")
+	errInvalidSecret  = errors.New("invalid TOTP secret")
+
+	encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+var _ users.TOTP = (*totp)(nil)
+
+type totp struct {
+	issuer string
+	now    func() time.Time
+}
+
+// New instantiates the TOTP implementation. The issuer is shown by the
+// authenticator apps next to the account name and the clock is used to
+// determine the current time step.
+func New(issuer string, clock func() time.Time) users.TOTP {
+	return &totp{
+		issuer: issuer,
+		now:    clock,
+	}
+}
+
+func (t *totp) GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(errGenerateSecret, err)
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+func (t *totp) URI(account, secret string) string {
+	label := url.PathEscape(t.issuer + ":" + account)
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", t.issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", strconv.Itoa(Digits))
+	q.Set("period", strconv.Itoa(int(Period.Seconds())))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, q.Encode())
+}
+
+func (t *totp) Validate(secret, code string) (uint64, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+	if len(code) != Digits {
+		return 0, users.ErrInvalidMFACode
+	}
+
+	current := step(t.now())
+	for i := -skew; i <= skew; i++ {
+		s := current + uint64(i)
+		if subtle.ConstantTimeCompare([]byte(hotp(key, s)), []byte(code)) == 1 {
+			return s, nil
+		}
+	}
+
+	return 0, users.ErrInvalidMFACode
+}
+
+// Code returns the code of the secret at the given time.
+func Code(secret string, at time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, step(at)), nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, errors.Wrap(errInvalidSecret, err)
+	}
+
+	return key, nil
+}
+
+func step(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period.Seconds())
+}
+
+// hotp computes the HMAC-based one-time password as described in RFC 4226.
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range Digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package totp_test
+
+import (
+	"encoding/base32"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/absmach/supermq/pkg/errors"
+	"github.com/absmach/supermq/users"
+	"github.com/absmach/supermq/users/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 secret of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// RFC 6238 Appendix B test vectors, truncated to 6 digits.
+	cases := []struct {
+		desc string
+		at   time.Time
+		code string
+	}{
+		{desc: "code at 59", at: time.Unix(59, 0), code: "287082"},
+		{desc: "code at 1111111109", at: time.Unix(1111111109, 0), code: "081804"},
+		{desc: "code at 1111111111", at: time.Unix(1111111111, 0), code: "050471"},
+		{desc: "code at 1234567890", at: time.Unix(1234567890, 0), code: "005924"},
+		{desc: "code at 2000000000", at: time.Unix(2000000000, 0), code: "279037"},
+		{desc: "code at 20000000000", at: time.Unix(20000000000, 0), code: "353130"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			code, err := totp.Code(rfcSecret, tc.at)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.code, code, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, code))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	tp := totp.New("SuperMQ", func() time.Time { return now })
+	currentStep := uint64(now.Unix()) / uint64(totp.Period.Seconds())
+
+	code := func(at time.Time) string {
+		c, err := totp.Code(rfcSecret, at)
+		require.Nil(t, err, fmt.Sprintf("generating code expected to succeed: %s", err))
+		return c
+	}
+
+	cases := []struct {
+		desc   string
+		secret string
+		code   string
+		step   uint64
+		err    error
+	}{
+		{
+			desc:   "validate code of the current period",
+			secret: rfcSecret,
+			code:   code(now),
+			step:   currentStep,
+		},
+		{
+			desc:   "validate code of the previous period",
+			secret: rfcSecret,
+			code:   code(now.Add(-totp.Period)),
+			step:   currentStep - 1,
+		},
+		{
+			desc:   "validate code of the next period",
+			secret: rfcSecret,
+			code:   code(now.Add(totp.Period)),
+			step:   currentStep + 1,
+		},
+		{
+			desc:   "validate code out of the accepted skew",
+			secret: rfcSecret,
+			code:   code(now.Add(-2 * totp.Period)),
+			err:    users.ErrInvalidMFACode,
+		},
+		{
+			desc:   "validate wrong code",
+			secret: rfcSecret,
+			code:   "000000",
+			err:    users.ErrInvalidMFACode,
+		},
+		{
+			desc:   "validate code of wrong length",
+			secret: rfcSecret,
+			code:   "12345",
+			err:    users.ErrInvalidMFACode,
+		},
+		{
+			desc:   "validate code with invalid secret",
+			secret: "invalid secret!",
+			code:   code(now),
+			err:    errors.New("invalid TOTP secret"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			step, err := tp.Validate(tc.secret, tc.code)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.step, step, fmt.Sprintf("%s: expected step %d got %d", tc.desc, tc.step, step))
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	now := time.Now()
+	tp := totp.New("SuperMQ", func() time.Time { return now })
+
+	secret, err := tp.GenerateSecret()
+	assert.Nil(t, err, fmt.Sprintf("generating secret: unexpected error %s", err))
+	other, err := tp.GenerateSecret()
+	assert.Nil(t, err, fmt.Sprintf("generating secret: unexpected error %s", err))
+	assert.NotEqual(t, secret, other, "expected generated secrets to differ")
+
+	code, err := totp.Code(secret, now)
+	require.Nil(t, err, fmt.Sprintf("generating code expected to succeed: %s", err))
+	_, err = tp.Validate(secret, code)
+	assert.Nil(t, err, fmt.Sprintf("validating code of generated secret: unexpected error %s", err))
+}
+
+func TestURI(t *testing.T) {
+	tp := totp.New("SuperMQ", time.Now)
+
+	uri, err := url.Parse(tp.URI("user@example.com", rfcSecret))
+	require.Nil(t, err, fmt.Sprintf("parsing URI expected to succeed: %s", err))
+
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/SuperMQ:user@example.com", uri.Path)
+	q := uri.Query()
+	assert.Equal(t, rfcSecret, q.Get("secret"))
+	assert.Equal(t, "SuperMQ", q.Get("issuer"))
+	assert.Equal(t, "SHA1", q.Get("algorithm"))
+	assert.Equal(t, "6", q.Get("digits"))
+	assert.Equal(t, "30", q.Get("period"))
+}
@@ -33,6 +33,9 @@ type User struct {
 	UpdatedBy       string      `json:"updated_by,omitempty"`
 	VerifiedAt      time.Time   `json:"verified_at,omitempty"`
 	AuthProvider    string      `json:"auth_provider,omitempty"`
+	// InvitationToken is the token from the domain invitation sent to the
+	// user email. It is used only on registration and it's never stored.
+	InvitationToken string `json:"invitation_token,omitempty"`
 }
 
 type Credentials struct {
@@ -48,6 +51,25 @@ type UsersPage struct {
 // Metadata represents arbitrary JSON.
 type Metadata map[string]any
 
+// LoginSession represents the user login session, started by issuing the
+// token and kept alive by refreshing it.
+type LoginSession struct {
+	ID          string    `json:"id"`
+	IP          string    `json:"ip,omitempty"`
+	UserAgent   string    `json:"user_agent,omitempty"`
+	IssuedAt    time.Time `json:"issued_at"`
+	RefreshedAt time.Time `json:"refreshed_at,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// LoginSessionsPage contains page related metadata as well as list of login sessions.
+type LoginSessionsPage struct {
+	Total    uint64
+	Offset   uint64
+	Limit    uint64
+	Sessions []LoginSession
+}
+
 type UserReq struct {
 	FirstName       *string    `json:"first_name,omitempty"`
 	LastName        *string    `json:"last_name,omitempty"`
@@ -128,6 +150,32 @@ type Repository interface {
 
 	// UpdateUserVerificationDetails update verification details for the given user id and email.
 	UpdateUserVerification(ctx context.Context, uv UserVerification) error
+
+	// SaveMFA creates or replaces the MFA settings of the user.
+	SaveMFA(ctx context.Context, mfa MFA) error
+
+	// RetrieveMFA retrieves the MFA settings of the user.
+	RetrieveMFA(ctx context.Context, userID string) (MFA, error)
+
+	// RemoveMFA removes the MFA settings of the user.
+	RemoveMFA(ctx context.Context, userID string) error
+
+	// SaveMFAChallenge persists the MFA challenge.
+	SaveMFAChallenge(ctx context.Context, challenge MFAChallenge) error
+
+	// RetrieveMFAChallenge retrieves the MFA challenge by its ID.
+	RetrieveMFAChallenge(ctx context.Context, id string) (MFAChallenge, error)
+
+	// CountMFAChallenges returns the number of the MFA challenges of the user
+	// which expire after the given time.
+	CountMFAChallenges(ctx context.Context, userID string, expiresAfter time.Time) (uint64, error)
+
+	// IncrementMFAChallengeAttempts increments the number of the MFA challenge
+	// attempts, unless the challenge already reached the attempts limit.
+	IncrementMFAChallengeAttempts(ctx context.Context, id string, limit uint) error
+
+	// RemoveMFAChallenge removes the MFA challenge.
+	RemoveMFAChallenge(ctx context.Context, id string) error
 }
 
 // Validate returns an error if user representation is invalid.
@@ -264,17 +312,45 @@ type Service interface {
 	Identify(ctx context.Context, session authn.Session) (string, error)
 
 	// IssueToken issues a new access and refresh token when provided with either a username or email.
-	IssueToken(ctx context.Context, identity, secret string) (*grpcTokenV1.Token, error)
+	// If the user has MFA enabled, the MFA challenge is returned instead of the token.
+	IssueToken(ctx context.Context, identity, secret string) (*grpcTokenV1.Token, MFAChallenge, error)
+
+	// VerifyMFA answers the MFA challenge with the TOTP or recovery code and
+	// issues a new access and refresh token.
+	VerifyMFA(ctx context.Context, challengeID, code string) (*grpcTokenV1.Token, error)
+
+	// EnrollMFA starts the MFA enrolment of the user by generating a new TOTP secret.
+	EnrollMFA(ctx context.Context, session authn.Session) (MFAEnrolment, error)
+
+	// ConfirmMFA confirms the MFA enrolment with the TOTP code generated by the
+	// authenticator app and returns the one-time recovery codes.
+	ConfirmMFA(ctx context.Context, session authn.Session, code string) ([]string, error)
+
+	// DisableMFA disables the MFA of the user, provided with the TOTP or recovery code.
+	DisableMFA(ctx context.Context, session authn.Session, code string) error
 
 	// RefreshToken refreshes expired access tokens.
 	// After an access token expires, the refresh token is used to get
 	// a new pair of access and refresh tokens.
 	RefreshToken(ctx context.Context, session authn.Session, refreshToken string) (*grpcTokenV1.Token, error)
 
+	// ListSessions lists the active login sessions of the user.
+	ListSessions(ctx context.Context, session authn.Session, pm Page) (LoginSessionsPage, error)
+
+	// RevokeSession revokes the login session of the user, so its refresh token
+	// can't be used anymore.
+	RevokeSession(ctx context.Context, session authn.Session, id string) error
+
 	// OAuthCallback handles the callback from any supported OAuth provider.
 	// It processes the OAuth tokens and either signs in or signs up the user based on the provided state.
 	OAuthCallback(ctx context.Context, user User) (User, error)
 
 	// OAuthAddUserPolicy adds a policy to the user for an OAuth request.
 	OAuthAddUserPolicy(ctx context.Context, user User) error
+
+	// OAuthMFAChallenge issues the MFA challenge to the user who logged in
+	// with the OAuth provider if the user has enabled MFA, so the tokens are
+	// issued only once the challenge is answered with VerifyMFA. Otherwise,
+	// it returns an empty challenge.
+	OAuthMFAChallenge(ctx context.Context, user User) (MFAChallenge, error)
 }